@@ -35,6 +35,10 @@ func (this *IDriverContext) Cache(ctx context.Context, id int64, level int8) (_e
 	return this.PackError(ierror.RaiseMsg("Driver does not implement cache"))
 }
 
+func (this *IDriverContext) Unpersist(ctx context.Context, id int64) (_err error) {
+	return this.PackError(ierror.RaiseMsg("Driver does not implement unpersist"))
+}
+
 func (this *IDriverContext) LoadCache(ctx context.Context, id int64) (_err error) {
 	this.mu.Lock()
 	defer this.mu.Unlock()
@@ -46,6 +50,18 @@ func (this *IDriverContext) LoadCache(ctx context.Context, id int64) (_err error
 	return nil
 }
 
+func (this *IDriverContext) LoadReplica(ctx context.Context, id int64, executor int64) (_err error) {
+	return this.PackError(ierror.RaiseMsg("Driver does not implement loadReplica"))
+}
+
+func (this *IDriverContext) Checkpoint(ctx context.Context) (_r int64, _err error) {
+	return 0, this.PackError(ierror.RaiseMsg("Driver does not implement checkpoint"))
+}
+
+func (this *IDriverContext) RestoreCheckpoint(ctx context.Context) (_err error) {
+	return this.PackError(ierror.RaiseMsg("Driver does not implement restoreCheckpoint"))
+}
+
 func (this *IDriverContext) SaveContext(ctx context.Context) (_r int64, _err error) {
 	this.mu.Lock()
 	defer this.mu.Unlock()
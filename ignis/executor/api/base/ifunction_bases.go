@@ -1,9 +1,12 @@
 package base
 
 import (
+	"encoding/json"
 	"ignis/executor/api"
 	"ignis/executor/api/function"
+	"ignis/executor/api/ipair"
 	"ignis/executor/api/iterator"
+	"ignis/executor/core/ierror"
 	"ignis/executor/core/modules/impl"
 )
 
@@ -120,6 +123,22 @@ func (this *IMapPartitions[T, R]) RunMapPartitions(i *impl.IPipeImpl, f function
 	return impl.MapPartitions[T, R](i, f.(function.IFunction[iterator.IReadIterator[T], []R]))
 }
 
+type IMapPartitionsStreamAbs interface {
+	RunMapPartitionsStream(i *impl.IPipeImpl, f function.IBaseFunction) error
+}
+
+/*mapPartitions whose function writes its results to an iterator instead of returning them*/
+type IMapPartitionsStream[T any, R any] struct {
+}
+
+func (this *IMapPartitionsStream[T, R]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T](), NewTypeA[R]()}
+}
+
+func (this *IMapPartitionsStream[T, R]) RunMapPartitionsStream(i *impl.IPipeImpl, f function.IBaseFunction) error {
+	return impl.MapPartitionsStream[T, R](i, f.(function.IVoidFunction2[iterator.IReadIterator[T], iterator.IWriteIterator[R]]))
+}
+
 type IMapPartitionsWithIndexAbs interface {
 	RunMapPartitionsWithIndex(i *impl.IPipeImpl, f function.IBaseFunction) error
 }
@@ -135,6 +154,21 @@ func (this *IMapPartitionsWithIndex[T, R]) RunMapPartitionsWithIndex(i *impl.IPi
 	return impl.MapPartitionsWithIndex[T, R](i, f.(function.IFunction2[int64, iterator.IReadIterator[T], []R]))
 }
 
+type IMapPartitionsWithBoundaryAbs interface {
+	RunMapPartitionsWithBoundary(i *impl.IPipeImpl, f function.IBaseFunction, k int64) error
+}
+
+type IMapPartitionsWithBoundary[T any, R any] struct {
+}
+
+func (this *IMapPartitionsWithBoundary[T, R]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T](), NewTypeA[R]()}
+}
+
+func (this *IMapPartitionsWithBoundary[T, R]) RunMapPartitionsWithBoundary(i *impl.IPipeImpl, f function.IBaseFunction, k int64) error {
+	return impl.MapPartitionsWithBoundary[T, R](i, k, f.(function.IFunction2[impl.IBoundary[T], iterator.IReadIterator[T], []R]))
+}
+
 type IMapExecutorAbs interface {
 	RunMapExecutor(i *impl.IPipeImpl, f function.IBaseFunction) error
 }
@@ -225,6 +259,21 @@ func (this *IPartitionBy[T]) RunPartitionBy(i *impl.IRepartitionImpl, f function
 	return impl.PartitionBy[T](i, f.(function.IFunction[T, int64]), numPartitions)
 }
 
+type IPartitionByKeyAbs interface {
+	RunPartitionByKey(i *impl.IRepartitionImpl, f function.IBaseFunction, name string, numPartitions int64) error
+}
+
+type IPartitionByKey[K any, V any] struct {
+}
+
+func (this *IPartitionByKey[K, V]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[K](), NewTypeA[V](), NewTypeAA[K, V]()}
+}
+
+func (this *IPartitionByKey[K, V]) RunPartitionByKey(i *impl.IRepartitionImpl, f function.IBaseFunction, name string, numPartitions int64) error {
+	return impl.PartitionByKeyBy[V, K](i, f.(function.IFunction[K, int64]), name, numPartitions)
+}
+
 type IMapValuesAbs interface {
 	RunMapValues(i *impl.IPipeImpl, f function.IBaseFunction) error
 }
@@ -255,6 +304,43 @@ func (this *IFlatMapValues[K, T, R]) RunFlatMapValues(i *impl.IPipeImpl, f funct
 	return impl.FlatMapValues[K](i, f.(function.IFunction[T, []R]))
 }
 
+type ISelectToAbs interface {
+	RunSelectTo(i *impl.IPipeImpl, paths []string) error
+}
+
+/*Only declares the input and output types of selectTo, the function is never called*/
+type ISelectTo[T any, R any] struct {
+}
+
+func (this *ISelectTo[T, R]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T](), NewTypeA[R]()}
+}
+
+func (this *ISelectTo[T, R]) RunSelectTo(i *impl.IPipeImpl, paths []string) error {
+	return impl.SelectTo[T, R](i, paths)
+}
+
+type IExplodeAbs interface {
+	RunExplode(i *impl.IPipeImpl, path string) error
+	RunExplodeSelect(i *impl.IPipeImpl, path string, paths []string) error
+}
+
+/*Only declares the input and item types of explode, the function is never called*/
+type IExplode[T any, E any] struct {
+}
+
+func (this *IExplode[T, E]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T](), NewTypeA[E](), NewTypeAA[T, E](), NewTypeAA[[]any, E]()}
+}
+
+func (this *IExplode[T, E]) RunExplode(i *impl.IPipeImpl, path string) error {
+	return impl.Explode[T, E](i, path)
+}
+
+func (this *IExplode[T, E]) RunExplodeSelect(i *impl.IPipeImpl, path string, paths []string) error {
+	return impl.ExplodeSelect[T, E](i, path, paths)
+}
+
 type IReduceByKeyAbs interface {
 	RunReduceByKey(i *impl.IReduceImpl, f function.IBaseFunction, numPartitions int64, localReduce bool) error
 }
@@ -270,6 +356,15 @@ func (this *IReduceByKey[K, T]) RunReduceByKey(i *impl.IReduceImpl, f function.I
 	return impl.ReduceByKey[K](i, f.(function.IFunction2[T, T, T]), numPartitions, localReduce)
 }
 
+type IReduceByKeyLocallyAbs interface {
+	RunReduceByKeyLocally(i *impl.IReduceImpl, f function.IBaseFunction) error
+}
+
+/*The function of a reduceByKey can also reduce the keys in the driver*/
+func (this *IReduceByKey[K, T]) RunReduceByKeyLocally(i *impl.IReduceImpl, f function.IBaseFunction) error {
+	return impl.ReduceByKeyLocally[K](i, f.(function.IFunction2[T, T, T]))
+}
+
 type IAggregateByKeyAbs interface {
 	RunAggregateByKey(i *impl.IReduceImpl, f function.IBaseFunction, numPartitions int64, hashing bool) error
 }
@@ -285,6 +380,24 @@ func (this *IAggregateByKey[K, T1, T2]) RunAggregateByKey(i *impl.IReduceImpl, f
 	return impl.AggregateByKey[K](i, f.(function.IFunction2[T1, T2, T1]), numPartitions, hashing)
 }
 
+type ICombineByKeyAbs interface {
+	RunCombineByKey(i *impl.IReduceImpl, create function.IBaseFunction, merge function.IBaseFunction,
+		combine function.IBaseFunction, numPartitions int64) error
+}
+
+type ICombineByKey[K comparable, V any, C any] struct {
+}
+
+func (this *ICombineByKey[K, V, C]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeC[K](), NewTypeA[V](), NewTypeA[C](), NewTypeCA[K, V](), NewTypeCA[K, C]()}
+}
+
+func (this *ICombineByKey[K, V, C]) RunCombineByKey(i *impl.IReduceImpl, create function.IBaseFunction, merge function.IBaseFunction,
+	combine function.IBaseFunction, numPartitions int64) error {
+	return impl.CombineByKey[K](i, create.(function.IFunction[V, C]), merge.(function.IFunction2[C, V, C]),
+		combine.(function.IFunction2[C, C, C]), numPartitions)
+}
+
 type IFoldByKeyAbs interface {
 	RunFoldByKey(i *impl.IReduceImpl, f function.IBaseFunction, numPartitions int64, localFold bool) error
 }
@@ -485,6 +598,67 @@ func (this *ITreeFold[T]) RunTreeFold(i *impl.IReduceImpl, f function.IBaseFunct
 	return impl.TreeFold(i, f.(function.IFunction2[T, T, T]))
 }
 
+type IPivotAbs interface {
+	RunPivot(i *impl.IReduceImpl, keyF function.IBaseFunction, columnF function.IBaseFunction, aggF function.IBaseFunction, numPartitions int64) (string, error)
+}
+
+/*Embedded in the aggregation function, the pivot columns are returned as json*/
+type IPivot[T any, K comparable, C comparable, V any] struct {
+}
+
+func (this *IPivot[T, K, C, V]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T](), NewTypeC[K](), NewTypeA[V](), NewTypeCA[K, []V]()}
+}
+
+func (this *IPivot[T, K, C, V]) RunPivot(i *impl.IReduceImpl, keyF function.IBaseFunction, columnF function.IBaseFunction,
+	aggF function.IBaseFunction, numPartitions int64) (string, error) {
+	columns, err := impl.Pivot(i, keyF.(function.IFunction[T, K]), columnF.(function.IFunction[T, C]),
+		aggF.(function.IFunction2[V, T, V]), numPartitions)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(columns)
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	return string(data), nil
+}
+
+type IUnpivotAbs interface {
+	RunUnpivot(i *impl.IReduceImpl, columns string) error
+}
+
+/*Only declares the key, column and value types of unpivot, the function is never called*/
+type IUnpivot[K any, C any, V any] struct {
+}
+
+func (this *IUnpivot[K, C, V]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[K](), NewTypeA[V](), NewTypeAA[K, []V](), NewTypeAA[C, V](), NewTypeAA[K, ipair.IPair[C, V]]()}
+}
+
+func (this *IUnpivot[K, C, V]) RunUnpivot(i *impl.IReduceImpl, columns string) error {
+	var parsed []C
+	if err := json.Unmarshal([]byte(columns), &parsed); err != nil {
+		return ierror.Raise(err)
+	}
+	return impl.Unpivot[K, C, V](i, parsed)
+}
+
+type IScanAbs interface {
+	RunScan(i *impl.IReduceImpl, f function.IBaseFunction) error
+}
+
+type IScan[T any] struct {
+}
+
+func (this *IScan[T]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T]()}
+}
+
+func (this *IScan[T]) RunScan(i *impl.IReduceImpl, f function.IBaseFunction) error {
+	return impl.Scan(i, f.(function.IFunction2[T, T, T]))
+}
+
 type ISortByKeyAbs interface {
 	RunSortByKey(i *impl.ISortImpl, f function.IBaseFunction, ascending bool) error
 	RunSortByKeyWithPartitions(i *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error
@@ -504,3 +678,65 @@ func (this *ISortByKey[K, V]) RunSortByKey(i *impl.ISortImpl, f function.IBaseFu
 func (this *ISortByKey[K, V]) RunSortByKeyWithPartitions(i *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error {
 	return impl.SortByKeyByWithPartitions[V, K](i, f.(function.IFunction2[K, K, bool]), ascending, partitions)
 }
+
+type IGroupByKeyAndSortValuesAbs interface {
+	RunGroupByKeyAndSortValues(i *impl.ISortImpl, f function.IBaseFunction, numPartitions int64, ascending bool) error
+}
+
+type IGroupByKeyAndSortValues[K any, V any] struct {
+}
+
+func (this *IGroupByKeyAndSortValues[K, V]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[K](), NewTypeA[V](), NewTypeAA[K, V]()}
+}
+
+func (this *IGroupByKeyAndSortValues[K, V]) RunGroupByKeyAndSortValues(i *impl.ISortImpl, f function.IBaseFunction, numPartitions int64, ascending bool) error {
+	return impl.GroupByKeyAndSortValuesBy[V, K](i, f.(function.IFunction2[V, V, bool]), numPartitions, ascending)
+}
+
+type IGapsAndIslandsAbs interface {
+	RunGapsAndIslands(i *impl.ISortImpl, keyF function.IBaseFunction, seqF function.IBaseFunction, maxGap int64, numPartitions int64) error
+}
+
+/*Embedded in the key function, the sequence function must be a function from T to int64*/
+type IGapsAndIslands[T any, K comparable] struct {
+}
+
+func (this *IGapsAndIslands[T, K]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T](), NewTypeC[K](), NewTypeCA[int64, T](), NewTypeCA[K, ipair.IPair[int64, T]]()}
+}
+
+func (this *IGapsAndIslands[T, K]) RunGapsAndIslands(i *impl.ISortImpl, keyF function.IBaseFunction, seqF function.IBaseFunction,
+	maxGap int64, numPartitions int64) error {
+	return impl.GapsAndIslands(i, keyF.(function.IFunction[T, K]), seqF.(function.IFunction[T, int64]), maxGap, numPartitions)
+}
+
+type ISaveAsTextFileAbs interface {
+	RunSaveAsTextFile(i *impl.IIOImpl, f function.IBaseFunction, path string, first int64) error
+}
+
+type ISaveAsTextFile[T any] struct {
+}
+
+func (this *ISaveAsTextFile[T]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[T]()}
+}
+
+func (this *ISaveAsTextFile[T]) RunSaveAsTextFile(i *impl.IIOImpl, f function.IBaseFunction, path string, first int64) error {
+	return impl.SaveAsTextFileBy[T](i, f.(function.IFunction[T, string]), path, first)
+}
+
+type IUpdateStateByKeyAbs interface {
+	RunUpdateStateByKey(i *impl.IStateImpl, f function.IBaseFunction, name string, numPartitions int64) error
+}
+
+type IUpdateStateByKey[K comparable, V any, S any] struct {
+}
+
+func (this *IUpdateStateByKey[K, V, S]) Types() []api.IContextType {
+	return []api.IContextType{NewTypeA[K](), NewTypeA[V](), NewTypeA[S](), NewTypeAA[K, V](), NewTypeAA[K, S]()}
+}
+
+func (this *IUpdateStateByKey[K, V, S]) RunUpdateStateByKey(i *impl.IStateImpl, f function.IBaseFunction, name string, numPartitions int64) error {
+	return impl.UpdateStateByKey[K, V, S](i, name, f.(function.IFunction2[[]V, *S, *S]), numPartitions)
+}
@@ -2,7 +2,9 @@ package base
 
 import (
 	"ignis/executor/api"
+	"ignis/executor/api/function"
 	"ignis/executor/api/ipair"
+	"ignis/executor/core"
 	"ignis/executor/core/modules/impl"
 )
 
@@ -13,6 +15,7 @@ type ITypeFunctions interface {
 	LoadType()
 
 	LoadFromDisk(cacheImpl *impl.ICacheImpl, group []string) error
+	RestoreCheckpoint(cacheImpl *impl.ICacheImpl, checkpoint *core.ICheckpoint) error
 	GetPartitions(commImpl *impl.ICommImpl, protocol int8, minPartitions int64) ([][]byte, error)
 	SetPartitions(commImpl *impl.ICommImpl, partitions [][]byte) error
 	DriverGather(commImpl *impl.ICommImpl, group string) error
@@ -22,14 +25,24 @@ type ITypeFunctions interface {
 
 	PartitionApproxSize(ioImpl *impl.IIOImpl) (int64, error)
 	PartitionObjectFile(ioImpl *impl.IIOImpl, path string, first int64, partitions int64) error
+	PartitionJsonFile(ioImpl *impl.IIOImpl, path string, first int64, partitions int64) error
 	SaveAsObjectFile(ioImpl *impl.IIOImpl, path string, compression int8, first int64) error
 	SaveAsTextFile(ioImpl *impl.IIOImpl, path string, first int64) error
 	SaveAsJsonFile(ioImpl *impl.IIOImpl, path string, first int64, pretty bool) error
+	SaveAsSequenceFile(ioImpl *impl.IIOImpl, path string, compression int8, first int64) error
+	BinaryFile(ioImpl *impl.IIOImpl, path string) error
+	CsvFile(ioImpl *impl.IIOImpl, path string, minPartitions int64, options impl.ICsvOptions) error
+	JsonFile(ioImpl *impl.IIOImpl, path string, minPartitions int64, permissive bool) (*impl.IJsonErrors, error)
+	SaveAsBinaryFile(ioImpl *impl.IIOImpl, path string, compression int8, first int64) error
 
 	Sort(sortImpl *impl.ISortImpl, ascending bool) error
 	SortWithPartitions(sortImpl *impl.ISortImpl, ascending bool, partitions int64) error
 	SortByKey(sortImpl *impl.ISortImpl, ascending bool) error
 	SortByKeyWithPartitions(sortImpl *impl.ISortImpl, ascending bool, partitions int64) error
+	SortByKeyBy(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool) error
+	SortByKeyByWithPartitions(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error
+	RepartitionAndSortWithinPartitions(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error
+	GroupByKeyAndSortValues(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error
 	Top(sortImpl *impl.ISortImpl, n int64) error
 	TakeOrdered(sortImpl *impl.ISortImpl, n int64) error
 	Max(sortImpl *impl.ISortImpl) error
@@ -38,21 +51,59 @@ type ITypeFunctions interface {
 	Take(pipeImpl *impl.IPipeImpl, num int64) error
 	Keys(pipeImpl *impl.IPipeImpl) error
 	Values(pipeImpl *impl.IPipeImpl) error
+	Zip(pipeImpl *impl.IPipeImpl, other string) error
+	UnionAll(pipeImpl *impl.IPipeImpl, others []string, rebalance bool) error
+	ZipWithIndex(pipeImpl *impl.IPipeImpl) error
+	Sliding(pipeImpl *impl.IPipeImpl, size int64, step int64) error
+	PipeCmd(pipeImpl *impl.IPipeImpl, command *impl.IPipeCommand, encoding string) error
+	Select(pipeImpl *impl.IPipeImpl, paths []string) error
+	MapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, any]) error
+	FlatMapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, []any]) error
 
 	Sample(mathImpl *impl.IMathImpl, withReplacement bool, num []int64, seed int32) error
 	SampleByKeyFilter(mathImpl *impl.IMathImpl) (int64, error)
 	SampleByKey(mathImpl *impl.IMathImpl, withReplacement bool, seed int32) error
+	TakeSample(mathImpl *impl.IMathImpl, withReplacement bool, n int64, seed int32) error
+	SampleByKeyExact(mathImpl *impl.IMathImpl, withReplacement bool, seed int32) error
 	CountByKey(mathImpl *impl.IMathImpl) error
 	CountByValue(mathImpl *impl.IMathImpl) error
+	CountApproxDistinct(mathImpl *impl.IMathImpl, relativeSD float64) (int64, error)
+	CountApproxDistinctByKey(mathImpl *impl.IMathImpl, relativeSD float64, numPartitions int64) error
+	TopN(mathImpl *impl.IMathImpl, n int64) error
+	TakeOrderedN(mathImpl *impl.IMathImpl, n int64) error
+	Histogram(mathImpl *impl.IMathImpl, buckets int64) error
+	ApproxQuantile(mathImpl *impl.IMathImpl, probabilities []float64, relativeError float64) error
+	Sum(mathImpl *impl.IMathImpl) error
+	Mean(mathImpl *impl.IMathImpl) error
+	SumByKey(mathImpl *impl.IMathImpl, numPartitions int64) error
 
+	Fold(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any], tree bool) error
+	Aggregate(reduceImpl *impl.IReduceImpl, seqOp function.IFunction2[any, any, any]) error
 	GroupByKey(reduceImpl *impl.IReduceImpl, numPartitions int64) error
+	ReduceByKey(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any], numPartitions int64, localReduce bool) error
+	ReduceByKeyLocally(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any]) error
 	Union(reduceImpl *impl.IReduceImpl, other string, preserveOrder bool) error
 	Join(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
+	LeftOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
+	RightOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
+	FullOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
+	CoGroup(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
 	Distinct(reduceImpl *impl.IReduceImpl, numPartitions int64) error
+	Intersection(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
+	Subtract(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
+	SubtractByKey(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error
 
 	Repartition(repartitionImpl *impl.IRepartitionImpl, numPartitions int64, preserveOrdering bool, global bool) error
+	Coalesce(repartitionImpl *impl.IRepartitionImpl, numPartitions int64, shuffle bool) error
 	PartitionByRandom(repartitionImpl *impl.IRepartitionImpl, numPartitions int64, seed int32) error
 	PartitionByHash(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error
+	PartitionByKeyHash(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error
+	PartitionByKeyRange(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error
+	ReorderPartitions(repartitionImpl *impl.IRepartitionImpl, order []int64) error
+	ReorderPartitionsBy(repartitionImpl *impl.IRepartitionImpl) error
+
+	PartitionStats(estimateImpl *impl.IEstimateImpl) (*impl.IDatasetStats, error)
+	KeyCardinality(estimateImpl *impl.IEstimateImpl, other string, values bool) ([]int64, error)
 }
 
 func NewTypeA[T any]() ITypeFunctions {
@@ -2,6 +2,8 @@ package base
 
 import (
 	"ignis/executor/api"
+	"ignis/executor/api/function"
+	"ignis/executor/core"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/iio"
 	"ignis/executor/core/modules/impl"
@@ -14,6 +16,15 @@ func registerTypeA[T any]() {
 	storage.CreateList[T]()
 }
 
+/*
+Encodes T with marshal and unmarshal instead of the reflection based native serialization, for example with the
+functions of protobuf generated structs. Every executor that reads or writes T must register the same functions.
+*/
+func RegisterSerializer[T any](marshal func(obj *T) ([]byte, error), unmarshal func(data []byte, obj *T) error) {
+	iio.SetNativeSerializer[T](marshal, unmarshal)
+	registerTypeA[T]()
+}
+
 type iTypeA[T any] struct {
 	tp   string
 	next ITypeFunctions
@@ -52,6 +63,10 @@ func (this *iTypeA[T]) LoadFromDisk(cacheImpl *impl.ICacheImpl, group []string)
 	return impl.LoadFromDisk[T](cacheImpl, group)
 }
 
+func (this *iTypeA[T]) RestoreCheckpoint(cacheImpl *impl.ICacheImpl, checkpoint *core.ICheckpoint) error {
+	return impl.RestoreCheckpoint[T](cacheImpl, checkpoint)
+}
+
 /*ICommImpl*/
 
 func (this *iTypeA[T]) GetPartitions(commImpl *impl.ICommImpl, protocol int8, minPartitions int64) ([][]byte, error) {
@@ -88,6 +103,10 @@ func (this *iTypeA[T]) PartitionObjectFile(ioImpl *impl.IIOImpl, path string, fi
 	return impl.PartitionObjectFile[T](ioImpl, path, first, partitions)
 }
 
+func (this *iTypeA[T]) PartitionJsonFile(ioImpl *impl.IIOImpl, path string, first int64, partitions int64) error {
+	return impl.PartitionJsonFile[T](ioImpl, path, first, partitions)
+}
+
 func (this *iTypeA[T]) SaveAsObjectFile(ioImpl *impl.IIOImpl, path string, compression int8, first int64) error {
 	return impl.SaveAsObjectFile[T](ioImpl, path, compression, first)
 }
@@ -100,6 +119,26 @@ func (this *iTypeA[T]) SaveAsJsonFile(ioImpl *impl.IIOImpl, path string, first i
 	return impl.SaveAsJsonFile[T](ioImpl, path, first, pretty)
 }
 
+func (this *iTypeA[T]) SaveAsSequenceFile(ioImpl *impl.IIOImpl, path string, compression int8, first int64) error {
+	return impl.SaveAsSequenceFile[T](ioImpl, path, compression, first)
+}
+
+func (this *iTypeA[T]) BinaryFile(ioImpl *impl.IIOImpl, path string) error {
+	return impl.BinaryFile[T](ioImpl, path)
+}
+
+func (this *iTypeA[T]) CsvFile(ioImpl *impl.IIOImpl, path string, minPartitions int64, options impl.ICsvOptions) error {
+	return impl.CsvFile[T](ioImpl, path, minPartitions, options)
+}
+
+func (this *iTypeA[T]) JsonFile(ioImpl *impl.IIOImpl, path string, minPartitions int64, permissive bool) (*impl.IJsonErrors, error) {
+	return impl.JsonFile[T](ioImpl, path, minPartitions, permissive)
+}
+
+func (this *iTypeA[T]) SaveAsBinaryFile(ioImpl *impl.IIOImpl, path string, compression int8, first int64) error {
+	return impl.SaveAsBinaryFile[T](ioImpl, path, compression, first)
+}
+
 /*ISortImpl*/
 
 func (this *iTypeA[T]) Sort(sortImpl *impl.ISortImpl, ascending bool) error {
@@ -124,6 +163,34 @@ func (this *iTypeA[T]) SortByKeyWithPartitions(sortImpl *impl.ISortImpl, ascendi
 	return typeAError()
 }
 
+func (this *iTypeA[T]) SortByKeyBy(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool) error {
+	if this.next != nil {
+		return this.next.SortByKeyBy(sortImpl, f, ascending)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) SortByKeyByWithPartitions(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error {
+	if this.next != nil {
+		return this.next.SortByKeyByWithPartitions(sortImpl, f, ascending, partitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) RepartitionAndSortWithinPartitions(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error {
+	if this.next != nil {
+		return this.next.RepartitionAndSortWithinPartitions(sortImpl, numPartitions, ascending)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) GroupByKeyAndSortValues(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error {
+	if this.next != nil {
+		return this.next.GroupByKeyAndSortValues(sortImpl, numPartitions, ascending)
+	}
+	return typeAError()
+}
+
 func (this *iTypeA[T]) Top(sortImpl *impl.ISortImpl, n int64) error {
 	return impl.Top[T](sortImpl, n)
 }
@@ -160,12 +227,57 @@ func (this *iTypeA[T]) Values(pipeImpl *impl.IPipeImpl) error {
 	return typeAError()
 }
 
+func (this *iTypeA[T]) Zip(pipeImpl *impl.IPipeImpl, other string) error {
+	if this.next != nil {
+		return this.next.Zip(pipeImpl, other)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) MapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, any]) error {
+	if this.next != nil {
+		return this.next.MapValues(pipeImpl, f)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) FlatMapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, []any]) error {
+	if this.next != nil {
+		return this.next.FlatMapValues(pipeImpl, f)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) UnionAll(pipeImpl *impl.IPipeImpl, others []string, rebalance bool) error {
+	return impl.UnionAll[T](pipeImpl, others, rebalance)
+}
+
+func (this *iTypeA[T]) ZipWithIndex(pipeImpl *impl.IPipeImpl) error {
+	return impl.ZipWithIndex[T](pipeImpl)
+}
+
+func (this *iTypeA[T]) Sliding(pipeImpl *impl.IPipeImpl, size int64, step int64) error {
+	return impl.Sliding[T](pipeImpl, size, step)
+}
+
+func (this *iTypeA[T]) PipeCmd(pipeImpl *impl.IPipeImpl, command *impl.IPipeCommand, encoding string) error {
+	return impl.PipeCmdEncoding[T](pipeImpl, command, encoding)
+}
+
+func (this *iTypeA[T]) Select(pipeImpl *impl.IPipeImpl, paths []string) error {
+	return impl.Select[T](pipeImpl, paths)
+}
+
 /*IMathImpl*/
 
 func (this *iTypeA[T]) Sample(mathImpl *impl.IMathImpl, withReplacement bool, num []int64, seed int32) error {
 	return impl.Sample[T](mathImpl, withReplacement, num, seed)
 }
 
+func (this *iTypeA[T]) TakeSample(mathImpl *impl.IMathImpl, withReplacement bool, n int64, seed int32) error {
+	return impl.TakeSample[T](mathImpl, withReplacement, n, seed)
+}
+
 func (this *iTypeA[T]) SampleByKeyFilter(mathImpl *impl.IMathImpl) (int64, error) {
 	if this.next != nil {
 		return this.next.SampleByKeyFilter(mathImpl)
@@ -180,6 +292,13 @@ func (this *iTypeA[T]) SampleByKey(mathImpl *impl.IMathImpl, withReplacement boo
 	return typeAError()
 }
 
+func (this *iTypeA[T]) SampleByKeyExact(mathImpl *impl.IMathImpl, withReplacement bool, seed int32) error {
+	if this.next != nil {
+		return this.next.SampleByKeyExact(mathImpl, withReplacement, seed)
+	}
+	return typeAError()
+}
+
 func (this *iTypeA[T]) CountByKey(mathImpl *impl.IMathImpl) error {
 	if this.next != nil {
 		return this.next.CountByKey(mathImpl)
@@ -194,8 +313,61 @@ func (this *iTypeA[T]) CountByValue(mathImpl *impl.IMathImpl) error {
 	return typeAError()
 }
 
+func (this *iTypeA[T]) CountApproxDistinct(mathImpl *impl.IMathImpl, relativeSD float64) (int64, error) {
+	return impl.CountApproxDistinct[T](mathImpl, relativeSD)
+}
+
+func (this *iTypeA[T]) CountApproxDistinctByKey(mathImpl *impl.IMathImpl, relativeSD float64, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.CountApproxDistinctByKey(mathImpl, relativeSD, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) TopN(mathImpl *impl.IMathImpl, n int64) error {
+	return impl.TopN[T](mathImpl, n)
+}
+
+func (this *iTypeA[T]) TakeOrderedN(mathImpl *impl.IMathImpl, n int64) error {
+	return impl.TakeOrderedN[T](mathImpl, n)
+}
+
+func (this *iTypeA[T]) Histogram(mathImpl *impl.IMathImpl, buckets int64) error {
+	return impl.Histogram[T](mathImpl, buckets)
+}
+
+func (this *iTypeA[T]) ApproxQuantile(mathImpl *impl.IMathImpl, probabilities []float64, relativeError float64) error {
+	return impl.ApproxQuantile[T](mathImpl, probabilities, relativeError)
+}
+
+func (this *iTypeA[T]) Sum(mathImpl *impl.IMathImpl) error {
+	return impl.Sum[T](mathImpl)
+}
+
+func (this *iTypeA[T]) Mean(mathImpl *impl.IMathImpl) error {
+	return impl.Mean[T](mathImpl)
+}
+
+func (this *iTypeA[T]) SumByKey(mathImpl *impl.IMathImpl, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.SumByKey(mathImpl, numPartitions)
+	}
+	return typeAError()
+}
+
 /*IReduceImpl*/
 
+func (this *iTypeA[T]) Fold(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any], tree bool) error {
+	if tree {
+		return impl.TreeFold[T](reduceImpl, impl.AnyFunction2[T](f))
+	}
+	return impl.Fold[T](reduceImpl, impl.AnyFunction2[T](f))
+}
+
+func (this *iTypeA[T]) Aggregate(reduceImpl *impl.IReduceImpl, seqOp function.IFunction2[any, any, any]) error {
+	return impl.Aggregate[any, T](reduceImpl, impl.AnySeqFunction[T](seqOp))
+}
+
 func (this *iTypeA[T]) GroupByKey(reduceImpl *impl.IReduceImpl, numPartitions int64) error {
 	if this.next != nil {
 		return this.next.GroupByKey(reduceImpl, numPartitions)
@@ -203,6 +375,20 @@ func (this *iTypeA[T]) GroupByKey(reduceImpl *impl.IReduceImpl, numPartitions in
 	return typeAError()
 }
 
+func (this *iTypeA[T]) ReduceByKey(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any], numPartitions int64, localReduce bool) error {
+	if this.next != nil {
+		return this.next.ReduceByKey(reduceImpl, f, numPartitions, localReduce)
+	}
+	return impl.ReduceByKey[any](reduceImpl, f, numPartitions, localReduce)
+}
+
+func (this *iTypeA[T]) ReduceByKeyLocally(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any]) error {
+	if this.next != nil {
+		return this.next.ReduceByKeyLocally(reduceImpl, f)
+	}
+	return impl.ReduceByKeyLocally[any](reduceImpl, f)
+}
+
 func (this *iTypeA[T]) Union(reduceImpl *impl.IReduceImpl, other string, preserveOrder bool) error {
 	return impl.Union[T](reduceImpl, other, preserveOrder)
 }
@@ -214,6 +400,34 @@ func (this *iTypeA[T]) Join(reduceImpl *impl.IReduceImpl, other string, numParti
 	return typeAError()
 }
 
+func (this *iTypeA[T]) LeftOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.LeftOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) RightOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.RightOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) FullOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.FullOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) CoGroup(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.CoGroup(reduceImpl, other, numPartitions)
+	}
+	return typeAError()
+}
+
 func (this *iTypeA[T]) Distinct(reduceImpl *impl.IReduceImpl, numPartitions int64) error {
 	if this.next != nil {
 		return this.next.Distinct(reduceImpl, numPartitions)
@@ -221,12 +435,37 @@ func (this *iTypeA[T]) Distinct(reduceImpl *impl.IReduceImpl, numPartitions int6
 	return typeAError()
 }
 
+func (this *iTypeA[T]) Intersection(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.Intersection(reduceImpl, other, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) Subtract(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.Subtract(reduceImpl, other, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) SubtractByKey(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.SubtractByKey(reduceImpl, other, numPartitions)
+	}
+	return typeAError()
+}
+
 /*IRepartitionImpl*/
 
 func (this *iTypeA[T]) Repartition(repartitionImpl *impl.IRepartitionImpl, numPartitions int64, preserveOrdering bool, global bool) error {
 	return impl.Repartition[T](repartitionImpl, numPartitions, preserveOrdering, global)
 }
 
+func (this *iTypeA[T]) Coalesce(repartitionImpl *impl.IRepartitionImpl, numPartitions int64, shuffle bool) error {
+	return impl.Coalesce[T](repartitionImpl, numPartitions, shuffle)
+}
+
 func (this *iTypeA[T]) PartitionByRandom(repartitionImpl *impl.IRepartitionImpl, numPartitions int64, seed int32) error {
 	return impl.PartitionByRandom[T](repartitionImpl, numPartitions, seed)
 }
@@ -237,3 +476,44 @@ func (this *iTypeA[T]) PartitionByHash(repartitionImpl *impl.IRepartitionImpl, n
 	}
 	return typeAError()
 }
+
+func (this *iTypeA[T]) PartitionByKeyHash(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.PartitionByKeyHash(repartitionImpl, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) PartitionByKeyRange(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.PartitionByKeyRange(repartitionImpl, numPartitions)
+	}
+	return typeAError()
+}
+
+func (this *iTypeA[T]) ReorderPartitions(repartitionImpl *impl.IRepartitionImpl, order []int64) error {
+	return impl.ReorderPartitions[T](repartitionImpl, order)
+}
+
+func (this *iTypeA[T]) ReorderPartitionsBy(repartitionImpl *impl.IRepartitionImpl) error {
+	if this.next != nil {
+		return this.next.ReorderPartitionsBy(repartitionImpl)
+	}
+	return impl.ReorderPartitionsByValue[T](repartitionImpl)
+}
+
+/*IEstimateImpl*/
+
+func (this *iTypeA[T]) PartitionStats(estimateImpl *impl.IEstimateImpl) (*impl.IDatasetStats, error) {
+	if this.next != nil {
+		return this.next.PartitionStats(estimateImpl)
+	}
+	return impl.PartitionStats[T](estimateImpl)
+}
+
+func (this *iTypeA[T]) KeyCardinality(estimateImpl *impl.IEstimateImpl, other string, values bool) ([]int64, error) {
+	if this.next != nil {
+		return this.next.KeyCardinality(estimateImpl, other, values)
+	}
+	return nil, typeAError()
+}
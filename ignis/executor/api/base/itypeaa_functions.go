@@ -1,9 +1,12 @@
 package base
 
 import (
+	"ignis/executor/api/function"
 	"ignis/executor/api/ipair"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/modules/impl"
+	"ignis/executor/core/utils"
+	"reflect"
 )
 
 func registerTypeAA[T1 any, T2 any]() {
@@ -24,6 +27,13 @@ func typeAAError() error {
 	return ierror.RaiseMsg("TypeAA functions only implement non-comparable pair functions.")
 }
 
+func sortByKeyBy[K any, V any](sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error {
+	if cmp, ok := f.(function.IFunction2[K, K, bool]); ok {
+		return impl.SortByKeyByWithPartitions[V, K](sortImpl, cmp, ascending, partitions)
+	}
+	return ierror.RaiseMsg(reflect.TypeOf(f).String() + " can not compare keys of type " + utils.TypeName[K]())
+}
+
 /*ICommImpl*/
 
 /*IIOImpl*/
@@ -38,6 +48,22 @@ func (this *iTypeAA[T1, T2]) SortByKeyWithPartitions(sortImpl *impl.ISortImpl, a
 	return impl.SortByKeyWithPartitions[T2, T1](sortImpl, ascending, partitions)
 }
 
+func (this *iTypeAA[T1, T2]) SortByKeyBy(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool) error {
+	return sortByKeyBy[T1, T2](sortImpl, f, ascending, -1)
+}
+
+func (this *iTypeAA[T1, T2]) SortByKeyByWithPartitions(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error {
+	return sortByKeyBy[T1, T2](sortImpl, f, ascending, partitions)
+}
+
+func (this *iTypeAA[T1, T2]) RepartitionAndSortWithinPartitions(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error {
+	return impl.RepartitionAndSortWithinPartitions[T2, T1](sortImpl, numPartitions, ascending)
+}
+
+func (this *iTypeAA[T1, T2]) GroupByKeyAndSortValues(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error {
+	return impl.GroupByKeyAndSortValues[T2, T1](sortImpl, numPartitions, ascending)
+}
+
 /*IPipeImpl*/
 
 func (this *iTypeAA[T1, T2]) Keys(pipeImpl *impl.IPipeImpl) error {
@@ -48,19 +74,38 @@ func (this *iTypeAA[T1, T2]) Values(pipeImpl *impl.IPipeImpl) error {
 	return impl.Values[T1, T2](pipeImpl)
 }
 
+func (this *iTypeAA[T1, T2]) Zip(pipeImpl *impl.IPipeImpl, other string) error {
+	return impl.Zip[T1, T2](pipeImpl, other)
+}
+
+func (this *iTypeAA[T1, T2]) MapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, any]) error {
+	return impl.MapValues[T1, T2, any](pipeImpl, impl.AnyInputFunction[T2](f))
+}
+
+func (this *iTypeAA[T1, T2]) FlatMapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, []any]) error {
+	return impl.FlatMapValues[T1, T2, any](pipeImpl, impl.AnyInputFunction[T2](f))
+}
+
 /*IMathImpl*/
 
 func (this *iTypeAA[T1, T2]) SampleByKeyFilter(mathImpl *impl.IMathImpl) (int64, error) {
 	if this.next != nil {
 		return this.next.SampleByKeyFilter(mathImpl)
 	}
-	return 0, typeAAError()
+	return impl.SampleBySerializedKeyFilter[T1, T2](mathImpl)
 }
 
 func (this *iTypeAA[T1, T2]) SampleByKey(mathImpl *impl.IMathImpl, withReplacement bool, seed int32) error {
 	if this.next != nil {
 		return this.next.SampleByKey(mathImpl, withReplacement, seed)
 	}
+	return impl.SampleBySerializedKey[T1, T2](mathImpl, withReplacement, seed)
+}
+
+func (this *iTypeAA[T1, T2]) SampleByKeyExact(mathImpl *impl.IMathImpl, withReplacement bool, seed int32) error {
+	if this.next != nil {
+		return this.next.SampleByKeyExact(mathImpl, withReplacement, seed)
+	}
 	return typeAAError()
 }
 
@@ -68,13 +113,20 @@ func (this *iTypeAA[T1, T2]) CountByKey(mathImpl *impl.IMathImpl) error {
 	if this.next != nil {
 		return this.next.CountByKey(mathImpl)
 	}
-	return typeAAError()
+	return impl.CountBySerializedKey[T1, T2](mathImpl)
 }
 
 func (this *iTypeAA[T1, T2]) CountByValue(mathImpl *impl.IMathImpl) error {
 	if this.next != nil {
 		return this.next.CountByValue(mathImpl)
 	}
+	return impl.CountBySerializedValue[T1, T2](mathImpl)
+}
+
+func (this *iTypeAA[T1, T2]) CountApproxDistinctByKey(mathImpl *impl.IMathImpl, relativeSD float64, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.CountApproxDistinctByKey(mathImpl, relativeSD, numPartitions)
+	}
 	return typeAAError()
 }
 
@@ -84,7 +136,15 @@ func (this *iTypeAA[T1, T2]) GroupByKey(reduceImpl *impl.IReduceImpl, numPartiti
 	if this.next != nil {
 		return this.next.GroupByKey(reduceImpl, numPartitions)
 	}
-	return typeAAError()
+	return impl.GroupBySerializedKey[T1, T2](reduceImpl, numPartitions)
+}
+
+func (this *iTypeAA[T1, T2]) ReduceByKey(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any], numPartitions int64, localReduce bool) error {
+	return impl.ReduceBySerializedKey[T1](reduceImpl, impl.AnyFunction2[T2](f), numPartitions, localReduce)
+}
+
+func (this *iTypeAA[T1, T2]) ReduceByKeyLocally(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any]) error {
+	return impl.ReduceBySerializedKeyLocally[T1](reduceImpl, impl.AnyFunction2[T2](f))
 }
 
 func (this *iTypeAA[T1, T2]) Join(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
@@ -94,11 +154,61 @@ func (this *iTypeAA[T1, T2]) Join(reduceImpl *impl.IReduceImpl, other string, nu
 	return typeAAError()
 }
 
+func (this *iTypeAA[T1, T2]) LeftOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.LeftOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeAAError()
+}
+
+func (this *iTypeAA[T1, T2]) RightOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.RightOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeAAError()
+}
+
+func (this *iTypeAA[T1, T2]) FullOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.FullOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeAAError()
+}
+
+func (this *iTypeAA[T1, T2]) CoGroup(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.CoGroup(reduceImpl, other, numPartitions)
+	}
+	return typeAAError()
+}
+
 func (this *iTypeAA[T1, T2]) Distinct(reduceImpl *impl.IReduceImpl, numPartitions int64) error {
 	if this.next != nil {
 		return this.next.Distinct(reduceImpl, numPartitions)
 	}
-	return typeAAError()
+	return impl.SerializedDistinct[ipair.IPair[T1, T2]](reduceImpl, numPartitions)
 }
 
 /*IRepartitionImpl*/
+
+func (this *iTypeAA[T1, T2]) PartitionByKeyHash(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error {
+	return impl.PartitionByKeyHash[T2, T1](repartitionImpl, numPartitions)
+}
+
+func (this *iTypeAA[T1, T2]) PartitionByKeyRange(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error {
+	return impl.PartitionByKeyRange[T2, T1](repartitionImpl, numPartitions)
+}
+
+func (this *iTypeAA[T1, T2]) ReorderPartitionsBy(repartitionImpl *impl.IRepartitionImpl) error {
+	return impl.ReorderPartitionsByKey[T2, T1](repartitionImpl)
+}
+
+/*IEstimateImpl*/
+
+func (this *iTypeAA[T1, T2]) PartitionStats(estimateImpl *impl.IEstimateImpl) (*impl.IDatasetStats, error) {
+	return impl.PartitionStatsByKey[T1, T2](estimateImpl)
+}
+
+func (this *iTypeAA[T1, T2]) KeyCardinality(estimateImpl *impl.IEstimateImpl, other string, values bool) ([]int64, error) {
+	return impl.KeyCardinality[T1, T2](estimateImpl, other, values)
+}
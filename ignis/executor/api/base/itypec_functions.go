@@ -1,6 +1,7 @@
 package base
 
 import (
+	"ignis/executor/api/function"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/modules/impl"
 )
@@ -33,6 +34,20 @@ func (this *iTypeC[T]) SortByKeyWithPartitions(sortImpl *impl.ISortImpl, ascendi
 	return typeCError()
 }
 
+func (this *iTypeC[T]) SortByKeyBy(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool) error {
+	if this.next != nil {
+		return this.next.SortByKeyBy(sortImpl, f, ascending)
+	}
+	return typeCError()
+}
+
+func (this *iTypeC[T]) SortByKeyByWithPartitions(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error {
+	if this.next != nil {
+		return this.next.SortByKeyByWithPartitions(sortImpl, f, ascending, partitions)
+	}
+	return typeCError()
+}
+
 /*IPipeImpl*/
 
 func (this *iTypeC[T]) Keys(pipeImpl *impl.IPipeImpl) error {
@@ -49,6 +64,27 @@ func (this *iTypeC[T]) Values(pipeImpl *impl.IPipeImpl) error {
 	return typeCError()
 }
 
+func (this *iTypeC[T]) Zip(pipeImpl *impl.IPipeImpl, other string) error {
+	if this.next != nil {
+		return this.next.Zip(pipeImpl, other)
+	}
+	return typeCError()
+}
+
+func (this *iTypeC[T]) MapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, any]) error {
+	if this.next != nil {
+		return this.next.MapValues(pipeImpl, f)
+	}
+	return typeCError()
+}
+
+func (this *iTypeC[T]) FlatMapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, []any]) error {
+	if this.next != nil {
+		return this.next.FlatMapValues(pipeImpl, f)
+	}
+	return typeCError()
+}
+
 /*IMathImpl*/
 
 func (this *iTypeC[T]) SampleByKeyFilter(mathImpl *impl.IMathImpl) (int64, error) {
@@ -65,6 +101,13 @@ func (this *iTypeC[T]) SampleByKey(mathImpl *impl.IMathImpl, withReplacement boo
 	return typeCError()
 }
 
+func (this *iTypeC[T]) SampleByKeyExact(mathImpl *impl.IMathImpl, withReplacement bool, seed int32) error {
+	if this.next != nil {
+		return this.next.SampleByKeyExact(mathImpl, withReplacement, seed)
+	}
+	return typeCError()
+}
+
 func (this *iTypeC[T]) CountByKey(mathImpl *impl.IMathImpl) error {
 	if this.next != nil {
 		return this.next.CountByKey(mathImpl)
@@ -79,6 +122,13 @@ func (this *iTypeC[T]) CountByValue(mathImpl *impl.IMathImpl) error {
 	return typeCError()
 }
 
+func (this *iTypeC[T]) CountApproxDistinctByKey(mathImpl *impl.IMathImpl, relativeSD float64, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.CountApproxDistinctByKey(mathImpl, relativeSD, numPartitions)
+	}
+	return typeCError()
+}
+
 /*IReduceImpl*/
 
 func (this *iTypeC[T]) GroupByKey(reduceImpl *impl.IReduceImpl, numPartitions int64) error {
@@ -95,10 +145,46 @@ func (this *iTypeC[T]) Join(reduceImpl *impl.IReduceImpl, other string, numParti
 	return typeCError()
 }
 
+func (this *iTypeC[T]) LeftOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.LeftOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeCError()
+}
+
+func (this *iTypeC[T]) RightOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.RightOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeCError()
+}
+
+func (this *iTypeC[T]) FullOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.FullOuterJoin(reduceImpl, other, numPartitions)
+	}
+	return typeCError()
+}
+
+func (this *iTypeC[T]) CoGroup(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	if this.next != nil {
+		return this.next.CoGroup(reduceImpl, other, numPartitions)
+	}
+	return typeCError()
+}
+
 func (this *iTypeC[T]) Distinct(reduceImpl *impl.IReduceImpl, numPartitions int64) error {
 	return impl.Distinct[T](reduceImpl, numPartitions)
 }
 
+func (this *iTypeC[T]) Intersection(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	return impl.Intersection[T](reduceImpl, other, numPartitions)
+}
+
+func (this *iTypeC[T]) Subtract(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	return impl.Subtract[T](reduceImpl, other, numPartitions)
+}
+
 /*IRepartitionImpl*/
 
 func (this *iTypeC[T]) PartitionByHash(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error {
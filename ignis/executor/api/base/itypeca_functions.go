@@ -1,6 +1,7 @@
 package base
 
 import (
+	"ignis/executor/api/function"
 	"ignis/executor/api/ipair"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/iio"
@@ -40,6 +41,22 @@ func (this *iTypeCA[T1, T2]) SortByKeyWithPartitions(sortImpl *impl.ISortImpl, a
 	return impl.SortByKeyWithPartitions[T2, T1](sortImpl, ascending, partitions)
 }
 
+func (this *iTypeCA[T1, T2]) SortByKeyBy(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool) error {
+	return sortByKeyBy[T1, T2](sortImpl, f, ascending, -1)
+}
+
+func (this *iTypeCA[T1, T2]) SortByKeyByWithPartitions(sortImpl *impl.ISortImpl, f function.IBaseFunction, ascending bool, partitions int64) error {
+	return sortByKeyBy[T1, T2](sortImpl, f, ascending, partitions)
+}
+
+func (this *iTypeCA[T1, T2]) RepartitionAndSortWithinPartitions(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error {
+	return impl.RepartitionAndSortWithinPartitions[T2, T1](sortImpl, numPartitions, ascending)
+}
+
+func (this *iTypeCA[T1, T2]) GroupByKeyAndSortValues(sortImpl *impl.ISortImpl, numPartitions int64, ascending bool) error {
+	return impl.GroupByKeyAndSortValues[T2, T1](sortImpl, numPartitions, ascending)
+}
+
 /*IPipeImpl*/
 
 func (this *iTypeCA[T1, T2]) Keys(pipeImpl *impl.IPipeImpl) error {
@@ -50,6 +67,18 @@ func (this *iTypeCA[T1, T2]) Values(pipeImpl *impl.IPipeImpl) error {
 	return impl.Values[T1, T2](pipeImpl)
 }
 
+func (this *iTypeCA[T1, T2]) Zip(pipeImpl *impl.IPipeImpl, other string) error {
+	return impl.Zip[T1, T2](pipeImpl, other)
+}
+
+func (this *iTypeCA[T1, T2]) MapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, any]) error {
+	return impl.MapValues[T1, T2, any](pipeImpl, impl.AnyInputFunction[T2](f))
+}
+
+func (this *iTypeCA[T1, T2]) FlatMapValues(pipeImpl *impl.IPipeImpl, f function.IFunction[any, []any]) error {
+	return impl.FlatMapValues[T1, T2, any](pipeImpl, impl.AnyInputFunction[T2](f))
+}
+
 /*IMathImpl*/
 
 func (this *iTypeCA[T1, T2]) SampleByKeyFilter(mathImpl *impl.IMathImpl) (int64, error) {
@@ -60,6 +89,10 @@ func (this *iTypeCA[T1, T2]) SampleByKey(mathImpl *impl.IMathImpl, withReplaceme
 	return impl.SampleByKey[T2, T1](mathImpl, withReplacement, seed)
 }
 
+func (this *iTypeCA[T1, T2]) SampleByKeyExact(mathImpl *impl.IMathImpl, withReplacement bool, seed int32) error {
+	return impl.SampleByKeyExact[T2, T1](mathImpl, withReplacement, seed)
+}
+
 func (this *iTypeCA[T1, T2]) CountByKey(mathImpl *impl.IMathImpl) error {
 	return impl.CountByKey[T2, T1](mathImpl)
 }
@@ -68,7 +101,15 @@ func (this *iTypeCA[T1, T2]) CountByValue(mathImpl *impl.IMathImpl) error {
 	if this.next != nil {
 		return this.next.CountByValue(mathImpl)
 	}
-	return typeCAError()
+	return impl.CountBySerializedValue[T1, T2](mathImpl)
+}
+
+func (this *iTypeCA[T1, T2]) CountApproxDistinctByKey(mathImpl *impl.IMathImpl, relativeSD float64, numPartitions int64) error {
+	return impl.CountApproxDistinctByKey[T1, T2](mathImpl, relativeSD, numPartitions)
+}
+
+func (this *iTypeCA[T1, T2]) SumByKey(mathImpl *impl.IMathImpl, numPartitions int64) error {
+	return impl.SumByKey[T1, T2](mathImpl, numPartitions)
 }
 
 /*IReduceImpl*/
@@ -77,15 +118,65 @@ func (this *iTypeCA[T1, T2]) GroupByKey(reduceImpl *impl.IReduceImpl, numPartiti
 	return impl.GroupByKey[T2, T1](reduceImpl, numPartitions)
 }
 
+func (this *iTypeCA[T1, T2]) ReduceByKey(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any], numPartitions int64, localReduce bool) error {
+	return impl.ReduceByKey[T1](reduceImpl, impl.AnyFunction2[T2](f), numPartitions, localReduce)
+}
+
+func (this *iTypeCA[T1, T2]) ReduceByKeyLocally(reduceImpl *impl.IReduceImpl, f function.IFunction2[any, any, any]) error {
+	return impl.ReduceByKeyLocally[T1](reduceImpl, impl.AnyFunction2[T2](f))
+}
+
 func (this *iTypeCA[T1, T2]) Join(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
 	return impl.Join[T1, T2](reduceImpl, other, numPartitions)
 }
 
+func (this *iTypeCA[T1, T2]) LeftOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	return impl.LeftOuterJoin[T1, T2](reduceImpl, other, numPartitions)
+}
+
+func (this *iTypeCA[T1, T2]) RightOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	return impl.RightOuterJoin[T1, T2](reduceImpl, other, numPartitions)
+}
+
+func (this *iTypeCA[T1, T2]) FullOuterJoin(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	return impl.FullOuterJoin[T1, T2](reduceImpl, other, numPartitions)
+}
+
+func (this *iTypeCA[T1, T2]) CoGroup(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	return impl.CoGroup[T1, T2, T2](reduceImpl, other, numPartitions)
+}
+
 func (this *iTypeCA[T1, T2]) Distinct(reduceImpl *impl.IReduceImpl, numPartitions int64) error {
 	if this.next != nil {
 		return this.next.Distinct(reduceImpl, numPartitions)
 	}
-	return typeCAError()
+	return impl.SerializedDistinct[ipair.IPair[T1, T2]](reduceImpl, numPartitions)
+}
+
+func (this *iTypeCA[T1, T2]) SubtractByKey(reduceImpl *impl.IReduceImpl, other string, numPartitions int64) error {
+	return impl.SubtractByKey[T1, T2](reduceImpl, other, numPartitions)
 }
 
 /*IRepartitionImpl*/
+
+func (this *iTypeCA[T1, T2]) PartitionByKeyHash(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error {
+	return impl.PartitionByKeyHash[T2, T1](repartitionImpl, numPartitions)
+}
+
+func (this *iTypeCA[T1, T2]) PartitionByKeyRange(repartitionImpl *impl.IRepartitionImpl, numPartitions int64) error {
+	return impl.PartitionByKeyRange[T2, T1](repartitionImpl, numPartitions)
+}
+
+func (this *iTypeCA[T1, T2]) ReorderPartitionsBy(repartitionImpl *impl.IRepartitionImpl) error {
+	return impl.ReorderPartitionsByKey[T2, T1](repartitionImpl)
+}
+
+/*IEstimateImpl*/
+
+func (this *iTypeCA[T1, T2]) PartitionStats(estimateImpl *impl.IEstimateImpl) (*impl.IDatasetStats, error) {
+	return impl.PartitionStatsByKey[T1, T2](estimateImpl)
+}
+
+func (this *iTypeCA[T1, T2]) KeyCardinality(estimateImpl *impl.IEstimateImpl, other string, values bool) ([]int64, error) {
+	return impl.KeyCardinality[T1, T2](estimateImpl, other, values)
+}
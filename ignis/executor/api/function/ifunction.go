@@ -48,6 +48,24 @@ type IVoidFunction2[T1 any, T2 any] interface {
 	After(context api.IContext) error
 }
 
+/*
+Optional hooks of a function called by every thread before the first element of a partition and after its last one,
+so resources can be opened once per partition instead of once per element. AfterPartition is also called when the
+partition fails. The partition is the index of the partition in the executor.
+*/
+type IPartitionFunction interface {
+	BeforePartition(partition int64, context api.IContext) error
+	AfterPartition(partition int64, context api.IContext) error
+}
+
+/*
+Optional marker of a function whose result only depends on its input, only then the executor can reuse the result of
+a previous call with the same input instead of calling the function again.
+*/
+type IDeterministicFunction interface {
+	Deterministic() bool
+}
+
 type IBeforeNone struct{}
 
 type IAfterNone struct{}
@@ -0,0 +1,82 @@
+package api
+
+import "sync"
+
+const (
+	IntAccumulatorKind    = 0
+	FloatAccumulatorKind  = 1
+	CustomAccumulatorKind = 2
+)
+
+type IAccumulatorBase interface {
+	Name() string
+	Kind() int8
+	Get() any
+	MergeAny(value any)
+	Reset()
+}
+
+type IAccumulator[T any] struct {
+	name  string
+	kind  int8
+	mu    sync.Mutex
+	zero  T
+	value T
+	merge func(T, T) T
+}
+
+func NewIAccumulator[T any](name string, zero T, merge func(T, T) T) *IAccumulator[T] {
+	return &IAccumulator[T]{name: name, kind: CustomAccumulatorKind, zero: zero, value: zero, merge: merge}
+}
+
+func (this *IAccumulator[T]) Name() string {
+	return this.name
+}
+
+func (this *IAccumulator[T]) Kind() int8 {
+	return this.kind
+}
+
+func (this *IAccumulator[T]) Add(value T) {
+	this.mu.Lock()
+	this.value = this.merge(this.value, value)
+	this.mu.Unlock()
+}
+
+func (this *IAccumulator[T]) Value() T {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.value
+}
+
+func (this *IAccumulator[T]) Get() any {
+	return this.Value()
+}
+
+func (this *IAccumulator[T]) MergeAny(value any) {
+	this.Add(value.(T))
+}
+
+func (this *IAccumulator[T]) Reset() {
+	this.mu.Lock()
+	this.value = this.zero
+	this.mu.Unlock()
+}
+
+func IntAccumulator(ctx IContext, name string) *IAccumulator[int64] {
+	return ctx.Accumulator(name, func() IAccumulatorBase {
+		return &IAccumulator[int64]{name: name, kind: IntAccumulatorKind, merge: func(a, b int64) int64 { return a + b }}
+	}).(*IAccumulator[int64])
+}
+
+func FloatAccumulator(ctx IContext, name string) *IAccumulator[float64] {
+	return ctx.Accumulator(name, func() IAccumulatorBase {
+		return &IAccumulator[float64]{name: name, kind: FloatAccumulatorKind, merge: func(a, b float64) float64 { return a + b }}
+	}).(*IAccumulator[float64])
+}
+
+func CustomAccumulator[T any](ctx IContext, name string, zero T, merge func(T, T) T) *IAccumulator[T] {
+	return ctx.Accumulator(name, func() IAccumulatorBase {
+		return NewIAccumulator[T](name, zero, merge)
+	}).(*IAccumulator[T])
+}
@@ -0,0 +1,378 @@
+package ibitmap
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+const arrayMaxSize = 4096
+const bitmapWords = 1024
+
+type container struct {
+	array  []uint16
+	bitmap []uint64
+	card   int
+}
+
+func newArrayContainer() *container {
+	return &container{array: make([]uint16, 0, 4)}
+}
+
+func (this *container) isBitmap() bool {
+	return this.bitmap != nil
+}
+
+func (this *container) contains(x uint16) bool {
+	if this.isBitmap() {
+		return this.bitmap[x>>6]&(1<<(x&63)) != 0
+	}
+	i := sort.Search(len(this.array), func(i int) bool { return this.array[i] >= x })
+	return i < len(this.array) && this.array[i] == x
+}
+
+func (this *container) add(x uint16) bool {
+	if this.isBitmap() {
+		mask := uint64(1) << (x & 63)
+		if this.bitmap[x>>6]&mask != 0 {
+			return false
+		}
+		this.bitmap[x>>6] |= mask
+		this.card++
+		return true
+	}
+	i := sort.Search(len(this.array), func(i int) bool { return this.array[i] >= x })
+	if i < len(this.array) && this.array[i] == x {
+		return false
+	}
+	this.array = append(this.array, 0)
+	copy(this.array[i+1:], this.array[i:])
+	this.array[i] = x
+	this.card++
+	if this.card > arrayMaxSize {
+		this.toBitmap()
+	}
+	return true
+}
+
+func (this *container) toBitmap() {
+	this.bitmap = make([]uint64, bitmapWords)
+	for _, x := range this.array {
+		this.bitmap[x>>6] |= 1 << (x & 63)
+	}
+	this.array = nil
+}
+
+func (this *container) toArray() {
+	this.array = make([]uint16, 0, this.card)
+	this.forEach(func(x uint16) {
+		this.array = append(this.array, x)
+	})
+	this.bitmap = nil
+}
+
+func (this *container) normalize() {
+	if this.isBitmap() && this.card <= arrayMaxSize {
+		this.toArray()
+	} else if !this.isBitmap() && this.card > arrayMaxSize {
+		this.toBitmap()
+	}
+}
+
+func (this *container) forEach(f func(x uint16)) {
+	if !this.isBitmap() {
+		for _, x := range this.array {
+			f(x)
+		}
+		return
+	}
+	for i, w := range this.bitmap {
+		for w != 0 {
+			t := bits.TrailingZeros64(w)
+			f(uint16(i<<6 + t))
+			w &= w - 1
+		}
+	}
+}
+
+func (this *container) words() []uint64 {
+	if this.isBitmap() {
+		return this.bitmap
+	}
+	words := make([]uint64, bitmapWords)
+	for _, x := range this.array {
+		words[x>>6] |= 1 << (x & 63)
+	}
+	return words
+}
+
+func (this *container) clone() *container {
+	other := &container{card: this.card}
+	if this.isBitmap() {
+		other.bitmap = append([]uint64{}, this.bitmap...)
+	} else {
+		other.array = append([]uint16{}, this.array...)
+	}
+	return other
+}
+
+func (this *container) or(other *container) *container {
+	if !this.isBitmap() && !other.isBitmap() && this.card+other.card <= arrayMaxSize {
+		result := &container{array: make([]uint16, 0, this.card+other.card)}
+		i, j := 0, 0
+		for i < len(this.array) && j < len(other.array) {
+			if this.array[i] < other.array[j] {
+				result.array = append(result.array, this.array[i])
+				i++
+			} else if this.array[i] > other.array[j] {
+				result.array = append(result.array, other.array[j])
+				j++
+			} else {
+				result.array = append(result.array, this.array[i])
+				i++
+				j++
+			}
+		}
+		result.array = append(result.array, this.array[i:]...)
+		result.array = append(result.array, other.array[j:]...)
+		result.card = len(result.array)
+		return result
+	}
+	a, b := this.words(), other.words()
+	result := &container{bitmap: make([]uint64, bitmapWords)}
+	for i := range result.bitmap {
+		result.bitmap[i] = a[i] | b[i]
+		result.card += bits.OnesCount64(result.bitmap[i])
+	}
+	result.normalize()
+	return result
+}
+
+func (this *container) and(other *container) *container {
+	if !this.isBitmap() || !other.isBitmap() {
+		small, large := this, other
+		if small.isBitmap() || (!large.isBitmap() && large.card < small.card) {
+			small, large = other, this
+		}
+		result := &container{array: make([]uint16, 0, small.card)}
+		for _, x := range small.array {
+			if large.contains(x) {
+				result.array = append(result.array, x)
+			}
+		}
+		result.card = len(result.array)
+		return result
+	}
+	result := &container{bitmap: make([]uint64, bitmapWords)}
+	for i := range result.bitmap {
+		result.bitmap[i] = this.bitmap[i] & other.bitmap[i]
+		result.card += bits.OnesCount64(result.bitmap[i])
+	}
+	result.normalize()
+	return result
+}
+
+type IBitmap struct {
+	keys       []uint64
+	containers []*container
+}
+
+func New() *IBitmap {
+	return &IBitmap{}
+}
+
+func FromArray(values []int64) *IBitmap {
+	bitmap := New()
+	for _, v := range values {
+		bitmap.Add(v)
+	}
+	return bitmap
+}
+
+func (this *IBitmap) search(key uint64) int {
+	return sort.Search(len(this.keys), func(i int) bool { return this.keys[i] >= key })
+}
+
+func (this *IBitmap) Add(v int64) bool {
+	key := uint64(v) >> 16
+	i := this.search(key)
+	if i == len(this.keys) || this.keys[i] != key {
+		this.keys = append(this.keys, 0)
+		copy(this.keys[i+1:], this.keys[i:])
+		this.keys[i] = key
+		this.containers = append(this.containers, nil)
+		copy(this.containers[i+1:], this.containers[i:])
+		this.containers[i] = newArrayContainer()
+	}
+	return this.containers[i].add(uint16(v))
+}
+
+func (this *IBitmap) Contains(v int64) bool {
+	key := uint64(v) >> 16
+	i := this.search(key)
+	return i < len(this.keys) && this.keys[i] == key && this.containers[i].contains(uint16(v))
+}
+
+func (this *IBitmap) Cardinality() int64 {
+	var card int64
+	for _, c := range this.containers {
+		card += int64(c.card)
+	}
+	return card
+}
+
+func (this *IBitmap) Empty() bool {
+	return len(this.keys) == 0
+}
+
+func (this *IBitmap) ForEach(f func(v int64)) {
+	for i, c := range this.containers {
+		high := this.keys[i] << 16
+		c.forEach(func(x uint16) {
+			f(int64(high | uint64(x)))
+		})
+	}
+}
+
+func (this *IBitmap) ToArray() []int64 {
+	array := make([]int64, 0, this.Cardinality())
+	this.ForEach(func(v int64) {
+		array = append(array, v)
+	})
+	return array
+}
+
+func (this *IBitmap) Clone() *IBitmap {
+	other := &IBitmap{
+		keys:       append([]uint64{}, this.keys...),
+		containers: make([]*container, len(this.containers)),
+	}
+	for i, c := range this.containers {
+		other.containers[i] = c.clone()
+	}
+	return other
+}
+
+func (this *IBitmap) Or(other *IBitmap) *IBitmap {
+	result := &IBitmap{}
+	i, j := 0, 0
+	for i < len(this.keys) && j < len(other.keys) {
+		if this.keys[i] < other.keys[j] {
+			result.keys = append(result.keys, this.keys[i])
+			result.containers = append(result.containers, this.containers[i].clone())
+			i++
+		} else if this.keys[i] > other.keys[j] {
+			result.keys = append(result.keys, other.keys[j])
+			result.containers = append(result.containers, other.containers[j].clone())
+			j++
+		} else {
+			result.keys = append(result.keys, this.keys[i])
+			result.containers = append(result.containers, this.containers[i].or(other.containers[j]))
+			i++
+			j++
+		}
+	}
+	for ; i < len(this.keys); i++ {
+		result.keys = append(result.keys, this.keys[i])
+		result.containers = append(result.containers, this.containers[i].clone())
+	}
+	for ; j < len(other.keys); j++ {
+		result.keys = append(result.keys, other.keys[j])
+		result.containers = append(result.containers, other.containers[j].clone())
+	}
+	return result
+}
+
+func (this *IBitmap) And(other *IBitmap) *IBitmap {
+	result := &IBitmap{}
+	i, j := 0, 0
+	for i < len(this.keys) && j < len(other.keys) {
+		if this.keys[i] < other.keys[j] {
+			i++
+		} else if this.keys[i] > other.keys[j] {
+			j++
+		} else {
+			c := this.containers[i].and(other.containers[j])
+			if c.card > 0 {
+				result.keys = append(result.keys, this.keys[i])
+				result.containers = append(result.containers, c)
+			}
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+func (this *IBitmap) AndCardinality(other *IBitmap) int64 {
+	return this.And(other).Cardinality()
+}
+
+func (this *IBitmap) MarshalBinary() ([]byte, error) {
+	sz := 8
+	for _, c := range this.containers {
+		sz += 12
+		if c.isBitmap() {
+			sz += bitmapWords * 8
+		} else {
+			sz += len(c.array) * 2
+		}
+	}
+	data := make([]byte, 0, sz)
+	data = binary.LittleEndian.AppendUint64(data, uint64(len(this.keys)))
+	for i, c := range this.containers {
+		data = binary.LittleEndian.AppendUint64(data, this.keys[i])
+		data = binary.LittleEndian.AppendUint32(data, uint32(c.card))
+		if c.isBitmap() {
+			for _, w := range c.bitmap {
+				data = binary.LittleEndian.AppendUint64(data, w)
+			}
+		} else {
+			for _, x := range c.array {
+				data = binary.LittleEndian.AppendUint16(data, x)
+			}
+		}
+	}
+	return data, nil
+}
+
+func (this *IBitmap) UnmarshalBinary(data []byte) error {
+	corrupted := errors.New("ibitmap: corrupted data")
+	if len(data) < 8 {
+		return corrupted
+	}
+	n := binary.LittleEndian.Uint64(data)
+	data = data[8:]
+	this.keys = make([]uint64, 0, n)
+	this.containers = make([]*container, 0, n)
+	for i := uint64(0); i < n; i++ {
+		if len(data) < 12 {
+			return corrupted
+		}
+		c := &container{card: int(binary.LittleEndian.Uint32(data[8:]))}
+		this.keys = append(this.keys, binary.LittleEndian.Uint64(data))
+		data = data[12:]
+		if c.card > arrayMaxSize {
+			if len(data) < bitmapWords*8 {
+				return corrupted
+			}
+			c.bitmap = make([]uint64, bitmapWords)
+			for j := range c.bitmap {
+				c.bitmap[j] = binary.LittleEndian.Uint64(data[j*8:])
+			}
+			data = data[bitmapWords*8:]
+		} else {
+			if len(data) < c.card*2 {
+				return corrupted
+			}
+			c.array = make([]uint16, c.card)
+			for j := range c.array {
+				c.array[j] = binary.LittleEndian.Uint16(data[j*2:])
+			}
+			data = data[c.card*2:]
+		}
+		this.containers = append(this.containers, c)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package ibitmap
+
+import (
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+func TestIBitmap(t *testing.T) {
+	a := New()
+	b := New()
+	setA := map[int64]bool{}
+	setB := map[int64]bool{}
+	for i := 0; i < 20000; i++ {
+		v := rand.Int63n(100000) - 10000
+		a.Add(v)
+		setA[v] = true
+		v = rand.Int63n(100000)
+		b.Add(v)
+		setB[v] = true
+	}
+	require.Equal(t, int64(len(setA)), a.Cardinality())
+	require.Equal(t, int64(len(setB)), b.Cardinality())
+
+	union := map[int64]bool{}
+	inter := map[int64]bool{}
+	for v := range setA {
+		union[v] = true
+		if setB[v] {
+			inter[v] = true
+		}
+	}
+	for v := range setB {
+		union[v] = true
+	}
+	or := a.Or(b)
+	and := a.And(b)
+	require.Equal(t, int64(len(union)), or.Cardinality())
+	require.Equal(t, int64(len(inter)), and.Cardinality())
+	for v := range inter {
+		require.True(t, and.Contains(v))
+	}
+
+	data, err := or.MarshalBinary()
+	require.Nil(t, err)
+	other := New()
+	require.Nil(t, other.UnmarshalBinary(data))
+	require.Equal(t, or.ToArray(), other.ToArray())
+}
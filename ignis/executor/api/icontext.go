@@ -12,7 +12,18 @@ type IContext interface {
 	MpiGroup() impi.C_MPI_Comm
 	Props() map[string]string
 	Vars() map[string]any
+	Broadcast(name string) (any, bool)
+	Accumulator(name string, create func() IAccumulatorBase) IAccumulatorBase
 	Register(tp IContextType)
+	CancelToken() ICancelToken
+}
+
+/*Cancellation of the running job, long user functions can check it to stop early*/
+type ICancelToken interface {
+	Cancelled() bool
+	Done() <-chan struct{}
+	/*Returns nil until the job is cancelled*/
+	Err() error
 }
 
 type ITypeBase interface {
@@ -24,3 +35,13 @@ type IContextType interface {
 	AddType(tp IContextType)
 	LoadType()
 }
+
+func GetBroadcast[T any](ctx IContext, name string) (T, bool) {
+	value, ok := ctx.Broadcast(name)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	result, ok := value.(T)
+	return result, ok
+}
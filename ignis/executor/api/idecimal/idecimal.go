@@ -0,0 +1,263 @@
+package idecimal
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strings"
+)
+
+var ErrOverflow = errors.New("idecimal: overflow")
+var ErrSyntax = errors.New("idecimal: invalid syntax")
+var ErrDivision = errors.New("idecimal: division by zero")
+
+const MaxScale = 18
+
+var powers [MaxScale + 1]int64
+
+func init() {
+	powers[0] = 1
+	for i := 1; i <= MaxScale; i++ {
+		powers[i] = powers[i-1] * 10
+	}
+}
+
+/*Value Unscaled / 10^Scale, the Scale must be between 0 and MaxScale, decimals built without New are not checked*/
+type IDecimal struct {
+	Unscaled int64
+	Scale    int32
+}
+
+func New(unscaled int64, scale int32) (IDecimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return IDecimal{}, ErrOverflow
+	}
+	return IDecimal{unscaled, scale}, nil
+}
+
+func FromInt(v int64, scale int32) (IDecimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return IDecimal{}, ErrOverflow
+	}
+	overflow, v := mul64(v, powers[scale])
+	if overflow {
+		return IDecimal{}, ErrOverflow
+	}
+	return IDecimal{v, scale}, nil
+}
+
+func Parse(s string) (IDecimal, error) {
+	if len(s) == 0 {
+		return IDecimal{}, ErrSyntax
+	}
+	neg := s[0] == '-'
+	if neg || s[0] == '+' {
+		s = s[1:]
+	}
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	if len(intPart)+len(fracPart) == 0 || len(fracPart) > MaxScale {
+		return IDecimal{}, ErrSyntax
+	}
+	var v int64
+	for _, c := range intPart + fracPart {
+		if c < '0' || c > '9' {
+			return IDecimal{}, ErrSyntax
+		}
+		if v > (math.MaxInt64-int64(c-'0'))/10 {
+			return IDecimal{}, ErrOverflow
+		}
+		v = v*10 + int64(c-'0')
+	}
+	if neg {
+		v = -v
+	}
+	return IDecimal{v, int32(len(fracPart))}, nil
+}
+
+func FromRat(r *big.Rat, scale int32) (IDecimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return IDecimal{}, ErrOverflow
+	}
+	num := new(big.Int).Mul(r.Num(), big.NewInt(powers[scale]))
+	return fromBig(roundQuo(num, r.Denom()), scale)
+}
+
+func (this IDecimal) String() string {
+	neg := this.Unscaled < 0
+	digits := new(big.Int).Abs(big.NewInt(this.Unscaled)).String()
+	if this.Scale > 0 {
+		if len(digits) <= int(this.Scale) {
+			digits = strings.Repeat("0", int(this.Scale)-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-int(this.Scale)] + "." + digits[len(digits)-int(this.Scale):]
+	}
+	if neg {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (this IDecimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(big.NewInt(this.Unscaled), big.NewInt(powers[this.Scale]))
+}
+
+func (this IDecimal) Float64() float64 {
+	f, _ := this.Rat().Float64()
+	return f
+}
+
+func (this IDecimal) Sign() int {
+	if this.Unscaled < 0 {
+		return -1
+	} else if this.Unscaled > 0 {
+		return 1
+	}
+	return 0
+}
+
+func (this IDecimal) Rescale(scale int32) (IDecimal, error) {
+	if scale < 0 || scale > MaxScale {
+		return IDecimal{}, ErrOverflow
+	}
+	if scale >= this.Scale {
+		overflow, v := mul64(this.Unscaled, powers[scale-this.Scale])
+		if overflow {
+			return IDecimal{}, ErrOverflow
+		}
+		return IDecimal{v, scale}, nil
+	}
+	d := powers[this.Scale-scale]
+	q, r := this.Unscaled/d, this.Unscaled%d
+	if 2*abs(r) >= d {
+		if r > 0 {
+			q++
+		} else {
+			q--
+		}
+	}
+	return IDecimal{q, scale}, nil
+}
+
+func (this IDecimal) align(other IDecimal) (int64, int64, int32, error) {
+	scale := this.Scale
+	if other.Scale > scale {
+		scale = other.Scale
+	}
+	a, err := this.Rescale(scale)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	b, err := other.Rescale(scale)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	return a.Unscaled, b.Unscaled, scale, nil
+}
+
+func (this IDecimal) Add(other IDecimal) (IDecimal, error) {
+	a, b, scale, err := this.align(other)
+	if err != nil {
+		return IDecimal{}, err
+	}
+	r := a + b
+	if (a > 0 && b > 0 && r < 0) || (a < 0 && b < 0 && r >= 0) {
+		return IDecimal{}, ErrOverflow
+	}
+	return IDecimal{r, scale}, nil
+}
+
+func (this IDecimal) Sub(other IDecimal) (IDecimal, error) {
+	if other.Unscaled == math.MinInt64 {
+		return IDecimal{}, ErrOverflow
+	}
+	return this.Add(IDecimal{-other.Unscaled, other.Scale})
+}
+
+func (this IDecimal) Mul(other IDecimal) (IDecimal, error) {
+	scale := this.Scale + other.Scale
+	num := new(big.Int).Mul(big.NewInt(this.Unscaled), big.NewInt(other.Unscaled))
+	if scale > MaxScale {
+		num = roundQuo(num, big.NewInt(powers[scale-MaxScale]))
+		scale = MaxScale
+	}
+	return fromBig(num, scale)
+}
+
+func (this IDecimal) Quo(other IDecimal, scale int32) (IDecimal, error) {
+	if other.Unscaled == 0 {
+		return IDecimal{}, ErrDivision
+	}
+	return FromRat(new(big.Rat).Quo(this.Rat(), other.Rat()), scale)
+}
+
+func (this IDecimal) Cmp(other IDecimal) int {
+	if this.Scale == other.Scale {
+		if this.Unscaled < other.Unscaled {
+			return -1
+		} else if this.Unscaled > other.Unscaled {
+			return 1
+		}
+		return 0
+	}
+	return this.Rat().Cmp(other.Rat())
+}
+
+/*Removes the trailing zeros of the scale, equal decimals have the same normalized value*/
+func (this IDecimal) Normalize() IDecimal {
+	if this.Unscaled == 0 {
+		return IDecimal{}
+	}
+	for this.Scale > 0 && this.Unscaled%10 == 0 {
+		this.Unscaled /= 10
+		this.Scale--
+	}
+	return this
+}
+
+func (this IDecimal) Less(a IDecimal, b IDecimal) bool {
+	return a.Cmp(b) < 0
+}
+
+func (this IDecimal) MarshalText() ([]byte, error) {
+	return []byte(this.String()), nil
+}
+
+func (this *IDecimal) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*this = v
+	return nil
+}
+
+func fromBig(v *big.Int, scale int32) (IDecimal, error) {
+	if !v.IsInt64() {
+		return IDecimal{}, ErrOverflow
+	}
+	return IDecimal{v.Int64(), scale}, nil
+}
+
+func roundQuo(num *big.Int, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(den)) >= 0 {
+		if (r.Sign() > 0) == (den.Sign() > 0) {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+func mul64(a int64, b int64) (bool, int64) {
+	r := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	return !r.IsInt64(), r.Int64()
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
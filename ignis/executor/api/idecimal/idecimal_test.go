@@ -0,0 +1,72 @@
+package idecimal
+
+import (
+	"github.com/stretchr/testify/require"
+	"math"
+	"math/big"
+	"sort"
+	"testing"
+)
+
+func TestIDecimal(t *testing.T) {
+	a, err := Parse("12.345")
+	require.Nil(t, err)
+	require.Equal(t, IDecimal{12345, 3}, a)
+	require.Equal(t, "12.345", a.String())
+	b, err := Parse("-0.05")
+	require.Nil(t, err)
+	require.Equal(t, "-0.05", b.String())
+
+	sum, err := a.Add(b)
+	require.Nil(t, err)
+	require.Equal(t, "12.295", sum.String())
+	diff, err := a.Sub(b)
+	require.Nil(t, err)
+	require.Equal(t, "12.395", diff.String())
+	prod, err := a.Mul(b)
+	require.Nil(t, err)
+	require.Equal(t, "-0.61725", prod.String())
+	quo, err := a.Quo(IDecimal{3, 0}, 4)
+	require.Nil(t, err)
+	require.Equal(t, "4.1150", quo.String())
+	_, err = a.Quo(IDecimal{}, 2)
+	require.Equal(t, ErrDivision, err)
+
+	round, err := IDecimal{125, 2}.Rescale(1)
+	require.Nil(t, err)
+	require.Equal(t, "1.3", round.String())
+	round, err = IDecimal{-125, 2}.Rescale(1)
+	require.Nil(t, err)
+	require.Equal(t, "-1.3", round.String())
+
+	_, err = IDecimal{math.MaxInt64, 0}.Add(IDecimal{1, 0})
+	require.Equal(t, ErrOverflow, err)
+	_, err = Parse("99999999999999999999")
+	require.Equal(t, ErrOverflow, err)
+	_, err = Parse("1.2.3")
+	require.Equal(t, ErrSyntax, err)
+
+	third, err := FromRat(big.NewRat(1, 3), 6)
+	require.Nil(t, err)
+	require.Equal(t, "0.333333", third.String())
+
+	values := []IDecimal{{5, 1}, {-1, 0}, {45, 2}, {100, 3}}
+	sort.Slice(values, func(i, j int) bool { return values[i].Less(values[i], values[j]) })
+	require.Equal(t, []IDecimal{{-1, 0}, {100, 3}, {45, 2}, {5, 1}}, values)
+
+	var text IDecimal
+	require.Nil(t, text.UnmarshalText([]byte("3.14")))
+	require.Equal(t, IDecimal{314, 2}, text)
+
+	require.Equal(t, IDecimal{1, 0}, IDecimal{100, 2}.Normalize())
+	require.Equal(t, IDecimal{15, 1}, IDecimal{1500, 3}.Normalize())
+	require.Equal(t, IDecimal{}, IDecimal{0, 4}.Normalize())
+
+	d, err := New(-314, MaxScale)
+	require.Nil(t, err)
+	require.Equal(t, IDecimal{-314, MaxScale}, d)
+	_, err = New(1, -1)
+	require.Equal(t, ErrOverflow, err)
+	_, err = New(1, MaxScale+1)
+	require.Equal(t, ErrOverflow, err)
+}
@@ -0,0 +1,115 @@
+package ihyperloglog
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+var ErrPrecision = errors.New("ihyperloglog: precision mismatch")
+
+const MinPrecision = 4
+const MaxPrecision = 18
+
+type IHyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+func New(precision int) *IHyperLogLog {
+	precision = clamp(precision)
+	return &IHyperLogLog{
+		precision: uint8(precision),
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+func PrecisionFor(relativeSD float64) int {
+	if relativeSD <= 0 {
+		return MaxPrecision
+	}
+	return clamp(int(math.Ceil(2 * math.Log2(1.04/relativeSD))))
+}
+
+func clamp(precision int) int {
+	if precision < MinPrecision {
+		return MinPrecision
+	} else if precision > MaxPrecision {
+		return MaxPrecision
+	}
+	return precision
+}
+
+func FromRegisters(registers []uint8) (*IHyperLogLog, error) {
+	precision := bits.TrailingZeros(uint(len(registers)))
+	if len(registers) != 1<<precision || precision < MinPrecision || precision > MaxPrecision {
+		return nil, ErrPrecision
+	}
+	return &IHyperLogLog{uint8(precision), registers}, nil
+}
+
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func (this *IHyperLogLog) Precision() int {
+	return int(this.precision)
+}
+
+func (this *IHyperLogLog) Registers() []uint8 {
+	return this.registers
+}
+
+func (this *IHyperLogLog) Add(hash uint64) {
+	x := mix(hash)
+	index := x >> (64 - this.precision)
+	rank := uint8(bits.LeadingZeros64(x<<this.precision|1<<(this.precision-1))) + 1
+	if rank > this.registers[index] {
+		this.registers[index] = rank
+	}
+}
+
+func (this *IHyperLogLog) Merge(other *IHyperLogLog) error {
+	if this.precision != other.precision {
+		return ErrPrecision
+	}
+	for i, rank := range other.registers {
+		if rank > this.registers[i] {
+			this.registers[i] = rank
+		}
+	}
+	return nil
+}
+
+func (this *IHyperLogLog) Count() int64 {
+	m := float64(len(this.registers))
+	sum := 0.0
+	zeros := 0
+	for _, rank := range this.registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+	var alpha float64
+	switch len(this.registers) {
+	case 16:
+		alpha = 0.673
+	case 32:
+		alpha = 0.697
+	case 64:
+		alpha = 0.709
+	default:
+		alpha = 0.7213 / (1 + 1.079/m)
+	}
+	estimate := alpha * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int64(math.Round(estimate))
+}
@@ -0,0 +1,37 @@
+package ihyperloglog
+
+import (
+	"github.com/stretchr/testify/require"
+	"math"
+	"testing"
+)
+
+func TestIHyperLogLog(t *testing.T) {
+	require.Equal(t, 9, PrecisionFor(0.05))
+	require.Equal(t, MaxPrecision, PrecisionFor(0))
+
+	a := New(14)
+	b := New(14)
+	for i := uint64(0); i < 60000; i++ {
+		a.Add(i)
+		b.Add(i + 40000)
+	}
+	require.InEpsilon(t, 60000, a.Count(), 0.03)
+	require.Nil(t, a.Merge(b))
+	require.InEpsilon(t, 100000, a.Count(), 0.03)
+
+	small := New(12)
+	for i := 0; i < 3; i++ {
+		for v := uint64(0); v < 100; v++ {
+			small.Add(v)
+		}
+	}
+	require.LessOrEqual(t, math.Abs(float64(small.Count()-100)), 2.0)
+
+	copied, err := FromRegisters(append([]uint8{}, a.Registers()...))
+	require.Nil(t, err)
+	require.Equal(t, a.Count(), copied.Count())
+	require.Equal(t, ErrPrecision, copied.Merge(small))
+	_, err = FromRegisters(make([]uint8, 100))
+	require.Equal(t, ErrPrecision, err)
+}
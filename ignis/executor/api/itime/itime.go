@@ -0,0 +1,72 @@
+package itime
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrUnit = errors.New("itime: unknown truncation unit")
+
+var locations sync.Map
+
+func LoadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+func InZone(t time.Time, name string) (time.Time, error) {
+	loc, err := LoadLocation(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.In(loc), nil
+}
+
+func Truncate(t time.Time, unit string) (time.Time, error) {
+	year, month, day := t.Date()
+	loc := t.Location()
+	switch unit {
+	case "second":
+		return t.Truncate(time.Second), nil
+	case "minute":
+		return time.Date(year, month, day, t.Hour(), t.Minute(), 0, 0, loc), nil
+	case "hour":
+		return time.Date(year, month, day, t.Hour(), 0, 0, 0, loc), nil
+	case "day":
+		return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+	case "week":
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(year, month, day-offset, 0, 0, 0, 0, loc), nil
+	case "month":
+		return time.Date(year, month, 1, 0, 0, 0, 0, loc), nil
+	case "quarter":
+		return time.Date(year, month-(month-1)%3, 1, 0, 0, 0, 0, loc), nil
+	case "year":
+		return time.Date(year, 1, 1, 0, 0, 0, 0, loc), nil
+	}
+	return time.Time{}, ErrUnit
+}
+
+func Bucket(t time.Time, width time.Duration, origin time.Time) time.Time {
+	if width <= 0 {
+		return t
+	}
+	d := t.Sub(origin)
+	n := d / width
+	if d < 0 && d%width != 0 {
+		n--
+	}
+	return origin.Add(n * width).In(t.Location())
+}
+
+func Less(a time.Time, b time.Time) bool {
+	return a.Before(b)
+}
@@ -0,0 +1,44 @@
+package itime
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestITime(t *testing.T) {
+	madrid, err := LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Skip("timezone database not available")
+	}
+	v := time.Date(2023, time.August, 17, 13, 45, 30, 500, madrid)
+
+	for unit, expected := range map[string]time.Time{
+		"second":  time.Date(2023, time.August, 17, 13, 45, 30, 0, madrid),
+		"minute":  time.Date(2023, time.August, 17, 13, 45, 0, 0, madrid),
+		"hour":    time.Date(2023, time.August, 17, 13, 0, 0, 0, madrid),
+		"day":     time.Date(2023, time.August, 17, 0, 0, 0, 0, madrid),
+		"week":    time.Date(2023, time.August, 14, 0, 0, 0, 0, madrid),
+		"month":   time.Date(2023, time.August, 1, 0, 0, 0, 0, madrid),
+		"quarter": time.Date(2023, time.July, 1, 0, 0, 0, 0, madrid),
+		"year":    time.Date(2023, time.January, 1, 0, 0, 0, 0, madrid),
+	} {
+		result, err := Truncate(v, unit)
+		require.Nil(t, err)
+		require.True(t, expected.Equal(result), unit)
+	}
+	_, err = Truncate(v, "decade")
+	require.Equal(t, ErrUnit, err)
+
+	utc, err := InZone(v, "UTC")
+	require.Nil(t, err)
+	require.True(t, v.Equal(utc))
+	require.Equal(t, 11, utc.Hour())
+
+	origin := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	bucket := Bucket(utc, 15*time.Minute, origin)
+	require.Equal(t, time.Date(2023, time.August, 17, 11, 45, 0, 0, time.UTC), bucket)
+	bucket = Bucket(origin.Add(-time.Minute), 15*time.Minute, origin)
+	require.Equal(t, origin.Add(-15*time.Minute), bucket)
+	require.True(t, Less(origin, utc))
+}
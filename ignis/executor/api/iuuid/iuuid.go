@@ -0,0 +1,108 @@
+package iuuid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+)
+
+var ErrSyntax = errors.New("iuuid: invalid syntax")
+
+type IUUID [16]byte
+
+func New() (IUUID, error) {
+	var id IUUID
+	if _, err := rand.Read(id[:]); err != nil {
+		return id, err
+	}
+	id[6] = (id[6] & 0x0f) | 0x40
+	id[8] = (id[8] & 0x3f) | 0x80
+	return id, nil
+}
+
+func FromBytes(b []byte) (IUUID, error) {
+	var id IUUID
+	if len(b) != len(id) {
+		return id, ErrSyntax
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+func Parse(s string) (IUUID, error) {
+	var id IUUID
+	if len(s) == 32 {
+		if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+			return id, ErrSyntax
+		}
+		return id, nil
+	}
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return id, ErrSyntax
+	}
+	j := 0
+	for i := 0; i < len(s); i += 2 {
+		if s[i] == '-' {
+			i--
+			continue
+		}
+		if _, err := hex.Decode(id[j:j+1], []byte(s[i:i+2])); err != nil {
+			return id, ErrSyntax
+		}
+		j++
+	}
+	return id, nil
+}
+
+func (this IUUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], this[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], this[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], this[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], this[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], this[10:])
+	return string(buf[:])
+}
+
+func (this IUUID) High() uint64 {
+	return binary.BigEndian.Uint64(this[0:8])
+}
+
+func (this IUUID) Low() uint64 {
+	return binary.BigEndian.Uint64(this[8:16])
+}
+
+func (this IUUID) Cmp(other IUUID) int {
+	a, b := this.High(), other.High()
+	if a == b {
+		a, b = this.Low(), other.Low()
+	}
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+	return 0
+}
+
+func (this IUUID) Less(a IUUID, b IUUID) bool {
+	return a.Cmp(b) < 0
+}
+
+func (this IUUID) MarshalText() ([]byte, error) {
+	return []byte(this.String()), nil
+}
+
+func (this *IUUID) UnmarshalText(text []byte) error {
+	id, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*this = id
+	return nil
+}
@@ -0,0 +1,36 @@
+package iuuid
+
+import (
+	"github.com/stretchr/testify/require"
+	"sort"
+	"testing"
+)
+
+func TestIUUID(t *testing.T) {
+	id, err := Parse("123e4567-e89b-12d3-a456-426614174000")
+	require.Nil(t, err)
+	require.Equal(t, "123e4567-e89b-12d3-a456-426614174000", id.String())
+	compact, err := Parse("123e4567e89b12d3a456426614174000")
+	require.Nil(t, err)
+	require.Equal(t, id, compact)
+	_, err = Parse("123e4567-e89b-12d3-a456-42661417400")
+	require.Equal(t, ErrSyntax, err)
+	_, err = Parse("123e4567-e89b-12d3-a456-42661417400g")
+	require.Equal(t, ErrSyntax, err)
+
+	random, err := New()
+	require.Nil(t, err)
+	require.Equal(t, byte(0x40), random[6]&0xf0)
+	parsed, err := Parse(random.String())
+	require.Nil(t, err)
+	require.Equal(t, random, parsed)
+
+	ids := make([]IUUID, 100)
+	for i := range ids {
+		ids[i], _ = New()
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Less(ids[i], ids[j]) })
+	for i := 1; i < len(ids); i++ {
+		require.True(t, ids[i-1].String() <= ids[i].String())
+	}
+}
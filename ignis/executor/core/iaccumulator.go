@@ -0,0 +1,225 @@
+package core
+
+import (
+	"context"
+	"ignis/executor/api"
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/iprotocol"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/logger"
+	"sort"
+	"strings"
+)
+
+func (this *IMpi) GatherBytes(data []byte, root int) ([][]byte, error) {
+	if this.Executors() == 1 {
+		return [][]byte{data}, nil
+	}
+	sz := C_int(len(data))
+	szv := []C_int{0}
+	displs := []C_int{0}
+	if this.IsRoot(root) {
+		szv = make([]C_int, this.Executors())
+	}
+	if err := MPI_Gather(P(&sz), 1, MPI_INT, P(&szv[0]), 1, MPI_INT, C_int(root), this.Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	var all []byte
+	if this.IsRoot(root) {
+		displs = this.displs(szv)
+		all = make([]byte, int(displs[len(displs)-1]))
+	}
+	if err := MPI_Gatherv(PA(&data), sz, MPI_BYTE, PA(&all), &szv[0], &displs[0], MPI_BYTE, C_int(root), this.Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if !this.IsRoot(root) {
+		return nil, nil
+	}
+	result := make([][]byte, len(szv))
+	for i := range szv {
+		result[i] = all[displs[i] : displs[i]+szv[i]]
+	}
+	return result, nil
+}
+
+func (this *IExecutorData) accumulatorNames() ([]string, []int8, error) {
+	local := this.context.accumulators
+	names := make([]string, 0, len(local))
+	for name, acc := range local {
+		names = append(names, string(rune('0'+acc.Kind()))+name)
+	}
+	sort.Strings(names)
+	all, err := this.mpi_.GatherBytes([]byte(strings.Join(names, "\x00")), 0)
+	if err != nil {
+		return nil, nil, ierror.Raise(err)
+	}
+	var union []byte
+	if this.mpi_.IsRoot(0) {
+		kinds := map[string]byte{}
+		for _, data := range all {
+			if len(data) == 0 {
+				continue
+			}
+			for _, entry := range strings.Split(string(data), "\x00") {
+				if _, ok := kinds[entry[1:]]; !ok {
+					kinds[entry[1:]] = entry[0]
+				}
+			}
+		}
+		names = names[:0]
+		for name, kind := range kinds {
+			names = append(names, string(kind)+name)
+		}
+		sort.Strings(names)
+		union = []byte(strings.Join(names, "\x00"))
+	}
+	if union, err = this.mpi_.BcastBytes(union, 0, 0); err != nil {
+		return nil, nil, ierror.Raise(err)
+	}
+	if len(union) == 0 {
+		return nil, nil, nil
+	}
+	names = strings.Split(string(union), "\x00")
+	kinds := make([]int8, len(names))
+	for i, entry := range names {
+		kinds[i] = int8(entry[0] - '0')
+		names[i] = entry[1:]
+	}
+	return names, kinds, nil
+}
+
+/*Aggregates the accumulators of all executors, the result is only available in executor 0*/
+func (this *IExecutorData) ReduceAccumulators() (map[string][]byte, error) {
+	this.context.accumulatorMu.Lock()
+	defer this.context.accumulatorMu.Unlock()
+	names, kinds, err := this.accumulatorNames()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	local := this.context.accumulators
+	ints := make([]int64, 0)
+	floats := make([]float64, 0)
+	custom := itransport.NewIMemoryBuffer()
+	proto := iprotocol.NewIObjectProtocol(custom)
+	for i, name := range names {
+		acc, ok := local[name]
+		switch kinds[i] {
+		case api.IntAccumulatorKind:
+			var value int64
+			if ok {
+				value = acc.Get().(int64)
+			}
+			ints = append(ints, value)
+		case api.FloatAccumulatorKind:
+			var value float64
+			if ok {
+				value = acc.Get().(float64)
+			}
+			floats = append(floats, value)
+		default:
+			if err = proto.WriteBool(context.Background(), ok); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			if ok {
+				if err = proto.WriteObjectWithNative(acc.Get(), true); err != nil {
+					return nil, ierror.Raise(err)
+				}
+			}
+		}
+	}
+
+	if this.mpi_.Executors() > 1 {
+		if len(ints) > 0 {
+			sendbuf := PA(&ints)
+			if this.mpi_.IsRoot(0) {
+				sendbuf = MPI_IN_PLACE
+			}
+			if err = MPI_Reduce(sendbuf, PA(&ints), C_int(len(ints)), MPI_INT64_T, MPI_SUM, 0, this.mpi_.Native()); err != nil {
+				return nil, ierror.Raise(err)
+			}
+		}
+		if len(floats) > 0 {
+			sendbuf := PA(&floats)
+			if this.mpi_.IsRoot(0) {
+				sendbuf = MPI_IN_PLACE
+			}
+			if err = MPI_Reduce(sendbuf, PA(&floats), C_int(len(floats)), MPI_DOUBLE, MPI_SUM, 0, this.mpi_.Native()); err != nil {
+				return nil, ierror.Raise(err)
+			}
+		}
+	}
+	values, err := this.mpi_.GatherBytes(custom.Bytes(), 0)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	defer func() {
+		for _, acc := range local {
+			acc.Reset()
+		}
+	}()
+	if !this.mpi_.IsRoot(0) {
+		return map[string][]byte{}, nil
+	}
+
+	readers := make([]*iprotocol.IObjectProtocol, len(values))
+	for i, data := range values {
+		readers[i] = iprotocol.NewIObjectProtocol(itransport.NewIMemoryBufferWrapper(data, int64(len(data)), itransport.OBSERVE))
+	}
+	result := make(map[string][]byte, len(names))
+	buffer := itransport.NewIMemoryBuffer()
+	writer := iprotocol.NewIObjectProtocol(buffer)
+	native, err := this.properties.NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	for i, name := range names {
+		var value any
+		switch kinds[i] {
+		case api.IntAccumulatorKind:
+			value, ints = ints[0], ints[1:]
+		case api.FloatAccumulatorKind:
+			value, floats = floats[0], floats[1:]
+		default:
+			acc, ok := local[name]
+			var merged any
+			found := false
+			for r, reader := range readers {
+				present, err := reader.ReadBool(context.Background())
+				if err != nil {
+					return nil, ierror.Raise(err)
+				}
+				if !present {
+					continue
+				}
+				other, err := reader.ReadObject()
+				if err != nil {
+					return nil, ierror.Raise(err)
+				}
+				if r == 0 {
+					continue
+				}
+				if ok {
+					acc.MergeAny(other)
+				} else if !found {
+					merged = other
+				} else {
+					return nil, ierror.RaiseMsg("accumulator " + name + " must be registered in executor 0 to be merged")
+				}
+				found = true
+			}
+			if ok {
+				value = acc.Get()
+			} else {
+				value = merged
+			}
+		}
+		buffer.ResetBuffer()
+		if err = writer.WriteObjectWithNative(value, native && kinds[i] == api.CustomAccumulatorKind); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		result[name] = append([]byte{}, buffer.Bytes()...)
+	}
+	logger.Info("Accumulators: ", len(result), " accumulators reduced")
+	return result, nil
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/iprotocol"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/logger"
+)
+
+func (this *IExecutorData) Broadcast(name string, value []byte) error {
+	chunk, err := this.properties.BroadcastChunk()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if this.mpi_.IsRoot(0) && len(value) == 0 {
+		return ierror.RaiseMsg("broadcast " + name + " has no value")
+	}
+	logger.Info("Broadcast: distributing variable ", name)
+	value, err = this.mpi_.BcastBytes(value, int(chunk), 0)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	buffer := itransport.NewIMemoryBufferWrapper(value, int64(len(value)), itransport.OBSERVE)
+	proto := iprotocol.NewIObjectProtocol(buffer)
+	obj, err := proto.ReadObject()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.context.broadcasts[name] = obj
+	return nil
+}
+
+func (this *IExecutorData) HasBroadcast(name string) bool {
+	_, ok := this.context.broadcasts[name]
+	return ok
+}
+
+func (this *IExecutorData) RemoveBroadcast(name string) {
+	delete(this.context.broadcasts, name)
+}
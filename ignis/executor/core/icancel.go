@@ -0,0 +1,116 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/logger"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+Cancellation of the job that is running in the executor. Modules check it when they take the partitions, parallel
+loops between chunks and MPI operations before they start and while they wait for a request.
+*/
+type ICancelToken struct {
+	mu        sync.Mutex
+	cancelled atomic.Bool
+	done      chan struct{}
+	reason    string
+}
+
+func newICancelToken() *ICancelToken {
+	return &ICancelToken{done: make(chan struct{})}
+}
+
+func (this *ICancelToken) Cancel(reason string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if this.cancelled.Load() {
+		return
+	}
+	this.reason = reason
+	this.cancelled.Store(true)
+	close(this.done)
+	logger.Warn("Executor: job cancelled ", reason)
+}
+
+/*Prepares the token for the next job*/
+func (this *ICancelToken) Reset() {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if !this.cancelled.Load() {
+		return
+	}
+	this.reason = ""
+	this.done = make(chan struct{})
+	this.cancelled.Store(false)
+}
+
+func (this *ICancelToken) Cancelled() bool {
+	return this.cancelled.Load()
+}
+
+func (this *ICancelToken) Done() <-chan struct{} {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.done
+}
+
+func (this *ICancelToken) Err() error {
+	if !this.cancelled.Load() {
+		return nil
+	}
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return ierror.RaiseCancelled(this.reason)
+}
+
+/*Returns an error if the job was cancelled or any executor of the group stopped answering*/
+func (this *IMpi) check() error {
+	if err := this.heartbeat.Check(); err != nil {
+		return ierror.Raise(err)
+	}
+	if this.context != nil {
+		return this.context.CancelToken().Err()
+	}
+	return nil
+}
+
+/*
+Waits for a request without blocking on MPI, so a cancelled job does not leave the executor waiting forever. Point to
+point requests are cancelled, collective requests can not be cancelled and are drained before the error is returned
+because the other executors still take part in them.
+*/
+func (this *IMpi) wait(request *C_MPI_Request, collective bool) error {
+	if this.context == nil {
+		return ierror.Raise(MPI_Wait(request, MPI_STATUS_IGNORE))
+	}
+	token := this.context.CancelToken()
+	pause := time.Microsecond
+	for {
+		var flag C_int
+		if err := MPI_Test(request, &flag, MPI_STATUS_IGNORE); err != nil {
+			return ierror.Raise(err)
+		}
+		if flag != 0 {
+			return nil
+		}
+		if token.Cancelled() {
+			if !collective {
+				if err := MPI_Cancel(request); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			if err := MPI_Wait(request, MPI_STATUS_IGNORE); err != nil {
+				return ierror.Raise(err)
+			}
+			return token.Err()
+		}
+		time.Sleep(pause)
+		if pause < reliablePollMax {
+			pause *= 2
+		}
+	}
+}
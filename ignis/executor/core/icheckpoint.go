@@ -0,0 +1,185 @@
+package core
+
+import (
+	"encoding/gob"
+	"errors"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"io"
+	"io/fs"
+	"os"
+	"strconv"
+)
+
+type ICheckpoint struct {
+	Id         int64
+	Type       string
+	Executors  int
+	Partitions []string
+	Variables  map[string]any
+	Vars       map[string]any
+}
+
+type ICheckpointManager struct {
+	executorData *IExecutorData
+}
+
+func (this *ICheckpointManager) directory() (string, error) {
+	path, err := this.executorData.properties.CheckpointDirectory()
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	path += "/executor" + strconv.Itoa(this.executorData.context.ExecutorId())
+	if err = this.executorData.partitionTools.CreateDirectoryIfNotExists(path); err != nil {
+		return "", ierror.Raise(err)
+	}
+	return path, nil
+}
+
+func (this *ICheckpointManager) Enabled() bool {
+	return this.executorData.properties.Has("ignis.checkpoint.dir")
+}
+
+func checkpointEncodable(v any) bool {
+	return gob.NewEncoder(io.Discard).Encode(struct{ V any }{v}) == nil
+}
+
+func checkpointValues(values map[string]any) map[string]any {
+	result := make(map[string]any, len(values))
+	for key, value := range values {
+		if checkpointEncodable(value) {
+			result[key] = value
+		} else {
+			logger.Warn("Checkpoint: variable ", key, " can not be serialized, ignoring")
+		}
+	}
+	return result
+}
+
+func (this *ICheckpointManager) Save() (int64, error) {
+	dir, err := this.directory()
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	previous, err := this.Load()
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	checkpoint := &ICheckpoint{
+		Executors: this.executorData.context.Executors(),
+		Variables: checkpointValues(this.executorData.variables),
+		Vars:      checkpointValues(this.executorData.context.Vars()),
+	}
+	if previous != nil {
+		checkpoint.Id = previous.Id + 1
+	}
+	logger.Info("Checkpoint: saving checkpoint ", checkpoint.Id)
+
+	group := this.executorData.GetPartitionsAny()
+	if group == nil {
+		group = storage.NewIPartitionGroup[any]()
+	}
+	checkpoint.Type = group.Type().String()
+	compression, err := this.executorData.properties.PartitionCompression()
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	path := dir + "/checkpoint" + strconv.FormatInt(checkpoint.Id, 10)
+	if err = this.executorData.partitionTools.CreateDirectoryIfNotExists(path); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	disk := group.NewGroup()
+	for i := 0; i < group.Size(); i++ {
+		partPath := path + "/part" + strconv.Itoa(i)
+		if err = disk.AddDiskPartition(partPath, compression, group.GetBase(i).Native()); err != nil {
+			return 0, ierror.Raise(err)
+		}
+		part := disk.GetBase(i)
+		if err = group.GetBase(i).CopyTo(part); err != nil {
+			return 0, ierror.Raise(err)
+		}
+		if err = part.Sync(); err != nil {
+			return 0, ierror.Raise(err)
+		}
+		part.(storage.IDiskPreservation).Persist(true)
+		checkpoint.Partitions = append(checkpoint.Partitions, partPath)
+	}
+
+	info := dir + "/checkpoint.info"
+	file, err := os.Create(info + ".tmp")
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	if err = gob.NewEncoder(file).Encode(checkpoint); err != nil {
+		file.Close()
+		return 0, ierror.Raise(err)
+	}
+	if err = file.Sync(); err != nil {
+		file.Close()
+		return 0, ierror.Raise(err)
+	}
+	if err = file.Close(); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	if err = os.Rename(info+".tmp", info); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	if previous != nil {
+		_ = os.RemoveAll(dir + "/checkpoint" + strconv.FormatInt(previous.Id, 10))
+	}
+	return checkpoint.Id, nil
+}
+
+func (this *ICheckpointManager) Load() (*ICheckpoint, error) {
+	dir, err := this.directory()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	file, err := os.Open(dir + "/checkpoint.info")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	defer file.Close()
+	checkpoint := &ICheckpoint{}
+	if err = gob.NewDecoder(file).Decode(checkpoint); err != nil {
+		return nil, ierror.RaiseMsgCause("checkpoint is corrupted", err)
+	}
+	if checkpoint.Executors != this.executorData.context.Executors() {
+		return nil, ierror.RaiseMsg("checkpoint was created with " + strconv.Itoa(checkpoint.Executors) + " executors")
+	}
+	return checkpoint, nil
+}
+
+func (this *ICheckpointManager) Remove() error {
+	dir, err := this.directory()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(os.RemoveAll(dir))
+}
+
+func RestoreCheckpoint[T any](this *ICheckpointManager, checkpoint *ICheckpoint) error {
+	logger.Info("Checkpoint: restoring checkpoint ", checkpoint.Id)
+	group := storage.NewIPartitionGroup[T]()
+	for _, path := range checkpoint.Partitions {
+		part, err := storage.NewIDiskPartition[T](path, 0, false, true, true)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		group.Add(part)
+	}
+	group.SetCache(true)
+	SetPartitions(this.executorData, group)
+	this.executorData.ClearVariables()
+	for key, value := range checkpoint.Variables {
+		this.executorData.variables[key] = value
+	}
+	vars := this.executorData.context.Vars()
+	for key, value := range checkpoint.Vars {
+		vars[key] = value
+	}
+	return nil
+}
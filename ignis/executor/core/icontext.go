@@ -4,13 +4,18 @@ import (
 	"ignis/executor/api"
 	"ignis/executor/core/impi"
 	"ignis/executor/core/ithreads"
+	"sync"
 )
 
 type iContextImpl struct {
 	properties     map[string]string
 	variables      map[string]any
+	broadcasts     map[string]any
+	accumulators   map[string]api.IAccumulatorBase
+	accumulatorMu  sync.Mutex
 	arrayTypes     []api.IContextType
 	mpiThreadGroup []impi.C_MPI_Comm
+	cancel         *ICancelToken
 }
 
 type iThreadContextImpl struct {
@@ -26,7 +31,10 @@ func NewIContext() api.IContext {
 	return &iContextImpl{
 		properties:     make(map[string]string),
 		variables:      make(map[string]any),
+		broadcasts:     make(map[string]any),
+		accumulators:   make(map[string]api.IAccumulatorBase),
 		mpiThreadGroup: []impi.C_MPI_Comm{impi.MPI_COMM_WORLD},
+		cancel:         newICancelToken(),
 	}
 }
 
@@ -80,6 +88,26 @@ func (this *iContextImpl) Vars() map[string]any {
 	return this.variables
 }
 
+func (this *iContextImpl) Broadcast(name string) (any, bool) {
+	value, ok := this.broadcasts[name]
+	return value, ok
+}
+
+func (this *iContextImpl) Accumulator(name string, create func() api.IAccumulatorBase) api.IAccumulatorBase {
+	this.accumulatorMu.Lock()
+	defer this.accumulatorMu.Unlock()
+	acc, ok := this.accumulators[name]
+	if !ok {
+		acc = create()
+		this.accumulators[name] = acc
+	}
+	return acc
+}
+
 func (this *iContextImpl) Register(tp api.IContextType) {
 	this.arrayTypes = append(this.arrayTypes, tp)
 }
+
+func (this *iContextImpl) CancelToken() api.ICancelToken {
+	return this.cancel
+}
@@ -0,0 +1,249 @@
+package ierror
+
+import (
+	"errors"
+	"github.com/apache/thrift/lib/go/thrift"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+/*Every category owns the thousand codes that start at category * 1000*/
+type ICategory int32
+
+const (
+	Unknown           ICategory = 0
+	Communication     ICategory = 1
+	Serialization     ICategory = 2
+	UserFunction      ICategory = 3
+	ResourceExhausted ICategory = 4
+	Cancelled         ICategory = 5
+)
+
+/*Codes are sent to the driver, they must never be renumbered*/
+const (
+	CodeUnknown           int32 = 0
+	CodeCommunication     int32 = 1000
+	CodePeerDead          int32 = 1001
+	CodeTimeout           int32 = 1002
+	CodeSerialization     int32 = 2000
+	CodeUserFunction      int32 = 3000
+	CodeUserPanic         int32 = 3001
+	CodeResourceExhausted int32 = 4000
+	CodeOutOfMemory       int32 = 4001
+	CodeDiskFull          int32 = 4002
+	CodeCancelled         int32 = 5000
+)
+
+var categoryNames = []string{"Unknown", "Communication", "Serialization", "UserFunction", "ResourceExhausted", "Cancelled"}
+
+func (this ICategory) String() string {
+	if this < 0 || int(this) >= len(categoryNames) {
+		return "Category" + strconv.Itoa(int(this))
+	}
+	return categoryNames[this]
+}
+
+func CategoryOf(code int32) ICategory {
+	return ICategory(code / 1000)
+}
+
+/*Errors of other packages can implement it to choose their code*/
+type ICodedError interface {
+	ErrorCode() int32
+}
+
+var classifiers []func(err error) (int32, bool)
+
+/*Chooses the code of errors from packages that ierror cannot import*/
+func AddClassifier(f func(err error) (int32, bool)) {
+	classifiers = append(classifiers, f)
+}
+
+func classify(err error) int32 {
+	for _, f := range classifiers {
+		if code, ok := f(err); ok {
+			return code
+		}
+	}
+	var coded ICodedError
+	var netErr net.Error
+	var protocolErr thrift.TProtocolException
+	switch {
+	case errors.As(err, &coded):
+		return coded.ErrorCode()
+	case errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EDQUOT):
+		return CodeDiskFull
+	case errors.Is(err, syscall.ENOMEM):
+		return CodeOutOfMemory
+	case errors.Is(err, os.ErrDeadlineExceeded):
+		return CodeTimeout
+	case errors.As(err, &netErr):
+		if netErr.Timeout() {
+			return CodeTimeout
+		}
+		return CodeCommunication
+	case errors.As(err, &protocolErr):
+		return CodeSerialization
+	}
+	return CodeUnknown
+}
+
+func classifyCause(cause error) int32 {
+	if cause == nil {
+		return CodeUnknown
+	}
+	if ex := executorError(cause); ex != nil {
+		return ex.code
+	}
+	return classify(cause)
+}
+
+func executorError(err error) *IExecutorError {
+	switch err2 := err.(type) {
+	case *IExecutorError:
+		return err2
+	case *IPeerDead:
+		return &err2.IExecutorError
+	}
+	return nil
+}
+
+func (this *IExecutorError) Code() int32 {
+	return this.code
+}
+
+func (this *IExecutorError) ErrorCode() int32 {
+	return this.code
+}
+
+func (this *IExecutorError) Category() ICategory {
+	return CategoryOf(this.code)
+}
+
+/*Rank of the executor that failed, -1 if unknown*/
+func (this *IExecutorError) Rank() int {
+	return this.rank
+}
+
+func (this *IExecutorError) Module() string {
+	return this.module
+}
+
+/*Partition that was being processed, -1 if unknown*/
+func (this *IExecutorError) Partition() int64 {
+	return this.partition
+}
+
+func (this *IExecutorError) Stack() string {
+	return this.stack
+}
+
+func (this *IExecutorError) Unwrap() error {
+	return this.cause
+}
+
+/*Sets where the error happened, fields already known are preserved*/
+func WithContext(err error, rank int, module string) error {
+	if err == nil {
+		return nil
+	}
+	ex := executorError(err)
+	if ex == nil {
+		err = Raise(err)
+		ex = executorError(err)
+	}
+	if ex.rank < 0 {
+		ex.rank = rank
+	}
+	if ex.module == "" {
+		ex.module = module
+	}
+	return err
+}
+
+const encodeHeader = "IgnisError"
+
+/*
+Text sent to the driver, a header line with the error fields followed by the error as Error() prints it, so a driver
+that does not know the header can still show it.
+*/
+func Encode(err error) string {
+	ex := executorError(err)
+	if ex == nil {
+		ex = executorError(Raise(err))
+	}
+	var sb strings.Builder
+	sb.WriteString(encodeHeader)
+	sb.WriteString(" code=" + strconv.Itoa(int(ex.code)))
+	sb.WriteString(" category=" + ex.Category().String())
+	sb.WriteString(" rank=" + strconv.Itoa(ex.rank))
+	sb.WriteString(" partition=" + strconv.FormatInt(ex.partition, 10))
+	sb.WriteString(" module=" + strconv.Quote(ex.module))
+	sb.WriteString("\n")
+	sb.WriteString(err.Error())
+	return sb.String()
+}
+
+/*Rebuilds an error from Encode, the cause is kept as text*/
+func Decode(data string) (*IExecutorError, error) {
+	header, body, _ := strings.Cut(data, "\n")
+	if !strings.HasPrefix(header, encodeHeader+" ") {
+		return nil, errors.New("missing " + encodeHeader + " header")
+	}
+	ex := &IExecutorError{rank: -1, partition: -1}
+	fields := header[len(encodeHeader)+1:]
+	for fields != "" {
+		key, value, found := strings.Cut(fields, "=")
+		if !found {
+			return nil, errors.New("malformed field " + fields)
+		}
+		if strings.HasPrefix(value, "\"") {
+			quoted, err := strconv.QuotedPrefix(value)
+			if err != nil {
+				return nil, err
+			}
+			fields = strings.TrimPrefix(value[len(quoted):], " ")
+			if value, err = strconv.Unquote(quoted); err != nil {
+				return nil, err
+			}
+		} else {
+			value, fields, _ = strings.Cut(value, " ")
+		}
+		var err error
+		switch key {
+		case "code":
+			var code int64
+			code, err = strconv.ParseInt(value, 10, 32)
+			ex.code = int32(code)
+		case "rank":
+			ex.rank, err = strconv.Atoi(value)
+		case "partition":
+			ex.partition, err = strconv.ParseInt(value, 10, 64)
+		case "module":
+			ex.module = value
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	body = strings.TrimPrefix(body, "GoExecutorError: ")
+	var message, stack []string
+	lines := strings.Split(body, "\n")
+	i := 0
+	for ; i < len(lines) && !strings.HasPrefix(lines[i], "\t") && !strings.HasPrefix(lines[i], "Caused by: "); i++ {
+		message = append(message, lines[i])
+	}
+	for ; i < len(lines) && strings.HasPrefix(lines[i], "\t"); i++ {
+		stack = append(stack, lines[i]+"\n")
+	}
+	ex.message = strings.Join(message, "\n")
+	ex.stack = strings.Join(stack, "")
+	if i < len(lines) {
+		ex.cause = errors.New(strings.TrimPrefix(strings.Join(lines[i:], "\n"), "Caused by: "))
+	}
+	return ex, nil
+}
@@ -3,44 +3,111 @@ package ierror
 import (
 	"fmt"
 	"runtime"
+	"strconv"
 	"strings"
+	"time"
 )
 
 type IExecutorError struct {
-	message string
-	stack   string
-	cause   error
+	message   string
+	stack     string
+	cause     error
+	code      int32
+	rank      int
+	module    string
+	partition int64
+}
+
+func newError(code int32, message string, cause error) IExecutorError {
+	return IExecutorError{
+		message:   message,
+		stack:     stack(),
+		cause:     cause,
+		code:      code,
+		rank:      -1,
+		partition: -1,
+	}
 }
 
 func Raise(err error) error {
 	if err == nil {
 		return nil
 	}
-	if err2, ok := err.(*IExecutorError); ok {
+	switch err2 := err.(type) {
+	case *IExecutorError:
+		return err2
+	case *IPeerDead:
 		return err2
 	}
-	return &IExecutorError{
-		err.Error(),
-		stack(),
-		nil,
-	}
+	ex := newError(classify(err), err.Error(), nil)
+	return &ex
 }
 
 func RaiseMsg(message string) error {
-	return &IExecutorError{
-		message,
-		stack(),
-		nil,
-	}
+	ex := newError(CodeUnknown, message, nil)
+	return &ex
 }
 
 func RaiseMsgCause(message string, cause error) error {
-	return &IExecutorError{
-		message,
-		stack(),
-		cause,
+	ex := newError(classifyCause(cause), message, cause)
+	return &ex
+}
+
+func RaiseCode(code int32, message string) error {
+	ex := newError(code, message, nil)
+	return &ex
+}
+
+func RaiseCodeCause(code int32, message string, cause error) error {
+	ex := newError(code, message, cause)
+	return &ex
+}
+
+/*Error returned by a user function while it was processing a partition, partition is -1 if unknown*/
+func RaiseUser(err error, partition int64) error {
+	if err == nil {
+		return nil
+	}
+	if ex := executorError(err); ex != nil {
+		if ex.code == CodeUnknown {
+			ex.code = CodeUserFunction
+		}
+		if ex.partition < 0 {
+			ex.partition = partition
+		}
+		return err
+	}
+	ex := newError(CodeUserFunction, err.Error(), nil)
+	ex.partition = partition
+	return &ex
+}
+
+/*An executor of the group stopped answering heartbeats*/
+type IPeerDead struct {
+	IExecutorError
+	Rank     int
+	LastSeen time.Time
+}
+
+func RaisePeerDead(rank int, lastSeen time.Time) error {
+	return &IPeerDead{
+		newError(CodePeerDead, "executor "+strconv.Itoa(rank)+" is not responding, last seen at "+
+			lastSeen.Format(time.RFC3339Nano)+" ("+time.Since(lastSeen).Round(time.Millisecond).String()+" ago)", nil),
+		rank,
+		lastSeen,
+	}
+}
+
+/*The job was cancelled by the driver, the executor stopped at the next partition or chunk*/
+func RaiseCancelled(reason string) error {
+	message := "job cancelled"
+	if reason != "" {
+		message += ": " + reason
 	}
+	ex := newError(CodeCancelled, message, nil)
+	return &ex
 }
+
 func (this *IExecutorError) GetMessage() string {
 	return this.message
 }
@@ -60,7 +127,7 @@ func (this *IExecutorError) Error() string {
 
 func stack() string {
 	rpc := make([]uintptr, 10)
-	runtime.Callers(3, rpc)
+	runtime.Callers(4, rpc)
 	frames := runtime.CallersFrames(rpc)
 	frame, more := frames.Next()
 	var sb strings.Builder
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"hash/maphash"
+)
+
+/*
+Partitions of an exchange kept between iterations. The sender remembers a fingerprint of every partition it sent and
+the receiver the serialized copy of every partition it received, an unchanged partition is not transferred again.
+*/
+type IExchangeDelta struct {
+	seed     maphash.Seed
+	sent     []uint64
+	received [][][]byte
+}
+
+func NewIExchangeDelta() *IExchangeDelta {
+	return &IExchangeDelta{seed: maphash.MakeSeed()}
+}
+
+/*Compares the partition with the one sent in the previous iteration and keeps its fingerprint for the next one*/
+func (this *IExchangeDelta) Changed(numPartitions int, part int, data []byte) bool {
+	if len(this.sent) != numPartitions {
+		this.sent = make([]uint64, numPartitions)
+	}
+	/*Zero is kept for the partitions never sent*/
+	sum := maphash.Bytes(this.seed, data) | 1
+	changed := this.sent[part] != sum
+	this.sent[part] = sum
+	return changed
+}
+
+/*The partition was sent in the previous iteration*/
+func (this *IExchangeDelta) Sent(numPartitions int, part int) bool {
+	return len(this.sent) == numPartitions && this.sent[part] != 0
+}
+
+/*Serialized copy received from source in the previous iteration, nil if there is none*/
+func (this *IExchangeDelta) Received(numPartitions int, part int, source int, executors int) []byte {
+	if len(this.received) != numPartitions || len(this.received[part]) != executors {
+		return nil
+	}
+	return this.received[part][source]
+}
+
+func (this *IExchangeDelta) SetReceived(numPartitions int, part int, source int, executors int, data []byte) {
+	if len(this.received) != numPartitions {
+		this.received = make([][][]byte, numPartitions)
+	}
+	if len(this.received[part]) != executors {
+		this.received[part] = make([][]byte, executors)
+	}
+	this.received[part][source] = data
+}
+
+/*Forgets every partition, the next exchange transfers all of them*/
+func (this *IExchangeDelta) Reset() {
+	this.sent = nil
+	this.received = nil
+}
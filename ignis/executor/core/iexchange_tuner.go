@@ -0,0 +1,105 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/utils"
+	"math"
+	"sync"
+	"time"
+)
+
+/*Peer used by the collective exchanges, where all executors take part in every transfer*/
+const ExchangeAllPeers = -1
+
+/*Relative throughput loss accepted when the threads of a peer are reduced*/
+const exchangeTunerTolerance = 0.1
+
+/*
+Adaptive number of transport threads of the exchanges. The first round with a peer uses all the threads and measures
+its throughput, later rounds halve the threads while the throughput stays within a tolerance and go back to the last
+good value when it drops, so large messages do not oversubscribe the network. Small messages are limited to one
+thread for every ignis.transport.cores.bytes transferred, more threads only add overhead to them.
+*/
+type IExchangeTuner struct {
+	mu         sync.Mutex
+	properties *IPropertyParser
+	peers      map[int]*iPeerTuning
+}
+
+type iPeerTuning struct {
+	threads    int
+	best       int
+	throughput float64
+	settled    bool
+}
+
+func (this *IExchangeTuner) Enabled() (bool, error) {
+	return this.properties.TransportCoresAdaptive()
+}
+
+/*Threads for the next round with peer, max when the peer was not measured or the tuner is disabled*/
+func (this *IExchangeTuner) Threads(peer int, max int) (int, error) {
+	if enabled, err := this.Enabled(); err != nil {
+		return 0, ierror.Raise(err)
+	} else if !enabled {
+		return max, nil
+	}
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if tuning, ok := this.peers[peer]; ok {
+		return utils.Max(utils.Min(tuning.threads, max), 1), nil
+	}
+	return max, nil
+}
+
+/*Records a round of bytes sent and received with peer in messages transfers that took elapsed using threads*/
+func (this *IExchangeTuner) Observe(peer int, threads int, bytes int64, messages int64, elapsed time.Duration) error {
+	if enabled, err := this.Enabled(); err != nil {
+		return ierror.Raise(err)
+	} else if !enabled || bytes <= 0 || messages <= 0 || elapsed <= 0 {
+		return nil
+	}
+	minBytes, err := this.properties.TransportCoresBytes()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	need := utils.Min(int64(math.Ceil(float64(bytes)/float64(utils.Max(minBytes, 1)))), messages)
+	throughput := float64(bytes) / elapsed.Seconds()
+
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if this.peers == nil {
+		this.peers = map[int]*iPeerTuning{}
+	}
+	tuning, ok := this.peers[peer]
+	if !ok {
+		tuning = &iPeerTuning{best: threads, throughput: throughput}
+		this.peers[peer] = tuning
+		tuning.threads = threads / 2
+	} else if tuning.settled {
+		tuning.threads = tuning.best
+	} else if threads < tuning.best && throughput >= tuning.throughput*(1-exchangeTunerTolerance) {
+		tuning.best = threads
+		tuning.throughput = utils.Max(tuning.throughput, throughput)
+		tuning.threads = threads / 2
+	} else {
+		tuning.threads = tuning.best
+		tuning.settled = true
+	}
+	if tuning.threads < 1 {
+		tuning.threads = 1
+		tuning.settled = true
+	}
+	tuning.threads = int(utils.Min(int64(tuning.threads), need))
+	logger.Debug("Exchange: ", bytes, " bytes in ", messages, " messages with peer ", peer, " at ",
+		int64(throughput), " bytes/s using ", threads, " threads, next round uses ", tuning.threads)
+	return nil
+}
+
+/*Forgets the measures, the peers change when the executor group changes*/
+func (this *IExchangeTuner) Reset() {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.peers = nil
+}
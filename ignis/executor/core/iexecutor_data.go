@@ -33,6 +33,19 @@ type IExecutorData struct {
 	partitionTools IPartitionTools
 	context        *iContextImpl
 	mpi_           IMpi
+	checkpoints    ICheckpointManager
+	metrics        *IMetrics
+	lineage        *ILineage
+	partitioner    string
+	keyOrder       int
+	variableLayout map[string]IKeyLayout
+	exchangePlans  map[string]string
+	exchangeDeltas map[string]*IExchangeDelta
+	heartbeat      IHeartbeat
+	memory         IMemoryManager
+	results        IResultCache
+	exchangeTuner  IExchangeTuner
+	trace          ITrace
 }
 
 func NewIExecutorData() *IExecutorData {
@@ -41,16 +54,29 @@ func NewIExecutorData() *IExecutorData {
 		functions: make(map[string]function.IBaseFunction),
 		baseTypes: make(map[string]api.IContextType),
 		context:   NewIContext().(*iContextImpl),
+		metrics:   NewIMetrics(),
+		lineage:   NewILineage(),
 	}
 
 	this.libraryLoader.executorData = this
 	this.partitionTools.properties = &this.properties
 	this.partitionTools.context = this.context
+	this.partitionTools.spill.tools = &this.partitionTools
 	this.properties.properties = this.context.properties
 
 	this.mpi_.propertyParser = &this.properties
 	this.mpi_.partitionTools = &this.partitionTools
 	this.mpi_.context = this.context
+	this.mpi_.heartbeat = &this.heartbeat
+	this.heartbeat.properties = &this.properties
+	this.memory.properties = &this.properties
+	this.exchangeTuner.properties = &this.properties
+	this.results.executorData = this
+	this.trace.executorData = this
+	this.checkpoints.executorData = this
+	ithreads.SetInterrupt(this.context.cancel.Err)
+	this.metrics.cancel = this.context.cancel
+	this.metrics.trace = &this.trace
 
 	return this
 }
@@ -63,9 +89,14 @@ func (this *IExecutorData) SetPartitionsAny(group storage.IPartitionGroupBase) {
 	this.DeletePartitions()
 	this.partitions = group
 	_ = group.Sync()
+	this.metrics.Partitions(group)
+	this.lineage.Produced(group.Size())
 }
 
 func GetPartitions[T any](this *IExecutorData) (*storage.IPartitionGroup[T], error) {
+	if err := this.context.cancel.Err(); err != nil {
+		return nil, err
+	}
 	if this.partitions == nil {
 		return nil, nil
 	}
@@ -99,6 +130,8 @@ func SetPartitions[T any](this *IExecutorData, group *storage.IPartitionGroup[T]
 	this.DeletePartitions()
 	this.partitions = group
 	_ = group.Sync()
+	this.metrics.Partitions(group)
+	this.lineage.Produced(group.Size())
 }
 
 func (this *IExecutorData) HasPartitions() bool {
@@ -108,6 +141,93 @@ func (this *IExecutorData) HasPartitions() bool {
 func (this *IExecutorData) DeletePartitions() {
 	this.partitions = nil
 	this.convPartitions = nil
+	this.partitioner = ""
+	this.keyOrder = 0
+	this.results.input = ""
+}
+
+/*Identity of the partitioner that distributed the current partitions by key, empty if they are not co-partitioned*/
+func (this *IExecutorData) Partitioner() string {
+	return this.partitioner
+}
+
+func (this *IExecutorData) SetPartitioner(id string) {
+	this.partitioner = id
+}
+
+/*Order of the keys inside every current partition by their natural order, 1 ascending, -1 descending, 0 unsorted*/
+func (this *IExecutorData) KeyOrder() int {
+	return this.keyOrder
+}
+
+func (this *IExecutorData) SetKeyOrder(order int) {
+	this.keyOrder = order
+}
+
+/*How a partition group is distributed and sorted by key, it is kept when the group is saved in a context*/
+type IKeyLayout struct {
+	Partitioner string
+	Order       int
+}
+
+func (this *IExecutorData) KeyLayout() IKeyLayout {
+	return IKeyLayout{this.partitioner, this.keyOrder}
+}
+
+func (this *IExecutorData) SetKeyLayout(layout IKeyLayout) {
+	this.partitioner = layout.Partitioner
+	this.keyOrder = layout.Order
+}
+
+/*Layout of a partition group loaded as a variable, empty if it is unknown*/
+func (this *IExecutorData) VariableKeyLayout(key string) IKeyLayout {
+	return this.variableLayout[key]
+}
+
+func (this *IExecutorData) SetVariableKeyLayout(key string, layout IKeyLayout) {
+	if this.variableLayout == nil {
+		this.variableLayout = make(map[string]IKeyLayout)
+	}
+	this.variableLayout[key] = layout
+}
+
+/*
+While an iterative cache is alive every iteration repeats the same exchanges, so the exchange type selected the first
+time is reused instead of being planned again.
+*/
+func (this *IExecutorData) ExchangePlan(key string) (string, bool) {
+	tp, present := this.exchangePlans[key]
+	return tp, present
+}
+
+func (this *IExecutorData) SetExchangePlan(key string, tp string) {
+	if this.exchangePlans != nil {
+		this.exchangePlans[key] = tp
+	}
+}
+
+/*Copies of the previous iteration of an exchange, nil outside the iterative mode*/
+func (this *IExecutorData) ExchangeDelta(key string) *IExchangeDelta {
+	if this.exchangeDeltas == nil {
+		return nil
+	}
+	delta, present := this.exchangeDeltas[key]
+	if !present {
+		delta = NewIExchangeDelta()
+		this.exchangeDeltas[key] = delta
+	}
+	return delta
+}
+
+/*Plans and copies are only kept in iterative mode and are discarded when it ends*/
+func (this *IExecutorData) SetIterative(iterative bool) {
+	if !iterative {
+		this.exchangePlans = nil
+		this.exchangeDeltas = nil
+	} else if this.exchangePlans == nil {
+		this.exchangePlans = make(map[string]string)
+		this.exchangeDeltas = make(map[string]*IExchangeDelta)
+	}
 }
 
 func SetVariable[T any](this *IExecutorData, key string, value T) {
@@ -125,10 +245,23 @@ func (this *IExecutorData) HasVariable(key string) bool {
 
 func (this *IExecutorData) RemoveVariable(key string) {
 	delete(this.variables, key)
+	delete(this.variableLayout, key)
 }
 
 func (this *IExecutorData) ClearVariables() {
 	this.variables = make(map[string]any)
+	this.variableLayout = nil
+}
+
+/*Partition groups held as variables, like the second dataset of a join*/
+func (this *IExecutorData) VariablePartitions() []storage.IPartitionGroupBase {
+	var groups []storage.IPartitionGroupBase
+	for _, value := range this.variables {
+		if group, ok := value.(storage.IPartitionGroupBase); ok {
+			groups = append(groups, group)
+		}
+	}
+	return groups
 }
 
 func (this *IExecutorData) InfoDirectory() (string, error) {
@@ -145,10 +278,16 @@ func (this *IExecutorData) InfoDirectory() (string, error) {
 
 func (this *IExecutorData) SetMpiGroup(comm impi.C_MPI_Comm) error {
 	this.context.mpiThreadGroup = []impi.C_MPI_Comm{comm}
-	return nil
+	this.exchangeTuner.Reset()
+	if comm == impi.MPI_COMM_WORLD {
+		this.heartbeat.Stop()
+		return nil
+	}
+	return this.heartbeat.Start(comm)
 }
 
 func (this *IExecutorData) DestroyMpiGroup() {
+	this.heartbeat.Stop()
 	for _, comm := range this.context.mpiThreadGroup {
 		if comm != impi.MPI_COMM_WORLD {
 			impi.MPI_Comm_free(&comm)
@@ -173,15 +312,68 @@ func (this *IExecutorData) GetPartitionTools() *IPartitionTools {
 	return &this.partitionTools
 }
 
+func (this *IExecutorData) Heartbeat() *IHeartbeat {
+	return &this.heartbeat
+}
+
+func (this *IExecutorData) Memory() *IMemoryManager {
+	return &this.memory
+}
+
+func (this *IExecutorData) Results() *IResultCache {
+	return &this.results
+}
+
 func (this *IExecutorData) Mpi() *IMpi {
 	return &this.mpi_
 }
 
+func (this *IExecutorData) Metrics() *IMetrics {
+	return this.metrics
+}
+
+func (this *IExecutorData) Lineage() *ILineage {
+	return this.lineage
+}
+
+func (this *IExecutorData) Trace() *ITrace {
+	return &this.trace
+}
+
+func (this *IExecutorData) Checkpoints() *ICheckpointManager {
+	return &this.checkpoints
+}
+
 func (this *IExecutorData) SetCores(n int) {
 	runtime.GOMAXPROCS(n)
 	ithreads.SetDefaultCores(n)
 }
 
+/*Binds the executor to a numa node, it must be called before MPI starts so its threads inherit the binding*/
+func (this *IExecutorData) EnableAffinity() error {
+	mode, err := this.properties.ExecutorAffinity()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	selected, err := this.properties.ExecutorAffinityNode()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	node, err := ithreads.SetAffinity(mode, int(selected))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if node >= 0 {
+		_, cpus := ithreads.Affinity()
+		logger.Info("Executor bound to numa node ", node, " with ", len(cpus), " cpus (", mode, ")")
+	}
+	return nil
+}
+
+func (this *IExecutorData) Cancellation() *ICancelToken {
+	return this.context.cancel
+}
+
 func (this *IExecutorData) GetCores() int {
 	return ithreads.DefaultCores()
 }
@@ -190,6 +382,10 @@ func (this *IExecutorData) GetMpiCores() int {
 	return len(this.context.mpiThreadGroup)
 }
 
+func (this *IExecutorData) ExchangeTuner() *IExchangeTuner {
+	return &this.exchangeTuner
+}
+
 func (this *IExecutorData) EnableMpiCores() error {
 	ratio, err := this.properties.TransportCores()
 	if err != nil {
@@ -316,6 +512,10 @@ func (this *IExecutorData) loadLibrary(src *rpc.ISource, withBackup bool, fast b
 	return baseFunction, nil
 }
 
+func (this *IExecutorData) LoadedLibraries() []string {
+	return this.libraryLoader.Paths()
+}
+
 func (this *IExecutorData) LoadLibraryFunctions(path string) error {
 	return ierror.RaiseMsg("Not implemented yet")
 }
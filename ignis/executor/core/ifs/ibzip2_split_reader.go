@@ -0,0 +1,169 @@
+package ifs
+
+import (
+	"bufio"
+	"bytes"
+	"compress/bzip2"
+	"io"
+)
+
+const bzip2BlockMagic = 0x314159265359
+const bzip2EndMagic = 0x177245385090
+const bzip2MagicMask = 1<<48 - 1
+
+/*
+Decodes the bzip2 blocks that start inside a byte range of a file. Blocks are found by their 48 bit magic, which is
+not byte aligned, and each block is decoded as a stream with a single block. After the blocks of the range, the
+reader continues with the following blocks so the caller can finish its last record, Owned tells how many decoded
+bytes belong to the range.
+*/
+type IBzip2SplitReader struct {
+	reader  *bufio.Reader
+	first   int64
+	end     int64
+	pos     int64
+	cur     byte
+	left    int
+	window  uint64
+	found   bool
+	owned   int64
+	done    bool
+	decoded []byte
+}
+
+func NewIBzip2SplitReader(file IFileReader, init int64, end int64) (*IBzip2SplitReader, error) {
+	if _, err := file.Seek(init, io.SeekStart); err != nil {
+		return nil, err
+	}
+	this := &IBzip2SplitReader{
+		reader: bufio.NewReaderSize(file, 64*1024),
+		first:  init * 8,
+		end:    end * 8,
+		pos:    init * 8,
+	}
+	var err error
+	this.found, err = this.skip()
+	return this, err
+}
+
+/*Decoded bytes of the blocks that start inside the range, the value is final once the second result is true*/
+func (this *IBzip2SplitReader) Owned() (int64, bool) {
+	return this.owned, this.done
+}
+
+func (this *IBzip2SplitReader) Read(p []byte) (int, error) {
+	for len(this.decoded) == 0 {
+		if !this.found {
+			this.done = true
+			return 0, io.EOF
+		}
+		if this.pos-48 >= this.end {
+			this.done = true
+		}
+		data, err := this.block()
+		if err != nil {
+			return 0, err
+		}
+		if !this.done {
+			this.owned += int64(len(data))
+		}
+		this.decoded = data
+	}
+	n := copy(p, this.decoded)
+	this.decoded = this.decoded[n:]
+	return n, nil
+}
+
+func (this *IBzip2SplitReader) bit() (uint64, error) {
+	if this.left == 0 {
+		b, err := this.reader.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		this.cur = b
+		this.left = 8
+	}
+	this.left--
+	this.pos++
+	return uint64(this.cur>>this.left) & 1, nil
+}
+
+/*Moves after the next block magic, returns false at the end of the file*/
+func (this *IBzip2SplitReader) skip() (bool, error) {
+	for {
+		b, err := this.bit()
+		if err == io.EOF {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+		this.window = this.window<<1 | b
+		if this.window&bzip2MagicMask == bzip2BlockMagic && this.pos-48 >= this.first {
+			return true, nil
+		}
+	}
+}
+
+/*Copies the current block to a stream of its own and decodes it*/
+func (this *IBzip2SplitReader) block() ([]byte, error) {
+	stream := &iBitWriter{data: []byte("BZh9")}
+	stream.n = 32
+	stream.write(bzip2BlockMagic, 48)
+	this.found = false
+	for {
+		b, err := this.bit()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		this.window = this.window<<1 | b
+		stream.write(b, 1)
+		if magic := this.window & bzip2MagicMask; magic == bzip2BlockMagic || magic == bzip2EndMagic {
+			stream.truncate(stream.n - 48)
+			if magic == bzip2BlockMagic {
+				this.found = true
+			} else if this.found, err = this.skip(); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	crc := stream.read(32+48, 32)
+	stream.write(bzip2EndMagic, 48)
+	stream.write(crc, 32)
+	return io.ReadAll(bzip2.NewReader(bytes.NewReader(stream.data)))
+}
+
+type iBitWriter struct {
+	data []byte
+	n    int
+}
+
+func (this *iBitWriter) write(v uint64, bits int) {
+	for i := bits - 1; i >= 0; i-- {
+		if this.n%8 == 0 {
+			this.data = append(this.data, 0)
+		}
+		if v>>i&1 != 0 {
+			this.data[len(this.data)-1] |= 0x80 >> (this.n % 8)
+		}
+		this.n++
+	}
+}
+
+func (this *iBitWriter) read(pos int, bits int) uint64 {
+	v := uint64(0)
+	for i := pos; i < pos+bits; i++ {
+		v = v<<1 | uint64(this.data[i/8]>>(7-i%8)&1)
+	}
+	return v
+}
+
+func (this *iBitWriter) truncate(n int) {
+	this.n = n
+	this.data = this.data[:(n+7)/8]
+	if n%8 != 0 {
+		this.data[len(this.data)-1] &= 0xFF << (8 - n%8)
+	}
+}
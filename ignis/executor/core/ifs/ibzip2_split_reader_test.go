@@ -0,0 +1,45 @@
+package ifs
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestBzip2SplitReader(t *testing.T) {
+	if _, err := exec.LookPath("bzip2"); err != nil {
+		t.Skip("bzip2 not found")
+	}
+	var text bytes.Buffer
+	for i := 0; i < 60000; i++ {
+		text.WriteString("line " + strconv.Itoa(i*7919%100003) + "\n")
+	}
+	path := filepath.Join(t.TempDir(), "text")
+	require.Nil(t, os.WriteFile(path, text.Bytes(), 0644))
+	require.Nil(t, exec.Command("bzip2", "-1", path).Run())
+	info, err := os.Stat(path + ".bz2")
+	require.Nil(t, err)
+
+	for _, splits := range []int64{1, 3, 7} {
+		var result bytes.Buffer
+		chunk := (info.Size() + splits - 1) / splits
+		for init := int64(0); init < info.Size(); init += chunk {
+			file, err := os.Open(path + ".bz2")
+			require.Nil(t, err)
+			reader, err := NewIBzip2SplitReader(file, init, init+chunk)
+			require.Nil(t, err)
+			data, err := io.ReadAll(reader)
+			require.Nil(t, err)
+			owned, done := reader.Owned()
+			require.True(t, done)
+			result.Write(data[:owned])
+			file.Close()
+		}
+		require.Equal(t, text.String(), result.String())
+	}
+}
@@ -0,0 +1,114 @@
+package ifs
+
+import (
+	"compress/bzip2"
+	"compress/gzip"
+	"errors"
+	"io"
+	"strings"
+)
+
+/*Compression of whole files, selected by the extension of the file or by name*/
+type IFileCodec interface {
+	Name() string
+	Extension() string
+	Splittable() bool
+	NewReader(r io.Reader) (io.ReadCloser, error)
+	NewWriter(w io.Writer) (io.WriteCloser, error)
+}
+
+var fileCodecs = []IFileCodec{&iGzipCodec{}, &iBzip2Codec{}, &iZstdCodec{}}
+
+/*Returns nil when name is empty or none*/
+func FileCodec(name string) (IFileCodec, error) {
+	if name == "" || name == "none" {
+		return nil, nil
+	}
+	for _, codec := range fileCodecs {
+		if codec.Name() == name {
+			return codec, nil
+		}
+	}
+	return nil, errors.New("ifs: codec " + name + " is not available")
+}
+
+/*Returns nil when the extension of path does not belong to any codec*/
+func FileCodecFromPath(path string) IFileCodec {
+	for _, codec := range fileCodecs {
+		if strings.HasSuffix(path, codec.Extension()) {
+			return codec
+		}
+	}
+	return nil
+}
+
+type iGzipCodec struct {
+}
+
+func (this *iGzipCodec) Name() string {
+	return "gzip"
+}
+
+func (this *iGzipCodec) Extension() string {
+	return ".gz"
+}
+
+func (this *iGzipCodec) Splittable() bool {
+	return false
+}
+
+func (this *iGzipCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+func (this *iGzipCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriter(w), nil
+}
+
+/*Blocks of bzip2 can be found in the middle of a file, see IBzip2SplitReader*/
+type iBzip2Codec struct {
+}
+
+func (this *iBzip2Codec) Name() string {
+	return "bzip2"
+}
+
+func (this *iBzip2Codec) Extension() string {
+	return ".bz2"
+}
+
+func (this *iBzip2Codec) Splittable() bool {
+	return true
+}
+
+func (this *iBzip2Codec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(bzip2.NewReader(r)), nil
+}
+
+func (this *iBzip2Codec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return nil, errors.New("ifs: bzip2 compression is not available, only decompression")
+}
+
+/*Recognized by its extension so the files are not read as plain text, but there is no zstd implementation*/
+type iZstdCodec struct {
+}
+
+func (this *iZstdCodec) Name() string {
+	return "zstd"
+}
+
+func (this *iZstdCodec) Extension() string {
+	return ".zst"
+}
+
+func (this *iZstdCodec) Splittable() bool {
+	return false
+}
+
+func (this *iZstdCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return nil, errors.New("ifs: zstd codec is not available")
+}
+
+func (this *iZstdCodec) NewWriter(w io.Writer) (io.WriteCloser, error) {
+	return nil, errors.New("ifs: zstd codec is not available")
+}
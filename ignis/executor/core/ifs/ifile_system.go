@@ -0,0 +1,107 @@
+package ifs
+
+import (
+	"errors"
+	"io"
+	"path"
+	"sort"
+	"strings"
+)
+
+var ErrNotExist = errors.New("ifs: file does not exist")
+
+type IFileInfo struct {
+	Size int64
+	Dir  bool
+}
+
+type IFileEntry struct {
+	Path string
+	Size int64
+}
+
+type IFileReader interface {
+	io.ReadSeekCloser
+}
+
+type IFileSystem interface {
+	Scheme() string
+	Stat(path string) (*IFileInfo, error)
+	List(path string) ([]IFileEntry, error)
+	Open(path string) (IFileReader, error)
+	Create(path string) (io.WriteCloser, error)
+	MkdirAll(path string) error
+	Remove(path string) error
+	Rename(src string, dst string) error
+}
+
+/*File systems that know the hosts storing a file implement it, so readers can schedule the file close to its data*/
+type IFileLocality interface {
+	Hosts(path string) ([]string, error)
+}
+
+func HasGlob(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+/*
+Files matching the pattern sorted by path, the files inside a matching directory are included recursively. Files
+and folders starting with '.' or '_', like the markers of finished jobs or the temporary files of running jobs, are
+skipped.
+*/
+func Glob(fsys IFileSystem, pattern string) ([]IFileEntry, error) {
+	root := pattern
+	if i := strings.IndexAny(pattern, "*?["); i >= 0 {
+		root = pattern[:strings.LastIndexByte(pattern[:i], '/')+1]
+	}
+	entries, err := fsys.List(root)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]IFileEntry, 0, len(entries))
+	for _, entry := range entries {
+		if hidden(strings.TrimPrefix(entry.Path, root)) {
+			continue
+		}
+		if HasGlob(pattern) {
+			matched := false
+			for i := len(root); i <= len(entry.Path) && !matched; i++ {
+				if i == len(entry.Path) || entry.Path[i] == '/' {
+					if matched, err = path.Match(pattern, entry.Path[:i]); err != nil {
+						return nil, err
+					}
+				}
+			}
+			if !matched {
+				continue
+			}
+		}
+		files = append(files, entry)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, nil
+}
+
+/*Files and folders starting with '.' or '_' hold metadata or files still being written*/
+func hidden(path string) bool {
+	for _, name := range strings.Split(path, "/") {
+		if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+			return true
+		}
+	}
+	return false
+}
+
+func Scheme(path string) string {
+	if i := strings.Index(path, "://"); i > 0 {
+		return path[:i]
+	}
+	return ""
+}
+
+func IsLocal(path string) bool {
+	scheme := Scheme(path)
+	return scheme == "" || scheme == "file"
+}
@@ -0,0 +1,37 @@
+package ifs
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGlob(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.csv", "_SUCCESS", "logs/2024/c.txt", "logs/2025/d.txt", "other/.e.txt",
+		"logs/_temporary/attempt0/f.txt"} {
+		require.Nil(t, os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), os.ModePerm))
+		require.Nil(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0644))
+	}
+	fsys := NewILocalFileSystem()
+	paths := func(pattern string) []string {
+		entries, err := Glob(fsys, pattern)
+		require.Nil(t, err)
+		result := []string{}
+		for _, entry := range entries {
+			require.Equal(t, int64(len(entry.Path)-len(dir)-1), entry.Size)
+			result = append(result, entry.Path[len(dir)+1:])
+		}
+		return result
+	}
+
+	require.Equal(t, []string{"a.txt"}, paths(dir+"/*.txt"))
+	require.Equal(t, []string{"logs/2024/c.txt", "logs/2025/d.txt"}, paths(dir+"/logs"))
+	require.Equal(t, []string{"logs/2025/d.txt"}, paths(dir+"/logs/*5"))
+	require.Equal(t, []string{"a.txt", "b.csv", "logs/2024/c.txt", "logs/2025/d.txt"}, paths(dir))
+	require.Equal(t, []string{}, paths(dir+"/other/*"))
+
+	_, err := Glob(fsys, dir+"/missing")
+	require.Equal(t, ErrNotExist, err)
+}
@@ -0,0 +1,83 @@
+package ifs
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type ILocalFileSystem struct {
+}
+
+func NewILocalFileSystem() *ILocalFileSystem {
+	return &ILocalFileSystem{}
+}
+
+func (this *ILocalFileSystem) path(path string) string {
+	return strings.TrimPrefix(path, "file://")
+}
+
+func (this *ILocalFileSystem) Scheme() string {
+	return "file"
+}
+
+func (this *ILocalFileSystem) Stat(path string) (*IFileInfo, error) {
+	info, err := os.Stat(this.path(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotExist
+	} else if err != nil {
+		return nil, err
+	}
+	return &IFileInfo{info.Size(), info.IsDir()}, nil
+}
+
+/*Regular files inside path, recursively*/
+func (this *ILocalFileSystem) List(path string) ([]IFileEntry, error) {
+	root := this.path(path)
+	prefix := path[:len(path)-len(root)]
+	if root == "" {
+		root = "."
+	}
+	var entries []IFileEntry
+	err := filepath.WalkDir(root, func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, IFileEntry{prefix + file, info.Size()})
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotExist
+	}
+	return entries, err
+}
+
+func (this *ILocalFileSystem) Open(path string) (IFileReader, error) {
+	return os.Open(this.path(path))
+}
+
+func (this *ILocalFileSystem) Create(path string) (io.WriteCloser, error) {
+	return os.Create(this.path(path))
+}
+
+func (this *ILocalFileSystem) MkdirAll(path string) error {
+	return os.MkdirAll(this.path(path), os.ModePerm)
+}
+
+func (this *ILocalFileSystem) Remove(path string) error {
+	return os.Remove(this.path(path))
+}
+
+func (this *ILocalFileSystem) Rename(src string, dst string) error {
+	return os.Rename(this.path(src), this.path(dst))
+}
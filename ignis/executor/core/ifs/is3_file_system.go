@@ -0,0 +1,513 @@
+package ifs
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type IS3Config struct {
+	Endpoint  string
+	Region    string
+	AccessKey string
+	SecretKey string
+	PartSize  int64
+}
+
+type IS3FileSystem struct {
+	config IS3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewIS3FileSystem(config IS3Config) *IS3FileSystem {
+	config.Endpoint = strings.TrimSuffix(config.Endpoint, "/")
+	return &IS3FileSystem{
+		config: config,
+		client: http.DefaultClient,
+		now:    time.Now,
+	}
+}
+
+func (this *IS3FileSystem) Scheme() string {
+	return "s3"
+}
+
+func (this *IS3FileSystem) split(path string) (string, string, error) {
+	if !strings.HasPrefix(path, "s3://") {
+		return "", "", errors.New("ifs: " + path + " is not a s3 uri")
+	}
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(path, "s3://"), "/")
+	if bucket == "" {
+		return "", "", errors.New("ifs: " + path + " has no bucket")
+	}
+	return bucket, key, nil
+}
+
+func s3Escape(s string, slash bool) string {
+	s = strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+	if slash {
+		s = strings.ReplaceAll(s, "%2F", "/")
+	}
+	return s
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func (this *IS3FileSystem) request(method string, bucket string, key string, query map[string]string,
+	header http.Header, body []byte) (*http.Response, error) {
+	uri := "/" + bucket
+	if key != "" {
+		uri += "/" + s3Escape(key, true)
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	params := make([]string, len(names))
+	for i, name := range names {
+		params[i] = s3Escape(name, false) + "=" + s3Escape(query[name], false)
+	}
+	rawQuery := strings.Join(params, "&")
+
+	req, err := http.NewRequest(method, this.config.Endpoint+uri+"?"+rawQuery, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	req.ContentLength = int64(len(body))
+
+	if this.config.AccessKey != "" {
+		now := this.now().UTC()
+		amzDate := now.Format("20060102T150405Z")
+		date := amzDate[:8]
+		payload := sha256Hex(body)
+		req.Header.Set("x-amz-content-sha256", payload)
+		req.Header.Set("x-amz-date", amzDate)
+		amzHeaders := []string{"x-amz-content-sha256", "x-amz-date"}
+		for name := range header {
+			if name = strings.ToLower(name); strings.HasPrefix(name, "x-amz-") {
+				amzHeaders = append(amzHeaders, name)
+			}
+		}
+		sort.Strings(amzHeaders)
+		signed := "host;" + strings.Join(amzHeaders, ";")
+		headers := "host:" + req.URL.Host + "\n"
+		for _, name := range amzHeaders {
+			headers += name + ":" + strings.TrimSpace(req.Header.Get(name)) + "\n"
+		}
+		canonical := strings.Join([]string{
+			method,
+			uri,
+			rawQuery,
+			headers,
+			signed,
+			payload,
+		}, "\n")
+		scope := date + "/" + this.config.Region + "/s3/aws4_request"
+		toSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonical))
+		signingKey := hmacSHA256([]byte("AWS4"+this.config.SecretKey), date)
+		signingKey = hmacSHA256(signingKey, this.config.Region)
+		signingKey = hmacSHA256(signingKey, "s3")
+		signingKey = hmacSHA256(signingKey, "aws4_request")
+		req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+this.config.AccessKey+"/"+scope+
+			", SignedHeaders="+signed+", Signature="+hex.EncodeToString(hmacSHA256(signingKey, toSign)))
+	}
+
+	resp, err := this.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, ErrNotExist
+		}
+		return nil, errors.New("ifs: s3 " + method + " " + bucket + "/" + key + " failed with " + resp.Status + " " + string(msg))
+	}
+	return resp, nil
+}
+
+func (this *IS3FileSystem) call(method string, bucket string, key string, query map[string]string, body []byte) ([]byte, http.Header, error) {
+	resp, err := this.request(method, bucket, key, query, nil, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	return data, resp.Header, err
+}
+
+func (this *IS3FileSystem) Stat(path string) (*IFileInfo, error) {
+	bucket, key, err := this.split(path)
+	if err != nil {
+		return nil, err
+	}
+	if key != "" && !strings.HasSuffix(key, "/") {
+		resp, err := this.request(http.MethodHead, bucket, key, nil, nil, nil)
+		if err == nil {
+			resp.Body.Close()
+			return &IFileInfo{resp.ContentLength, false}, nil
+		} else if err != ErrNotExist {
+			return nil, err
+		}
+	}
+	prefix := key
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	data, _, err := this.call(http.MethodGet, bucket, "", map[string]string{"list-type": "2", "prefix": prefix, "max-keys": "1"}, nil)
+	if err != nil {
+		return nil, err
+	}
+	var result struct {
+		KeyCount int
+	}
+	if err = xml.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	if result.KeyCount == 0 && key != "" {
+		return nil, ErrNotExist
+	}
+	return &IFileInfo{0, true}, nil
+}
+
+/*Objects whose key starts with the key of path*/
+func (this *IS3FileSystem) List(path string) ([]IFileEntry, error) {
+	bucket, key, err := this.split(path)
+	if err != nil {
+		return nil, err
+	}
+	var entries []IFileEntry
+	query := map[string]string{"list-type": "2", "prefix": key}
+	for {
+		data, _, err := this.call(http.MethodGet, bucket, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+		var result struct {
+			Contents []struct {
+				Key  string
+				Size int64
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		if err = xml.Unmarshal(data, &result); err != nil {
+			return nil, err
+		}
+		for _, object := range result.Contents {
+			inside := key == "" || strings.HasSuffix(key, "/") || object.Key == key || strings.HasPrefix(object.Key, key+"/")
+			if inside && !strings.HasSuffix(object.Key, "/") {
+				entries = append(entries, IFileEntry{"s3://" + bucket + "/" + object.Key, object.Size})
+			}
+		}
+		if !result.IsTruncated {
+			break
+		}
+		query["continuation-token"] = result.NextContinuationToken
+	}
+	if len(entries) == 0 && key != "" {
+		if _, err := this.Stat(path); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+func (this *IS3FileSystem) Open(path string) (IFileReader, error) {
+	info, err := this.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Dir {
+		return nil, errors.New("ifs: " + path + " is a directory")
+	}
+	bucket, key, _ := this.split(path)
+	return &iS3Reader{fs: this, bucket: bucket, key: key, size: info.Size}, nil
+}
+
+func (this *IS3FileSystem) Create(path string) (io.WriteCloser, error) {
+	bucket, key, err := this.split(path)
+	if err != nil {
+		return nil, err
+	}
+	return &iS3Writer{fs: this, bucket: bucket, key: key}, nil
+}
+
+func (this *IS3FileSystem) MkdirAll(path string) error {
+	_, _, err := this.split(path)
+	return err
+}
+
+func (this *IS3FileSystem) Remove(path string) error {
+	bucket, key, err := this.split(path)
+	if err != nil {
+		return err
+	}
+	_, _, err = this.call(http.MethodDelete, bucket, key, nil, nil)
+	return err
+}
+
+/*Largest object that a single copy can create, larger objects are copied in parts of this size*/
+var s3MaxCopySize = int64(5) << 30
+
+/*S3 has no rename, the object is copied and the source is only removed when the copy succeeded*/
+func (this *IS3FileSystem) Rename(src string, dst string) error {
+	srcBucket, srcKey, err := this.split(src)
+	if err != nil {
+		return err
+	}
+	bucket, key, err := this.split(dst)
+	if err != nil {
+		return err
+	}
+	info, err := this.Stat(src)
+	if err != nil {
+		return err
+	}
+	if info.Dir {
+		return errors.New("ifs: " + src + " is a directory")
+	}
+	source := "/" + srcBucket + "/" + s3Escape(srcKey, true)
+	if info.Size <= s3MaxCopySize {
+		header := http.Header{}
+		header.Set("x-amz-copy-source", source)
+		if _, err = this.copy(bucket, key, nil, header); err != nil {
+			return err
+		}
+	} else if err = this.copyParts(source, info.Size, bucket, key); err != nil {
+		return err
+	}
+	return this.Remove(src)
+}
+
+/*A copy can answer 200 OK with an <Error> body when it fails after it started, the ETag is returned on success*/
+func (this *IS3FileSystem) copy(bucket string, key string, query map[string]string, header http.Header) (string, error) {
+	resp, err := this.request(http.MethodPut, bucket, key, query, header, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return "", nil
+	}
+	var result struct {
+		XMLName xml.Name
+		ETag    string
+		Code    string
+		Message string
+	}
+	if err = xml.Unmarshal(data, &result); err != nil {
+		return "", err
+	}
+	if result.XMLName.Local == "Error" {
+		return "", errors.New("ifs: s3 copy to " + bucket + "/" + key + " failed with " + result.Code + " " + result.Message)
+	}
+	return result.ETag, nil
+}
+
+/*Copies an object larger than a single copy allows with a multipart upload whose parts are ranges of the source*/
+func (this *IS3FileSystem) copyParts(source string, size int64, bucket string, key string) error {
+	writer := &iS3Writer{fs: this, bucket: bucket, key: key}
+	result, _, err := this.call(http.MethodPost, bucket, key, map[string]string{"uploads": ""}, nil)
+	if err != nil {
+		return err
+	}
+	var initiate struct {
+		UploadId string
+	}
+	if err = xml.Unmarshal(result, &initiate); err != nil {
+		return err
+	}
+	writer.uploadId = initiate.UploadId
+	for offset := int64(0); offset < size; offset += s3MaxCopySize {
+		number := len(writer.parts) + 1
+		end := offset + s3MaxCopySize
+		if end > size {
+			end = size
+		}
+		header := http.Header{}
+		header.Set("x-amz-copy-source", source)
+		header.Set("x-amz-copy-source-range", "bytes="+strconv.FormatInt(offset, 10)+"-"+
+			strconv.FormatInt(end-1, 10))
+		etag, err := this.copy(bucket, key, map[string]string{
+			"partNumber": strconv.Itoa(number),
+			"uploadId":   writer.uploadId,
+		}, header)
+		if err != nil {
+			return writer.abort(err)
+		}
+		writer.parts = append(writer.parts, iS3Part{number, etag})
+	}
+	return writer.Close()
+}
+
+type iS3Reader struct {
+	fs     *IS3FileSystem
+	bucket string
+	key    string
+	size   int64
+	pos    int64
+	body   io.ReadCloser
+}
+
+func (this *iS3Reader) Read(p []byte) (int, error) {
+	if this.pos >= this.size {
+		return 0, io.EOF
+	}
+	if this.body == nil {
+		header := http.Header{}
+		header.Set("Range", "bytes="+strconv.FormatInt(this.pos, 10)+"-")
+		resp, err := this.fs.request(http.MethodGet, this.bucket, this.key, nil, header, nil)
+		if err != nil {
+			return 0, err
+		}
+		this.body = resp.Body
+	}
+	n, err := this.body.Read(p)
+	this.pos += int64(n)
+	if err == io.EOF {
+		this.body.Close()
+		this.body = nil
+		if this.pos < this.size {
+			err = nil
+		}
+	}
+	return n, err
+}
+
+func (this *iS3Reader) Seek(offset int64, whence int) (int64, error) {
+	pos := offset
+	if whence == io.SeekCurrent {
+		pos += this.pos
+	} else if whence == io.SeekEnd {
+		pos += this.size
+	}
+	if pos < 0 {
+		return this.pos, errors.New("ifs: negative position")
+	}
+	if pos != this.pos && this.body != nil {
+		this.body.Close()
+		this.body = nil
+	}
+	this.pos = pos
+	return pos, nil
+}
+
+func (this *iS3Reader) Close() error {
+	if this.body != nil {
+		err := this.body.Close()
+		this.body = nil
+		return err
+	}
+	return nil
+}
+
+type iS3Part struct {
+	PartNumber int
+	ETag       string
+}
+
+type iS3Writer struct {
+	fs       *IS3FileSystem
+	bucket   string
+	key      string
+	buffer   []byte
+	uploadId string
+	parts    []iS3Part
+}
+
+func (this *iS3Writer) Write(p []byte) (int, error) {
+	this.buffer = append(this.buffer, p...)
+	for this.fs.config.PartSize > 0 && int64(len(this.buffer)) >= this.fs.config.PartSize {
+		if err := this.upload(this.buffer[:this.fs.config.PartSize]); err != nil {
+			return 0, err
+		}
+		this.buffer = append(this.buffer[:0], this.buffer[this.fs.config.PartSize:]...)
+	}
+	return len(p), nil
+}
+
+func (this *iS3Writer) upload(data []byte) error {
+	if this.uploadId == "" {
+		result, _, err := this.fs.call(http.MethodPost, this.bucket, this.key, map[string]string{"uploads": ""}, nil)
+		if err != nil {
+			return err
+		}
+		var initiate struct {
+			UploadId string
+		}
+		if err = xml.Unmarshal(result, &initiate); err != nil {
+			return err
+		}
+		this.uploadId = initiate.UploadId
+	}
+	number := len(this.parts) + 1
+	_, header, err := this.fs.call(http.MethodPut, this.bucket, this.key, map[string]string{
+		"partNumber": strconv.Itoa(number),
+		"uploadId":   this.uploadId,
+	}, data)
+	if err != nil {
+		return err
+	}
+	this.parts = append(this.parts, iS3Part{number, header.Get("ETag")})
+	return nil
+}
+
+func (this *iS3Writer) Close() error {
+	if this.uploadId == "" {
+		_, _, err := this.fs.call(http.MethodPut, this.bucket, this.key, nil, this.buffer)
+		this.buffer = nil
+		return err
+	}
+	if len(this.buffer) > 0 {
+		if err := this.upload(this.buffer); err != nil {
+			return this.abort(err)
+		}
+		this.buffer = nil
+	}
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name  `xml:"CompleteMultipartUpload"`
+		Parts   []iS3Part `xml:"Part"`
+	}{Parts: this.parts})
+	if err != nil {
+		return this.abort(err)
+	}
+	if _, _, err = this.fs.call(http.MethodPost, this.bucket, this.key, map[string]string{"uploadId": this.uploadId}, body); err != nil {
+		return this.abort(err)
+	}
+	return nil
+}
+
+func (this *iS3Writer) abort(cause error) error {
+	_, _, _ = this.fs.call(http.MethodDelete, this.bucket, this.key, map[string]string{"uploadId": this.uploadId}, nil)
+	return cause
+}
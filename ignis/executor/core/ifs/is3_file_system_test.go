@@ -0,0 +1,218 @@
+package ifs
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type fakeS3 struct {
+	mu        sync.Mutex
+	objects   map[string][]byte
+	uploads   map[string]map[int][]byte
+	copyError bool
+}
+
+func (this *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+	switch {
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		count := 0
+		for key := range this.objects {
+			if strings.HasPrefix(key, name+"/"+query.Get("prefix")) {
+				count++
+			}
+		}
+		io.WriteString(w, "<ListBucketResult><KeyCount>"+strconv.Itoa(count)+"</KeyCount></ListBucketResult>")
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		id := strconv.Itoa(len(this.uploads) + 1)
+		this.uploads[id] = map[int][]byte{}
+		io.WriteString(w, "<InitiateMultipartUploadResult><UploadId>"+id+"</UploadId></InitiateMultipartUploadResult>")
+	case r.Method == http.MethodPut && r.Header.Get("x-amz-copy-source") != "":
+		if !strings.Contains(r.Header.Get("Authorization"), "x-amz-copy-source") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		data, ok := this.objects[strings.TrimPrefix(r.Header.Get("x-amz-copy-source"), "/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if this.copyError {
+			io.WriteString(w, "<Error><Code>InternalError</Code><Message>copy failed</Message></Error>")
+			return
+		}
+		if query.Has("uploadId") {
+			var start, end int
+			fmt.Sscanf(r.Header.Get("x-amz-copy-source-range"), "bytes=%d-%d", &start, &end)
+			number, _ := strconv.Atoi(query.Get("partNumber"))
+			this.uploads[query.Get("uploadId")][number] = data[start : end+1]
+			io.WriteString(w, "<CopyPartResult><ETag>\"etag"+query.Get("partNumber")+"\"</ETag></CopyPartResult>")
+			return
+		}
+		this.objects[name] = data
+		io.WriteString(w, "<CopyObjectResult><ETag>\"etag\"</ETag></CopyObjectResult>")
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		this.uploads[query.Get("uploadId")][number] = body
+		w.Header().Set("ETag", "\"etag"+query.Get("partNumber")+"\"")
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		var complete struct {
+			Parts []iS3Part `xml:"Part"`
+		}
+		_ = xml.Unmarshal(body, &complete)
+		parts := this.uploads[query.Get("uploadId")]
+		numbers := make([]int, 0, len(parts))
+		for number := range parts {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+		if len(numbers) != len(complete.Parts) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		this.objects[name] = bytes.Join(func() [][]byte {
+			result := make([][]byte, len(numbers))
+			for i, number := range numbers {
+				result[i] = parts[number]
+			}
+			return result
+		}(), nil)
+	case r.Method == http.MethodPut:
+		this.objects[name] = body
+	case r.Method == http.MethodDelete:
+		delete(this.objects, name)
+	default:
+		data, ok := this.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if start := r.Header.Get("Range"); start != "" {
+			offset, _ := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(start, "bytes="), "-"))
+			data = data[offset:]
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	}
+}
+
+func TestS3FileSystem(t *testing.T) {
+	server := httptest.NewServer(&fakeS3{objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}})
+	defer server.Close()
+	fsys := NewIS3FileSystem(IS3Config{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		AccessKey: "access",
+		SecretKey: "secret",
+		PartSize:  1000,
+	})
+
+	_, err := fsys.Stat("s3://bucket/data/part000000")
+	require.Equal(t, ErrNotExist, err)
+
+	data := make([]byte, 2500)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	for _, sz := range []int{10, len(data)} {
+		writer, err := fsys.Create("s3://bucket/data/part000000")
+		require.Nil(t, err)
+		_, err = writer.Write(data[:sz])
+		require.Nil(t, err)
+		require.Nil(t, writer.Close())
+
+		info, err := fsys.Stat("s3://bucket/data/part000000")
+		require.Nil(t, err)
+		require.Equal(t, IFileInfo{int64(sz), false}, *info)
+
+		reader, err := fsys.Open("s3://bucket/data/part000000")
+		require.Nil(t, err)
+		result, err := io.ReadAll(reader)
+		require.Nil(t, err)
+		require.Equal(t, data[:sz], result)
+		_, err = reader.Seek(5, io.SeekStart)
+		require.Nil(t, err)
+		result, err = io.ReadAll(reader)
+		require.Nil(t, err)
+		require.Equal(t, data[5:sz], result)
+		require.Nil(t, reader.Close())
+	}
+
+	info, err := fsys.Stat("s3://bucket/data")
+	require.Nil(t, err)
+	require.True(t, info.Dir)
+
+	require.Nil(t, fsys.Rename("s3://bucket/data/part000000", "s3://bucket/data/part000001"))
+	_, err = fsys.Stat("s3://bucket/data/part000000")
+	require.Equal(t, ErrNotExist, err)
+	info, err = fsys.Stat("s3://bucket/data/part000001")
+	require.Nil(t, err)
+	require.Equal(t, IFileInfo{int64(len(data)), false}, *info)
+
+	require.Nil(t, fsys.Remove("s3://bucket/data/part000001"))
+	_, err = fsys.Stat("s3://bucket/data")
+	require.Equal(t, ErrNotExist, err)
+}
+
+func TestS3FileSystemRenameError(t *testing.T) {
+	fake := &fakeS3{objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}, copyError: true}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	fsys := NewIS3FileSystem(IS3Config{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	fake.objects["bucket/data/part000000"] = []byte("data")
+
+	require.NotNil(t, fsys.Rename("s3://bucket/data/part000000", "s3://bucket/data/part000001"))
+	info, err := fsys.Stat("s3://bucket/data/part000000")
+	require.Nil(t, err)
+	require.Equal(t, IFileInfo{4, false}, *info)
+}
+
+func TestS3FileSystemRenameParts(t *testing.T) {
+	defer func(size int64) { s3MaxCopySize = size }(s3MaxCopySize)
+	s3MaxCopySize = 1000
+	fake := &fakeS3{objects: map[string][]byte{}, uploads: map[string]map[int][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+	fsys := NewIS3FileSystem(IS3Config{
+		Endpoint:  server.URL,
+		Region:    "us-east-1",
+		AccessKey: "access",
+		SecretKey: "secret",
+	})
+	data := make([]byte, 2500)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	fake.objects["bucket/data/part000000"] = data
+
+	require.Nil(t, fsys.Rename("s3://bucket/data/part000000", "s3://bucket/data/part000001"))
+	_, err := fsys.Stat("s3://bucket/data/part000000")
+	require.Equal(t, ErrNotExist, err)
+	require.Equal(t, data, fake.objects["bucket/data/part000001"])
+	require.Equal(t, 3, len(fake.uploads["1"]))
+}
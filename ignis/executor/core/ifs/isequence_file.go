@@ -0,0 +1,748 @@
+package ifs
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"reflect"
+)
+
+const (
+	sequenceVersion      = 6
+	sequenceSyncSize     = 16
+	sequenceSyncEscape   = -1
+	sequenceSyncInterval = 100 * (sequenceSyncSize + 4)
+	sequenceBlockSize    = 1000000
+
+	WritableText    = "org.apache.hadoop.io.Text"
+	WritableBytes   = "org.apache.hadoop.io.BytesWritable"
+	WritableInt     = "org.apache.hadoop.io.IntWritable"
+	WritableLong    = "org.apache.hadoop.io.LongWritable"
+	WritableVInt    = "org.apache.hadoop.io.VIntWritable"
+	WritableVLong   = "org.apache.hadoop.io.VLongWritable"
+	WritableFloat   = "org.apache.hadoop.io.FloatWritable"
+	WritableDouble  = "org.apache.hadoop.io.DoubleWritable"
+	WritableBoolean = "org.apache.hadoop.io.BooleanWritable"
+	WritableByte    = "org.apache.hadoop.io.ByteWritable"
+	WritableNull    = "org.apache.hadoop.io.NullWritable"
+
+	sequenceDefaultCodec = "org.apache.hadoop.io.compress.DefaultCodec"
+	sequenceGzipCodec    = "org.apache.hadoop.io.compress.GzipCodec"
+)
+
+var errSequenceCorrupt = errors.New("ifs: corrupt sequence file")
+
+/*
+Reads the records of a Hadoop SequenceFile that belong to a byte range. A range starts at the first sync marker at or
+after its beginning, the header counts as the sync of the first records, and ends before the first sync marker at or
+after its end, so every record is read by exactly one range. Uncompressed, record compressed and block compressed
+files are supported with the zlib and gzip codecs. Values of the common writables are decoded to Go values, other
+classes are returned as their serialized bytes.
+*/
+type ISequenceReader struct {
+	reader     *bufio.Reader
+	pos        int64
+	end        int64
+	keyClass   string
+	valueClass string
+	compressed bool
+	block      bool
+	codec      string
+	sync       [sequenceSyncSize]byte
+	done       bool
+	afterSync  bool
+	keys       [][]byte
+	values     [][]byte
+	next       int
+}
+
+func NewISequenceReader(file IFileReader, init int64, end int64) (*ISequenceReader, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	this := &ISequenceReader{reader: bufio.NewReaderSize(file, 64*1024), end: end}
+	if err := this.header(); err != nil {
+		return nil, err
+	}
+	if headerSync := this.pos - sequenceSyncSize; init <= headerSync {
+		this.done = headerSync >= end
+		return this, nil
+	}
+	if _, err := file.Seek(init, io.SeekStart); err != nil {
+		return nil, err
+	}
+	this.reader.Reset(file)
+	this.pos = init
+	q, err := this.seekSync()
+	if err != nil {
+		return nil, err
+	}
+	this.done = q < 0 || q >= end
+	this.afterSync = true
+	return this, nil
+}
+
+func (this *ISequenceReader) KeyClass() string {
+	return this.keyClass
+}
+
+func (this *ISequenceReader) ValueClass() string {
+	return this.valueClass
+}
+
+func (this *ISequenceReader) header() error {
+	magic := make([]byte, 4)
+	if err := this.readFull(magic); err != nil {
+		return err
+	}
+	if string(magic[:3]) != "SEQ" {
+		return errors.New("ifs: not a sequence file")
+	}
+	version := magic[3]
+	if version < 4 || version > sequenceVersion {
+		return errors.New("ifs: sequence file version " + string('0'+rune(version)) + " is not supported")
+	}
+	var err error
+	if this.keyClass, err = this.readText(); err != nil {
+		return err
+	}
+	if this.valueClass, err = this.readText(); err != nil {
+		return err
+	}
+	if this.compressed, err = this.readBool(); err != nil {
+		return err
+	}
+	if this.block, err = this.readBool(); err != nil {
+		return err
+	}
+	if this.compressed {
+		this.codec = sequenceDefaultCodec
+		if version >= 5 {
+			if this.codec, err = this.readText(); err != nil {
+				return err
+			}
+		}
+		if this.codec != sequenceDefaultCodec && this.codec != sequenceGzipCodec {
+			return errors.New("ifs: sequence file codec " + this.codec + " is not supported")
+		}
+	}
+	if version >= 6 {
+		n, err := this.readInt32()
+		if err != nil {
+			return err
+		}
+		for i := int32(0); i < 2*n; i++ {
+			if _, err = this.readText(); err != nil {
+				return err
+			}
+		}
+	}
+	return this.readFull(this.sync[:])
+}
+
+/*Position of the first sync marker from the current position, -1 if there is none*/
+func (this *ISequenceReader) seekSync() (int64, error) {
+	window := make([]byte, 0, sequenceSyncSize)
+	for {
+		c, err := this.reader.ReadByte()
+		if err == io.EOF {
+			return -1, nil
+		} else if err != nil {
+			return -1, err
+		}
+		this.pos++
+		if len(window) == sequenceSyncSize {
+			copy(window, window[1:])
+			window = window[:sequenceSyncSize-1]
+		}
+		window = append(window, c)
+		if len(window) == sequenceSyncSize && bytes.Equal(window, this.sync[:]) {
+			return this.pos - sequenceSyncSize, nil
+		}
+	}
+}
+
+/*Returns false when the range has no more records*/
+func (this *ISequenceReader) Next() (any, any, bool, error) {
+	key, value, ok, err := this.NextRaw()
+	if !ok || err != nil {
+		return nil, nil, ok, err
+	}
+	k, err := DecodeWritable(this.keyClass, key)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	v, err := DecodeWritable(this.valueClass, value)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	return k, v, true, nil
+}
+
+/*Serialized key and value of the next record*/
+func (this *ISequenceReader) NextRaw() ([]byte, []byte, bool, error) {
+	for !this.done {
+		if this.block {
+			if this.next < len(this.keys) {
+				this.next++
+				return this.keys[this.next-1], this.values[this.next-1], true, nil
+			}
+			if err := this.readBlock(); err != nil {
+				return nil, nil, false, err
+			}
+			continue
+		}
+		length, err := this.readInt32()
+		if err == io.EOF {
+			this.done = true
+			break
+		} else if err != nil {
+			return nil, nil, false, err
+		}
+		if length == sequenceSyncEscape {
+			if err = this.readSync(); err != nil {
+				return nil, nil, false, err
+			}
+			continue
+		}
+		keyLength, err := this.readInt32()
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if keyLength < 0 || keyLength > length {
+			return nil, nil, false, errSequenceCorrupt
+		}
+		record := make([]byte, length)
+		if err = this.readFull(record); err != nil {
+			return nil, nil, false, err
+		}
+		value := record[keyLength:]
+		if this.compressed {
+			if value, err = this.decompress(value); err != nil {
+				return nil, nil, false, err
+			}
+		}
+		return record[:keyLength], value, true, nil
+	}
+	return nil, nil, false, nil
+}
+
+/*Reads the sync marker after an escape, the range ends at the first marker at or after its end*/
+func (this *ISequenceReader) readSync() error {
+	q := this.pos
+	sync := make([]byte, sequenceSyncSize)
+	if err := this.readFull(sync); err != nil {
+		return err
+	}
+	if !bytes.Equal(sync, this.sync[:]) {
+		return errSequenceCorrupt
+	}
+	this.done = q >= this.end
+	return nil
+}
+
+func (this *ISequenceReader) readBlock() error {
+	if !this.afterSync {
+		escape, err := this.readInt32()
+		if err == io.EOF {
+			this.done = true
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if escape != sequenceSyncEscape {
+			return errSequenceCorrupt
+		}
+		if err = this.readSync(); err != nil || this.done {
+			return err
+		}
+	}
+	this.afterSync = false
+	records, err := this.readVLong()
+	if err != nil {
+		return err
+	}
+	buffers := make([][]byte, 4)
+	for i := range buffers {
+		n, err := this.readVLong()
+		if err != nil {
+			return err
+		}
+		if n < 0 || n > math.MaxInt32 {
+			return errSequenceCorrupt
+		}
+		data := make([]byte, n)
+		if err = this.readFull(data); err != nil {
+			return err
+		}
+		if buffers[i], err = this.decompress(data); err != nil {
+			return err
+		}
+	}
+	if this.keys, err = splitLengths(buffers[0], buffers[1], records); err != nil {
+		return err
+	}
+	if this.values, err = splitLengths(buffers[2], buffers[3], records); err != nil {
+		return err
+	}
+	this.next = 0
+	return nil
+}
+
+/*Cuts data in the records whose lengths are written as vints*/
+func splitLengths(lengths []byte, data []byte, records int64) ([][]byte, error) {
+	reader := bytes.NewReader(lengths)
+	result := make([][]byte, 0, records)
+	for i := int64(0); i < records; i++ {
+		n, err := ReadVLong(reader)
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 || n > int64(len(data)) {
+			return nil, errSequenceCorrupt
+		}
+		result = append(result, data[:n:n])
+		data = data[n:]
+	}
+	return result, nil
+}
+
+func (this *ISequenceReader) decompress(data []byte) ([]byte, error) {
+	var decoder io.ReadCloser
+	var err error
+	if this.codec == sequenceGzipCodec {
+		decoder, err = gzip.NewReader(bytes.NewReader(data))
+	} else {
+		decoder, err = zlib.NewReader(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer decoder.Close()
+	return io.ReadAll(decoder)
+}
+
+func (this *ISequenceReader) readFull(data []byte) error {
+	n, err := io.ReadFull(this.reader, data)
+	this.pos += int64(n)
+	if err == io.ErrUnexpectedEOF {
+		return errSequenceCorrupt
+	}
+	return err
+}
+
+func (this *ISequenceReader) readInt32() (int32, error) {
+	var data [4]byte
+	if err := this.readFull(data[:]); err != nil {
+		return 0, err
+	}
+	return int32(binary.BigEndian.Uint32(data[:])), nil
+}
+
+func (this *ISequenceReader) readBool() (bool, error) {
+	c, err := this.reader.ReadByte()
+	if err != nil {
+		return false, err
+	}
+	this.pos++
+	return c != 0, nil
+}
+
+func (this *ISequenceReader) readVLong() (int64, error) {
+	counter := &iCountingByteReader{reader: this.reader}
+	n, err := ReadVLong(counter)
+	this.pos += counter.n
+	return n, err
+}
+
+func (this *ISequenceReader) readText() (string, error) {
+	n, err := this.readVLong()
+	if err != nil {
+		return "", err
+	}
+	if n < 0 || n > math.MaxInt32 {
+		return "", errSequenceCorrupt
+	}
+	data := make([]byte, n)
+	if err = this.readFull(data); err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+type iCountingByteReader struct {
+	reader io.ByteReader
+	n      int64
+}
+
+func (this *iCountingByteReader) ReadByte() (byte, error) {
+	c, err := this.reader.ReadByte()
+	if err == nil {
+		this.n++
+	}
+	return c, err
+}
+
+/*
+Writes a Hadoop SequenceFile, block compressed with zlib when level is not zero. The classes of the keys and values
+are fixed by the header, see WritableClass.
+*/
+type ISequenceWriter struct {
+	writer     *bufio.Writer
+	pos        int64
+	lastSync   int64
+	keyClass   string
+	valueClass string
+	level      int
+	sync       [sequenceSyncSize]byte
+	records    int64
+	keyLengths bytes.Buffer
+	keys       bytes.Buffer
+	valLengths bytes.Buffer
+	values     bytes.Buffer
+	scratch    bytes.Buffer
+}
+
+func NewISequenceWriter(w io.Writer, keyClass string, valueClass string, level int) (*ISequenceWriter, error) {
+	this := &ISequenceWriter{
+		writer:     bufio.NewWriterSize(w, 64*1024),
+		keyClass:   keyClass,
+		valueClass: valueClass,
+		level:      level,
+	}
+	if _, err := rand.Read(this.sync[:]); err != nil {
+		return nil, err
+	}
+	var header bytes.Buffer
+	header.WriteString("SEQ")
+	header.WriteByte(sequenceVersion)
+	writeText(&header, keyClass)
+	writeText(&header, valueClass)
+	compressed := byte(0)
+	if level != 0 {
+		compressed = 1
+	}
+	header.WriteByte(compressed)
+	header.WriteByte(compressed)
+	if level != 0 {
+		writeText(&header, sequenceDefaultCodec)
+	}
+	header.Write([]byte{0, 0, 0, 0})
+	header.Write(this.sync[:])
+	if err := this.write(header.Bytes()); err != nil {
+		return nil, err
+	}
+	this.lastSync = this.pos
+	return this, nil
+}
+
+func (this *ISequenceWriter) write(data []byte) error {
+	n, err := this.writer.Write(data)
+	this.pos += int64(n)
+	return err
+}
+
+func (this *ISequenceWriter) writeSync() error {
+	var escape [4]byte
+	binary.BigEndian.PutUint32(escape[:], math.MaxUint32)
+	if err := this.write(escape[:]); err != nil {
+		return err
+	}
+	this.lastSync = this.pos
+	return this.write(this.sync[:])
+}
+
+func (this *ISequenceWriter) Append(key any, value any) error {
+	this.scratch.Reset()
+	if err := EncodeWritable(&this.scratch, this.keyClass, key); err != nil {
+		return err
+	}
+	keyLength := this.scratch.Len()
+	if err := EncodeWritable(&this.scratch, this.valueClass, value); err != nil {
+		return err
+	}
+	record := this.scratch.Bytes()
+	if this.level != 0 {
+		WriteVLong(&this.keyLengths, int64(keyLength))
+		this.keys.Write(record[:keyLength])
+		WriteVLong(&this.valLengths, int64(len(record)-keyLength))
+		this.values.Write(record[keyLength:])
+		this.records++
+		if this.keys.Len()+this.values.Len() >= sequenceBlockSize {
+			return this.flushBlock()
+		}
+		return nil
+	}
+	if this.pos >= this.lastSync+sequenceSyncInterval {
+		if err := this.writeSync(); err != nil {
+			return err
+		}
+	}
+	var lengths [8]byte
+	binary.BigEndian.PutUint32(lengths[:4], uint32(len(record)))
+	binary.BigEndian.PutUint32(lengths[4:], uint32(keyLength))
+	if err := this.write(lengths[:]); err != nil {
+		return err
+	}
+	return this.write(record)
+}
+
+func (this *ISequenceWriter) flushBlock() error {
+	if err := this.writeSync(); err != nil {
+		return err
+	}
+	var block bytes.Buffer
+	WriteVLong(&block, this.records)
+	for _, buffer := range []*bytes.Buffer{&this.keyLengths, &this.keys, &this.valLengths, &this.values} {
+		var compressed bytes.Buffer
+		encoder, err := zlib.NewWriterLevel(&compressed, this.level)
+		if err != nil {
+			return err
+		}
+		if _, err = encoder.Write(buffer.Bytes()); err != nil {
+			return err
+		}
+		if err = encoder.Close(); err != nil {
+			return err
+		}
+		WriteVLong(&block, int64(compressed.Len()))
+		block.Write(compressed.Bytes())
+		buffer.Reset()
+	}
+	this.records = 0
+	return this.write(block.Bytes())
+}
+
+/*Writes the pending block, the underlying writer is not closed*/
+func (this *ISequenceWriter) Close() error {
+	if this.records > 0 {
+		if err := this.flushBlock(); err != nil {
+			return err
+		}
+	}
+	return this.writer.Flush()
+}
+
+/*Writable class that stores the Go value*/
+func WritableClass(value any) (string, error) {
+	switch value.(type) {
+	case string:
+		return WritableText, nil
+	case []byte:
+		return WritableBytes, nil
+	case int32:
+		return WritableInt, nil
+	case int64, int:
+		return WritableLong, nil
+	case float32:
+		return WritableFloat, nil
+	case float64:
+		return WritableDouble, nil
+	case bool:
+		return WritableBoolean, nil
+	case int8:
+		return WritableByte, nil
+	case nil:
+		return WritableNull, nil
+	}
+	return "", errors.New("ifs: " + reflect.TypeOf(value).String() + " has no writable class")
+}
+
+func EncodeWritable(buffer *bytes.Buffer, class string, value any) error {
+	var data [8]byte
+	switch v := value.(type) {
+	case string:
+		if class == WritableText {
+			writeText(buffer, v)
+			return nil
+		}
+	case []byte:
+		if class == WritableBytes {
+			binary.BigEndian.PutUint32(data[:4], uint32(len(v)))
+			buffer.Write(data[:4])
+			buffer.Write(v)
+			return nil
+		}
+	case int32:
+		if class == WritableInt {
+			binary.BigEndian.PutUint32(data[:4], uint32(v))
+			buffer.Write(data[:4])
+			return nil
+		} else if class == WritableVInt {
+			WriteVLong(buffer, int64(v))
+			return nil
+		}
+	case int64, int:
+		n := reflect.ValueOf(v).Int()
+		if class == WritableLong {
+			binary.BigEndian.PutUint64(data[:], uint64(n))
+			buffer.Write(data[:])
+			return nil
+		} else if class == WritableVLong {
+			WriteVLong(buffer, n)
+			return nil
+		}
+	case float32:
+		if class == WritableFloat {
+			binary.BigEndian.PutUint32(data[:4], math.Float32bits(v))
+			buffer.Write(data[:4])
+			return nil
+		}
+	case float64:
+		if class == WritableDouble {
+			binary.BigEndian.PutUint64(data[:], math.Float64bits(v))
+			buffer.Write(data[:])
+			return nil
+		}
+	case bool:
+		if class == WritableBoolean {
+			if v {
+				buffer.WriteByte(1)
+			} else {
+				buffer.WriteByte(0)
+			}
+			return nil
+		}
+	case int8:
+		if class == WritableByte {
+			buffer.WriteByte(byte(v))
+			return nil
+		}
+	case nil:
+		if class == WritableNull {
+			return nil
+		}
+	}
+	return errors.New("ifs: " + reflect.TypeOf(value).String() + " can not be written as " + class)
+}
+
+/*Go value of a serialized writable, unknown classes are returned as their bytes*/
+func DecodeWritable(class string, data []byte) (any, error) {
+	fixed := func(n int) error {
+		if len(data) != n {
+			return errors.New("ifs: invalid " + class)
+		}
+		return nil
+	}
+	switch class {
+	case WritableText:
+		reader := bytes.NewReader(data)
+		n, err := ReadVLong(reader)
+		if err != nil || n != int64(reader.Len()) {
+			return nil, errors.New("ifs: invalid " + class)
+		}
+		return string(data[len(data)-int(n):]), nil
+	case WritableBytes:
+		if len(data) < 4 || int64(binary.BigEndian.Uint32(data)) != int64(len(data)-4) {
+			return nil, errors.New("ifs: invalid " + class)
+		}
+		return data[4:], nil
+	case WritableInt:
+		if err := fixed(4); err != nil {
+			return nil, err
+		}
+		return int32(binary.BigEndian.Uint32(data)), nil
+	case WritableLong:
+		if err := fixed(8); err != nil {
+			return nil, err
+		}
+		return int64(binary.BigEndian.Uint64(data)), nil
+	case WritableVInt, WritableVLong:
+		n, err := ReadVLong(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if class == WritableVInt {
+			return int32(n), nil
+		}
+		return n, nil
+	case WritableFloat:
+		if err := fixed(4); err != nil {
+			return nil, err
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(data)), nil
+	case WritableDouble:
+		if err := fixed(8); err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(data)), nil
+	case WritableBoolean:
+		if err := fixed(1); err != nil {
+			return nil, err
+		}
+		return data[0] != 0, nil
+	case WritableByte:
+		if err := fixed(1); err != nil {
+			return nil, err
+		}
+		return int8(data[0]), nil
+	case WritableNull:
+		return nil, nil
+	}
+	return data, nil
+}
+
+func writeText(buffer *bytes.Buffer, text string) {
+	WriteVLong(buffer, int64(len(text)))
+	buffer.WriteString(text)
+}
+
+/*Variable length integer of Hadoop WritableUtils*/
+func WriteVLong(buffer *bytes.Buffer, i int64) {
+	if i >= -112 && i <= 127 {
+		buffer.WriteByte(byte(i))
+		return
+	}
+	length := -112
+	if i < 0 {
+		i ^= -1
+		length = -120
+	}
+	for tmp := i; tmp != 0; tmp >>= 8 {
+		length--
+	}
+	buffer.WriteByte(byte(length))
+	if length < -120 {
+		length = -(length + 120)
+	} else {
+		length = -(length + 112)
+	}
+	for idx := length; idx != 0; idx-- {
+		buffer.WriteByte(byte(i >> ((idx - 1) * 8)))
+	}
+}
+
+func ReadVLong(reader io.ByteReader) (int64, error) {
+	c, err := reader.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	first := int8(c)
+	if first >= -112 {
+		return int64(first), nil
+	}
+	length := int(-111 - int(first))
+	if first < -120 {
+		length = int(-119 - int(first))
+	}
+	i := int64(0)
+	for idx := 0; idx < length-1; idx++ {
+		b, err := reader.ReadByte()
+		if err == io.EOF {
+			return 0, errSequenceCorrupt
+		} else if err != nil {
+			return 0, err
+		}
+		i = i<<8 | int64(b)
+	}
+	if first < -120 {
+		return i ^ -1, nil
+	}
+	return i, nil
+}
@@ -0,0 +1,64 @@
+package ifs
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestVLong(t *testing.T) {
+	for _, n := range []int64{0, 1, -1, 127, -112, 128, -113, 1 << 20, -(1 << 40), 1<<63 - 1, -1 << 63} {
+		var buffer bytes.Buffer
+		WriteVLong(&buffer, n)
+		m, err := ReadVLong(&buffer)
+		require.Nil(t, err)
+		require.Equal(t, n, m)
+		require.Equal(t, 0, buffer.Len())
+	}
+}
+
+func TestSequenceFile(t *testing.T) {
+	for _, level := range []int{0, 6} {
+		path := filepath.Join(t.TempDir(), "part")
+		file, err := os.Create(path)
+		require.Nil(t, err)
+		writer, err := NewISequenceWriter(file, WritableText, WritableLong, level)
+		require.Nil(t, err)
+		n := 200000
+		for i := 0; i < n; i++ {
+			require.Nil(t, writer.Append("key"+strconv.Itoa(i), int64(i)))
+		}
+		require.Nil(t, writer.Close())
+		require.Nil(t, file.Close())
+		info, err := os.Stat(path)
+		require.Nil(t, err)
+
+		for _, splits := range []int64{1, 3, 7} {
+			next := 0
+			chunk := (info.Size() + splits - 1) / splits
+			for init := int64(0); init < info.Size(); init += chunk {
+				file, err := os.Open(path)
+				require.Nil(t, err)
+				reader, err := NewISequenceReader(file, init, init+chunk)
+				require.Nil(t, err)
+				require.Equal(t, WritableText, reader.KeyClass())
+				require.Equal(t, WritableLong, reader.ValueClass())
+				for {
+					key, value, ok, err := reader.Next()
+					require.Nil(t, err)
+					if !ok {
+						break
+					}
+					require.Equal(t, "key"+strconv.Itoa(next), key)
+					require.Equal(t, int64(next), value)
+					next++
+				}
+				require.Nil(t, file.Close())
+			}
+			require.Equal(t, n, next)
+		}
+	}
+}
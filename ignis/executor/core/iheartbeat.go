@@ -0,0 +1,192 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/logger"
+	"sync"
+	"time"
+)
+
+/*
+Every executor sends a liveness token to the others over a duplicate of the group communicator. A peer whose tokens
+stop arriving is marked as dead, so the next collective fails with an IPeerDead instead of blocking forever. Tokens
+are exchanged from a goroutine, so the monitor requires MPI_THREAD_MULTIPLE.
+*/
+type IHeartbeat struct {
+	mu         sync.Mutex
+	properties *IPropertyParser
+	comm       C_MPI_Comm
+	lastSeen   []time.Time
+	dead       int
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+func (this *IHeartbeat) Start(group C_MPI_Comm) error {
+	this.Stop()
+	interval, err := this.properties.HeartbeatInterval()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	timeout, err := this.properties.HeartbeatTimeout()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	var executors, rank, provided C_int
+	if err = MPI_Comm_size(group, &executors); err != nil {
+		return ierror.Raise(err)
+	}
+	if interval == 0 || executors < 2 {
+		return nil
+	}
+	if err = MPI_Query_thread(&provided); err != nil {
+		return ierror.Raise(err)
+	}
+	if provided != MPI_THREAD_MULTIPLE {
+		logger.Warn("Heartbeat: disabled, MPI is not running in thread mode")
+		return nil
+	}
+	if err = MPI_Comm_dup(group, &this.comm); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = MPI_Comm_rank(this.comm, &rank); err != nil {
+		return ierror.Raise(err)
+	}
+	now := time.Now()
+	this.mu.Lock()
+	this.lastSeen = make([]time.Time, executors)
+	for i := range this.lastSeen {
+		this.lastSeen[i] = now
+	}
+	this.dead = -1
+	this.mu.Unlock()
+	this.stop = make(chan struct{})
+	this.done = make(chan struct{})
+	logger.Info("Heartbeat: monitoring ", executors-1, " executors every ", interval, "s")
+	go this.run(int(rank), time.Duration(interval*float64(time.Second)), time.Duration(timeout*float64(time.Second)))
+	return nil
+}
+
+func (this *IHeartbeat) Stop() {
+	if this.stop == nil {
+		return
+	}
+	close(this.stop)
+	<-this.done
+	this.stop = nil
+	this.done = nil
+	_ = MPI_Comm_free(&this.comm)
+}
+
+/*Returns an IPeerDead error if any executor of the group stopped answering*/
+func (this *IHeartbeat) Check() error {
+	if this == nil {
+		return nil
+	}
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if this.stop == nil || this.dead < 0 {
+		return nil
+	}
+	return ierror.RaisePeerDead(this.dead, this.lastSeen[this.dead])
+}
+
+func (this *IHeartbeat) LastSeen(rank int) time.Time {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if rank < len(this.lastSeen) {
+		return this.lastSeen[rank]
+	}
+	return time.Time{}
+}
+
+func (this *IHeartbeat) run(rank int, interval time.Duration, timeout time.Duration) {
+	defer close(this.done)
+	executors := len(this.lastSeen)
+	var token C_int8
+	tokens := make([]C_int8, executors)
+	recvs := make([]C_MPI_Request, executors)
+	sends := make([]C_MPI_Request, executors)
+	sending := make([]bool, executors)
+	for p := range recvs {
+		if p != rank {
+			if err := MPI_Irecv(P(&tokens[p]), 1, MPI_BYTE, C_int(p), 0, this.comm, &recvs[p]); err != nil {
+				logger.Error("Heartbeat: ", err)
+				return
+			}
+		}
+	}
+	defer func() {
+		for p := range recvs {
+			if p != rank {
+				_ = MPI_Cancel(&recvs[p])
+				_ = MPI_Request_free(&recvs[p])
+			}
+			if sending[p] {
+				_ = MPI_Cancel(&sends[p])
+				_ = MPI_Request_free(&sends[p])
+			}
+		}
+	}()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-this.stop:
+			return
+		case <-ticker.C:
+		}
+		for p := 0; p < executors; p++ {
+			if p == rank {
+				continue
+			}
+			if err := this.beat(p, &tokens[p], &recvs[p]); err != nil {
+				logger.Error("Heartbeat: ", err)
+				return
+			}
+			var flag C_int
+			if sending[p] {
+				if err := MPI_Test(&sends[p], &flag, MPI_STATUS_IGNORE); err != nil {
+					logger.Error("Heartbeat: ", err)
+					return
+				}
+				sending[p] = flag == 0
+			}
+			if !sending[p] {
+				if err := MPI_Isend(P(&token), 1, MPI_BYTE, C_int(p), 0, this.comm, &sends[p]); err != nil {
+					logger.Error("Heartbeat: ", err)
+					return
+				}
+				sending[p] = true
+			}
+		}
+		this.mu.Lock()
+		for p, seen := range this.lastSeen {
+			if p != rank && this.dead < 0 && time.Since(seen) > timeout {
+				this.dead = p
+				logger.Error("Heartbeat: executor ", p, " has not answered since ", seen.Format(time.RFC3339Nano))
+			}
+		}
+		this.mu.Unlock()
+	}
+}
+
+/*Consumes every token that has arrived from the peer*/
+func (this *IHeartbeat) beat(peer int, token *C_int8, request *C_MPI_Request) error {
+	for {
+		var flag C_int
+		if err := MPI_Test(request, &flag, MPI_STATUS_IGNORE); err != nil {
+			return ierror.Raise(err)
+		}
+		if flag == 0 {
+			return nil
+		}
+		this.mu.Lock()
+		this.lastSeen[peer] = time.Now()
+		this.mu.Unlock()
+		if err := MPI_Irecv(P(token), 1, MPI_BYTE, C_int(peer), 0, this.comm, request); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+}
@@ -38,6 +38,8 @@ func IsContiguousType(tp reflect.Type) bool {
 			}
 		}
 		return true
+	} else if tp.Kind() == reflect.Array {
+		return IsContiguousType(tp.Elem())
 	} else {
 		return kindContiguous[tp.Kind()]
 	}
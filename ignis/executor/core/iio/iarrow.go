@@ -0,0 +1,716 @@
+package iio
+
+import (
+	"encoding/binary"
+	"fmt"
+	"ignis/executor/core/ierror"
+	"io"
+	"math"
+	"reflect"
+	"strings"
+	"unsafe"
+)
+
+/*Apache arrow IPC streaming format, only the types shared by all executors are supported*/
+
+const (
+	arrowContinuation = 0xFFFFFFFF
+	arrowVersion      = 4 // MetadataVersion.V5
+	arrowSchema       = 1
+	arrowRecordBatch  = 3
+)
+
+const (
+	arrowInt         = 2
+	arrowFloat       = 3
+	arrowBinary      = 4
+	arrowUtf8        = 5
+	arrowBool        = 6
+	arrowStruct      = 13
+	arrowLargeBinary = 19
+	arrowLargeUtf8   = 20
+)
+
+type iArrowField struct {
+	name     string
+	tp       byte
+	bits     int
+	signed   bool
+	children []*iArrowField
+}
+
+var byteSliceType = reflect.TypeOf([]byte(nil))
+
+func IsArrowType(tp reflect.Type) bool {
+	_, err := arrowFields(tp)
+	return err == nil
+}
+
+func arrowName(field reflect.StructField) string {
+	if tag := field.Tag.Get("arrow"); tag != "" {
+		return tag
+	}
+	return field.Name
+}
+
+func arrowStructFields(tp reflect.Type) ([]*iArrowField, error) {
+	var fields []*iArrowField
+	for i := 0; i < tp.NumField(); i++ {
+		field := tp.Field(i)
+		if !field.IsExported() || field.Tag.Get("arrow") == "-" {
+			continue
+		}
+		arrow, err := arrowFieldOf(arrowName(field), field.Type)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, arrow)
+	}
+	if len(fields) == 0 {
+		return nil, ierror.RaiseMsg("arrow requires at least one exported field in " + tp.String())
+	}
+	return fields, nil
+}
+
+func arrowFieldOf(name string, tp reflect.Type) (*iArrowField, error) {
+	field := &iArrowField{name: name}
+	switch tp.Kind() {
+	case reflect.Bool:
+		field.tp = arrowBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		field.tp, field.bits, field.signed = arrowInt, int(tp.Size())*8, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		field.tp, field.bits = arrowInt, int(tp.Size())*8
+	case reflect.Float32, reflect.Float64:
+		field.tp, field.bits = arrowFloat, int(tp.Size())*8
+	case reflect.String:
+		field.tp = arrowUtf8
+	case reflect.Slice:
+		if tp.Elem().Kind() != reflect.Uint8 {
+			return nil, ierror.RaiseMsg("arrow does not support " + tp.String())
+		}
+		field.tp = arrowBinary
+	case reflect.Struct:
+		children, err := arrowStructFields(tp)
+		if err != nil {
+			return nil, err
+		}
+		field.tp, field.children = arrowStruct, children
+	default:
+		return nil, ierror.RaiseMsg("arrow does not support " + tp.String())
+	}
+	return field, nil
+}
+
+/*A struct is stored as one column per field, any other type as a single column named value*/
+func arrowFields(tp reflect.Type) ([]*iArrowField, error) {
+	if tp.Kind() == reflect.Struct {
+		fields, err := arrowStructFields(tp)
+		return fields, err
+	}
+	field, err := arrowFieldOf("value", tp)
+	if err != nil {
+		return nil, err
+	}
+	return []*iArrowField{field}, nil
+}
+
+func (this *iArrowField) buffers() int {
+	switch this.tp {
+	case arrowStruct:
+		return 1
+	case arrowBinary, arrowUtf8, arrowLargeBinary, arrowLargeUtf8:
+		return 3
+	default:
+		return 2
+	}
+}
+
+func (this *iArrowField) table() *fbTable {
+	var tp *fbTable
+	switch this.tp {
+	case arrowInt:
+		tp = newFbTable(2).scalar(0, 4, uint64(this.bits))
+		if this.signed {
+			tp.scalar(1, 1, 1)
+		}
+	case arrowFloat:
+		precision := uint64(2)
+		if this.bits == 32 {
+			precision = 1
+		}
+		tp = newFbTable(1).scalar(0, 2, precision)
+	default:
+		tp = newFbTable(0)
+	}
+	children := make([]any, len(this.children))
+	for i, child := range this.children {
+		children[i] = child.table()
+	}
+	return newFbTable(6).
+		ref(0, this.name).
+		scalar(2, 1, uint64(this.tp)).
+		ref(3, tp).
+		ref(5, &fbVector{refs: children})
+}
+
+type IArrowWriter struct {
+	w      io.Writer
+	tp     reflect.Type
+	fields []*iArrowField
+	schema bool
+}
+
+func NewIArrowWriter(w io.Writer, tp reflect.Type) (*IArrowWriter, error) {
+	fields, err := arrowFields(tp)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return &IArrowWriter{w: w, tp: tp, fields: fields}, nil
+}
+
+func (this *IArrowWriter) message(header byte, body *fbTable, bodyLength int) error {
+	meta := fbFinish(newFbTable(4).
+		scalar(0, 2, arrowVersion).
+		scalar(1, 1, uint64(header)).
+		ref(2, body).
+		scalar(3, 8, uint64(bodyLength)))
+	prefix := make([]byte, 8)
+	binary.LittleEndian.PutUint32(prefix, arrowContinuation)
+	binary.LittleEndian.PutUint32(prefix[4:], uint32(len(meta)))
+	if _, err := this.w.Write(prefix); err != nil {
+		return ierror.Raise(err)
+	}
+	if _, err := this.w.Write(meta); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+func (this *IArrowWriter) writeSchema() error {
+	if this.schema {
+		return nil
+	}
+	this.schema = true
+	fields := make([]any, len(this.fields))
+	for i, field := range this.fields {
+		fields[i] = field.table()
+	}
+	return this.message(arrowSchema, newFbTable(2).ref(1, &fbVector{refs: fields}), 0)
+}
+
+type iArrowBody struct {
+	nodes   []byte
+	buffers []byte
+	data    []byte
+}
+
+func (this *iArrowBody) node(length int) {
+	this.nodes = binary.LittleEndian.AppendUint64(this.nodes, uint64(length))
+	this.nodes = binary.LittleEndian.AppendUint64(this.nodes, 0)
+}
+
+func (this *iArrowBody) buffer(data []byte) {
+	this.buffers = binary.LittleEndian.AppendUint64(this.buffers, uint64(len(this.data)))
+	this.buffers = binary.LittleEndian.AppendUint64(this.buffers, uint64(len(data)))
+	this.data = append(this.data, data...)
+	for len(this.data)%8 != 0 {
+		this.data = append(this.data, 0)
+	}
+}
+
+func (this *iArrowBody) column(field *iArrowField, values []reflect.Value) error {
+	n := len(values)
+	this.node(n)
+	this.buffer(nil)
+	switch field.tp {
+	case arrowBool:
+		bitmap := make([]byte, (n+7)/8)
+		for i, v := range values {
+			if v.Bool() {
+				bitmap[i/8] |= 1 << (i % 8)
+			}
+		}
+		this.buffer(bitmap)
+	case arrowInt, arrowFloat:
+		width := field.bits / 8
+		data := make([]byte, n*width)
+		for i, v := range values {
+			var bits uint64
+			switch v.Kind() {
+			case reflect.Float32:
+				bits = uint64(math.Float32bits(float32(v.Float())))
+			case reflect.Float64:
+				bits = math.Float64bits(v.Float())
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				bits = v.Uint()
+			default:
+				bits = uint64(v.Int())
+			}
+			for b := 0; b < width; b++ {
+				data[i*width+b] = byte(bits >> (8 * b))
+			}
+		}
+		this.buffer(data)
+	case arrowUtf8, arrowBinary:
+		offsets := make([]byte, 4, 4*(n+1))
+		var data []byte
+		for _, v := range values {
+			if v.Kind() == reflect.String {
+				data = append(data, v.String()...)
+			} else {
+				data = append(data, v.Bytes()...)
+			}
+			if len(data) > math.MaxInt32 {
+				return ierror.RaiseMsg("arrow column " + field.name + " exceeds 2GB")
+			}
+			offsets = binary.LittleEndian.AppendUint32(offsets, uint32(len(data)))
+		}
+		this.buffer(offsets)
+		this.buffer(data)
+	case arrowStruct:
+		for c, child := range field.children {
+			children := make([]reflect.Value, n)
+			for i, v := range values {
+				children[i] = arrowStructValue(v, c)
+			}
+			if err := this.column(child, children); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+/*Returns the c-th exported field, the same order used by arrowStructFields*/
+func arrowStructValue(v reflect.Value, c int) reflect.Value {
+	tp := v.Type()
+	for i := 0; i < tp.NumField(); i++ {
+		if field := tp.Field(i); field.IsExported() && field.Tag.Get("arrow") != "-" {
+			if c == 0 {
+				return v.Field(i)
+			}
+			c--
+		}
+	}
+	panic("arrow: field out of range")
+}
+
+/*Writes a slice of elements as one record batch, the schema is written before the first batch*/
+func (this *IArrowWriter) Write(array any) error {
+	if err := this.writeSchema(); err != nil {
+		return ierror.Raise(err)
+	}
+	rows := reflect.ValueOf(array)
+	if rows.Kind() != reflect.Slice || rows.Type().Elem() != this.tp {
+		return ierror.RaiseMsg("arrow writer expected []" + this.tp.String())
+	}
+	n := rows.Len()
+	body := &iArrowBody{}
+	if this.tp.Kind() == reflect.Struct {
+		for c, field := range this.fields {
+			values := make([]reflect.Value, n)
+			for i := range values {
+				values[i] = arrowStructValue(rows.Index(i), c)
+			}
+			if err := body.column(field, values); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+	} else if field := this.fields[0]; (field.tp == arrowInt || field.tp == arrowFloat) && n > 0 {
+		body.node(n)
+		body.buffer(nil)
+		body.buffer(unsafe.Slice((*byte)(rows.UnsafePointer()), n*int(this.tp.Size())))
+	} else {
+		values := make([]reflect.Value, n)
+		for i := range values {
+			values[i] = rows.Index(i)
+		}
+		if err := body.column(field, values); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	batch := newFbTable(3).
+		scalar(0, 8, uint64(n)).
+		ref(1, &fbVector{data: body.nodes, n: len(body.nodes) / 16, align: 8}).
+		ref(2, &fbVector{data: body.buffers, n: len(body.buffers) / 16, align: 8})
+	if err := this.message(arrowRecordBatch, batch, len(body.data)); err != nil {
+		return ierror.Raise(err)
+	}
+	if _, err := this.w.Write(body.data); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+/*
+Writes n elements stored as one little endian column per field as a record batch, bool columns use a byte per
+value. Only flat types have a column per field.
+*/
+func (this *IArrowWriter) WriteColumns(n int, columns [][]byte) error {
+	if err := this.writeSchema(); err != nil {
+		return ierror.Raise(err)
+	}
+	if len(columns) != len(this.fields) {
+		return ierror.RaiseMsg("arrow writer expected " + fmt.Sprint(len(this.fields)) + " columns")
+	}
+	body := &iArrowBody{}
+	for c, field := range this.fields {
+		body.node(n)
+		body.buffer(nil)
+		switch field.tp {
+		case arrowBool:
+			bitmap := make([]byte, (n+7)/8)
+			for i, v := range columns[c][:n] {
+				if v != 0 {
+					bitmap[i/8] |= 1 << (i % 8)
+				}
+			}
+			body.buffer(bitmap)
+		case arrowInt, arrowFloat:
+			body.buffer(columns[c][:n*field.bits/8])
+		default:
+			return ierror.RaiseMsg("arrow column " + field.name + " is not a fixed width column")
+		}
+	}
+	batch := newFbTable(3).
+		scalar(0, 8, uint64(n)).
+		ref(1, &fbVector{data: body.nodes, n: len(body.nodes) / 16, align: 8}).
+		ref(2, &fbVector{data: body.buffers, n: len(body.buffers) / 16, align: 8})
+	if err := this.message(arrowRecordBatch, batch, len(body.data)); err != nil {
+		return ierror.Raise(err)
+	}
+	if _, err := this.w.Write(body.data); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+/*Writes the end of stream marker*/
+func (this *IArrowWriter) Close() error {
+	if err := this.writeSchema(); err != nil {
+		return ierror.Raise(err)
+	}
+	eos := make([]byte, 8)
+	binary.LittleEndian.PutUint32(eos, arrowContinuation)
+	_, err := this.w.Write(eos)
+	return ierror.Raise(err)
+}
+
+func WriteArrow(w io.Writer, array any) error {
+	writer, err := NewIArrowWriter(w, reflect.TypeOf(array).Elem())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = writer.Write(array); err != nil {
+		return ierror.Raise(err)
+	}
+	return writer.Close()
+}
+
+type iArrowColumn struct {
+	length   int
+	nulls    int
+	validity []byte
+	offsets  []byte
+	values   []byte
+	children []*iArrowColumn
+}
+
+func (this *iArrowColumn) valid(i int) bool {
+	return this.nulls == 0 || len(this.validity) == 0 || this.validity[i/8]&(1<<(i%8)) != 0
+}
+
+func (this *iArrowColumn) bytes(field *iArrowField, i int) []byte {
+	if field.tp == arrowLargeUtf8 || field.tp == arrowLargeBinary {
+		return this.values[binary.LittleEndian.Uint64(this.offsets[8*i:]):binary.LittleEndian.Uint64(this.offsets[8*i+8:])]
+	}
+	return this.values[binary.LittleEndian.Uint32(this.offsets[4*i:]):binary.LittleEndian.Uint32(this.offsets[4*i+4:])]
+}
+
+/*Record batches read from an arrow stream*/
+type IArrowTable struct {
+	fields  []*iArrowField
+	batches [][]*iArrowColumn
+	rows    int
+}
+
+func (this *IArrowTable) Len() int {
+	return this.rows
+}
+
+func arrowReadField(field fbReader) (*iArrowField, error) {
+	result := &iArrowField{name: field.string(0), tp: byte(field.scalar(2, 1))}
+	tp, _ := field.table(3)
+	switch result.tp {
+	case arrowInt:
+		result.bits, result.signed = int(tp.scalar(0, 4)), tp.scalar(1, 1) != 0
+	case arrowFloat:
+		switch tp.scalar(0, 2) {
+		case 1:
+			result.bits = 32
+		case 2:
+			result.bits = 64
+		default:
+			return nil, ierror.RaiseMsg("arrow half floats are not supported")
+		}
+	case arrowBool, arrowUtf8, arrowBinary, arrowLargeUtf8, arrowLargeBinary:
+	case arrowStruct:
+		pos, n := field.vector(5)
+		for i := 0; i < n; i++ {
+			child, err := arrowReadField(field.tableAt(pos + 4*i))
+			if err != nil {
+				return nil, err
+			}
+			result.children = append(result.children, child)
+		}
+	default:
+		return nil, ierror.RaiseMsg(fmt.Sprintf("arrow type %d of column %s is not supported", result.tp, result.name))
+	}
+	return result, nil
+}
+
+type iArrowBatchReader struct {
+	body    []byte
+	batch   fbReader
+	nodes   int
+	buffers int
+	node    int
+	buffer  int
+}
+
+func (this *iArrowBatchReader) nextBuffer() []byte {
+	pos := this.buffers + 16*this.buffer
+	this.buffer++
+	offset := binary.LittleEndian.Uint64(this.batch.buf[pos:])
+	length := binary.LittleEndian.Uint64(this.batch.buf[pos+8:])
+	return this.body[offset : offset+length]
+}
+
+func (this *iArrowBatchReader) column(field *iArrowField) *iArrowColumn {
+	pos := this.nodes + 16*this.node
+	this.node++
+	column := &iArrowColumn{
+		length: int(binary.LittleEndian.Uint64(this.batch.buf[pos:])),
+		nulls:  int(binary.LittleEndian.Uint64(this.batch.buf[pos+8:])),
+	}
+	column.validity = this.nextBuffer()
+	switch field.buffers() {
+	case 2:
+		column.values = this.nextBuffer()
+	case 3:
+		column.offsets = this.nextBuffer()
+		column.values = this.nextBuffer()
+	}
+	for _, child := range field.children {
+		column.children = append(column.children, this.column(child))
+	}
+	return column
+}
+
+func ReadArrow(r io.Reader) (table *IArrowTable, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			table, err = nil, ierror.RaiseMsg(fmt.Sprint("corrupted arrow stream: ", recovered))
+		}
+	}()
+	table = &IArrowTable{}
+	prefix := make([]byte, 4)
+	for {
+		if _, err = io.ReadFull(r, prefix); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		size := binary.LittleEndian.Uint32(prefix)
+		if size == arrowContinuation {
+			if _, err = io.ReadFull(r, prefix); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			size = binary.LittleEndian.Uint32(prefix)
+		}
+		if size == 0 {
+			break
+		}
+		meta := make([]byte, size)
+		if _, err = io.ReadFull(r, meta); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		message := fbRoot(meta)
+		body := make([]byte, message.scalar(3, 8))
+		if _, err = io.ReadFull(r, body); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		header, _ := message.table(2)
+		switch message.scalar(1, 1) {
+		case arrowSchema:
+			pos, n := header.vector(1)
+			table.fields = nil
+			for i := 0; i < n; i++ {
+				field, err := arrowReadField(header.tableAt(pos + 4*i))
+				if err != nil {
+					return nil, ierror.Raise(err)
+				}
+				table.fields = append(table.fields, field)
+			}
+		case arrowRecordBatch:
+			if table.fields == nil {
+				return nil, ierror.RaiseMsg("arrow record batch before schema")
+			}
+			if _, compressed := header.table(3); compressed {
+				return nil, ierror.RaiseMsg("arrow body compression is not supported")
+			}
+			reader := &iArrowBatchReader{body: body, batch: header}
+			reader.nodes, _ = header.vector(1)
+			reader.buffers, _ = header.vector(2)
+			columns := make([]*iArrowColumn, len(table.fields))
+			for i, field := range table.fields {
+				columns[i] = reader.column(field)
+			}
+			table.batches = append(table.batches, columns)
+			table.rows += int(header.scalar(0, 8))
+		default:
+			return nil, ierror.RaiseMsg("arrow dictionaries and tensors are not supported")
+		}
+	}
+	return table, nil
+}
+
+func arrowSet(dst reflect.Value, field *iArrowField, column *iArrowColumn, i int) error {
+	if !column.valid(i) {
+		return nil
+	}
+	kind := dst.Kind()
+	switch field.tp {
+	case arrowBool:
+		if kind != reflect.Bool {
+			break
+		}
+		dst.SetBool(column.values[i/8]&(1<<(i%8)) != 0)
+		return nil
+	case arrowInt:
+		width := field.bits / 8
+		bits := uint64(0)
+		for b := 0; b < width; b++ {
+			bits |= uint64(column.values[i*width+b]) << (8 * b)
+		}
+		if field.signed && width < 8 && bits&(1<<(field.bits-1)) != 0 {
+			bits |= math.MaxUint64 << field.bits
+		}
+		switch kind {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			dst.SetInt(int64(bits))
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			dst.SetUint(bits)
+			return nil
+		case reflect.Float32, reflect.Float64:
+			if field.signed {
+				dst.SetFloat(float64(int64(bits)))
+			} else {
+				dst.SetFloat(float64(bits))
+			}
+			return nil
+		}
+	case arrowFloat:
+		if kind != reflect.Float32 && kind != reflect.Float64 {
+			break
+		}
+		if field.bits == 32 {
+			dst.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(column.values[4*i:]))))
+		} else {
+			dst.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(column.values[8*i:])))
+		}
+		return nil
+	case arrowUtf8, arrowBinary, arrowLargeUtf8, arrowLargeBinary:
+		if kind == reflect.String {
+			dst.SetString(string(column.bytes(field, i)))
+			return nil
+		} else if dst.Type() == byteSliceType {
+			dst.SetBytes(append([]byte(nil), column.bytes(field, i)...))
+			return nil
+		}
+	case arrowStruct:
+		if kind != reflect.Struct {
+			break
+		}
+		for c, child := range field.children {
+			if target, ok := arrowTarget(dst.Type(), child.name); ok {
+				if err := arrowSet(dst.FieldByIndex(target), child, column.children[c], i); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	return ierror.RaiseMsg("arrow column " + field.name + " can not be converted to " + dst.Type().String())
+}
+
+func arrowTarget(tp reflect.Type, name string) ([]int, bool) {
+	for i := 0; i < tp.NumField(); i++ {
+		field := tp.Field(i)
+		if !field.IsExported() || field.Tag.Get("arrow") == "-" {
+			continue
+		}
+		if field.Tag.Get("arrow") == name || strings.EqualFold(field.Name, name) {
+			return field.Index, true
+		}
+	}
+	return nil, false
+}
+
+/*Converts the table to a []tp, struct fields are matched by arrow tag or name and other types use the first column*/
+func (this *IArrowTable) Array(tp reflect.Type) (any, error) {
+	if len(this.fields) == 0 {
+		return reflect.MakeSlice(reflect.SliceOf(tp), 0, 0).Interface(), nil
+	}
+	array := reflect.MakeSlice(reflect.SliceOf(tp), this.rows, this.rows)
+	offset := 0
+	for _, batch := range this.batches {
+		n := 0
+		if len(batch) > 0 {
+			n = batch[0].length
+		}
+		if n == 0 {
+			continue
+		} else if tp.Kind() == reflect.Struct {
+			for c, field := range this.fields {
+				target, ok := arrowTarget(tp, field.name)
+				if !ok {
+					continue
+				}
+				for i := 0; i < n; i++ {
+					if err := arrowSet(array.Index(offset+i).FieldByIndex(target), field, batch[c], i); err != nil {
+						return nil, ierror.Raise(err)
+					}
+				}
+			}
+		} else if field, column := this.fields[0], batch[0]; arrowSameLayout(field, tp) && column.nulls == 0 {
+			size := n * int(tp.Size())
+			copy(unsafe.Slice((*byte)(array.Index(offset).Addr().UnsafePointer()), size), column.values[:size])
+		} else {
+			for i := 0; i < n; i++ {
+				if err := arrowSet(array.Index(offset+i), field, column, i); err != nil {
+					return nil, ierror.Raise(err)
+				}
+			}
+		}
+		offset += n
+	}
+	return array.Interface(), nil
+}
+
+func arrowSameLayout(field *iArrowField, tp reflect.Type) bool {
+	if field.bits == 0 || field.bits != int(tp.Size())*8 {
+		return false
+	}
+	switch tp.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return field.tp == arrowInt && field.signed
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return field.tp == arrowInt && !field.signed
+	case reflect.Float32, reflect.Float64:
+		return field.tp == arrowFloat
+	}
+	return false
+}
@@ -0,0 +1,80 @@
+package iio
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"reflect"
+	"testing"
+)
+
+type arrowPoint struct {
+	X, Y float64
+}
+
+type arrowRow struct {
+	Id      int64
+	Name    string `arrow:"name"`
+	Flag    bool
+	Small   int8
+	Count   uint32
+	Ratio   float32
+	Data    []byte
+	Point   arrowPoint
+	Ignored int `arrow:"-"`
+	private int
+}
+
+func testArrow[T any](t *testing.T, elems []T) {
+	buffer := &bytes.Buffer{}
+	require.True(t, IsArrowType(reflect.TypeOf(elems).Elem()))
+	require.Nil(t, WriteArrow(buffer, elems))
+	table, err := ReadArrow(buffer)
+	require.Nil(t, err)
+	require.Equal(t, len(elems), table.Len())
+	array, err := table.Array(reflect.TypeOf(elems).Elem())
+	require.Nil(t, err)
+	require.Equal(t, elems, array)
+	require.Equal(t, 0, buffer.Len())
+}
+
+func TestArrow(t *testing.T) {
+	testArrow(t, []int64{1, -2, 3})
+	testArrow(t, []float32{1.5, -2})
+	testArrow(t, []bool{true, false, true, true, false, false, false, false, true})
+	testArrow(t, []string{"a", "", "ñandú"})
+	testArrow(t, []arrowRow{
+		{Id: 1, Name: "one", Flag: true, Small: -1, Count: 7, Ratio: 0.5, Data: []byte{1, 2}, Point: arrowPoint{1, 2}},
+		{Id: -2, Name: "", Small: 127, Point: arrowPoint{-1, 0}},
+	})
+	testArrow(t, []int64{})
+	require.False(t, IsArrowType(reflect.TypeOf(map[string]int{})))
+	require.False(t, IsArrowType(reflect.TypeOf([]int{})))
+}
+
+func TestArrowBatches(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	writer, err := NewIArrowWriter(buffer, reflect.TypeOf(int32(0)))
+	require.Nil(t, err)
+	require.Nil(t, writer.Write([]int32{1, 2}))
+	require.Nil(t, writer.Write([]int32{3}))
+	require.Nil(t, writer.Close())
+	table, err := ReadArrow(buffer)
+	require.Nil(t, err)
+	array, err := table.Array(reflect.TypeOf(int64(0)))
+	require.Nil(t, err)
+	require.Equal(t, []int64{1, 2, 3}, array)
+}
+
+func TestArrowColumnsByName(t *testing.T) {
+	type partial struct {
+		Name string
+		Id   float64
+	}
+	buffer := &bytes.Buffer{}
+	require.Nil(t, WriteArrow(buffer, []arrowRow{{Id: 4, Name: "four"}}))
+	table, err := ReadArrow(buffer)
+	require.Nil(t, err)
+	array, err := table.Array(reflect.TypeOf(partial{}))
+	require.Nil(t, err)
+	require.Equal(t, []partial{{"four", 4}}, array)
+}
@@ -0,0 +1,82 @@
+package iio
+
+import (
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/api/idecimal"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/utils"
+	"math/big"
+	"reflect"
+	"unsafe"
+)
+
+func readBigInt(value any) (*big.Int, error) {
+	if v, ok := new(big.Int).SetString(value.(string), 10); ok {
+		return v, nil
+	}
+	return nil, ierror.RaiseMsg("invalid big.Int " + value.(string))
+}
+
+func readBigFloat(value any) (*big.Float, error) {
+	s := value.(string)
+	// Text('g', -1) is the shortest representation, a precision from its digits keeps every one of them
+	v, _, err := big.ParseFloat(s, 10, utils.Max(64, uint(len(s))*4), big.ToNearestEven)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return v, nil
+}
+
+func readBigRat(value any) (*big.Rat, error) {
+	if v, ok := new(big.Rat).SetString(value.(string)); ok {
+		return v, nil
+	}
+	return nil, ierror.RaiseMsg("invalid big.Rat " + value.(string))
+}
+
+func init() {
+	SetWriter(utils.TypeName[big.Int](), NewIWrite(I_STRING, func(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+		return protocol.WriteString(ctx, (*big.Int)(obj).String())
+	}))
+	SetWriter(utils.TypeName[big.Float](), NewIWrite(I_STRING, func(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+		return protocol.WriteString(ctx, (*big.Float)(obj).Text('g', -1))
+	}))
+	SetWriter(utils.TypeName[big.Rat](), NewIWrite(I_STRING, func(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+		return protocol.WriteString(ctx, (*big.Rat)(obj).RatString())
+	}))
+	SetWriter(utils.TypeName[idecimal.IDecimal](), NewIWrite(I_STRING, func(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+		return protocol.WriteString(ctx, (*idecimal.IDecimal)(obj).String())
+	}))
+
+	SetTypedReader(utils.TypeName[big.Int](), func(value any) (any, error) {
+		v, err := readBigInt(value)
+		if err != nil {
+			return nil, err
+		}
+		return *v, nil
+	})
+	SetTypedReader(utils.TypeName[big.Float](), func(value any) (any, error) {
+		v, err := readBigFloat(value)
+		if err != nil {
+			return nil, err
+		}
+		return *v, nil
+	})
+	SetTypedReader(utils.TypeName[big.Rat](), func(value any) (any, error) {
+		v, err := readBigRat(value)
+		if err != nil {
+			return nil, err
+		}
+		return *v, nil
+	})
+	SetTypedReader(utils.TypeName[idecimal.IDecimal](), func(value any) (any, error) {
+		v, err := idecimal.Parse(value.(string))
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		return v, nil
+	})
+	utils.SetHasher(func(e *idecimal.IDecimal) uint64 {
+		return utils.HashValue(e.Normalize())
+	})
+}
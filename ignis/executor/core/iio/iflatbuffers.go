@@ -0,0 +1,198 @@
+package iio
+
+import (
+	"encoding/binary"
+)
+
+/*Minimal flatbuffers encoding used by the arrow metadata messages, objects are written front to back*/
+
+type fbTable struct {
+	slots []fbSlot
+}
+
+type fbSlot struct {
+	size  int
+	value uint64
+	child any
+}
+
+type fbVector struct {
+	refs  []any
+	data  []byte
+	n     int
+	align int
+}
+
+func newFbTable(slots int) *fbTable {
+	return &fbTable{slots: make([]fbSlot, slots)}
+}
+
+func (this *fbTable) scalar(slot int, size int, value uint64) *fbTable {
+	this.slots[slot] = fbSlot{size: size, value: value}
+	return this
+}
+
+func (this *fbTable) ref(slot int, child any) *fbTable {
+	this.slots[slot] = fbSlot{size: 4, child: child}
+	return this
+}
+
+type fbBuilder struct {
+	buf []byte
+}
+
+func fbFinish(root *fbTable) []byte {
+	b := &fbBuilder{buf: make([]byte, 4)}
+	binary.LittleEndian.PutUint32(b.buf, uint32(b.object(root)))
+	b.align(8)
+	return b.buf
+}
+
+func (this *fbBuilder) align(n int) {
+	for len(this.buf)%n != 0 {
+		this.buf = append(this.buf, 0)
+	}
+}
+
+func (this *fbBuilder) patch(pos int, target int) {
+	binary.LittleEndian.PutUint32(this.buf[pos:], uint32(target-pos))
+}
+
+func (this *fbBuilder) object(obj any) int {
+	switch o := obj.(type) {
+	case *fbTable:
+		return this.table(o)
+	case *fbVector:
+		return this.vector(o)
+	case string:
+		this.align(4)
+		pos := len(this.buf)
+		this.buf = binary.LittleEndian.AppendUint32(this.buf, uint32(len(o)))
+		this.buf = append(append(this.buf, o...), 0)
+		return pos
+	}
+	panic("flatbuffers: unknown object")
+}
+
+func (this *fbBuilder) table(t *fbTable) int {
+	offsets := make([]int, len(t.slots))
+	size := 4
+	for i, slot := range t.slots {
+		if slot.size == 0 {
+			continue
+		}
+		size = (size + slot.size - 1) / slot.size * slot.size
+		offsets[i] = size
+		size += slot.size
+	}
+	this.align(2)
+	vtable := len(this.buf)
+	this.buf = binary.LittleEndian.AppendUint16(this.buf, uint16(4+2*len(t.slots)))
+	this.buf = binary.LittleEndian.AppendUint16(this.buf, uint16(size))
+	for _, offset := range offsets {
+		this.buf = binary.LittleEndian.AppendUint16(this.buf, uint16(offset))
+	}
+	this.align(8)
+	pos := len(this.buf)
+	this.buf = append(this.buf, make([]byte, size)...)
+	binary.LittleEndian.PutUint32(this.buf[pos:], uint32(pos-vtable))
+	for i, slot := range t.slots {
+		if slot.size == 0 || slot.child != nil {
+			continue
+		}
+		for b := 0; b < slot.size; b++ {
+			this.buf[pos+offsets[i]+b] = byte(slot.value >> (8 * b))
+		}
+	}
+	for i, slot := range t.slots {
+		if slot.child != nil {
+			this.patch(pos+offsets[i], this.object(slot.child))
+		}
+	}
+	return pos
+}
+
+func (this *fbBuilder) vector(v *fbVector) int {
+	if v.refs != nil {
+		this.align(4)
+		pos := len(this.buf)
+		this.buf = binary.LittleEndian.AppendUint32(this.buf, uint32(len(v.refs)))
+		this.buf = append(this.buf, make([]byte, 4*len(v.refs))...)
+		for i, child := range v.refs {
+			this.patch(pos+4+4*i, this.object(child))
+		}
+		return pos
+	}
+	for (len(this.buf)+4)%v.align != 0 {
+		this.buf = append(this.buf, 0)
+	}
+	pos := len(this.buf)
+	this.buf = binary.LittleEndian.AppendUint32(this.buf, uint32(v.n))
+	this.buf = append(this.buf, v.data...)
+	return pos
+}
+
+type fbReader struct {
+	buf []byte
+	pos int
+}
+
+func fbRoot(buf []byte) fbReader {
+	return fbReader{buf, int(binary.LittleEndian.Uint32(buf))}
+}
+
+func (this fbReader) field(slot int) int {
+	vtable := this.pos - int(int32(binary.LittleEndian.Uint32(this.buf[this.pos:])))
+	if o := 4 + 2*slot; o < int(binary.LittleEndian.Uint16(this.buf[vtable:])) {
+		return int(binary.LittleEndian.Uint16(this.buf[vtable+o:]))
+	}
+	return 0
+}
+
+func (this fbReader) scalar(slot int, size int) uint64 {
+	offset := this.field(slot)
+	if offset == 0 {
+		return 0
+	}
+	value := uint64(0)
+	for b := 0; b < size; b++ {
+		value |= uint64(this.buf[this.pos+offset+b]) << (8 * b)
+	}
+	return value
+}
+
+func (this fbReader) deref(pos int) int {
+	return pos + int(binary.LittleEndian.Uint32(this.buf[pos:]))
+}
+
+func (this fbReader) table(slot int) (fbReader, bool) {
+	offset := this.field(slot)
+	if offset == 0 {
+		return this, false
+	}
+	return fbReader{this.buf, this.deref(this.pos + offset)}, true
+}
+
+func (this fbReader) string(slot int) string {
+	offset := this.field(slot)
+	if offset == 0 {
+		return ""
+	}
+	pos := this.deref(this.pos + offset)
+	n := int(binary.LittleEndian.Uint32(this.buf[pos:]))
+	return string(this.buf[pos+4 : pos+4+n])
+}
+
+/*Returns the position of the first element and the number of elements*/
+func (this fbReader) vector(slot int) (int, int) {
+	offset := this.field(slot)
+	if offset == 0 {
+		return 0, 0
+	}
+	pos := this.deref(this.pos + offset)
+	return pos + 4, int(binary.LittleEndian.Uint32(this.buf[pos:]))
+}
+
+func (this fbReader) tableAt(pos int) fbReader {
+	return fbReader{this.buf, this.deref(pos)}
+}
@@ -13,6 +13,7 @@ import (
 var native_arrays = make(map[string]func(n int64) any)
 var native_writers = make(map[string]func() IWriter)
 var native_readers = make(map[string]func() IReader)
+var native_marshalers = make(map[string]func(obj unsafe.Pointer) ([]byte, error))
 
 func init() {
 	nameAny := NameFix(utils.TypeName[any]())
@@ -55,7 +56,7 @@ func GetNativeWriterTp[T any]() IWriter {
 
 func newNativeType[T any]() string {
 	name := NameFix(utils.TypeName[T]())
-	if name == utils.TypeName[any]() {
+	if _, custom := native_marshalers[name]; custom || name == utils.TypeName[any]() {
 		return name
 	}
 	f := func(decoder *gob.Decoder) (any, error) {
@@ -74,6 +75,65 @@ func newNativeType[T any]() string {
 	return name
 }
 
+/*
+Replaces the gob reflection encoding of T with custom functions, for example the ones generated for protobuf messages.
+The encoded bytes are still written as a gob []byte, so they can be mixed with other native values in the same stream.
+*/
+func SetNativeSerializer[T any](marshal func(obj *T) ([]byte, error), unmarshal func(data []byte, obj *T) error) {
+	name := NameFix(utils.TypeName[T]())
+	if marshal == nil {
+		delete(native_marshalers, name)
+		newNativeType[T]()
+		return
+	}
+	f := func(decoder *gob.Decoder) (any, error) {
+		var data []byte
+		if err := decoder.Decode(&data); err != nil {
+			return nil, err
+		}
+		v := new(T)
+		return *v, unmarshal(data, v)
+	}
+	native_marshalers[name] = func(obj unsafe.Pointer) ([]byte, error) {
+		return marshal((*T)(obj))
+	}
+	native_arrays[name] = func(n int64) any {
+		return make([]T, n)
+	}
+	native_writers[name] = func() IWriter {
+		return &INativeSerializerWriter[T]{marshal: marshal}
+	}
+	native_readers[name] = func() IReader {
+		return &INativeReader{f, nil, nil}
+	}
+}
+
+type INativeSerializerWriter[T any] struct {
+	marshal  func(obj *T) ([]byte, error)
+	encoder  *gob.Encoder
+	protocol thrift.TProtocol
+}
+
+func (this *INativeSerializerWriter[T]) Write(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+	if this.protocol != protocol {
+		this.protocol = protocol
+		this.encoder = gob.NewEncoder(protocol.Transport())
+	}
+	data, err := this.marshal((*T)(obj))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(this.encoder.Encode(data))
+}
+
+func (this *INativeSerializerWriter[T]) WriteType(protocol thrift.TProtocol) error {
+	return nil
+}
+
+func (this *INativeSerializerWriter[T]) Type() int8 {
+	return I_VOID
+}
+
 type INativeWriter[T any] struct {
 	encoder  *gob.Encoder
 	protocol thrift.TProtocol
@@ -109,6 +169,15 @@ func (this *IAnyNativeWriter) Write(protocol thrift.TProtocol, rtp reflect.Type,
 	if err := this.encoder.Encode(val.Type().String()); err != nil {
 		return ierror.Raise(err)
 	}
+	if marshal, custom := native_marshalers[NameFix(val.Type().String())]; custom {
+		ptr := reflect.New(val.Type())
+		ptr.Elem().Set(val)
+		data, err := marshal(ptr.UnsafePointer())
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		return ierror.Raise(this.encoder.Encode(data))
+	}
 	return ierror.Raise(this.encoder.EncodeValue(val))
 }
 
@@ -253,6 +322,46 @@ func WriteNative(protocol thrift.TProtocol, obj any) error {
 	return nil
 }
 
+/*Native version of WriteChunks*/
+func WriteNativeChunks[T any](protocol thrift.TProtocol, chunks [][]T) error {
+	tp := utils.TypeObj[T]()
+	if tp.Kind() == reflect.Interface {
+		var all []T
+		for _, chunk := range chunks {
+			all = append(all, chunk...)
+		}
+		return WriteNative(protocol, all)
+	}
+	sz := int64(0)
+	for _, chunk := range chunks {
+		sz += int64(len(chunk))
+	}
+	if err := protocol.WriteBool(context.Background(), true); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := protocol.WriteString(context.Background(), tp.String()); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := WriteSizeAux(protocol, sz); err != nil {
+		return ierror.Raise(err)
+	}
+	if sz == 0 {
+		return nil
+	}
+	writer, err := GetNativeWriter(tp.String())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for _, chunk := range chunks {
+		for i := range chunk {
+			if err = writer.Write(protocol, tp, unsafe.Pointer(&chunk[i])); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+	}
+	return nil
+}
+
 func ReadNative(protocol thrift.TProtocol) (any, error) {
 	isArray, err := protocol.ReadBool(context.Background())
 	if err != nil {
@@ -271,7 +380,7 @@ func ReadNative(protocol thrift.TProtocol) (any, error) {
 		if err != nil {
 			return nil, ierror.Raise(err)
 		}
-		array := native_arrays[name](n)
+		array := native_arrays[NameFix(name)](n)
 		rarray := reflect.ValueOf(array)
 		for i := int64(0); i < n; i++ {
 			elem, err := reader.Read(protocol)
@@ -0,0 +1,39 @@
+package iio
+
+import (
+	"encoding/binary"
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/stretchr/testify/require"
+	"ignis/executor/core/itransport"
+	"testing"
+)
+
+type nativePoint struct {
+	X int32
+	Y int32
+}
+
+func TestNativeSerializer(t *testing.T) {
+	calls := 0
+	SetNativeSerializer[nativePoint](func(obj *nativePoint) ([]byte, error) {
+		calls++
+		data := binary.LittleEndian.AppendUint32(nil, uint32(obj.X))
+		return binary.LittleEndian.AppendUint32(data, uint32(obj.Y)), nil
+	}, func(data []byte, obj *nativePoint) error {
+		obj.X = int32(binary.LittleEndian.Uint32(data))
+		obj.Y = int32(binary.LittleEndian.Uint32(data[4:]))
+		return nil
+	})
+	defer SetNativeSerializer[nativePoint](nil, nil)
+	AddBasicType[nativePoint]()
+
+	buffer := itransport.NewIMemoryBuffer()
+	proto := thrift.NewTCompactProtocolConf(buffer, &thrift.TConfiguration{})
+	points := []nativePoint{{1, 2}, {3, -4}}
+	require.Nil(t, WriteNative(proto, points))
+	require.Equal(t, len(points), calls)
+
+	val, err := ReadNative(proto)
+	require.Nil(t, err)
+	require.Equal(t, points, val)
+}
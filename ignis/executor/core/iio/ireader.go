@@ -92,15 +92,57 @@ func Read[T any](protocol thrift.TProtocol) (r T, errr error) {
 		errr = ierror.Raise(err)
 		return
 	}
-	value, errr := reader.Read(protocol)
+	value, err := reader.Read(protocol)
 	if err != nil {
 		errr = ierror.Raise(err)
 		return
 	}
+	if value, err = ReadTyped[T](value); err != nil {
+		errr = ierror.Raise(err)
+		return
+	}
 	r = value.(T)
 	return
 }
 
+type ITypedReaderF func(value any) (any, error)
+
+var typedReaders = map[string]ITypedReaderF{}
+
+/*
+Types written as a basic type of the protocol are read back as that basic type, the typed reader rebuilds the
+original type when the expected type is known.
+*/
+func SetTypedReader(name string, f ITypedReaderF) {
+	typedReaders[NameFix(name)] = f
+}
+
+/*Rebuilds a value or an array of values read without the Go type, other values are returned as they are*/
+func ReadTyped[T any](value any) (any, error) {
+	if _, ok := value.(T); ok {
+		return value, nil
+	} else if _, ok := value.([]T); ok {
+		return value, nil
+	}
+	f, present := typedReaders[NameFix(utils.TypeName[T]())]
+	if !present {
+		return value, nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice || rv.Type().Elem().Kind() == reflect.Uint8 {
+		return f(value)
+	}
+	array := make([]T, rv.Len())
+	for i := range array {
+		elem, err := f(rv.Index(i).Interface())
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		array[i] = elem.(T)
+	}
+	return array, nil
+}
+
 type IReaderType struct {
 	readers map[string]IGenericReader
 	def     IGenericReader
@@ -0,0 +1,34 @@
+package iio
+
+import (
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/utils"
+	"reflect"
+	"time"
+	"unsafe"
+)
+
+func init() {
+	// RFC 3339 keeps the offset of the location, named locations are read back as a fixed zone
+	SetWriter(utils.TypeName[time.Time](), NewIWrite(I_STRING, func(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+		return protocol.WriteString(ctx, (*time.Time)(obj).Format(time.RFC3339Nano))
+	}))
+	SetWriter(utils.TypeName[time.Duration](), NewIWrite(I_I64, func(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+		return protocol.WriteI64(ctx, *(*int64)(obj))
+	}))
+
+	SetTypedReader(utils.TypeName[time.Time](), func(value any) (any, error) {
+		t, err := time.Parse(time.RFC3339Nano, value.(string))
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		return t, nil
+	})
+	SetTypedReader(utils.TypeName[time.Duration](), func(value any) (any, error) {
+		return time.Duration(value.(int64)), nil
+	})
+	utils.SetHasher(func(e *time.Time) uint64 {
+		return utils.HashValue(e.UnixNano())
+	})
+}
@@ -0,0 +1,36 @@
+package iio
+
+import (
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/api/iuuid"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/utils"
+	"reflect"
+	"unsafe"
+)
+
+func init() {
+	SetWriter(utils.TypeName[iuuid.IUUID](), NewIWrite(I_BINARY, func(protocol thrift.TProtocol, rtp reflect.Type, obj unsafe.Pointer) error {
+		id := (*iuuid.IUUID)(obj)
+		if err := WriteSizeAux(protocol, int64(len(id))); err != nil {
+			return ierror.Raise(err)
+		}
+		for _, e := range id {
+			if err := protocol.WriteByte(ctx, int8(e)); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		return nil
+	}))
+
+	SetTypedReader(utils.TypeName[iuuid.IUUID](), func(value any) (any, error) {
+		id, err := iuuid.FromBytes(value.([]byte))
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		return id, nil
+	})
+	utils.SetHasher(func(e *iuuid.IUUID) uint64 {
+		return e.High() ^ e.Low()
+	})
+}
@@ -40,6 +40,40 @@ func Write[T any](protocol thrift.TProtocol, obj T) error {
 	return writer.Write(protocol, tp, unsafe.Pointer(&obj))
 }
 
+/*Writes the chunks as a single array, the result is the same as writing their concatenation*/
+func WriteChunks[T any](protocol thrift.TProtocol, chunks [][]T) error {
+	writer, _, err := GetWriterObj([]T(nil))
+	array, ok := writer.(*IArrayWriterType[T])
+	if err != nil || !ok || array.err != nil {
+		var all []T
+		for _, chunk := range chunks {
+			all = append(all, chunk...)
+		}
+		return Write(protocol, all)
+	}
+	sz := 0
+	for _, chunk := range chunks {
+		sz += len(chunk)
+	}
+	if err = array.WriteType(protocol); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = WriteSizeAux(protocol, int64(sz)); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = array.valWriter.WriteType(protocol); err != nil {
+		return ierror.Raise(err)
+	}
+	for _, chunk := range chunks {
+		for i := range chunk {
+			if err = array.valWriter.Write(protocol, array.rtp, unsafe.Pointer(&chunk[i])); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+	}
+	return nil
+}
+
 func SetWriter(id string, value IWriter) {
 	id = NameFix(id)
 	if value != nil {
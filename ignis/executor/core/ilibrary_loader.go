@@ -11,11 +11,12 @@ import (
 
 type ILibraryLoader struct {
 	executorData *IExecutorData
+	paths        []string
 }
 
 func NewILibraryLoader(executorData *IExecutorData) *ILibraryLoader {
 	return &ILibraryLoader{
-		executorData,
+		executorData: executorData,
 	}
 }
 
@@ -43,6 +44,7 @@ func (this *ILibraryLoader) LoadFunction(name string) (any, error) {
 	if err != nil {
 		return nil, ierror.RaiseMsgCause(path+" could not be loaded", err)
 	}
+	this.addPath(path)
 
 	v, err := library.Lookup("New" + class_name)
 	if err != nil {
@@ -74,6 +76,7 @@ func (this *ILibraryLoader) LoadLibrary(path string) ([]string, error) {
 	if err != nil {
 		return nil, ierror.RaiseMsgCause(path+" could not be loaded", err)
 	}
+	this.addPath(path)
 
 	v, err := library.Lookup("IgnisLibrary")
 	if err != nil {
@@ -87,6 +90,19 @@ func (this *ILibraryLoader) LoadLibrary(path string) ([]string, error) {
 	return ignis_library, nil
 }
 
+func (this *ILibraryLoader) addPath(path string) {
+	for _, p := range this.paths {
+		if p == path {
+			return
+		}
+	}
+	this.paths = append(this.paths, path)
+}
+
+func (this *ILibraryLoader) Paths() []string {
+	return this.paths
+}
+
 func (this *ILibraryLoader) CreateType(name string, path string) (string, error) {
 	return "", ierror.RaiseMsg("Not implemented yet") //TODO
 }
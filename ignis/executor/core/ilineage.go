@@ -0,0 +1,113 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/logger"
+	"sync"
+)
+
+/*Module operation and input partitions that produced an output partition*/
+type ILineageRecord struct {
+	Operation string
+	Inputs    []int64
+	Executors []int
+}
+
+type ILineage struct {
+	mu        sync.Mutex
+	operation string
+	records   []ILineageRecord
+	lost      []int
+	recompute func() ([]int, error)
+	exchanges int
+}
+
+func NewILineage() *ILineage {
+	return &ILineage{}
+}
+
+/*Starts a new module operation, partitions lost by the previous one can no longer be recomputed*/
+func (this *ILineage) Begin(operation string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.operation = operation
+	this.lost = nil
+	this.recompute = nil
+	this.exchanges = 0
+}
+
+func (this *ILineage) Operation() string {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.operation
+}
+
+/*Position of a new exchange inside the current operation*/
+func (this *ILineage) NextExchange() int {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.exchanges++
+	return this.exchanges - 1
+}
+
+func (this *ILineage) Record(records []ILineageRecord) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.records = records
+}
+
+/*Partitions without an explicit record were produced from the partition with the same index*/
+func (this *ILineage) Produced(n int) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if len(this.records) == n {
+		return
+	}
+	this.records = make([]ILineageRecord, n)
+	for i := range this.records {
+		this.records[i] = ILineageRecord{Operation: this.operation, Inputs: []int64{int64(i)}}
+	}
+}
+
+func (this *ILineage) Records() []ILineageRecord {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return append([]ILineageRecord(nil), this.records...)
+}
+
+/*Keeps the function that recomputes the lost partitions, it returns the partitions that are still lost*/
+func (this *ILineage) Lost(partitions []int, recompute func() ([]int, error)) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.lost = partitions
+	this.recompute = recompute
+}
+
+func (this *ILineage) Pending() []int {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return append([]int(nil), this.lost...)
+}
+
+func (this *ILineage) Recompute() (int, error) {
+	this.mu.Lock()
+	recompute := this.recompute
+	n := len(this.lost)
+	this.mu.Unlock()
+	if recompute == nil {
+		return 0, nil
+	}
+	logger.Info("Lineage: recomputing ", n, " partitions of ", this.Operation())
+	lost, err := recompute()
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.lost = lost
+	if len(lost) > 0 {
+		return n - len(lost), ierror.RaiseMsg("partitions are still lost after recomputation")
+	}
+	this.recompute = nil
+	return n, nil
+}
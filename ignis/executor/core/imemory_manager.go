@@ -0,0 +1,185 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/utils"
+	"sync"
+)
+
+/*
+Storage (cached partitions) and execution (exchange and aggregation buffers) share a single pool of
+ignis.executor.memory * ignis.executor.memory.fraction bytes. Execution can take the memory of the storage by
+evicting cached partitions and can ask other execution consumers to spill, storage never takes execution memory.
+Without ignis.executor.memory the memory is only accounted.
+*/
+type IMemoryManager struct {
+	mu         sync.Mutex
+	properties *IPropertyParser
+	limit      int64
+	storage    int64
+	execution  int64
+	evictors   []func(need int64) (int64, error)
+	consumers  map[IMemoryConsumer]bool
+	started    bool
+}
+
+/*Execution consumer that can free its memory on demand, Spill must release the freed bytes itself*/
+type IMemoryConsumer interface {
+	Spill(need int64) (int64, error)
+}
+
+func (this *IMemoryManager) init() error {
+	if this.started {
+		return nil
+	}
+	memory, err := this.properties.ExecutorMemory()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	fraction, err := this.properties.MemoryFraction()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.limit = int64(float64(memory) * fraction)
+	if this.limit > 0 {
+		logger.Info("Memory: ", this.limit, " bytes shared by storage and execution")
+	}
+	this.consumers = map[IMemoryConsumer]bool{}
+	this.started = true
+	return nil
+}
+
+/*Zero if the memory is not limited*/
+func (this *IMemoryManager) Limit() int64 {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	_ = this.init()
+	return this.limit
+}
+
+/*Bytes held by storage and execution*/
+func (this *IMemoryManager) Used() (int64, int64) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.storage, this.execution
+}
+
+func (this *IMemoryManager) free() int64 {
+	return this.limit - this.storage - this.execution
+}
+
+/*Evictors are called in registration order when execution needs memory held by the storage*/
+func (this *IMemoryManager) AddEvictor(evictor func(need int64) (int64, error)) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.evictors = append(this.evictors, evictor)
+}
+
+func (this *IMemoryManager) AddConsumer(consumer IMemoryConsumer) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	_ = this.init()
+	this.consumers[consumer] = true
+}
+
+func (this *IMemoryManager) RemoveConsumer(consumer IMemoryConsumer) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	delete(this.consumers, consumer)
+}
+
+/*
+Returns false if the bytes do not fit in the free memory, so the partition should be stored elsewhere. With force the
+bytes are accounted anyway, for partitions that can only be kept in memory.
+*/
+func (this *IMemoryManager) AcquireStorage(bytes int64, force bool) (bool, error) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if err := this.init(); err != nil {
+		return false, ierror.Raise(err)
+	}
+	fit := this.limit == 0 || bytes <= this.free()
+	if fit || force {
+		this.storage += bytes
+	}
+	return fit, nil
+}
+
+func (this *IMemoryManager) ReleaseStorage(bytes int64) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.storage = utils.Max(this.storage-bytes, 0)
+}
+
+/*
+Returns the bytes granted, which can be less than requested if neither evicting cached partitions nor spilling other
+consumers frees enough memory. The caller must release the granted bytes.
+*/
+func (this *IMemoryManager) AcquireExecution(bytes int64, owner IMemoryConsumer) (int64, error) {
+	this.mu.Lock()
+	if err := this.init(); err != nil {
+		this.mu.Unlock()
+		return 0, ierror.Raise(err)
+	}
+	if this.limit == 0 || bytes <= this.free() {
+		this.execution += bytes
+		this.mu.Unlock()
+		return bytes, nil
+	}
+	evictors := append([]func(int64) (int64, error){}, this.evictors...)
+	consumers := make([]IMemoryConsumer, 0, len(this.consumers))
+	for consumer := range this.consumers {
+		if consumer != owner {
+			consumers = append(consumers, consumer)
+		}
+	}
+	this.mu.Unlock()
+
+	/*Callbacks release memory, so they are called without the lock*/
+	need := bytes - this.Free()
+	for _, evictor := range evictors {
+		if need <= 0 {
+			break
+		}
+		freed, err := evictor(need)
+		if err != nil {
+			return 0, ierror.Raise(err)
+		}
+		need -= freed
+	}
+	if need > 0 && len(consumers) > 0 {
+		logger.Info("Memory: spilling execution consumers to get ", need, " bytes")
+	}
+	for _, consumer := range consumers {
+		if need <= 0 {
+			break
+		}
+		freed, err := consumer.Spill(need)
+		if err != nil {
+			return 0, ierror.Raise(err)
+		}
+		need -= freed
+	}
+
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	granted := utils.Max(utils.Min(bytes, this.free()), 0)
+	if granted < bytes {
+		logger.Warn("Memory: execution requested ", bytes, " bytes but only ", granted, " are available")
+	}
+	this.execution += granted
+	return granted, nil
+}
+
+func (this *IMemoryManager) ReleaseExecution(bytes int64) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.execution = utils.Max(this.execution-bytes, 0)
+}
+
+func (this *IMemoryManager) Free() int64 {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return this.free()
+}
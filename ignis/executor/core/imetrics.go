@@ -0,0 +1,276 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"io"
+	"math"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	iMetricCounterType   = "counter"
+	iMetricGaugeType     = "gauge"
+	iMetricHistogramType = "histogram"
+)
+
+var iMetricBytesBuckets = []float64{1 << 10, 1 << 14, 1 << 17, 1 << 20, 1 << 23, 1 << 26, 1 << 30}
+var iMetricSecondsBuckets = []float64{0.001, 0.01, 0.1, 0.5, 1, 5, 30, 120, 600}
+
+type iMetricHistogram struct {
+	buckets []float64
+	counts  []int64
+	sum     float64
+	count   int64
+}
+
+/*Executor metrics exported in prometheus text format*/
+type IMetrics struct {
+	mu         sync.Mutex
+	families   map[string]string
+	counters   map[string]float64
+	gauges     map[string]float64
+	histograms map[string]*iMetricHistogram
+	server     *http.Server
+	cancel     *ICancelToken
+	trace      *ITrace
+}
+
+func NewIMetrics() *IMetrics {
+	return &IMetrics{
+		families:   make(map[string]string),
+		counters:   make(map[string]float64),
+		gauges:     make(map[string]float64),
+		histograms: make(map[string]*iMetricHistogram),
+	}
+}
+
+func metricKey(name string, labels []string) string {
+	if len(labels) == 0 {
+		return name
+	}
+	var key strings.Builder
+	key.WriteString(name)
+	key.WriteByte('{')
+	for i := 0; i+1 < len(labels); i += 2 {
+		if i > 0 {
+			key.WriteByte(',')
+		}
+		key.WriteString(labels[i])
+		key.WriteString("=")
+		key.WriteString(strconv.Quote(labels[i+1]))
+	}
+	key.WriteByte('}')
+	return key.String()
+}
+
+func (this *IMetrics) family(name, tp string) {
+	if _, ok := this.families[name]; !ok {
+		this.families[name] = tp
+	}
+}
+
+/*Labels are passed as key value pairs*/
+func (this *IMetrics) Count(name string, value float64, labels ...string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.family(name, iMetricCounterType)
+	this.counters[metricKey(name, labels)] += value
+}
+
+func (this *IMetrics) Set(name string, value float64, labels ...string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.family(name, iMetricGaugeType)
+	this.gauges[metricKey(name, labels)] = value
+}
+
+func (this *IMetrics) Observe(name string, buckets []float64, value float64, labels ...string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.family(name, iMetricHistogramType)
+	key := metricKey(name, labels)
+	hist, ok := this.histograms[key]
+	if !ok {
+		hist = &iMetricHistogram{buckets: buckets, counts: make([]int64, len(buckets))}
+		this.histograms[key] = hist
+	}
+	for i, bound := range hist.buckets {
+		if value <= bound {
+			hist.counts[i]++
+		}
+	}
+	hist.sum += value
+	hist.count++
+}
+
+func (this *IMetrics) Transfer(peer int, send bool, bytes int64) {
+	direction := "recv"
+	if send {
+		direction = "send"
+	}
+	if this.trace != nil {
+		this.trace.Transfer(send, bytes)
+	}
+	this.Count("ignis_exchange_bytes_total", float64(bytes), "direction", direction)
+	this.Observe("ignis_exchange_transfer_bytes", iMetricBytesBuckets, float64(bytes),
+		"peer", strconv.Itoa(peer), "direction", direction)
+}
+
+func (this *IMetrics) ModuleTime(method string, elapsed time.Duration) {
+	this.Observe("ignis_module_seconds", iMetricSecondsBuckets, elapsed.Seconds(), "method", method)
+}
+
+func (this *IMetrics) Partitions(group storage.IPartitionGroupBase) {
+	bytes := int64(0)
+	for i := 0; i < group.Size(); i++ {
+		bytes += group.GetBase(i).Bytes()
+	}
+	this.Set("ignis_partitions", float64(group.Size()))
+	this.Set("ignis_partitions_bytes", float64(bytes))
+}
+
+func withBucket(key string, bound string) string {
+	if i := strings.IndexByte(key, '{'); i >= 0 {
+		return key[:i] + "_bucket{" + key[i+1:len(key)-1] + ",le=\"" + bound + "\"}"
+	}
+	return key + "_bucket{le=\"" + bound + "\"}"
+}
+
+func withSuffix(key string, suffix string) string {
+	if i := strings.IndexByte(key, '{'); i >= 0 {
+		return key[:i] + suffix + key[i:]
+	}
+	return key + suffix
+}
+
+func metricName(key string) string {
+	if i := strings.IndexByte(key, '{'); i >= 0 {
+		return key[:i]
+	}
+	return key
+}
+
+func formatMetric(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func (this *IMetrics) WriteTo(w io.Writer) (int64, error) {
+	events, elements := storage.SpillStats()
+	hits, misses := itransport.BufferPoolStats()
+	this.mu.Lock()
+	this.family("ignis_spill_events_total", iMetricCounterType)
+	this.family("ignis_spill_elements_total", iMetricCounterType)
+	this.counters["ignis_spill_events_total"] = float64(events)
+	this.counters["ignis_spill_elements_total"] = float64(elements)
+	this.family("ignis_buffer_pool_hits_total", iMetricCounterType)
+	this.family("ignis_buffer_pool_misses_total", iMetricCounterType)
+	this.counters["ignis_buffer_pool_hits_total"] = float64(hits)
+	this.counters["ignis_buffer_pool_misses_total"] = float64(misses)
+
+	series := make(map[string][]string)
+	for key, value := range this.counters {
+		series[metricName(key)] = append(series[metricName(key)], key+" "+formatMetric(value))
+	}
+	for key, value := range this.gauges {
+		series[metricName(key)] = append(series[metricName(key)], key+" "+formatMetric(value))
+	}
+	for key, hist := range this.histograms {
+		lines := make([]string, 0, len(hist.buckets)+3)
+		for i, bound := range hist.buckets {
+			lines = append(lines, withBucket(key, formatMetric(bound))+" "+strconv.FormatInt(hist.counts[i], 10))
+		}
+		lines = append(lines, withBucket(key, "+Inf")+" "+strconv.FormatInt(hist.count, 10))
+		lines = append(lines, withSuffix(key, "_sum")+" "+formatMetric(hist.sum))
+		lines = append(lines, withSuffix(key, "_count")+" "+strconv.FormatInt(hist.count, 10))
+		series[metricName(key)] = append(series[metricName(key)], lines...)
+	}
+	names := make([]string, 0, len(this.families))
+	for name := range this.families {
+		names = append(names, name)
+	}
+	families := make(map[string]string, len(this.families))
+	for name, tp := range this.families {
+		families[name] = tp
+	}
+	this.mu.Unlock()
+
+	sort.Strings(names)
+	var out strings.Builder
+	for _, name := range names {
+		lines := series[name]
+		if families[name] != iMetricHistogramType {
+			sort.Strings(lines)
+		}
+		fmt.Fprintf(&out, "# TYPE %s %s\n", name, families[name])
+		for _, line := range lines {
+			out.WriteString(line)
+			out.WriteByte('\n')
+		}
+	}
+	n, err := io.WriteString(w, out.String())
+	return int64(n), err
+}
+
+/*Starts a http server with the /metrics endpoint and the /cancel endpoint to abort the running job*/
+func (this *IMetrics) Serve(address string) error {
+	if this.server != nil {
+		return nil
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return ierror.RaiseMsgCause("metrics server can not listen on "+address, err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if _, err := this.WriteTo(w); err != nil {
+			logger.Warn("Metrics: ", err)
+		}
+	})
+	mux.HandleFunc("/cancel", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "cancel requires POST", http.StatusMethodNotAllowed)
+			return
+		}
+		if this.cancel == nil {
+			http.Error(w, "cancellation is not available", http.StatusServiceUnavailable)
+			return
+		}
+		this.cancel.Cancel(r.URL.Query().Get("reason"))
+		w.WriteHeader(http.StatusAccepted)
+	})
+	server := &http.Server{Handler: mux}
+	this.server = server
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logger.Error("Metrics: ", err)
+		}
+	}()
+	logger.Info("Metrics: serving on " + address)
+	return nil
+}
+
+func (this *IMetrics) Close() error {
+	if this.server == nil {
+		return nil
+	}
+	server := this.server
+	this.server = nil
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	return server.Shutdown(ctx)
+}
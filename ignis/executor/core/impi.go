@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"errors"
+	"github.com/apache/thrift/lib/go/thrift"
 	"ignis/executor/api"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/iio"
@@ -11,6 +13,7 @@ import (
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
+	"math"
 	"unsafe"
 )
 
@@ -18,6 +21,17 @@ type IMpi struct {
 	propertyParser *IPropertyParser
 	partitionTools *IPartitionTools
 	context        api.IContext
+	heartbeat      *IHeartbeat
+}
+
+func init() {
+	ierror.AddClassifier(func(err error) (int32, bool) {
+		var mpiErr *MpiError
+		if errors.As(err, &mpiErr) {
+			return ierror.CodeCommunication, true
+		}
+		return 0, false
+	})
 }
 
 func NewIMpi(propertyParser *IPropertyParser, partitionTools *IPartitionTools, context api.IContext) *IMpi {
@@ -25,6 +39,7 @@ func NewIMpi(propertyParser *IPropertyParser, partitionTools *IPartitionTools, c
 		propertyParser,
 		partitionTools,
 		context,
+		nil,
 	}
 }
 
@@ -32,6 +47,9 @@ func Gather[T any](this *IMpi, part storage.IPartition[T], root int) error {
 	if this.Executors() == 1 {
 		return nil
 	}
+	if err := this.check(); err != nil {
+		return ierror.Raise(err)
+	}
 	return gatherImpl[T](this, this.Native(), part, root, true)
 }
 
@@ -39,6 +57,10 @@ func Bcast[T any](this *IMpi, part storage.IPartition[T], root int) error {
 	if this.Executors() == 1 {
 		return nil
 	}
+	if err := this.check(); err != nil {
+		return ierror.Raise(err)
+	}
+	part = unwrapSpill(part)
 	if part.Type() == storage.IMemoryPartitionType {
 		if list, ok := part.Inner().(*storage.IListImpl[T]); ok && iio.IsContiguous[T]() {
 			sz := C_int(len(list.Array().([]T)))
@@ -48,9 +70,12 @@ func Bcast[T any](this *IMpi, part storage.IPartition[T], root int) error {
 			if !this.IsRoot(root) {
 				list.Resize(int(sz), true)
 			}
+			datatype, err := elemDatatype[T]()
+			if err != nil {
+				return ierror.Raise(err)
+			}
 			array := list.Array().([]T)
-			bytes := C_int(int(sz) * int(utils.TypeObj[T]().Size()))
-			if err := MPI_Bcast(PA(&array), bytes, MPI_BYTE, C_int(root), this.Native()); err != nil {
+			if err := MPI_Bcast(PA(&array), sz, datatype, C_int(root), this.Native()); err != nil {
 				return ierror.Raise(err)
 			}
 		} else {
@@ -65,7 +90,7 @@ func Bcast[T any](this *IMpi, part storage.IPartition[T], root int) error {
 			}
 			var sz C_int
 			if this.IsRoot(root) {
-				if err = part.WriteWithNative(buffer, cmp, native); err != nil {
+				if err = writePartition(this, part, buffer, cmp, native); err != nil {
 					return ierror.Raise(err)
 				}
 				sz = C_int(buffer.WriteEnd())
@@ -530,6 +555,14 @@ func SendGroupOpt[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T
 }
 
 func Send[T any](this *IMpi, part storage.IPartition[T], dest int, tag int) error {
+	if tcp, err := this.tcp(); err != nil {
+		return ierror.Raise(err)
+	} else if tcp != nil {
+		return sendTcp(this, tcp, part, dest, tag)
+	}
+	if err := this.handshake(this.Native(), true, dest, tag); err != nil {
+		return ierror.Raise(err)
+	}
 	return sendRcv(this, part, this.Rank(), dest, tag)
 }
 
@@ -554,6 +587,14 @@ func RecvGroupOpt[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T
 }
 
 func Recv[T any](this *IMpi, part storage.IPartition[T], source int, tag int) error {
+	if tcp, err := this.tcp(); err != nil {
+		return ierror.Raise(err)
+	} else if tcp != nil {
+		return recvTcp(this, tcp, part, source, tag)
+	}
+	if err := this.handshake(this.Native(), false, source, tag); err != nil {
+		return ierror.Raise(err)
+	}
 	return sendRcv(this, part, source, this.Rank(), tag)
 }
 
@@ -578,6 +619,7 @@ func SendRcv[T any](this *IMpi, sendp storage.IPartition[T], rcvp storage.IParti
 }
 
 func gatherImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T], root int, sameProtocol bool) error {
+	part = unwrapSpill(part)
 	var rank int
 	var executors int
 	{
@@ -592,9 +634,17 @@ func gatherImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T],
 		executors = int(aux)
 	}
 	if part.Type() == storage.IMemoryPartitionType {
+		if codec, err := this.wireCodec(); err != nil {
+			return ierror.Raise(err)
+		} else if codec != nil {
+			return gatherCodecImpl(this, group, part, root, rank, executors, sameProtocol, codec)
+		}
 		if list, ok := part.Inner().(*storage.IListImpl[T]); ok && iio.IsContiguous[T]() && sameProtocol {
-			elemSz := int(utils.TypeObj[T]().Size())
-			sz := C_int(int(part.Size()) * elemSz)
+			datatype, err := elemDatatype[T]()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			sz := C_int(part.Size())
 			szv := []C_int{0}
 			displs := []C_int{0}
 			if rank == root {
@@ -605,21 +655,22 @@ func gatherImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T],
 			}
 			if rank == root {
 				displs = this.displs(szv)
-				list.Resize(int(displs[len(displs)-1])/elemSz, false)
+				list.Resize(int(displs[len(displs)-1]), false)
 				array := list.Array().([]T)
 				//Use same buffer to rcv elements
-				move[T](array, int(szv[rank])/elemSz, int(displs[rank])/elemSz)
-				if err := MPI_Gatherv(MPI_IN_PLACE, C_int(sz), MPI_BYTE, PA(&array), &szv[0], &displs[0], MPI_BYTE, C_int(root), group); err != nil {
+				move[T](array, int(szv[rank]), int(displs[rank]))
+				if err := MPI_Gatherv(MPI_IN_PLACE, sz, datatype, PA(&array), &szv[0], &displs[0], datatype, C_int(root), group); err != nil {
 					return ierror.Raise(err)
 				}
 			} else {
 				array := list.Array().([]T)
-				if err := MPI_Gatherv(PA(&array), C_int(sz), MPI_BYTE, C_NULL(), nil, nil, MPI_BYTE, C_int(root), group); err != nil {
+				if err := MPI_Gatherv(PA(&array), sz, datatype, C_NULL(), nil, nil, datatype, C_int(root), group); err != nil {
 					return ierror.Raise(err)
 				}
 			}
 		} else {
-			buffer := itransport.NewIMemoryBuffer()
+			buffer := itransport.NewIPooledMemoryBuffer(itransport.MemoryDefaultSize)
+			defer buffer.Release()
 			sz := C_int(0)
 			szv := []C_int{0}
 			displs := []C_int{0}
@@ -632,7 +683,7 @@ func gatherImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T],
 				return ierror.Raise(err)
 			}
 			if rank != root {
-				if err = part.WriteWithNative(buffer, cmp, native); err != nil {
+				if err = writePartition(this, part, buffer, cmp, native); err != nil {
 					return ierror.Raise(err)
 				}
 				sz = C_int(buffer.WriteEnd())
@@ -729,7 +780,8 @@ func sendRecvGroupImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartit
 	if opt.sameStorage {
 		return sendRecvImpl(this, group, part, source, dest, tag, opt.sameStorage)
 	} else {
-		buffer := itransport.NewIMemoryBuffer()
+		buffer := itransport.NewIPooledMemoryBuffer(itransport.MemoryDefaultSize)
+		defer buffer.Release()
 		sz := C_int(0)
 		if id == source {
 			cmp, err := this.propertyParser.MsgCompression()
@@ -740,7 +792,7 @@ func sendRecvGroupImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartit
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			if err = part.WriteWithNative(buffer, cmp, native); err != nil {
+			if err = writePartition(this, part, buffer, cmp, native); err != nil {
 				return ierror.Raise(err)
 			}
 			sz = C_int(buffer.WriteEnd())
@@ -778,6 +830,7 @@ func sendRecvGroupImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartit
 }
 
 func sendRecvImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T], source int, dest int, tag int, sameProtocol bool) error {
+	part = unwrapSpill(part)
 	var id int
 	{
 		var aux C_int
@@ -787,16 +840,27 @@ func sendRecvImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T
 		id = int(aux)
 	}
 	if part.Type() == storage.IMemoryPartitionType {
+		codec, err := this.wireCodec()
+		if err != nil {
+			return ierror.Raise(err)
+		}
 		if list, ok := part.Inner().(*storage.IListImpl[T]); ok && iio.IsContiguous[T]() && sameProtocol {
 			sz := C_int(part.Size())
 			elemSz := int(utils.TypeObj[T]().Size())
+			datatype, err := elemDatatype[T]()
+			if err != nil {
+				return ierror.Raise(err)
+			}
 			if id == source {
 				if err := MPI_Send(P(&sz), 1, MPI_INT, C_int(dest), C_int(tag), group); err != nil {
 					return ierror.Raise(err)
 				}
 				array := list.Array().([]T)
-				if err := MPI_Send(PA(&array), C_int(int(sz)*elemSz), MPI_BYTE, C_int(dest),
-					C_int(tag), group); err != nil {
+				if codec != nil {
+					if err := codec.send(group, arrayBytes(array, elemSz), dest, tag); err != nil {
+						return ierror.Raise(err)
+					}
+				} else if err := MPI_Send(PA(&array), sz, datatype, C_int(dest), C_int(tag), group); err != nil {
 					return ierror.Raise(err)
 				}
 			} else {
@@ -805,14 +869,20 @@ func sendRecvImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T
 					return ierror.Raise(err)
 				}
 				list.Resize(int(init)+int(sz), false)
-				array := list.Array().([]T)
-				if err := MPI_Recv(PA(&array), C_int(int(sz)*elemSz), MPI_BYTE, C_int(source), C_int(tag), group,
-					MPI_STATUS_IGNORE); err != nil {
+				array := list.Array().([]T)[init:]
+				if codec != nil {
+					if err := codec.recv(group, source, tag, func(n int) ([]byte, error) {
+						return arrayBytes(array, elemSz), nil
+					}); err != nil {
+						return ierror.Raise(err)
+					}
+				} else if err := MPI_Recv(PA(&array), sz, datatype, C_int(source), C_int(tag), group, MPI_STATUS_IGNORE); err != nil {
 					return ierror.Raise(err)
 				}
 			}
 		} else {
-			buffer := itransport.NewIMemoryBuffer()
+			buffer := itransport.NewIPooledMemoryBuffer(itransport.MemoryDefaultSize)
+			defer buffer.Release()
 			sz := C_int(0)
 			if id == source {
 				cmp, err := this.propertyParser.MsgCompression()
@@ -823,9 +893,15 @@ func sendRecvImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if err = part.WriteWithNative(buffer, cmp, native); err != nil {
+				if codec != nil {
+					cmp = 0
+				}
+				if err = writePartition(this, part, buffer, cmp, native); err != nil {
 					return ierror.Raise(err)
 				}
+				if codec != nil {
+					return ierror.Raise(codec.send(group, buffer.GetBufferAsBytes(), dest, tag))
+				}
 				sz = C_int(buffer.WriteEnd())
 				buffer.ResetBuffer()
 				if err = MPI_Send(P(&sz), 1, MPI_INT, C_int(dest), C_int(tag), group); err != nil {
@@ -838,6 +914,23 @@ func sendRecvImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T
 				if err = MPI_Send(P(&ptr[0]), sz, MPI_BYTE, C_int(dest), C_int(tag), group); err != nil {
 					return ierror.Raise(err)
 				}
+			} else if codec != nil {
+				if err := codec.recv(group, source, tag, func(n int) ([]byte, error) {
+					sz = C_int(n)
+					ptr, err := buffer.GetWritePtr(int64(n))
+					if err != nil {
+						return nil, err
+					}
+					return ptr[:n], nil
+				}); err != nil {
+					return ierror.Raise(err)
+				}
+				if err := buffer.WroteBytes(int64(sz)); err != nil {
+					return ierror.Raise(err)
+				}
+				if err := part.Read(buffer); err != nil {
+					return ierror.Raise(err)
+				}
 			} else {
 				if err := MPI_Recv(P(&sz), 1, MPI_INT, C_int(source), C_int(tag), group, MPI_STATUS_IGNORE); err != nil {
 					return ierror.Raise(err)
@@ -863,7 +956,67 @@ func sendRecvImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T
 	}
 }
 
+func (this *IMpi) BcastBytes(data []byte, chunk int, root int) ([]byte, error) {
+	if this.Executors() == 1 {
+		return data, nil
+	}
+	sz := int64(len(data))
+	if err := MPI_Bcast(P(&sz), 1, MPI_INT64_T, C_int(root), this.Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if !this.IsRoot(root) {
+		data = make([]byte, sz)
+	}
+	if chunk <= 0 || chunk > math.MaxInt32 {
+		chunk = math.MaxInt32
+	}
+	for i := int64(0); i < sz; i += int64(chunk) {
+		n := sz - i
+		if n > int64(chunk) {
+			n = int64(chunk)
+		}
+		if err := MPI_Bcast(P(&data[i]), C_int(n), MPI_BYTE, C_int(root), this.Native()); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	return data, nil
+}
+
+/*Sends data to dest while receiving the data of source, messages are split in chunks that fit in a C int*/
+func (this *IMpi) SendRcvBytes(data []byte, dest int, source int, tag int) ([]byte, error) {
+	return this.SendRcvBytesGroup(this.Native(), data, dest, source, tag)
+}
+
+func (this *IMpi) SendRcvBytesGroup(group C_MPI_Comm, data []byte, dest int, source int, tag int) ([]byte, error) {
+	sz := int64(len(data))
+	var rsz int64
+	if err := MPI_Sendrecv(P(&sz), 1, MPI_INT64_T, C_int(dest), C_int(tag), P(&rsz), 1, MPI_INT64_T, C_int(source),
+		C_int(tag), group, MPI_STATUS_IGNORE); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	result := make([]byte, rsz)
+	for i := int64(0); i < sz || i < rsz; i += math.MaxInt32 {
+		scount := utils.Max(utils.Min(sz-i, math.MaxInt32), 0)
+		rcount := utils.Max(utils.Min(rsz-i, math.MaxInt32), 0)
+		var sptr, rptr unsafe.Pointer
+		if scount > 0 {
+			sptr = P(&data[i])
+		}
+		if rcount > 0 {
+			rptr = P(&result[i])
+		}
+		if err := MPI_Sendrecv(sptr, C_int(scount), MPI_BYTE, C_int(dest), C_int(tag), rptr, C_int(rcount), MPI_BYTE,
+			C_int(source), C_int(tag), group, MPI_STATUS_IGNORE); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	return result, nil
+}
+
 func (this *IMpi) Barrier() error {
+	if err := this.check(); err != nil {
+		return ierror.Raise(err)
+	}
 	return MPI_Barrier(this.Native())
 }
 
@@ -896,6 +1049,25 @@ func (this *IMpi) displs(sz []C_int) []C_int {
 	return d
 }
 
+/*Partitions of arrow compatible types are sent as arrow record batches when the serialization is arrow*/
+func writePartition[T any](this *IMpi, part storage.IPartition[T], transport thrift.TTransport, compression int8, native bool) error {
+	arrow, err := this.propertyParser.ArrowSerialization()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if arrow && iio.IsArrowType(utils.TypeObj[T]()) {
+		return storage.WriteArrow(part, transport, compression)
+	}
+	return part.WriteWithNative(transport, compression, native)
+}
+
+func unwrapSpill[T any](part storage.IPartition[T]) storage.IPartition[T] {
+	if spill, ok := part.(*storage.ISpillPartition[T]); ok {
+		return spill.Current()
+	}
+	return part
+}
+
 func move[T any](array []T, n int, displ int) {
 	if displ > 0 {
 		copy(array[displ:displ+n], array[0:n])
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/storage"
+	"math"
+	"strconv"
+)
+
+/*Collective exchange in flight, the received partitions are filled when it is waited*/
+type IAlltoall[T any] struct {
+	mpi     *IMpi
+	recv    []storage.IPartition[T]
+	send    *itransport.IMemoryBuffer
+	sbuf    []byte
+	rbuf    []byte
+	scounts []C_int
+	sdispls []C_int
+	rcounts []C_int
+	rdispls []C_int
+	request C_MPI_Request
+}
+
+/*
+Starts a non-blocking exchange of one partition with every executor, send[i] is sent to executor i and the data of
+executor i is appended to recv[i] by Wait. Nil partitions are skipped in both directions.
+*/
+func Ialltoall[T any](this *IMpi, send []storage.IPartition[T], recv []storage.IPartition[T]) (*IAlltoall[T], error) {
+	executors := this.Executors()
+	if err := this.check(); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	cmp, err := this.propertyParser.MsgCompression()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	native, err := this.propertyParser.NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	ex := &IAlltoall[T]{
+		mpi:     this,
+		recv:    recv,
+		scounts: make([]C_int, executors),
+		rcounts: make([]C_int, executors),
+	}
+	buffer := itransport.NewIPooledMemoryBuffer(itransport.MemoryDefaultSize)
+	ex.send = buffer
+	for i, part := range send {
+		if part == nil {
+			continue
+		}
+		init := buffer.WriteEnd()
+		if err = writePartition(this, part, buffer, cmp, native); err != nil {
+			ex.release()
+			return nil, ierror.Raise(err)
+		}
+		if buffer.WriteEnd() > math.MaxInt32 {
+			ex.release()
+			return nil, ierror.RaiseMsg("alltoall exchange of " + strconv.FormatInt(buffer.WriteEnd(), 10) +
+				" bytes exceeds the limit of a single message")
+		}
+		ex.scounts[i] = C_int(buffer.WriteEnd() - init)
+	}
+	if _, err = buffer.Write([]byte{0}); err != nil {
+		ex.release()
+		return nil, ierror.Raise(err)
+	}
+	ex.sbuf = buffer.GetBufferAsBytes()
+	if err = MPI_Alltoall(P(&ex.scounts[0]), 1, MPI_INT, P(&ex.rcounts[0]), 1, MPI_INT, this.Native()); err != nil {
+		ex.release()
+		return nil, ierror.Raise(err)
+	}
+	total := int64(0)
+	for _, count := range ex.rcounts {
+		total += int64(count)
+	}
+	if total > math.MaxInt32 {
+		ex.release()
+		return nil, ierror.RaiseMsg("alltoall exchange of " + strconv.FormatInt(total, 10) +
+			" received bytes exceeds the limit of a single message")
+	}
+	ex.sdispls = this.displs(ex.scounts)
+	ex.rdispls = this.displs(ex.rcounts)
+	ex.rbuf = itransport.GetBuffer(int(ex.rdispls[executors]) + 1)
+	if err = MPI_Ialltoallv(P(&ex.sbuf[0]), &ex.scounts[0], &ex.sdispls[0], MPI_BYTE, P(&ex.rbuf[0]), &ex.rcounts[0],
+		&ex.rdispls[0], MPI_BYTE, this.Native(), &ex.request); err != nil {
+		ex.release()
+		return nil, ierror.Raise(err)
+	}
+	return ex, nil
+}
+
+/*Bytes sent to and received from the executor*/
+func (this *IAlltoall[T]) Bytes(executor int) (int64, int64) {
+	return int64(this.scounts[executor]), int64(this.rcounts[executor])
+}
+
+func (this *IAlltoall[T]) Wait() error {
+	if err := this.mpi.wait(&this.request, true); err != nil {
+		this.release()
+		return ierror.Raise(err)
+	}
+	defer this.release()
+	this.sbuf = nil
+	for i, part := range this.recv {
+		if part == nil || this.rcounts[i] == 0 {
+			continue
+		}
+		view := itransport.NewIMemoryBufferWrapper(this.rbuf[this.rdispls[i]:], int64(this.rcounts[i]), itransport.OBSERVE)
+		if err := part.Read(view); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+func (this *IAlltoall[T]) release() {
+	if this.send != nil {
+		this.send.Release()
+		this.send = nil
+	}
+	if this.rbuf != nil {
+		itransport.PutBuffer(this.rbuf)
+		this.rbuf = nil
+	}
+}
@@ -0,0 +1,227 @@
+package core
+
+import (
+	"encoding/binary"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/iio"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/storage"
+	"ignis/executor/core/utils"
+	"unsafe"
+)
+
+const wireHeader = 9
+
+type iWireCodec struct {
+	codec itransport.ICodec
+	min   int
+}
+
+func (this *IMpi) wireCodec() (*iWireCodec, error) {
+	name, err := this.propertyParser.TransportCodec()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if name == "none" {
+		return nil, nil
+	}
+	level, err := this.propertyParser.TransportCodecLevel()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	min, err := this.propertyParser.TransportCodecMin()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	codec, err := itransport.NewICodec(name, int(level))
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return &iWireCodec{codec, int(min)}, nil
+}
+
+func (this *iWireCodec) encode(data []byte) ([]byte, error) {
+	frame := make([]byte, wireHeader, wireHeader+len(data))
+	binary.LittleEndian.PutUint64(frame[1:], uint64(len(data)))
+	if len(data) >= this.min {
+		compressed, err := this.codec.Compress(frame, data)
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		if len(compressed) < wireHeader+len(data) {
+			compressed[0] = 1
+			return compressed, nil
+		}
+	}
+	return append(frame[:wireHeader], data...), nil
+}
+
+func (this *iWireCodec) rawLength(frame []byte) (int, error) {
+	if len(frame) < wireHeader {
+		return 0, ierror.Raise(itransport.ErrCorrupted)
+	}
+	return int(binary.LittleEndian.Uint64(frame[1:])), nil
+}
+
+func (this *iWireCodec) decode(frame []byte, dst []byte) error {
+	if n, err := this.rawLength(frame); err != nil {
+		return ierror.Raise(err)
+	} else if n != len(dst) {
+		return ierror.Raise(itransport.ErrCorrupted)
+	}
+	if frame[0] == 0 {
+		copy(dst, frame[wireHeader:])
+		return nil
+	}
+	return ierror.Raise(this.codec.Decompress(dst, frame[wireHeader:]))
+}
+
+func (this *iWireCodec) send(group C_MPI_Comm, data []byte, dest int, tag int) error {
+	frame, err := this.encode(data)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	sz := C_int(len(frame))
+	if err = MPI_Send(P(&sz), 1, MPI_INT, C_int(dest), C_int(tag), group); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(MPI_Send(PA(&frame), sz, MPI_BYTE, C_int(dest), C_int(tag), group))
+}
+
+func (this *iWireCodec) recv(group C_MPI_Comm, source int, tag int, alloc func(n int) ([]byte, error)) error {
+	var sz C_int
+	if err := MPI_Recv(P(&sz), 1, MPI_INT, C_int(source), C_int(tag), group, MPI_STATUS_IGNORE); err != nil {
+		return ierror.Raise(err)
+	}
+	frame := make([]byte, int(sz))
+	if err := MPI_Recv(PA(&frame), sz, MPI_BYTE, C_int(source), C_int(tag), group, MPI_STATUS_IGNORE); err != nil {
+		return ierror.Raise(err)
+	}
+	n, err := this.rawLength(frame)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	dst, err := alloc(n)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(this.decode(frame, dst))
+}
+
+func arrayBytes[T any](array []T, elemSz int) []byte {
+	if len(array) == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(&array[0])), len(array)*elemSz)
+}
+
+func gatherCodecImpl[T any](this *IMpi, group C_MPI_Comm, part storage.IPartition[T], root int, rank int, executors int,
+	sameProtocol bool, codec *iWireCodec) error {
+	list, contiguous := part.Inner().(*storage.IListImpl[T])
+	contiguous = contiguous && iio.IsContiguous[T]() && sameProtocol
+	elemSz := int(utils.TypeObj[T]().Size())
+	var frame []byte
+	if rank != root {
+		var data []byte
+		if contiguous {
+			data = arrayBytes(list.Array().([]T), elemSz)
+		} else {
+			native, err := this.propertyParser.NativeSerialization()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			buffer := itransport.NewIPooledMemoryBuffer(itransport.MemoryDefaultSize)
+			defer buffer.Release()
+			if err = writePartition(this, part, buffer, 0, native); err != nil {
+				return ierror.Raise(err)
+			}
+			data = buffer.GetBufferAsBytes()
+		}
+		var err error
+		if frame, err = codec.encode(data); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	sz := C_int(len(frame))
+	szv := []C_int{0}
+	displs := []C_int{0}
+	if rank == root {
+		szv = make([]C_int, executors)
+	}
+	if err := MPI_Gather(P(&sz), 1, MPI_INT, P(&szv[0]), 1, MPI_INT, C_int(root), group); err != nil {
+		return ierror.Raise(err)
+	}
+	var frames []byte
+	if rank == root {
+		displs = this.displs(szv)
+		frames = make([]byte, int(displs[len(displs)-1]))
+	}
+	if err := MPI_Gatherv(PA(&frame), sz, MPI_BYTE, PA(&frames), &szv[0], &displs[0], MPI_BYTE, C_int(root), group); err != nil {
+		return ierror.Raise(err)
+	}
+	if rank != root {
+		return nil
+	}
+
+	if contiguous {
+		counts := make([]int, executors)
+		offsets := make([]int, executors+1)
+		for i := 0; i < executors; i++ {
+			if i == rank {
+				counts[i] = int(part.Size())
+			} else {
+				n, err := codec.rawLength(frames[displs[i] : displs[i]+szv[i]])
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				counts[i] = n / elemSz
+			}
+			offsets[i+1] = offsets[i] + counts[i]
+		}
+		list.Resize(offsets[executors], false)
+		array := list.Array().([]T)
+		move[T](array, counts[rank], offsets[rank])
+		for i := 0; i < executors; i++ {
+			if i == rank {
+				continue
+			}
+			dst := arrayBytes(array[offsets[i]:offsets[i+1]], elemSz)
+			if err := codec.decode(frames[displs[i]:displs[i]+szv[i]], dst); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		return nil
+	}
+
+	rcv, err := NewMemoryPartitionDef[T](this.partitionTools)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for i := 0; i < executors; i++ {
+		if i == rank {
+			//Avoid serialization own elements
+			if err = part.MoveTo(rcv); err != nil {
+				return ierror.Raise(err)
+			}
+			continue
+		}
+		current := frames[displs[i] : displs[i]+szv[i]]
+		n, err := codec.rawLength(current)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		raw := make([]byte, n)
+		if err = codec.decode(current, raw); err != nil {
+			return ierror.Raise(err)
+		}
+		view := itransport.NewIMemoryBufferWrapper(raw, int64(n), itransport.OBSERVE)
+		if err = rcv.Read(view); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	if err = part.Clear(); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(rcv.MoveTo(part))
+}
@@ -0,0 +1,157 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/utils"
+	"reflect"
+	"sync"
+)
+
+/*Committed MPI datatypes of fixed-size elements, they live until the MPI environment is finalized*/
+var elemDatatypes sync.Map
+
+type iElemDatatype struct {
+	once     sync.Once
+	datatype C_MPI_Datatype
+	err      error
+}
+
+/*
+MPI datatype of a contiguous element, so a []T can be transferred directly with its length as count. Struct fields
+are described by offset, so padding is never sent and the receiver rebuilds it with its own layout.
+*/
+func elemDatatype[T any]() (C_MPI_Datatype, error) {
+	tp := utils.TypeObj[T]()
+	entry, _ := elemDatatypes.LoadOrStore(tp, &iElemDatatype{})
+	elem := entry.(*iElemDatatype)
+	elem.once.Do(func() {
+		elem.datatype, elem.err = newElemDatatype(tp)
+	})
+	return elem.datatype, elem.err
+}
+
+func basicDatatype(tp reflect.Type) (C_MPI_Datatype, bool) {
+	switch tp.Kind() {
+	case reflect.Bool:
+		return MPI_C_BOOL, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		switch tp.Size() {
+		case 1:
+			return MPI_INT8_T, true
+		case 2:
+			return MPI_INT16_T, true
+		case 4:
+			return MPI_INT32_T, true
+		case 8:
+			return MPI_INT64_T, true
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		switch tp.Size() {
+		case 1:
+			return MPI_UINT8_T, true
+		case 2:
+			return MPI_UINT16_T, true
+		case 4:
+			return MPI_UINT32_T, true
+		case 8:
+			return MPI_UINT64_T, true
+		}
+	case reflect.Float32:
+		return MPI_FLOAT, true
+	case reflect.Float64:
+		return MPI_DOUBLE, true
+	case reflect.Complex64:
+		return MPI_C_FLOAT_COMPLEX, true
+	case reflect.Complex128:
+		return MPI_C_DOUBLE_COMPLEX, true
+	}
+	return MPI_DATATYPE_NULL, false
+}
+
+func newElemDatatype(tp reflect.Type) (C_MPI_Datatype, error) {
+	if datatype, ok := basicDatatype(tp); ok {
+		return datatype, nil
+	}
+	datatype, err := buildDatatype(tp)
+	if err != nil {
+		return MPI_DATATYPE_NULL, ierror.Raise(err)
+	}
+	if err = MPI_Type_commit(&datatype); err != nil {
+		return MPI_DATATYPE_NULL, ierror.Raise(err)
+	}
+	return datatype, nil
+}
+
+/*Uncommitted datatype of tp, intermediate datatypes are freed once they are referenced by the result*/
+func buildDatatype(tp reflect.Type) (C_MPI_Datatype, error) {
+	if datatype, ok := basicDatatype(tp); ok {
+		return datatype, nil
+	}
+	var datatype C_MPI_Datatype
+	switch tp.Kind() {
+	case reflect.Array:
+		inner, err := buildDatatype(tp.Elem())
+		if err != nil {
+			return MPI_DATATYPE_NULL, ierror.Raise(err)
+		}
+		err = MPI_Type_contiguous(C_int(tp.Len()), inner, &datatype)
+		if err2 := freeDerived(tp.Elem(), &inner); err == nil {
+			err = err2
+		}
+		if err != nil {
+			return MPI_DATATYPE_NULL, ierror.Raise(err)
+		}
+	case reflect.Struct:
+		n := tp.NumField()
+		if n == 0 {
+			if err := MPI_Type_contiguous(0, MPI_BYTE, &datatype); err != nil {
+				return MPI_DATATYPE_NULL, ierror.Raise(err)
+			}
+			return datatype, nil
+		}
+		lengths := make([]C_int, n)
+		displs := make([]C_MPI_Aint, n)
+		types := make([]C_MPI_Datatype, n)
+		var err error
+		built := 0
+		for ; built < n; built++ {
+			field := tp.Field(built)
+			lengths[built] = 1
+			displs[built] = C_MPI_Aint(field.Offset)
+			if types[built], err = buildDatatype(field.Type); err != nil {
+				break
+			}
+		}
+		var fields C_MPI_Datatype
+		if err == nil {
+			err = MPI_Type_create_struct(C_int(n), &lengths[0], &displs[0], &types[0], &fields)
+		}
+		for i := 0; i < built; i++ {
+			if err2 := freeDerived(tp.Field(i).Type, &types[i]); err == nil {
+				err = err2
+			}
+		}
+		if err != nil {
+			return MPI_DATATYPE_NULL, ierror.Raise(err)
+		}
+		/*Trailing padding must be part of the extent to step between elements*/
+		err = MPI_Type_create_resized(fields, 0, C_MPI_Aint(tp.Size()), &datatype)
+		if err2 := MPI_Type_free(&fields); err == nil {
+			err = err2
+		}
+		if err != nil {
+			return MPI_DATATYPE_NULL, ierror.Raise(err)
+		}
+	default:
+		return MPI_DATATYPE_NULL, ierror.RaiseMsg("type " + tp.String() + " has no fixed size")
+	}
+	return datatype, nil
+}
+
+func freeDerived(tp reflect.Type, datatype *C_MPI_Datatype) error {
+	if _, ok := basicDatatype(tp); ok {
+		return nil
+	}
+	return MPI_Type_free(datatype)
+}
@@ -0,0 +1,87 @@
+package core
+
+/*
+static void ignis_register_max(void *in, void *inout, int *len, void *datatype) {
+	unsigned char *a = (unsigned char *)in;
+	unsigned char *b = (unsigned char *)inout;
+	for (int i = 0; i < *len; i++) {
+		if (a[i] > b[i]) {
+			b[i] = a[i];
+		}
+	}
+}
+
+static void *ignis_register_max_fn() {
+	return (void *)ignis_register_max;
+}
+
+static void ignis_bloom_merge(void *in, void *inout, int *len, void *datatype) {
+	unsigned int *a = (unsigned int *)in;
+	unsigned int *b = (unsigned int *)inout;
+	for (int i = 0; i < *len; i++) {
+		b[i] = (a[i] | b[i]) | ((a[i] & b[i] & 0xFFFF) << 16);
+	}
+}
+
+static void *ignis_bloom_merge_fn() {
+	return (void *)ignis_bloom_merge;
+}
+*/
+import "C"
+import (
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"sync"
+)
+
+var registerMaxOnce sync.Once
+var registerMaxOp C_MPI_Op
+var registerMaxErr error
+
+func registerMax() (C_MPI_Op, error) {
+	registerMaxOnce.Do(func() {
+		registerMaxErr = MPI_Op_create((*C_MPI_User_function)(C.ignis_register_max_fn()), 1, &registerMaxOp)
+	})
+	return registerMaxOp, registerMaxErr
+}
+
+var bloomMergeOnce sync.Once
+var bloomMergeOp C_MPI_Op
+var bloomMergeErr error
+
+func bloomMerge() (C_MPI_Op, error) {
+	bloomMergeOnce.Do(func() {
+		bloomMergeErr = MPI_Op_create((*C_MPI_User_function)(C.ignis_bloom_merge_fn()), 1, &bloomMergeOp)
+	})
+	return bloomMergeOp, bloomMergeErr
+}
+
+/*
+Merges the bloom filters of all executors. The low half of each word has the bits set by any executor and the high
+half the bits set by at least two of them.
+*/
+func (this *IMpi) AllReduceBloom(filter []uint32) error {
+	if len(filter) == 0 {
+		return nil
+	}
+	op, err := bloomMerge()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(MPI_Allreduce(MPI_IN_PLACE, P(&filter[0]), C_int(len(filter)), MPI_UINT32_T, op, this.Native()))
+}
+
+func (this *IMpi) ReduceRegisters(registers []byte, root int) error {
+	if len(registers) == 0 {
+		return nil
+	}
+	op, err := registerMax()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	sendbuf := P(&registers[0])
+	if this.IsRoot(root) {
+		sendbuf = MPI_IN_PLACE
+	}
+	return ierror.Raise(MPI_Reduce(sendbuf, P(&registers[0]), C_int(len(registers)), MPI_BYTE, op, C_int(root), this.Native()))
+}
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/logger"
+	"strconv"
+	"time"
+)
+
+const reliablePollMax = 10 * time.Millisecond
+
+type iMsgPolicy struct {
+	timeout time.Duration
+	retries int
+	backoff time.Duration
+}
+
+func (this *IMpi) msgPolicy() (*iMsgPolicy, error) {
+	timeout, err := this.propertyParser.TransportTimeout()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if timeout == 0 {
+		return nil, nil
+	}
+	retries, err := this.propertyParser.TransportRetries()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	backoff, err := this.propertyParser.TransportBackoff()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return &iMsgPolicy{
+		time.Duration(timeout * float64(time.Second)),
+		int(retries),
+		time.Duration(backoff * float64(time.Second)),
+	}, nil
+}
+
+/*Polls test until it returns true, each attempt lasts the timeout and failed attempts wait an exponential backoff*/
+func (this *iMsgPolicy) poll(test func() (bool, error)) (bool, error) {
+	backoff := this.backoff
+	for attempt := 0; attempt <= this.retries; attempt++ {
+		if attempt > 0 {
+			logger.Warn("MPI: peer did not respond, retrying in ", backoff)
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		deadline := time.Now().Add(this.timeout)
+		wait := time.Microsecond
+		for {
+			if ok, err := test(); err != nil || ok {
+				return ok, err
+			}
+			if time.Now().After(deadline) {
+				break
+			}
+			time.Sleep(wait)
+			if wait < reliablePollMax {
+				wait *= 2
+			}
+		}
+	}
+	return false, nil
+}
+
+func (this *iMsgPolicy) error(peer int, tag int, send bool) error {
+	action := "receive from"
+	if send {
+		action = "send to"
+	}
+	return ierror.RaiseMsg("MPI: " + action + " executor " + strconv.Itoa(peer) + " (tag " + strconv.Itoa(tag) +
+		") timed out after " + strconv.Itoa(this.retries+1) + " attempts of " + this.timeout.String())
+}
+
+/*
+When ignis.transport.timeout is set, both peers exchange a synchronous token before the partition transfer so that a
+dead peer becomes an error instead of a blocked exchange.
+*/
+func (this *IMpi) handshake(group C_MPI_Comm, send bool, other int, tag int) error {
+	if err := this.check(); err != nil {
+		return ierror.Raise(err)
+	}
+	policy, err := this.msgPolicy()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if policy == nil {
+		return nil
+	}
+	var token C_int8
+	if send {
+		var request C_MPI_Request
+		if err := MPI_Issend(P(&token), 1, MPI_BYTE, C_int(other), C_int(tag), group, &request); err != nil {
+			return ierror.Raise(err)
+		}
+		ok, err := policy.poll(func() (bool, error) {
+			var flag C_int
+			if err := MPI_Test(&request, &flag, MPI_STATUS_IGNORE); err != nil {
+				return false, err
+			}
+			return flag != 0, nil
+		})
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if !ok {
+			_ = MPI_Cancel(&request)
+			_ = MPI_Request_free(&request)
+			return policy.error(other, tag, true)
+		}
+	} else {
+		ok, err := policy.poll(func() (bool, error) {
+			var flag C_int
+			if err := MPI_Iprobe(C_int(other), C_int(tag), group, &flag, MPI_STATUS_IGNORE); err != nil {
+				return false, err
+			}
+			return flag != 0, nil
+		})
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if !ok {
+			return policy.error(other, tag, false)
+		}
+		if err := MPI_Recv(P(&token), 1, MPI_BYTE, C_int(other), C_int(tag), group, MPI_STATUS_IGNORE); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
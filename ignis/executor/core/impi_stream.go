@@ -0,0 +1,278 @@
+package core
+
+import (
+	"context"
+	"encoding/binary"
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/storage"
+	"io"
+)
+
+const streamHeader = 8
+
+type iStreamWriter struct {
+	mpi      *IMpi
+	group    C_MPI_Comm
+	dest     int
+	tag      int
+	block    int
+	buffers  [][]byte
+	requests []C_MPI_Request
+	pending  []bool
+	slot     int
+	used     int
+}
+
+func newIStreamWriter(mpi *IMpi, group C_MPI_Comm, dest int, tag int, block int64, inflight int64) *iStreamWriter {
+	slots := int(inflight / block)
+	if slots < 1 {
+		slots = 1
+	}
+	return &iStreamWriter{
+		mpi:      mpi,
+		group:    group,
+		dest:     dest,
+		tag:      tag,
+		block:    int(block),
+		buffers:  make([][]byte, slots),
+		requests: make([]C_MPI_Request, slots),
+		pending:  make([]bool, slots),
+	}
+}
+
+func (this *iStreamWriter) wait(slot int) error {
+	if !this.pending[slot] {
+		return nil
+	}
+	this.pending[slot] = false
+	return ierror.Raise(this.mpi.wait(&this.requests[slot], false))
+}
+
+func (this *iStreamWriter) current() ([]byte, error) {
+	if this.buffers[this.slot] == nil {
+		this.buffers[this.slot] = make([]byte, streamHeader+this.block)
+	}
+	if err := this.wait(this.slot); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return this.buffers[this.slot], nil
+}
+
+func (this *iStreamWriter) send(last bool) error {
+	buffer := this.buffers[this.slot]
+	binary.LittleEndian.PutUint32(buffer, uint32(this.used))
+	binary.LittleEndian.PutUint32(buffer[4:], 0)
+	if last {
+		buffer[4] = 1
+	}
+	if err := MPI_Isend(PA(&buffer), C_int(streamHeader+this.used), MPI_BYTE, C_int(this.dest), C_int(this.tag),
+		this.group, &this.requests[this.slot]); err != nil {
+		return ierror.Raise(err)
+	}
+	this.pending[this.slot] = true
+	this.slot = (this.slot + 1) % len(this.buffers)
+	this.used = 0
+	return nil
+}
+
+func (this *iStreamWriter) Write(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		buffer, err := this.current()
+		if err != nil {
+			return n, ierror.Raise(err)
+		}
+		c := copy(buffer[streamHeader+this.used:], p[n:])
+		this.used += c
+		n += c
+		if this.used == this.block {
+			if err = this.send(false); err != nil {
+				return n, ierror.Raise(err)
+			}
+		}
+	}
+	return n, nil
+}
+
+func (this *iStreamWriter) Close() error {
+	if _, err := this.current(); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := this.send(true); err != nil {
+		return ierror.Raise(err)
+	}
+	for i := range this.requests {
+		if err := this.wait(i); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+func (this *iStreamWriter) Read(p []byte) (int, error) {
+	return 0, ierror.RaiseMsg("stream writer can not be read")
+}
+
+func (this *iStreamWriter) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (this *iStreamWriter) RemainingBytes() uint64 {
+	return ^uint64(0)
+}
+
+func (this *iStreamWriter) Open() error {
+	return nil
+}
+
+func (this *iStreamWriter) IsOpen() bool {
+	return true
+}
+
+type iStreamReader struct {
+	mpi     *IMpi
+	group   C_MPI_Comm
+	source  int
+	tag     int
+	buffers [2][]byte
+	request C_MPI_Request
+	pending bool
+	data    []byte
+	last    bool
+}
+
+func newIStreamReader(mpi *IMpi, group C_MPI_Comm, source int, tag int, block int64) (*iStreamReader, error) {
+	this := &iStreamReader{
+		mpi:    mpi,
+		group:  group,
+		source: source,
+		tag:    tag,
+	}
+	this.buffers[0] = make([]byte, streamHeader+int(block))
+	this.buffers[1] = make([]byte, streamHeader+int(block))
+	if err := this.post(); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return this, nil
+}
+
+func (this *iStreamReader) post() error {
+	buffer := this.buffers[1]
+	this.pending = true
+	return ierror.Raise(MPI_Irecv(PA(&buffer), C_int(len(buffer)), MPI_BYTE, C_int(this.source), C_int(this.tag),
+		this.group, &this.request))
+}
+
+func (this *iStreamReader) next() error {
+	if err := this.mpi.wait(&this.request, false); err != nil {
+		this.pending = false
+		return ierror.Raise(err)
+	}
+	this.pending = false
+	this.buffers[0], this.buffers[1] = this.buffers[1], this.buffers[0]
+	buffer := this.buffers[0]
+	sz := int(binary.LittleEndian.Uint32(buffer))
+	this.last = binary.LittleEndian.Uint32(buffer[4:]) != 0
+	this.data = buffer[streamHeader : streamHeader+sz]
+	if !this.last {
+		return this.post()
+	}
+	return nil
+}
+
+func (this *iStreamReader) Read(p []byte) (int, error) {
+	for len(this.data) == 0 {
+		if !this.pending {
+			return 0, io.EOF
+		}
+		if err := this.next(); err != nil {
+			return 0, ierror.Raise(err)
+		}
+	}
+	n := copy(p, this.data)
+	this.data = this.data[n:]
+	return n, nil
+}
+
+func (this *iStreamReader) Close() error {
+	for this.pending {
+		if err := this.next(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	this.data = nil
+	return nil
+}
+
+func (this *iStreamReader) Write(p []byte) (int, error) {
+	return 0, ierror.RaiseMsg("stream reader can not be written")
+}
+
+func (this *iStreamReader) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (this *iStreamReader) RemainingBytes() uint64 {
+	return ^uint64(0)
+}
+
+func (this *iStreamReader) Open() error {
+	return nil
+}
+
+func (this *iStreamReader) IsOpen() bool {
+	return true
+}
+
+func SendStream[T any](this *IMpi, part storage.IPartition[T], dest int, tag int, block int64, inflight int64) error {
+	if tcp, err := this.tcp(); err != nil {
+		return ierror.Raise(err)
+	} else if tcp != nil {
+		return sendTcp(this, tcp, part, dest, tag)
+	}
+	cmp, err := this.propertyParser.MsgCompression()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	native, err := this.propertyParser.NativeSerialization()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	writer := newIStreamWriter(this, this.Native(), dest, tag, block, inflight)
+	if err = writePartition(this, part, writer, cmp, native); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(writer.Close())
+}
+
+func RecvStream[T any](this *IMpi, part storage.IPartition[T], source int, tag int, block int64) error {
+	if tcp, err := this.tcp(); err != nil {
+		return ierror.Raise(err)
+	} else if tcp != nil {
+		return recvTcp(this, tcp, part, source, tag)
+	}
+	reader, err := newIStreamReader(this, this.Native(), source, tag, block)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = part.Read(reader); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(reader.Close())
+}
+
+func SendRcvStream[T any](this *IMpi, sendp storage.IPartition[T], rcvp storage.IPartition[T], other int, tag int,
+	block int64, inflight int64) error {
+	if this.Rank() > other {
+		if err := SendStream(this, sendp, other, tag, block, inflight); err != nil {
+			return ierror.Raise(err)
+		}
+		return ierror.Raise(RecvStream(this, rcvp, other, tag, block))
+	} else {
+		if err := RecvStream(this, rcvp, other, tag, block); err != nil {
+			return ierror.Raise(err)
+		}
+		return ierror.Raise(SendStream(this, sendp, other, tag, block, inflight))
+	}
+}
@@ -3,7 +3,9 @@ package core
 import (
 	"ignis/executor/api"
 	"ignis/executor/api/ipair"
+	"ignis/executor/api/iterator"
 	"ignis/executor/core/ierror"
+	"ignis/executor/core/ifs"
 	"ignis/executor/core/itransport"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
@@ -17,14 +19,43 @@ type IPartitionTools struct {
 	properties     *IPropertyParser
 	context        api.IContext
 	partitionIdGen atomic.Int32
+	spill          ISpillManager
 }
 
 func NewIPartitionTools(properties *IPropertyParser, ctx api.IContext) *IPartitionTools {
-	return &IPartitionTools{
-		properties,
-		ctx,
-		atomic.Int32{},
+	this := &IPartitionTools{
+		properties: properties,
+		context:    ctx,
 	}
+	this.spill.tools = this
+	return this
+}
+
+func (this *IPartitionTools) FileSystem(path string) (ifs.IFileSystem, error) {
+	if ifs.IsLocal(path) {
+		return ifs.NewILocalFileSystem(), nil
+	}
+	if ifs.Scheme(path) != "s3" {
+		return nil, ierror.RaiseMsg("file system " + ifs.Scheme(path) + " is not supported")
+	}
+	var config ifs.IS3Config
+	var err error
+	if config.Endpoint, err = this.properties.S3Endpoint(); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if config.Region, err = this.properties.S3Region(); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if config.AccessKey, err = this.properties.S3Access(); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if config.SecretKey, err = this.properties.S3Secret(); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if config.PartSize, err = this.properties.S3Multipart(); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return ifs.NewIS3FileSystem(config), nil
 }
 
 func NewPartitionDef[T any](this *IPartitionTools) (storage.IPartition[T], error) {
@@ -40,8 +71,16 @@ func NewPartitionWithName[T any](this *IPartitionTools, name string) (storage.IP
 		return NewMemoryPartitionDef[T](this)
 	} else if name == storage.IRawMemoryPartitionType {
 		return NewRawMemoryPartitionDef[T](this)
+	} else if name == storage.IMappedMemoryPartitionType {
+		return NewMappedMemoryPartition[T](this, 1024*1024*8)
 	} else if name == storage.IDiskPartitionType {
 		return NewDiskPartitionDef[T](this)
+	} else if name == storage.ISpillPartitionType {
+		return NewSpillPartitionDef[T](this)
+	} else if name == storage.ICompressedMemoryPartitionType {
+		return NewCompressedMemoryPartition[T](this)
+	} else if name == storage.IColumnarPartitionType {
+		return NewColumnarPartition[T](this, 1024*1024)
 	}
 	return nil, ierror.RaiseMsg("unknown partition type: " + name)
 }
@@ -55,8 +94,16 @@ func NewPartitionWithOther[T any](this *IPartitionTools, other storage.IPartitio
 		return NewMemoryPartition[T](this, other.Size())
 	} else if name == storage.IRawMemoryPartitionType {
 		return NewRawMemoryPartition[T](this, other.Bytes())
+	} else if name == storage.IMappedMemoryPartitionType {
+		return NewMappedMemoryPartition[T](this, other.Bytes())
 	} else if name == storage.IDiskPartitionType {
 		return NewDiskPartitionDef[T](this)
+	} else if name == storage.ISpillPartitionType {
+		return NewSpillPartition[T](this, other.Size())
+	} else if name == storage.ICompressedMemoryPartitionType {
+		return NewCompressedMemoryPartition[T](this)
+	} else if name == storage.IColumnarPartitionType {
+		return NewColumnarPartition[T](this, other.Size())
 	}
 	return nil, ierror.RaiseMsg("unknown partition type: " + name)
 }
@@ -90,11 +137,7 @@ func NewPartitionGroupDef[T any](this *IPartitionTools) (*storage.IPartitionGrou
 }
 
 func NewMemoryPartitionDef[T any](this *IPartitionTools) (*storage.IMemoryPartition[T], error) {
-	native, err := this.properties.NativeSerialization()
-	if err != nil {
-		return nil, ierror.Raise(err)
-	}
-	return storage.NewIMemoryPartition[T](1024*1024, native), nil
+	return NewMemoryPartition[T](this, 1024*1024)
 }
 
 func NewMemoryPartition[T any](this *IPartitionTools, sz int64) (*storage.IMemoryPartition[T], error) {
@@ -102,7 +145,13 @@ func NewMemoryPartition[T any](this *IPartitionTools, sz int64) (*storage.IMemor
 	if err != nil {
 		return nil, ierror.Raise(err)
 	}
-	return storage.NewIMemoryPartition[T](sz, native), nil
+	chunk, err := this.properties.PartitionChunk()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	part := storage.NewIMemoryPartition[T](sz, native)
+	part.SetChunk(chunk)
+	return part, nil
 }
 
 func NewRawMemoryPartitionDef[T any](this *IPartitionTools) (*storage.IRawMemoryPartition[T], error) {
@@ -121,6 +170,18 @@ func NewRawMemoryPartition[T any](this *IPartitionTools, bytes int64) (*storage.
 	return storage.NewIRawMemoryPartition[T](bytes, compression, native)
 }
 
+func NewMappedMemoryPartition[T any](this *IPartitionTools, bytes int64) (*storage.IRawMemoryPartition[T], error) {
+	native, err := this.properties.NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	compression, err := this.properties.PartitionCompression()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return storage.NewIMappedMemoryPartition[T](bytes, compression, native)
+}
+
 func NewDiskPartitionDef[T any](this *IPartitionTools) (*storage.IDiskPartition[T], error) {
 	return NewDiskPartition[T](this, "", false, false)
 }
@@ -141,7 +202,88 @@ func NewDiskPartition[T any](this *IPartitionTools, name string, persist bool, r
 	return storage.NewIDiskPartition[T](path, compression, native, persist, read)
 }
 
+func NewSpillPartitionDef[T any](this *IPartitionTools) (*storage.ISpillPartition[T], error) {
+	return NewSpillPartition[T](this, 1024*1024)
+}
+
+func NewSpillPartition[T any](this *IPartitionTools, sz int64) (*storage.ISpillPartition[T], error) {
+	native, err := this.properties.NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	compression, err := this.properties.PartitionCompression()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	threshold, err := this.properties.PartitionSpill()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return storage.NewISpillPartition[T](sz, threshold, compression, native, func() (string, error) {
+		return this.Diskpath("")
+	}), nil
+}
+
+func NewCompressedMemoryPartition[T any](this *IPartitionTools) (*storage.ICompressedMemoryPartition[T], error) {
+	native, err := this.properties.NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	compression, err := this.properties.PartitionCompression()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	name, err := this.properties.PartitionCodec()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	block, err := this.properties.PartitionBlock()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	codec, err := itransport.NewICodec(name, int(compression))
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return storage.NewICompressedMemoryPartition[T](block, codec, compression, native), nil
+}
+
+/*Types without a columnar layout are stored in a row based memory partition*/
+func NewColumnarPartition[T any](this *IPartitionTools, sz int64) (storage.IPartition[T], error) {
+	if !storage.IsColumnarType[T]() {
+		return NewMemoryPartition[T](this, sz)
+	}
+	native, err := this.properties.NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return storage.NewIColumnarPartition[T](sz, native)
+}
+
+func PrefetchReadIterator[T any](this *IPartitionTools, part storage.IPartition[T]) (iterator.IReadIterator[T], error) {
+	it, err := part.ReadIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if this.IsMemory(part) || this.IsColumnar(part) || part.Size() == 0 {
+		return it, nil
+	}
+	n, err := this.properties.PartitionPrefetch()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if n == 0 {
+		return it, nil
+	}
+	return storage.NewIPrefetchIterator[T](it, int(n)), nil
+}
+
 func (this *IPartitionTools) Diskpath(name string) (string, error) {
+	if len(name) == 0 {
+		if path, err := this.spill.Path(); err != nil || path != "" {
+			return path, err
+		}
+	}
 	path, err := this.properties.ExecutorDirectory()
 	if err != nil {
 		return "", ierror.Raise(err)
@@ -159,6 +301,10 @@ func (this *IPartitionTools) Diskpath(name string) (string, error) {
 	return path, nil
 }
 
+func (this *IPartitionTools) Spill() *ISpillManager {
+	return &this.spill
+}
+
 func (this *IPartitionTools) IsMemory(part storage.IPartitionBase) bool {
 	return part.Type() == storage.IMemoryPartitionType
 }
@@ -192,6 +338,39 @@ func (this *IPartitionTools) IsDiskGroup(group storage.IPartitionGroupBase) bool
 	return false
 }
 
+func (this *IPartitionTools) IsSpill(part storage.IPartitionBase) bool {
+	return part.Type() == storage.ISpillPartitionType
+}
+
+func (this *IPartitionTools) IsSpillGroup(group storage.IPartitionGroupBase) bool {
+	if group.Size() > 0 {
+		return group.GetBase(0).Type() == storage.ISpillPartitionType
+	}
+	return false
+}
+
+func (this *IPartitionTools) IsCompressedMemory(part storage.IPartitionBase) bool {
+	return part.Type() == storage.ICompressedMemoryPartitionType
+}
+
+func (this *IPartitionTools) IsCompressedMemoryGroup(group storage.IPartitionGroupBase) bool {
+	if group.Size() > 0 {
+		return group.GetBase(0).Type() == storage.ICompressedMemoryPartitionType
+	}
+	return false
+}
+
+func (this *IPartitionTools) IsColumnar(part storage.IPartitionBase) bool {
+	return part.Type() == storage.IColumnarPartitionType
+}
+
+func (this *IPartitionTools) IsColumnarGroup(group storage.IPartitionGroupBase) bool {
+	if group.Size() > 0 {
+		return group.GetBase(0).Type() == storage.IColumnarPartitionType
+	}
+	return false
+}
+
 func ConvertGroupPartitionTo[T any](this *IPartitionTools, other storage.IPartitionGroupBase) (*storage.IPartitionGroup[T], error) {
 	group, err := NewPartitionGroupDef[T](this)
 	if err != nil {
@@ -237,6 +416,38 @@ func ConvertGroupPartitionTo[T any](this *IPartitionTools, other storage.IPartit
 			}
 			group.AddBase(part)
 		}
+	} else if this.IsCompressedMemoryGroup(other) {
+		buffer := itransport.NewIMemoryBuffer()
+		for i := 0; i < other.Size(); i++ {
+			buffer.ResetBuffer()
+			if err := other.GetBase(i).Write(buffer, 0); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			part, err := NewCompressedMemoryPartition[T](this)
+			if err != nil {
+				return nil, ierror.Raise(err)
+			}
+			if err = part.Read(buffer); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			group.AddBase(part)
+		}
+	} else if this.IsColumnarGroup(other) {
+		buffer := itransport.NewIMemoryBuffer()
+		for i := 0; i < other.Size(); i++ {
+			buffer.ResetBuffer()
+			if err := other.GetBase(i).Write(buffer, 0); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			part, err := NewColumnarPartition[T](this, other.GetBase(i).Size())
+			if err != nil {
+				return nil, ierror.Raise(err)
+			}
+			if err = part.Read(buffer); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			group.AddBase(part)
+		}
 	} else {
 		for i := 0; i < other.Size(); i++ {
 			part, err := storage.ConvIDiskPartition[T](other.GetBase(i))
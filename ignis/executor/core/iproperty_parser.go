@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"ignis/executor/core/ierror"
 	"math"
+	"os"
 	"regexp"
 	"strconv"
 	"strings"
@@ -21,10 +22,49 @@ func NewIPropertyParser(properties map[string]string) *IPropertyParser {
 
 func (this *IPropertyParser) Cores() (int64, error) { return this.GetNumber("ignis.executor.cores") }
 
+/*Binding of the executor threads: none, numa to keep them on one socket or core to also give each worker its cpu*/
+func (this *IPropertyParser) ExecutorAffinity() (string, error) {
+	if !this.Has("ignis.executor.affinity") {
+		return "none", nil
+	}
+	value, err := this.GetString("ignis.executor.affinity")
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	if value != "none" && value != "numa" && value != "core" {
+		return "", ierror.RaiseMsg("ignis.executor.affinity must be none, numa or core, found " + value)
+	}
+	return value, nil
+}
+
+/*Numa node of the executor, by default it is selected from the local rank*/
+func (this *IPropertyParser) ExecutorAffinityNode() (int64, error) {
+	if !this.Has("ignis.executor.affinity.node") {
+		return -1, nil
+	}
+	return this.GetMinNumber("ignis.executor.affinity.node", 0)
+}
+
 func (this *IPropertyParser) TransportCores() (float64, error) {
 	return this.GetMinFloat("ignis.transport.cores", 0)
 }
 
+/*Adjusts the transport threads of each peer from the throughput of the previous exchanges*/
+func (this *IPropertyParser) TransportCoresAdaptive() (bool, error) {
+	if !this.Has("ignis.transport.cores.adaptive") {
+		return false, nil
+	}
+	return this.GetBool("ignis.transport.cores.adaptive")
+}
+
+/*Minimum bytes transferred by each thread of an adaptive exchange*/
+func (this *IPropertyParser) TransportCoresBytes() (int64, error) {
+	if !this.Has("ignis.transport.cores.bytes") {
+		return 1 << 20, nil
+	}
+	return this.GetSize("ignis.transport.cores.bytes")
+}
+
 func (this *IPropertyParser) PartitionMinimal() (int64, error) {
 	return this.GetSize("ignis.partition.minimal")
 }
@@ -37,10 +77,96 @@ func (this *IPropertyParser) SortResampling() (bool, error) {
 	return this.GetBool("ignis.modules.sort.resampling")
 }
 
+func (this *IPropertyParser) SortRun() (int64, error) {
+	if !this.Has("ignis.modules.sort.run") {
+		return 1000000, nil
+	}
+	return this.GetMinNumber("ignis.modules.sort.run", 1)
+}
+
+func (this *IPropertyParser) SortRange() (bool, error) {
+	if !this.Has("ignis.modules.sort.range") {
+		return false, nil
+	}
+	return this.GetBool("ignis.modules.sort.range")
+}
+
+func (this *IPropertyParser) SortRangeFraction() (float64, error) {
+	if !this.Has("ignis.modules.sort.range.fraction") {
+		return 0.01, nil
+	}
+	return this.GetRangeFloat("ignis.modules.sort.range.fraction", 0, 1)
+}
+
+func (this *IPropertyParser) SortRangeMax() (int64, error) {
+	if !this.Has("ignis.modules.sort.range.max") {
+		return 1000000, nil
+	}
+	return this.GetMinNumber("ignis.modules.sort.range.max", 1)
+}
+
 func (this *IPropertyParser) IoOverwrite() (bool, error) {
 	return this.GetBool("ignis.modules.io.overwrite")
 }
 
+/*Codec of text and json files, when it is not set the codec of a file being read is chosen by its extension*/
+func (this *IPropertyParser) IoCodec() (string, error) {
+	if !this.Has("ignis.modules.io.codec") {
+		return "", nil
+	}
+	return this.GetString("ignis.modules.io.codec")
+}
+
+/*Bytes of the blocks of a binary file, every block can be read as a partition*/
+func (this *IPropertyParser) IoBinaryBlock() (int64, error) {
+	if !this.Has("ignis.modules.io.binary.block") {
+		return 8 << 20, nil
+	}
+	return this.GetSize("ignis.modules.io.binary.block")
+}
+
+func (this *IPropertyParser) S3Endpoint() (string, error) {
+	if !this.Has("ignis.fs.s3.endpoint") {
+		return "https://s3.amazonaws.com", nil
+	}
+	return this.GetString("ignis.fs.s3.endpoint")
+}
+
+func (this *IPropertyParser) S3Region() (string, error) {
+	if !this.Has("ignis.fs.s3.region") {
+		return "us-east-1", nil
+	}
+	return this.GetString("ignis.fs.s3.region")
+}
+
+func (this *IPropertyParser) S3Access() (string, error) {
+	if !this.Has("ignis.fs.s3.access") {
+		return os.Getenv("AWS_ACCESS_KEY_ID"), nil
+	}
+	return this.GetString("ignis.fs.s3.access")
+}
+
+func (this *IPropertyParser) S3Secret() (string, error) {
+	if !this.Has("ignis.fs.s3.secret") {
+		return os.Getenv("AWS_SECRET_ACCESS_KEY"), nil
+	}
+	return this.GetString("ignis.fs.s3.secret")
+}
+
+func (this *IPropertyParser) S3Multipart() (int64, error) {
+	if !this.Has("ignis.fs.s3.multipart") {
+		return 8 * 1024 * 1024, nil
+	}
+	value, err := this.GetSize("ignis.fs.s3.multipart")
+	if err != nil {
+		return 0, err
+	}
+	if value < 5*1024*1024 {
+		return 0, ierror.RaiseMsg("ignis.fs.s3.multipart error " + strconv.FormatInt(value, 10) + " is less than 5MB")
+	}
+	return value, nil
+}
+
 func (this *IPropertyParser) IoCores() (float64, error) {
 	return this.GetMinFloat("ignis.modules.io.cores", 0)
 }
@@ -57,6 +183,34 @@ func (this *IPropertyParser) MsgCompression() (int8, error) {
 	return int8(vaue), nil
 }
 
+func (this *IPropertyParser) TransportCodec() (string, error) {
+	if !this.Has("ignis.transport.codec") {
+		return "none", nil
+	}
+	value, err := this.GetString("ignis.transport.codec")
+	if err != nil {
+		return "", err
+	}
+	if value != "none" && value != "zlib" && value != "lz4" && value != "zstd" {
+		return "", ierror.RaiseMsg("ignis.transport.codec error " + value + " is not none, zlib, lz4 or zstd")
+	}
+	return value, nil
+}
+
+func (this *IPropertyParser) TransportCodecLevel() (int64, error) {
+	if !this.Has("ignis.transport.codec.level") {
+		return 1, nil
+	}
+	return this.GetRangeNumber("ignis.transport.codec.level", 0, 9)
+}
+
+func (this *IPropertyParser) TransportCodecMin() (int64, error) {
+	if !this.Has("ignis.transport.codec.min") {
+		return 64 * 1024, nil
+	}
+	return this.GetSize("ignis.transport.codec.min")
+}
+
 func (this *IPropertyParser) NativeSerialization() (bool, error) {
 	value, err := this.GetString("ignis.partition.serialization")
 	if err != nil {
@@ -65,11 +219,114 @@ func (this *IPropertyParser) NativeSerialization() (bool, error) {
 	return value == "native", nil
 }
 
+func (this *IPropertyParser) ArrowSerialization() (bool, error) {
+	value, err := this.GetString("ignis.partition.serialization")
+	if err != nil {
+		return false, err
+	}
+	return value == "arrow", nil
+}
+
 func (this *IPropertyParser) PartitionCompression() (int8, error) {
 	n, err := this.GetRangeNumber("ignis.partition.compression", 0, 9)
 	return int8(n), err
 }
 
+func (this *IPropertyParser) PartitionCodec() (string, error) {
+	if !this.Has("ignis.partition.codec") {
+		return "lz4", nil
+	}
+	return this.GetString("ignis.partition.codec")
+}
+
+func (this *IPropertyParser) PartitionBlock() (int64, error) {
+	if !this.Has("ignis.partition.block") {
+		return 4096, nil
+	}
+	return this.GetMinNumber("ignis.partition.block", 1)
+}
+
+/*Memory partitions larger than this size are split in chunks of this size by Fit, 0 keeps them contiguous*/
+func (this *IPropertyParser) PartitionChunk() (int64, error) {
+	if !this.Has("ignis.partition.chunk") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.partition.chunk")
+}
+
+func (this *IPropertyParser) PartitionPrefetch() (int64, error) {
+	if !this.Has("ignis.partition.prefetch") {
+		return 1024, nil
+	}
+	return this.GetMinNumber("ignis.partition.prefetch", 0)
+}
+
+/*Transport of point to point messages, mpi or tcp*/
+func (this *IPropertyParser) TransportType() (string, error) {
+	if !this.Has("ignis.transport.type") {
+		return "mpi", nil
+	}
+	tp, err := this.GetString("ignis.transport.type")
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	if tp != "mpi" && tp != "tcp" {
+		return "", ierror.RaiseMsg("ignis.transport.type must be mpi or tcp, found " + tp)
+	}
+	return tp, nil
+}
+
+func (this *IPropertyParser) TransportTimeout() (float64, error) {
+	if !this.Has("ignis.transport.timeout") {
+		return 0, nil
+	}
+	return this.GetMinFloat("ignis.transport.timeout", 0)
+}
+
+/*Seconds between liveness tokens, the heartbeat is disabled when it is zero*/
+func (this *IPropertyParser) HeartbeatInterval() (float64, error) {
+	if !this.Has("ignis.executor.heartbeat.interval") {
+		return 0, nil
+	}
+	return this.GetMinFloat("ignis.executor.heartbeat.interval", 0)
+}
+
+func (this *IPropertyParser) HeartbeatTimeout() (float64, error) {
+	if !this.Has("ignis.executor.heartbeat.timeout") {
+		return 30, nil
+	}
+	return this.GetMinFloat("ignis.executor.heartbeat.timeout", 0)
+}
+
+func (this *IPropertyParser) TransportRetries() (int64, error) {
+	if !this.Has("ignis.transport.retries") {
+		return 3, nil
+	}
+	return this.GetMinNumber("ignis.transport.retries", 0)
+}
+
+func (this *IPropertyParser) TransportBackoff() (float64, error) {
+	if !this.Has("ignis.transport.backoff") {
+		return 0.1, nil
+	}
+	return this.GetMinFloat("ignis.transport.backoff", 0)
+}
+
+func (this *IPropertyParser) MetricsPort() (int64, error) {
+	if !this.Has("ignis.executor.metrics.port") {
+		return 0, nil
+	}
+	return this.GetRangeNumber("ignis.executor.metrics.port", 0, 65535)
+}
+
+/*Appends every module call to a trace file of the executor in the job directory*/
+func (this *IPropertyParser) Trace() (bool, error) {
+	if !this.Has("ignis.executor.trace") {
+		return false, nil
+	}
+	return this.GetBool("ignis.executor.trace")
+}
+
 func (this *IPropertyParser) TransportElemSize() (int64, error) {
 	return this.GetSize("ignis.transport.element.size")
 }
@@ -78,18 +335,273 @@ func (this *IPropertyParser) PartitionType() (string, error) {
 	return this.GetString("ignis.partition.type")
 }
 
+func (this *IPropertyParser) PartitionSpill() (int64, error) {
+	if !this.Has("ignis.partition.spill") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.partition.spill")
+}
+
 func (this *IPropertyParser) ExchangeType() (string, error) {
 	return this.GetString("ignis.modules.exchange.type")
 }
 
+func (this *IPropertyParser) ExchangeBlock() (int64, error) {
+	if !this.Has("ignis.modules.exchange.block") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.modules.exchange.block")
+}
+
+func (this *IPropertyParser) ExchangeInflight() (int64, error) {
+	if !this.Has("ignis.modules.exchange.inflight") {
+		block, err := this.ExchangeBlock()
+		return 4 * block, err
+	}
+	return this.GetSize("ignis.modules.exchange.inflight")
+}
+
+/*In iterative mode only the partitions changed since the previous iteration are exchanged*/
+func (this *IPropertyParser) ExchangeDelta() (bool, error) {
+	if !this.Has("ignis.modules.exchange.delta") {
+		return false, nil
+	}
+	return this.GetBool("ignis.modules.exchange.delta")
+}
+
+/*Seconds that a message costs in the model used to detect the exchange type*/
+func (this *IPropertyParser) ExchangeLatency() (float64, error) {
+	if !this.Has("ignis.modules.exchange.latency") {
+		return 50e-6, nil
+	}
+	return this.GetMinFloat("ignis.modules.exchange.latency", 0)
+}
+
+/*Bytes per second moved between executors in the model used to detect the exchange type*/
+func (this *IPropertyParser) ExchangeBandwidth() (int64, error) {
+	if !this.Has("ignis.modules.exchange.bandwidth") {
+		return 1000 * 1000 * 1000, nil
+	}
+	return this.GetSize("ignis.modules.exchange.bandwidth")
+}
+
+func (this *IPropertyParser) ExchangeRecompute() (int64, error) {
+	if !this.Has("ignis.modules.exchange.recompute") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.modules.exchange.recompute", 0)
+}
+
+func (this *IPropertyParser) BroadcastChunk() (int64, error) {
+	if !this.Has("ignis.transport.broadcast.chunk") {
+		return 64 * 1024 * 1024, nil
+	}
+	return this.GetSize("ignis.transport.broadcast.chunk")
+}
+
+func (this *IPropertyParser) ReduceCombineLimit() (int64, error) {
+	if !this.Has("ignis.modules.reduce.combine.limit") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.modules.reduce.combine.limit", 0)
+}
+
+func (this *IPropertyParser) ReduceCache() (int64, error) {
+	if !this.Has("ignis.modules.reduce.cache") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.modules.reduce.cache", 0)
+}
+
+func (this *IPropertyParser) ReduceTreeDepth() (int64, error) {
+	if !this.Has("ignis.modules.reduce.tree.depth") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.modules.reduce.tree.depth", 0)
+}
+
+/*Number of partitions a hot key is spread over before the exchange, salting is disabled below two*/
+func (this *IPropertyParser) ReduceSkewSalt() (int64, error) {
+	if !this.Has("ignis.modules.reduce.skew.salt") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.modules.reduce.skew.salt", 0)
+}
+
+/*Fraction of the elements a key must hold to be salted*/
+func (this *IPropertyParser) ReduceSkewFraction() (float64, error) {
+	if !this.Has("ignis.modules.reduce.skew.fraction") {
+		return 0.05, nil
+	}
+	return this.GetRangeFloat("ignis.modules.reduce.skew.fraction", 0, 1)
+}
+
+func (this *IPropertyParser) ReduceGroupMax() (int64, error) {
+	if !this.Has("ignis.modules.reduce.group.max") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.modules.reduce.group.max", 0)
+}
+
+/*Bloom filter bits per element used by distinct to keep unique elements out of the exchange, zero disables it*/
+func (this *IPropertyParser) DistinctBloom() (int64, error) {
+	if !this.Has("ignis.modules.distinct.bloom") {
+		return 8, nil
+	}
+	return this.GetMinNumber("ignis.modules.distinct.bloom", 0)
+}
+
+/*
+Straggler partitions of a map are duplicated on idle threads and the first copy that finishes is kept, the function
+must be idempotent and must not keep state in its context
+*/
+func (this *IPropertyParser) Speculation() (bool, error) {
+	if !this.Has("ignis.modules.speculation") {
+		return false, nil
+	}
+	return this.GetBool("ignis.modules.speculation")
+}
+
+/*Bytes below which the right dataset of a join is broadcast to every executor instead of exchanged, disabled by default*/
+func (this *IPropertyParser) JoinBroadcast() (int64, error) {
+	if !this.Has("ignis.modules.join.broadcast") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.modules.join.broadcast")
+}
+
+/*Bytes of a result returned to the driver by an action, zero disables the limit*/
+func (this *IPropertyParser) ResultMax() (int64, error) {
+	if !this.Has("ignis.modules.result.max") {
+		return 1024 * 1024 * 1024, nil
+	}
+	return this.GetSize("ignis.modules.result.max")
+}
+
+func (this *IPropertyParser) CountMaxKeys() (int64, error) {
+	if !this.Has("ignis.modules.count.max") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.modules.count.max", 0)
+}
+
+func (this *IPropertyParser) MathOverflow() (string, error) {
+	if !this.Has("ignis.modules.math.overflow") {
+		return "wrap", nil
+	}
+	value, err := this.GetString("ignis.modules.math.overflow")
+	if err != nil {
+		return "", err
+	}
+	if value != "wrap" && value != "error" && value != "saturate" && value != "promote" {
+		return "", ierror.RaiseMsg("ignis.modules.math.overflow error " + value + " is not wrap, error, saturate or promote")
+	}
+	return value, nil
+}
+
+func (this *IPropertyParser) MathTopHeap() (int64, error) {
+	if !this.Has("ignis.modules.math.top.heap") {
+		return 10000, nil
+	}
+	return this.GetMinNumber("ignis.modules.math.top.heap", 0)
+}
+
+func (this *IPropertyParser) CheckpointDirectory() (string, error) {
+	return this.GetString("ignis.checkpoint.dir")
+}
+
 func (this *IPropertyParser) JobDirectory() (string, error) {
 	return this.GetString("ignis.job.directory")
 }
 
+func (this *IPropertyParser) CacheMemory() (int64, error) {
+	if !this.Has("ignis.executor.cache.memory") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.executor.cache.memory")
+}
+
+/*Executors after this one that keep a copy of every cache, zero replicates only the caches that request it*/
+func (this *IPropertyParser) CacheReplication() (int64, error) {
+	if !this.Has("ignis.executor.cache.replication") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.executor.cache.replication", 0)
+}
+
+/*Zero if the executor memory is not limited*/
+func (this *IPropertyParser) ExecutorMemory() (int64, error) {
+	if !this.Has("ignis.executor.memory") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.executor.memory")
+}
+
+func (this *IPropertyParser) MemoryFraction() (float64, error) {
+	if !this.Has("ignis.executor.memory.fraction") {
+		return 0.6, nil
+	}
+	return this.GetRangeFloat("ignis.executor.memory.fraction", 0, 1)
+}
+
 func (this *IPropertyParser) ExecutorDirectory() (string, error) {
 	return this.GetString("ignis.executor.directory")
 }
 
+/*Comma separated list, spill files use the partitions folder of the executor directory when it is empty*/
+func (this *IPropertyParser) SpillDirectories() ([]string, error) {
+	if !this.Has("ignis.executor.spill.directories") {
+		return nil, nil
+	}
+	value, err := this.GetString("ignis.executor.spill.directories")
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	var dirs []string
+	for _, dir := range strings.Split(value, ",") {
+		if dir = strings.TrimSpace(dir); dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+	return dirs, nil
+}
+
+func (this *IPropertyParser) SpillQuota() (int64, error) {
+	if !this.Has("ignis.executor.spill.quota") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.executor.spill.quota")
+}
+
+/*Results of deterministic operations kept by the executor, the result cache is disabled with zero entries*/
+func (this *IPropertyParser) ResultCacheEntries() (int64, error) {
+	if !this.Has("ignis.executor.result.cache.entries") {
+		return 0, nil
+	}
+	return this.GetMinNumber("ignis.executor.result.cache.entries", 0)
+}
+
+/*Bytes of the results kept by the executor, zero only limits them by the storage memory*/
+func (this *IPropertyParser) ResultCacheBytes() (int64, error) {
+	if !this.Has("ignis.executor.result.cache.bytes") {
+		return 0, nil
+	}
+	return this.GetSize("ignis.executor.result.cache.bytes")
+}
+
+/*Folder of the keyed states kept between jobs, the first spill directory is used when it is empty*/
+func (this *IPropertyParser) StateDirectory() (string, error) {
+	if !this.Has("ignis.executor.state.directory") {
+		return "", nil
+	}
+	return this.GetString("ignis.executor.state.directory")
+}
+
+func (this *IPropertyParser) Has(key string) bool {
+	_, ok := this.properties[key]
+	return ok
+}
+
 func (this *IPropertyParser) GetString(key string) (string, error) {
 	value, ok := this.properties[key]
 	if ok {
@@ -12,6 +12,7 @@ var ctx = context.Background()
 const (
 	IGNIS_PROTOCOL = 0
 	GO_PROTOCOL    = 3
+	ARROW_PROTOCOL = 4
 )
 
 type IObjectProtocol struct {
@@ -39,8 +40,16 @@ func (this *IObjectProtocol) WriteObjectWithNative(obj any, native bool) error {
 	}
 }
 
+/*Arrow objects are returned as an *iio.IArrowTable, the caller converts them to the expected type*/
 func (this *IObjectProtocol) ReadObject() (any, error) {
-	native, err := this.ReadSerialization()
+	id, err := this.ReadProtocol()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if id == ARROW_PROTOCOL {
+		return iio.ReadArrow(this.Transport())
+	}
+	native, err := this.Serialization(id)
 	if err != nil {
 		return nil, ierror.Raise(err)
 	}
@@ -51,11 +60,23 @@ func (this *IObjectProtocol) ReadObject() (any, error) {
 	}
 }
 
-func (this *IObjectProtocol) ReadSerialization() (bool, error) {
+func (this *IObjectProtocol) ReadProtocol() (int8, error) {
 	id, err := this.ReadByte(ctx)
 	if err != nil {
-		return false, nil
+		return IGNIS_PROTOCOL, nil
 	}
+	return id, nil
+}
+
+func (this *IObjectProtocol) ReadSerialization() (bool, error) {
+	id, err := this.ReadProtocol()
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	return this.Serialization(id)
+}
+
+func (this *IObjectProtocol) Serialization(id int8) (bool, error) {
 	if id == IGNIS_PROTOCOL {
 		return false, nil
 	} else if id == GO_PROTOCOL {
@@ -65,6 +86,10 @@ func (this *IObjectProtocol) ReadSerialization() (bool, error) {
 	}
 }
 
+func (this *IObjectProtocol) WriteArrowSerialization() error {
+	return this.WriteByte(ctx, ARROW_PROTOCOL)
+}
+
 func (this *IObjectProtocol) WriteSerialization(native bool) error {
 	if native {
 		return this.WriteByte(ctx, GO_PROTOCOL)
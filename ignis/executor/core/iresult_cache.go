@@ -0,0 +1,149 @@
+package core
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/core/ierror"
+	. "ignis/executor/core/impi"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"ignis/rpc"
+	"sort"
+)
+
+/*
+Output of deterministic operations indexed by a fingerprint of the operation, its arguments, its input and the
+properties. The input is identified by the cache it was loaded from or by the fingerprint of the operation that
+produced it, so chains of operations over a cache are also reused. Operations are collective, so a result is only
+reused when every executor still keeps it.
+*/
+type IResultCache struct {
+	executorData *IExecutorData
+	input        string
+	entries      map[string]*list.Element
+	order        list.List
+	bytes        int64
+}
+
+type iResultEntry struct {
+	key         string
+	group       storage.IPartitionGroupBase
+	partitioner string
+	bytes       int64
+}
+
+/*Identifies the current partitions, empty when they can not be identified*/
+func (this *IResultCache) SetInput(id string) {
+	this.input = id
+}
+
+/*Returns an empty fingerprint when the result cache is disabled or the input is unknown*/
+func (this *IResultCache) Fingerprint(operation string, args ...any) (string, error) {
+	entries, err := this.executorData.GetProperties().ResultCacheEntries()
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	if entries == 0 || this.input == "" {
+		return "", nil
+	}
+	h := sha256.New()
+	fmt.Fprint(h, this.input, "\x00", operation)
+	for _, arg := range args {
+		h.Write([]byte{0})
+		if src, ok := arg.(*rpc.ISource); ok {
+			data, err := thrift.NewTSerializer().Write(context.Background(), src)
+			if err != nil {
+				return "", ierror.Raise(err)
+			}
+			h.Write(data)
+		} else {
+			fmt.Fprint(h, arg)
+		}
+	}
+	props := this.executorData.GetContext().Props()
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprint(h, "\x00", key, "=", props[key])
+	}
+	return "result:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+/*The group must not be modified, it is shared with the cache*/
+func (this *IResultCache) Get(key string) (storage.IPartitionGroupBase, string, bool, error) {
+	elem, present := this.entries[key]
+	hit := C_int(0)
+	if present {
+		hit = 1
+	}
+	if err := MPI_Allreduce(MPI_IN_PLACE, P(&hit), 1, MPI_INT, MPI_MIN, this.executorData.Mpi().Native()); err != nil {
+		return nil, "", false, ierror.Raise(err)
+	}
+	if hit == 0 {
+		return nil, "", false, nil
+	}
+	this.order.MoveToFront(elem)
+	entry := elem.Value.(*iResultEntry)
+	return entry.group.ShadowCopyBase(), entry.partitioner, true, nil
+}
+
+/*Keeps the result while it fits, least recently used results are released first*/
+func (this *IResultCache) Put(key string, group storage.IPartitionGroupBase, partitioner string) error {
+	if _, present := this.entries[key]; present || group == nil {
+		return nil
+	}
+	maxEntries, err := this.executorData.GetProperties().ResultCacheEntries()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	maxBytes, err := this.executorData.GetProperties().ResultCacheBytes()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	bytes := int64(0)
+	for i := 0; i < group.Size(); i++ {
+		if !this.executorData.GetPartitionTools().IsDisk(group.GetBase(i)) {
+			bytes += group.GetBase(i).Bytes()
+		}
+	}
+	if maxBytes > 0 && bytes > maxBytes {
+		return nil
+	}
+	for this.order.Len() > 0 && (int64(this.order.Len()) >= maxEntries || (maxBytes > 0 && this.bytes+bytes > maxBytes)) {
+		this.remove(this.order.Back())
+	}
+	if fit, err := this.executorData.Memory().AcquireStorage(bytes, false); err != nil {
+		return ierror.Raise(err)
+	} else if !fit {
+		logger.Info("ResultCache: result of ", bytes, " bytes does not fit in the storage memory")
+		return nil
+	}
+	if this.entries == nil {
+		this.entries = make(map[string]*list.Element)
+	}
+	group.SetCache(true)
+	this.entries[key] = this.order.PushFront(&iResultEntry{key, group, partitioner, bytes})
+	this.bytes += bytes
+	return nil
+}
+
+func (this *IResultCache) remove(elem *list.Element) {
+	entry := this.order.Remove(elem).(*iResultEntry)
+	delete(this.entries, entry.key)
+	this.bytes -= entry.bytes
+	this.executorData.Memory().ReleaseStorage(entry.bytes)
+}
+
+/*Results are discarded when a cache they may derive from changes*/
+func (this *IResultCache) Clear() {
+	for this.order.Len() > 0 {
+		this.remove(this.order.Back())
+	}
+}
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/logger"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+)
+
+/*
+Spill files are spread round-robin across the configured directories. Each executor writes inside its own
+spill<executor>-<pid> folder, so the folders of processes that are no longer running can be removed at startup.
+*/
+type ISpillManager struct {
+	mu      sync.Mutex
+	tools   *IPartitionTools
+	dirs    []string
+	quota   int64
+	next    int
+	ids     atomic.Int64
+	started bool
+}
+
+const spillPrefix = "spill"
+
+func (this *ISpillManager) init() error {
+	if this.started {
+		return nil
+	}
+	dirs, err := this.tools.properties.SpillDirectories()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if this.quota, err = this.tools.properties.SpillQuota(); err != nil {
+		return ierror.Raise(err)
+	}
+	folder := spillPrefix + strconv.Itoa(this.tools.context.ExecutorId()) + "-" + strconv.Itoa(os.Getpid())
+	for _, dir := range dirs {
+		path := filepath.Join(dir, folder)
+		if err = this.tools.CreateDirectoryIfNotExists(path); err != nil {
+			logger.Warn("Spill: directory ", dir, " ignored, ", err)
+			continue
+		}
+		this.dirs = append(this.dirs, path)
+	}
+	if len(dirs) > 0 && len(this.dirs) == 0 {
+		return ierror.RaiseMsg("none of the spill directories can be used")
+	}
+	this.started = true
+	return nil
+}
+
+/*Returns an empty path when no spill directory is configured*/
+func (this *ISpillManager) Path() (string, error) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if err := this.init(); err != nil {
+		return "", ierror.Raise(err)
+	}
+	if len(this.dirs) == 0 && this.quota == 0 {
+		return "", nil
+	}
+	if this.quota > 0 {
+		if used := this.Used(); used >= this.quota {
+			return "", ierror.RaiseMsg("spill quota of " + strconv.FormatInt(this.quota, 10) + " bytes exceeded, " +
+				strconv.FormatInt(used, 10) + " bytes in use")
+		}
+	}
+	if len(this.dirs) == 0 {
+		return "", nil
+	}
+	dir := this.dirs[this.next]
+	this.next = (this.next + 1) % len(this.dirs)
+	return filepath.Join(dir, "partition"+strconv.FormatInt(this.ids.Add(1), 10)), nil
+}
+
+/*Bytes of the spill files of this executor*/
+func (this *ISpillManager) Used() int64 {
+	dirs := this.dirs
+	prefix := ""
+	if len(dirs) == 0 {
+		if dir, err := this.tools.properties.ExecutorDirectory(); err == nil {
+			dirs = []string{dir + "/partitions"}
+			prefix = "partition" + strconv.Itoa(this.tools.context.ExecutorId()) + "."
+		}
+	}
+	used := int64(0)
+	for _, dir := range dirs {
+		entries, _ := os.ReadDir(dir)
+		for _, entry := range entries {
+			if !strings.HasPrefix(entry.Name(), prefix) {
+				continue
+			}
+			if info, err := entry.Info(); err == nil && info.Mode().IsRegular() {
+				used += info.Size()
+			}
+		}
+	}
+	return used
+}
+
+/*Removes the spill folders left by executors that are no longer running*/
+func (this *ISpillManager) Cleanup() error {
+	dirs, err := this.tools.properties.SpillDirectories()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			sep := strings.LastIndexByte(name, '-')
+			if !entry.IsDir() || !strings.HasPrefix(name, spillPrefix) || sep < 0 {
+				continue
+			}
+			pid, err := strconv.Atoi(name[sep+1:])
+			if err != nil || pid == os.Getpid() || processAlive(pid) {
+				continue
+			}
+			logger.Info("Spill: removing orphaned files in ", filepath.Join(dir, name))
+			if err = os.RemoveAll(filepath.Join(dir, name)); err != nil {
+				logger.Warn("Spill: ", err)
+			}
+		}
+	}
+	return nil
+}
+
+func processAlive(pid int) bool {
+	err := syscall.Kill(pid, 0)
+	return err == nil || err == syscall.EPERM
+}
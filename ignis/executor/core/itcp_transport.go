@@ -0,0 +1,326 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"ignis/executor/core/utils"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+)
+
+/*
+Point to point transport over TCP for clusters where MPI point to point is not reliable. Every executor listens on a
+port and publishes its address in the job directory, so peers are found without MPI. Partitions keep the format of
+the MPI streams, split in frames with a size prefix, and collectives still use MPI.
+*/
+type iTcpTransport struct {
+	mu       sync.Mutex
+	listener net.Listener
+	dir      string
+	timeout  time.Duration
+	inbox    map[iTcpKey]chan net.Conn
+}
+
+/*Messages are matched like MPI messages, the thread identifies the communicator*/
+type iTcpKey struct {
+	source int32
+	tag    int32
+	thread int32
+}
+
+const tcpFrame = 1 << 20
+
+var tcpTransport iTcpTransport
+
+/*Returns nil when point to point messages use MPI*/
+func (this *IMpi) tcp() (*iTcpTransport, error) {
+	tp, err := this.propertyParser.TransportType()
+	if err != nil || tp != "tcp" {
+		return nil, err
+	}
+	tcpTransport.mu.Lock()
+	defer tcpTransport.mu.Unlock()
+	if tcpTransport.listener == nil {
+		if err = tcpTransport.start(this); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	return &tcpTransport, nil
+}
+
+func (this *iTcpTransport) start(mpi *IMpi) error {
+	jobDir, err := mpi.propertyParser.JobDirectory()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	timeout, err := mpi.propertyParser.TransportTimeout()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.dir = filepath.Join(jobDir, "transport")
+	if err = os.MkdirAll(this.dir, os.ModePerm); err != nil {
+		return ierror.Raise(err)
+	}
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	address := net.JoinHostPort(host, strconv.Itoa(listener.Addr().(*net.TCPAddr).Port))
+	path := filepath.Join(this.dir, "executor"+strconv.Itoa(mpi.Rank()))
+	if err = os.WriteFile(path+".tmp", []byte(address), 0644); err != nil {
+		listener.Close()
+		return ierror.Raise(err)
+	}
+	if err = os.Rename(path+".tmp", path); err != nil {
+		listener.Close()
+		return ierror.Raise(err)
+	}
+	logger.Info("Transport: listening on ", address)
+	this.listener = listener
+	this.timeout = time.Duration(timeout * float64(time.Second))
+	this.inbox = make(map[iTcpKey]chan net.Conn)
+	go this.accept()
+	return nil
+}
+
+func (this *iTcpTransport) channel(key iTcpKey) chan net.Conn {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if _, present := this.inbox[key]; !present {
+		this.inbox[key] = make(chan net.Conn, 16)
+	}
+	return this.inbox[key]
+}
+
+func (this *iTcpTransport) accept() {
+	for {
+		conn, err := this.listener.Accept()
+		if err != nil {
+			logger.Error("Transport: ", err)
+			return
+		}
+		go func() {
+			var header [12]byte
+			if _, err := io.ReadFull(conn, header[:]); err != nil {
+				logger.Warn("Transport: ", err)
+				conn.Close()
+				return
+			}
+			this.channel(iTcpKey{
+				source: int32(binary.LittleEndian.Uint32(header[0:])),
+				tag:    int32(binary.LittleEndian.Uint32(header[4:])),
+				thread: int32(binary.LittleEndian.Uint32(header[8:])),
+			}) <- conn
+		}()
+	}
+}
+
+/*Connects to the peer, retrying until its address is published and it accepts connections*/
+func (this *iTcpTransport) dial(peer int) (net.Conn, error) {
+	path := filepath.Join(this.dir, "executor"+strconv.Itoa(peer))
+	start := time.Now()
+	wait := 10 * time.Millisecond
+	for {
+		address, err := os.ReadFile(path)
+		if err == nil {
+			var conn net.Conn
+			if conn, err = net.Dial("tcp", string(address)); err == nil {
+				return conn, nil
+			}
+		}
+		if this.timeout > 0 && time.Since(start) > this.timeout {
+			return nil, ierror.RaiseMsgCause("executor "+strconv.Itoa(peer)+" can not be reached", err)
+		}
+		time.Sleep(wait)
+		wait = utils.Min(2*wait, time.Second)
+	}
+}
+
+func sendTcp[T any](this *IMpi, tcp *iTcpTransport, part storage.IPartition[T], dest int, tag int) error {
+	cmp, err := this.propertyParser.MsgCompression()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	native, err := this.propertyParser.NativeSerialization()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	conn, err := tcp.dial(dest)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	defer conn.Close()
+	var header [12]byte
+	binary.LittleEndian.PutUint32(header[0:], uint32(this.Rank()))
+	binary.LittleEndian.PutUint32(header[4:], uint32(tag))
+	binary.LittleEndian.PutUint32(header[8:], uint32(this.context.ThreadId()))
+	if _, err = conn.Write(header[:]); err != nil {
+		return ierror.Raise(err)
+	}
+	writer := &iTcpWriter{buffer: bufio.NewWriterSize(conn, tcpFrame+4)}
+	if err = writePartition(this, part, writer, cmp, native); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = writer.Close(); err != nil {
+		return ierror.Raise(err)
+	}
+	/*The receiver acknowledges when the partition is read, so the send completes like a synchronous MPI send*/
+	var ack [1]byte
+	if _, err = io.ReadFull(conn, ack[:]); err != nil {
+		return ierror.RaiseMsgCause("executor "+strconv.Itoa(dest)+" did not receive the partition", err)
+	}
+	return nil
+}
+
+func recvTcp[T any](this *IMpi, tcp *iTcpTransport, part storage.IPartition[T], source int, tag int) error {
+	var conn net.Conn
+	channel := tcp.channel(iTcpKey{int32(source), int32(tag), int32(this.context.ThreadId())})
+	if tcp.timeout > 0 {
+		select {
+		case conn = <-channel:
+		case <-time.After(tcp.timeout):
+			return ierror.RaiseMsg("executor " + strconv.Itoa(source) + " did not send the partition")
+		}
+	} else {
+		conn = <-channel
+	}
+	defer conn.Close()
+	reader := &iTcpReader{conn: bufio.NewReaderSize(conn, tcpFrame+4)}
+	if err := part.Read(reader); err != nil {
+		return ierror.Raise(err)
+	}
+	if _, err := io.Copy(io.Discard, reader); err != nil {
+		return ierror.Raise(err)
+	}
+	_, err := conn.Write([]byte{1})
+	return ierror.Raise(err)
+}
+
+/*Frames are a little endian size followed by the data, a frame of size zero ends the partition*/
+type iTcpWriter struct {
+	buffer *bufio.Writer
+	frame  []byte
+}
+
+func (this *iTcpWriter) send() error {
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(this.frame)))
+	if _, err := this.buffer.Write(size[:]); err != nil {
+		return err
+	}
+	if _, err := this.buffer.Write(this.frame); err != nil {
+		return err
+	}
+	this.frame = this.frame[:0]
+	return nil
+}
+
+func (this *iTcpWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		if this.frame == nil {
+			this.frame = make([]byte, 0, tcpFrame)
+		}
+		c := utils.Min(len(p), tcpFrame-len(this.frame))
+		this.frame = append(this.frame, p[:c]...)
+		p = p[c:]
+		if len(this.frame) == tcpFrame {
+			if err := this.send(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+func (this *iTcpWriter) Close() error {
+	if len(this.frame) > 0 {
+		if err := this.send(); err != nil {
+			return err
+		}
+	}
+	if err := this.send(); err != nil {
+		return err
+	}
+	return this.buffer.Flush()
+}
+
+func (this *iTcpWriter) Read(p []byte) (int, error) {
+	return 0, ierror.RaiseMsg("tcp writer can not be read")
+}
+
+func (this *iTcpWriter) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (this *iTcpWriter) RemainingBytes() uint64 {
+	return ^uint64(0)
+}
+
+func (this *iTcpWriter) Open() error {
+	return nil
+}
+
+func (this *iTcpWriter) IsOpen() bool {
+	return true
+}
+
+type iTcpReader struct {
+	conn      *bufio.Reader
+	remaining int
+	done      bool
+}
+
+func (this *iTcpReader) Read(p []byte) (int, error) {
+	for this.remaining == 0 {
+		if this.done {
+			return 0, io.EOF
+		}
+		var size [4]byte
+		if _, err := io.ReadFull(this.conn, size[:]); err != nil {
+			return 0, err
+		}
+		this.remaining = int(binary.LittleEndian.Uint32(size[:]))
+		this.done = this.remaining == 0
+	}
+	n, err := this.conn.Read(p[:utils.Min(len(p), this.remaining)])
+	this.remaining -= n
+	return n, err
+}
+
+func (this *iTcpReader) Close() error {
+	return nil
+}
+
+func (this *iTcpReader) Write(p []byte) (int, error) {
+	return 0, ierror.RaiseMsg("tcp reader can not be written")
+}
+
+func (this *iTcpReader) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (this *iTcpReader) RemainingBytes() uint64 {
+	return uint64(this.remaining)
+}
+
+func (this *iTcpReader) Open() error {
+	return nil
+}
+
+func (this *iTcpReader) IsOpen() bool {
+	return true
+}
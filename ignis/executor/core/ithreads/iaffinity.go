@@ -0,0 +1,172 @@
+package ithreads
+
+import (
+	"ignis/executor/core/ierror"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	AffinityNone = "none"
+	AffinityNuma = "numa"
+	AffinityCore = "core"
+)
+
+/*
+Mode and cpus used by the workers, with numa the whole process is bound to the cpus of one node and with core each
+worker is also bound to its own cpu of the node.
+*/
+var affinityMode = AffinityNone
+var affinityCpus []int
+
+func Affinity() (string, []int) {
+	return affinityMode, affinityCpus
+}
+
+/*
+Binds the process to the cpus of a numa node. Threads started later, including the ones created by MPI, inherit the
+mask, so the workers and the communication share the memory of the same socket. A negative node selects the node
+from the local rank of the process.
+*/
+func SetAffinity(mode string, node int) (int, error) {
+	if mode == AffinityNone || mode == "" {
+		affinityMode = AffinityNone
+		affinityCpus = nil
+		return -1, nil
+	}
+	if mode != AffinityNuma && mode != AffinityCore {
+		return -1, ierror.RaiseMsg("unknown affinity mode " + mode)
+	}
+	nodes, err := numaNodes()
+	if err != nil {
+		return -1, ierror.Raise(err)
+	}
+	var cpus []int
+	if len(nodes) == 0 {
+		/*Without numa information the whole machine is a single node*/
+		node = 0
+		if cpus, err = getAffinity(0); err != nil {
+			return -1, ierror.Raise(err)
+		}
+	} else {
+		if node < 0 {
+			node = localRank() % len(nodes)
+		} else if node >= len(nodes) {
+			return -1, ierror.RaiseMsg("numa node " + strconv.Itoa(node) + " not found, there are " +
+				strconv.Itoa(len(nodes)) + " nodes")
+		}
+		cpus = nodes[node]
+	}
+	if len(cpus) == 0 {
+		return -1, ierror.RaiseMsg("numa node " + strconv.Itoa(node) + " has no cpus")
+	}
+	if err = setProcessAffinity(cpus); err != nil {
+		return -1, ierror.Raise(err)
+	}
+	affinityMode = mode
+	affinityCpus = cpus
+	return node, nil
+}
+
+/*Binds the worker goroutine to its cpu, the returned function restores the thread before it is released*/
+func pinWorker(threadId int) func() {
+	if affinityMode != AffinityCore || len(affinityCpus) == 0 {
+		return func() {}
+	}
+	runtime.LockOSThread()
+	if err := setAffinity(0, affinityCpus[threadId%len(affinityCpus)]); err != nil {
+		runtime.UnlockOSThread()
+		return func() {}
+	}
+	return func() {
+		if err := setAffinity(0, affinityCpus...); err != nil {
+			/*A thread that can not be restored is discarded by the runtime when the goroutine ends*/
+			return
+		}
+		runtime.UnlockOSThread()
+	}
+}
+
+func localRank() int {
+	for _, name := range []string{"OMPI_COMM_WORLD_LOCAL_RANK", "MPI_LOCALRANKID", "MV2_COMM_WORLD_LOCAL_RANK", "SLURM_LOCALID"} {
+		if value, err := strconv.Atoi(os.Getenv(name)); err == nil && value >= 0 {
+			return value
+		}
+	}
+	return 0
+}
+
+/*Cpus of each numa node from sysfs, empty when the system does not expose them*/
+func numaNodes() ([][]int, error) {
+	paths, err := filepath.Glob("/sys/devices/system/node/node[0-9]*")
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	ids := make([]int, 0, len(paths))
+	for _, path := range paths {
+		if id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(path), "node")); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	nodes := make([][]int, 0, len(ids))
+	for _, id := range ids {
+		data, err := os.ReadFile("/sys/devices/system/node/node" + strconv.Itoa(id) + "/cpulist")
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		cpus, err := parseCpuList(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		nodes = append(nodes, cpus)
+	}
+	return nodes, nil
+}
+
+/*Parses the kernel list format, like 0-3,8,10-11*/
+func parseCpuList(list string) ([]int, error) {
+	var cpus []int
+	if list == "" {
+		return cpus, nil
+	}
+	for _, item := range strings.Split(list, ",") {
+		first, last, isRange := strings.Cut(item, "-")
+		a, err := strconv.Atoi(first)
+		if err != nil {
+			return nil, ierror.RaiseMsg("invalid cpu list " + list)
+		}
+		b := a
+		if isRange {
+			if b, err = strconv.Atoi(last); err != nil || b < a {
+				return nil, ierror.RaiseMsg("invalid cpu list " + list)
+			}
+		}
+		for cpu := a; cpu <= b; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus, nil
+}
+
+func setProcessAffinity(cpus []int) error {
+	tasks, err := os.ReadDir("/proc/self/task")
+	if err != nil {
+		return setAffinity(0, cpus...)
+	}
+	for _, task := range tasks {
+		tid, err := strconv.Atoi(task.Name())
+		if err != nil {
+			continue
+		}
+		/*A thread can exit while the list is traversed*/
+		if err = setAffinity(tid, cpus...); err != nil && tid == os.Getpid() {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package ithreads
+
+import (
+	"ignis/executor/core/ierror"
+	"syscall"
+	"unsafe"
+)
+
+type iCpuSet [1024 / 64]uint64
+
+/*Mask of the thread tid, zero is the calling thread*/
+func getAffinity(tid int) ([]int, error) {
+	var set iCpuSet
+	_, _, errno := syscall.RawSyscall(syscall.SYS_SCHED_GETAFFINITY, uintptr(tid), unsafe.Sizeof(set),
+		uintptr(unsafe.Pointer(&set)))
+	if errno != 0 {
+		return nil, ierror.Raise(errno)
+	}
+	var cpus []int
+	for cpu := 0; cpu < len(set)*64; cpu++ {
+		if set[cpu/64]&(1<<(cpu%64)) != 0 {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus, nil
+}
+
+func setAffinity(tid int, cpus ...int) error {
+	var set iCpuSet
+	for _, cpu := range cpus {
+		if cpu < 0 || cpu >= len(set)*64 {
+			return ierror.RaiseMsg("cpu out of range")
+		}
+		set[cpu/64] |= 1 << (cpu % 64)
+	}
+	_, _, errno := syscall.RawSyscall(syscall.SYS_SCHED_SETAFFINITY, uintptr(tid), unsafe.Sizeof(set),
+		uintptr(unsafe.Pointer(&set)))
+	if errno != 0 {
+		return ierror.Raise(errno)
+	}
+	return nil
+}
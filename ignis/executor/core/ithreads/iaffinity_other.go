@@ -0,0 +1,13 @@
+//go:build !linux
+
+package ithreads
+
+import "ignis/executor/core/ierror"
+
+func getAffinity(tid int) ([]int, error) {
+	return nil, ierror.RaiseMsg("thread affinity is only supported on linux")
+}
+
+func setAffinity(tid int, cpus ...int) error {
+	return ierror.RaiseMsg("thread affinity is only supported on linux")
+}
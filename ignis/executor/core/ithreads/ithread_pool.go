@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"ignis/executor/core/ierror"
+	"ignis/executor/core/utils"
 	"math"
+	"sort"
 	"sync"
+	"time"
 )
 
 var defaultCores = 1
@@ -18,6 +21,13 @@ func SetDefaultCores(n int) {
 	defaultCores = n
 }
 
+var interrupt func() error
+
+/*Checked by every thread before each chunk of a loop, a loop stops with the first error it returns*/
+func SetInterrupt(check func() error) {
+	interrupt = check
+}
+
 type IRuntimeContext interface {
 	Threads() int
 	ThreadId() int
@@ -53,8 +63,9 @@ func (this *cyclicBarrier) await() {
 
 type iRuntimeContextData struct {
 	threads int
-	data    chan [2]int
-	loop    bool
+	queue   *iWorkQueue
+	deques  []iStealDeque
+	spec    *iSpeculation
 	error   chan error
 	f       func(rctx IRuntimeContext) error
 	mutex   sync.Mutex
@@ -64,6 +75,7 @@ type iRuntimeContextData struct {
 type iRuntimeContextImpl struct {
 	*iRuntimeContextData
 	threadId int
+	loop     bool
 }
 
 func Parallel(f func(rctx IRuntimeContext) error) error {
@@ -73,8 +85,7 @@ func Parallel(f func(rctx IRuntimeContext) error) error {
 func ParallelT(threads int, f func(rctx IRuntimeContext) error) (r error) {
 	rctx := &iRuntimeContextData{
 		threads: threads,
-		data:    nil,
-		loop:    false,
+		queue:   nil,
 		error:   make(chan error, threads),
 		f:       f,
 		barrier: cyclicBarrier{
@@ -85,9 +96,9 @@ func ParallelT(threads int, f func(rctx IRuntimeContext) error) (r error) {
 	}
 	rctx.barrier.cond = sync.NewCond(&rctx.mutex)
 	for i := 1; i < threads; i++ {
-		go worker(&iRuntimeContextImpl{rctx, i})
+		go worker(&iRuntimeContextImpl{rctx, i, false})
 	}
-	worker(&iRuntimeContextImpl{rctx, 0})
+	worker(&iRuntimeContextImpl{rctx, 0, false})
 
 	for i := 0; i < threads; i++ {
 		if err := <-rctx.error; err != nil {
@@ -98,6 +109,7 @@ func ParallelT(threads int, f func(rctx IRuntimeContext) error) (r error) {
 }
 
 func worker(rctx *iRuntimeContextImpl) {
+	defer pinWorker(rctx.threadId)()
 	defer func() {
 		if r := recover(); r != nil {
 			if err, ok := r.(error); ok {
@@ -138,38 +150,81 @@ func (this *iRuntimeContextImpl) Barrier() {
 
 func (this *iRuntimeContextImpl) For() IForBuilder {
 	return &iForBuilderImpl{
-		rctx:    this,
-		static:  true,
-		threads: this.threads,
-		chunk:   -1,
-		start:   0,
+		rctx:     this,
+		schedule: scheduleStatic,
+		threads:  this.threads,
+		chunk:    -1,
+		start:    0,
 	}
 }
 
 type IForBuilder interface {
 	Static() IForBuilder
 	Dynamic() IForBuilder
+	Guided() IForBuilder
+	Stealing() IForBuilder
+	Weighted(weights []int64) IForBuilder
 	Threads(n int) IForBuilder
 	Chunk(n int) IForBuilder
 	Start(n int) IForBuilder
+	Speculative(enabled bool) IForBuilder
 	Run(n int, f func(i int) error) error
+	RunPrivate(n int, f func(i int) (func() error, error)) error
 }
 
+const (
+	scheduleStatic = iota
+	scheduleDynamic
+	scheduleGuided
+	scheduleWeighted
+	scheduleStealing
+)
+
 type iForBuilderImpl struct {
-	rctx    *iRuntimeContextImpl
-	static  bool
-	threads int
-	chunk   int
-	start   int
+	rctx     *iRuntimeContextImpl
+	schedule int
+	threads  int
+	chunk    int
+	start    int
+	weights  []int64
+	spec     bool
 }
 
 func (this *iForBuilderImpl) Static() IForBuilder {
-	this.static = true
+	this.schedule = scheduleStatic
 	return this
 }
 
 func (this *iForBuilderImpl) Dynamic() IForBuilder {
-	this.static = false
+	this.schedule = scheduleDynamic
+	return this
+}
+
+/*
+Guided is like Dynamic, but chunks start at a share of the remaining iterations and shrink as the loop advances, so
+skewed loops balance without the queue overhead of single iterations
+*/
+func (this *iForBuilderImpl) Guided() IForBuilder {
+	this.schedule = scheduleGuided
+	return this
+}
+
+/*
+Stealing starts like Static, but a thread without iterations left takes half of the remaining iterations of another
+thread, so a few slow iterations do not delay the rest of the loop.
+*/
+func (this *iForBuilderImpl) Stealing() IForBuilder {
+	this.schedule = scheduleStealing
+	return this
+}
+
+/*
+Weighted assigns iterations to threads by decreasing weight, always to the least loaded thread. Unlike Dynamic and
+Guided, the assignment only depends on the weights, so every process computes the same schedule.
+*/
+func (this *iForBuilderImpl) Weighted(weights []int64) IForBuilder {
+	this.schedule = scheduleWeighted
+	this.weights = weights
 	return this
 }
 
@@ -190,62 +245,370 @@ func (this *iForBuilderImpl) Start(n int) IForBuilder {
 	return this
 }
 
-func (this *iForBuilderImpl) Run(end int, f func(i int) error) (_err error) {
-	if this.rctx.loop {
-		return ierror.RaiseMsg("parallel loop in parallel loop error")
+/*
+Speculative duplicates the slowest iterations of RunPrivate on idle threads once most of the loop is done, only the
+output of the copy that finishes first is published and the other is abandoned. An abandoned copy can still be running
+when the loop returns, so the iterations must not have side effects outside their private output.
+*/
+func (this *iForBuilderImpl) Speculative(enabled bool) IForBuilder {
+	this.spec = enabled
+	return this
+}
+
+type iWorkQueue struct {
+	mutex   sync.Mutex
+	next    int
+	end     int
+	threads int
+	chunk   int
+	guided  bool
+}
+
+func (this *iWorkQueue) pop() (int, int, bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if this.next >= this.end {
+		return 0, 0, false
 	}
-	if this.chunk == -1 {
-		if this.static {
-			this.chunk = int(math.Ceil(float64(end-this.start) / float64(this.threads)))
-		} else {
-			this.chunk = 1
+	size := this.chunk
+	if this.guided {
+		if guided := (this.end - this.next + this.threads - 1) / this.threads; guided > size {
+			size = guided
 		}
 	}
+	i := this.next
+	this.next += size
+	if this.next > this.end {
+		this.next = this.end
+	}
+	return i, this.next, true
+}
 
-	data := this.rctx.data
+type iStealDeque struct {
+	mutex sync.Mutex
+	first int
+	last  int
+}
+
+func (this *iStealDeque) pop(chunk int) (int, int, bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if this.first >= this.last {
+		return 0, 0, false
+	}
+	i := this.first
+	this.first = utils.Min(this.first+chunk, this.last)
+	return i, this.first, true
+}
+
+func (this *iStealDeque) steal() (int, int, bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	n := (this.last - this.first + 1) / 2
+	if n <= 0 {
+		return 0, 0, false
+	}
+	this.last -= n
+	return this.last, this.last + n, true
+}
+
+func (this *iStealDeque) push(first int, last int) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.first = first
+	this.last = last
+}
+
+func (this *iForBuilderImpl) stealingDeques(end int) []iStealDeque {
+	deques := make([]iStealDeque, this.threads)
+	block := int(math.Ceil(float64(end-this.start) / float64(this.threads)))
+	for i := range deques {
+		deques[i].first = utils.Min(this.start+i*block, end)
+		deques[i].last = utils.Min(deques[i].first+block, end)
+	}
+	return deques
+}
+
+func (this *iForBuilderImpl) staticChunks(end int) [][2]int {
+	chunk := this.chunk
+	if chunk == -1 {
+		chunk = int(math.Ceil(float64(end-this.start) / float64(this.threads)))
+	}
+	var chunks [][2]int
 	threadId := this.rctx.ThreadId()
-	if threadId == 0 || this.static {
-		data = make(chan [2]int, (end-this.start)/this.chunk+1)
-		if !this.static {
-			this.rctx.data = data
+	id := 0
+	for i := this.start; i < end; i += chunk {
+		if id == threadId {
+			chunks = append(chunks, [2]int{i, utils.Min(i+chunk, end)})
 		}
+		id = (id + 1) % this.threads
+	}
+	return chunks
+}
 
-		i := this.start
+func (this *iForBuilderImpl) weightedChunks(end int) [][2]int {
+	order := make([]int, 0, end-this.start)
+	for i := this.start; i < end; i++ {
+		order = append(order, i)
+	}
+	weight := func(i int) int64 {
+		if i < len(this.weights) {
+			return this.weights[i]
+		}
+		return 0
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return weight(order[a]) > weight(order[b])
+	})
+	load := make([]int64, this.threads)
+	var chunks [][2]int
+	threadId := this.rctx.ThreadId()
+	for _, i := range order {
 		id := 0
-		for {
-			next := i + this.chunk
-			if next > end {
-				if i < end {
-					next = end
-				} else {
-					break
-				}
+		for t := 1; t < this.threads; t++ {
+			if load[t] < load[id] {
+				id = t
 			}
-			if !this.static || threadId == id {
-				data <- [2]int{i, next}
+		}
+		load[id] += weight(i) + 1
+		if id == threadId {
+			chunks = append(chunks, [2]int{i, i + 1})
+		}
+	}
+	return chunks
+}
+
+func (this *iForBuilderImpl) Run(end int, f func(i int) error) error {
+	this.spec = false
+	return this.RunPrivate(end, func(i int) (func() error, error) {
+		return nil, f(i)
+	})
+}
+
+/*
+Every iteration writes to a private output and returns the function that publishes it, or nil if there is nothing to
+publish. Outputs are published by the thread that runs the iteration, or by the winner copy if it was duplicated.
+*/
+func (this *iForBuilderImpl) RunPrivate(end int, f func(i int) (func() error, error)) error {
+	if this.rctx.loop {
+		return ierror.RaiseMsg("parallel loop in parallel loop error")
+	}
+	var chunks [][2]int
+	switch this.schedule {
+	case scheduleStatic:
+		chunks = this.staticChunks(end)
+	case scheduleWeighted:
+		chunks = this.weightedChunks(end)
+	case scheduleStealing:
+		if this.rctx.ThreadId() == 0 {
+			this.rctx.deques = this.stealingDeques(end)
+		}
+	default:
+		if this.rctx.ThreadId() == 0 {
+			this.rctx.queue = &iWorkQueue{
+				next:    this.start,
+				end:     end,
+				threads: this.threads,
+				chunk:   utils.Max(this.chunk, 1),
+				guided:  this.schedule == scheduleGuided,
 			}
-			i = next
-			id++
-			id = id % this.threads
 		}
-		close(data)
+	}
+	if this.rctx.ThreadId() == 0 {
+		this.rctx.spec = nil
+		if this.spec {
+			this.rctx.spec = newISpeculation(end - this.start)
+		}
 	}
 	this.rctx.Barrier()
 	this.rctx.loop = true
-	if !this.static {
-		data = this.rctx.data
-	}
+	queue := this.rctx.queue
+	deques := this.rctx.deques
+	spec := this.rctx.spec
 
-	for chunk := range data {
-		for i := chunk[0]; i < chunk[1]; i++ {
-			if err := f(i); err != nil {
-				this.rctx.Barrier()
-				this.rctx.loop = false
+	call := func(i int) error {
+		commit, err := f(i)
+		if err != nil || commit == nil {
+			return err
+		}
+		return commit()
+	}
+	if spec != nil {
+		call = func(i int) error {
+			return spec.run(i, f)
+		}
+	}
+	run := func(first int, last int) error {
+		if interrupt != nil {
+			if err := interrupt(); err != nil {
+				return err
+			}
+		}
+		for i := first; i < last; i++ {
+			if err := call(i); err != nil {
 				return err
 			}
 		}
+		return nil
+	}
+	var err error
+	if this.schedule == scheduleDynamic || this.schedule == scheduleGuided {
+		for err == nil {
+			first, last, ok := queue.pop()
+			if !ok {
+				break
+			}
+			err = run(first, last)
+		}
+	} else if this.schedule == scheduleStealing {
+		id := this.rctx.ThreadId()
+		chunk := utils.Max(this.chunk, 1)
+		for err == nil && id < len(deques) {
+			first, last, ok := deques[id].pop(chunk)
+			if !ok {
+				for victim := (id + 1) % len(deques); victim != id; victim = (victim + 1) % len(deques) {
+					if first, last, ok = deques[victim].steal(); ok {
+						break
+					}
+				}
+				if !ok {
+					break
+				}
+				deques[id].push(first, last)
+				continue
+			}
+			err = run(first, last)
+		}
+	} else {
+		for _, chunk := range chunks {
+			if err = run(chunk[0], chunk[1]); err != nil {
+				break
+			}
+		}
+	}
+	if spec != nil {
+		for err == nil {
+			i, ok := spec.candidate()
+			if !ok {
+				break
+			}
+			err = spec.run(i, f)
+		}
+		if err != nil {
+			spec.fail()
+		}
 	}
 	this.rctx.Barrier()
 	this.rctx.loop = false
-	return nil
+	return err
+}
+
+/*Fraction of the iterations that must be finished before the running ones are duplicated*/
+const speculationQuantile = 0.75
+
+type iSpeculation struct {
+	mutex    sync.Mutex
+	cond     *sync.Cond
+	total    int
+	finished int
+	failed   bool
+	running  map[int]*iSpeculativeTask
+}
+
+type iSpeculativeTask struct {
+	started time.Time
+	copies  int
+	done    chan struct{}
+	err     error
+}
+
+type iSpeculativeResult struct {
+	commit func() error
+	err    error
+}
+
+func newISpeculation(total int) *iSpeculation {
+	spec := &iSpeculation{
+		total:   total,
+		running: map[int]*iSpeculativeTask{},
+	}
+	spec.cond = sync.NewCond(&spec.mutex)
+	return spec
+}
+
+/*Runs a copy of the iteration in its own goroutine, so the thread can leave it when another copy finishes first*/
+func (this *iSpeculation) run(i int, f func(i int) (func() error, error)) error {
+	this.mutex.Lock()
+	task, ok := this.running[i]
+	if !ok {
+		task = &iSpeculativeTask{started: time.Now(), done: make(chan struct{})}
+		this.running[i] = task
+	}
+	task.copies++
+	this.mutex.Unlock()
+
+	result := make(chan iSpeculativeResult, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				if err, ok := r.(error); ok {
+					result <- iSpeculativeResult{err: err}
+				} else {
+					result <- iSpeculativeResult{err: errors.New(fmt.Sprint(r))}
+				}
+			}
+		}()
+		commit, err := f(i)
+		result <- iSpeculativeResult{commit, err}
+	}()
+	select {
+	case r := <-result:
+		this.finish(i, task, r)
+	case <-task.done:
+	}
+	return task.err
+}
+
+/*The first copy that finishes publishes its output, the output of a later copy is discarded*/
+func (this *iSpeculation) finish(i int, task *iSpeculativeTask, result iSpeculativeResult) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if this.running[i] != task {
+		return
+	}
+	delete(this.running, i)
+	task.err = result.err
+	if task.err == nil && result.commit != nil {
+		task.err = result.commit()
+	}
+	close(task.done)
+	this.finished++
+	this.cond.Broadcast()
+}
+
+func (this *iSpeculation) fail() {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.failed = true
+	this.cond.Broadcast()
+}
+
+/*Waits until most iterations are finished and returns the oldest running iteration that has not been duplicated yet*/
+func (this *iSpeculation) candidate() (int, bool) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	threshold := int(math.Ceil(speculationQuantile * float64(this.total)))
+	for !this.failed && this.finished < threshold {
+		this.cond.Wait()
+	}
+	if this.failed {
+		return 0, false
+	}
+	best := -1
+	for i, task := range this.running {
+		if task.copies == 1 && (best < 0 || task.started.Before(this.running[best].started)) {
+			best = i
+		}
+	}
+	return best, best >= 0
 }
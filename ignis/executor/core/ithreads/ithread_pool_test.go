@@ -0,0 +1,107 @@
+package ithreads
+
+import (
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func scheduleTest(t *testing.T, n int, schedule func(IForBuilder) IForBuilder) []int32 {
+	owners := make([]int32, n)
+	runs := make([]int32, n)
+	require.Nil(t, ParallelT(4, func(rctx IRuntimeContext) error {
+		return schedule(rctx.For()).Run(n, func(i int) error {
+			atomic.AddInt32(&runs[i], 1)
+			atomic.StoreInt32(&owners[i], int32(rctx.ThreadId()))
+			return nil
+		})
+	}))
+	for i := 0; i < n; i++ {
+		require.Equal(t, int32(1), runs[i], "iteration %d", i)
+	}
+	return owners
+}
+
+func TestSchedules(t *testing.T) {
+	schedules := map[string]func(IForBuilder) IForBuilder{
+		"Static":   func(b IForBuilder) IForBuilder { return b.Static() },
+		"Dynamic":  func(b IForBuilder) IForBuilder { return b.Dynamic() },
+		"Guided":   func(b IForBuilder) IForBuilder { return b.Guided() },
+		"Stealing": func(b IForBuilder) IForBuilder { return b.Stealing() },
+		"Weighted": func(b IForBuilder) IForBuilder { return b.Weighted([]int64{5, 1, 1, 9}) },
+		"Chunk":    func(b IForBuilder) IForBuilder { return b.Guided().Chunk(3) },
+	}
+	for name, schedule := range schedules {
+		t.Run(name, func(t *testing.T) {
+			for _, n := range []int{0, 1, 3, 100} {
+				scheduleTest(t, n, schedule)
+			}
+		})
+	}
+}
+
+func TestWeightedSchedule(t *testing.T) {
+	weights := []int64{100, 1, 1, 1, 1, 1, 50, 50}
+	owners := scheduleTest(t, len(weights), func(b IForBuilder) IForBuilder { return b.Weighted(weights) })
+	// the heaviest iteration is alone in its thread and the assignment is the same in every run
+	for i := 1; i < len(weights); i++ {
+		require.NotEqual(t, owners[0], owners[i])
+	}
+	for r := 0; r < 5; r++ {
+		require.Equal(t, owners, scheduleTest(t, len(weights), func(b IForBuilder) IForBuilder {
+			return b.Weighted(weights)
+		}))
+	}
+}
+
+func TestStealing(t *testing.T) {
+	n := 64
+	var done, waited int32
+	require.Nil(t, ParallelT(4, func(rctx IRuntimeContext) error {
+		return rctx.For().Stealing().Run(n, func(i int) error {
+			if i == 0 {
+				// the other iterations of the slow thread must be stolen while it waits
+				deadline := time.Now().Add(5 * time.Second)
+				for atomic.LoadInt32(&done) < int32(n-1) && time.Now().Before(deadline) {
+					time.Sleep(time.Millisecond)
+				}
+				atomic.StoreInt32(&waited, atomic.LoadInt32(&done))
+			} else {
+				atomic.AddInt32(&done, 1)
+			}
+			return nil
+		})
+	}))
+	require.Equal(t, int32(n-1), atomic.LoadInt32(&waited))
+}
+
+func TestSpeculative(t *testing.T) {
+	n := 16
+	outputs := make([]int, n)
+	commits := make([]int32, n)
+	var slow int32
+	require.Nil(t, ParallelT(4, func(rctx IRuntimeContext) error {
+		return rctx.For().Dynamic().Speculative(true).RunPrivate(n, func(i int) (func() error, error) {
+			private := i * i
+			if i == n-1 && atomic.AddInt32(&slow, 1) == 1 {
+				// only the first copy is a straggler, the duplicate finishes first
+				time.Sleep(200 * time.Millisecond)
+				private = -1
+			}
+			return func() error {
+				atomic.AddInt32(&commits[i], 1)
+				outputs[i] = private
+				return nil
+			}, nil
+		})
+	}))
+	for i := 0; i < n; i++ {
+		require.Equal(t, int32(1), atomic.LoadInt32(&commits[i]))
+		require.Equal(t, i*i, outputs[i])
+	}
+	require.Equal(t, int32(2), atomic.LoadInt32(&slow))
+	time.Sleep(300 * time.Millisecond)
+	require.Equal(t, int32(1), atomic.LoadInt32(&commits[n-1]))
+	require.Equal(t, (n-1)*(n-1), outputs[n-1])
+}
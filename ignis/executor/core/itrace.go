@@ -0,0 +1,147 @@
+package core
+
+import (
+	"encoding/json"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const traceRecordsMax = 10000
+
+/*Module call of the execution trace, bytes are the sum of the bytes of the partitions*/
+type ITraceRecord struct {
+	Module        string    `json:"module"`
+	Executor      int       `json:"executor"`
+	Start         time.Time `json:"start"`
+	Seconds       float64   `json:"seconds"`
+	TypeIn        string    `json:"typeIn,omitempty"`
+	TypeOut       string    `json:"typeOut,omitempty"`
+	PartitionsIn  int       `json:"partitionsIn"`
+	PartitionsOut int       `json:"partitionsOut"`
+	BytesIn       int64     `json:"bytesIn"`
+	BytesOut      int64     `json:"bytesOut"`
+	BytesSent     int64     `json:"bytesSent"`
+	BytesReceived int64     `json:"bytesReceived"`
+	Error         string    `json:"error,omitempty"`
+}
+
+/*
+Records every module call of the job. The last records are kept in memory for the driver and, when the trace is
+enabled, every record is also appended as a json line to a file of the executor in the trace folder of the job.
+*/
+type ITrace struct {
+	mu           sync.Mutex
+	executorData *IExecutorData
+	current      ITraceRecord
+	sent         atomic.Int64
+	received     atomic.Int64
+	records      []ITraceRecord
+	file         *os.File
+}
+
+func partitionsInfo(group storage.IPartitionGroupBase) (string, int, int64) {
+	if group == nil {
+		return "", 0, 0
+	}
+	bytes := int64(0)
+	for i := 0; i < group.Size(); i++ {
+		bytes += group.GetBase(i).Bytes()
+	}
+	return group.Type().String(), group.Size(), bytes
+}
+
+func (this *ITrace) Begin(module string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.current = ITraceRecord{Module: module, Executor: this.executorData.context.ExecutorId(), Start: time.Now()}
+	this.current.TypeIn, this.current.PartitionsIn, this.current.BytesIn = partitionsInfo(this.executorData.partitions)
+	this.sent.Store(0)
+	this.received.Store(0)
+}
+
+/*Bytes exchanged with other executors by the running module call*/
+func (this *ITrace) Transfer(send bool, bytes int64) {
+	if send {
+		this.sent.Add(bytes)
+	} else {
+		this.received.Add(bytes)
+	}
+}
+
+/*Error returned by the running module call to the driver*/
+func (this *ITrace) Failed(message string) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	this.current.Error = message
+}
+
+func (this *ITrace) End(err error) {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	record := this.current
+	record.Seconds = time.Since(record.Start).Seconds()
+	record.TypeOut, record.PartitionsOut, record.BytesOut = partitionsInfo(this.executorData.partitions)
+	record.BytesSent = this.sent.Load()
+	record.BytesReceived = this.received.Load()
+	if err != nil && record.Error == "" {
+		record.Error = err.Error()
+	}
+	if len(this.records) == traceRecordsMax {
+		this.records = append(this.records[:0], this.records[1:]...)
+	}
+	this.records = append(this.records, record)
+	if err := this.write(&record); err != nil {
+		logger.Warn("Trace: record can not be written, ", err)
+	}
+}
+
+func (this *ITrace) write(record *ITraceRecord) error {
+	if enabled, err := this.executorData.properties.Trace(); err != nil || !enabled {
+		return err
+	}
+	if this.file == nil {
+		dir, err := this.executorData.properties.JobDirectory()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		dir = filepath.Join(dir, "trace")
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			return ierror.Raise(err)
+		}
+		path := filepath.Join(dir, "executor"+strconv.Itoa(record.Executor)+".json")
+		if this.file, err = os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	_, err = this.file.Write(append(line, '\n'))
+	return ierror.Raise(err)
+}
+
+/*Module calls of the job, the oldest are discarded when there are too many*/
+func (this *ITrace) Records() []ITraceRecord {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	return append([]ITraceRecord(nil), this.records...)
+}
+
+func (this *ITrace) Close() error {
+	this.mu.Lock()
+	defer this.mu.Unlock()
+	if this.file == nil {
+		return nil
+	}
+	err := this.file.Close()
+	this.file = nil
+	return err
+}
@@ -0,0 +1,75 @@
+package itransport
+
+import (
+	"bufio"
+	"io"
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+/*Buffers are pooled in power of two size classes, larger buffers are allocated and released to the gc*/
+const (
+	bufferPoolMinClass = 10
+	bufferPoolMaxClass = 26
+)
+
+var bufferPool [bufferPoolMaxClass - bufferPoolMinClass + 1]sync.Pool
+var bufferPoolHits, bufferPoolMisses atomic.Int64
+
+func bufferClass(size int) int {
+	if size <= 1<<bufferPoolMinClass {
+		return 0
+	}
+	return bits.Len(uint(size-1)) - bufferPoolMinClass
+}
+
+/*Returns a buffer of len size, its content is undefined*/
+func GetBuffer(size int) []byte {
+	class := bufferClass(size)
+	if class >= len(bufferPool) {
+		bufferPoolMisses.Add(1)
+		return make([]byte, size)
+	}
+	if buf, ok := bufferPool[class].Get().(*[]byte); ok {
+		bufferPoolHits.Add(1)
+		return (*buf)[:size]
+	}
+	bufferPoolMisses.Add(1)
+	return make([]byte, size, 1<<(class+bufferPoolMinClass))
+}
+
+/*The buffer must not be used after it is released*/
+func PutBuffer(buf []byte) {
+	class := bufferClass(cap(buf))
+	if class >= len(bufferPool) || cap(buf) != 1<<(class+bufferPoolMinClass) {
+		return
+	}
+	buf = buf[:0]
+	bufferPool[class].Put(&buf)
+}
+
+const writerPoolSize = 1 << 16
+
+var writerPool sync.Pool
+
+/*Buffered writer for file output, it must be flushed before PutWriter*/
+func GetWriter(w io.Writer) *bufio.Writer {
+	if writer, ok := writerPool.Get().(*bufio.Writer); ok {
+		bufferPoolHits.Add(1)
+		writer.Reset(w)
+		return writer
+	}
+	bufferPoolMisses.Add(1)
+	return bufio.NewWriterSize(w, writerPoolSize)
+}
+
+func PutWriter(writer *bufio.Writer) {
+	writer.Reset(nil)
+	writerPool.Put(writer)
+}
+
+/*Number of buffers served from the pool and buffers that had to be allocated*/
+func BufferPoolStats() (int64, int64) {
+	return bufferPoolHits.Load(), bufferPoolMisses.Load()
+}
@@ -0,0 +1,43 @@
+package itransport
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBufferPool(t *testing.T) {
+	buf := GetBuffer(3000)
+	require.Equal(t, 3000, len(buf))
+	require.Equal(t, 4096, cap(buf))
+	PutBuffer(buf)
+
+	hits, _ := BufferPoolStats()
+	again := GetBuffer(2049)
+	require.Equal(t, 4096, cap(again))
+	after, _ := BufferPoolStats()
+	require.GreaterOrEqual(t, after, hits)
+
+	large := GetBuffer(1<<bufferPoolMaxClass + 1)
+	require.Equal(t, 1<<bufferPoolMaxClass+1, cap(large))
+	PutBuffer(large)
+	PutBuffer(make([]byte, 1000))
+	require.Equal(t, 1024, cap(GetBuffer(10)))
+}
+
+func TestPooledMemoryBuffer(t *testing.T) {
+	buffer := NewIPooledMemoryBuffer(16)
+	data := make([]byte, 5000)
+	for i := range data {
+		data[i] = byte(i)
+	}
+	_, err := buffer.Write(data)
+	require.Nil(t, err)
+	require.Equal(t, data, buffer.Bytes())
+	out := make([]byte, len(data))
+	_, err = buffer.Read(out)
+	require.Nil(t, err)
+	require.Equal(t, data, out)
+	buffer.Release()
+	require.Nil(t, buffer.GetBuffer())
+	buffer.Release()
+}
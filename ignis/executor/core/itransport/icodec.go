@@ -0,0 +1,194 @@
+package itransport
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+var ErrCorrupted = errors.New("icodec: corrupted data")
+
+type ICodec interface {
+	Name() string
+	Compress(dst []byte, src []byte) ([]byte, error)
+	Decompress(dst []byte, src []byte) error
+}
+
+func NewICodec(name string, level int) (ICodec, error) {
+	switch name {
+	case "zlib":
+		return &iZlibCodec{level}, nil
+	case "lz4":
+		return &iLz4Codec{}, nil
+	}
+	return nil, errors.New("icodec: codec " + name + " is not available")
+}
+
+type iZlibCodec struct {
+	level int
+}
+
+func (this *iZlibCodec) Name() string {
+	return "zlib"
+}
+
+func (this *iZlibCodec) Compress(dst []byte, src []byte) ([]byte, error) {
+	buffer := bytes.NewBuffer(dst)
+	writer, err := zlib.NewWriterLevel(buffer, this.level)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = writer.Write(src); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func (this *iZlibCodec) Decompress(dst []byte, src []byte) error {
+	reader, err := zlib.NewReader(bytes.NewReader(src))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	if _, err = io.ReadFull(reader, dst); err != nil {
+		return ErrCorrupted
+	}
+	return nil
+}
+
+const lz4MinMatch = 4
+const lz4HashLog = 16
+const lz4MaxOffset = 65535
+const lz4LastLiterals = 5
+const lz4MatchLimit = 12
+
+type iLz4Codec struct {
+}
+
+func (this *iLz4Codec) Name() string {
+	return "lz4"
+}
+
+func lz4Length(dst []byte, n int) []byte {
+	for ; n >= 255; n -= 255 {
+		dst = append(dst, 255)
+	}
+	return append(dst, byte(n))
+}
+
+func lz4Sequence(dst []byte, literals []byte, offset int, match int) []byte {
+	token := byte(0)
+	if len(literals) >= 15 {
+		token = 15 << 4
+	} else {
+		token = byte(len(literals)) << 4
+	}
+	if offset > 0 {
+		if match-lz4MinMatch >= 15 {
+			token |= 15
+		} else {
+			token |= byte(match - lz4MinMatch)
+		}
+	}
+	dst = append(dst, token)
+	if len(literals) >= 15 {
+		dst = lz4Length(dst, len(literals)-15)
+	}
+	dst = append(dst, literals...)
+	if offset > 0 {
+		dst = binary.LittleEndian.AppendUint16(dst, uint16(offset))
+		if match-lz4MinMatch >= 15 {
+			dst = lz4Length(dst, match-lz4MinMatch-15)
+		}
+	}
+	return dst
+}
+
+func (this *iLz4Codec) Compress(dst []byte, src []byte) ([]byte, error) {
+	var table [1 << lz4HashLog]int32
+	hash := func(i int) uint32 {
+		return (binary.LittleEndian.Uint32(src[i:]) * 2654435761) >> (32 - lz4HashLog)
+	}
+	anchor := 0
+	limit := len(src) - lz4MatchLimit
+	for i := 0; i < limit; {
+		h := hash(i)
+		ref := int(table[h]) - 1
+		table[h] = int32(i + 1)
+		if ref < 0 || i-ref > lz4MaxOffset || binary.LittleEndian.Uint32(src[ref:]) != binary.LittleEndian.Uint32(src[i:]) {
+			i++
+			continue
+		}
+		match := lz4MinMatch
+		for i+match < len(src)-lz4LastLiterals && src[ref+match] == src[i+match] {
+			match++
+		}
+		dst = lz4Sequence(dst, src[anchor:i], i-ref, match)
+		i += match
+		anchor = i
+	}
+	return lz4Sequence(dst, src[anchor:], 0, 0), nil
+}
+
+func (this *iLz4Codec) Decompress(dst []byte, src []byte) error {
+	readLength := func(i int, n int) (int, int, error) {
+		if n < 15 {
+			return i, n, nil
+		}
+		for {
+			if i >= len(src) {
+				return 0, 0, ErrCorrupted
+			}
+			b := src[i]
+			i++
+			n += int(b)
+			if b != 255 {
+				return i, n, nil
+			}
+		}
+	}
+	i, o := 0, 0
+	for i < len(src) {
+		token := src[i]
+		i++
+		var literals int
+		var err error
+		if i, literals, err = readLength(i, int(token>>4)); err != nil {
+			return err
+		}
+		if i+literals > len(src) || o+literals > len(dst) {
+			return ErrCorrupted
+		}
+		o += copy(dst[o:], src[i:i+literals])
+		i += literals
+		if i == len(src) {
+			break
+		}
+		if i+2 > len(src) {
+			return ErrCorrupted
+		}
+		offset := int(binary.LittleEndian.Uint16(src[i:]))
+		i += 2
+		var match int
+		if i, match, err = readLength(i, int(token&15)); err != nil {
+			return err
+		}
+		match += lz4MinMatch
+		if offset == 0 || offset > o || o+match > len(dst) {
+			return ErrCorrupted
+		}
+		for j := 0; j < match; j++ {
+			dst[o+j] = dst[o-offset+j]
+		}
+		o += match
+	}
+	if o != len(dst) {
+		return ErrCorrupted
+	}
+	return nil
+}
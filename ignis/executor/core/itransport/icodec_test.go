@@ -0,0 +1,35 @@
+package itransport
+
+import (
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+func TestICodec(t *testing.T) {
+	inputs := [][]byte{{}, []byte("a"), []byte("abcabcabcabcabcabcabcabcabcabcabcabc")}
+	random := make([]byte, 100000)
+	rand.Read(random)
+	inputs = append(inputs, random)
+	repeated := make([]byte, 300000)
+	for i := range repeated {
+		repeated[i] = byte(i % 251 / 7)
+	}
+	inputs = append(inputs, repeated)
+
+	for _, name := range []string{"zlib", "lz4"} {
+		codec, err := NewICodec(name, 6)
+		require.Nil(t, err)
+		for _, input := range inputs {
+			compressed, err := codec.Compress(nil, input)
+			require.Nil(t, err)
+			output := make([]byte, len(input))
+			require.Nil(t, codec.Decompress(output, compressed))
+			require.Equal(t, input, output, name)
+		}
+		compressed, _ := codec.Compress(nil, repeated)
+		require.Less(t, len(compressed), len(repeated)/4, name)
+	}
+	_, err := NewICodec("zstd", 1)
+	require.NotNil(t, err)
+}
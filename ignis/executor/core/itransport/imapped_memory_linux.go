@@ -0,0 +1,31 @@
+package itransport
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/utils"
+	"syscall"
+)
+
+func mapMemory(sz int64) ([]byte, error) {
+	buf, err := syscall.Mmap(-1, 0, int(utils.Max(sz, 1)), syscall.PROT_READ|syscall.PROT_WRITE,
+		syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return nil, ierror.RaiseMsgCause("memory can not be mapped", err)
+	}
+	return buf[:sz], nil
+}
+
+func unmapMemory(buf []byte) error {
+	return ierror.Raise(syscall.Munmap(buf[:cap(buf)]))
+}
+
+func adviseMemory(buf []byte, sequential bool) error {
+	if len(buf) == 0 {
+		return nil
+	}
+	advice := syscall.MADV_NORMAL
+	if sequential {
+		advice = syscall.MADV_SEQUENTIAL
+	}
+	return ierror.Raise(syscall.Madvise(buf, advice))
+}
@@ -0,0 +1,17 @@
+//go:build !linux
+
+package itransport
+
+import "ignis/executor/core/ierror"
+
+func mapMemory(sz int64) ([]byte, error) {
+	return nil, ierror.RaiseMsg("mapped memory is only supported on linux")
+}
+
+func unmapMemory(buf []byte) error {
+	return ierror.RaiseMsg("mapped memory is only supported on linux")
+}
+
+func adviseMemory(buf []byte, sequential bool) error {
+	return nil
+}
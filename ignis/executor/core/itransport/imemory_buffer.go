@@ -4,6 +4,7 @@ import (
 	"context"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/utils"
+	"runtime"
 )
 
 type MemoryPolicy int
@@ -22,6 +23,9 @@ type IMemoryBuffer struct {
 	owner         bool
 	rBase         int64
 	wBase         int64
+	pooled        bool
+	mapped        bool
+	parent        *IMemoryBuffer
 }
 
 func NewIMemoryBuffer() *IMemoryBuffer {
@@ -45,6 +49,67 @@ func NewIMemoryBufferWrapper(buf []byte, sz int64, policy MemoryPolicy) *IMemory
 	return this
 }
 
+/*The buffer is taken from the pool and returned with Release*/
+func NewIPooledMemoryBuffer(sz int64) *IMemoryBuffer {
+	buf := GetBuffer(int(sz))
+	this := &IMemoryBuffer{}
+	this.initCommon(buf[:cap(buf)], int64(cap(buf)), true, 0)
+	this.pooled = true
+	return this
+}
+
+/*
+The buffer is allocated outside the Go heap with mmap and unmapped with Release or when it is collected. Views of
+the buffer must be created with Observe to keep it alive.
+*/
+func NewIMappedMemoryBuffer(sz int64) (*IMemoryBuffer, error) {
+	buf, err := mapMemory(sz)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	this := &IMemoryBuffer{}
+	this.initCommon(buf, sz, true, 0)
+	this.mapped = true
+	runtime.SetFinalizer(this, func(buffer *IMemoryBuffer) {
+		buffer.Release()
+	})
+	return this, nil
+}
+
+func (this *IMemoryBuffer) Release() {
+	if this.mapped {
+		_ = unmapMemory(this.buffer)
+		this.mapped = false
+	} else if this.pooled {
+		PutBuffer(this.buffer)
+		this.pooled = false
+	} else {
+		return
+	}
+	this.buffer = nil
+	this.rBase = 0
+	this.wBase = 0
+}
+
+func (this *IMemoryBuffer) Mapped() bool {
+	return this.mapped
+}
+
+/*Read only view of the written bytes that keeps the buffer alive*/
+func (this *IMemoryBuffer) Observe() *IMemoryBuffer {
+	view := NewIMemoryBufferWrapper(this.buffer, this.wBase, OBSERVE)
+	view.parent = this
+	return view
+}
+
+/*Tells the kernel that a mapped buffer will be read sequentially, it has no effect on other buffers*/
+func (this *IMemoryBuffer) Advise(sequential bool) error {
+	if !this.mapped {
+		return nil
+	}
+	return adviseMemory(this.buffer, sequential)
+}
+
 func (this *IMemoryBuffer) initCommon(buf []byte, size int64, owner bool, wPos int64) {
 	this.maxBufferSize = int64((^uint(0)) >> 1)
 
@@ -172,8 +237,25 @@ func (this *IMemoryBuffer) SetBufferSize(newSize int64) error {
 	if newSize > this.maxBufferSize {
 		return ierror.RaiseMsg("resize more than Maximum buffer size")
 	}
-	newBuffer := make([]byte, int(newSize), int(newSize))
-	copy(newBuffer, this.buffer)
+	var newBuffer []byte
+	if this.mapped {
+		var err error
+		if newBuffer, err = mapMemory(newSize); err != nil {
+			return ierror.Raise(err)
+		}
+		copy(newBuffer, this.buffer)
+		if err = unmapMemory(this.buffer); err != nil {
+			return ierror.Raise(err)
+		}
+	} else if this.pooled {
+		newBuffer = GetBuffer(int(newSize))
+		newBuffer = newBuffer[:cap(newBuffer)]
+		copy(newBuffer, this.buffer)
+		PutBuffer(this.buffer)
+	} else {
+		newBuffer = make([]byte, int(newSize), int(newSize))
+		copy(newBuffer, this.buffer)
+	}
 	this.buffer = newBuffer
 	this.rBase = utils.Min(this.rBase, newSize)
 	this.wBase = utils.Min(this.wBase, newSize)
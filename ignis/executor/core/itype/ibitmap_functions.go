@@ -0,0 +1,36 @@
+package itype
+
+import (
+	"ignis/executor/api"
+	"ignis/executor/api/base"
+	"ignis/executor/api/function"
+	"ignis/executor/api/ibitmap"
+)
+
+type IBitmapUnion struct {
+	base.IReduce[ibitmap.IBitmap]
+	base.ITreeReduce[ibitmap.IBitmap]
+	function.IOnlyCall
+}
+
+func (this *IBitmapUnion) Types() []api.IContextType {
+	return []api.IContextType{base.NewTypeA[ibitmap.IBitmap]()}
+}
+
+func (this *IBitmapUnion) Call(v1 ibitmap.IBitmap, v2 ibitmap.IBitmap, context api.IContext) (ibitmap.IBitmap, error) {
+	return *v1.Or(&v2), nil
+}
+
+type IBitmapIntersection struct {
+	base.IReduce[ibitmap.IBitmap]
+	base.ITreeReduce[ibitmap.IBitmap]
+	function.IOnlyCall
+}
+
+func (this *IBitmapIntersection) Types() []api.IContextType {
+	return []api.IContextType{base.NewTypeA[ibitmap.IBitmap]()}
+}
+
+func (this *IBitmapIntersection) Call(v1 ibitmap.IBitmap, v2 ibitmap.IBitmap, context api.IContext) (ibitmap.IBitmap, error) {
+	return *v1.And(&v2), nil
+}
@@ -0,0 +1,65 @@
+package itype
+
+import (
+	"ignis/executor/api"
+	"ignis/executor/api/base"
+	"ignis/executor/api/function"
+	"ignis/executor/api/idecimal"
+	"math/big"
+)
+
+type IDecimalSum struct {
+	base.IReduce[idecimal.IDecimal]
+	base.ITreeReduce[idecimal.IDecimal]
+	function.IOnlyCall
+}
+
+func (this *IDecimalSum) Types() []api.IContextType {
+	return []api.IContextType{base.NewTypeA[idecimal.IDecimal]()}
+}
+
+func (this *IDecimalSum) Call(v1 idecimal.IDecimal, v2 idecimal.IDecimal, context api.IContext) (idecimal.IDecimal, error) {
+	return v1.Add(v2)
+}
+
+type IBigIntSum struct {
+	base.IReduce[*big.Int]
+	base.ITreeReduce[*big.Int]
+	function.IOnlyCall
+}
+
+func (this *IBigIntSum) Types() []api.IContextType {
+	return []api.IContextType{base.NewTypeA[*big.Int]()}
+}
+
+func (this *IBigIntSum) Call(v1 *big.Int, v2 *big.Int, context api.IContext) (*big.Int, error) {
+	return new(big.Int).Add(v1, v2), nil
+}
+
+type IBigRatSum struct {
+	base.IReduce[*big.Rat]
+	base.ITreeReduce[*big.Rat]
+	function.IOnlyCall
+}
+
+func (this *IBigRatSum) Types() []api.IContextType {
+	return []api.IContextType{base.NewTypeA[*big.Rat]()}
+}
+
+func (this *IBigRatSum) Call(v1 *big.Rat, v2 *big.Rat, context api.IContext) (*big.Rat, error) {
+	return new(big.Rat).Add(v1, v2), nil
+}
+
+type IBigFloatSum struct {
+	base.IReduce[*big.Float]
+	base.ITreeReduce[*big.Float]
+	function.IOnlyCall
+}
+
+func (this *IBigFloatSum) Types() []api.IContextType {
+	return []api.IContextType{base.NewTypeA[*big.Float]()}
+}
+
+func (this *IBigFloatSum) Call(v1 *big.Float, v2 *big.Float, context api.IContext) (*big.Float, error) {
+	return new(big.Float).SetPrec(v1.Prec()).Add(v1, v2), nil
+}
@@ -0,0 +1,55 @@
+package itype
+
+import (
+	"ignis/executor/api"
+	"ignis/executor/api/base"
+	"ignis/executor/api/function"
+	"ignis/executor/api/itime"
+	"ignis/executor/core/ierror"
+	"time"
+)
+
+type ITimeZone struct {
+	base.IMap[time.Time, time.Time]
+	function.IAfterNone
+	loc *time.Location
+}
+
+func (this *ITimeZone) Before(context api.IContext) error {
+	zone, ok := context.Vars()["zone"].(string)
+	if !ok {
+		return ierror.RaiseMsg("ITimeZone requires a zone parameter")
+	}
+	loc, err := itime.LoadLocation(zone)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.loc = loc
+	return nil
+}
+
+func (this *ITimeZone) Call(v time.Time, context api.IContext) (time.Time, error) {
+	return v.In(this.loc), nil
+}
+
+type ITimeTruncate struct {
+	base.IMap[time.Time, time.Time]
+	function.IAfterNone
+	unit string
+}
+
+func (this *ITimeTruncate) Before(context api.IContext) error {
+	unit, ok := context.Vars()["unit"].(string)
+	if !ok {
+		return ierror.RaiseMsg("ITimeTruncate requires a unit parameter")
+	}
+	if _, err := itime.Truncate(time.Time{}, unit); err != nil {
+		return ierror.Raise(err)
+	}
+	this.unit = unit
+	return nil
+}
+
+func (this *ITimeTruncate) Call(v time.Time, context api.IContext) (time.Time, error) {
+	return itime.Truncate(v, this.unit)
+}
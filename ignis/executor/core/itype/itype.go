@@ -4,6 +4,9 @@ import (
 	"ignis/executor/api"
 	"ignis/executor/api/base"
 	"ignis/executor/api/function"
+	"ignis/executor/api/idecimal"
+	"ignis/executor/api/iuuid"
+	"time"
 )
 
 func DefaultTypes() []api.IContextType {
@@ -22,6 +25,9 @@ func DefaultTypes() []api.IContextType {
 		base.NewTypeC[float32](),
 		base.NewTypeC[float64](),
 		base.NewTypeC[string](),
+		base.NewTypeC[idecimal.IDecimal](),
+		base.NewTypeC[time.Time](),
+		base.NewTypeC[iuuid.IUUID](),
 		//
 		base.NewTypeCC[int64, bool](),
 		base.NewTypeCC[int64, int64](),
@@ -35,9 +41,22 @@ func DefaultTypes() []api.IContextType {
 		base.NewTypeCC[string, int64](),
 		base.NewTypeCC[string, float64](),
 		base.NewTypeCC[string, string](),
+		base.NewTypeCC[int64, idecimal.IDecimal](),
+		base.NewTypeCC[string, idecimal.IDecimal](),
+		base.NewTypeCC[iuuid.IUUID, int64](),
+		base.NewTypeCC[iuuid.IUUID, string](),
 	}
 }
 
 func DefaultFunctions() []function.IBaseFunction {
-	return []function.IBaseFunction{}
+	return []function.IBaseFunction{
+		&IBitmapUnion{},
+		&IBitmapIntersection{},
+		&IDecimalSum{},
+		&IBigIntSum{},
+		&IBigRatSum{},
+		&IBigFloatSum{},
+		&ITimeZone{},
+		&ITimeTruncate{},
+	}
 }
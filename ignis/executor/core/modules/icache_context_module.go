@@ -3,6 +3,7 @@ package modules
 import (
 	"context"
 	"ignis/executor/core"
+	"ignis/executor/core/ierror"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/modules/impl"
 )
@@ -38,9 +39,26 @@ func NewICacheContextModule(executorData *core.IExecutorData) *ICacheContextModu
 		}
 	}
 
+	//restore the last checkpoint when the executor has been restarted
+	if err = this.restoreCheckpoint(); err != nil {
+		logger.Error(err)
+	}
+
 	return this
 }
 
+func (this *ICacheContextModule) restoreCheckpoint() error {
+	checkpoint, err := this.impl.LoadCheckpoint()
+	if err != nil || checkpoint == nil {
+		return err
+	}
+	tp, err := this.TypeFromName(checkpoint.Type)
+	if err != nil {
+		return err
+	}
+	return tp.RestoreCheckpoint(this.impl, checkpoint)
+}
+
 func (this *ICacheContextModule) SaveContext(ctx context.Context) (_r int64, _err error) {
 	defer this.moduleRecover(&_err)
 	_r, _err = this.impl.SaveContext()
@@ -68,7 +86,39 @@ func (this *ICacheContextModule) Cache(ctx context.Context, id int64, level int8
 	return this.PackError(this.impl.Cache(id, level))
 }
 
+func (this *ICacheContextModule) Unpersist(ctx context.Context, id int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.PackError(this.impl.Unpersist(id))
+}
+
 func (this *ICacheContextModule) LoadCache(ctx context.Context, id int64) (_err error) {
 	defer this.moduleRecover(&_err)
 	return this.PackError(this.impl.LoadCache(id))
 }
+
+func (this *ICacheContextModule) LoadReplica(ctx context.Context, id int64, executor int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.PackError(this.impl.LoadReplica(id, int(executor)))
+}
+
+func (this *ICacheContextModule) Checkpoint(ctx context.Context) (_r int64, _err error) {
+	defer this.moduleRecover(&_err)
+	_r, _err = this.impl.Checkpoint()
+	_err = this.PackError(_err)
+	return
+}
+
+func (this *ICacheContextModule) RestoreCheckpoint(ctx context.Context) (_err error) {
+	defer this.moduleRecover(&_err)
+	checkpoint, err := this.impl.LoadCheckpoint()
+	if err != nil {
+		return this.PackError(err)
+	} else if checkpoint == nil {
+		return this.PackError(ierror.RaiseMsg("checkpoint not found"))
+	}
+	tp, err := this.TypeFromName(checkpoint.Type)
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(tp.RestoreCheckpoint(this.impl, checkpoint))
+}
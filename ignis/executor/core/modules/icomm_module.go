@@ -58,6 +58,23 @@ func (this *ICommModule) DestroyGroups(ctx context.Context) (_err error) {
 	return this.PackError(this.impl.DestroyGroups())
 }
 
+func (this *ICommModule) Broadcast(ctx context.Context, name string, value []byte) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.PackError(this.impl.Broadcast(name, value))
+}
+
+func (this *ICommModule) RemoveBroadcast(ctx context.Context, name string) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.PackError(this.impl.RemoveBroadcast(name))
+}
+
+func (this *ICommModule) ReduceAccumulators(ctx context.Context) (_r map[string][]byte, _err error) {
+	defer this.moduleRecover(&_err)
+	_r, _err = this.impl.ReduceAccumulators()
+	_err = this.PackError(_err)
+	return
+}
+
 func (this *ICommModule) GetProtocol(ctx context.Context) (_r int8, _err error) {
 	defer this.moduleRecover(&_err)
 	_r, _err = this.impl.GetProtocol()
@@ -0,0 +1,82 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/modules/impl"
+)
+
+type IDiagnosticModule struct {
+	IModule
+	estimateImpl *impl.IEstimateImpl
+	selfTestImpl *impl.ISelfTestImpl
+}
+
+func NewIDiagnosticModule(executorData *core.IExecutorData) *IDiagnosticModule {
+	return &IDiagnosticModule{
+		IModule{executorData},
+		impl.NewIEstimateImpl(executorData),
+		impl.NewISelfTestImpl(executorData),
+	}
+}
+
+/*Results of the diagnostic operations are returned as json*/
+func (this *IDiagnosticModule) toJson(value any) (string, error) {
+	result, err := json.Marshal(value)
+	if err != nil {
+		return "", this.PackError(ierror.Raise(err))
+	}
+	return string(result), nil
+}
+
+func (this *IDiagnosticModule) Estimate(ctx context.Context, operation string, numPartitions int64, other string, n int64) (_r string, _err error) {
+	defer this.moduleRecover(&_err)
+	var keys []int64
+	if impl.KeyedEstimation(operation) {
+		base, err := this.TypeFromPartition()
+		if err != nil {
+			return "", this.PackError(err)
+		}
+		if keys, err = base.KeyCardinality(this.estimateImpl, other, operation == "countByValue"); err != nil {
+			return "", this.PackError(err)
+		}
+	}
+	estimation, err := this.estimateImpl.Estimate(operation, numPartitions, other, n, keys)
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	return this.toJson(estimation)
+}
+
+func (this *IDiagnosticModule) SelfTest(ctx context.Context) (_r string, _err error) {
+	defer this.moduleRecover(&_err)
+	report, err := this.selfTestImpl.SelfTest()
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	return this.toJson(report)
+}
+
+type iExchangeStep struct {
+	Partition int64 `json:"partition"`
+	Executor  int64 `json:"executor"`
+}
+
+/*Partition and target executor of every gather of a synchronous exchange, in the order they are run*/
+func (this *IDiagnosticModule) ExchangeSchedule(ctx context.Context, numPartitions int64) (_r string, _err error) {
+	defer this.moduleRecover(&_err)
+	schedule := this.estimateImpl.ExchangeSchedule(numPartitions)
+	steps := make([]iExchangeStep, len(schedule))
+	for i, step := range schedule {
+		steps[i] = iExchangeStep{step.First, step.Second}
+	}
+	return this.toJson(steps)
+}
+
+/*Module calls of the job recorded by this executor*/
+func (this *IDiagnosticModule) Trace(ctx context.Context) (_r string, _err error) {
+	defer this.moduleRecover(&_err)
+	return this.toJson(this.executorData.Trace().Records())
+}
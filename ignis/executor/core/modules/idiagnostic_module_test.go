@@ -0,0 +1,152 @@
+package modules
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"ignis/executor/api/base"
+	"ignis/executor/api/ipair"
+	"ignis/executor/core"
+	"ignis/executor/core/modules/impl"
+	"os"
+	"runtime"
+	"testing"
+)
+
+type IDiagnosticModuleTest struct {
+	diagnostic   *IDiagnosticModule
+	executorData *core.IExecutorData
+}
+
+var diagnosticModuleTest *IDiagnosticModuleTest
+
+func init() {
+	executorData := core.NewIExecutorData()
+	diagnostic := NewIDiagnosticModule(executorData)
+	diagnosticModuleTest = &IDiagnosticModuleTest{diagnostic, executorData}
+
+	sepUpDefault(executorData)
+	props := executorData.GetContext().Props()
+	props["ignis.partition.type"] = "Memory"
+}
+
+func TestEstimateTake(t *testing.T) {
+	estimateTakeTest(diagnosticModuleTest, t, 2)
+}
+
+func TestEstimateCountByKey(t *testing.T) {
+	estimateCountByKeyTest(diagnosticModuleTest, t, 2)
+}
+
+func TestEstimateJoin(t *testing.T) {
+	estimateJoinTest(diagnosticModuleTest, t, 2, 100)
+}
+
+func TestEstimateCrossJoin(t *testing.T) {
+	estimateJoinTest(diagnosticModuleTest, t, 2, 1)
+}
+
+func TestSelfTest(t *testing.T) {
+	selfTestReportTest(diagnosticModuleTest, t, 2)
+}
+
+/* Implementations */
+func estimate(this *IDiagnosticModuleTest, t *testing.T, operation string, other string, n int64) impl.IEstimation {
+	result, err := this.diagnostic.Estimate(context.Background(), operation, 0, other, n)
+	require.Nil(t, err)
+	var estimation impl.IEstimation
+	require.Nil(t, json.Unmarshal([]byte(result), &estimation))
+	return estimation
+}
+
+func estimateTakeTest(this *IDiagnosticModuleTest, t *testing.T, cores int) {
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := (&IElemensInt{}).create(100*cores*2*np, 0)
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+
+	estimation := estimate(this, t, "take", "", 5)
+	require.Equal(t, int64(len(elems)), estimation.Elements)
+	require.Equal(t, int64(5), estimation.OutputElements)
+	require.Equal(t, int64(1), estimation.OutputPartitions)
+
+	estimation = estimate(this, t, "take", "", int64(10*len(elems)))
+	require.Equal(t, int64(len(elems)), estimation.OutputElements)
+}
+
+func estimateCountByKeyTest(this *IDiagnosticModuleTest, t *testing.T, cores int) {
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := make([]ipair.IPair[int64, int64], 100*cores*2*np)
+	for i := range elems {
+		elems[i] = *ipair.New(int64(i%10), int64(i))
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	this.executorData.RegisterType(base.NewTypeCC[int64, int64]())
+
+	require.InDelta(t, 10, estimate(this, t, "countByKey", "", 0).OutputElements, 1)
+	require.InDelta(t, len(elems), estimate(this, t, "countByValue", "", 0).OutputElements, float64(len(elems))*0.1)
+}
+
+/*Keys are taken from keys distinct values, with a single key the join is a cartesian product*/
+func estimateJoinTest(this *IDiagnosticModuleTest, t *testing.T, cores int, keys int) {
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := make([]ipair.IPair[int64, int64], 100*cores*2*np)
+	for i := range elems {
+		elems[i] = *ipair.New(int64(i%keys), int64(i))
+	}
+	this.executorData.RegisterType(base.NewTypeCC[int64, int64]())
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	core.SetVariable(this.executorData, "other", this.executorData.GetPartitionsAny())
+	defer this.executorData.RemoveVariable("other")
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+
+	estimation := estimate(this, t, "join", "other", 0)
+	n := float64(len(elems))
+	require.InDelta(t, n*n/float64(keys), estimation.OutputElements, n*n/float64(keys)*0.1)
+	if keys == 1 {
+		require.Equal(t, 1, len(estimation.Warnings))
+	} else {
+		require.Empty(t, estimation.Warnings)
+	}
+}
+
+func selfTestReportTest(this *IDiagnosticModuleTest, t *testing.T, cores int) {
+	this.executorData.SetCores(cores)
+	result, err := this.diagnostic.SelfTest(context.Background())
+	require.Nil(t, err)
+	var report impl.ISelfTestReport
+	require.Nil(t, json.Unmarshal([]byte(result), &report))
+
+	mpi := this.executorData.Mpi()
+	require.Equal(t, mpi.Rank(), report.Rank)
+	require.Equal(t, mpi.Executors(), report.Executors)
+	require.Equal(t, cores, report.Cores)
+	require.Equal(t, runtime.Version(), report.GoVersion)
+	require.Empty(t, report.Errors)
+
+	if mpi.IsRoot(0) {
+		require.Equal(t, report.Executors-1, len(report.Peers))
+		for i, peer := range report.Peers {
+			require.Equal(t, i+1, peer.Rank)
+			require.Positive(t, peer.Latency)
+			require.Positive(t, peer.Bandwidth)
+		}
+	} else {
+		require.Empty(t, report.Peers)
+	}
+
+	require.NotEmpty(t, report.DiskPath)
+	_, err = os.Stat(report.DiskPath)
+	require.True(t, os.IsNotExist(err), "self test file must be removed")
+	require.Positive(t, report.DiskWrite)
+	require.Positive(t, report.DiskRead)
+	require.Equal(t, runtime.GOOS != "linux", report.DiskReadCached)
+
+	require.Positive(t, report.MemoryTotal)
+	require.Positive(t, report.MemoryAvailable)
+	require.LessOrEqual(t, report.MemoryAvailable, report.MemoryTotal)
+	require.Positive(t, report.HeapInUse)
+	require.Equal(t, len(this.executorData.LoadedLibraries()), len(report.Libraries))
+}
@@ -68,6 +68,12 @@ func (this *IExecutorServerModule) Start(ctx context.Context, properties map[str
 	}
 
 	this.executorData.SetCores(int(cores))
+	if err = this.executorData.EnableAffinity(); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = this.executorData.GetPartitionTools().Spill().Cleanup(); err != nil {
+		return ierror.Raise(err)
+	}
 	for key, value := range env {
 		if err = os.Setenv(key, value); err != nil {
 			return ierror.Raise(err)
@@ -85,14 +91,52 @@ func (this *IExecutorServerModule) Start(ctx context.Context, properties map[str
 		return err
 	}
 	this.services(this.processor)
+	metrics := this.executorData.Metrics()
+	for name, function := range this.processor.ProcessorMap() {
+		this.processor.AddToProcessorMap(name, &iTimedProcessorFunction{name, function, metrics, this.executorData.Lineage(),
+			this.executorData.Cancellation(), this.executorData.Trace()})
+	}
+	port, err := this.executorData.GetProperties().MetricsPort()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if port > 0 {
+		if err = metrics.Serve(fmt.Sprintf(":%d", int(port)+this.executorData.Mpi().Rank())); err != nil {
+			return ierror.Raise(err)
+		}
+	}
 	logger.Info("ServerModule: go executor ready")
 	return nil
 }
 
+type iTimedProcessorFunction struct {
+	name     string
+	function thrift.TProcessorFunction
+	metrics  *core.IMetrics
+	lineage  *core.ILineage
+	cancel   *core.ICancelToken
+	trace    *core.ITrace
+}
+
+func (this *iTimedProcessorFunction) Process(ctx context.Context, seqId int32, in, out thrift.TProtocol) (_ok bool, _err thrift.TException) {
+	start := time.Now()
+	this.cancel.Reset()
+	this.lineage.Begin(this.name)
+	this.trace.Begin(this.name)
+	defer func() {
+		this.metrics.ModuleTime(this.name, time.Since(start))
+		this.trace.End(_err)
+	}()
+	return this.function.Process(ctx, seqId, in, out)
+}
+
 func (this *IExecutorServerModule) Stop(ctx context.Context) (_err error) {
 	server := this.server
 	this.processor = nil
 	this.server = nil
+	_ = this.executorData.Metrics().Close()
+	_ = this.executorData.Trace().Close()
+	this.executorData.Heartbeat().Stop()
 	var flag impi.C_int
 	err := impi.MPI_Initialized(&flag)
 	if flag != 0 && err == nil {
@@ -105,6 +149,12 @@ func (this *IExecutorServerModule) Stop(ctx context.Context) (_err error) {
 	return nil
 }
 
+/*Aborts the running job, it stops at the next partition, loop chunk or MPI operation with a Cancelled error*/
+func (this *IExecutorServerModule) Cancel(ctx context.Context, reason string) (_err error) {
+	this.executorData.Cancellation().Cancel(reason)
+	return nil
+}
+
 func (this *IExecutorServerModule) Test(ctx context.Context) (_r bool, _err error) {
 	return true, nil
 }
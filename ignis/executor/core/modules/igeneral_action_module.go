@@ -16,6 +16,7 @@ type IGeneralActionModule struct {
 	pipeImpl   *impl.IPipeImpl
 	sortImpl   *impl.ISortImpl
 	reduceImpl *impl.IReduceImpl
+	mathImpl   *impl.IMathImpl
 }
 
 func NewIGeneralActionModule(executorData *core.IExecutorData) *IGeneralActionModule {
@@ -24,6 +25,7 @@ func NewIGeneralActionModule(executorData *core.IExecutorData) *IGeneralActionMo
 		impl.NewIPipeImpl(executorData),
 		impl.NewISortImpl(executorData),
 		impl.NewIReduceImpl(executorData),
+		impl.NewIMathImpl(executorData),
 	}
 }
 
@@ -67,6 +69,25 @@ func (this *IGeneralActionModule) TreeReduce(ctx context.Context, src *rpc.ISour
 	return this.CompatibilityError(reflect.TypeOf(basefun), "treeReduce")
 }
 
+/*Reduces the values of every key and leaves the whole result in the executor 0 to be returned to the driver*/
+func (this *IGeneralActionModule) ReduceByKeyLocally(ctx context.Context, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	basefun, err := this.executorData.LoadLibrary(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := basefun.(base.IReduceByKeyLocallyAbs); ok {
+		return this.PackError(fun.RunReduceByKeyLocally(this.reduceImpl, basefun))
+	} else if anyfun, ok := basefun.(function.IFunction2[any, any, any]); ok {
+		base, err := this.TypeFromPartition()
+		if err != nil {
+			return this.PackError(err)
+		}
+		return this.PackError(base.ReduceByKeyLocally(this.reduceImpl, anyfun))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "reduceByKeyLocally")
+}
+
 func (this *IGeneralActionModule) Collect(ctx context.Context) (_err error) {
 	//Do nothing
 	return nil
@@ -93,9 +114,12 @@ func (this *IGeneralActionModule) Aggregate(ctx context.Context, zero *rpc.ISour
 		return this.PackError(err)
 	}
 	if fun, ok := seqfun.(base.IAggregateAbs); ok {
-		err = fun.RunAggregate(this.reduceImpl, zerofun)
+		err = fun.RunAggregate(this.reduceImpl, seqfun)
 	} else if anyfun, ok := seqfun.(function.IFunction2[any, any, any]); ok {
-		err = impl.Aggregate(this.reduceImpl, anyfun)
+		var tp base.ITypeFunctions
+		if tp, err = this.TypeFromPartition(); err == nil {
+			err = tp.Aggregate(this.reduceImpl, anyfun)
+		}
 	} else {
 		return this.CompatibilityError(reflect.TypeOf(seqfun), "aggregate")
 	}
@@ -107,7 +131,7 @@ func (this *IGeneralActionModule) Aggregate(ctx context.Context, zero *rpc.ISour
 		return this.PackError(err)
 	}
 	if fun, ok := combfun.(base.IReduceAbs); ok {
-		err = fun.RunReduce(this.reduceImpl, zerofun)
+		err = fun.RunReduce(this.reduceImpl, combfun)
 	} else if anyfun, ok := combfun.(function.IFunction2[any, any, any]); ok {
 		err = impl.Reduce(this.reduceImpl, anyfun)
 	} else {
@@ -140,9 +164,12 @@ func (this *IGeneralActionModule) TreeAggregate(ctx context.Context, zero *rpc.I
 		return this.PackError(err)
 	}
 	if fun, ok := seqfun.(base.ITreeAggregateAbs); ok {
-		err = fun.RunTreeAggregate(this.reduceImpl, zerofun)
+		err = fun.RunTreeAggregate(this.reduceImpl, seqfun)
 	} else if anyfun, ok := seqfun.(function.IFunction2[any, any, any]); ok {
-		err = impl.TreeAggregate(this.reduceImpl, anyfun)
+		var tp base.ITypeFunctions
+		if tp, err = this.TypeFromPartition(); err == nil {
+			err = tp.Aggregate(this.reduceImpl, anyfun)
+		}
 	} else {
 		return this.CompatibilityError(reflect.TypeOf(seqfun), "treeAggregate")
 	}
@@ -154,7 +181,7 @@ func (this *IGeneralActionModule) TreeAggregate(ctx context.Context, zero *rpc.I
 		return this.PackError(err)
 	}
 	if fun, ok := combfun.(base.ITreeReduceAbs); ok {
-		err = fun.RunTreeReduce(this.reduceImpl, zerofun)
+		err = fun.RunTreeReduce(this.reduceImpl, combfun)
 	} else if anyfun, ok := combfun.(function.IFunction2[any, any, any]); ok {
 		err = impl.TreeReduce(this.reduceImpl, anyfun)
 	} else {
@@ -187,9 +214,12 @@ func (this *IGeneralActionModule) Fold(ctx context.Context, zero *rpc.ISource, s
 		return this.PackError(err)
 	}
 	if fun, ok := seqfun.(base.IFoldAbs); ok {
-		err = fun.RunFold(this.reduceImpl, zerofun)
+		err = fun.RunFold(this.reduceImpl, seqfun)
 	} else if anyfun, ok := seqfun.(function.IFunction2[any, any, any]); ok {
-		err = impl.Fold(this.reduceImpl, anyfun)
+		var tp base.ITypeFunctions
+		if tp, err = this.TypeFromPartition(); err == nil {
+			err = tp.Fold(this.reduceImpl, anyfun, false)
+		}
 	} else {
 		return this.CompatibilityError(reflect.TypeOf(seqfun), "fold")
 	}
@@ -220,9 +250,12 @@ func (this *IGeneralActionModule) TreeFold(ctx context.Context, zero *rpc.ISourc
 		return this.PackError(err)
 	}
 	if fun, ok := seqfun.(base.ITreeFoldAbs); ok {
-		err = fun.RunTreeFold(this.reduceImpl, zerofun)
+		err = fun.RunTreeFold(this.reduceImpl, seqfun)
 	} else if anyfun, ok := seqfun.(function.IFunction2[any, any, any]); ok {
-		err = impl.TreeFold(this.reduceImpl, anyfun)
+		var tp base.ITypeFunctions
+		if tp, err = this.TypeFromPartition(); err == nil {
+			err = tp.Fold(this.reduceImpl, anyfun, true)
+		}
 	} else {
 		return this.CompatibilityError(reflect.TypeOf(seqfun), "treeFold")
 	}
@@ -288,6 +321,13 @@ func (this *IGeneralActionModule) Top(ctx context.Context, num int64) (_err erro
 	if err != nil {
 		return this.PackError(err)
 	}
+	heap, err := this.executorData.GetProperties().MathTopHeap()
+	if err != nil {
+		return this.PackError(err)
+	}
+	if num <= heap {
+		return this.PackError(base.TopN(this.mathImpl, num))
+	}
 	return this.PackError(base.Top(this.sortImpl, num))
 }
 
@@ -311,6 +351,13 @@ func (this *IGeneralActionModule) TakeOrdered(ctx context.Context, num int64) (_
 	if err != nil {
 		return this.PackError(err)
 	}
+	heap, err := this.executorData.GetProperties().MathTopHeap()
+	if err != nil {
+		return this.PackError(err)
+	}
+	if num <= heap {
+		return this.PackError(base.TakeOrderedN(this.mathImpl, num))
+	}
 	return this.PackError(base.TakeOrdered(this.sortImpl, num))
 }
 
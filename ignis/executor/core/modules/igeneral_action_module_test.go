@@ -0,0 +1,129 @@
+package modules
+
+import (
+	"github.com/stretchr/testify/require"
+	"ignis/executor/api"
+	"ignis/executor/api/base"
+	"ignis/executor/api/function"
+	"ignis/executor/core"
+	"testing"
+)
+
+type IGeneralActionModuleTest struct {
+	action       *IGeneralActionModule
+	executorData *core.IExecutorData
+}
+
+var generalActionModuleTest *IGeneralActionModuleTest
+
+func init() {
+	executorData := core.NewIExecutorData()
+	action := NewIGeneralActionModule(executorData)
+	generalActionModuleTest = &IGeneralActionModuleTest{action, executorData}
+
+	sepUpDefault(executorData)
+}
+
+type ZeroSlice struct {
+	function.IOnlyCall
+	base.IZero[[]int64]
+}
+
+func (this *ZeroSlice) Call(ctx api.IContext) ([]int64, error) {
+	return make([]int64, 0, 1000), nil
+}
+
+type FoldSlice struct {
+	function.IOnlyCall
+	base.IFold[[]int64]
+}
+
+func (this *FoldSlice) Call(v1 []int64, v2 []int64, ctx api.IContext) ([]int64, error) {
+	return append(v1, v2...), nil
+}
+
+func TestFoldSlice(t *testing.T) {
+	generalActionModuleTest.executorData.RegisterFunction(&ZeroSlice{})
+	generalActionModuleTest.executorData.RegisterFunction(&FoldSlice{})
+	foldTest(generalActionModuleTest, t, "ZeroSlice", "FoldSlice", 2, "Memory")
+}
+
+type ZeroCounts struct {
+	function.IOnlyCall
+	base.IZero[map[int64]int64]
+}
+
+func (this *ZeroCounts) Call(ctx api.IContext) (map[int64]int64, error) {
+	return map[int64]int64{}, nil
+}
+
+type AggregateCounts struct {
+	function.IOnlyCall
+	base.IAggregate[map[int64]int64, int64]
+}
+
+func (this *AggregateCounts) Call(v1 map[int64]int64, v2 int64, ctx api.IContext) (map[int64]int64, error) {
+	v1[v2]++
+	return v1, nil
+}
+
+type ReduceCounts struct {
+	function.IOnlyCall
+	base.IReduce[map[int64]int64]
+}
+
+func (this *ReduceCounts) Call(v1 map[int64]int64, v2 map[int64]int64, ctx api.IContext) (map[int64]int64, error) {
+	for k, v := range v2 {
+		v1[k] += v
+	}
+	return v1, nil
+}
+
+func TestAggregateCounts(t *testing.T) {
+	generalActionModuleTest.executorData.RegisterFunction(&ZeroCounts{})
+	generalActionModuleTest.executorData.RegisterFunction(&AggregateCounts{})
+	generalActionModuleTest.executorData.RegisterFunction(&ReduceCounts{})
+	aggregateTest(generalActionModuleTest, t, "ZeroCounts", "AggregateCounts", "ReduceCounts", 2, "Memory")
+}
+
+/* Implementations */
+func foldTest(this *IGeneralActionModuleTest, t *testing.T, zero string, name string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := make([][]int64, 100*cores*2*np)
+	expected := make([]int64, len(elems))
+	for i := range elems {
+		elems[i] = []int64{int64(i)}
+		expected[i] = int64(i)
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+
+	require.Nil(t, this.action.Fold(nil, newSource(zero), newSource(name)))
+	result := getFromPartitions[[]int64](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, 1, len(result))
+		require.Equal(t, expected, result[0])
+	}
+}
+
+func aggregateTest(this *IGeneralActionModuleTest, t *testing.T, zero string, seqOp string, combOp string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := (&IElemensInt{}).create(100*cores*2*np, 0)
+	expected := make(map[int64]int64)
+	for _, elem := range elems {
+		expected[elem]++
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+
+	require.Nil(t, this.action.Aggregate(nil, newSource(zero), newSource(seqOp), newSource(combOp)))
+	result := getFromPartitions[map[int64]int64](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, 1, len(result))
+		require.Equal(t, expected, result[0])
+	}
+}
@@ -45,58 +45,66 @@ func (this *IGeneralModule) ExecuteTo(ctx context.Context, src *rpc.ISource) (_e
 
 func (this *IGeneralModule) Map_(ctx context.Context, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
-	if err != nil {
-		return this.PackError(err)
-	}
-	if fun, ok := basefun.(base.IMapAbs); ok {
-		return this.PackError(fun.RunMap(this.pipeImpl, basefun))
-	} else if anyfun, ok := basefun.(function.IFunction[any, any]); ok {
-		return this.PackError(impl.Map(this.pipeImpl, anyfun))
-	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "map")
+	return this.cachedResult("map", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IMapAbs); ok {
+			return this.PackError(fun.RunMap(this.pipeImpl, basefun))
+		} else if anyfun, ok := basefun.(function.IFunction[any, any]); ok {
+			return this.PackError(impl.Map(this.pipeImpl, anyfun))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "map")
+	}, src)
 }
 
 func (this *IGeneralModule) Filter(ctx context.Context, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
-	if err != nil {
-		return this.PackError(err)
-	}
-	if fun, ok := basefun.(base.IFilterAbs); ok {
-		return this.PackError(fun.RunFilter(this.pipeImpl, basefun))
-	} else if anyfun, ok := basefun.(function.IFunction[any, bool]); ok {
-		return this.PackError(impl.Filter(this.pipeImpl, anyfun))
-	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "filter")
+	return this.cachedResult("filter", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IFilterAbs); ok {
+			return this.PackError(fun.RunFilter(this.pipeImpl, basefun))
+		} else if anyfun, ok := basefun.(function.IFunction[any, bool]); ok {
+			return this.PackError(impl.Filter(this.pipeImpl, anyfun))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "filter")
+	}, src)
 }
 
 func (this *IGeneralModule) Flatmap(ctx context.Context, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
-	if err != nil {
-		return this.PackError(err)
-	}
-	if fun, ok := basefun.(base.IFlatmapAbs); ok {
-		return this.PackError(fun.RunFlatmap(this.pipeImpl, basefun))
-	} else if anyfun, ok := basefun.(function.IFunction[any, []any]); ok {
-		return this.PackError(impl.Flatmap(this.pipeImpl, anyfun))
-	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "flatmap")
+	return this.cachedResult("flatmap", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IFlatmapAbs); ok {
+			return this.PackError(fun.RunFlatmap(this.pipeImpl, basefun))
+		} else if anyfun, ok := basefun.(function.IFunction[any, []any]); ok {
+			return this.PackError(impl.Flatmap(this.pipeImpl, anyfun))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "flatmap")
+	}, src)
 }
 
 func (this *IGeneralModule) KeyBy(ctx context.Context, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
-	if err != nil {
-		return this.PackError(err)
-	}
-	if fun, ok := basefun.(base.IKeyByAbs); ok {
-		return this.PackError(fun.RunKeyBy(this.pipeImpl, basefun))
-	} else if anyfun, ok := basefun.(function.IFunction[any, any]); ok {
-		return this.PackError(impl.KeyBy(this.pipeImpl, anyfun))
-	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "keyBy")
+	return this.cachedResult("keyBy", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IKeyByAbs); ok {
+			return this.PackError(fun.RunKeyBy(this.pipeImpl, basefun))
+		} else if anyfun, ok := basefun.(function.IFunction[any, any]); ok {
+			return this.PackError(impl.KeyBy(this.pipeImpl, anyfun))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "keyBy")
+	}, src)
 }
 
 func (this *IGeneralModule) MapWithIndex(ctx context.Context, src *rpc.ISource) (_err error) {
@@ -115,16 +123,22 @@ func (this *IGeneralModule) MapWithIndex(ctx context.Context, src *rpc.ISource)
 
 func (this *IGeneralModule) MapPartitions(ctx context.Context, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
-	if err != nil {
-		return this.PackError(err)
-	}
-	if fun, ok := basefun.(base.IMapPartitionsAbs); ok {
-		return this.PackError(fun.RunMapPartitions(this.pipeImpl, basefun))
-	} else if anyfun, ok := basefun.(function.IFunction[iterator.IReadIterator[any], []any]); ok {
-		return this.PackError(impl.MapPartitions(this.pipeImpl, anyfun))
-	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "mapPartitions")
+	return this.cachedResult("mapPartitions", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IMapPartitionsAbs); ok {
+			return this.PackError(fun.RunMapPartitions(this.pipeImpl, basefun))
+		} else if fun, ok := basefun.(base.IMapPartitionsStreamAbs); ok {
+			return this.PackError(fun.RunMapPartitionsStream(this.pipeImpl, basefun))
+		} else if anyfun, ok := basefun.(function.IFunction[iterator.IReadIterator[any], []any]); ok {
+			return this.PackError(impl.MapPartitions(this.pipeImpl, anyfun))
+		} else if anyfun, ok := basefun.(function.IVoidFunction2[iterator.IReadIterator[any], iterator.IWriteIterator[any]]); ok {
+			return this.PackError(impl.MapPartitionsStream(this.pipeImpl, anyfun))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "mapPartitions")
+	}, src)
 }
 
 func (this *IGeneralModule) MapPartitionsWithIndex(ctx context.Context, src *rpc.ISource) (_err error) {
@@ -141,6 +155,21 @@ func (this *IGeneralModule) MapPartitionsWithIndex(ctx context.Context, src *rpc
 	return this.CompatibilityError(reflect.TypeOf(basefun), "mapPartitionsWithIndex")
 }
 
+/*Like mapPartitions but src also receives up to k elements of the neighbor partitions*/
+func (this *IGeneralModule) MapPartitionsWithBoundary(ctx context.Context, src *rpc.ISource, k int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	basefun, err := this.executorData.LoadLibrary(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := basefun.(base.IMapPartitionsWithBoundaryAbs); ok {
+		return this.PackError(fun.RunMapPartitionsWithBoundary(this.pipeImpl, basefun, k))
+	} else if anyfun, ok := basefun.(function.IFunction2[impl.IBoundary[any], iterator.IReadIterator[any], []any]); ok {
+		return this.PackError(impl.MapPartitionsWithBoundary(this.pipeImpl, k, anyfun))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "mapPartitionsWithBoundary")
+}
+
 func (this *IGeneralModule) MapExecutor(ctx context.Context, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
 	basefun, err := this.executorData.LoadLibrary(src)
@@ -193,68 +222,164 @@ func (this *IGeneralModule) GroupBy(ctx context.Context, src *rpc.ISource, numPa
 }
 
 func (this *IGeneralModule) Sort(ctx context.Context, ascending bool) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("sort", func() error {
+		base, err := this.TypeFromPartition()
+		if err != nil {
+			return this.PackError(err)
+		}
+		return this.PackError(base.Sort(this.sortImpl, ascending))
+	}, ascending)
+}
+
+func (this *IGeneralModule) Sort2(ctx context.Context, ascending bool, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("sort", func() error {
+		base, err := this.TypeFromPartition()
+		if err != nil {
+			return this.PackError(err)
+		}
+		return this.PackError(base.SortWithPartitions(this.sortImpl, ascending, numPartitions))
+	}, ascending, numPartitions)
+}
+
+func (this *IGeneralModule) SortBy(ctx context.Context, src *rpc.ISource, ascending bool) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("sortBy", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.ISortByAbs); ok {
+			return this.PackError(fun.RunSortBy(this.sortImpl, basefun, ascending))
+		} else if anyfun, ok := basefun.(function.IFunction2[any, any, bool]); ok {
+			return this.PackError(impl.SortBy(this.sortImpl, anyfun, ascending))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "sortBy")
+	}, src, ascending)
+}
+
+func (this *IGeneralModule) SortBy3(ctx context.Context, src *rpc.ISource, ascending bool, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("sortBy", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.ISortByAbs); ok {
+			return this.PackError(fun.RunSortByWithPartitions(this.sortImpl, basefun, ascending, numPartitions))
+		} else if anyfun, ok := basefun.(function.IFunction2[any, any, bool]); ok {
+			return this.PackError(impl.SortByWithPartitions(this.sortImpl, anyfun, ascending, numPartitions))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "sortBy")
+	}, src, ascending, numPartitions)
+}
+
+func (this *IGeneralModule) Union_(ctx context.Context, other string, preserveOrder bool) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
 	if err != nil {
 		return this.PackError(err)
 	}
-	return this.PackError(base.Sort(this.sortImpl, ascending))
+	return this.PackError(base.Union(this.reduceImpl, other, preserveOrder))
 }
 
-func (this *IGeneralModule) Sort2(ctx context.Context, ascending bool, numPartitions int64) (_err error) {
+func (this *IGeneralModule) Union2(ctx context.Context, other string, preserveOrder bool, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromSource(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Union(this.reduceImpl, other, preserveOrder))
+}
+
+func (this *IGeneralModule) UnionAll(ctx context.Context, others []string, rebalance bool) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
 	if err != nil {
 		return this.PackError(err)
 	}
-	return this.PackError(base.SortWithPartitions(this.sortImpl, ascending, numPartitions))
+	return this.PackError(base.UnionAll(this.pipeImpl, others, rebalance))
 }
 
-func (this *IGeneralModule) SortBy(ctx context.Context, src *rpc.ISource, ascending bool) (_err error) {
+/*Pairs every element with the element at the same position of other, src is the type of the resulting pairs*/
+func (this *IGeneralModule) Zip(ctx context.Context, other string, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
+	base, err := this.TypeFromSource(src)
 	if err != nil {
 		return this.PackError(err)
 	}
-	if fun, ok := basefun.(base.ISortByAbs); ok {
-		return this.PackError(fun.RunSortBy(this.sortImpl, basefun, ascending))
-	} else if anyfun, ok := basefun.(function.IFunction2[any, any, bool]); ok {
-		return this.PackError(impl.SortBy(this.sortImpl, anyfun, ascending))
+	return this.PackError(base.Zip(this.pipeImpl, other))
+}
+
+func (this *IGeneralModule) ZipWithIndex(ctx context.Context) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
 	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "sortBy")
+	return this.PackError(base.ZipWithIndex(this.pipeImpl))
 }
 
-func (this *IGeneralModule) SortBy3(ctx context.Context, src *rpc.ISource, ascending bool, numPartitions int64) (_err error) {
+/*Streams every partition through an external command, encoding is text or json*/
+func (this *IGeneralModule) PipeCmd(ctx context.Context, command []string, env []string, encoding string) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.PipeCmd(this.pipeImpl, &impl.IPipeCommand{Args: command, Env: env}, encoding))
+}
+
+/*Projects the field paths of every element into a row*/
+func (this *IGeneralModule) Select(ctx context.Context, paths []string) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Select(this.pipeImpl, paths))
+}
+
+/*Projects the field paths of every element into the output type of src*/
+func (this *IGeneralModule) SelectTo(ctx context.Context, src *rpc.ISource, paths []string) (_err error) {
 	defer this.moduleRecover(&_err)
 	basefun, err := this.executorData.LoadLibrary(src)
 	if err != nil {
 		return this.PackError(err)
 	}
-	if fun, ok := basefun.(base.ISortByAbs); ok {
-		return this.PackError(fun.RunSortByWithPartitions(this.sortImpl, basefun, ascending, numPartitions))
-	} else if anyfun, ok := basefun.(function.IFunction2[any, any, bool]); ok {
-		return this.PackError(impl.SortByWithPartitions(this.sortImpl, anyfun, ascending, numPartitions))
+	if fun, ok := basefun.(base.ISelectToAbs); ok {
+		return this.PackError(fun.RunSelectTo(this.pipeImpl, paths))
 	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "sortBy")
+	return this.CompatibilityError(reflect.TypeOf(basefun), "selectTo")
 }
 
-func (this *IGeneralModule) Union_(ctx context.Context, other string, preserveOrder bool) (_err error) {
+/*Emits one pair for every item of the slice at path, src declares the element and item types*/
+func (this *IGeneralModule) Explode(ctx context.Context, src *rpc.ISource, path string) (_err error) {
 	defer this.moduleRecover(&_err)
-	base, err := this.TypeFromPartition()
+	basefun, err := this.executorData.LoadLibrary(src)
 	if err != nil {
 		return this.PackError(err)
 	}
-	return this.PackError(base.Union(this.reduceImpl, other, preserveOrder))
+	if fun, ok := basefun.(base.IExplodeAbs); ok {
+		return this.PackError(fun.RunExplode(this.pipeImpl, path))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "explode")
 }
 
-func (this *IGeneralModule) Union2(ctx context.Context, other string, preserveOrder bool, src *rpc.ISource) (_err error) {
+/*Like explode but the element is replaced by the row of its field paths*/
+func (this *IGeneralModule) ExplodeSelect(ctx context.Context, src *rpc.ISource, path string, paths []string) (_err error) {
 	defer this.moduleRecover(&_err)
-	base, err := this.TypeFromSource(src)
+	basefun, err := this.executorData.LoadLibrary(src)
 	if err != nil {
 		return this.PackError(err)
 	}
-	return this.PackError(base.Union(this.reduceImpl, other, preserveOrder))
+	if fun, ok := basefun.(base.IExplodeAbs); ok {
+		return this.PackError(fun.RunExplodeSelect(this.pipeImpl, path, paths))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "explodeSelect")
 }
+
 func (this *IGeneralModule) Join(ctx context.Context, other string, numPartitions int64) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
@@ -271,13 +396,47 @@ func (this *IGeneralModule) Join3(ctx context.Context, other string, numPartitio
 	}
 	return this.PackError(base.Join(this.reduceImpl, other, numPartitions))
 }
-func (this *IGeneralModule) Distinct(ctx context.Context, numPartitions int64) (_err error) {
+func (this *IGeneralModule) LeftOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
 	if err != nil {
 		return this.PackError(err)
 	}
-	return this.PackError(base.Distinct(this.reduceImpl, numPartitions))
+	return this.PackError(base.LeftOuterJoin(this.reduceImpl, other, numPartitions))
+}
+func (this *IGeneralModule) RightOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.RightOuterJoin(this.reduceImpl, other, numPartitions))
+}
+func (this *IGeneralModule) FullOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.FullOuterJoin(this.reduceImpl, other, numPartitions))
+}
+func (this *IGeneralModule) CoGroup(ctx context.Context, other string, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.CoGroup(this.reduceImpl, other, numPartitions))
+}
+func (this *IGeneralModule) Distinct(ctx context.Context, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("distinct", func() error {
+		base, err := this.TypeFromPartition()
+		if err != nil {
+			return this.PackError(err)
+		}
+		return this.PackError(base.Distinct(this.reduceImpl, numPartitions))
+	}, numPartitions)
 }
 func (this *IGeneralModule) Distinct2(ctx context.Context, numPartitions int64, src *rpc.ISource) (_err error) {
 	defer this.moduleRecover(&_err)
@@ -287,6 +446,30 @@ func (this *IGeneralModule) Distinct2(ctx context.Context, numPartitions int64,
 	}
 	return this.PackError(base.Distinct(this.reduceImpl, numPartitions))
 }
+func (this *IGeneralModule) Intersection(ctx context.Context, other string, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Intersection(this.reduceImpl, other, numPartitions))
+}
+func (this *IGeneralModule) Subtract(ctx context.Context, other string, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Subtract(this.reduceImpl, other, numPartitions))
+}
+func (this *IGeneralModule) SubtractByKey(ctx context.Context, other string, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.SubtractByKey(this.reduceImpl, other, numPartitions))
+}
 func (this *IGeneralModule) Repartition(ctx context.Context, numPartitions int64, preserveOrdering bool, global_ bool) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
@@ -295,6 +478,14 @@ func (this *IGeneralModule) Repartition(ctx context.Context, numPartitions int64
 	}
 	return this.PackError(base.Repartition(this.repartitionImpl, numPartitions, preserveOrdering, global_))
 }
+func (this *IGeneralModule) Coalesce(ctx context.Context, numPartitions int64, shuffle bool) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Coalesce(this.repartitionImpl, numPartitions, shuffle))
+}
 func (this *IGeneralModule) PartitionByRandom(ctx context.Context, numPartitions int64, seed int32) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
@@ -324,42 +515,115 @@ func (this *IGeneralModule) PartitionBy(ctx context.Context, src *rpc.ISource, n
 	}
 	return this.CompatibilityError(reflect.TypeOf(basefun), "partitionBy")
 }
-
-func (this *IGeneralModule) FlatMapValues(ctx context.Context, src *rpc.ISource) (_err error) {
+func (this *IGeneralModule) PartitionByKeyHash(ctx context.Context, numPartitions int64) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
+	base, err := this.TypeFromPartition()
 	if err != nil {
 		return this.PackError(err)
 	}
-	if fun, ok := basefun.(base.IFlatMapValuesAbs); ok {
-		return this.PackError(fun.RunFlatMapValues(this.pipeImpl, basefun))
-	} else if anyfun, ok := basefun.(function.IFunction[any, []any]); ok {
-		return this.PackError(impl.Map(this.pipeImpl, anyfun))
+	return this.PackError(base.PartitionByKeyHash(this.repartitionImpl, numPartitions))
+}
+func (this *IGeneralModule) PartitionByKeyRange(ctx context.Context, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
 	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "flatMapValues")
+	return this.PackError(base.PartitionByKeyRange(this.repartitionImpl, numPartitions))
 }
-
-func (this *IGeneralModule) MapValues(ctx context.Context, src *rpc.ISource) (_err error) {
+func (this *IGeneralModule) PartitionByKey(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error) {
 	defer this.moduleRecover(&_err)
 	basefun, err := this.executorData.LoadLibrary(src)
 	if err != nil {
 		return this.PackError(err)
 	}
-	if fun, ok := basefun.(base.IMapValuesAbs); ok {
-		return this.PackError(fun.RunMapValues(this.pipeImpl, basefun))
-	} else if anyfun, ok := basefun.(function.IFunction[any, any]); ok {
-		return this.PackError(impl.Map(this.pipeImpl, anyfun))
+	if fun, ok := basefun.(base.IPartitionByKeyAbs); ok {
+		name := reflect.TypeOf(basefun).String()
+		if src.Obj.Name != nil {
+			name = *src.Obj.Name
+		}
+		return this.PackError(fun.RunPartitionByKey(this.repartitionImpl, basefun, name, numPartitions))
 	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "mapValues")
+	return this.CompatibilityError(reflect.TypeOf(basefun), "partitionByKey")
 }
 
-func (this *IGeneralModule) GroupByKey(ctx context.Context, numPartitions int64) (_err error) {
+/*order is a permutation of the local partitions of the executor*/
+func (this *IGeneralModule) ReorderPartitions(ctx context.Context, order []int64) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
 	if err != nil {
 		return this.PackError(err)
 	}
-	return this.PackError(base.GroupByKey(this.reduceImpl, numPartitions))
+	return this.PackError(base.ReorderPartitions(this.repartitionImpl, order))
+}
+
+/*Partitions are numbered by the smallest key, or element if they are not pairs, across all executors*/
+func (this *IGeneralModule) ReorderPartitionsBy(ctx context.Context) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.ReorderPartitionsBy(this.repartitionImpl))
+}
+
+/*Global number of the first partition of the executor, used as first index when the partitions are saved*/
+func (this *IGeneralModule) PartitionOffset(ctx context.Context) (_r int64, _err error) {
+	defer this.moduleRecover(&_err)
+	_r, _err = this.repartitionImpl.PartitionOffset()
+	_err = this.PackError(_err)
+	return
+}
+
+func (this *IGeneralModule) FlatMapValues(ctx context.Context, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("flatMapValues", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IFlatMapValuesAbs); ok {
+			return this.PackError(fun.RunFlatMapValues(this.pipeImpl, basefun))
+		} else if anyfun, ok := basefun.(function.IFunction[any, []any]); ok {
+			base, err := this.TypeFromPartition()
+			if err != nil {
+				return this.PackError(err)
+			}
+			return this.PackError(base.FlatMapValues(this.pipeImpl, anyfun))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "flatMapValues")
+	}, src)
+}
+
+func (this *IGeneralModule) MapValues(ctx context.Context, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("mapValues", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IMapValuesAbs); ok {
+			return this.PackError(fun.RunMapValues(this.pipeImpl, basefun))
+		} else if anyfun, ok := basefun.(function.IFunction[any, any]); ok {
+			base, err := this.TypeFromPartition()
+			if err != nil {
+				return this.PackError(err)
+			}
+			return this.PackError(base.MapValues(this.pipeImpl, anyfun))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "mapValues")
+	}, src)
+}
+
+func (this *IGeneralModule) GroupByKey(ctx context.Context, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.cachedResult("groupByKey", func() error {
+		base, err := this.TypeFromPartition()
+		if err != nil {
+			return this.PackError(err)
+		}
+		return this.PackError(base.GroupByKey(this.reduceImpl, numPartitions))
+	}, numPartitions)
 }
 
 func (this *IGeneralModule) GroupByKey2(ctx context.Context, numPartitions int64, src *rpc.ISource) (_err error) {
@@ -373,16 +637,22 @@ func (this *IGeneralModule) GroupByKey2(ctx context.Context, numPartitions int64
 
 func (this *IGeneralModule) ReduceByKey(ctx context.Context, src *rpc.ISource, numPartitions int64, localReduce bool) (_err error) {
 	defer this.moduleRecover(&_err)
-	basefun, err := this.executorData.LoadLibrary(src)
-	if err != nil {
-		return this.PackError(err)
-	}
-	if fun, ok := basefun.(base.IReduceByKeyAbs); ok {
-		return this.PackError(fun.RunReduceByKey(this.reduceImpl, basefun, numPartitions, localReduce))
-	} else if anyfun, ok := basefun.(function.IFunction2[any, any, any]); ok {
-		return this.PackError(impl.ReduceByKey[any](this.reduceImpl, anyfun, numPartitions, localReduce))
-	}
-	return this.CompatibilityError(reflect.TypeOf(basefun), "reduceByKey")
+	return this.cachedResult("reduceByKey", func() error {
+		basefun, err := this.executorData.LoadLibrary(src)
+		if err != nil {
+			return this.PackError(err)
+		}
+		if fun, ok := basefun.(base.IReduceByKeyAbs); ok {
+			return this.PackError(fun.RunReduceByKey(this.reduceImpl, basefun, numPartitions, localReduce))
+		} else if anyfun, ok := basefun.(function.IFunction2[any, any, any]); ok {
+			base, err := this.TypeFromPartition()
+			if err != nil {
+				return this.PackError(err)
+			}
+			return this.PackError(base.ReduceByKey(this.reduceImpl, anyfun, numPartitions, localReduce))
+		}
+		return this.CompatibilityError(reflect.TypeOf(basefun), "reduceByKey")
+	}, src, numPartitions, localReduce)
 }
 
 func (this *IGeneralModule) AggregateByKey(ctx context.Context, zero *rpc.ISource, seqOp *rpc.ISource, numPartitions int64) (_err error) {
@@ -406,7 +676,7 @@ func (this *IGeneralModule) AggregateByKey(ctx context.Context, zero *rpc.ISourc
 		return this.PackError(err)
 	}
 	if fun, ok := seqfun.(base.IAggregateByKeyAbs); ok {
-		err = fun.RunAggregateByKey(this.reduceImpl, zerofun, numPartitions, true)
+		err = fun.RunAggregateByKey(this.reduceImpl, seqfun, numPartitions, true)
 	} else if anyfun, ok := seqfun.(function.IFunction2[any, any, any]); ok {
 		err = impl.AggregateByKey[any](this.reduceImpl, anyfun, numPartitions, true)
 	} else {
@@ -498,6 +768,71 @@ func (this *IGeneralModule) FoldByKey(ctx context.Context, zero *rpc.ISource, sr
 	return nil
 }
 
+/*Turns the distinct values of column into columns of the key rows, returns the columns as json*/
+func (this *IGeneralModule) Pivot(ctx context.Context, key *rpc.ISource, column *rpc.ISource, agg *rpc.ISource, numPartitions int64) (_r string, _err error) {
+	defer this.moduleRecover(&_err)
+	keyfun, err := this.executorData.LoadLibrary(key)
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	columnfun, err := this.executorData.LoadLibrary(column)
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	aggfun, err := this.executorData.LoadLibrary(agg)
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	if fun, ok := aggfun.(base.IPivotAbs); ok {
+		_r, _err = fun.RunPivot(this.reduceImpl, keyfun, columnfun, aggfun, numPartitions)
+		_err = this.PackError(_err)
+		return
+	}
+	return "", this.CompatibilityError(reflect.TypeOf(aggfun), "pivot")
+}
+
+/*Inverse of pivot, columns is the json returned by pivot*/
+func (this *IGeneralModule) Unpivot(ctx context.Context, src *rpc.ISource, columns string) (_err error) {
+	defer this.moduleRecover(&_err)
+	basefun, err := this.executorData.LoadLibrary(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := basefun.(base.IUnpivotAbs); ok {
+		return this.PackError(fun.RunUnpivot(this.reduceImpl, columns))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "unpivot")
+}
+
+/*Inclusive running fold of src in partition order, starting from zero*/
+func (this *IGeneralModule) Scan(ctx context.Context, zero *rpc.ISource, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	zerofun, err := this.executorData.LoadLibrary(zero)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := zerofun.(base.IZeroAbs); ok {
+		err = fun.RunZero(this.reduceImpl, zerofun)
+	} else if anyfun, ok := zerofun.(function.IFunction0[any]); ok {
+		err = impl.Zero(this.reduceImpl, anyfun)
+	} else {
+		return this.CompatibilityError(reflect.TypeOf(zerofun), "scan")
+	}
+	if err != nil {
+		return this.PackError(err)
+	}
+	basefun, err := this.executorData.LoadLibrary(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := basefun.(base.IScanAbs); ok {
+		return this.PackError(fun.RunScan(this.reduceImpl, basefun))
+	} else if anyfun, ok := basefun.(function.IFunction2[any, any, any]); ok {
+		return this.PackError(impl.Scan(this.reduceImpl, anyfun))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "scan")
+}
+
 func (this *IGeneralModule) SortByKey(ctx context.Context, ascending bool) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
@@ -527,6 +862,9 @@ func (this *IGeneralModule) SortByKey2b(ctx context.Context, src *rpc.ISource, a
 	} else if anyfun, ok := basefun.(function.IFunction2[any, any, bool]); ok {
 		return this.PackError(impl.SortByKeyBy[any, any](this.sortImpl, anyfun, ascending))
 	}
+	if tp, err := this.TypeFromPartition(); err == nil {
+		return this.PackError(tp.SortByKeyBy(this.sortImpl, basefun, ascending))
+	}
 	return this.CompatibilityError(reflect.TypeOf(basefun), "sortByKey")
 }
 
@@ -541,5 +879,55 @@ func (this *IGeneralModule) SortByKey3(ctx context.Context, src *rpc.ISource, as
 	} else if anyfun, ok := basefun.(function.IFunction2[any, any, bool]); ok {
 		return this.PackError(impl.SortByKeyByWithPartitions[any, any](this.sortImpl, anyfun, ascending, numPartitions))
 	}
+	if tp, err := this.TypeFromPartition(); err == nil {
+		return this.PackError(tp.SortByKeyByWithPartitions(this.sortImpl, basefun, ascending, numPartitions))
+	}
 	return this.CompatibilityError(reflect.TypeOf(basefun), "sortByKey")
 }
+
+func (this *IGeneralModule) RepartitionAndSortWithinPartitions(ctx context.Context, numPartitions int64, ascending bool) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.RepartitionAndSortWithinPartitions(this.sortImpl, numPartitions, ascending))
+}
+
+func (this *IGeneralModule) GroupByKeyAndSortValues(ctx context.Context, numPartitions int64, ascending bool) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.GroupByKeyAndSortValues(this.sortImpl, numPartitions, ascending))
+}
+
+func (this *IGeneralModule) GroupByKeyAndSortValues3(ctx context.Context, src *rpc.ISource, numPartitions int64, ascending bool) (_err error) {
+	defer this.moduleRecover(&_err)
+	basefun, err := this.executorData.LoadLibrary(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := basefun.(base.IGroupByKeyAndSortValuesAbs); ok {
+		return this.PackError(fun.RunGroupByKeyAndSortValues(this.sortImpl, basefun, numPartitions, ascending))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "groupByKeyAndSortValues")
+}
+
+/*Numbers the runs of every key whose sequence values are at most maxGap apart*/
+func (this *IGeneralModule) GapsAndIslands(ctx context.Context, key *rpc.ISource, seq *rpc.ISource, maxGap int64, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	keyfun, err := this.executorData.LoadLibrary(key)
+	if err != nil {
+		return this.PackError(err)
+	}
+	seqfun, err := this.executorData.LoadLibrary(seq)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := keyfun.(base.IGapsAndIslandsAbs); ok {
+		return this.PackError(fun.RunGapsAndIslands(this.sortImpl, keyfun, seqfun, maxGap, numPartitions))
+	}
+	return this.CompatibilityError(reflect.TypeOf(keyfun), "gapsAndIslands")
+}
@@ -1,17 +1,22 @@
 package modules
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/stretchr/testify/require"
 	"ignis/executor/api"
 	"ignis/executor/api/base"
 	"ignis/executor/api/function"
+	"ignis/executor/api/idecimal"
 	"ignis/executor/api/ipair"
 	"ignis/executor/api/iterator"
 	"ignis/executor/core"
+	"ignis/executor/core/modules/impl"
+	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
 	"reflect"
 	"sort"
+	"sync"
 	"testing"
 )
 
@@ -65,6 +70,44 @@ func TestMapInt(t *testing.T) {
 	mapTest[int64](generalModuleTest, t, "MapInt", 2, "Memory", &IElemensInt{})
 }
 
+type MapHooksInt struct {
+	function.IOnlyCall
+	base.IMap[int64, string]
+	mutex  sync.Mutex
+	before map[int64]int
+	after  map[int64]int
+	fail   bool
+}
+
+func (this *MapHooksInt) BeforePartition(partition int64, ctx api.IContext) error {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.before[partition]++
+	return nil
+}
+
+func (this *MapHooksInt) AfterPartition(partition int64, ctx api.IContext) error {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.after[partition]++
+	return nil
+}
+
+func (this *MapHooksInt) Call(e int64, ctx api.IContext) (string, error) {
+	if this.fail {
+		return "", fmt.Errorf("map of %d failed", e)
+	}
+	return fmt.Sprint(e), nil
+}
+
+func TestMapPartitionHooksInt(t *testing.T) {
+	mapPartitionHooksTest(generalModuleTest, t, false, 2, "Memory")
+}
+
+func TestMapPartitionHooksErrorInt(t *testing.T) {
+	mapPartitionHooksTest(generalModuleTest, t, true, 2, "Memory")
+}
+
 type FilterInt struct {
 	function.IOnlyCall
 	base.IFilter[int64]
@@ -143,6 +186,29 @@ func TestMapPartitionsInt(t *testing.T) {
 	mapPartitionsTest[int64](generalModuleTest, t, "MapPartitionsInt", 2, "Memory", &IElemensInt{})
 }
 
+type MapPartitionsStreamInt struct {
+	function.IOnlyCall
+	base.IMapPartitionsStream[int64, string]
+}
+
+func (this *MapPartitionsStreamInt) Call(it iterator.IReadIterator[int64], out iterator.IWriteIterator[string], ctx api.IContext) error {
+	for it.HasNext() {
+		elem, err := it.Next()
+		if err != nil {
+			return err
+		}
+		if err = out.Write(fmt.Sprint(elem)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestMapPartitionsStreamInt(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&MapPartitionsStreamInt{})
+	mapPartitionsTest[int64](generalModuleTest, t, "MapPartitionsStreamInt", 2, "RawMemory", &IElemensInt{})
+}
+
 type MapPartitionWithIndexInt struct {
 	function.IOnlyCall
 	base.IMapPartitionsWithIndex[int64, string]
@@ -241,6 +307,11 @@ func (this *SortString) Call(a string, b string, ctx api.IContext) (bool, error)
 	return a < b, nil
 }
 
+func TestSortChunkedInt(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&SortInt{})
+	sortChunkedTest(generalModuleTest, t, "SortInt", 2, "Memory")
+}
+
 func TestSortString(t *testing.T) {
 	generalModuleTest.executorData.RegisterFunction(&SortString{})
 	sortTest[string](generalModuleTest, t, "SortString", 2, "Memory", false, &IElemensString{})
@@ -255,10 +326,34 @@ func TestDistinctInt(t *testing.T) {
 	distinctTest[int64](generalModuleTest, t, 2, "Memory", &IElemensInt{})
 }
 
+func TestDistinctDecimal(t *testing.T) {
+	distinctDecimalTest(generalModuleTest, t, 2, "Memory")
+}
+
+func TestGroupByKeyDecimal(t *testing.T) {
+	groupByKeyDecimalTest(generalModuleTest, t, 2, "Memory")
+}
+
 func TestJoinStringInt(t *testing.T) {
 	joinTest[string, int64](generalModuleTest, t, 2, "Memory", &IElemensPair[string, int64]{&IElemensString{}, &IElemensInt{}})
 }
 
+func TestSortMergeJoinStringInt(t *testing.T) {
+	sortMergeJoinTest[string, int64](generalModuleTest, t, 2, "Memory", &IElemensPair[string, int64]{&IElemensString{}, &IElemensInt{}})
+}
+
+func TestBroadcastJoinStringInt(t *testing.T) {
+	broadcastJoinTest[string, int64](generalModuleTest, t, 2, "Memory", &IElemensPair[string, int64]{&IElemensString{}, &IElemensInt{}})
+}
+
+func TestOuterJoinStringInt(t *testing.T) {
+	outerJoinTest[string, int64](generalModuleTest, t, 2, "Memory", &IElemensPair[string, int64]{&IElemensString{}, &IElemensInt{}})
+}
+
+func TestCoGroupStringInt(t *testing.T) {
+	coGroupTest[string](generalModuleTest, t, 2, "Memory", &IElemensPair[string, int64]{&IElemensString{}, &IElemensInt{}})
+}
+
 func TestUnionInt(t *testing.T) {
 	unionTest[int64](generalModuleTest, t, 2, "Memory", true, &IElemensInt{})
 }
@@ -267,6 +362,14 @@ func TestUnionUnorderedString(t *testing.T) {
 	unionTest[int64](generalModuleTest, t, 2, "Memory", false, &IElemensInt{})
 }
 
+func TestZipIntString(t *testing.T) {
+	zipTest[int64, string](generalModuleTest, t, 2, "Memory", &IElemensInt{}, &IElemensString{})
+}
+
+func TestZipWithIndexString(t *testing.T) {
+	zipWithIndexTest[string](generalModuleTest, t, 2, "Memory", &IElemensString{})
+}
+
 type FlatMapValuesInt struct {
 	function.IOnlyCall
 	base.IFlatMapValues[int64, int64, string]
@@ -299,6 +402,14 @@ func TestGroupByKeyIntString(t *testing.T) {
 	groupByKeyTest(generalModuleTest, t, 2, "Memory")
 }
 
+func TestGroupBySerializedKeySliceInt(t *testing.T) {
+	groupBySerializedKeyTest(generalModuleTest, t, 2, "Memory")
+}
+
+func TestDistinctSerializedSliceInt(t *testing.T) {
+	distinctSerializedTest(generalModuleTest, t, 2, "Memory")
+}
+
 type ReduceString struct {
 	function.IOnlyCall
 	base.IReduceByKey[int64, string]
@@ -313,6 +424,23 @@ func TestReduceByKeyIntString(t *testing.T) {
 	reduceByKeyTest[int64, string](generalModuleTest, t, "ReduceString", 2, "Memory", &IElemensPair[int64, string]{&IElemensInt{}, &IElemensString{}})
 }
 
+type ReduceAny struct {
+	function.IOnlyCall
+}
+
+func (this *ReduceAny) Types() []api.IContextType {
+	return nil
+}
+
+func (this *ReduceAny) Call(v1 any, v2 any, ctx api.IContext) (any, error) {
+	return v1.(int64) + v2.(int64), nil
+}
+
+func TestReduceBySerializedKeySliceInt(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&ReduceAny{})
+	reduceBySerializedKeyTest(generalModuleTest, t, "ReduceAny", 2, "Memory")
+}
+
 type ZeroString struct {
 	function.IOnlyCall
 	base.IZero[string]
@@ -402,6 +530,185 @@ func TestPartitionByHashString(t *testing.T) {
 	partitionByHashTest[string](generalModuleTest, t, 2, "Memory", &IElemensString{})
 }
 
+func TestReorderPartitionsByInt(t *testing.T) {
+	reorderPartitionsByTest(generalModuleTest, t, 2, "Memory")
+}
+
+type SelectAddress struct {
+	City string
+}
+
+type SelectPerson struct {
+	Name    string
+	Age     int64
+	Address *SelectAddress
+	Tags    map[string]string
+}
+
+type SelectRow struct {
+	Name string
+	City string
+}
+
+type SelectToRow struct {
+	function.IOnlyCall
+	base.ISelectTo[SelectPerson, SelectRow]
+}
+
+func TestSelectStruct(t *testing.T) {
+	selectTest(generalModuleTest, t, 2, "Memory")
+}
+
+func TestSelectToStruct(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&SelectToRow{})
+	selectToTest(generalModuleTest, t, "SelectToRow", 2, "Memory")
+}
+
+type ExplodeOrder struct {
+	Id    int64
+	Items []string
+}
+
+type ExplodeOrderItems struct {
+	function.IOnlyCall
+	base.IExplode[ExplodeOrder, string]
+}
+
+func TestExplodeStruct(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&ExplodeOrderItems{})
+	explodeTest(generalModuleTest, t, "ExplodeOrderItems", 2, "Memory")
+}
+
+func TestExplodeSelectStruct(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&ExplodeOrderItems{})
+	explodeSelectTest(generalModuleTest, t, "ExplodeOrderItems", 2, "Memory")
+}
+
+type PivotSale struct {
+	Region string
+	Month  string
+	Amount int64
+}
+
+type PivotSaleRegion struct {
+	function.IOnlyCall
+	base.IMap[PivotSale, string]
+}
+
+func (this *PivotSaleRegion) Call(e PivotSale, ctx api.IContext) (string, error) {
+	return e.Region, nil
+}
+
+type PivotSaleMonth struct {
+	function.IOnlyCall
+	base.IMap[PivotSale, string]
+}
+
+func (this *PivotSaleMonth) Call(e PivotSale, ctx api.IContext) (string, error) {
+	return e.Month, nil
+}
+
+type PivotSaleSum struct {
+	function.IOnlyCall
+	base.IPivot[PivotSale, string, string, int64]
+}
+
+func (this *PivotSaleSum) Call(acc int64, e PivotSale, ctx api.IContext) (int64, error) {
+	return acc + e.Amount, nil
+}
+
+type UnpivotSale struct {
+	function.IOnlyCall
+	base.IUnpivot[string, string, int64]
+}
+
+func TestPivotUnpivotStruct(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&PivotSaleRegion{})
+	generalModuleTest.executorData.RegisterFunction(&PivotSaleMonth{})
+	generalModuleTest.executorData.RegisterFunction(&PivotSaleSum{})
+	generalModuleTest.executorData.RegisterFunction(&UnpivotSale{})
+	pivotUnpivotTest(generalModuleTest, t, "PivotSaleRegion", "PivotSaleMonth", "PivotSaleSum", "UnpivotSale", 2, "Memory")
+}
+
+type ScanInt struct {
+	function.IOnlyCall
+	base.IScan[int64]
+}
+
+func (this *ScanInt) Call(v1 int64, v2 int64, ctx api.IContext) (int64, error) {
+	return v1 + v2, nil
+}
+
+func TestScanInt(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&ZeroInt{})
+	generalModuleTest.executorData.RegisterFunction(&ScanInt{})
+	scanTest(generalModuleTest, t, "ZeroInt", "ScanInt", 2, "Memory")
+}
+
+type IslandEvent struct {
+	User string
+	Day  int64
+}
+
+type IslandEventUser struct {
+	function.IOnlyCall
+	base.IGapsAndIslands[IslandEvent, string]
+}
+
+func (this *IslandEventUser) Call(e IslandEvent, ctx api.IContext) (string, error) {
+	return e.User, nil
+}
+
+type IslandEventDay struct {
+	function.IOnlyCall
+	base.IMap[IslandEvent, int64]
+}
+
+func (this *IslandEventDay) Call(e IslandEvent, ctx api.IContext) (int64, error) {
+	return e.Day, nil
+}
+
+func TestGapsAndIslandsStruct(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&IslandEventUser{})
+	generalModuleTest.executorData.RegisterFunction(&IslandEventDay{})
+	gapsAndIslandsTest(generalModuleTest, t, "IslandEventUser", "IslandEventDay", 2, "Memory")
+}
+
+type MapPartitionsWithBoundaryInt struct {
+	function.IOnlyCall
+	base.IMapPartitionsWithBoundary[int64, int64]
+}
+
+func (this *MapPartitionsWithBoundaryInt) Call(boundary impl.IBoundary[int64], it iterator.IReadIterator[int64], ctx api.IContext) ([]int64, error) {
+	window := append([]int64{}, boundary.Previous...)
+	for it.HasNext() {
+		elem, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		window = append(window, elem)
+	}
+	first := len(boundary.Previous)
+	window = append(window, boundary.Next...)
+	result := make([]int64, 0, len(window))
+	for i := first; i < len(window)-len(boundary.Next); i++ {
+		sum := window[i]
+		if i > 0 {
+			sum += window[i-1]
+		}
+		if i+1 < len(window) {
+			sum += window[i+1]
+		}
+		result = append(result, sum)
+	}
+	return result, nil
+}
+
+func TestMapPartitionsWithBoundaryInt(t *testing.T) {
+	generalModuleTest.executorData.RegisterFunction(&MapPartitionsWithBoundaryInt{})
+	mapPartitionsWithBoundaryTest(generalModuleTest, t, "MapPartitionsWithBoundaryInt", 2, "Memory")
+}
+
 /* Implementations */
 func executeToTest(this *IGeneralModuleTest, t *testing.T, name string, partitionType string) {
 	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
@@ -429,6 +736,33 @@ func mapTest[T any](this *IGeneralModuleTest, t *testing.T, name string, cores i
 	}
 }
 
+func mapPartitionHooksTest(this *IGeneralModuleTest, t *testing.T, fail bool, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	f := &MapHooksInt{before: map[int64]int{}, after: map[int64]int{}, fail: fail}
+	this.executorData.RegisterFunction(f)
+	elems := (&IElemensInt{}).create(100*cores, 0)
+	loadToPartitions(t, this.executorData, elems, cores*2)
+
+	if fail {
+		require.NotNil(t, this.general.Map_(nil, newSource("MapHooksInt")))
+		require.NotEmpty(t, f.before)
+		require.Equal(t, f.before, f.after)
+		return
+	}
+	require.Nil(t, this.general.Map_(nil, newSource("MapHooksInt")))
+	result := getFromPartitions[string](t, this.executorData)
+
+	require.Equal(t, len(elems), len(result))
+	for i := 0; i < len(elems); i++ {
+		require.Equal(t, fmt.Sprint(elems[i]), result[i])
+	}
+	for p := int64(0); p < int64(cores*2); p++ {
+		require.Equal(t, 1, f.before[p])
+		require.Equal(t, 1, f.after[p])
+	}
+}
+
 func filterTest[T utils.Integer](this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string, gen IElements[T]) {
 	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
 	this.executorData.SetCores(cores)
@@ -619,6 +953,46 @@ func sortTest[T utils.Ordered](this *IGeneralModuleTest, t *testing.T, name stri
 	}
 }
 
+func sortChunkedTest(this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string) {
+	props := this.executorData.GetContext().Props()
+	props["ignis.partition.type"] = partitionType
+	props["ignis.partition.chunk"] = "64"
+	defer delete(props, "ignis.partition.chunk")
+
+	np := this.executorData.GetContext().Executors()
+	this.executorData.SetCores(cores)
+	elems := (&IElemensInt{}).create(100*cores*2*np, 0)
+	localElems := rankVector(this.executorData, elems)
+	loadToPartitions(t, this.executorData, localElems, cores*2)
+	group, err := core.GetPartitions[int64](this.executorData)
+	require.Nil(t, err)
+	for _, part := range group.Iter() {
+		require.Nil(t, part.Fit())
+		_, shared := storage.SharedArray[int64](part.Inner().(storage.IList))
+		require.False(t, shared)
+	}
+
+	require.Nil(t, this.general.SortBy(nil, newSource(name), true))
+	result := getFromPartitions[int64](t, this.executorData)
+
+	for i := 1; i < len(result); i++ {
+		require.GreaterOrEqual(t, result[i], result[i-1])
+	}
+
+	loadToPartitions(t, this.executorData, result, 1)
+	group, err = core.GetPartitions[int64](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+	result = getFromPartitions[int64](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		sort.Slice(elems, func(i, j int) bool {
+			return elems[i] < elems[j]
+		})
+		require.Equal(t, elems, result)
+	}
+}
+
 func distinctTest[T comparable](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[T]) {
 	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
 	this.executorData.SetCores(cores)
@@ -654,6 +1028,70 @@ func distinctTest[T comparable](this *IGeneralModuleTest, t *testing.T, cores in
 
 }
 
+/*Every value is repeated with two scales, none of them normalized*/
+func decimalElems(n int) []idecimal.IDecimal {
+	elems := make([]idecimal.IDecimal, 0, 2*n)
+	for i := 1; i <= n; i++ {
+		elems = append(elems, idecimal.IDecimal{Unscaled: int64(i * 10), Scale: 1}, idecimal.IDecimal{Unscaled: int64(i * 100), Scale: 2})
+	}
+	return elems
+}
+
+func distinctDecimalTest(this *IGeneralModuleTest, t *testing.T, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	n := 100 * cores * np
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, decimalElems(n)), cores*2)
+	this.executorData.RegisterType(base.NewTypeC[idecimal.IDecimal]())
+	require.Nil(t, this.general.Distinct(nil, 4))
+
+	loadToPartitions(t, this.executorData, getFromPartitions[idecimal.IDecimal](t, this.executorData), 1)
+	group, err := core.GetPartitions[idecimal.IDecimal](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+	result := getFromPartitions[idecimal.IDecimal](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, n, len(result))
+		values := map[idecimal.IDecimal]bool{}
+		for _, elem := range result {
+			require.NotEqual(t, int32(0), elem.Scale, "distinct must keep the original element")
+			values[elem.Normalize()] = true
+		}
+		require.Equal(t, n, len(values))
+	}
+}
+
+func groupByKeyDecimalTest(this *IGeneralModuleTest, t *testing.T, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	n := 100 * cores * np
+	keys := decimalElems(n)
+	elems := make([]ipair.IPair[idecimal.IDecimal, int64], len(keys))
+	for i, key := range keys {
+		elems[i] = *ipair.New(key, int64(i))
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	this.executorData.RegisterType(base.NewTypeCC[idecimal.IDecimal, int64]())
+	require.Nil(t, this.general.GroupByKey(nil, int64(cores*2)))
+
+	loadToPartitions(t, this.executorData, getFromPartitions[ipair.IPair[idecimal.IDecimal, []int64]](t, this.executorData), 1)
+	group, err := core.GetPartitions[ipair.IPair[idecimal.IDecimal, []int64]](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+	result := getFromPartitions[ipair.IPair[idecimal.IDecimal, []int64]](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, n, len(result))
+		for _, elem := range result {
+			require.NotEqual(t, int32(0), elem.First.Scale, "groupByKey must keep the original key")
+			require.Equal(t, 2, len(elem.Second))
+		}
+	}
+}
+
 func joinTest[K comparable, V any](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[ipair.IPair[K, V]]) {
 	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
 	this.executorData.SetCores(cores)
@@ -701,48 +1139,302 @@ func joinTest[K comparable, V any](this *IGeneralModuleTest, t *testing.T, cores
 	}
 }
 
-func unionTest[T any](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, preserveOrder bool, gen IElements[T]) {
+func sortMergeJoinTest[K comparable, V any](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[ipair.IPair[K, V]]) {
 	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
 	this.executorData.SetCores(cores)
 	np := this.executorData.GetContext().Executors()
 	elems := gen.create(100*cores*2*np, 0)
 	elems2 := gen.create(100*cores*2*np, 1)
-	localElems := rankVector(this.executorData, elems)
-	localElems2 := rankVector(this.executorData, elems2)
+	this.executorData.RegisterType(base.NewTypeCA[K, V]())
+	this.executorData.RegisterType(base.NewTypeCA[K, ipair.IPair[V, V]]())
+	defer this.executorData.SetVariableKeyLayout("other", core.IKeyLayout{})
 
-	loadToPartitions(t, this.executorData, localElems2, cores*2)
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems2), cores*2)
+	require.Nil(t, this.general.RepartitionAndSortWithinPartitions(nil, int64(cores*2), true))
 	core.SetVariable(this.executorData, "other", this.executorData.GetPartitionsAny())
-	loadToPartitions(t, this.executorData, localElems, cores*2)
+	this.executorData.SetVariableKeyLayout("other", this.executorData.KeyLayout())
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	require.Nil(t, this.general.RepartitionAndSortWithinPartitions(nil, int64(cores*2), true))
+	layout := this.executorData.KeyLayout()
 
-	this.executorData.RegisterType(base.NewTypeA[T]())
-	require.Nil(t, this.general.Union_(nil, "other", preserveOrder))
-	result := getFromPartitions[T](t, this.executorData)
+	require.Nil(t, this.general.Join(nil, "other", int64(cores*2)))
+	/*Only the sort-merge join keeps the partitions sorted by key*/
+	require.Equal(t, layout, this.executorData.KeyLayout())
+	result := getFromPartitions[ipair.IPair[K, ipair.IPair[V, V]]](t, this.executorData)
 
 	loadToPartitions(t, this.executorData, result, 1)
-	group, err := core.GetPartitions[T](this.executorData)
+	group, err := core.GetPartitions[ipair.IPair[K, ipair.IPair[V, V]]](this.executorData)
 	require.Nil(t, err)
 	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
-	result = getFromPartitions[T](t, this.executorData)
+	result = getFromPartitions[ipair.IPair[K, ipair.IPair[V, V]]](t, this.executorData)
 
 	if this.executorData.Mpi().IsRoot(0) {
-		require.Equal(t, len(elems)+len(elems2), len(result))
-
-		expected := append(append(make([]T, 0), elems...), elems2...)
+		m1 := make(map[K][]V)
+		for _, entry := range elems {
+			m1[entry.First] = append(m1[entry.First], entry.Second)
+		}
 
-		if preserveOrder {
-			require.Equal(t, expected, result)
-		} else {
-			require.ElementsMatch(t, expected, result)
+		expected := make([]ipair.IPair[K, ipair.IPair[V, V]], 0)
+		for _, entry := range elems2 {
+			for _, value := range m1[entry.First] {
+				expected = append(expected, *ipair.New(entry.First, *ipair.New(value, entry.Second)))
+			}
 		}
+
+		require.Equal(t, len(expected), len(result))
+		require.ElementsMatch(t, expected, result)
 	}
 }
 
-func flatMapValuesTest[T any](this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string, gen IElements[T]) {
-	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
-	this.executorData.SetCores(cores)
-	elems := (&IElemensPair[int64, T]{&IElemensInt{}, gen}).create(100*cores*2, 0)
-	loadToPartitions(t, this.executorData, elems, cores*2)
-	require.Nil(t, this.general.FlatMapValues(nil, newSource(name)))
+func joinCollect[K comparable, V any, R any](this *IGeneralModuleTest, t *testing.T, elems []ipair.IPair[K, V],
+	elems2 []ipair.IPair[K, V], partitions int, join func() error) []R {
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems2), partitions)
+	core.SetVariable(this.executorData, "other", this.executorData.GetPartitionsAny())
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), partitions)
+	require.Nil(t, join())
+	result := getFromPartitions[R](t, this.executorData)
+
+	loadToPartitions(t, this.executorData, result, 1)
+	group, err := core.GetPartitions[R](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+	return getFromPartitions[R](t, this.executorData)
+}
+
+func broadcastJoinTest[K comparable, V any](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[ipair.IPair[K, V]]) {
+	props := this.executorData.GetContext().Props()
+	props["ignis.partition.type"] = partitionType
+	defer delete(props, "ignis.modules.join.broadcast")
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := gen.create(100*cores*2*np, 0)
+	elems2 := gen.create(10*cores*2*np, 1)
+
+	this.executorData.RegisterType(base.NewTypeCA[K, V]())
+	this.executorData.RegisterType(base.NewTypeCA[K, ipair.IPair[V, V]]())
+	join := func() error { return this.general.Join(nil, "other", int64(cores*2)) }
+
+	props["ignis.modules.join.broadcast"] = "0"
+	hash := joinCollect[K, V, ipair.IPair[K, ipair.IPair[V, V]]](this, t, elems, elems2, cores*2, join)
+	props["ignis.modules.join.broadcast"] = "1GB"
+	broadcast := joinCollect[K, V, ipair.IPair[K, ipair.IPair[V, V]]](this, t, elems, elems2, cores*2, join)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.NotEmpty(t, hash)
+		require.ElementsMatch(t, hash, broadcast)
+	}
+}
+
+func joinMaybe[V any](values []V) []V {
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
+func outerJoinTest[K comparable, V any](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[ipair.IPair[K, V]]) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := gen.create(100*cores*2*np, 0)
+	elems2 := gen.create(100*cores*2*np, 1)
+
+	this.executorData.RegisterType(base.NewTypeCA[K, V]())
+	this.executorData.RegisterType(base.NewTypeCA[K, ipair.IPair[V, []V]]())
+	this.executorData.RegisterType(base.NewTypeCA[K, ipair.IPair[[]V, V]]())
+	this.executorData.RegisterType(base.NewTypeCA[K, ipair.IPair[[]V, []V]]())
+	left := joinCollect[K, V, ipair.IPair[K, ipair.IPair[V, []V]]](this, t, elems, elems2, cores*2, func() error {
+		return this.general.LeftOuterJoin(nil, "other", int64(cores*2))
+	})
+	right := joinCollect[K, V, ipair.IPair[K, ipair.IPair[[]V, V]]](this, t, elems, elems2, cores*2, func() error {
+		return this.general.RightOuterJoin(nil, "other", int64(cores*2))
+	})
+	full := joinCollect[K, V, ipair.IPair[K, ipair.IPair[[]V, []V]]](this, t, elems, elems2, cores*2, func() error {
+		return this.general.FullOuterJoin(nil, "other", int64(cores*2))
+	})
+
+	if this.executorData.Mpi().IsRoot(0) {
+		m1 := make(map[K][]V)
+		for _, entry := range elems {
+			m1[entry.First] = append(m1[entry.First], entry.Second)
+		}
+		m2 := make(map[K][]V)
+		for _, entry := range elems2 {
+			m2[entry.First] = append(m2[entry.First], entry.Second)
+		}
+
+		expectedLeft := make([]ipair.IPair[K, ipair.IPair[V, []V]], 0)
+		expectedRight := make([]ipair.IPair[K, ipair.IPair[[]V, V]], 0)
+		expectedFull := make([]ipair.IPair[K, ipair.IPair[[]V, []V]], 0)
+		for key, values := range m1 {
+			for _, value := range values {
+				if values2, present := m2[key]; present {
+					for _, value2 := range values2 {
+						expectedLeft = append(expectedLeft, *ipair.New(key, *ipair.New(value, []V{value2})))
+						expectedRight = append(expectedRight, *ipair.New(key, *ipair.New([]V{value}, value2)))
+						expectedFull = append(expectedFull, *ipair.New(key, *ipair.New([]V{value}, []V{value2})))
+					}
+				} else {
+					expectedLeft = append(expectedLeft, *ipair.New(key, *ipair.New(value, []V(nil))))
+					expectedFull = append(expectedFull, *ipair.New(key, *ipair.New([]V{value}, []V(nil))))
+				}
+			}
+		}
+		for key, values2 := range m2 {
+			if _, present := m1[key]; !present {
+				for _, value2 := range values2 {
+					expectedRight = append(expectedRight, *ipair.New(key, *ipair.New([]V(nil), value2)))
+					expectedFull = append(expectedFull, *ipair.New(key, *ipair.New([]V(nil), []V{value2})))
+				}
+			}
+		}
+
+		for i := range left {
+			left[i].Second.Second = joinMaybe(left[i].Second.Second)
+		}
+		for i := range right {
+			right[i].Second.First = joinMaybe(right[i].Second.First)
+		}
+		for i := range full {
+			full[i].Second.First = joinMaybe(full[i].Second.First)
+			full[i].Second.Second = joinMaybe(full[i].Second.Second)
+		}
+		require.Less(t, len(expectedLeft), len(expectedFull))
+		require.Less(t, len(expectedRight), len(expectedFull))
+		require.ElementsMatch(t, expectedLeft, left)
+		require.ElementsMatch(t, expectedRight, right)
+		require.ElementsMatch(t, expectedFull, full)
+	}
+}
+
+func coGroupTest[K comparable](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[ipair.IPair[K, int64]]) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := gen.create(100*cores*2*np, 0)
+	elems2 := gen.create(100*cores*2*np, 1)
+
+	this.executorData.RegisterType(base.NewTypeCC[K, int64]())
+	this.executorData.RegisterType(base.NewTypeCA[K, ipair.IPair[[]int64, []int64]]())
+	result := joinCollect[K, int64, ipair.IPair[K, ipair.IPair[[]int64, []int64]]](this, t, elems, elems2, cores*2, func() error {
+		return this.general.CoGroup(nil, "other", int64(cores*2))
+	})
+
+	if this.executorData.Mpi().IsRoot(0) {
+		expected := make(map[K]*ipair.IPair[[]int64, []int64])
+		group := func(key K) *ipair.IPair[[]int64, []int64] {
+			if _, present := expected[key]; !present {
+				expected[key] = &ipair.IPair[[]int64, []int64]{}
+			}
+			return expected[key]
+		}
+		for _, entry := range elems {
+			values := group(entry.First)
+			values.First = append(values.First, entry.Second)
+		}
+		for _, entry := range elems2 {
+			values := group(entry.First)
+			values.Second = append(values.Second, entry.Second)
+		}
+
+		require.Equal(t, len(expected), len(result))
+		for _, entry := range result {
+			values, present := expected[entry.First]
+			require.True(t, present)
+			require.ElementsMatch(t, values.First, entry.Second.First)
+			require.ElementsMatch(t, values.Second, entry.Second.Second)
+			delete(expected, entry.First)
+		}
+	}
+}
+
+func unionTest[T any](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, preserveOrder bool, gen IElements[T]) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := gen.create(100*cores*2*np, 0)
+	elems2 := gen.create(100*cores*2*np, 1)
+	localElems := rankVector(this.executorData, elems)
+	localElems2 := rankVector(this.executorData, elems2)
+
+	loadToPartitions(t, this.executorData, localElems2, cores*2)
+	core.SetVariable(this.executorData, "other", this.executorData.GetPartitionsAny())
+	loadToPartitions(t, this.executorData, localElems, cores*2)
+
+	this.executorData.RegisterType(base.NewTypeA[T]())
+	require.Nil(t, this.general.Union_(nil, "other", preserveOrder))
+	result := getFromPartitions[T](t, this.executorData)
+
+	loadToPartitions(t, this.executorData, result, 1)
+	group, err := core.GetPartitions[T](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+	result = getFromPartitions[T](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, len(elems)+len(elems2), len(result))
+
+		expected := append(append(make([]T, 0), elems...), elems2...)
+
+		if preserveOrder {
+			require.Equal(t, expected, result)
+		} else {
+			require.ElementsMatch(t, expected, result)
+		}
+	}
+}
+
+func zipTest[T1 comparable, T2 comparable](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[T1], gen2 IElements[T2]) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := rankVector(this.executorData, gen.create(100*cores*2*np, 0))
+	elems2 := rankVector(this.executorData, gen2.create(100*cores*2*np, 1))
+	tp := base.NewTypeCC[T1, T2]()
+	this.executorData.RegisterType(base.NewTypeC[T1]())
+	this.executorData.RegisterType(tp)
+
+	loadToPartitions(t, this.executorData, elems2, cores)
+	core.SetVariable(this.executorData, "other", this.executorData.GetPartitionsAny())
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.NotNil(t, this.general.Zip(nil, "other", newSource(":"+tp.Name())))
+
+	loadToPartitions(t, this.executorData, elems2, cores*2)
+	core.SetVariable(this.executorData, "other", this.executorData.GetPartitionsAny())
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.Nil(t, this.general.Zip(nil, "other", newSource(":"+tp.Name())))
+	result := getFromPartitions[ipair.IPair[T1, T2]](t, this.executorData)
+
+	require.Equal(t, len(elems), len(result))
+	for i := range elems {
+		require.Equal(t, *ipair.New(elems[i], elems2[i]), result[i])
+	}
+}
+
+func zipWithIndexTest[T any](this *IGeneralModuleTest, t *testing.T, cores int, partitionType string, gen IElements[T]) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := rankVector(this.executorData, gen.create(100*cores*2*np, 0))
+	loadToPartitions(t, this.executorData, elems, cores*2)
+
+	this.executorData.RegisterType(base.NewTypeA[T]())
+	require.Nil(t, this.general.ZipWithIndex(nil))
+	result := getFromPartitions[ipair.IPair[T, int64]](t, this.executorData)
+
+	require.Equal(t, len(elems), len(result))
+	first := int64(this.executorData.Mpi().Rank() * len(elems))
+	for i := range elems {
+		require.Equal(t, *ipair.New(elems[i], first+int64(i)), result[i])
+	}
+}
+
+func flatMapValuesTest[T any](this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string, gen IElements[T]) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	elems := (&IElemensPair[int64, T]{&IElemensInt{}, gen}).create(100*cores*2, 0)
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.Nil(t, this.general.FlatMapValues(nil, newSource(name)))
 	result := getFromPartitions[ipair.IPair[int64, string]](t, this.executorData)
 
 	require.Equal(t, len(elems), len(result))
@@ -800,6 +1492,82 @@ func groupByKeyTest(this *IGeneralModuleTest, t *testing.T, cores int, partition
 	}
 }
 
+func serializedPairs(n int, seed int) []ipair.IPair[[]int64, int64] {
+	values := (&IElemensInt{}).create(n, seed)
+	elems := make([]ipair.IPair[[]int64, int64], n)
+	for i, v := range values {
+		elems[i] = *ipair.New([]int64{v % 7, v % 3}, v%5)
+	}
+	return elems
+}
+
+func groupBySerializedKeyTest(this *IGeneralModuleTest, t *testing.T, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	np := this.executorData.GetContext().Executors()
+	this.executorData.SetCores(cores)
+	elems := serializedPairs(100*cores*2*np, 0)
+	localElems := rankVector(this.executorData, elems)
+	loadToPartitions(t, this.executorData, localElems, cores*2)
+
+	this.executorData.RegisterType(base.NewTypeAA[[]int64, int64]())
+	require.Nil(t, this.general.GroupByKey(nil, int64(cores*2)))
+	result := getFromPartitions[ipair.IPair[[]int64, []int64]](t, this.executorData)
+
+	counts := make(map[string]int)
+	for _, elem := range elems {
+		counts[fmt.Sprint(elem.First)]++
+	}
+
+	loadToPartitions(t, this.executorData, result, 1)
+
+	group, err := core.GetPartitions[ipair.IPair[[]int64, []int64]](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+
+	result = getFromPartitions[ipair.IPair[[]int64, []int64]](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, len(counts), len(result))
+		for i := 0; i < len(result); i++ {
+			require.Equal(t, counts[fmt.Sprint(result[i].First)], len(result[i].Second))
+		}
+	}
+}
+
+func distinctSerializedTest(this *IGeneralModuleTest, t *testing.T, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	np := this.executorData.GetContext().Executors()
+	this.executorData.SetCores(cores)
+	elems := serializedPairs(100*cores*2*np, 0)
+	localElems := rankVector(this.executorData, elems)
+	loadToPartitions(t, this.executorData, localElems, cores*2)
+
+	this.executorData.RegisterType(base.NewTypeAA[[]int64, int64]())
+	require.Nil(t, this.general.Distinct(nil, int64(cores*2)))
+	result := getFromPartitions[ipair.IPair[[]int64, int64]](t, this.executorData)
+
+	loadToPartitions(t, this.executorData, result, 1)
+
+	group, err := core.GetPartitions[ipair.IPair[[]int64, int64]](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+
+	result = getFromPartitions[ipair.IPair[[]int64, int64]](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		distinct := make(map[string]bool)
+		for _, elem := range elems {
+			distinct[fmt.Sprint(elem)] = true
+		}
+		rdist := make(map[string]bool)
+		for _, elem := range result {
+			rdist[fmt.Sprint(elem)] = true
+		}
+		require.Equal(t, len(rdist), len(result))
+		require.Equal(t, distinct, rdist)
+	}
+}
+
 func reduceByKeyTest[K comparable, V utils.Ordered](this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string, gen IElements[ipair.IPair[K, V]]) {
 	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
 	np := this.executorData.GetContext().Executors()
@@ -836,6 +1604,40 @@ func reduceByKeyTest[K comparable, V utils.Ordered](this *IGeneralModuleTest, t
 	}
 }
 
+func reduceBySerializedKeyTest(this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	np := this.executorData.GetContext().Executors()
+	this.executorData.SetCores(cores)
+	values := (&IElemensInt{}).create(100*cores*2*np, 0)
+	elems := make([]ipair.IPair[[]int64, int64], len(values))
+	acums := make(map[string]int64)
+	for i, v := range values {
+		elems[i] = *ipair.New([]int64{v % 7, v % 3}, v)
+		acums[fmt.Sprint(elems[i].First)] += v
+	}
+	localElems := rankVector(this.executorData, elems)
+	loadToPartitions(t, this.executorData, localElems, cores*2)
+
+	this.executorData.RegisterType(base.NewTypeAA[[]int64, int64]())
+	require.Nil(t, this.general.ReduceByKey(nil, newSource(name), int64(cores*2), true))
+	result := getFromPartitions[ipair.IPair[[]int64, int64]](t, this.executorData)
+
+	loadToPartitions(t, this.executorData, result, 1)
+
+	group, err := core.GetPartitions[ipair.IPair[[]int64, int64]](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+
+	result = getFromPartitions[ipair.IPair[[]int64, int64]](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, len(acums), len(result))
+		for i := 0; i < len(result); i++ {
+			require.Equal(t, acums[fmt.Sprint(result[i].First)], result[i].Second)
+		}
+	}
+}
+
 func aggregateByKeyTest[K comparable, V utils.Ordered](this *IGeneralModuleTest, t *testing.T, zero string, seq string, comb string, cores int, partitionType string, gen IElements[ipair.IPair[K, V]]) {
 	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
 	np := this.executorData.GetContext().Executors()
@@ -1112,3 +1914,256 @@ func partitionByHashTest[T comparable](this *IGeneralModuleTest, t *testing.T, c
 		}
 	}
 }
+
+func reorderPartitionsByTest(this *IGeneralModuleTest, t *testing.T, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	rank := this.executorData.Mpi().Rank()
+	parts := cores * 2
+	total := parts * np
+
+	elems := make([]int64, 0, parts*10)
+	for g := rank * parts; g < (rank+1)*parts; g++ {
+		for i := 0; i < 10; i++ {
+			elems = append(elems, int64((total-1-g)*10+i))
+		}
+	}
+	loadToPartitions(t, this.executorData, elems, parts)
+
+	this.executorData.RegisterType(base.NewTypeC[int64]())
+	require.Nil(t, this.general.ReorderPartitionsBy(nil))
+	offset, err := this.general.PartitionOffset(nil)
+	require.Nil(t, err)
+
+	group, err := core.GetPartitions[int64](this.executorData)
+	require.Nil(t, err)
+	for i, part := range group.Iter() {
+		reader, err := part.ReadIterator()
+		require.Nil(t, err)
+		for reader.HasNext() {
+			elem, err := reader.Next()
+			require.Nil(t, err)
+			require.Equal(t, (offset+int64(i))*10, elem-elem%10)
+		}
+	}
+}
+
+func selectPersons(n int) []SelectPerson {
+	elems := make([]SelectPerson, n)
+	for i := range elems {
+		elems[i] = SelectPerson{Name: fmt.Sprint("p", i), Age: int64(i), Tags: map[string]string{"id": fmt.Sprint(i)}}
+		if i%3 != 0 {
+			elems[i].Address = &SelectAddress{City: fmt.Sprint("c", i%5)}
+		}
+	}
+	return elems
+}
+
+func selectTest(this *IGeneralModuleTest, t *testing.T, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	elems := selectPersons(100 * cores)
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	this.executorData.RegisterType(base.NewTypeA[SelectPerson]())
+	require.Nil(t, this.general.Select(nil, []string{"Name", "Address.City", "Tags.id"}))
+	result := getFromPartitions[[]any](t, this.executorData)
+
+	require.Equal(t, len(elems), len(result))
+	for i, elem := range elems {
+		city := ""
+		if elem.Address != nil {
+			city = elem.Address.City
+		}
+		require.Equal(t, []any{elem.Name, city, elem.Tags["id"]}, result[i])
+	}
+}
+
+func selectToTest(this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	elems := selectPersons(100 * cores)
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.NotNil(t, this.general.SelectTo(nil, newSource(name), []string{"Name"}))
+
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.Nil(t, this.general.SelectTo(nil, newSource(name), []string{"Name", "Address.City"}))
+	result := getFromPartitions[SelectRow](t, this.executorData)
+
+	require.Equal(t, len(elems), len(result))
+	for i, elem := range elems {
+		require.Equal(t, elem.Name, result[i].Name)
+		if elem.Address != nil {
+			require.Equal(t, elem.Address.City, result[i].City)
+		} else {
+			require.Equal(t, "", result[i].City)
+		}
+	}
+}
+
+func explodeOrders(n int) []ExplodeOrder {
+	elems := make([]ExplodeOrder, n)
+	for i := range elems {
+		elems[i] = ExplodeOrder{Id: int64(i), Items: make([]string, i%4)}
+		for j := range elems[i].Items {
+			elems[i].Items[j] = fmt.Sprint(i, "-", j)
+		}
+	}
+	return elems
+}
+
+func explodeTest(this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	elems := explodeOrders(100 * cores)
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.NotNil(t, this.general.Explode(nil, newSource(name), "Id"))
+
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.Nil(t, this.general.Explode(nil, newSource(name), "Items"))
+	result := getFromPartitions[ipair.IPair[ExplodeOrder, string]](t, this.executorData)
+
+	k := 0
+	for _, elem := range elems {
+		for _, item := range elem.Items {
+			require.Equal(t, elem.Id, result[k].First.Id)
+			require.Equal(t, item, result[k].Second)
+			k++
+		}
+	}
+	require.Equal(t, k, len(result))
+}
+
+func explodeSelectTest(this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	elems := explodeOrders(100 * cores)
+	loadToPartitions(t, this.executorData, elems, cores*2)
+	require.Nil(t, this.general.ExplodeSelect(nil, newSource(name), "Items", []string{"Id"}))
+	result := getFromPartitions[ipair.IPair[[]any, string]](t, this.executorData)
+
+	k := 0
+	for _, elem := range elems {
+		for _, item := range elem.Items {
+			require.Equal(t, []any{elem.Id}, result[k].First)
+			require.Equal(t, item, result[k].Second)
+			k++
+		}
+	}
+	require.Equal(t, k, len(result))
+}
+
+func pivotUnpivotTest(this *IGeneralModuleTest, t *testing.T, key string, column string, agg string, unpivot string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := make([]PivotSale, 0, 100*cores*np)
+	expected := make(map[string]map[string]int64)
+	for i := 0; i < 100*cores*np; i++ {
+		elem := PivotSale{fmt.Sprint("r", i%7), fmt.Sprint("m", i%5), int64(i)}
+		if expected[elem.Region] == nil {
+			expected[elem.Region] = make(map[string]int64)
+		}
+		expected[elem.Region][elem.Month] += elem.Amount
+		elems = append(elems, elem)
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	columnsJson, err := this.general.Pivot(nil, newSource(key), newSource(column), newSource(agg), 2)
+	require.Nil(t, err)
+	var columns []string
+	require.Nil(t, json.Unmarshal([]byte(columnsJson), &columns))
+	require.Equal(t, []string{"m0", "m1", "m2", "m3", "m4"}, columns)
+
+	rows := getFromPartitions[ipair.IPair[string, []int64]](t, this.executorData)
+	for _, row := range rows {
+		require.Equal(t, len(columns), len(row.Second))
+		for i, column := range columns {
+			require.Equal(t, expected[row.First][column], row.Second[i])
+		}
+	}
+
+	loadToPartitions(t, this.executorData, rows, cores*2)
+	require.Nil(t, this.general.Unpivot(nil, newSource(unpivot), columnsJson))
+	result := getFromPartitions[ipair.IPair[string, ipair.IPair[string, int64]]](t, this.executorData)
+	require.Equal(t, len(rows)*len(columns), len(result))
+	for _, elem := range result {
+		require.Equal(t, expected[elem.First][elem.Second.First], elem.Second.Second)
+	}
+}
+
+func scanTest(this *IGeneralModuleTest, t *testing.T, zero string, name string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := make([]int64, 100*cores*np)
+	expected := make([]int64, len(elems))
+	acum := int64(0)
+	for i := range elems {
+		elems[i] = int64(i % 13)
+		acum += elems[i]
+		expected[i] = acum
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	require.Nil(t, this.general.Scan(nil, newSource(zero), newSource(name)))
+	result := getFromPartitions[int64](t, this.executorData)
+
+	require.Equal(t, rankVector(this.executorData, expected), result)
+}
+
+func gapsAndIslandsTest(this *IGeneralModuleTest, t *testing.T, key string, seq string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := make([]IslandEvent, 0, 100*cores*np)
+	for i := 0; i < 100*cores*np; i++ {
+		/* days 0..9 then a gap of 10, every block of 10 days is an island */
+		elems = append(elems, IslandEvent{fmt.Sprint("u", i%3), int64(i/3 + (i/30)*10)})
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	require.Nil(t, this.general.GapsAndIslands(nil, newSource(key), newSource(seq), 2, 2))
+	result := getFromPartitions[ipair.IPair[string, ipair.IPair[int64, IslandEvent]]](t, this.executorData)
+
+	islands := make(map[string]map[int64]bool)
+	for _, elem := range result {
+		require.Equal(t, elem.First, elem.Second.Second.User)
+		require.Equal(t, elem.Second.Second.Day/20, elem.Second.First)
+		if islands[elem.First] == nil {
+			islands[elem.First] = make(map[int64]bool)
+		}
+		islands[elem.First][elem.Second.First] = true
+	}
+	if np == 1 {
+		require.Equal(t, len(elems), len(result))
+		for _, user := range islands {
+			require.Equal(t, (len(elems)+29)/30, len(user))
+		}
+	}
+}
+
+func mapPartitionsWithBoundaryTest(this *IGeneralModuleTest, t *testing.T, name string, cores int, partitionType string) {
+	this.executorData.GetContext().Props()["ignis.partition.type"] = partitionType
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	elems := make([]int64, 100*cores*np)
+	for i := range elems {
+		elems[i] = int64(i)
+	}
+	expected := make([]int64, len(elems))
+	for i := range elems {
+		expected[i] = elems[i]
+		if i > 0 {
+			expected[i] += elems[i-1]
+		}
+		if i+1 < len(elems) {
+			expected[i] += elems[i+1]
+		}
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	require.NotNil(t, this.general.MapPartitionsWithBoundary(nil, newSource(name), 0))
+
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	require.Nil(t, this.general.MapPartitionsWithBoundary(nil, newSource(name), 1))
+	result := getFromPartitions[int64](t, this.executorData)
+
+	require.Equal(t, rankVector(this.executorData, expected), result)
+}
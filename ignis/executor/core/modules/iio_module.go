@@ -2,10 +2,15 @@ package modules
 
 import (
 	"context"
+	"encoding/json"
+	"ignis/executor/api/base"
+	"ignis/executor/api/function"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/modules/impl"
 	"ignis/rpc"
+	"reflect"
+	"unicode/utf8"
 )
 
 type IIOModule struct {
@@ -80,6 +85,62 @@ func (this *IIOModule) TextFile2(ctx context.Context, path string, minPartitions
 	return this.PackError(this.ioImpl.TextFile(path, minPartitions))
 }
 
+func (this *IIOModule) SequenceFile(ctx context.Context, path string, minPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.PackError(this.ioImpl.SequenceFile(path, minPartitions))
+}
+
+func (this *IIOModule) BinaryFile(ctx context.Context, path string, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromSource(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.BinaryFile(this.ioImpl, path))
+}
+
+/*Reads csv rows into the struct type of src, an empty delimiter or quote keeps the default*/
+func (this *IIOModule) CsvFile(ctx context.Context, path string, minPartitions int64, delimiter string, quote string, header bool, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	options := impl.DefaultCsvOptions()
+	options.Header = header
+	if len(delimiter) > 0 {
+		if utf8.RuneCountInString(delimiter) != 1 {
+			return this.PackError(ierror.RaiseMsg("csv delimiter must be a single character"))
+		}
+		options.Delimiter, _ = utf8.DecodeRuneInString(delimiter)
+	}
+	if len(quote) > 0 {
+		if utf8.RuneCountInString(quote) != 1 {
+			return this.PackError(ierror.RaiseMsg("csv quote must be a single character"))
+		}
+		options.Quote, _ = utf8.DecodeRuneInString(quote)
+	}
+	base, err := this.TypeFromSource(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.CsvFile(this.ioImpl, path, minPartitions, options))
+}
+
+/*Reads json lines into the type of src, in permissive mode malformed lines are skipped and returned as json*/
+func (this *IIOModule) JsonFile(ctx context.Context, path string, minPartitions int64, permissive bool, src *rpc.ISource) (_r string, _err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromSource(src)
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	errors, err := base.JsonFile(this.ioImpl, path, minPartitions, permissive)
+	if err != nil || errors == nil {
+		return "", this.PackError(err)
+	}
+	result, err := json.Marshal(errors)
+	if err != nil {
+		return "", this.PackError(ierror.Raise(err))
+	}
+	return string(result), nil
+}
+
 func (this *IIOModule) PartitionObjectFile(ctx context.Context, path string, first int64, partitions int64) (_err error) {
 	return this.PackError(ierror.RaiseMsg("Not implemented yet"))
 }
@@ -103,7 +164,12 @@ func (this *IIOModule) PartitionJsonFile4a(ctx context.Context, path string, fir
 }
 
 func (this *IIOModule) PartitionJsonFile4b(ctx context.Context, path string, first int64, partitions int64, src *rpc.ISource) (_err error) {
-	return this.PackError(ierror.RaiseMsg("Not implemented yet"))
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromSource(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.PartitionJsonFile(this.ioImpl, path, first, partitions))
 }
 
 func (this *IIOModule) SaveAsObjectFile(ctx context.Context, path string, compression int8, first int64) (_err error) {
@@ -115,6 +181,24 @@ func (this *IIOModule) SaveAsObjectFile(ctx context.Context, path string, compre
 	return this.PackError(base.SaveAsObjectFile(this.ioImpl, path, compression, first))
 }
 
+func (this *IIOModule) SaveAsSequenceFile(ctx context.Context, path string, compression int8, first int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.SaveAsSequenceFile(this.ioImpl, path, compression, first))
+}
+
+func (this *IIOModule) SaveAsBinaryFile(ctx context.Context, path string, compression int8, first int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.SaveAsBinaryFile(this.ioImpl, path, compression, first))
+}
+
 func (this *IIOModule) SaveAsTextFile(ctx context.Context, path string, first int64) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
@@ -124,6 +208,20 @@ func (this *IIOModule) SaveAsTextFile(ctx context.Context, path string, first in
 	return this.PackError(base.SaveAsTextFile(this.ioImpl, path, first))
 }
 
+func (this *IIOModule) SaveAsTextFile3(ctx context.Context, path string, first int64, src *rpc.ISource) (_err error) {
+	defer this.moduleRecover(&_err)
+	basefun, err := this.executorData.LoadLibrary(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := basefun.(base.ISaveAsTextFileAbs); ok {
+		return this.PackError(fun.RunSaveAsTextFile(this.ioImpl, basefun, path, first))
+	} else if anyfun, ok := basefun.(function.IFunction[any, string]); ok {
+		return this.PackError(impl.SaveAsTextFileBy(this.ioImpl, anyfun, path, first))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "saveAsTextFile")
+}
+
 func (this *IIOModule) SaveAsJsonFile(ctx context.Context, path string, first int64, pretty bool) (_err error) {
 	defer this.moduleRecover(&_err)
 	base, err := this.TypeFromPartition()
@@ -2,9 +2,12 @@ package modules
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"github.com/stretchr/testify/require"
 	"ignis/executor/api/base"
 	"ignis/executor/core"
+	"ignis/executor/core/modules/impl"
 	"os"
 	"strings"
 	"testing"
@@ -71,6 +74,29 @@ func TestPartitionTextFile(t *testing.T) {
 	partitionTextFileTest(ioModuleTest, t, 8)
 }
 
+type CsvRow struct {
+	Id    int64
+	Name  string `csv:"full name"`
+	Score float64
+}
+
+func TestCsvFile(t *testing.T) {
+	csvFileTest(ioModuleTest, t, 8, 2, false)
+}
+
+func TestCsvFileHeader(t *testing.T) {
+	csvFileTest(ioModuleTest, t, 8, 2, true)
+}
+
+type JsonRow struct {
+	Id   int64    `json:"id"`
+	Tags []string `json:"tags"`
+}
+
+func TestJsonFile(t *testing.T) {
+	jsonFileTest(ioModuleTest, t, 8, 2)
+}
+
 func textFileTest(this *IIOModuleTest, t *testing.T, n int, cores int) {
 	this.executorData.SetCores(cores)
 	path := "./tmpfile.txt"
@@ -174,3 +200,93 @@ func partitionTextFileTest(this *IIOModuleTest, t *testing.T, n int) {
 
 	require.Equal(t, lines, result)
 }
+
+func csvFileTest(this *IIOModuleTest, t *testing.T, n int, cores int, header bool) {
+	this.executorData.SetCores(cores)
+	path := "./tmpfile.csv"
+	file, err := os.Create(path)
+	require.Nil(t, err)
+	rows := make([]CsvRow, 1000)
+	if header {
+		_, err := file.WriteString("score;id;full name\n")
+		require.Nil(t, err)
+	}
+	for i := range rows {
+		rows[i] = CsvRow{int64(i), fmt.Sprint("name; ", i), float64(i) / 4}
+		line := fmt.Sprint(rows[i].Id, ";'", rows[i].Name, "';", rows[i].Score, "\n")
+		if header {
+			line = fmt.Sprint(rows[i].Score, ";", rows[i].Id, ";'", rows[i].Name, "'\n")
+		}
+		_, err := file.WriteString(line)
+		require.Nil(t, err)
+	}
+	require.Nil(t, file.Close())
+
+	tp := base.NewTypeA[CsvRow]()
+	this.executorData.RegisterType(tp)
+	require.NotNil(t, this.io.CsvFile(context.Background(), path, int64(n), ";;", "'", header, newSource(":"+tp.Name())))
+	require.Nil(t, this.io.CsvFile(context.Background(), path, int64(n), ";", "'", header, newSource(":"+tp.Name())))
+
+	result := getFromPartitions[CsvRow](t, this.executorData)
+	loadToPartitions(t, this.executorData, result, 1)
+
+	group, err := core.GetPartitions[CsvRow](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+
+	result = getFromPartitions[CsvRow](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, rows, result)
+	}
+}
+
+func jsonFileTest(this *IIOModuleTest, t *testing.T, n int, cores int) {
+	this.executorData.SetCores(cores)
+	path := "./tmpfile.json"
+	file, err := os.Create(path)
+	require.Nil(t, err)
+	rows := make([]JsonRow, 0, 1000)
+	malformed := 0
+	for i := 0; i < 1000; i++ {
+		if i%100 == 7 {
+			_, err := file.WriteString(fmt.Sprint("{\"id\": ", i, ",\n"))
+			require.Nil(t, err)
+			malformed++
+			continue
+		}
+		row := JsonRow{int64(i), []string{fmt.Sprint("t", i%3)}}
+		line, err := json.Marshal(row)
+		require.Nil(t, err)
+		_, err = file.WriteString(string(line) + "\n")
+		require.Nil(t, err)
+		rows = append(rows, row)
+	}
+	require.Nil(t, file.Close())
+
+	tp := base.NewTypeA[JsonRow]()
+	this.executorData.RegisterType(tp)
+	_, err = this.io.JsonFile(context.Background(), path, int64(n), false, newSource(":"+tp.Name()))
+	require.NotNil(t, err)
+	report, err := this.io.JsonFile(context.Background(), path, int64(n), true, newSource(":"+tp.Name()))
+	require.Nil(t, err)
+
+	var errors impl.IJsonErrors
+	require.Nil(t, json.Unmarshal([]byte(report), &errors))
+	result := getFromPartitions[JsonRow](t, this.executorData)
+	loadToPartitions(t, this.executorData, result, 1)
+
+	group, err := core.GetPartitions[JsonRow](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+
+	result = getFromPartitions[JsonRow](t, this.executorData)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		require.Equal(t, rows, result)
+	}
+	if this.executorData.GetContext().Executors() == 1 {
+		require.Equal(t, int64(malformed), errors.Count)
+		require.Equal(t, malformed, len(errors.Lines))
+	}
+}
@@ -120,3 +120,48 @@ func (this *IMathModule) CountByValue(ctx context.Context) (_err error) {
 	}
 	return this.PackError(base.CountByValue(this.mathImpl))
 }
+
+func (this *IMathModule) Histogram(ctx context.Context, buckets int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Histogram(this.mathImpl, buckets))
+}
+
+func (this *IMathModule) ApproxQuantile(ctx context.Context, probabilities []float64, relativeError float64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.ApproxQuantile(this.mathImpl, probabilities, relativeError))
+}
+
+func (this *IMathModule) Sum(ctx context.Context) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Sum(this.mathImpl))
+}
+
+func (this *IMathModule) Mean(ctx context.Context) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.Mean(this.mathImpl))
+}
+
+func (this *IMathModule) SumByKey(ctx context.Context, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return this.PackError(err)
+	}
+	return this.PackError(base.SumByKey(this.mathImpl, numPartitions))
+}
@@ -0,0 +1,245 @@
+package modules
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"ignis/executor/api/base"
+	"ignis/executor/api/ipair"
+	"ignis/executor/core"
+	"math"
+	"math/big"
+	"testing"
+)
+
+type IMathModuleTest struct {
+	math         *IMathModule
+	executorData *core.IExecutorData
+}
+
+var mathModuleTest *IMathModuleTest
+
+func init() {
+	executorData := core.NewIExecutorData()
+	mathModule := NewIMathModule(executorData)
+	mathModuleTest = &IMathModuleTest{mathModule, executorData}
+
+	sepUpDefault(executorData)
+	props := executorData.GetContext().Props()
+	props["ignis.partition.type"] = "Memory"
+}
+
+func TestSumOverflowError(t *testing.T) {
+	sumOverflowTest(mathModuleTest, t, "error", 2)
+}
+
+func TestSumOverflowErrorExecutors(t *testing.T) {
+	sumExecutorsOverflowTest(mathModuleTest, t, 2)
+}
+
+func TestSumOverflowSaturate(t *testing.T) {
+	sumOverflowTest(mathModuleTest, t, "saturate", 2)
+}
+
+func TestSumOverflowPromote(t *testing.T) {
+	sumOverflowTest(mathModuleTest, t, "promote", 2)
+}
+
+func TestMeanOverflowPromote(t *testing.T) {
+	meanOverflowTest(mathModuleTest, t, 2)
+}
+
+func TestSumByKeyOverflowError(t *testing.T) {
+	sumByKeyOverflowTest(mathModuleTest, t, "error", 2)
+}
+
+func TestSumByKeyOverflowSaturate(t *testing.T) {
+	sumByKeyOverflowTest(mathModuleTest, t, "saturate", 2)
+}
+
+func TestSumByKeyOverflowPromote(t *testing.T) {
+	sumByKeyOverflowTest(mathModuleTest, t, "promote", 2)
+}
+
+func TestCountBySerializedKey(t *testing.T) {
+	countBySerializedTest(mathModuleTest, t, true, 2)
+}
+
+func TestCountBySerializedValue(t *testing.T) {
+	countBySerializedTest(mathModuleTest, t, false, 2)
+}
+
+func TestSampleBySerializedKey(t *testing.T) {
+	sampleBySerializedKeyTest(mathModuleTest, t, 2)
+}
+
+func overflowElements(n int) []int64 {
+	elems := make([]int64, n)
+	for i := range elems {
+		elems[i] = math.MaxInt64 / 4
+	}
+	return elems
+}
+
+func overflowSum(n int) *big.Int {
+	return new(big.Int).Mul(big.NewInt(math.MaxInt64/4), big.NewInt(int64(n)))
+}
+
+func sumOverflowTest(this *IMathModuleTest, t *testing.T, mode string, cores int) {
+	this.executorData.GetContext().Props()["ignis.modules.math.overflow"] = mode
+	this.executorData.SetCores(cores)
+	elems := overflowElements(10)
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	this.executorData.RegisterType(base.NewTypeC[int64]())
+
+	err := this.math.Sum(context.Background())
+	if mode == "error" {
+		require.NotNil(t, err)
+		return
+	}
+	require.Nil(t, err)
+
+	if this.executorData.Mpi().IsRoot(0) {
+		if mode == "saturate" {
+			require.Equal(t, []int64{math.MaxInt64}, getFromPartitions[int64](t, this.executorData))
+		} else {
+			result := getFromPartitions[*big.Int](t, this.executorData)
+			require.Equal(t, 1, len(result))
+			require.Equal(t, 0, overflowSum(len(elems)).Cmp(result[0]))
+		}
+	}
+}
+
+/*Every executor sum fits, only their total overflows*/
+func sumExecutorsOverflowTest(this *IMathModuleTest, t *testing.T, cores int) {
+	this.executorData.GetContext().Props()["ignis.modules.math.overflow"] = "error"
+	this.executorData.SetCores(cores)
+	loadToPartitions(t, this.executorData, []int64{math.MaxInt64/2 + 1}, cores*2)
+	this.executorData.RegisterType(base.NewTypeC[int64]())
+
+	err := this.math.Sum(context.Background())
+	if this.executorData.Mpi().Executors() > 1 {
+		require.NotNil(t, err)
+	} else {
+		require.Nil(t, err)
+	}
+}
+
+func meanOverflowTest(this *IMathModuleTest, t *testing.T, cores int) {
+	this.executorData.GetContext().Props()["ignis.modules.math.overflow"] = "promote"
+	this.executorData.SetCores(cores)
+	elems := overflowElements(10)
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	this.executorData.RegisterType(base.NewTypeC[int64]())
+
+	require.Nil(t, this.math.Mean(context.Background()))
+
+	if this.executorData.Mpi().IsRoot(0) {
+		result := getFromPartitions[float64](t, this.executorData)
+		require.Equal(t, 1, len(result))
+		require.InEpsilon(t, float64(math.MaxInt64/4), result[0], 1e-9)
+	}
+}
+
+func sumByKeyOverflowTest(this *IMathModuleTest, t *testing.T, mode string, cores int) {
+	this.executorData.GetContext().Props()["ignis.modules.math.overflow"] = mode
+	this.executorData.SetCores(cores)
+	values := overflowElements(20)
+	elems := make([]ipair.IPair[int64, int64], len(values))
+	for i, v := range values {
+		elems[i] = *ipair.New(int64(i%2), v)
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	this.executorData.RegisterType(base.NewTypeCC[int64, int64]())
+
+	err := this.math.SumByKey(context.Background(), 2)
+	if mode == "error" {
+		require.NotNil(t, err)
+		return
+	}
+	require.Nil(t, err)
+
+	if mode == "saturate" {
+		for _, elem := range getFromPartitions[ipair.IPair[int64, int64]](t, this.executorData) {
+			require.Equal(t, int64(math.MaxInt64), elem.Second)
+		}
+	} else {
+		for _, elem := range getFromPartitions[ipair.IPair[int64, *big.Int]](t, this.executorData) {
+			require.Equal(t, 0, overflowSum(len(values)/2).Cmp(elem.Second))
+		}
+	}
+}
+
+func countBySerializedTest(this *IMathModuleTest, t *testing.T, byKey bool, cores int) {
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	values := (&IElemensInt{}).create(100*cores*2*np, 0)
+	elems := make([]ipair.IPair[[]int64, []int64], len(values))
+	expected := make(map[string]int64)
+	for i, v := range values {
+		elems[i] = *ipair.New([]int64{v % 7, v % 3}, []int64{v % 5})
+		if byKey {
+			expected[fmt.Sprint(elems[i].First)]++
+		} else {
+			expected[fmt.Sprint(elems[i].Second)]++
+		}
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	this.executorData.RegisterType(base.NewTypeAA[[]int64, []int64]())
+
+	if byKey {
+		require.Nil(t, this.math.CountByKey(context.Background()))
+	} else {
+		require.Nil(t, this.math.CountByValue(context.Background()))
+	}
+
+	if this.executorData.Mpi().IsRoot(0) {
+		result := make(map[string]int64)
+		for _, elem := range getFromPartitions[ipair.IPair[[]int64, int64]](t, this.executorData) {
+			result[fmt.Sprint(elem.First)] += elem.Second
+		}
+		require.Equal(t, expected, result)
+	}
+}
+
+func sampleBySerializedKeyTest(this *IMathModuleTest, t *testing.T, cores int) {
+	this.executorData.SetCores(cores)
+	np := this.executorData.GetContext().Executors()
+	values := (&IElemensInt{}).create(100*cores*2*np, 0)
+	elems := make([]ipair.IPair[[]int64, int64], len(values))
+	sizes := make(map[string]int64)
+	for i, v := range values {
+		elems[i] = *ipair.New([]int64{v % 3, v % 2}, v)
+		sizes[fmt.Sprint(elems[i].First)]++
+	}
+	fractions := []ipair.IPair[[]int64, float64]{
+		*ipair.New([]int64{0, 0}, 0.5),
+		*ipair.New([]int64{1, 1}, 0.25),
+		*ipair.New([]int64{2, 0}, 1.0),
+	}
+	expected := make(map[string]int64)
+	for _, fraction := range fractions {
+		key := fmt.Sprint(fraction.First)
+		expected[key] = int64(float64(sizes[key]) * fraction.Second)
+	}
+	loadToPartitions(t, this.executorData, rankVector(this.executorData, elems), cores*2)
+	this.executorData.RegisterType(base.NewTypeAA[[]int64, int64]())
+	this.executorData.GetContext().Vars()["fractions"] = fractions
+
+	require.Nil(t, this.math.SampleByKey(context.Background(), false, nil, 0))
+	result := getFromPartitions[ipair.IPair[[]int64, int64]](t, this.executorData)
+
+	loadToPartitions(t, this.executorData, result, 1)
+	group, err := core.GetPartitions[ipair.IPair[[]int64, int64]](this.executorData)
+	require.Nil(t, err)
+	require.Nil(t, core.Gather(this.executorData.Mpi(), group.Get(0), 0))
+
+	if this.executorData.Mpi().IsRoot(0) {
+		counts := make(map[string]int64)
+		for _, elem := range getFromPartitions[ipair.IPair[[]int64, int64]](t, this.executorData) {
+			require.Equal(t, []int64{elem.Second % 3, elem.Second % 2}, elem.First)
+			counts[fmt.Sprint(elem.First)]++
+		}
+		require.Equal(t, expected, counts)
+	}
+}
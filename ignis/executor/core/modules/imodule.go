@@ -1,15 +1,20 @@
 package modules
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"ignis/executor/api/base"
+	"ignis/executor/api/function"
 	"ignis/executor/api/ipair"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/logger"
+	"ignis/executor/core/modules/impl"
 	"ignis/executor/core/utils"
 	"ignis/rpc"
 	"reflect"
+	"runtime"
 	"strings"
 )
 
@@ -25,25 +30,53 @@ func (this *IModule) GetExecutorData() *core.IExecutorData {
 	return this.executorData
 }
 
+/*Errors are sent to the driver encoded with their code and the executor, module and partition where they happened*/
 func (this *IModule) PackError(err error) error {
 	if err == nil {
 		return nil
 	}
+	if _, ok := err.(*rpc.IExecutorException); ok {
+		return err
+	}
 
-	ex := rpc.NewIExecutorException()
+	rank := -1
+	if this.executorData != nil {
+		rank = this.executorData.GetContext().ExecutorId()
+	}
+	err = ierror.WithContext(err, rank, callerModule())
 
-	if ee, ok := err.(*ierror.IExecutorError); ok {
+	ex := rpc.NewIExecutorException()
+	ex.Message = err.Error()
+	if ee, ok := err.(interface{ GetMessage() string }); ok {
 		ex.Message = ee.GetMessage()
-		ex.Cause_ = ee.Error()
-	} else {
-		ex.Message = err.Error()
-		ex.Cause_ = ""
-
 	}
-
+	ex.Cause_ = ierror.Encode(err)
+	if this.executorData != nil {
+		this.executorData.Trace().Failed(ex.Message)
+	}
 	return ex
 }
 
+/*First module method in the stack, a panic keeps the frames of the method that raised it below the recover*/
+func callerModule() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+	for {
+		frame, more := frames.Next()
+		name := frame.Function[strings.LastIndexByte(frame.Function, '/')+1:]
+		if strings.HasPrefix(name, "modules.(*I") && !strings.HasPrefix(name, "modules.(*IModule).") {
+			name = strings.TrimPrefix(name, "modules.(*")
+			if i := strings.Index(name, ".func"); i >= 0 {
+				name = name[:i]
+			}
+			return strings.Replace(name, ")", "", 1)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
 func (this *IModule) TypeFromDefault(pair bool) (base.ITypeFunctions, error) {
 	if pair {
 		return base.NewTypeCC[any, any](), nil
@@ -109,16 +142,90 @@ func (this *IModule) CompatibilityError(f reflect.Type, m string) error {
 	return this.PackError(ierror.RaiseMsg(f.String() + " is not compatible with " + m))
 }
 
+/*Recomputes the partitions lost by the last operation, returns the number of partitions recovered*/
+func (this *IModule) RecomputePartitions(ctx context.Context) (_r int64, _err error) {
+	defer this.moduleRecover(&_err)
+	n, err := this.executorData.Lineage().Recompute()
+	return int64(n), this.PackError(err)
+}
+
+/*
+Element count, serialized bytes and key range of every partition, the statistics are gathered in the executor 0
+and returned as json
+*/
+func (this *IModule) PartitionStats(ctx context.Context) (_r string, _err error) {
+	defer this.moduleRecover(&_err)
+	base, err := this.TypeFromPartition()
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	stats, err := base.PartitionStats(impl.NewIEstimateImpl(this.executorData))
+	if err != nil {
+		return "", this.PackError(err)
+	}
+	result, err := json.Marshal(stats)
+	if err != nil {
+		return "", this.PackError(ierror.Raise(err))
+	}
+	return string(result), nil
+}
+
+/*
+Runs a deterministic operation, its result is reused when the same operation already ran with the same arguments
+over the same input. User functions are only cached when they implement function.IDeterministicFunction. run must
+return a packed error.
+*/
+func (this *IModule) cachedResult(operation string, run func() error, args ...any) error {
+	results := this.executorData.Results()
+	key, err := results.Fingerprint(operation, args...)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if key == "" {
+		return run()
+	}
+	for _, arg := range args {
+		if src, ok := arg.(*rpc.ISource); ok {
+			basefun, err := this.executorData.LoadLibrary(src)
+			if err != nil {
+				return this.PackError(err)
+			}
+			if fun, ok := basefun.(function.IDeterministicFunction); !ok || !fun.Deterministic() {
+				return run()
+			}
+		}
+	}
+	group, partitioner, hit, err := results.Get(key)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if hit {
+		logger.Info("ResultCache: reusing the result of ", operation)
+		this.executorData.SetPartitionsAny(group)
+		this.executorData.SetPartitioner(partitioner)
+		results.SetInput(key)
+		return nil
+	}
+	if err = run(); err != nil {
+		return err
+	}
+	if err = results.Put(key, this.executorData.GetPartitionsAny(), this.executorData.Partitioner()); err != nil {
+		return this.PackError(err)
+	}
+	results.SetInput(key)
+	return nil
+}
+
 func (this *IModule) moduleRecover(err *error) {
 	if r := recover(); r != nil {
 		if err2, ok := r.(error); ok {
-			*err = ierror.Raise(err2)
+			*err = this.PackError(ierror.RaiseCodeCause(ierror.CodeUserPanic, err2.Error(), err2))
 			return
 		}
 		if msg, ok := r.(string); ok {
-			*err = ierror.RaiseMsg(msg)
+			*err = this.PackError(ierror.RaiseCode(ierror.CodeUserPanic, msg))
 			return
 		}
-		*err = ierror.RaiseMsg(fmt.Sprint(r))
+		*err = this.PackError(ierror.RaiseCode(ierror.CodeUserPanic, fmt.Sprint(r)))
 	}
 }
@@ -1,15 +1,22 @@
 package impl
 
 import (
+	"encoding/binary"
 	"ignis/executor/api"
 	"ignis/executor/api/ipair"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/impi"
 	"ignis/executor/core/ithreads"
+	"ignis/executor/core/itransport"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
 type IBaseImpl struct {
@@ -26,6 +33,7 @@ func (this *IBaseImpl) Base() *IBaseImpl {
 
 func Exchange[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T]) error {
 	executors := this.executorData.Mpi().Executors()
+	exchangeLineage(this, executors, in.Size())
 	if executors == 1 {
 		for _, part := range in.Iter() {
 			if err := part.Fit(); err != nil {
@@ -36,41 +44,52 @@ func Exchange[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *stora
 		return nil
 	}
 
+	/*Received partitions take about as much memory as the partitions sent*/
+	bytes := int64(0)
+	for _, part := range in.Iter() {
+		bytes += part.Bytes()
+	}
+	granted, err := this.executorData.Memory().AcquireExecution(bytes, nil)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	defer this.executorData.Memory().ReleaseExecution(granted)
+
+	plan := this.executorData.Lineage().Operation() + ":" + strconv.Itoa(in.Size())
+	if delta, err := this.executorData.GetProperties().ExchangeDelta(); err != nil {
+		return ierror.Raise(err)
+	} else if delta {
+		exchange := strconv.Itoa(this.executorData.Lineage().NextExchange())
+		if state := this.executorData.ExchangeDelta(plan + ":" + exchange); state != nil {
+			logger.Info("Base: using delta exchange")
+			return exchangeDelta[T](this, in, out, state)
+		}
+	}
+
 	tp, err := this.executorData.GetProperties().ExchangeType()
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	var sync bool
-	if tp == "sync" {
-		sync = true
-	} else if tp == "async" {
-		sync = false
-	} else {
-		logger.Info("Base: detecting exchange type")
-		data := []impi.C_int64{impi.C_int64(in.Size()), 0}
-		for _, part := range in.Iter() {
-			if part.Empty() {
-				data[1]++
+	if tp != "ring" && tp != "sync" && tp != "async" && tp != "alltoall" {
+		if planned, present := this.executorData.ExchangePlan(plan); present {
+			logger.Info("Base: reusing exchange type of the previous iteration")
+			tp = planned
+		} else {
+			logger.Info("Base: detecting exchange type")
+			if tp, err = exchangeDetect[T](this, in); err != nil {
+				return ierror.Raise(err)
 			}
+			this.executorData.SetExchangePlan(plan, tp)
 		}
-		rank := this.executorData.Mpi().Rank()
-		if err := impi.MPI_Reduce(utils.Ternary(rank == 0, impi.MPI_IN_PLACE, impi.P(&data[0])), impi.P(&data[0]), 2,
-			impi.MPI_LONG, impi.MPI_SUM, 0, this.executorData.Mpi().Native()); err != nil {
-			return ierror.Raise(err)
-		}
-		if this.executorData.Mpi().IsRoot(0) {
-			n := int(data[0])
-			nZero := int(data[1])
-			sync = nZero < (n / executors)
-		}
-		aux := impi.C_int8(utils.Ternary(sync, 1, 0))
-		if err := impi.MPI_Bcast(impi.P(&aux), 1, impi.MPI_BYTE, 0, this.executorData.Mpi().Native()); err != nil {
-			return ierror.Raise(err)
-		}
-		sync = aux != 0
 	}
 
-	if sync {
+	if tp == "ring" {
+		logger.Info("Base: using ring exchange")
+		return exchangeRing[T](this, in, out)
+	} else if tp == "alltoall" {
+		logger.Info("Base: using alltoall exchange")
+		return exchangeAlltoall[T](this, in, out)
+	} else if tp == "sync" {
 		logger.Info("Base: using synchronous exchange")
 		return exchangeSync[T](this, in, out)
 	} else {
@@ -79,9 +98,115 @@ func Exchange[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *stora
 	}
 }
 
-func exchangeSync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T]) error {
+/*Every exchange type leaves the same contiguous range of partitions in each executor, built from all executors*/
+func exchangeLineage(this *IBaseImpl, executors int, numPartitions int) {
+	lineage := this.executorData.Lineage()
+	owned := exchangeRanges(executors, numPartitions)[this.executorData.Mpi().Rank()]
+	sources := make([]int, executors)
+	for i := range sources {
+		sources[i] = i
+	}
+	records := make([]core.ILineageRecord, 0, owned.Second-owned.First)
+	for p := owned.First; p < owned.Second; p++ {
+		records = append(records, core.ILineageRecord{Operation: lineage.Operation(), Inputs: []int64{p}, Executors: sources})
+	}
+	lineage.Record(records)
+}
+
+var exchangeTypes = []string{"sync", "async", "ring"}
+
+/*
+Cost model used to choose the exchange, a message costs ignis.modules.exchange.latency seconds and bytes move at
+ignis.modules.exchange.bandwidth. Ring is estimated but only selected explicitly.
+*/
+func exchangeDetect[T any](this *IBaseImpl, in *storage.IPartitionGroup[T]) (string, error) {
 	executors := this.executorData.Mpi().Executors()
-	numPartitions := in.Size()
+	latency, err := this.executorData.GetProperties().ExchangeLatency()
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	bandwidth, err := this.executorData.GetProperties().ExchangeBandwidth()
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	speed := float64(utils.Max(bandwidth, 1))
+	data := []impi.C_int64{impi.C_int64(in.Size()), 0, 0}
+	for _, part := range in.Iter() {
+		if part.Empty() {
+			data[1]++
+		} else {
+			data[2] += impi.C_int64(part.Bytes())
+		}
+	}
+	maxBytes := data[2]
+	rank := this.executorData.Mpi().Rank()
+	if err := impi.MPI_Reduce(utils.Ternary(rank == 0, impi.MPI_IN_PLACE, impi.P(&data[0])), impi.P(&data[0]), 3,
+		impi.MPI_LONG, impi.MPI_SUM, 0, this.executorData.Mpi().Native()); err != nil {
+		return "", ierror.Raise(err)
+	}
+	if err := impi.MPI_Reduce(utils.Ternary(rank == 0, impi.MPI_IN_PLACE, impi.P(&maxBytes)), impi.P(&maxBytes), 1,
+		impi.MPI_LONG, impi.MPI_MAX, 0, this.executorData.Mpi().Native()); err != nil {
+		return "", ierror.Raise(err)
+	}
+	choice := impi.C_int8(0)
+	if this.executorData.Mpi().IsRoot(0) {
+		e := float64(executors)
+		// every executor holds all the partitions of the exchange
+		n := float64(data[0]) / e
+		nonEmpty := float64(data[0] - data[1])
+		total := float64(data[2])
+		costs := []float64{
+			// every partition is a collective gather, each executor receives an even share of the bytes
+			n*math.Ceil(math.Log2(e))*latency + total/e/speed,
+			// empty pairs are skipped after a handshake, the most loaded executor sets the pace
+			(e-1+nonEmpty)*latency + float64(maxBytes)/speed,
+			// no handshakes or skips, steps are pipelined between neighbours
+			n*latency + float64(maxBytes)/speed,
+		}
+		for i := range costs[:2] {
+			if costs[i] < costs[choice] {
+				choice = impi.C_int8(i)
+			}
+		}
+		imbalance := 0.0
+		if total > 0 {
+			imbalance = float64(maxBytes) * e / total
+		}
+		logger.Info("Base: exchange of ", int64(n), " partitions (", data[1], " empty pairs), ", data[2],
+			" bytes, imbalance ", strconv.FormatFloat(imbalance, 'f', 2, 64), ", costs sync=", costs[0], " async=", costs[1], " ring=", costs[2],
+			" selects ", exchangeTypes[choice])
+	}
+	if err := impi.MPI_Bcast(impi.P(&choice), 1, impi.MPI_BYTE, 0, this.executorData.Mpi().Native()); err != nil {
+		return "", ierror.Raise(err)
+	}
+	return exchangeTypes[choice], nil
+}
+
+/*Shapes of exchangeSync schedules kept, the cache is emptied when it is full*/
+const syncScheduleCacheMax = 64
+
+type iSyncScheduleKey struct {
+	numPartitions int
+	executors     int
+}
+
+var syncSchedules = struct {
+	sync.Mutex
+	cache map[iSyncScheduleKey][]ipair.IPair[int64, int64]
+}{cache: map[iSyncScheduleKey][]ipair.IPair[int64, int64]{}}
+
+/*
+Gather order of exchangeSync, each pair is a partition and the executor that receives it. Consecutive partitions of an
+executor are interleaved with the partitions of the others, so every step gathers to a different root. The schedule
+only depends on its shape and is shared between exchanges, so it must not be modified.
+*/
+func exchangeSyncSchedule(numPartitions int, executors int) []ipair.IPair[int64, int64] {
+	key := iSyncScheduleKey{numPartitions, executors}
+	syncSchedules.Lock()
+	defer syncSchedules.Unlock()
+	if schedule, ok := syncSchedules.cache[key]; ok {
+		return schedule
+	}
 	block := numPartitions / executors
 	remainder := numPartitions % executors
 	var partsTargets []ipair.IPair[int64, int64]
@@ -110,28 +235,105 @@ func exchangeSync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *s
 		}
 		partsTargets = aux
 	}
+	if len(syncSchedules.cache) >= syncScheduleCacheMax {
+		syncSchedules.cache = map[iSyncScheduleKey][]ipair.IPair[int64, int64]{}
+	}
+	syncSchedules.cache[key] = partsTargets
+	return partsTargets
+}
+
+/*Copy of the exchangeSync schedule for numPartitions partitions in the current executors, used to verify routing*/
+func (this *IBaseImpl) ExchangeSchedule(numPartitions int64) []ipair.IPair[int64, int64] {
+	schedule := exchangeSyncSchedule(int(numPartitions), this.executorData.Mpi().Executors())
+	return append([]ipair.IPair[int64, int64]{}, schedule...)
+}
+
+func exchangeSync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T]) error {
+	executors := this.executorData.Mpi().Executors()
+	metrics := this.executorData.Metrics()
+	numPartitions := in.Size()
+	partsTargets := exchangeSyncSchedule(numPartitions, executors)
+
+	/*Sorted partitions are merged when they are received, so each target keeps a single sorted partition*/
+	less := in.SortedBy()
+	var run int64
+	var unmerged atomic.Bool
+	if less != nil {
+		var err error
+		if run, err = this.executorData.GetProperties().SortRun(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
 
 	if err := this.executorData.EnableMpiCores(); err != nil {
 		return ierror.Raise(err)
 	}
 	mpiCores := this.executorData.GetMpiCores()
+	tuner := this.executorData.ExchangeTuner()
+	if adaptive, err := tuner.Enabled(); err != nil {
+		return ierror.Raise(err)
+	} else if adaptive && mpiCores > 1 {
+		threads, err := tuner.Threads(core.ExchangeAllPeers, mpiCores)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		/*Every executor measures its own throughput, the fewest threads are used by all*/
+		agreed := impi.C_int(threads)
+		if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&agreed), 1, impi.MPI_INT, impi.MPI_MIN,
+			this.executorData.Mpi().Native()); err != nil {
+			return ierror.Raise(err)
+		}
+		mpiCores = int(agreed)
+	}
 
+	// Gathers are collective, so every executor must run the same partitions on the same thread. Weights use the
+	// global partition sizes to keep the schedule identical everywhere while balancing skewed partitions.
+	var weights []int64
+	if mpiCores > 1 && numPartitions > 0 {
+		sizes := make([]impi.C_int64, numPartitions)
+		for i := 0; i < numPartitions; i++ {
+			sizes[i] = impi.C_int64(in.Get(i).Bytes())
+		}
+		if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&sizes[0]), impi.C_int(numPartitions), impi.MPI_LONG,
+			impi.MPI_SUM, this.executorData.Mpi().Native()); err != nil {
+			return ierror.Raise(err)
+		}
+		weights = make([]int64, len(partsTargets))
+		for i, target := range partsTargets {
+			weights[i] = int64(sizes[target.First])
+		}
+	}
+
+	var transferred int64
+	start := time.Now()
 	if err := ithreads.ParallelT(mpiCores, func(rctx ithreads.IRuntimeContext) error {
 		mpi := core.NewIMpi(this.executorData.GetProperties(),
 			this.executorData.GetPartitionTools(),
 			this.executorData.GetThreadContext(rctx.ThreadId()))
 
-		return rctx.For().Static().Run(numPartitions, func(i int) error {
+		return rctx.For().Weighted(weights).Run(numPartitions, func(i int) error {
 			p := partsTargets[i].First
 			target := partsTargets[i].Second
+			bytes := in.Get(int(p)).Bytes()
 			if err := core.Gather(mpi, in.Get(int(p)), int(target)); err != nil {
 				return ierror.Raise(err)
 			}
 			if mpi.IsRoot(int(target)) {
+				atomic.AddInt64(&transferred, in.Get(int(p)).Bytes()-bytes)
+				metrics.Transfer(int(target), false, in.Get(int(p)).Bytes()-bytes)
 				if err := in.Get(int(p)).Fit(); err != nil {
 					return ierror.Raise(err)
 				}
+				if less != nil {
+					if merged, err := mergeSortedRuns(in.Get(int(p)), less, run); err != nil {
+						return ierror.Raise(err)
+					} else if !merged {
+						unmerged.Store(true)
+					}
+				}
 			} else {
+				atomic.AddInt64(&transferred, bytes)
+				metrics.Transfer(int(target), true, bytes)
 				in.SetBase(int(p), nil)
 			}
 			return nil
@@ -139,12 +341,18 @@ func exchangeSync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *s
 	}); err != nil {
 		return ierror.Raise(err)
 	}
+	if err := tuner.Observe(core.ExchangeAllPeers, mpiCores, transferred, int64(numPartitions), time.Since(start)); err != nil {
+		return ierror.Raise(err)
+	}
 
 	for i := 0; i < numPartitions; i++ {
 		if in.Get(i) != nil {
 			out.Add(in.Get(i))
 		}
 	}
+	if less != nil && !unmerged.Load() {
+		out.SetSorted(less)
+	}
 	in.Clear()
 	return nil
 }
@@ -152,24 +360,11 @@ func exchangeSync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *s
 func exchangeAsync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T]) error {
 	executors := this.executorData.Mpi().Executors()
 	rank := this.executorData.Mpi().Rank()
+	metrics := this.executorData.Metrics()
 	numPartitions := in.Size()
-	block := numPartitions / executors
-	remainder := numPartitions % executors
-	var ranges []ipair.IPair[int64, int64]
+	ranges := exchangeRanges(executors, numPartitions)
 	var queue []int64
 
-	var init, end int64
-	for i := 0; i < executors; i++ {
-		if i < remainder {
-			init = int64((block + 1) * i)
-			end = init + int64(block+1)
-		} else {
-			init = int64((block+1)*remainder + block*(i-remainder))
-			end = init + int64(block)
-		}
-		ranges = append(ranges, *ipair.New(init, end))
-	}
-
 	m := utils.Ternary(executors%2 == 0, executors, executors+1)
 	id := 0
 	id2 := m*m - 2
@@ -193,12 +388,37 @@ func exchangeAsync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *
 		}
 	}
 
+	block, err := this.executorData.GetProperties().ExchangeBlock()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	inflight, err := this.executorData.GetProperties().ExchangeInflight()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if block > 0 {
+		logger.Info("Base: streaming partitions in blocks of ", block, " bytes")
+	}
+	attempts, err := this.executorData.GetProperties().ExchangeRecompute()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	/*Sent partitions are kept and received partitions are merged only when complete, so a failed transfer can be
+	recomputed from its source instead of aborting the exchange*/
+	recompute := attempts > 0
+	lost := make([]impi.C_int, utils.Ternary(recompute, executors*numPartitions, 0))
+	sent := make([]bool, numPartitions)
+
 	if err := this.executorData.EnableMpiCores(); err != nil {
 		return ierror.Raise(err)
 	}
 	mpiCores := this.executorData.GetMpiCores()
+	tuner := this.executorData.ExchangeTuner()
 
 	ignores := make([]bool, len(queue))
+	/*Both executors of a pair must split their partitions between the same threads*/
+	peerThreads := make([]int, len(queue))
+	peerBytes := make([]int64, len(queue))
 
 	if err := ithreads.ParallelT(mpiCores, func(rctx ithreads.IRuntimeContext) error {
 		mpi := core.NewIMpi(this.executorData.GetProperties(),
@@ -220,6 +440,18 @@ func exchangeAsync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *
 				return ierror.Raise(err)
 			}
 
+			threads, err := tuner.Threads(int(other), mpiCores)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			proposal := impi.C_int(threads)
+			var proposalOther impi.C_int
+			if err := impi.MPI_Sendrecv(impi.P(&proposal), 1, impi.MPI_INT, impi.C_int(other), 0, impi.P(&proposalOther), 1,
+				impi.MPI_INT, impi.C_int(other), 0, mpi.Native(), impi.MPI_STATUS_IGNORE); err != nil {
+				return ierror.Raise(err)
+			}
+			peerThreads[i] = int(utils.Min(proposal, proposalOther))
+
 			if ignore != 0 && ignoreOther != 0 {
 				ignores[i] = true
 				for j := ranges[other].First; j < ranges[other].Second; j++ {
@@ -243,44 +475,489 @@ func exchangeAsync[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *
 			meEnd := ranges[rank].Second
 			its := int(utils.Max(otherEnd-otherPart, meEnd-mePart))
 
-			err := rctx.For().Static().Chunk(1).Run(its, func(j int) error {
+			start := time.Now()
+			err := rctx.For().Static().Threads(peerThreads[i]).Chunk(1).Run(its, func(j int) (err error) {
 				mepart := ranges[rank].First + int64(j)
 				otherPart := ranges[other].First + int64(j)
+				var sentBytes, received int64
+				var target storage.IPartition[T]
+				if otherPart < otherEnd {
+					sentBytes = in.Get(int(otherPart)).Bytes()
+				}
+				if mepart < meEnd {
+					target = in.Get(int(mepart))
+					received = -target.Bytes()
+					if recompute {
+						if target, err = core.NewPartitionDef[T](this.executorData.GetPartitionTools()); err != nil {
+							return ierror.Raise(err)
+						}
+						received = 0
+					}
+				}
 				if otherPart >= otherEnd || mepart >= meEnd {
 					if otherPart >= otherEnd {
-						if err := core.Recv(mpi, in.Get(int(mepart)), int(other), 0); err != nil {
-							return ierror.Raise(err)
+						if block > 0 {
+							err = core.RecvStream(mpi, target, int(other), 0, block)
+						} else {
+							err = core.Recv(mpi, target, int(other), 0)
+						}
+						if err != nil {
+							return exchangeLost(lost, numPartitions, mepart, other, err)
 						}
 					} else if mepart >= meEnd {
-						if err := core.Send(mpi, in.Get(int(otherPart)), int(other), 0); err != nil {
-							return ierror.Raise(err)
+						if block > 0 {
+							err = core.SendStream(mpi, in.Get(int(otherPart)), int(other), 0, block, inflight)
+						} else {
+							err = core.Send(mpi, in.Get(int(otherPart)), int(other), 0)
+						}
+						if err != nil {
+							return exchangeLost(lost, numPartitions, -1, other, exchangeError(otherPart, other, err))
 						}
 					} else {
 						return nil
 					}
 				} else {
-					if err := core.SendRcv(mpi, in.Get(int(otherPart)), in.Get(int(mepart)), int(other), 0); err != nil {
-						return ierror.Raise(err)
+					if block > 0 {
+						err = core.SendRcvStream(mpi, in.Get(int(otherPart)), target, int(other), 0, block, inflight)
+					} else {
+						err = core.SendRcv(mpi, in.Get(int(otherPart)), target, int(other), 0)
+					}
+					if err != nil {
+						return exchangeLost(lost, numPartitions, mepart, other, err)
 					}
 				}
-				in.SetBase(int(otherPart), nil)
+				if otherPart < otherEnd {
+					atomic.AddInt64(&peerBytes[i], sentBytes)
+					metrics.Transfer(int(other), true, sentBytes)
+				}
+				if mepart < meEnd {
+					atomic.AddInt64(&peerBytes[i], received+target.Bytes())
+					metrics.Transfer(int(other), false, received+target.Bytes())
+					if recompute {
+						if err = in.Get(int(mepart)).CopyFrom(target); err != nil {
+							return exchangeLost(lost, numPartitions, mepart, other, err)
+						}
+					}
+				}
+				if recompute && otherPart < otherEnd {
+					sent[otherPart] = true
+				} else {
+					in.SetBase(int(otherPart), nil)
+				}
 				return nil
 			})
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			if rctx.ThreadId() == 0 {
+				if err = tuner.Observe(int(other), peerThreads[i], peerBytes[i], int64(its), time.Since(start)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
 		}
 		return nil
 	}); err != nil {
 		return ierror.Raise(err)
 	}
 
-	for i := 0; i < numPartitions; i++ {
-		if in.Get(i) != nil {
-			out.Add(in.Get(i))
+	finish := func() {
+		for i := 0; i < numPartitions; i++ {
+			if sent[i] {
+				in.SetBase(i, nil)
+			}
+			if in.Get(i) != nil {
+				out.Add(in.Get(i))
+			}
+		}
+		in.Clear()
+	}
+	if recompute {
+		still, err := exchangeRecompute[T](this, in, ranges, lost, attempts)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if len(still) > 0 {
+			this.executorData.Lineage().Lost(still, func() ([]int, error) {
+				still, err := exchangeRecompute[T](this, in, ranges, lost, 1)
+				if err != nil || len(still) > 0 {
+					return still, err
+				}
+				finish()
+				core.SetPartitions(this.executorData, out)
+				return nil, nil
+			})
+			return ierror.RaiseMsg(strconv.Itoa(len(still)) + " partitions were lost in the exchange, they can be " +
+				"recomputed with RecomputePartitions")
+		}
+	}
+	finish()
+	return nil
+}
+
+/*Without recomputation the error aborts the exchange, otherwise the receiver marks the partition as lost*/
+func exchangeLost(lost []impi.C_int, numPartitions int, part int64, other int64, err error) error {
+	if len(lost) == 0 {
+		return exchangeError(part, other, err)
+	}
+	logger.Warn("Base: ", exchangeError(part, other, err))
+	if part >= 0 {
+		lost[int(other)*numPartitions+int(part)] = 1
+	}
+	return nil
+}
+
+/*
+Lost transfers are agreed by all executors and sent again from the kept source partitions in the same order
+everywhere, so every send meets its receive. Returns the local partitions that are still lost after the attempts.
+*/
+func exchangeRecompute[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], ranges []ipair.IPair[int64, int64],
+	lost []impi.C_int, attempts int64) ([]int, error) {
+	mpi := this.executorData.Mpi()
+	rank := mpi.Rank()
+	numPartitions := in.Size()
+	if len(lost) == 0 {
+		return nil, nil
+	}
+	for attempt := int64(0); ; attempt++ {
+		if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&lost[0]), impi.C_int(len(lost)), impi.MPI_INT,
+			impi.MPI_MAX, mpi.Native()); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		var pending []int
+		for i, l := range lost {
+			if l != 0 {
+				pending = append(pending, i)
+			}
+		}
+		if len(pending) == 0 || attempt == attempts {
+			var still []int
+			for _, i := range pending {
+				if p := int64(i % numPartitions); p >= ranges[rank].First && p < ranges[rank].Second {
+					still = append(still, int(p-ranges[rank].First))
+				}
+			}
+			return still, nil
+		}
+		logger.Warn("Base: recomputing ", len(pending), " lost partitions, attempt ", attempt+1)
+		for _, i := range pending {
+			source, p := i/numPartitions, int64(i%numPartitions)
+			lost[i] = 0
+			if rank == source {
+				owner := 0
+				for ranges[owner].Second <= p {
+					owner++
+				}
+				if err := core.Send(mpi, in.Get(int(p)), owner, 0); err != nil {
+					logger.Warn("Base: ", exchangeError(p, int64(owner), err))
+				}
+			} else if p >= ranges[rank].First && p < ranges[rank].Second {
+				target, err := core.NewPartitionDef[T](this.executorData.GetPartitionTools())
+				if err != nil {
+					return nil, ierror.Raise(err)
+				}
+				if err = core.Recv(mpi, target, source, 0); err == nil {
+					err = in.Get(int(p)).CopyFrom(target)
+				}
+				if err != nil {
+					logger.Warn("Base: ", exchangeError(p, int64(source), err))
+					lost[i] = 1
+				}
+			}
 		}
 	}
+}
+
+func exchangeError(part int64, other int64, err error) error {
+	return ierror.RaiseMsgCause("exchange of partition "+strconv.FormatInt(part, 10)+" with executor "+
+		strconv.FormatInt(other, 10)+" failed", err)
+}
+
+func exchangeRanges(executors int, numPartitions int) []ipair.IPair[int64, int64] {
+	block := numPartitions / executors
+	remainder := numPartitions % executors
+	var ranges []ipair.IPair[int64, int64]
+
+	var init, end int64
+	for i := 0; i < executors; i++ {
+		if i < remainder {
+			init = int64((block + 1) * i)
+			end = init + int64(block+1)
+		} else {
+			init = int64((block+1)*remainder + block*(i-remainder))
+			end = init + int64(block)
+		}
+		ranges = append(ranges, *ipair.New(init, end))
+	}
+	return ranges
+}
+
+func exchangeRing[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T]) error {
+	executors := this.executorData.Mpi().Executors()
+	rank := this.executorData.Mpi().Rank()
+	numPartitions := in.Size()
+	ranges := exchangeRanges(executors, numPartitions)
+
+	mpi := this.executorData.Mpi()
+	metrics := this.executorData.Metrics()
+	var provided impi.C_int
+	if err := impi.MPI_Query_thread(&provided); err != nil {
+		return ierror.Raise(err)
+	}
+	send := func(dest int) error {
+		for p := ranges[dest].First; p < ranges[dest].Second; p++ {
+			sent := in.Get(int(p)).Bytes()
+			if err := core.Send(mpi, in.Get(int(p)), dest, int(p-ranges[dest].First)); err != nil {
+				return ierror.Raise(err)
+			}
+			metrics.Transfer(dest, true, sent)
+			in.SetBase(int(p), nil)
+		}
+		return nil
+	}
+	recv := func(source int) error {
+		for p := ranges[rank].First; p < ranges[rank].Second; p++ {
+			received := in.Get(int(p)).Bytes()
+			if err := core.Recv(mpi, in.Get(int(p)), source, int(p-ranges[rank].First)); err != nil {
+				return ierror.Raise(err)
+			}
+			metrics.Transfer(source, false, in.Get(int(p)).Bytes()-received)
+		}
+		return nil
+	}
+	for step := 1; step < executors; step++ {
+		dest := (rank + step) % executors
+		source := (rank - step + executors) % executors
+		if provided == impi.MPI_THREAD_MULTIPLE {
+			if err := ithreads.ParallelT(2, func(rctx ithreads.IRuntimeContext) error {
+				if rctx.ThreadId() == 0 {
+					return send(dest)
+				}
+				return recv(source)
+			}); err != nil {
+				return ierror.Raise(err)
+			}
+			continue
+		}
+		// same order as SendRcv, the higher rank of each pair sends first so every cycle of the step makes progress
+		if rank > dest {
+			if err := send(dest); err != nil {
+				return ierror.Raise(err)
+			}
+			if err := recv(source); err != nil {
+				return ierror.Raise(err)
+			}
+		} else {
+			if err := recv(source); err != nil {
+				return ierror.Raise(err)
+			}
+			if err := send(dest); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+	}
+
+	for p := ranges[rank].First; p < ranges[rank].Second; p++ {
+		if err := in.Get(int(p)).Fit(); err != nil {
+			return ierror.Raise(err)
+		}
+		out.Add(in.Get(int(p)))
+	}
 	in.Clear()
+	return nil
+}
+
+/*
+Round r sends the r-th partition of every destination range in a single collective, the next round is packed while
+the previous one is being transferred.
+*/
+func exchangeAlltoall[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T]) error {
+	executors := this.executorData.Mpi().Executors()
+	rank := this.executorData.Mpi().Rank()
+	numPartitions := in.Size()
+	ranges := exchangeRanges(executors, numPartitions)
+	rounds := ranges[0].Second - ranges[0].First
 
+	mpi := this.executorData.Mpi()
+	metrics := this.executorData.Metrics()
+	start := func(r int64) (*core.IAlltoall[T], error) {
+		send := make([]storage.IPartition[T], executors)
+		recv := make([]storage.IPartition[T], executors)
+		for i := 0; i < executors; i++ {
+			if i == rank {
+				continue
+			}
+			if p := ranges[i].First + r; p < ranges[i].Second {
+				send[i] = in.Get(int(p))
+			}
+			if p := ranges[rank].First + r; p < ranges[rank].Second {
+				recv[i] = in.Get(int(p))
+			}
+		}
+		return core.Ialltoall(mpi, send, recv)
+	}
+	var next *core.IAlltoall[T]
+	var err error
+	if rounds > 0 {
+		if next, err = start(0); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	for r := int64(0); r < rounds; r++ {
+		current := next
+		if r+1 < rounds {
+			if next, err = start(r + 1); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		if err = current.Wait(); err != nil {
+			return ierror.Raise(err)
+		}
+		for i := 0; i < executors; i++ {
+			if i == rank {
+				continue
+			}
+			if sent, received := current.Bytes(i); sent > 0 || received > 0 {
+				metrics.Transfer(i, true, sent)
+				metrics.Transfer(i, false, received)
+			}
+			if p := ranges[i].First + r; p < ranges[i].Second {
+				in.SetBase(int(p), nil)
+			}
+		}
+	}
+
+	for p := ranges[rank].First; p < ranges[rank].Second; p++ {
+		if err := in.Get(int(p)).Fit(); err != nil {
+			return ierror.Raise(err)
+		}
+		out.Add(in.Get(int(p)))
+	}
+	in.Clear()
+	return nil
+}
+
+/*
+Only the partitions changed since the previous iteration are sent, the copies received in the previous iteration take
+the place of the partitions that were not sent. A partition marked as clean in the input group is not even compared,
+the output partitions changed by any executor are marked as dirty.
+*/
+func exchangeDelta[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T],
+	delta *core.IExchangeDelta) error {
+	if err := exchangeDeltaImpl(this, in, out, delta); err != nil {
+		/*Executors can not agree on the copies of a failed exchange*/
+		delta.Reset()
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+func exchangeDeltaImpl[T any](this *IBaseImpl, in *storage.IPartitionGroup[T], out *storage.IPartitionGroup[T],
+	delta *core.IExchangeDelta) error {
+	mpi := this.executorData.Mpi()
+	executors := mpi.Executors()
+	rank := mpi.Rank()
+	numPartitions := in.Size()
+	ranges := exchangeRanges(executors, numPartitions)
+	cmp, err := this.executorData.GetProperties().MsgCompression()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	native, err := this.executorData.GetProperties().NativeSerialization()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	/*Serialized partition, nil if it did not change*/
+	serialize := func(p int) ([]byte, error) {
+		if !in.Dirty(p) && delta.Sent(numPartitions, p) {
+			return nil, nil
+		}
+		buffer := itransport.NewIMemoryBuffer()
+		if err := in.Get(p).WriteWithNative(buffer, cmp, native); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		data := buffer.Bytes()
+		changed := delta.Changed(numPartitions, p, data)
+		in.SetDirty(p, changed)
+		if !changed {
+			return nil, nil
+		}
+		return data, nil
+	}
+
+	for p := ranges[rank].First; p < ranges[rank].Second; p++ {
+		if _, err := serialize(int(p)); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	dirty := make([]bool, numPartitions)
+	metrics := this.executorData.Metrics()
+	for step := 1; step < executors; step++ {
+		dest := (rank + step) % executors
+		source := (rank - step + executors) % executors
+		var msg []byte
+		sent := 0
+		for p := ranges[dest].First; p < ranges[dest].Second; p++ {
+			data, err := serialize(int(p))
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if data == nil {
+				msg = binary.LittleEndian.AppendUint64(msg, math.MaxUint64)
+			} else {
+				msg = binary.LittleEndian.AppendUint64(msg, uint64(len(data)))
+				msg = append(msg, data...)
+				sent++
+			}
+			in.SetBase(int(p), nil)
+		}
+		logger.Info("Base: sending ", sent, " of ", ranges[dest].Second-ranges[dest].First, " partitions to executor ",
+			dest)
+		received, err := mpi.SendRcvBytes(msg, dest, source, 0)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		metrics.Transfer(dest, true, int64(len(msg)))
+		metrics.Transfer(source, false, int64(len(received)))
+		for p := ranges[rank].First; p < ranges[rank].Second; p++ {
+			if len(received) < 8 {
+				return ierror.RaiseMsg("Delta exchange message from executor " + strconv.Itoa(source) + " is truncated")
+			}
+			sz := binary.LittleEndian.Uint64(received)
+			received = received[8:]
+			if sz == math.MaxUint64 {
+				if delta.Received(numPartitions, int(p), source, executors) == nil {
+					return ierror.RaiseMsg("Partition " + strconv.FormatInt(p, 10) + " of executor " +
+						strconv.Itoa(source) + " was not received in the previous iteration")
+				}
+				continue
+			}
+			if uint64(len(received)) < sz {
+				return ierror.RaiseMsg("Delta exchange message from executor " + strconv.Itoa(source) + " is truncated")
+			}
+			delta.SetReceived(numPartitions, int(p), source, executors, received[:sz])
+			received = received[sz:]
+			dirty[p] = true
+		}
+	}
+
+	for p := ranges[rank].First; p < ranges[rank].Second; p++ {
+		part := in.Get(int(p))
+		for source := 0; source < executors; source++ {
+			if source == rank {
+				continue
+			}
+			data := delta.Received(numPartitions, int(p), source, executors)
+			if err := part.Read(itransport.NewIMemoryBufferWrapper(data, int64(len(data)), itransport.OBSERVE)); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		if err := part.Fit(); err != nil {
+			return ierror.Raise(err)
+		}
+		out.Add(part)
+		out.SetDirty(out.Size()-1, dirty[p] || in.Dirty(int(p)))
+	}
+	in.Clear()
 	return nil
 }
@@ -0,0 +1,147 @@
+package impl
+
+import (
+	"ignis/executor/api/iterator"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/ithreads"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"ignis/executor/core/utils"
+	"math"
+	"sync/atomic"
+)
+
+/*
+Bloom filter shared by all executors. Each word keeps 16 bits in the low half, set when any executor has an element
+with that bit, and the same 16 bits in the high half, set when at least two executors have it.
+*/
+type iBloomFilter struct {
+	words  []uint32
+	bits   uint64
+	hashes int
+}
+
+func newIBloomFilter(elems int64, bitsPerElem int64) *iBloomFilter {
+	bits := utils.Max(uint64(elems)*uint64(bitsPerElem), 64)
+	bits = utils.Min((bits+15)/16, math.MaxInt32) * 16
+	hashes := int(math.Round(float64(bitsPerElem) * math.Ln2))
+	return &iBloomFilter{
+		words:  make([]uint32, bits/16),
+		bits:   bits,
+		hashes: utils.Max(utils.Min(hashes, 8), 1),
+	}
+}
+
+/*Double hashing, the second hash is derived from the first one and forced to be odd*/
+func (this *iBloomFilter) positions(hash uint64, f func(word uint64, bit uint32) bool) bool {
+	h2 := ((hash ^ (hash >> 31)) * 0xbf58476d1ce4e5b9) | 1
+	for i := 0; i < this.hashes; i++ {
+		pos := (hash + uint64(i)*h2) % this.bits
+		if !f(pos/16, 1<<(pos%16)) {
+			return false
+		}
+	}
+	return true
+}
+
+/*Safe to call from several threads*/
+func (this *iBloomFilter) add(hash uint64) {
+	this.positions(hash, func(word uint64, bit uint32) bool {
+		for {
+			old := atomic.LoadUint32(&this.words[word])
+			if old&bit != 0 || atomic.CompareAndSwapUint32(&this.words[word], old, old|bit) {
+				return true
+			}
+		}
+	})
+}
+
+/*The element may also be in the partitions of other executor*/
+func (this *iBloomFilter) shared(hash uint64) bool {
+	return this.positions(hash, func(word uint64, bit uint32) bool {
+		return this.words[word]&(bit<<16) != 0
+	})
+}
+
+/*
+Removes from parts the elements that no other executor can have, they are already distinct and are returned in
+their own partitions, so only the candidates to be duplicated are exchanged. The elements of each partition must be
+locally distinct.
+*/
+func bloomUnique[T comparable](this *IReduceImpl, parts *storage.IPartitionGroup[T], bitsPerElem int64) (*storage.IPartitionGroup[T], error) {
+	elems := int64(0)
+	for _, part := range parts.Iter() {
+		elems += part.Size()
+	}
+	if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&elems), 1, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		this.executorData.Mpi().Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	filter := newIBloomFilter(elems, bitsPerElem)
+	logger.Info("Reduce: building a bloom filter of ", filter.bits, " bits for ", elems, " elements")
+	hasher := utils.GetHasher(utils.TypeObj[T]())
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(parts.Size(), func(p int) error {
+			reader, err := parts.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				filter.add(utils.Hash(elem, hasher))
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := this.executorData.Mpi().AllReduceBloom(filter.words); err != nil {
+		return nil, ierror.Raise(err)
+	}
+
+	unique, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), parts.Size())
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	candidates := int64(0)
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(parts.Size(), func(p int) error {
+			shared, err := core.NewPartitionDef[T](this.executorData.GetPartitionTools())
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			reader, err := parts.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writers := [2]iterator.IWriteIterator[T]{}
+			if writers[0], err = unique.Get(p).WriteIterator(); err != nil {
+				return ierror.Raise(err)
+			}
+			if writers[1], err = shared.WriteIterator(); err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writers[utils.Ternary(filter.shared(utils.Hash(elem, hasher)), 1, 0)].Write(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			atomic.AddInt64(&candidates, shared.Size())
+			parts.Set(p, shared)
+			return unique.Get(p).Fit()
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	logger.Info("Reduce: ", candidates, " elements may be duplicated in other executors")
+	return unique, nil
+}
@@ -0,0 +1,141 @@
+package impl
+
+import (
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/ithreads"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+)
+
+type IBoundary[T any] struct {
+	Previous []T
+	Next     []T
+}
+
+func Boundaries[T any](this *IBaseImpl, input *storage.IPartitionGroup[T], k int) ([]IBoundary[T], error) {
+	if k < 1 {
+		return nil, ierror.RaiseMsg("boundary exchange requires at least one element")
+	}
+	heads := make([][]T, input.Size())
+	tails := make([][]T, input.Size())
+	logger.Info("General: reading boundaries of ", input.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			ring := make([]T, 0, k)
+			n := 0
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if n < k {
+					heads[p] = append(heads[p], elem)
+					ring = append(ring, elem)
+				} else {
+					ring[n%k] = elem
+				}
+				n++
+			}
+			if n > k {
+				ring = append(ring[n%k:], ring[:n%k]...)
+			}
+			tails[p] = ring
+			return nil
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+
+	logger.Info("General: exchanging boundaries with neighbor executors")
+	prev, err := boundaryExchange(this, lastK(tails, k), func(chunks [][]T, rank int) []T {
+		return lastK(chunks[:rank], k)
+	})
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	next, err := boundaryExchange(this, firstK(heads, k), func(chunks [][]T, rank int) []T {
+		return firstK(chunks[rank+1:], k)
+	})
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+
+	boundaries := make([]IBoundary[T], input.Size())
+	for p := range boundaries {
+		boundaries[p].Previous = lastK(append([][]T{prev}, tails[:p]...), k)
+		boundaries[p].Next = firstK(append(append([][]T{}, heads[p+1:]...), next), k)
+	}
+	return boundaries, nil
+}
+
+func boundaryExchange[T any](this *IBaseImpl, local []T, f func(chunks [][]T, rank int) []T) ([]T, error) {
+	mpi := this.executorData.Mpi()
+	counts := make([]impi.C_int64, mpi.Executors())
+	count := impi.C_int64(len(local))
+	if err := impi.MPI_Allgather(impi.P(&count), 1, impi.MPI_LONG_LONG_INT, impi.P(&counts[0]), 1,
+		impi.MPI_LONG_LONG_INT, mpi.Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	part, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), int64(len(local)))
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	writer, err := part.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	for _, elem := range local {
+		if err = writer.Write(elem); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	if err = core.Gather[T](mpi, part, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err = core.Bcast[T](mpi, part, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	all := part.Inner().(*storage.IListImpl[T]).Array().([]T)
+	chunks := make([][]T, len(counts))
+	offset := 0
+	for i, c := range counts {
+		chunks[i] = all[offset : offset+int(c)]
+		offset += int(c)
+	}
+	return append([]T{}, f(chunks, mpi.Rank())...), nil
+}
+
+func firstK[T any](chunks [][]T, k int) []T {
+	result := make([]T, 0, k)
+	for _, chunk := range chunks {
+		for _, elem := range chunk {
+			if len(result) == k {
+				return result
+			}
+			result = append(result, elem)
+		}
+	}
+	return result
+}
+
+func lastK[T any](chunks [][]T, k int) []T {
+	n := 0
+	i := len(chunks) - 1
+	for ; i >= 0 && n < k; i-- {
+		n += len(chunks[i])
+	}
+	result := make([]T, 0, k)
+	for _, chunk := range chunks[i+1:] {
+		result = append(result, chunk...)
+	}
+	if len(result) > k {
+		result = result[len(result)-k:]
+	}
+	return result
+}
@@ -2,14 +2,18 @@ package impl
 
 import (
 	"bufio"
+	"encoding/binary"
 	"errors"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/itransport"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
 	"io/fs"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -18,16 +22,30 @@ type ICacheImpl struct {
 	IBaseImpl
 	nextContextId int64
 	context       map[int64]storage.IPartitionGroupBase
+	contextLayout map[int64]core.IKeyLayout
 	cache         map[int64]storage.IPartitionGroupBase
+	memory        map[int64]int64
+	replicas      map[int64]*iReplication
+	replicaComm   impi.C_MPI_Comm
+	replicaGroup  impi.C_MPI_Comm
+	lastReplica   *iReplication
+	iterative     map[int64]string
 }
 
 func NewICacheImpl(executorData *core.IExecutorData) *ICacheImpl {
-	return &ICacheImpl{
+	this := &ICacheImpl{
 		IBaseImpl:     IBaseImpl{executorData},
 		nextContextId: 11,
 		context:       make(map[int64]storage.IPartitionGroupBase),
+		contextLayout: make(map[int64]core.IKeyLayout),
 		cache:         make(map[int64]storage.IPartitionGroupBase),
+		memory:        make(map[int64]int64),
+		replicas:      make(map[int64]*iReplication),
+		replicaComm:   impi.MPI_COMM_NULL,
+		iterative:     make(map[int64]string),
 	}
+	executorData.Memory().AddEvictor(this.evict)
+	return this
 }
 
 func (this *ICacheImpl) fileCache() (string, error) {
@@ -54,6 +72,7 @@ func (this *ICacheImpl) SaveContext() (int64, error) {
 	}
 	logger.Info("CacheContext: saving context ", id)
 	this.context[id] = this.executorData.GetPartitionsAny()
+	this.contextLayout[id] = this.executorData.KeyLayout()
 	return id, nil
 }
 
@@ -72,6 +91,7 @@ func (this *ICacheImpl) LoadContext(id int64) error {
 	value, present := this.context[id]
 	if present && value == this.executorData.GetPartitionsAny() {
 		delete(this.context, id)
+		delete(this.contextLayout, id)
 		return nil
 	}
 	logger.Info("CacheContext: loading context ", id)
@@ -80,8 +100,10 @@ func (this *ICacheImpl) LoadContext(id int64) error {
 		return ierror.RaiseMsg("context " + strconv.FormatInt(id, 10) + " not found")
 	}
 	this.executorData.SetPartitionsAny(value)
+	this.executorData.SetKeyLayout(this.contextLayout[id])
 	this.executorData.ClearVariables()
 	delete(this.context, id)
+	delete(this.contextLayout, id)
 	return nil
 }
 
@@ -93,158 +115,544 @@ func (this *ICacheImpl) LoadContextAsVariable(id int64, name string) error {
 		return ierror.RaiseMsg("context " + strconv.FormatInt(id, 10) + " not found")
 	}
 	core.SetVariable[storage.IPartitionGroupBase](this.executorData, name, value)
+	this.executorData.SetVariableKeyLayout(name, this.contextLayout[id])
 	delete(this.context, id)
+	delete(this.contextLayout, id)
 	return nil
 }
 
+/*
+Cache levels, cacheReplicated can be combined with any level to keep a copy in the next executor and cacheIterative
+to pin the partitions and keep their key distribution while a loop reuses them
+*/
+const (
+	cacheNone             int8 = 0
+	cachePreserve         int8 = 1
+	cacheMemory           int8 = 2
+	cacheRawMemory        int8 = 3
+	cacheDisk             int8 = 4
+	cacheMemoryAndDisk    int8 = 5
+	cacheRawMemoryAndDisk int8 = 6
+	cacheReplicated       int8 = 0x10
+	cacheIterative        int8 = 0x20
+)
+
 func (this *ICacheImpl) Cache(id int64, level int8) error {
-	if level == 0 { // NO_CACHE
-		value, present := this.cache[id]
-		if !present {
-			logger.Warn("CacheContext: removing non existent cache " + strconv.FormatInt(id, 10))
-			return nil
-		}
-		delete(this.cache, id)
-		if this.executorData.GetPartitionTools().IsDiskGroup(value) {
-			cache, err := this.fileCache()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			found := false
-			var lines []string
+	this.executorData.Results().Clear()
+	if level == cacheNone {
+		return this.uncache(id)
+	}
 
-			file, err := os.Open(cache)
-			if err != nil {
+	tools := this.executorData.GetPartitionTools()
+	groupCache := this.executorData.GetPartitionsAny()
+	replicated := level&cacheReplicated != 0
+	iterative := level&cacheIterative != 0
+	level &^= cacheReplicated | cacheIterative
+	var err error
+
+	if level == cachePreserve {
+		if tools.IsDiskGroup(groupCache) {
+			level = cacheDisk
+		} else if tools.IsRawMemoryGroup(groupCache) {
+			level = cacheRawMemory
+		} else {
+			level = cacheMemory
+		}
+	}
+
+	switch level {
+	case cacheMemory:
+		logger.Info("CacheContext: saving partition in " + storage.IMemoryPartitionType + " cache")
+		if !tools.IsMemoryGroup(groupCache) {
+			if groupCache, err = this.cacheGroup(groupCache, func(storage.IPartitionBase) int8 { return level }); err != nil {
 				return ierror.Raise(err)
 			}
-			defer file.Close()
-
-			scanner := bufio.NewScanner(file)
-			for scanner.Scan() {
-				line := scanner.Text()
-				if !found || strings.HasPrefix(line, strconv.FormatInt(id, 10)+"\x00") {
-					found = true
-					continue
-				}
-				lines = append(lines, line)
-			}
-			if err = scanner.Err(); err != nil {
+		}
+	case cacheRawMemory:
+		logger.Info("CacheContext: saving partition in " + storage.IRawMemoryPartitionType + " cache")
+		if !tools.IsRawMemoryGroup(groupCache) {
+			if groupCache, err = this.cacheGroup(groupCache, func(storage.IPartitionBase) int8 { return level }); err != nil {
 				return ierror.Raise(err)
 			}
-			_, err = file.Seek(0, 0)
-			if err != nil {
-				return ierror.Raise(err)
+		}
+	case cacheMemoryAndDisk, cacheRawMemoryAndDisk:
+		budget, err := this.executorData.GetProperties().CacheMemory()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		used := int64(0)
+		for _, bytes := range this.memory {
+			used += bytes
+		}
+		memoryLevel := utils.Ternary(level == cacheMemoryAndDisk, cacheMemory, cacheRawMemory)
+		inMemory := 0
+		acquired := int64(0)
+		if groupCache, err = this.cacheGroup(groupCache, func(part storage.IPartitionBase) int8 {
+			if budget > 0 && used+part.Bytes() > budget {
+				return cacheDisk
 			}
-			err = file.Truncate(0)
-			if err != nil {
+			if fit, err := this.executorData.Memory().AcquireStorage(part.Bytes(), false); err != nil || !fit {
+				return cacheDisk
+			}
+			acquired += part.Bytes()
+			used += part.Bytes()
+			inMemory++
+			return memoryLevel
+		}); err != nil {
+			this.executorData.Memory().ReleaseStorage(acquired)
+			return ierror.Raise(err)
+		}
+		this.executorData.Memory().ReleaseStorage(acquired)
+		logger.Info("CacheContext: saving ", inMemory, " partitions in memory and ", groupCache.Size()-inMemory,
+			" in disk cache")
+	case cacheDisk:
+		if !tools.IsDiskGroup(groupCache) {
+			if groupCache, err = this.cacheGroup(groupCache, func(storage.IPartitionBase) int8 { return level }); err != nil {
 				return ierror.Raise(err)
 			}
-			for _, line := range lines {
-				if _, err = file.WriteString(line + "\n"); err != nil {
-					return ierror.Raise(err)
-				}
+		}
+		for i := 0; i < groupCache.Size(); i++ {
+			groupCache.GetBase(i).(storage.IDiskPreservation).Persist(true)
+		}
+		if err := this.registerDiskCache(id, groupCache); err != nil {
+			return ierror.Raise(err)
+		}
+		logger.Info("CacheContext: saving partition in " + storage.IDiskPartitionType + " cache")
+	default:
+		return ierror.RaiseMsg("unknown cache level " + strconv.Itoa(int(level)))
+	}
+
+	if level != cacheDisk {
+		bytes := int64(0)
+		for i := 0; i < groupCache.Size(); i++ {
+			if !tools.IsDisk(groupCache.GetBase(i)) {
+				bytes += groupCache.GetBase(i).Bytes()
 			}
 		}
+		if fit, err := this.executorData.Memory().AcquireStorage(bytes, true); err != nil {
+			return ierror.Raise(err)
+		} else if !fit {
+			logger.Warn("CacheContext: cache ", id, " exceeds the storage memory")
+		}
+		this.memory[id] = bytes
 	}
 
-	groupCache := this.executorData.GetPartitionsAny()
+	if err := this.replicate(id, groupCache, replicated); err != nil {
+		return ierror.Raise(err)
+	}
 
-	if level == 1 { // PRESERVE
-		if this.executorData.GetPartitionTools().IsDiskGroup(groupCache) {
-			level = 4
-		} else if this.executorData.GetPartitionTools().IsRawMemoryGroup(groupCache) {
-			level = 3
-		} else {
-			level = 2
+	if iterative {
+		logger.Info("CacheContext: cache ", id, " pinned for iterative use")
+		this.iterative[id] = this.executorData.Partitioner()
+		this.executorData.SetIterative(true)
+	}
+
+	groupCache.SetCache(true)
+	this.cache[id] = groupCache
+	return nil
+}
+
+/*Releases a cache, the iterative mode ends with the last iterative cache*/
+func (this *ICacheImpl) Unpersist(id int64) error {
+	logger.Info("CacheContext: unpersisting cache ", id)
+	return this.uncache(id)
+}
+
+/*
+Mapped partitions are freed now instead of waiting for the collector. A cache keeps the group it was given, so the
+partitions are kept while the current partitions, another cache, a saved context, a replica or a variable still
+holds them, reading an unmapped partition would crash the executor.
+*/
+func (this *ICacheImpl) releaseMapped(group storage.IPartitionGroupBase) {
+	holders := this.executorData.VariablePartitions()
+	holders = append(holders, this.executorData.GetPartitionsAny())
+	for _, other := range this.cache {
+		holders = append(holders, other)
+	}
+	for _, saved := range this.context {
+		holders = append(holders, saved)
+	}
+	for _, replication := range this.replicas {
+		select {
+		case <-replication.done:
+			holders = append(holders, replication.copies...)
+		default:
+		}
+	}
+	inUse := make(map[storage.IPartitionBase]bool)
+	for _, holder := range holders {
+		if holder == nil {
+			continue
+		}
+		for i := 0; i < holder.Size(); i++ {
+			inUse[holder.GetBase(i)] = true
 		}
 	}
+	for i := 0; i < group.Size(); i++ {
+		part := group.GetBase(i)
+		if raw, ok := part.Inner().(*itransport.IMemoryBuffer); ok && raw.Mapped() && !inUse[part] {
+			raw.Release()
+		}
+	}
+}
 
-	if level == 2 { // MEMORY
-		logger.Info("CacheContext: saving partition in " + storage.IMemoryPartitionType + " cache")
-		if !this.executorData.GetPartitionTools().IsMemoryGroup(groupCache) {
-			group := groupCache.NewGroup()
-			for i := 0; i < groupCache.Size(); i++ {
-				group.AddMemoryPartition(group.GetBase(i).Size())
-				if err := groupCache.GetBase(i).CopyTo(group.GetBase(i)); err != nil {
-					return ierror.Raise(err)
-				}
-			}
-			groupCache = group
+func (this *ICacheImpl) uncache(id int64) error {
+	this.executorData.Results().Clear()
+	value, present := this.cache[id]
+	if !present {
+		logger.Warn("CacheContext: removing non existent cache " + strconv.FormatInt(id, 10))
+		return nil
+	}
+	delete(this.cache, id)
+	this.executorData.Memory().ReleaseStorage(this.memory[id])
+	delete(this.memory, id)
+	delete(this.replicas, id)
+	delete(this.iterative, id)
+	this.executorData.SetIterative(len(this.iterative) > 0)
+	if this.executorData.GetPartitionTools().IsRawMemoryGroup(value) {
+		this.releaseMapped(value)
+		return nil
+	}
+	if !this.executorData.GetPartitionTools().IsDiskGroup(value) {
+		return nil
+	}
+	for i := 0; i < value.Size(); i++ {
+		value.GetBase(i).(storage.IDiskPreservation).Persist(false)
+	}
+	cache, err := this.fileCache()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	var lines []string
+
+	file, err := os.OpenFile(cache, os.O_RDWR, 0644)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, strconv.FormatInt(id, 10)+"\x00") {
+			continue
 		}
-	} else if level == 3 { // RAW_MEMORY
-		logger.Info("CacheContext: saving partition in " + storage.IRawMemoryPartitionType + " cache")
-		if !this.executorData.GetPartitionTools().IsRawMemoryGroup(groupCache) {
-			group := groupCache.NewGroup()
-			compression, err := this.executorData.GetProperties().PartitionCompression()
-			if err != nil {
-				return ierror.Raise(err)
+		lines = append(lines, line)
+	}
+	if err = scanner.Err(); err != nil {
+		return ierror.Raise(err)
+	}
+	_, err = file.Seek(0, 0)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	err = file.Truncate(0)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for _, line := range lines {
+		if _, err = file.WriteString(line + "\n"); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+/*
+Moves cached partitions from memory to disk, oldest caches first, until need bytes are freed. Iterative caches
+are pinned.
+*/
+func (this *ICacheImpl) evict(need int64) (int64, error) {
+	ids := make([]int64, 0, len(this.memory))
+	for id := range this.memory {
+		if _, pinned := this.iterative[id]; !pinned {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	freed := int64(0)
+	for _, id := range ids {
+		if freed >= need {
+			break
+		}
+		group, err := this.cacheGroup(this.cache[id], func(storage.IPartitionBase) int8 { return cacheDisk })
+		if err != nil {
+			return freed, ierror.Raise(err)
+		}
+		for i := 0; i < group.Size(); i++ {
+			group.GetBase(i).(storage.IDiskPreservation).Persist(true)
+		}
+		if err = this.registerDiskCache(id, group); err != nil {
+			return freed, ierror.Raise(err)
+		}
+		logger.Info("CacheContext: cache ", id, " evicted to disk, ", this.memory[id], " bytes released")
+		group.SetCache(true)
+		this.cache[id] = group
+		freed += this.memory[id]
+		this.executorData.Memory().ReleaseStorage(this.memory[id])
+		delete(this.memory, id)
+	}
+	return freed, nil
+}
+
+/*Copies every partition to the storage selected by level, partitions already stored there are reused*/
+func (this *ICacheImpl) cacheGroup(source storage.IPartitionGroupBase, level func(part storage.IPartitionBase) int8) (storage.IPartitionGroupBase, error) {
+	tools := this.executorData.GetPartitionTools()
+	compression, err := this.executorData.GetProperties().PartitionCompression()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	group := source.NewGroup()
+	for i := 0; i < source.Size(); i++ {
+		part := source.GetBase(i)
+		switch level(part) {
+		case cacheMemory:
+			if tools.IsMemory(part) {
+				group.AddBase(part)
+				continue
 			}
-			for i := 0; i < groupCache.Size(); i++ {
-				part := group.GetBase(i)
-				if err := group.AddRawMemoryPartition(part.Bytes(), compression, part.Native()); err != nil {
-					return ierror.Raise(err)
-				}
-				if err := groupCache.GetBase(i).CopyTo(part); err != nil {
-					return ierror.Raise(err)
-				}
+			group.AddMemoryPartition(part.Size())
+		case cacheRawMemory:
+			if tools.IsRawMemory(part) {
+				group.AddBase(part)
+				continue
 			}
-			groupCache = group
-		}
-	} else if level == 4 { // DISK
-		if this.executorData.GetPartitionTools().IsDiskGroup(groupCache) {
-			for i := 0; i < groupCache.Size(); i++ {
-				groupCache.(storage.IDiskPreservation).Persist(true)
+			if err := group.AddRawMemoryPartition(part.Bytes(), compression, part.Native()); err != nil {
+				return nil, ierror.Raise(err)
 			}
-		} else {
-			group := groupCache.NewGroup()
-			compression, err := this.executorData.GetProperties().PartitionCompression()
+		default:
+			if tools.IsDisk(part) {
+				group.AddBase(part)
+				continue
+			}
+			path, err := tools.Diskpath("")
 			if err != nil {
-				return ierror.Raise(err)
+				return nil, ierror.Raise(err)
 			}
-			for i := 0; i < groupCache.Size(); i++ {
-				path, err := this.executorData.GetPartitionTools().Diskpath("")
-				if err != nil {
-					return ierror.Raise(err)
-				}
-				part := group.GetBase(i)
-				if err = group.AddDiskPartition(path, compression, part.Native()); err != nil {
-					return ierror.Raise(err)
-				}
-				if err := groupCache.GetBase(i).CopyTo(part); err != nil {
-					return ierror.Raise(err)
-				}
+			if err = group.AddDiskPartition(path, compression, part.Native()); err != nil {
+				return nil, ierror.Raise(err)
 			}
-			groupCache = group
 		}
-		cpath, err := this.fileCache()
-		if err != nil {
-			return ierror.Raise(err)
+		if err := part.CopyTo(group.GetBase(group.Size() - 1)); err != nil {
+			return nil, ierror.Raise(err)
 		}
-		data := make([]byte, 0, 1000)
-		data = append(data, []byte(strconv.Itoa(int(id)))...)
+	}
+	return group, nil
+}
+
+func (this *ICacheImpl) registerDiskCache(id int64, group storage.IPartitionGroupBase) error {
+	cpath, err := this.fileCache()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	data := make([]byte, 0, 1000)
+	data = append(data, []byte(strconv.Itoa(int(id)))...)
+	data = append(data, 0)
+	if group.Size() > 0 {
+		data = append(data, []byte(group.GetBase(0).(storage.IDiskPreservation).GetTypeName())...)
+	} else {
+		data = append(data, []byte(utils.TypeName[any]())...)
+	}
+	for i := 0; i < group.Size(); i++ {
 		data = append(data, 0)
-		if groupCache.Size() > 0 {
-			data = append(data, []byte(groupCache.(storage.IDiskPreservation).GetTypeName())...)
-		} else {
-			data = append(data, []byte(utils.TypeName[any]())...)
+		data = append(data, []byte(group.GetBase(i).(storage.IDiskPreservation).GetPath())...)
+	}
+	data = append(data, []byte("\n")...)
+
+	file, err := os.OpenFile(cpath, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	defer file.Close()
+	if _, err = file.Write(data); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+/*Copies of a cache received from the previous executors, copies[d-1] is the cache of the executor at distance d*/
+type iReplication struct {
+	done   chan struct{}
+	copies []storage.IPartitionGroupBase
+	err    error
+}
+
+func (this *iReplication) wait() error {
+	<-this.done
+	return this.err
+}
+
+/*
+Every executor sends a serialized copy of its cache to the next executors and keeps the copies of the previous ones,
+so the partitions of a failed executor can be served by its neighbors. The copies are exchanged in a goroutine over a
+duplicate of the group communicator when MPI is running in thread mode, replications are chained so all executors
+exchange them in the same order.
+*/
+func (this *ICacheImpl) replicate(id int64, group storage.IPartitionGroupBase, requested bool) error {
+	factor, err := this.executorData.GetProperties().CacheReplication()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if factor == 0 && requested {
+		factor = 1
+	}
+	if factor == 0 {
+		return nil
+	}
+	mpi := this.executorData.Mpi()
+	executors := mpi.Executors()
+	if executors == 1 {
+		logger.Warn("CacheContext: cache replication requires more than one executor")
+		return nil
+	}
+	factor = utils.Min(factor, int64(executors-1))
+	if err := this.replicaCommunicator(); err != nil {
+		return ierror.Raise(err)
+	}
+	var provided impi.C_int
+	if err := impi.MPI_Query_thread(&provided); err != nil {
+		return ierror.Raise(err)
+	}
+	compression, err := this.executorData.GetProperties().PartitionCompression()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	native, err := this.executorData.GetProperties().NativeSerialization()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	parts := make([][]byte, group.Size())
+	for i := 0; i < group.Size(); i++ {
+		buffer := itransport.NewIMemoryBuffer()
+		if err := group.GetBase(i).Write(buffer, compression); err != nil {
+			return ierror.Raise(err)
 		}
-		for i := 0; i < groupCache.Size(); i++ {
-			data = append(data, 0)
-			data = append(data, []byte(groupCache.(storage.IDiskPreservation).GetPath())...)
+		parts[i] = buffer.GetBufferAsBytes()
+	}
+	empty := group.NewGroup()
+
+	replication := &iReplication{done: make(chan struct{})}
+	previous := this.lastReplica
+	this.lastReplica = replication
+	this.replicas[id] = replication
+	run := func() {
+		defer close(replication.done)
+		if previous != nil {
+			_ = previous.wait()
+		}
+		replication.copies, replication.err = this.replicateCopies(empty, parts, int(factor), compression, native)
+		if replication.err != nil {
+			logger.Error("CacheContext: replication of cache ", id, " failed, ", replication.err)
 		}
-		data = append(data, []byte("\n")...)
+	}
+	logger.Info("CacheContext: replicating cache ", id, " in the next ", factor, " executors")
+	if provided == impi.MPI_THREAD_MULTIPLE {
+		go run()
+		return nil
+	}
+	run()
+	return ierror.Raise(replication.err)
+}
 
-		file, err := os.OpenFile(cpath, os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
-		defer file.Close()
-		if _, err = file.Write(data); err != nil {
+/*Duplicates the group communicator, again if the group changed since the last replication*/
+func (this *ICacheImpl) replicaCommunicator() error {
+	group := this.executorData.Mpi().Native()
+	if this.replicaComm != impi.MPI_COMM_NULL && this.replicaGroup == group {
+		return nil
+	}
+	if this.replicaComm != impi.MPI_COMM_NULL {
+		if this.lastReplica != nil {
+			_ = this.lastReplica.wait()
+		}
+		if err := impi.MPI_Comm_free(&this.replicaComm); err != nil {
 			return ierror.Raise(err)
 		}
+	}
+	if err := impi.MPI_Comm_dup(group, &this.replicaComm); err != nil {
+		return ierror.Raise(err)
+	}
+	this.replicaGroup = group
+	return nil
+}
 
-		logger.Info("CacheContext: saving partition in " + storage.IDiskPartitionType + " cache")
+/*The partitions are serialized before the exchange, so the cache can be evicted while the copies are sent*/
+func (this *ICacheImpl) replicateCopies(group storage.IPartitionGroupBase, parts [][]byte, factor int, compression int8,
+	native bool) ([]storage.IPartitionGroupBase, error) {
+	mpi := this.executorData.Mpi()
+	var rank, executors impi.C_int
+	var err error
+	if err = impi.MPI_Comm_rank(this.replicaComm, &rank); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err = impi.MPI_Comm_size(this.replicaComm, &executors); err != nil {
+		return nil, ierror.Raise(err)
 	}
+	copies := make([]storage.IPartitionGroupBase, factor)
+	for d := 1; d <= factor; d++ {
+		next := (int(rank) + d) % int(executors)
+		prev := (int(rank) - d + int(executors)) % int(executors)
+		count := make([]byte, 8)
+		binary.LittleEndian.PutUint64(count, uint64(group.Size()))
+		if count, err = mpi.SendRcvBytesGroup(this.replicaComm, count, next, prev, 0); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		n := int(binary.LittleEndian.Uint64(count))
 
-	groupCache.SetCache(true)
-	this.cache[id] = groupCache
+		replica := group.NewGroup()
+		for i := 0; i < utils.Max(n, len(parts)); i++ {
+			var data []byte
+			if i < len(parts) {
+				data = parts[i]
+			}
+			if data, err = mpi.SendRcvBytesGroup(this.replicaComm, data, next, prev, 0); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			if i >= n {
+				continue
+			}
+			if err := replica.AddRawMemoryPartition(int64(len(data)), compression, native); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			buffer := itransport.NewIMemoryBufferWrapper(data, int64(len(data)), itransport.OBSERVE)
+			if err := replica.GetBase(replica.Size() - 1).Read(buffer); err != nil {
+				return nil, ierror.Raise(err)
+			}
+		}
+		copies[d-1] = replica
+	}
+	return copies, nil
+}
+
+/*Copy of the cache id of the previous executor, available when it was cached with replication*/
+func (this *ICacheImpl) Replica(id int64) (storage.IPartitionGroupBase, bool) {
+	return this.ReplicaOf(id, (this.executorData.Mpi().Rank()-1+this.executorData.Mpi().Executors())%
+		this.executorData.Mpi().Executors())
+}
+
+/*Copy of the cache id of the executor rank, available when this executor is one of its replicas*/
+func (this *ICacheImpl) ReplicaOf(id int64, rank int) (storage.IPartitionGroupBase, bool) {
+	replication, present := this.replicas[id]
+	if !present || replication.wait() != nil {
+		return nil, false
+	}
+	executors := this.executorData.Mpi().Executors()
+	d := (this.executorData.Mpi().Rank() - rank + executors) % executors
+	if d == 0 || d > len(replication.copies) {
+		return nil, false
+	}
+	return replication.copies[d-1], true
+}
+
+/*Serves the cache id of a failed executor from the copy kept by this executor*/
+func (this *ICacheImpl) LoadReplica(id int64, rank int) error {
+	logger.Info("CacheContext: loading replica of cache ", id, " from executor ", rank)
+	replica, present := this.ReplicaOf(id, rank)
+	if !present {
+		return ierror.RaiseMsg("replica of cache " + strconv.FormatInt(id, 10) + " from executor " +
+			strconv.Itoa(rank) + " not found")
+	}
+	this.executorData.SetPartitionsAny(replica)
+	this.executorData.Results().SetInput("replica:" + strconv.FormatInt(id, 10) + ":" + strconv.Itoa(rank))
 	return nil
 }
 
@@ -293,7 +701,26 @@ func (this *ICacheImpl) LoadCache(id int64) error {
 	logger.Info("CacheContext: loading partition from cache")
 	if value, present := this.cache[id]; present {
 		this.executorData.SetPartitionsAny(value)
+		if partitioner, iterative := this.iterative[id]; iterative {
+			this.executorData.SetPartitioner(partitioner)
+		}
+		this.executorData.Results().SetInput("cache:" + strconv.FormatInt(id, 10))
 		return nil
 	}
 	return ierror.RaiseMsg("cache " + strconv.FormatInt(id, 10) + " not found")
 }
+
+func (this *ICacheImpl) Checkpoint() (int64, error) {
+	return this.executorData.Checkpoints().Save()
+}
+
+func (this *ICacheImpl) LoadCheckpoint() (*core.ICheckpoint, error) {
+	if !this.executorData.Checkpoints().Enabled() {
+		return nil, nil
+	}
+	return this.executorData.Checkpoints().Load()
+}
+
+func RestoreCheckpoint[T any](this *ICacheImpl, checkpoint *core.ICheckpoint) error {
+	return core.RestoreCheckpoint[T](this.executorData.Checkpoints(), checkpoint)
+}
@@ -0,0 +1,78 @@
+package impl
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/storage"
+)
+
+const combinerCheck = 4096
+
+type iCombinerTable[K comparable, V any, C any] struct {
+	create    func(V) (C, error)
+	merge     func(C, V) (C, error)
+	limit     int
+	threshold int64
+	inserts   int
+	spills    int
+	elems     map[K]C
+}
+
+func newICombinerTable[K comparable, V any, C any](create func(V) (C, error), merge func(C, V) (C, error),
+	limit int, threshold int64) *iCombinerTable[K, V, C] {
+	return &iCombinerTable[K, V, C]{
+		create:    create,
+		merge:     merge,
+		limit:     limit,
+		threshold: threshold,
+		elems:     map[K]C{},
+	}
+}
+
+func (this *iCombinerTable[K, V, C]) Len() int {
+	return len(this.elems)
+}
+
+func (this *iCombinerTable[K, V, C]) Spills() int {
+	return this.spills
+}
+
+func (this *iCombinerTable[K, V, C]) full() bool {
+	if this.limit > 0 && len(this.elems) >= this.limit {
+		return true
+	}
+	if this.threshold > 0 {
+		this.inserts++
+		return this.inserts%combinerCheck == 0 && storage.HeapBytes() > this.threshold
+	}
+	return false
+}
+
+func (this *iCombinerTable[K, V, C]) Add(key K, value V, spill func(K, C) error) error {
+	var err error
+	if acum, present := this.elems[key]; present {
+		if this.elems[key], err = this.merge(acum, value); err != nil {
+			return ierror.Raise(err)
+		}
+		return nil
+	}
+	if spill != nil && this.full() {
+		this.spills++
+		if err = this.Flush(spill); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	if this.elems[key], err = this.create(value); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+func (this *iCombinerTable[K, V, C]) Flush(spill func(K, C) error) error {
+	for key, value := range this.elems {
+		if err := spill(key, value); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	this.elems = map[K]C{}
+	return nil
+}
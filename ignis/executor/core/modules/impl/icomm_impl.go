@@ -53,8 +53,7 @@ func (this *ICommImpl) JoinToGroup(id string, leader bool) error {
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	this.executorData.SetMpiGroup(comm)
-	return nil
+	return ierror.Raise(this.executorData.SetMpiGroup(comm))
 }
 
 func (this *ICommImpl) JoinToGroupName(id string, leader bool, name string) error {
@@ -94,6 +93,22 @@ func (this *ICommImpl) DestroyGroups() (err error) {
 	return
 }
 
+func (this *ICommImpl) Broadcast(name string, value []byte) error {
+	return ierror.Raise(this.executorData.Broadcast(name, value))
+}
+
+func (this *ICommImpl) RemoveBroadcast(name string) error {
+	if !this.executorData.HasBroadcast(name) {
+		logger.Warn("Comm: removing non existent broadcast " + name)
+	}
+	this.executorData.RemoveBroadcast(name)
+	return nil
+}
+
+func (this *ICommImpl) ReduceAccumulators() (map[string][]byte, error) {
+	return this.executorData.ReduceAccumulators()
+}
+
 func (this *ICommImpl) GetProtocol() (int8, error) {
 	return iprotocol.IGNIS_PROTOCOL, nil
 }
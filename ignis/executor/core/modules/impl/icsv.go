@@ -0,0 +1,146 @@
+package impl
+
+import (
+	"ignis/executor/core/ierror"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+type ICsvOptions struct {
+	Delimiter rune
+	Quote     rune
+	// First line contains the column names, fields are matched by `csv` tag or name instead of position
+	Header bool
+}
+
+func DefaultCsvOptions() ICsvOptions {
+	return ICsvOptions{Delimiter: ',', Quote: '"'}
+}
+
+type iCsvSchema struct {
+	tp      reflect.Type
+	columns [][]int
+}
+
+func newICsvSchema[T any](header []string) (*iCsvSchema, error) {
+	tp := reflect.TypeOf((*T)(nil)).Elem()
+	if tp.Kind() != reflect.Struct {
+		return nil, ierror.RaiseMsg("csv rows can only be mapped to structs, found " + tp.String())
+	}
+	schema := &iCsvSchema{tp: tp}
+	var fields []reflect.StructField
+	for i := 0; i < tp.NumField(); i++ {
+		if field := tp.Field(i); field.IsExported() && field.Tag.Get("csv") != "-" {
+			fields = append(fields, field)
+		}
+	}
+	if header == nil {
+		for _, field := range fields {
+			schema.columns = append(schema.columns, field.Index)
+		}
+		return schema, nil
+	}
+	for _, name := range header {
+		var index []int
+		for _, field := range fields {
+			if tag := field.Tag.Get("csv"); tag == name || (tag == "" && strings.EqualFold(field.Name, name)) {
+				index = field.Index
+				break
+			}
+		}
+		schema.columns = append(schema.columns, index)
+	}
+	return schema, nil
+}
+
+func (this *iCsvSchema) parse(fields []string) (reflect.Value, error) {
+	row := reflect.New(this.tp).Elem()
+	for i, field := range fields {
+		if i >= len(this.columns) {
+			break
+		}
+		if this.columns[i] == nil {
+			continue
+		}
+		if err := csvCoerce(row.FieldByIndex(this.columns[i]), field); err != nil {
+			return row, ierror.RaiseMsgCause("column "+strconv.Itoa(i)+" can not be parsed", err)
+		}
+	}
+	return row, nil
+}
+
+func csvCoerce(v reflect.Value, field string) error {
+	if field == "" {
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(field)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(strings.TrimSpace(field))
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(strings.TrimSpace(field), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(strings.TrimSpace(field), 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(strings.TrimSpace(field), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	case reflect.Pointer:
+		elem := reflect.New(v.Type().Elem())
+		if err := csvCoerce(elem.Elem(), field); err != nil {
+			return err
+		}
+		v.Set(elem)
+	default:
+		return ierror.RaiseMsg("csv can not be converted to " + v.Type().String())
+	}
+	return nil
+}
+
+/*A quoted field can contain the delimiter, a doubled quote inside it is read as a single quote*/
+func csvSplit(line string, options *ICsvOptions) []string {
+	line = strings.TrimSuffix(line, "\r")
+	var fields []string
+	var field strings.Builder
+	quoted := false
+	for i := 0; i < len(line); {
+		r, size := utf8.DecodeRuneInString(line[i:])
+		i += size
+		switch {
+		case quoted && r == options.Quote:
+			if next, nsize := utf8.DecodeRuneInString(line[i:]); i < len(line) && next == options.Quote {
+				field.WriteRune(r)
+				i += nsize
+			} else {
+				quoted = false
+			}
+		case quoted:
+			field.WriteRune(r)
+		case r == options.Quote && options.Quote != 0:
+			quoted = true
+		case r == options.Delimiter:
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteRune(r)
+		}
+	}
+	return append(fields, field.String())
+}
@@ -0,0 +1,410 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"ignis/executor/api/ihyperloglog"
+	"ignis/executor/api/ipair"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/ithreads"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"ignis/executor/core/utils"
+	"strconv"
+)
+
+type IEstimateImpl struct {
+	IBaseImpl
+}
+
+func NewIEstimateImpl(executorData *core.IExecutorData) *IEstimateImpl {
+	return &IEstimateImpl{
+		IBaseImpl{executorData},
+	}
+}
+
+type IEstimation struct {
+	Elements         int64    `json:"elements"`
+	Bytes            int64    `json:"bytes"`
+	Partitions       int64    `json:"partitions"`
+	ExchangeBytes    int64    `json:"exchangeBytes"`
+	MemoryHighwater  int64    `json:"memoryHighwater"`
+	OutputElements   int64    `json:"outputElements"`
+	OutputPartitions int64    `json:"outputPartitions"`
+	Warnings         []string `json:"warnings,omitempty"`
+}
+
+type IPartitionStats struct {
+	Executor  int64  `json:"executor"`
+	Partition int64  `json:"partition"`
+	Elements  int64  `json:"elements"`
+	Bytes     int64  `json:"bytes"`
+	MinKey    string `json:"minKey,omitempty"`
+	MaxKey    string `json:"maxKey,omitempty"`
+}
+
+/*Skew is the ratio between the largest partition and the mean partition size, in elements*/
+type IDatasetStats struct {
+	Partitions []IPartitionStats `json:"partitions"`
+	Elements   int64             `json:"elements"`
+	Bytes      int64             `json:"bytes"`
+	Skew       float64           `json:"skew"`
+}
+
+type iDatasetStats struct {
+	elements   int64
+	bytes      int64
+	partitions int64
+	maxBytes   int64
+}
+
+var narrowOperations = map[string]bool{
+	"map": true, "filter": true, "flatmap": true, "keyBy": true, "mapWithIndex": true, "mapPartitions": true,
+	"mapPartitionsWithIndex": true, "mapExecutor": true, "mapExecutorTo": true, "mapValues": true,
+	"flatMapValues": true, "keys": true, "values": true, "sample": true,
+}
+
+var wideOperations = map[string]bool{
+	"groupBy": true, "sort": true, "sortBy": true, "sortByKey": true, "groupByKey": true, "reduceByKey": true,
+	"aggregateByKey": true, "foldByKey": true, "distinct": true, "repartition": true, "partitionByRandom": true,
+	"partitionByHash": true, "partitionBy": true, "union": true, "join": true,
+}
+
+var actionOperations = map[string]bool{
+	"collect": true, "reduce": true, "treeReduce": true, "aggregate": true, "treeAggregate": true, "fold": true,
+	"treeFold": true, "take": true, "top": true, "takeOrdered": true, "max": true, "min": true, "count": true,
+	"countByKey": true, "countByValue": true,
+}
+
+func (this *IEstimateImpl) stats(group storage.IPartitionGroupBase) (*iDatasetStats, error) {
+	data := []impi.C_int64{0, 0, 0}
+	if group != nil {
+		data[2] = impi.C_int64(group.Size())
+		for i := 0; i < group.Size(); i++ {
+			part := group.GetBase(i)
+			if part == nil || part.Empty() {
+				continue
+			}
+			data[0] += impi.C_int64(part.Size())
+			data[1] += impi.C_int64(part.Bytes())
+		}
+	}
+	local := data[1]
+	if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&data[0]), 3, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		this.executorData.Mpi().Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&local), 1, impi.MPI_LONG_LONG_INT, impi.MPI_MAX,
+		this.executorData.Mpi().Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return &iDatasetStats{int64(data[0]), int64(data[1]), int64(data[2]), int64(local)}, nil
+}
+
+/*Operations whose output size depends on the number of distinct keys, see KeyCardinality*/
+func KeyedEstimation(operation string) bool {
+	return operation == "countByKey" || operation == "countByValue" || operation == "join"
+}
+
+/*
+n is the number of elements of take, top and takeOrdered. keys are the distinct keys of the input, and of the second
+dataset, of the operations that need them, or the distinct values for countByValue.
+*/
+func (this *IEstimateImpl) Estimate(operation string, numPartitions int64, other string, n int64, keys []int64) (*IEstimation, error) {
+	logger.Info("Estimate: estimating cost of ", operation)
+	var group storage.IPartitionGroupBase
+	if this.executorData.HasPartitions() {
+		group = this.executorData.GetPartitionsAny()
+	}
+	if KeyedEstimation(operation) && len(keys) < utils.Ternary(other != "", 2, 1) {
+		return nil, ierror.RaiseMsg(operation + " estimation requires the number of distinct keys")
+	}
+	input, err := this.stats(group)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	var second *iDatasetStats
+	if other != "" {
+		if !this.executorData.HasVariable(other) {
+			return nil, ierror.RaiseMsg("dataset " + other + " not found")
+		}
+		if second, err = this.stats(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other)); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+
+	executors := int64(this.executorData.Mpi().Executors())
+	result := &IEstimation{
+		Elements:         input.elements,
+		Bytes:            input.bytes,
+		Partitions:       input.partitions,
+		OutputElements:   input.elements,
+		OutputPartitions: input.partitions,
+	}
+	if numPartitions > 0 {
+		result.OutputPartitions = numPartitions
+	}
+	shuffle := func(bytes int64) int64 {
+		return bytes * (executors - 1) / executors
+	}
+
+	if narrowOperations[operation] {
+		result.OutputPartitions = input.partitions
+		result.MemoryHighwater = 2 * input.maxBytes
+	} else if wideOperations[operation] {
+		result.ExchangeBytes = shuffle(input.bytes)
+		result.MemoryHighwater = 3 * input.maxBytes
+		if second != nil {
+			result.ExchangeBytes += shuffle(second.bytes)
+			result.MemoryHighwater += 3 * second.maxBytes
+			if operation == "union" {
+				result.OutputElements += second.elements
+			} else if operation == "join" {
+				/*Keys are assumed uniform, each key of the side with fewer keys matches all the elements of its key*/
+				distinct := utils.Max(1, utils.Max(keys[0], keys[1]))
+				result.OutputElements = int64(float64(input.elements) * float64(second.elements) / float64(distinct))
+				if result.OutputElements > input.elements+second.elements {
+					result.Warnings = append(result.Warnings, "join generates "+
+						strconv.FormatInt(result.OutputElements, 10)+" elements, its keys repeat like a cartesian product")
+				}
+			} else {
+				result.OutputElements = utils.Max(input.elements, second.elements)
+			}
+		}
+	} else if actionOperations[operation] {
+		result.ExchangeBytes = input.bytes
+		result.MemoryHighwater = input.maxBytes + input.bytes
+		result.OutputPartitions = 1
+		elemBytes := input.bytes / utils.Max(1, input.elements)
+		switch operation {
+		case "collect":
+		case "take", "top", "takeOrdered":
+			/*Every executor sends at most n elements*/
+			result.OutputElements = utils.Min(n, input.elements)
+			result.ExchangeBytes = utils.Min(input.bytes, executors*utils.Max(n, 0)*elemBytes)
+		case "countByKey", "countByValue":
+			/*Every executor sends a count of each of its keys*/
+			result.OutputElements = utils.Min(keys[0], input.elements)
+			result.ExchangeBytes = utils.Min(input.bytes, executors*result.OutputElements*elemBytes)
+		default:
+			result.OutputElements = utils.Min(1, input.elements)
+			result.ExchangeBytes = utils.Min(input.bytes, executors*elemBytes)
+		}
+	} else if operation == "cartesian" {
+		if second == nil {
+			return nil, ierror.RaiseMsg("cartesian estimation requires a second dataset")
+		}
+		result.ExchangeBytes = second.bytes * (executors - 1)
+		result.OutputElements = input.elements * second.elements
+		outputBytes := int64(0)
+		if input.elements > 0 && second.elements > 0 {
+			outputBytes = input.bytes*second.elements + second.bytes*input.elements
+		}
+		result.MemoryHighwater = input.maxBytes + second.bytes + outputBytes/executors
+		result.Warnings = append(result.Warnings, "cartesian product generates "+
+			strconv.FormatInt(result.OutputElements, 10)+" elements")
+	} else {
+		return nil, ierror.RaiseMsg("operation " + operation + " can not be estimated")
+	}
+
+	return result, nil
+}
+
+/*
+Approximate number of distinct keys, or values, of the pairs of the current partitions and of the variable other when
+it is not empty. Every executor gets the counts.
+*/
+func KeyCardinality[K any, V any](this *IEstimateImpl, other string, values bool) ([]int64, error) {
+	var groups []*storage.IPartitionGroup[ipair.IPair[K, V]]
+	if this.executorData.HasPartitions() {
+		input, err := core.GetPartitions[ipair.IPair[K, V]](this.executorData)
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		groups = append(groups, input)
+	} else {
+		groups = append(groups, nil)
+	}
+	if other != "" {
+		if !this.executorData.HasVariable(other) {
+			return nil, ierror.RaiseMsg("dataset " + other + " not found")
+		}
+		input2, err := core.ConvertGroupPartitionTo[ipair.IPair[K, V]](this.executorData.GetPartitionTools(),
+			core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		groups = append(groups, input2)
+	}
+	result := make([]int64, len(groups))
+	for i, group := range groups {
+		var err error
+		if values {
+			result[i], err = cardinality(this, group, func(elem *ipair.IPair[K, V]) V { return elem.Second })
+		} else {
+			result[i], err = cardinality(this, group, func(elem *ipair.IPair[K, V]) K { return elem.First })
+		}
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	return result, nil
+}
+
+func cardinality[T any, C any](this *IEstimateImpl, group *storage.IPartitionGroup[T], field func(*T) C) (int64, error) {
+	precision := ihyperloglog.PrecisionFor(0.05)
+	hasher := utils.GetHasher(utils.TypeObj[C]())
+	sketch := ihyperloglog.New(precision)
+	if group != nil {
+		for _, part := range group.Iter() {
+			reader, err := part.ReadIterator()
+			if err != nil {
+				return 0, ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return 0, ierror.Raise(err)
+				}
+				sketch.Add(utils.Hash(field(&elem), hasher))
+			}
+		}
+	}
+	if err := this.executorData.Mpi().ReduceRegisters(sketch.Registers(), 0); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	count := impi.C_int64(sketch.Count())
+	if err := impi.MPI_Bcast(impi.P(&count), 1, impi.MPI_LONG_LONG_INT, 0, this.executorData.Mpi().Native()); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	return int64(count), nil
+}
+
+/*Keys are the elements themselves, min and max are only reported when the type has a natural order*/
+func PartitionStats[T any](this *IEstimateImpl) (*IDatasetStats, error) {
+	return partitionStatsImpl[T, T](this, func(elem T) T { return elem })
+}
+
+func PartitionStatsByKey[K any, V any](this *IEstimateImpl) (*IDatasetStats, error) {
+	return partitionStatsImpl[ipair.IPair[K, V], K](this, func(elem ipair.IPair[K, V]) K { return elem.First })
+}
+
+func partitionStatsImpl[T any, K any](this *IEstimateImpl, key func(T) K) (*IDatasetStats, error) {
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	less, _ := defaultCmp[K]()
+	rank := int64(this.executorData.Mpi().Rank())
+	logger.Info("Estimate: computing statistics of ", input.Size(), " partitions")
+	stats, err := core.NewMemoryPartition[IPartitionStats](this.executorData.GetPartitionTools(), int64(input.Size()))
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	local := make([]IPartitionStats, input.Size())
+	if err = ithreads.ParallelT(this.executorData.GetCores(), func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			part := input.Get(p)
+			local[p] = IPartitionStats{Executor: rank, Partition: int64(p), Elements: part.Size(), Bytes: part.Bytes()}
+			if part.Type() == storage.IMemoryPartitionType {
+				counter := &iByteCounter{}
+				if err := part.Write(counter, 0); err != nil {
+					return ierror.Raise(err)
+				}
+				local[p].Bytes = counter.n
+			}
+			if less == nil || part.Empty() {
+				return nil
+			}
+			reader, err := part.ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			elem, err := reader.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			min, max := key(elem), key(elem)
+			for reader.HasNext() {
+				if elem, err = reader.Next(); err != nil {
+					return ierror.Raise(err)
+				}
+				if k := key(elem); less(k, min) {
+					min = k
+				} else if less(max, k) {
+					max = k
+				}
+			}
+			local[p].MinKey = fmt.Sprint(min)
+			local[p].MaxKey = fmt.Sprint(max)
+			return nil
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	writer, err := stats.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	for _, elem := range local {
+		if err = writer.Write(elem); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+
+	logger.Info("Estimate: gathering partition statistics")
+	if err = core.Gather[IPartitionStats](this.executorData.Mpi(), stats, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	result := &IDatasetStats{}
+	if !this.executorData.Mpi().IsRoot(0) {
+		return result, nil
+	}
+	result.Partitions = stats.Inner().(*storage.IListImpl[IPartitionStats]).Array().([]IPartitionStats)
+	largest := int64(0)
+	for _, part := range result.Partitions {
+		result.Elements += part.Elements
+		result.Bytes += part.Bytes
+		largest = utils.Max(largest, part.Elements)
+	}
+	if result.Elements > 0 {
+		result.Skew = float64(largest) * float64(len(result.Partitions)) / float64(result.Elements)
+	}
+	return result, nil
+}
+
+/*Transport that only counts the bytes written, used to measure the serialized size of memory partitions*/
+type iByteCounter struct {
+	n int64
+}
+
+func (this *iByteCounter) Write(p []byte) (int, error) {
+	this.n += int64(len(p))
+	return len(p), nil
+}
+
+func (this *iByteCounter) Read(p []byte) (int, error) {
+	return 0, ierror.RaiseMsg("byte counter can not be read")
+}
+
+func (this *iByteCounter) Close() error {
+	return nil
+}
+
+func (this *iByteCounter) Flush(ctx context.Context) error {
+	return nil
+}
+
+func (this *iByteCounter) RemainingBytes() uint64 {
+	return 0
+}
+
+func (this *iByteCounter) Open() error {
+	return nil
+}
+
+func (this *iByteCounter) IsOpen() bool {
+	return true
+}
@@ -0,0 +1,80 @@
+package impl
+
+import (
+	"ignis/executor/core/ierror"
+	"reflect"
+	"strings"
+)
+
+type iFieldStep struct {
+	index []int
+	key   reflect.Value
+}
+
+type iFieldPath struct {
+	path  string
+	steps []iFieldStep
+	tp    reflect.Type
+}
+
+func newIFieldPath(tp reflect.Type, path string) (*iFieldPath, error) {
+	fieldPath := &iFieldPath{path: path}
+	for _, name := range strings.Split(path, ".") {
+		for tp.Kind() == reflect.Pointer {
+			tp = tp.Elem()
+		}
+		switch tp.Kind() {
+		case reflect.Struct:
+			field, ok := tp.FieldByName(name)
+			if !ok || !field.IsExported() {
+				return nil, ierror.RaiseMsg("field " + name + " of " + path + " not found in " + tp.String())
+			}
+			fieldPath.steps = append(fieldPath.steps, iFieldStep{index: field.Index})
+			tp = field.Type
+		case reflect.Map:
+			if tp.Key().Kind() != reflect.String {
+				return nil, ierror.RaiseMsg("map key of " + path + " is not a string")
+			}
+			fieldPath.steps = append(fieldPath.steps, iFieldStep{key: reflect.ValueOf(name).Convert(tp.Key())})
+			tp = tp.Elem()
+		default:
+			return nil, ierror.RaiseMsg(name + " of " + path + " can not be accessed in " + tp.String())
+		}
+	}
+	fieldPath.tp = tp
+	return fieldPath, nil
+}
+
+func (this *iFieldPath) get(v reflect.Value) reflect.Value {
+	for _, step := range this.steps {
+		for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return reflect.Zero(this.tp)
+			}
+			v = v.Elem()
+		}
+		if step.index != nil {
+			var err error
+			if v, err = v.FieldByIndexErr(step.index); err != nil {
+				return reflect.Zero(this.tp)
+			}
+		} else if v = v.MapIndex(step.key); !v.IsValid() {
+			return reflect.Zero(this.tp)
+		}
+	}
+	return v
+}
+
+func newIFieldPaths(tp reflect.Type, paths []string) ([]*iFieldPath, error) {
+	if len(paths) == 0 {
+		return nil, ierror.RaiseMsg("at least one field path is required")
+	}
+	fieldPaths := make([]*iFieldPath, len(paths))
+	for i, path := range paths {
+		var err error
+		if fieldPaths[i], err = newIFieldPath(tp, path); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	return fieldPaths, nil
+}
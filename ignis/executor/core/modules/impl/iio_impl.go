@@ -3,16 +3,22 @@ package impl
 import (
 	"bufio"
 	"bytes"
-	"errors"
+	"encoding/binary"
+	"encoding/json"
+	"ignis/executor/api/function"
+	"ignis/executor/api/ipair"
+	"ignis/executor/api/iterator"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
+	"ignis/executor/core/ifs"
 	iio "ignis/executor/core/iio"
+	"ignis/executor/core/impi"
 	"ignis/executor/core/ithreads"
+	"ignis/executor/core/itransport"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
 	"io"
-	"io/fs"
 	"math"
 	"os"
 	"strconv"
@@ -38,16 +44,20 @@ func PartitionApproxSize[T any](this *IIOImpl) (int64, error) {
 	if group.Size() == 0 {
 		return 0, nil
 	}
+	return approxBytes(this.executorData, group)
+}
 
+/*Serialized bytes of a group, the bytes of memory partitions are estimated from their number of elements*/
+func approxBytes[T any](executorData *core.IExecutorData, group *storage.IPartitionGroup[T]) (int64, error) {
 	size := int64(0)
-	if this.executorData.GetPartitionTools().IsMemoryGroup(group) {
+	if executorData.GetPartitionTools().IsMemoryGroup(group) {
 		for _, part := range group.Iter() {
 			size += part.Size()
 		}
 		if iio.IsContiguous[T]() {
 			size *= int64(utils.TypeObj[T]().Size())
 		} else {
-			if eSize, err := this.executorData.GetProperties().TransportElemSize(); err != nil {
+			if eSize, err := executorData.GetProperties().TransportElemSize(); err != nil {
 				return 0, ierror.Raise(err)
 			} else {
 				size *= eSize
@@ -72,6 +82,83 @@ func (this *IIOImpl) TextFile(path string, minPartitions int64) error {
 	return this.plainOrTextFile(path, minPartitions, "\n")
 }
 
+func CsvFile[T any](this *IIOImpl, path string, minPartitions int64, options ICsvOptions) error {
+	logger.Info("IO: reading csv file")
+	if options.Delimiter == 0 {
+		options.Delimiter = ','
+	}
+	var header []string
+	if options.Header {
+		file, err := this.openTextRead(path)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		line, err := bufio.NewReader(file).ReadString('\n')
+		file.Close()
+		if err != nil && err != io.EOF {
+			return ierror.Raise(err)
+		}
+		header = csvSplit(strings.TrimSuffix(line, "\n"), &options)
+	}
+	schema, err := newICsvSchema[T](header)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := this.plainOrTextFile(path, minPartitions, "\n"); err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[string](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	skipHeader := options.Header && this.executorData.GetContext().ExecutorId() == 0
+
+	logger.Info("IO: mapping csv rows to ", schema.tp.String())
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if skipHeader && p == 0 && reader.HasNext() {
+				if _, err := reader.Next(); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			for reader.HasNext() {
+				line, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if len(strings.TrimSpace(line)) == 0 {
+					continue
+				}
+				row, err := schema.parse(csvSplit(line, &options))
+				if err != nil {
+					return ierror.RaiseMsgCause("invalid csv line '"+line+"'", err)
+				}
+				if err := writer.Write(row.Interface().(T)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return output.Get(p).Fit()
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
 func readBytes(reader *bufio.Reader, buffer *[]byte, delim []byte, exs [][]byte) ([]byte, error) {
 	if len(delim) == 1 && len(exs) == 0 {
 		return reader.ReadBytes(delim[0])
@@ -106,13 +193,54 @@ OUT:
 	return (*buffer)[:], err
 }
 
+/*Delimiter of the lines and the exceptions that contain it but do not end a line, marked with '!' in delim*/
+func textDelimiter(delim string) ([]byte, [][]byte, int) {
+	exs := make([][]byte, 0)
+	esize := 0
+	if strings.ContainsRune(delim, '!') {
+		flag := rune(0)
+		for ; strings.ContainsRune(delim, flag); flag++ {
+		}
+		delim = strings.ReplaceAll(delim, "\\!", string(flag))
+		fields := strings.Split(delim, "!")
+		for i, _ := range fields {
+			fields[i] = strings.ReplaceAll(fields[i], string(flag), "!")
+			if i == 0 {
+				delim = fields[0]
+			} else {
+				exs = append(exs, []byte(fields[i]+delim))
+			}
+		}
+		for _, ex := range exs {
+			if len(ex) > esize {
+				esize = len(ex) + 1
+			}
+		}
+	}
+	if len(delim) == 0 {
+		delim = "\n"
+	}
+	return []byte(delim), exs, esize
+}
+
 func (this *IIOImpl) plainOrTextFile(path string, minPartitions int64, delim string) error {
-	size := int64(0)
-	if info, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+	fsys, err := this.executorData.GetPartitionTools().FileSystem(path)
+	if err != nil {
 		return ierror.Raise(err)
-	} else {
-		size = info.Size()
 	}
+	if ifs.HasGlob(path) {
+		return this.plainOrTextFiles(fsys, path, minPartitions, delim)
+	}
+	info, err := fsys.Stat(path)
+	if err != nil {
+		return ierror.RaiseMsgCause(path+" was not found", err)
+	}
+	if codec, err := this.fileCodec(path); err != nil {
+		return ierror.Raise(err)
+	} else if info.Dir || codec != nil {
+		return this.plainOrTextFiles(fsys, path, minPartitions, delim)
+	}
+	size := info.Size
 	logger.Info("IO: file has ", size, " Bytes")
 	result, err := core.NewPartitionGroupDef[string](this.executorData.GetPartitionTools())
 	if err != nil {
@@ -142,35 +270,9 @@ func (this *IIOImpl) plainOrTextFile(path string, minPartitions int64, delim str
 			return ierror.Raise(err)
 		}
 		minPartitions := int64(math.Ceil(float64(minPartitions) / float64(threads)))
-		ldelim := delim
 		buffer := make([]byte, 0, 1024)
-		exs := make([][]byte, 0)
-		esize := 0
-		if strings.ContainsRune(ldelim, '!') {
-			flag := rune(0)
-			for ; strings.ContainsRune(ldelim, flag); flag++ {
-			}
-			ldelim = strings.ReplaceAll(ldelim, "\\!", string(flag))
-			fields := strings.Split(ldelim, "!")
-			for i, _ := range fields {
-				fields[i] = strings.ReplaceAll(fields[i], string(flag), "!")
-				if i == 0 {
-					ldelim = fields[0]
-				} else {
-					exs = append(exs, []byte(fields[i]+ldelim))
-				}
-			}
-			for _, ex := range exs {
-				if len(ex) > esize {
-					esize = len(ex) + 1
-				}
-			}
-		}
-		if len(ldelim) == 0 {
-			ldelim = "\n"
-		}
-		dsize := len(ldelim)
-		bdelim := []byte(ldelim)
+		bdelim, exs, esize := textDelimiter(delim)
+		dsize := len(bdelim)
 
 		if globalThreadId > 0 {
 			padding := utils.Ternary(exChunkInit >= int64(dsize+esize), exChunkInit-int64(dsize+esize), 0)
@@ -270,6 +372,324 @@ func (this *IIOImpl) plainOrTextFile(path string, minPartitions int64, delim str
 	return nil
 }
 
+/*Byte range of a file, the lines that start inside the range belong to it*/
+type iTextSplit struct {
+	path  string
+	init  int64
+	end   int64
+	codec ifs.IFileCodec
+}
+
+/*Lines of every file matched by path, the byte ranges of all files are spread across the threads of all executors*/
+func (this *IIOImpl) plainOrTextFiles(fsys ifs.IFileSystem, path string, minPartitions int64, delim string) error {
+	files, err := ifs.Glob(fsys, path)
+	if err != nil {
+		return ierror.RaiseMsgCause(path+" was not found", err)
+	}
+	if len(files) == 0 {
+		return ierror.RaiseMsg("no file matches " + path)
+	}
+	size := int64(0)
+	for _, file := range files {
+		size += file.Size
+	}
+	logger.Info("IO: ", len(files), " files have ", size, " Bytes")
+	result, err := core.NewPartitionGroupDef[string](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	ioCores, err := this.ioCores()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	splits, err := this.textSplits(fsys, files, ioCores, true)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	threadGroup := make([]*storage.IPartitionGroup[string], ioCores)
+	elements := int64(0)
+
+	if err := ithreads.ParallelT(ioCores, func(rctx ithreads.IRuntimeContext) error {
+		id := rctx.ThreadId()
+		threads := this.executorData.GetContext().Executors() * ioCores
+		minPartitionSize, err := this.executorData.GetProperties().PartitionMinimal()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		minPartitions := int64(math.Ceil(float64(minPartitions) / float64(threads)))
+		threadBytes := int64(0)
+		for _, split := range splits[id] {
+			threadBytes += split.end - split.init
+		}
+		if minPartitions > 0 && threadBytes/minPartitionSize < minPartitions {
+			minPartitionSize = utils.Max(1, threadBytes/minPartitions)
+		}
+		bdelim, exs, esize := textDelimiter(delim)
+
+		if threadGroup[id], err = core.NewPartitionGroupDef[string](this.executorData.GetPartitionTools()); err != nil {
+			return ierror.Raise(err)
+		}
+		var writeIterator iterator.IWriteIterator[string]
+		partitionBytes := minPartitionSize + 1
+		threadElements := int64(0)
+		for _, split := range splits[id] {
+			file, err := this.openFileRead(split.path)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			read := readTextSplit
+			if split.codec != nil {
+				read = readCodecSplit
+			}
+			err = read(file, split, bdelim, exs, esize, func(line []byte, n int64) error {
+				if partitionBytes > minPartitionSize {
+					partition, err := core.NewPartitionDef[string](this.executorData.GetPartitionTools())
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					if writeIterator, err = partition.WriteIterator(); err != nil {
+						return ierror.Raise(err)
+					}
+					threadGroup[id].Add(partition)
+					partitionBytes = 0
+				}
+				partitionBytes += n
+				threadElements++
+				return writeIterator.Write(string(line))
+			})
+			file.Close()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		for _, part := range threadGroup[id].Iter() {
+			if err = part.Fit(); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		return rctx.Critical(func() error {
+			elements += threadElements
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	for _, group := range threadGroup {
+		for _, part := range group.Iter() {
+			result.Add(part)
+		}
+	}
+
+	logger.Info("IO: created ", result.Size(), " partitions, ", elements, " lines and ", size, " Bytes read")
+	core.SetPartitions[string](this.executorData, result)
+	return nil
+}
+
+/*
+Files are cut in ranges of the same size and every range goes to the least loaded thread. When the file system
+reports the hosts of a file, its ranges are only given to the threads of executors running on those hosts. Files
+with a codec that can not be split are a single range unless codecs is false.
+*/
+func (this *IIOImpl) textSplits(fsys ifs.IFileSystem, files []ifs.IFileEntry, ioCores int,
+	codecs bool) ([][]iTextSplit, error) {
+	executor := this.executorData.GetContext().ExecutorId()
+	threads := this.executorData.GetContext().Executors() * ioCores
+	size := int64(0)
+	for _, file := range files {
+		size += file.Size
+	}
+	chunk := utils.Max(1, int64(math.Ceil(float64(size)/float64(threads))))
+	hosts := make([][]string, len(files))
+	var executorHosts []string
+	if locality, ok := fsys.(ifs.IFileLocality); ok {
+		for i, file := range files {
+			var err error
+			if hosts[i], err = locality.Hosts(file.Path); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			if len(hosts[i]) > 0 && executorHosts == nil {
+				if executorHosts, err = this.executorHosts(); err != nil {
+					return nil, ierror.Raise(err)
+				}
+			}
+		}
+	}
+
+	load := make([]int64, threads)
+	splits := make([][]iTextSplit, ioCores)
+	for i, file := range files {
+		var codec ifs.IFileCodec
+		if codecs {
+			var err error
+			if codec, err = this.fileCodec(file.Path); err != nil {
+				return nil, ierror.Raise(err)
+			}
+		}
+		step := chunk
+		if codec != nil && !codec.Splittable() {
+			step = file.Size
+		}
+		local := func(t int) bool {
+			for _, host := range hosts[i] {
+				if host == executorHosts[t/ioCores] {
+					return true
+				}
+			}
+			return len(hosts[i]) == 0
+		}
+		for init := int64(0); init < file.Size; init += step {
+			best := -1
+			for t := range load {
+				if local(t) && (best < 0 || load[t] < load[best]) {
+					best = t
+				}
+			}
+			if best < 0 {
+				best = 0
+				for t := range load {
+					if load[t] < load[best] {
+						best = t
+					}
+				}
+			}
+			split := iTextSplit{file.Path, init, utils.Min(init+step, file.Size), codec}
+			load[best] += split.end - split.init
+			if best/ioCores == executor {
+				splits[best%ioCores] = append(splits[best%ioCores], split)
+			}
+		}
+	}
+	return splits, nil
+}
+
+/*Name of the host of every executor*/
+func (this *IIOImpl) executorHosts() ([]string, error) {
+	name := make([]byte, impi.MPI_MAX_PROCESSOR_NAME)
+	var length impi.C_int
+	if err := impi.MPI_Get_processor_name((*impi.C_char)(impi.P(&name[0])), &length); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	names := make([]byte, len(name)*this.executorData.Mpi().Executors())
+	if err := impi.MPI_Allgather(impi.P(&name[0]), impi.MPI_MAX_PROCESSOR_NAME, impi.MPI_BYTE, impi.P(&names[0]),
+		impi.MPI_MAX_PROCESSOR_NAME, impi.MPI_BYTE, this.executorData.Mpi().Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	hosts := make([]string, this.executorData.Mpi().Executors())
+	for i := range hosts {
+		host := names[i*len(name) : (i+1)*len(name)]
+		if end := bytes.IndexByte(host, 0); end >= 0 {
+			host = host[:end]
+		}
+		hosts[i] = string(host)
+	}
+	return hosts, nil
+}
+
+/*
+Reads the lines that start inside the range, a range that does not start the file skips the line that crosses its
+beginning because it belongs to the previous range. f receives every line without delimiter and its size in the file.
+*/
+func readTextSplit(file ifs.IFileReader, split iTextSplit, bdelim []byte, exs [][]byte, esize int,
+	f func(line []byte, n int64) error) error {
+	buffer := make([]byte, 0, 1024)
+	pos := split.init
+	if split.init > 0 {
+		padding := utils.Max(split.init-int64(len(bdelim)+esize), 0)
+		if _, err := file.Seek(padding, io.SeekStart); err != nil {
+			return ierror.Raise(err)
+		}
+		reader := bufio.NewReader(file)
+		for padding < split.init {
+			chunk, err := readBytes(reader, &buffer, bdelim, exs)
+			if err != nil && err != io.EOF {
+				return ierror.Raise(err)
+			}
+			padding += int64(len(chunk))
+			if err == io.EOF {
+				break
+			}
+		}
+		pos = padding
+		if _, err := file.Seek(pos, io.SeekStart); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	reader := bufio.NewReaderSize(file, 64*1024)
+	for pos < split.end {
+		line, err := readBytes(reader, &buffer, bdelim, exs)
+		eof := err == io.EOF
+		if err != nil && !eof {
+			return ierror.Raise(err)
+		}
+		pos += int64(len(line))
+		if eof {
+			if len(line) > 0 {
+				return f(line, int64(len(line)))
+			}
+			return nil
+		}
+		if err = f(line[:len(line)-len(bdelim)], int64(len(line))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+Reads the lines of a compressed file. A codec that can not be split always receives the whole file, a splittable
+codec reads the lines that start inside the blocks of the range plus the first line of the next range, because the
+next range always skips its first line.
+*/
+func readCodecSplit(file ifs.IFileReader, split iTextSplit, bdelim []byte, exs [][]byte, esize int,
+	f func(line []byte, n int64) error) error {
+	var reader io.Reader
+	owned := func() (int64, bool) { return 0, false }
+	if split.codec.Splittable() {
+		bzip2, err := ifs.NewIBzip2SplitReader(file, split.init, split.end)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		reader, owned = bzip2, bzip2.Owned
+	} else {
+		decoder, err := split.codec.NewReader(file)
+		if err != nil {
+			return ierror.RaiseMsgCause(split.path+" can not be decompressed", err)
+		}
+		defer decoder.Close()
+		reader = decoder
+	}
+	buffered := bufio.NewReaderSize(reader, 64*1024)
+	buffer := make([]byte, 0, 1024)
+	pos := int64(0)
+	if split.init > 0 {
+		chunk, err := readBytes(buffered, &buffer, bdelim, exs)
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return ierror.Raise(err)
+		}
+		pos += int64(len(chunk))
+	}
+	for {
+		line, err := readBytes(buffered, &buffer, bdelim, exs)
+		eof := err == io.EOF
+		if err != nil && !eof {
+			return ierror.Raise(err)
+		}
+		if end, done := owned(); (done && pos > end) || (eof && len(line) == 0) {
+			return nil
+		}
+		pos += int64(len(line))
+		if eof {
+			return f(line, int64(len(line)))
+		}
+		if err = f(line[:len(line)-len(bdelim)], int64(len(line))); err != nil {
+			return err
+		}
+	}
+}
+
 func PartitionObjectFile[T any](this *IIOImpl, path string, first int64, partitions int64) error {
 	logger.Info("IO: reading partition object file")
 	group, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
@@ -288,15 +708,18 @@ func PartitionObjectFile[T any](this *IIOImpl, path string, first int64, partiti
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			file, err := this.openFileRead(fileName) //Only to check
+			localName, err := this.fetchPartitionFile(fileName)
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			_ = file.Close()
-			open, err := storage.NewIDiskPartition[T](fileName, 0, true, true, true)
+			open, err := storage.NewIDiskPartition[T](localName, 0, true, true, true)
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			if localName != fileName {
+				open.Persist(false)
+				defer os.Remove(localName + ".header")
+			}
 			if err = group.Get(p).CopyFrom(open); err != nil {
 				return ierror.Raise(err)
 			}
@@ -316,6 +739,10 @@ func (this *IIOImpl) PartitionTextFile(path string, first int64, partitions int6
 	if err != nil {
 		return ierror.Raise(err)
 	}
+	extension, err := this.codecExtension()
+	if err != nil {
+		return ierror.Raise(err)
+	}
 
 	ioCores, err := this.ioCores()
 	if err != nil {
@@ -328,10 +755,11 @@ func (this *IIOImpl) PartitionTextFile(path string, first int64, partitions int6
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			file, err := this.openFileRead(fileName)
+			file, err := this.openTextRead(fileName + extension)
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			defer file.Close()
 			partition := group.Get(p)
 			writeIterator, err := partition.WriteIterator()
 			if err != nil {
@@ -370,126 +798,743 @@ func (this *IIOImpl) PartitionTextFile(path string, first int64, partitions int6
 
 func PartitionJsonFile[T any](this *IIOImpl, path string, first int64, partitions int64) error {
 	logger.Info("IO: reading partition json file")
-	return ierror.RaiseMsg("Not implemented yet") //TODO
+	if err := this.PartitionTextFile(path, first, partitions); err != nil {
+		return ierror.Raise(err)
+	}
+	return jsonLines[T](this, nil)
 }
 
-func SaveAsObjectFile[T any](this *IIOImpl, path string, compression int8, first int64) error {
-	logger.Info("IO: saving as object file")
-	group, err := core.GetAndDeletePartitions[T](this.executorData)
+type IJsonLineError struct {
+	Line  string `json:"line"`
+	Cause string `json:"cause"`
+}
+
+/*Malformed lines found in permissive mode, only the first jsonErrorsMax lines are kept*/
+type IJsonErrors struct {
+	Count int64            `json:"count"`
+	Lines []IJsonLineError `json:"lines"`
+}
+
+const jsonErrorsMax = 1000
+
+func (this *IJsonErrors) add(line string, err error) {
+	this.Count++
+	if len(this.Lines) < jsonErrorsMax {
+		this.Lines = append(this.Lines, IJsonLineError{line, err.Error()})
+	}
+}
+
+func JsonFile[T any](this *IIOImpl, path string, minPartitions int64, permissive bool) (*IJsonErrors, error) {
+	logger.Info("IO: reading json lines file")
+	if err := this.plainOrTextFile(path, minPartitions, "\n"); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	var errors *IJsonErrors
+	if permissive {
+		errors = &IJsonErrors{}
+	}
+	if err := jsonLines[T](this, errors); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return errors, nil
+}
+
+func jsonLines[T any](this *IIOImpl, errors *IJsonErrors) error {
+	input, err := core.GetAndDeletePartitions[string](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-
-	ioCores, err := this.ioCores()
+	output, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), input.Size())
 	if err != nil {
 		return ierror.Raise(err)
 	}
 
-	if err := ithreads.ParallelT(ioCores, func(rctx ithreads.IRuntimeContext) error {
-		return rctx.For().Dynamic().Run(group.Size(), func(p int) error {
-			var fileName string
-			if err := rctx.Critical(func() error {
-				fileName, err = this.partitionFileName(path, first+int64(p))
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				line, err := reader.Next()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				file, err := this.openFileWrite(fileName) //Only to check
-				if err != nil {
+				if len(strings.TrimSpace(line)) == 0 {
+					continue
+				}
+				var elem T
+				if err := json.Unmarshal([]byte(line), &elem); err != nil {
+					if errors == nil {
+						return ierror.RaiseMsgCause("invalid json line '"+line+"'", err)
+					}
+					if err := rctx.Critical(func() error {
+						errors.add(line, err)
+						return nil
+					}); err != nil {
+						return ierror.Raise(err)
+					}
+					continue
+				}
+				if err := writer.Write(elem); err != nil {
 					return ierror.Raise(err)
 				}
-				_ = file.Close()
-				return err
-			}); err != nil {
-				return ierror.Raise(err)
 			}
+			input.SetBase(p, nil)
+			return output.Get(p).Fit()
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if errors != nil && errors.Count > 0 {
+		logger.Warn("IO: ", errors.Count, " malformed json lines skipped")
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*
+Key and value pairs of the Hadoop SequenceFiles matched by path. The files are cut in byte ranges like text files,
+every range starts at a sync marker, and writables are decoded to Go values, see ifs.DecodeWritable.
+*/
+func (this *IIOImpl) SequenceFile(path string, minPartitions int64) error {
+	logger.Info("IO: reading sequence file")
+	fsys, err := this.executorData.GetPartitionTools().FileSystem(path)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	files, err := ifs.Glob(fsys, path)
+	if err != nil {
+		return ierror.RaiseMsgCause(path+" was not found", err)
+	}
+	if len(files) == 0 {
+		return ierror.RaiseMsg("no file matches " + path)
+	}
+	size := int64(0)
+	for _, file := range files {
+		size += file.Size
+	}
+	logger.Info("IO: ", len(files), " files have ", size, " Bytes")
+	result, err := core.NewPartitionGroupDef[ipair.IPair[any, any]](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	ioCores, err := this.ioCores()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	splits, err := this.textSplits(fsys, files, ioCores, false)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	threadGroup := make([]*storage.IPartitionGroup[ipair.IPair[any, any]], ioCores)
+	elements := int64(0)
+
+	if err := ithreads.ParallelT(ioCores, func(rctx ithreads.IRuntimeContext) error {
+		id := rctx.ThreadId()
+		threads := this.executorData.GetContext().Executors() * ioCores
+		minPartitionSize, err := this.executorData.GetProperties().PartitionMinimal()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		minPartitions := int64(math.Ceil(float64(minPartitions) / float64(threads)))
+		threadBytes := int64(0)
+		for _, split := range splits[id] {
+			threadBytes += split.end - split.init
+		}
+		if minPartitions > 0 && threadBytes/minPartitionSize < minPartitions {
+			minPartitionSize = utils.Max(1, threadBytes/minPartitions)
+		}
 
-			save, err := storage.NewIDiskPartition[T](fileName, 0, true, true, false)
+		tools := this.executorData.GetPartitionTools()
+		if threadGroup[id], err = core.NewPartitionGroupDef[ipair.IPair[any, any]](tools); err != nil {
+			return ierror.Raise(err)
+		}
+		var writeIterator iterator.IWriteIterator[ipair.IPair[any, any]]
+		partitionBytes := minPartitionSize + 1
+		threadElements := int64(0)
+		for _, split := range splits[id] {
+			file, err := this.openFileRead(split.path)
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			if err = group.Get(p).CopyTo(save); err != nil {
+			err = readSequenceSplit(file, split, func(key any, value any, n int64) error {
+				if partitionBytes > minPartitionSize {
+					partition, err := core.NewPartitionDef[ipair.IPair[any, any]](tools)
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					if writeIterator, err = partition.WriteIterator(); err != nil {
+						return ierror.Raise(err)
+					}
+					threadGroup[id].Add(partition)
+					partitionBytes = 0
+				}
+				partitionBytes += n
+				threadElements++
+				return writeIterator.Write(ipair.IPair[any, any]{First: key, Second: value})
+			})
+			file.Close()
+			if err != nil {
 				return ierror.Raise(err)
 			}
-			if err = save.Sync(); err != nil {
+		}
+		for _, part := range threadGroup[id].Iter() {
+			if err = part.Fit(); err != nil {
 				return ierror.Raise(err)
 			}
-			group.SetBase(p, nil)
+		}
+		return rctx.Critical(func() error {
+			elements += threadElements
 			return nil
 		})
 	}); err != nil {
 		return err
 	}
 
+	for _, group := range threadGroup {
+		for _, part := range group.Iter() {
+			result.Add(part)
+		}
+	}
+
+	logger.Info("IO: created ", result.Size(), " partitions, ", elements, " records and ", size, " Bytes read")
+	core.SetPartitions(this.executorData, result)
 	return nil
 }
 
-func SaveAsTextFile[T any](this *IIOImpl, path string, first int64) error {
-	logger.Info("IO: saving as text file")
-	group, err := core.GetAndDeletePartitions[T](this.executorData)
+/*f receives every record of the range and its serialized size*/
+func readSequenceSplit(file ifs.IFileReader, split iTextSplit, f func(key any, value any, n int64) error) error {
+	reader, err := ifs.NewISequenceReader(file, split.init, split.end)
+	if err != nil {
+		return ierror.RaiseMsgCause(split.path+" is not a valid sequence file", err)
+	}
+	for {
+		rawKey, rawValue, ok, err := reader.NextRaw()
+		if err != nil {
+			return ierror.RaiseMsgCause(split.path+" is not a valid sequence file", err)
+		} else if !ok {
+			return nil
+		}
+		key, err := ifs.DecodeWritable(reader.KeyClass(), rawKey)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		value, err := ifs.DecodeWritable(reader.ValueClass(), rawValue)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = f(key, value, int64(len(rawKey)+len(rawValue))); err != nil {
+			return err
+		}
+	}
+}
+
+/*Block of a binary file, offset and length in bytes*/
+type iBinaryBlock struct {
+	path     string
+	offset   int64
+	length   int64
+	elements int64
+}
+
+const (
+	binaryFooterMagic = "IGNISIDX"
+	binaryIndexEntry  = 24
+)
+
+/*
+Partitions of the binary files matched by path, see SaveAsBinaryFile. Every block indexed by the footers becomes a
+partition and the blocks are spread in order across the threads of all executors by their size.
+*/
+func BinaryFile[T any](this *IIOImpl, path string) error {
+	logger.Info("IO: reading binary file")
+	fsys, err := this.executorData.GetPartitionTools().FileSystem(path)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	isMemory := this.executorData.GetPartitionTools().IsMemoryGroup(group)
+	files, err := ifs.Glob(fsys, path)
+	if err != nil {
+		return ierror.RaiseMsgCause(path+" was not found", err)
+	}
+	if len(files) == 0 {
+		return ierror.RaiseMsg("no file matches " + path)
+	}
+	var blocks []iBinaryBlock
+	size := int64(0)
+	for _, file := range files {
+		fileBlocks, err := this.binaryIndex(file)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for _, block := range fileBlocks {
+			size += block.length
+		}
+		blocks = append(blocks, fileBlocks...)
+	}
+	logger.Info("IO: ", len(files), " files have ", len(blocks), " blocks and ", size, " Bytes")
 
 	ioCores, err := this.ioCores()
 	if err != nil {
 		return ierror.Raise(err)
 	}
+	executor := this.executorData.GetContext().ExecutorId()
+	threads := this.executorData.GetContext().Executors() * ioCores
+	first, last := 0, 0
+	acum := int64(0)
+	for i, block := range blocks {
+		thread := int(acum * int64(threads) / utils.Max(1, size))
+		if thread < executor*ioCores {
+			first = i + 1
+		}
+		if thread < (executor+1)*ioCores {
+			last = i + 1
+		}
+		acum += block.length
+	}
+	blocks = blocks[first:last]
+	group, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), len(blocks))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	elements := int64(0)
 
 	if err := ithreads.ParallelT(ioCores, func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(len(blocks), func(p int) error {
+			block := blocks[p]
+			file, err := this.openFileRead(block.path)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			defer file.Close()
+			data := make([]byte, block.length)
+			if _, err = file.Seek(block.offset, io.SeekStart); err != nil {
+				return ierror.Raise(err)
+			}
+			if _, err = io.ReadFull(file, data); err != nil {
+				return ierror.RaiseMsgCause(block.path+" is not a valid binary file", err)
+			}
+			part, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), block.elements)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = part.Read(itransport.NewIMemoryBufferWrapper(data, block.length, itransport.OBSERVE)); err != nil {
+				return ierror.RaiseMsgCause(block.path+" is not a valid binary file", err)
+			}
+			if err = group.Get(p).CopyFrom(part); err != nil {
+				return ierror.Raise(err)
+			}
+			if err = group.Get(p).Fit(); err != nil {
+				return ierror.Raise(err)
+			}
+			return rctx.Critical(func() error {
+				elements += block.elements
+				return nil
+			})
+		})
+	}); err != nil {
+		return err
+	}
+
+	logger.Info("IO: created ", group.Size(), " partitions and ", elements, " elements read")
+	core.SetPartitions(this.executorData, group)
+	return nil
+}
+
+/*Blocks of a binary file read from its footer*/
+func (this *IIOImpl) binaryIndex(entry ifs.IFileEntry) ([]iBinaryBlock, error) {
+	invalid := entry.Path + " is not a valid binary file"
+	tail := int64(4 + len(binaryFooterMagic))
+	if entry.Size < tail {
+		return nil, ierror.RaiseMsg(invalid)
+	}
+	file, err := this.openFileRead(entry.Path)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	defer file.Close()
+	footer := make([]byte, tail)
+	if _, err = file.Seek(entry.Size-tail, io.SeekStart); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if _, err = io.ReadFull(file, footer); err != nil {
+		return nil, ierror.RaiseMsgCause(invalid, err)
+	}
+	if string(footer[4:]) != binaryFooterMagic {
+		return nil, ierror.RaiseMsg(invalid)
+	}
+	n := int64(binary.LittleEndian.Uint32(footer))
+	if entry.Size < tail+n*binaryIndexEntry {
+		return nil, ierror.RaiseMsg(invalid)
+	}
+	index := make([]byte, n*binaryIndexEntry)
+	if _, err = file.Seek(entry.Size-tail-int64(len(index)), io.SeekStart); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if _, err = io.ReadFull(file, index); err != nil {
+		return nil, ierror.RaiseMsgCause(invalid, err)
+	}
+	blocks := make([]iBinaryBlock, n)
+	for i := range blocks {
+		entryData := index[i*binaryIndexEntry:]
+		blocks[i] = iBinaryBlock{
+			path:     entry.Path,
+			offset:   int64(binary.LittleEndian.Uint64(entryData)),
+			length:   int64(binary.LittleEndian.Uint64(entryData[8:])),
+			elements: int64(binary.LittleEndian.Uint64(entryData[16:])),
+		}
+		if blocks[i].offset < 0 || blocks[i].length < 0 || blocks[i].offset+blocks[i].length > entry.Size {
+			return nil, ierror.RaiseMsg(invalid)
+		}
+	}
+	return blocks, nil
+}
+
+func SaveAsObjectFile[T any](this *IIOImpl, path string, compression int8, first int64) error {
+	logger.Info("IO: saving as object file")
+	group, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	committer := this.newOutputCommitter(path)
+	ioCores, err := this.ioCores()
+	if err != nil {
+		return committer.commit(ierror.Raise(err))
+	}
+
+	return committer.commit(ithreads.ParallelT(ioCores, func(rctx ithreads.IRuntimeContext) error {
 		return rctx.For().Dynamic().Run(group.Size(), func(p int) error {
-			var fileName string
-			var file *os.File
+			var fileName, tmpName string
 			if err := rctx.Critical(func() error {
 				fileName, err = this.partitionFileName(path, first+int64(p))
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				file, err = this.openFileWrite(fileName)
-				if err != nil {
-					return ierror.Raise(err)
-				}
-				return err
+				tmpName, err = committer.reserve(fileName)
+				return ierror.Raise(err)
 			}); err != nil {
 				return ierror.Raise(err)
 			}
-			defer file.Close()
 
-			if isMemory {
-				list := group.Get(p).Inner().(storage.IList)
-				if err = iio.Print(file, list.Array()); err != nil {
-					return ierror.Raise(err)
-				}
-			} else {
-				it, err := group.Get(p).ReadIterator()
-				if err != nil {
+			localName := tmpName
+			if !ifs.IsLocal(tmpName) {
+				if localName, err = this.executorData.GetPartitionTools().Diskpath(""); err != nil {
 					return ierror.Raise(err)
 				}
-				if err = iio.Print(file, it); err != nil {
+			}
+			save, err := storage.NewIDiskPartition[T](localName, 0, true, true, false)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = group.Get(p).CopyTo(save); err != nil {
+				return ierror.Raise(err)
+			}
+			if err = save.Sync(); err != nil {
+				return ierror.Raise(err)
+			}
+			if localName != tmpName {
+				save.Persist(false)
+				defer os.Remove(localName + ".header")
+				if err = this.pushPartitionFile(localName, tmpName); err != nil {
 					return ierror.Raise(err)
 				}
 			}
-
+			committer.add(tmpName+".header", fileName+".header")
+			committer.add(tmpName, fileName)
 			group.SetBase(p, nil)
 			return nil
 		})
+	}))
+}
+
+func SaveAsTextFile[T any](this *IIOImpl, path string, first int64) error {
+	logger.Info("IO: saving as text file")
+	group, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	isMemory := this.executorData.GetPartitionTools().IsMemoryGroup(group)
+
+	return savePartitionFiles(this, group, path, first, func(thread int, p int, buffer *bufio.Writer) error {
+		if isMemory {
+			list := group.Get(p).Inner().(storage.IList)
+			return iio.Print(buffer, list.Array())
+		}
+		it, err := group.Get(p).ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		return iio.Print(buffer, it)
+	})
+}
+
+/*Writes the line returned by f for every element*/
+func SaveAsTextFileBy[T any](this *IIOImpl, f function.IFunction[T, string], path string, first int64) error {
+	logger.Info("IO: saving as text file with a format function")
+	context := this.executorData.GetContext()
+	group, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+
+	if err = savePartitionFiles(this, group, path, first, func(thread int, p int, buffer *bufio.Writer) error {
+		context := this.executorData.GetThreadContext(thread)
+		it, err := group.Get(p).ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for it.HasNext() {
+			elem, err := it.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			line, err := f.Call(elem, context)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if _, err = buffer.WriteString(line); err != nil {
+				return ierror.Raise(err)
+			}
+			if err = buffer.WriteByte('\n'); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		return nil
 	}); err != nil {
 		return err
 	}
 
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
 	return nil
 }
 
 func SaveAsJsonFile[T any](this *IIOImpl, path string, first int64, pretty bool) error {
-	return ierror.RaiseMsg("Not implemented yet") //TODO
+	logger.Info("IO: saving as json file")
+	group, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	return savePartitionFiles(this, group, path, first, func(thread int, p int, buffer *bufio.Writer) error {
+		encoder := json.NewEncoder(buffer)
+		if pretty {
+			encoder.SetIndent("", "  ")
+		}
+		it, err := group.Get(p).ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for it.HasNext() {
+			elem, err := it.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = encoder.Encode(elem); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		return nil
+	})
+}
+
+/*
+Writes a Hadoop SequenceFile per partition, the elements must be pairs of values with a writable class, see
+ifs.WritableClass. The classes are taken from the first element of every partition.
+*/
+func SaveAsSequenceFile[T any](this *IIOImpl, path string, compression int8, first int64) error {
+	logger.Info("IO: saving as sequence file")
+	group, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	return savePartitionFilesAs(this, group, path, first, "", func(thread int, p int, buffer *bufio.Writer) error {
+		it, err := group.Get(p).ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		var writer *ifs.ISequenceWriter
+		for it.HasNext() {
+			elem, err := it.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			pair, ok := any(&elem).(ipair.IAbstractPair)
+			if !ok {
+				return ierror.RaiseMsg("sequence files can only store pairs, found " + utils.TypeName[T]())
+			}
+			if writer == nil {
+				keyClass, err := ifs.WritableClass(pair.GetFirst())
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				valueClass, err := ifs.WritableClass(pair.GetSecond())
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if writer, err = ifs.NewISequenceWriter(buffer, keyClass, valueClass, int(compression)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			if err = writer.Append(pair.GetFirst(), pair.GetSecond()); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		if writer == nil {
+			if writer, err = ifs.NewISequenceWriter(buffer, ifs.WritableNull, ifs.WritableNull, 0); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		return ierror.Raise(writer.Close())
+	})
+}
+
+/*
+Writes a file per partition made of blocks in the binary format of the partitions, followed by a footer that indexes
+the blocks so that they can be read in parallel. The footer has the offset, length and elements of every block as
+little endian int64, the number of blocks as uint32 and the magic IGNISIDX.
+*/
+func SaveAsBinaryFile[T any](this *IIOImpl, path string, compression int8, first int64) error {
+	logger.Info("IO: saving as binary file")
+	group, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	blockBytes, err := this.executorData.GetProperties().IoBinaryBlock()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	elemBytes := int64(utils.TypeObj[T]().Size())
+	if !iio.IsContiguous[T]() {
+		if elemBytes, err = this.executorData.GetProperties().TransportElemSize(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	blockElements := utils.Max(1, blockBytes/utils.Max(1, elemBytes))
+
+	return savePartitionFilesAs(this, group, path, first, "", func(thread int, p int, buffer *bufio.Writer) error {
+		it, err := group.Get(p).ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		var index bytes.Buffer
+		offset := int64(0)
+		blocks := uint32(0)
+		var entry [binaryIndexEntry]byte
+		for it.HasNext() {
+			block, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), blockElements)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writeIterator, err := block.WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for i := int64(0); i < blockElements && it.HasNext(); i++ {
+				elem, err := it.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writeIterator.Write(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			data := itransport.NewIMemoryBuffer()
+			if err = block.Write(data, compression); err != nil {
+				return ierror.Raise(err)
+			}
+			if _, err = buffer.Write(data.Bytes()); err != nil {
+				return ierror.Raise(err)
+			}
+			binary.LittleEndian.PutUint64(entry[:], uint64(offset))
+			binary.LittleEndian.PutUint64(entry[8:], uint64(data.WriteEnd()))
+			binary.LittleEndian.PutUint64(entry[16:], uint64(block.Size()))
+			index.Write(entry[:])
+			offset += data.WriteEnd()
+			blocks++
+		}
+		binary.LittleEndian.PutUint32(entry[:], blocks)
+		index.Write(entry[:4])
+		index.WriteString(binaryFooterMagic)
+		_, err = buffer.Write(index.Bytes())
+		return ierror.Raise(err)
+	})
+}
+
+/*Writes a file per partition with print, the files are published by an output committer*/
+func savePartitionFiles[T any](this *IIOImpl, group *storage.IPartitionGroup[T], path string, first int64,
+	print func(thread int, p int, buffer *bufio.Writer) error) error {
+	extension, err := this.codecExtension()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return savePartitionFilesAs(this, group, path, first, extension, print)
+}
+
+/*Same as savePartitionFiles for formats that are not compressed by the codec, every file name ends with extension*/
+func savePartitionFilesAs[T any](this *IIOImpl, group *storage.IPartitionGroup[T], path string, first int64,
+	extension string, print func(thread int, p int, buffer *bufio.Writer) error) error {
+	committer := this.newOutputCommitter(path)
+	ioCores, err := this.ioCores()
+	if err != nil {
+		return committer.commit(ierror.Raise(err))
+	}
+
+	return committer.commit(ithreads.ParallelT(ioCores, func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(group.Size(), func(p int) error {
+			var file *iCommitWriter
+			if err := rctx.Critical(func() error {
+				fileName, err := this.partitionFileName(path, first+int64(p))
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				file, err = committer.create(fileName + extension)
+				return ierror.Raise(err)
+			}); err != nil {
+				return ierror.Raise(err)
+			}
+			defer file.Abort()
+			buffer := itransport.GetWriter(file)
+			defer itransport.PutWriter(buffer)
+
+			if err := print(rctx.ThreadId(), p, buffer); err != nil {
+				return ierror.Raise(err)
+			}
+			if err := buffer.Flush(); err != nil {
+				return ierror.Raise(err)
+			}
+			if err := file.Commit(); err != nil {
+				return ierror.RaiseMsgCause(file.path+" can not be written", err)
+			}
+
+			group.SetBase(p, nil)
+			return nil
+		})
+	}))
 }
 
 func (this *IIOImpl) partitionFileName(path string, index int64) (string, error) {
-	if info, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
-		if err = os.MkdirAll(path, os.ModePerm); err != nil {
+	fsys, err := this.executorData.GetPartitionTools().FileSystem(path)
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	if info, err := fsys.Stat(path); err == ifs.ErrNotExist {
+		if err = fsys.MkdirAll(path); err != nil {
 			return "", ierror.RaiseMsgCause("Unable to create directory "+path, err)
 		}
-	} else if errors.Is(err, fs.ErrExist) && !info.IsDir() {
+	} else if err != nil {
+		return "", ierror.Raise(err)
+	} else if !info.Dir {
 		return "", ierror.RaiseMsg("Unable to create directory " + path)
 	}
 
@@ -498,15 +1543,19 @@ func (this *IIOImpl) partitionFileName(path string, index int64) (string, error)
 	return path + "/part" + strings.Repeat("0", zeros) + strIndex, nil
 }
 
-func (this *IIOImpl) openFileRead(path string) (*os.File, error) {
+func (this *IIOImpl) openFileRead(path string) (ifs.IFileReader, error) {
 	logger.Info("IO: opening file ", path)
-	if info, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+	fsys, err := this.executorData.GetPartitionTools().FileSystem(path)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if info, err := fsys.Stat(path); err != nil {
 		return nil, ierror.RaiseMsgCause(path+" was not found", err)
-	} else if !info.Mode().IsRegular() {
+	} else if info.Dir {
 		return nil, ierror.RaiseMsg(path + " was not a file")
 	}
 
-	file, err := os.Open(path)
+	file, err := fsys.Open(path)
 	if err != nil {
 		return nil, ierror.RaiseMsgCause(path+" cannot be opened", err)
 	}
@@ -514,30 +1563,153 @@ func (this *IIOImpl) openFileRead(path string) (*os.File, error) {
 	return file, nil
 }
 
-func (this *IIOImpl) openFileWrite(path string) (*os.File, error) {
-	logger.Info("IO: creating file ", path)
-	if _, err := os.Stat(path); errors.Is(err, fs.ErrExist) {
+/*Codec of the property, or the one of the extension of path when the property is not set*/
+func (this *IIOImpl) fileCodec(path string) (ifs.IFileCodec, error) {
+	name, err := this.executorData.GetProperties().IoCodec()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	} else if name == "" {
+		return ifs.FileCodecFromPath(path), nil
+	}
+	codec, err := ifs.FileCodec(name)
+	return codec, ierror.Raise(err)
+}
+
+/*Extension of the partition files, text and json partitions are compressed with the codec of the property*/
+func (this *IIOImpl) codecExtension() (string, error) {
+	name, err := this.executorData.GetProperties().IoCodec()
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	codec, err := ifs.FileCodec(name)
+	if err != nil || codec == nil {
+		return "", ierror.Raise(err)
+	}
+	return codec.Extension(), nil
+}
+
+/*Closes the codec and then the file below it*/
+type iCodecReader struct {
+	io.ReadCloser
+	file io.Closer
+}
+
+func (this *iCodecReader) Close() error {
+	err := this.ReadCloser.Close()
+	if err2 := this.file.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+type iCodecWriter struct {
+	io.WriteCloser
+	file io.Closer
+}
+
+func (this *iCodecWriter) Close() error {
+	err := this.WriteCloser.Close()
+	if err2 := this.file.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+/*Opens a file decompressing its content when it has a codec, a glob or directory opens its first file*/
+func (this *IIOImpl) openTextRead(path string) (io.ReadCloser, error) {
+	fsys, err := this.executorData.GetPartitionTools().FileSystem(path)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if info, err := fsys.Stat(path); ifs.HasGlob(path) || (err == nil && info.Dir) {
+		files, err := ifs.Glob(fsys, path)
+		if err != nil {
+			return nil, ierror.RaiseMsgCause(path+" was not found", err)
+		} else if len(files) == 0 {
+			return nil, ierror.RaiseMsg("no file matches " + path)
+		}
+		path = files[0].Path
+	}
+	file, err := this.openFileRead(path)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	codec, err := this.fileCodec(path)
+	if err != nil || codec == nil {
+		return file, ierror.Raise(err)
+	}
+	decoder, err := codec.NewReader(file)
+	if err != nil {
+		file.Close()
+		return nil, ierror.RaiseMsgCause(path+" can not be decompressed", err)
+	}
+	return &iCodecReader{decoder, file}, nil
+}
+
+func (this *IIOImpl) checkOverwrite(fsys ifs.IFileSystem, path string) error {
+	if _, err := fsys.Stat(path); err == nil {
 		if o, err := this.executorData.GetProperties().IoOverwrite(); err != nil {
-			return nil, ierror.Raise(err)
-		} else {
-			if o {
-				logger.Warn("IO: ", path, " already exists")
-				if err = os.Remove(path); err != nil {
-					return nil, ierror.RaiseMsgCause(path+" can not be removed", err)
-				}
-			} else {
-				return nil, ierror.RaiseMsg(path + " already exists")
+			return ierror.Raise(err)
+		} else if o {
+			logger.Warn("IO: ", path, " already exists")
+			if err = fsys.Remove(path); err != nil {
+				return ierror.RaiseMsgCause(path+" can not be removed", err)
 			}
+		} else {
+			return ierror.RaiseMsg(path + " already exists")
 		}
-		return nil, ierror.RaiseMsgCause(path+" was not found", err)
 	}
+	return nil
+}
 
-	file, err := os.Create(path)
+func (this *IIOImpl) copyFile(src string, dst string) error {
+	in, err := this.openFileRead(src)
 	if err != nil {
-		return nil, ierror.RaiseMsgCause(path+" cannot be opened", err)
+		return ierror.Raise(err)
 	}
-	logger.Info("IO: file created successful")
-	return file, nil
+	defer in.Close()
+	fsys, err := this.executorData.GetPartitionTools().FileSystem(dst)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	out, err := fsys.Create(dst)
+	if err != nil {
+		return ierror.RaiseMsgCause(dst+" cannot be opened", err)
+	}
+	if _, err = io.Copy(out, in); err != nil {
+		out.Close()
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(out.Close())
+}
+
+func (this *IIOImpl) fetchPartitionFile(path string) (string, error) {
+	if ifs.IsLocal(path) {
+		file, err := this.openFileRead(path) //Only to check
+		if err != nil {
+			return "", ierror.Raise(err)
+		}
+		_ = file.Close()
+		return path, nil
+	}
+	local, err := this.executorData.GetPartitionTools().Diskpath("")
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	if err = this.copyFile(path+".header", local+".header"); err != nil {
+		return "", ierror.Raise(err)
+	}
+	if err = this.copyFile(path, local); err != nil {
+		return "", ierror.Raise(err)
+	}
+	return local, nil
+}
+
+func (this *IIOImpl) pushPartitionFile(local string, path string) error {
+	if err := this.copyFile(local+".header", path+".header"); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(this.copyFile(local, path))
 }
 
 func (this *IIOImpl) ioCores() (int, error) {
@@ -0,0 +1,148 @@
+package impl
+
+import (
+	"context"
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/iio"
+	"ignis/executor/core/itransport"
+)
+
+/*Serializes keys to compare them by their bytes, so keys do not need to be comparable*/
+type iKeyEncoder[K any] struct {
+	buffer *itransport.IMemoryBuffer
+	proto  thrift.TProtocol
+}
+
+func newIKeyEncoder[K any]() *iKeyEncoder[K] {
+	buffer := itransport.NewIMemoryBuffer()
+	return &iKeyEncoder[K]{buffer, thrift.NewTCompactProtocolConf(buffer, &thrift.TConfiguration{})}
+}
+
+/*The bytes are only valid until the next call*/
+func (this *iKeyEncoder[K]) Encode(key K) ([]byte, error) {
+	this.buffer.ResetBuffer()
+	if err := iio.Write(this.proto, key); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := this.proto.Flush(context.Background()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return this.buffer.Bytes(), nil
+}
+
+/*
+Open addressing table with linear probing indexed by the serialized keys. Keys and values are stored in typed slices
+and the serialized keys in a single arena, so adding an element does not allocate unless the table grows.
+*/
+type iKeyTable[K any, T any] struct {
+	slots  []int32
+	hashes []uint64
+	keys   []K
+	values []T
+	ends   []int
+	arena  []byte
+}
+
+const keyTableMinSlots = 64
+
+func newIKeyTable[K any, T any]() *iKeyTable[K, T] {
+	return &iKeyTable[K, T]{slots: make([]int32, keyTableMinSlots)}
+}
+
+func (this *iKeyTable[K, T]) Len() int {
+	return len(this.keys)
+}
+
+func (this *iKeyTable[K, T]) bytes(i int) []byte {
+	if i == 0 {
+		return this.arena[:this.ends[0]]
+	}
+	return this.arena[this.ends[i-1]:this.ends[i]]
+}
+
+/*Slot of the key, it holds zero if the key is not in the table*/
+func (this *iKeyTable[K, T]) find(hash uint64, data []byte) int {
+	mask := uint64(len(this.slots) - 1)
+	for s := hash & mask; ; s = (s + 1) & mask {
+		i := this.slots[s] - 1
+		if i < 0 || (this.hashes[i] == hash && string(this.bytes(int(i))) == string(data)) {
+			return int(s)
+		}
+	}
+}
+
+func (this *iKeyTable[K, T]) grow() {
+	this.slots = make([]int32, len(this.slots)*2)
+	mask := uint64(len(this.slots) - 1)
+	for i, hash := range this.hashes {
+		s := hash & mask
+		for this.slots[s] != 0 {
+			s = (s + 1) & mask
+		}
+		this.slots[s] = int32(i + 1)
+	}
+}
+
+/*Combines value with the value of the key using f, data and hash must be the serialized key and its hash*/
+func (this *iKeyTable[K, T]) Add(hash uint64, data []byte, key K, value T, f func(T, T) (T, error)) error {
+	s := this.find(hash, data)
+	if i := this.slots[s] - 1; i >= 0 {
+		var err error
+		if this.values[i], err = f(this.values[i], value); err != nil {
+			return ierror.Raise(err)
+		}
+		return nil
+	}
+	this.slots[s] = int32(len(this.keys) + 1)
+	this.hashes = append(this.hashes, hash)
+	this.keys = append(this.keys, key)
+	this.values = append(this.values, value)
+	this.arena = append(this.arena, data...)
+	this.ends = append(this.ends, len(this.arena))
+	if len(this.keys)*4 >= len(this.slots)*3 {
+		this.grow()
+	}
+	return nil
+}
+
+/*Position of the key in insertion order, -1 if the key is not in the table*/
+func (this *iKeyTable[K, T]) Index(hash uint64, data []byte) int {
+	return int(this.slots[this.find(hash, data)]) - 1
+}
+
+func (this *iKeyTable[K, T]) Value(i int) T {
+	return this.values[i]
+}
+
+/*Adds the elements of other combining the values of the keys in both tables with f*/
+func (this *iKeyTable[K, T]) Merge(other *iKeyTable[K, T], f func(T, T) (T, error)) error {
+	for i := range other.keys {
+		if err := this.Add(other.hashes[i], other.bytes(i), other.keys[i], other.values[i], f); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+/*Visits the elements in insertion order and empties the table*/
+func (this *iKeyTable[K, T]) Flush(f func(hash uint64, key K, value T) error) error {
+	for i := range this.keys {
+		if err := f(this.hashes[i], this.keys[i], this.values[i]); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	var zk K
+	var zt T
+	for i := range this.keys {
+		this.keys[i] = zk
+		this.values[i] = zt
+	}
+	this.slots = make([]int32, keyTableMinSlots)
+	this.hashes = this.hashes[:0]
+	this.keys = this.keys[:0]
+	this.values = this.values[:0]
+	this.ends = this.ends[:0]
+	this.arena = this.arena[:0]
+	return nil
+}
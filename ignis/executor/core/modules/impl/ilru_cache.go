@@ -0,0 +1,65 @@
+package impl
+
+import (
+	"container/list"
+	"ignis/executor/core/ierror"
+)
+
+type iLruEntry[K comparable, T any] struct {
+	key   K
+	value T
+}
+
+type iLruCache[K comparable, T any] struct {
+	capacity int
+	elems    map[K]*list.Element
+	order    *list.List
+}
+
+func newILruCache[K comparable, T any](capacity int) *iLruCache[K, T] {
+	return &iLruCache[K, T]{
+		capacity: capacity,
+		elems:    make(map[K]*list.Element, capacity),
+		order:    list.New(),
+	}
+}
+
+func (this *iLruCache[K, T]) Len() int {
+	return len(this.elems)
+}
+
+func (this *iLruCache[K, T]) Fold(key K, value T, f func(T, T) (T, error), evict func(K, T) error) error {
+	if elem, present := this.elems[key]; present {
+		entry := elem.Value.(*iLruEntry[K, T])
+		result, err := f(entry.value, value)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		entry.value = result
+		this.order.MoveToFront(elem)
+		return nil
+	}
+	if len(this.elems) >= this.capacity {
+		back := this.order.Back()
+		entry := back.Value.(*iLruEntry[K, T])
+		this.order.Remove(back)
+		delete(this.elems, entry.key)
+		if err := evict(entry.key, entry.value); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	this.elems[key] = this.order.PushFront(&iLruEntry[K, T]{key, value})
+	return nil
+}
+
+func (this *iLruCache[K, T]) Flush(evict func(K, T) error) error {
+	for elem := this.order.Back(); elem != nil; elem = elem.Prev() {
+		entry := elem.Value.(*iLruEntry[K, T])
+		if err := evict(entry.key, entry.value); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	this.elems = make(map[K]*list.Element, this.capacity)
+	this.order.Init()
+	return nil
+}
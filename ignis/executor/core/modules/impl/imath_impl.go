@@ -1,16 +1,25 @@
 package impl
 
 import (
+	"container/heap"
+	"fmt"
+	"ignis/executor/api"
+	"ignis/executor/api/function"
+	"ignis/executor/api/ihyperloglog"
 	"ignis/executor/api/ipair"
-	"ignis/executor/api/iterator"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/impi"
 	"ignis/executor/core/ithreads"
+	"ignis/executor/core/itransport"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
+	"math"
+	"math/big"
 	"math/rand"
+	"sort"
+	"strconv"
 )
 
 type IMathImpl struct {
@@ -61,13 +70,13 @@ func Sample[T any](this *IMathImpl, withReplacement bool, num []int64, seed int3
 			if withReplacement {
 				if ok {
 					for i := int64(0); i < num[p]; i++ {
-						if err := writer.Write(array[dist.Intn(int(size-1))]); err != nil {
+						if err := writer.Write(array[dist.Intn(int(size))]); err != nil {
 							return ierror.Raise(err)
 						}
 					}
 				} else {
 					for i := int64(0); i < num[p]; i++ {
-						if err := writer.Write(list.GetAny(dist.Intn(int(size - 1))).(T)); err != nil {
+						if err := writer.Write(list.GetAny(dist.Intn(int(size))).(T)); err != nil {
 							return ierror.Raise(err)
 						}
 					}
@@ -222,69 +231,233 @@ func SampleByKey[T any, K comparable](this *IMathImpl, withReplacement bool, see
 	return Sample[T](this, withReplacement, num, seed)
 }
 
-func CountByKey[T any, K comparable](this *IMathImpl) error {
+/*
+Fractions of keys that are not comparable can not be stored in a map, so they must be given as a
+[]ipair.IPair[K, float64]. The table is indexed by the serialized keys in the order of the list.
+*/
+func serializedFractions[K any](this *IMathImpl) (*iKeyTable[K, float64], error) {
+	fractions, ok := this.Context().Vars()["fractions"].([]ipair.IPair[K, float64])
+	if !ok {
+		return nil, ierror.RaiseMsg("fractions of " + utils.TypeName[K]() + " keys must be a list of pairs, " +
+			"the keys are not comparable")
+	}
+	table := newIKeyTable[K, float64]()
+	encoder := newIKeyEncoder[K]()
+	for _, fraction := range fractions {
+		data, err := encoder.Encode(fraction.First)
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		if err = table.Add(utils.HashBytes(data), data, fraction.First, fraction.Second, func(_ float64, last float64) (float64, error) {
+			return last, nil
+		}); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	return table, nil
+}
+
+/*SampleByKeyFilter for keys that are not comparable, keys are identified by their serialization*/
+func SampleBySerializedKeyFilter[K any, T any](this *IMathImpl) (int64, error) {
 	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
 	if err != nil {
-		return ierror.Raise(err)
+		return 0, ierror.Raise(err)
+	}
+	tmp, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	fractions, err := serializedFractions[K](this)
+	if err != nil {
+		return 0, ierror.Raise(err)
 	}
-	threads := this.executorData.GetCores()
-	acum := make([]map[K]int64, threads)
-	logger.Info("Math: counting local keys ", input.Size(), " partitions")
 
+	logger.Info("Math: filtering serialized key before sample ", +input.Size(), " partitions")
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		id := rctx.ThreadId()
-		if err := rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+		encoder := newIKeyEncoder[K]()
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			reader, err := input.Get(p).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			writer, err := tmp.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
 			for reader.HasNext() {
 				elem, err := reader.Next()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				acum[id][elem.First]++
+				data, err := encoder.Encode(elem.First)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if fractions.Index(utils.HashBytes(data), data) >= 0 {
+					if err = writer.Write(elem); err != nil {
+						return ierror.Raise(err)
+					}
+				}
 			}
 			input.SetBase(p, nil)
-			return nil
-		}); err != nil {
-			return ierror.Raise(err)
+			return ierror.Raise(tmp.Get(p).Fit())
+		})
+	}); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	for _, part := range tmp.Iter() {
+		if !part.Empty() {
+			output.Add(part)
 		}
-		distance := 1
-		order := 1
-		for order < threads {
-			rctx.Barrier()
-			order *= 2
-			if id%order == 0 {
-				other := id + distance
-				distance = order
-				if order >= threads {
+	}
+	numPartitions := impi.C_int64(utils.Min(output.Size(), fractions.Len()))
+	if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&numPartitions), 1, impi.MPI_LONG, impi.MPI_MAX,
+		this.executorData.Mpi().Native()); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return int64(numPartitions), nil
+}
+
+/*
+SampleByKey for keys that are not comparable, the values of each key must be already grouped. Every key of the
+fractions gets its own partition that is sampled with its fraction of the values.
+*/
+func SampleBySerializedKey[K any, T any](this *IMathImpl, withReplacement bool, seed int32) error {
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, []T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	fractions, err := serializedFractions[K](this)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), fractions.Len())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	num := make([]int64, fractions.Len())
+	logger.Info("Math: sampleByKey copying values of ", fractions.Len(), " serialized keys to their partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		encoder := newIKeyEncoder[K]()
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				data, err := encoder.Encode(elem.First)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				pos := fractions.Index(utils.HashBytes(data), data)
+				if pos < 0 {
 					continue
 				}
-				for key, value := range acum[other] {
-					acum[id][key] += value
+				/*Values are grouped, so each key is found only once and its partition has a single writer*/
+				num[pos] = int64(float64(len(elem.Second)) * fractions.Value(pos))
+				writer, err := output.Get(pos).WriteIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				for _, value := range elem.Second {
+					if err = writer.Write(*ipair.New[K, T](elem.First, value)); err != nil {
+						return ierror.Raise(err)
+					}
 				}
 			}
-		}
-		return nil
+			input.SetBase(p, nil)
+			return nil
+		})
 	}); err != nil {
 		return ierror.Raise(err)
 	}
-	return countByReduce[K](this, acum[0])
+	core.SetPartitions(this.executorData, output)
+	return Sample[ipair.IPair[K, T]](this, withReplacement, num, seed)
 }
 
-func CountByValue[T comparable, K any](this *IMathImpl) error {
+/*Samples exactly n elements, or all of them when there are fewer than n and withReplacement is false*/
+func TakeSample[T any](this *IMathImpl, withReplacement bool, n int64, seed int32) error {
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Math: takeSample ", n, " elements from ", input.Size(), " partitions")
+	output, err := exactSample[T](this, input, 1, func(T) int { return 0 }, func(totals []int64) []int64 {
+		if !withReplacement || totals[0] == 0 {
+			return []int64{utils.Min(n, totals[0])}
+		}
+		return []int64{n}
+	}, withReplacement, seed)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Samples exactly ceil(fraction * count) values of every key in fractions*/
+func SampleByKeyExact[T any, K comparable](this *IMathImpl, withReplacement bool, seed int32) error {
 	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	threads := this.executorData.GetCores()
-	acum := make([]map[T]int64, threads)
-	logger.Info("Math: counting local keys ", input.Size(), " partitions")
+	fractions := this.Context().Vars()["fractions"].(map[K]float64)
+	keys := make([]K, 0, len(fractions))
+	for key := range fractions {
+		keys = append(keys, key)
+	}
+	/*Every executor must use the same stratum order*/
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%#v", keys[i]) < fmt.Sprintf("%#v", keys[j])
+	})
+	strata := make(map[K]int, len(keys))
+	for i, key := range keys {
+		strata[key] = i
+	}
+
+	logger.Info("Math: sampleByKeyExact ", len(keys), " keys from ", input.Size(), " partitions")
+	output, err := exactSample[ipair.IPair[K, T]](this, input, len(keys), func(elem ipair.IPair[K, T]) int {
+		if s, found := strata[elem.First]; found {
+			return s
+		}
+		return -1
+	}, func(totals []int64) []int64 {
+		targets := make([]int64, len(totals))
+		for s, total := range totals {
+			targets[s] = int64(math.Ceil(float64(total) * fractions[keys[s]]))
+			if !withReplacement || total == 0 {
+				targets[s] = utils.Min(targets[s], total)
+			}
+		}
+		return targets
+	}, withReplacement, seed)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
 
+/*
+Counts the elements of each stratum in every partition of the cluster, splits the targets over the partitions with
+hypergeometric (binomial with replacement) draws that are the same in all executors, and then every partition draws
+its exact share in a single pass. Elements with a negative stratum are discarded.
+*/
+func exactSample[T any](this *IMathImpl, input *storage.IPartitionGroup[T], strata int, stratum func(T) int,
+	targets func(totals []int64) []int64, withReplacement bool, seed int32) (*storage.IPartitionGroup[T], error) {
+	mpi := this.executorData.Mpi()
+	local := make([]impi.C_int64, utils.Max(input.Size()*strata, 1))
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		id := rctx.ThreadId()
-		if err := rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			reader, err := input.Get(p).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
@@ -294,74 +467,175 @@ func CountByValue[T comparable, K any](this *IMathImpl) error {
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				acum[id][elem.Second]++
+				if s := stratum(elem); s >= 0 {
+					local[p*strata+s]++
+				}
 			}
-			input.SetBase(p, nil)
 			return nil
-		}); err != nil {
-			return ierror.Raise(err)
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+
+	parts := make([]impi.C_int64, mpi.Executors())
+	nparts := impi.C_int64(input.Size())
+	if err := impi.MPI_Allgather(impi.P(&nparts), 1, impi.MPI_LONG_LONG_INT, impi.P(&parts[0]), 1,
+		impi.MPI_LONG_LONG_INT, mpi.Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	szv := make([]impi.C_int, mpi.Executors())
+	displs := make([]impi.C_int, mpi.Executors())
+	offset := 0
+	total := 0
+	for i, n := range parts {
+		if i == mpi.Rank() {
+			offset = total
 		}
-		distance := 1
-		order := 1
-		for order < threads {
-			rctx.Barrier()
-			order *= 2
-			if id%order == 0 {
-				other := id + distance
-				distance = order
-				if order >= threads {
+		szv[i] = impi.C_int(int(n) * strata)
+		displs[i] = impi.C_int(total * strata)
+		total += int(n)
+	}
+	global := make([]impi.C_int64, utils.Max(total*strata, 1))
+	if err := impi.MPI_Allgatherv(impi.P(&local[0]), szv[mpi.Rank()], impi.MPI_LONG_LONG_INT, impi.P(&global[0]),
+		&szv[0], &displs[0], impi.MPI_LONG_LONG_INT, mpi.Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+
+	totals := make([]int64, strata)
+	for p := 0; p < total; p++ {
+		for s := 0; s < strata; s++ {
+			totals[s] += int64(global[p*strata+s])
+		}
+	}
+	need := targets(totals)
+	dist := rand.New(rand.NewSource(int64(seed)))
+	allocation := make([]int64, total*strata)
+	for s := 0; s < strata; s++ {
+		remaining, target := totals[s], need[s]
+		for p := 0; p < total && target > 0; p++ {
+			count := int64(global[p*strata+s])
+			var x int64
+			if withReplacement {
+				x = sampleBinomial(dist, target, float64(count)/float64(remaining))
+			} else {
+				x = sampleHypergeometric(dist, remaining, count, target)
+			}
+			allocation[p*strata+s] = x
+			target -= x
+			remaining -= count
+		}
+	}
+
+	output, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			dist := rand.New(rand.NewSource(int64(seed) + int64(offset+p) + 1))
+			picks := append([]int64{}, allocation[(offset+p)*strata:(offset+p+1)*strata]...)
+			remaining := make([]int64, strata)
+			for s := range remaining {
+				remaining[s] = int64(local[p*strata+s])
+			}
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				s := stratum(elem)
+				if s < 0 || picks[s] == 0 {
 					continue
 				}
-				for key, value := range acum[other] {
-					acum[id][key] += value
+				copies := int64(0)
+				if withReplacement {
+					copies = sampleBinomial(dist, picks[s], 1/float64(remaining[s]))
+				} else if dist.Float64()*float64(remaining[s]) < float64(picks[s]) {
+					copies = 1
+				}
+				for i := int64(0); i < copies; i++ {
+					if err = writer.Write(elem); err != nil {
+						return ierror.Raise(err)
+					}
 				}
+				picks[s] -= copies
+				remaining[s]--
 			}
-		}
-		return nil
+			input.SetBase(p, nil)
+			return ierror.Raise(output.Get(p).Fit())
+		})
 	}); err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
-	return countByReduce[T](this, acum[0])
+	return output, nil
 }
 
-func countByReduce[K comparable](this *IMathImpl, acum map[K]int64) error {
-	logger.Info("Math: reducing global counting")
-	executors := this.executorData.Mpi().Executors()
-	group, err := core.NewPartitionGroupDef[ipair.IPair[K, int64]](this.executorData.GetPartitionTools())
-	if err != nil {
-		return ierror.Raise(err)
-	}
-	output, err := core.NewPartitionGroupDef[ipair.IPair[K, int64]](this.executorData.GetPartitionTools())
-	if err != nil {
-		return ierror.Raise(err)
+/*Successes in n trials with probability p, counted by jumping over the failures*/
+func sampleBinomial(dist *rand.Rand, n int64, p float64) int64 {
+	if p <= 0 || n <= 0 {
+		return 0
+	} else if p >= 1 {
+		return n
+	} else if p > 0.5 {
+		return n - sampleBinomial(dist, n, 1-p)
 	}
-	writers := make([]iterator.IWriteIterator[ipair.IPair[K, int64]], executors)
-	for i := 0; i < executors; i++ {
-		group.AddMemoryPartition(1000)
-		wit, err := group.Get(i).WriteIterator()
-		if err != nil {
-			return ierror.Raise(err)
+	logq := math.Log1p(-p)
+	x := int64(0)
+	for pos := int64(0); ; x++ {
+		pos += int64(math.Log(1-dist.Float64())/logq) + 1
+		if pos > n {
+			return x
 		}
-		writers[i] = wit
 	}
-	hasher := utils.GetHasher(utils.TypeObj[K]())
-	for key, value := range acum {
-		if err := writers[utils.Hash(key, hasher)%uint64(executors)].Write(*ipair.New(key, value)); err != nil {
-			return ierror.Raise(err)
+}
+
+/*Elements taken from a group of k when drawing n of total without replacement*/
+func sampleHypergeometric(dist *rand.Rand, total int64, k int64, n int64) int64 {
+	if n <= 0 || k <= 0 {
+		return 0
+	} else if k >= total {
+		return n
+	}
+	low, high := utils.Max(0, n-(total-k)), utils.Min(n, k)
+	if low == high {
+		return low
+	}
+	if utils.Min(n, k) > 1<<16 {
+		/*Normal approximation, the exact simulation cost grows with the sample*/
+		pk := float64(k) / float64(total)
+		sd := math.Sqrt(float64(n) * pk * (1 - pk) * float64(total-n) / float64(total-1))
+		x := int64(math.Round(float64(n)*pk + sd*dist.NormFloat64()))
+		return utils.Max(low, utils.Min(high, x))
+	}
+	if k < n {
+		k, n = n, k
+	}
+	x := int64(0)
+	for i := int64(0); i < n; i++ {
+		if dist.Float64()*float64(total-i) < float64(k-x) {
+			x++
 		}
 	}
-	if err := Exchange(this.Base(), group, output); err != nil {
+	return x
+}
+
+func CountByKey[T any, K comparable](this *IMathImpl) error {
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
 		return ierror.Raise(err)
 	}
-	acum = map[K]int64{}
-	part := output.Get(0)
-
-	if array, ok := part.Inner().(storage.IList).Array().([]ipair.IPair[K, int64]); ok {
-		for i := 0; i < len(array); i++ {
-			acum[array[i].First] += array[i].Second
-		}
-	} else {
-		reader, err := part.ReadIterator()
+	logger.Info("Math: counting local keys ", input.Size(), " partitions")
+	normalize := utils.Normalizer[K]()
+	acum, err := countByThreads[K](this, input.Size(), func(p int, acum map[K]int64) error {
+		reader, err := input.Get(p).ReadIterator()
 		if err != nil {
 			return ierror.Raise(err)
 		}
@@ -370,23 +644,1227 @@ func countByReduce[K comparable](this *IMathImpl, acum map[K]int64) error {
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			acum[elem.First] += elem.Second
+			if normalize != nil {
+				elem.First = normalize(elem.First)
+			}
+			acum[elem.First]++
 		}
-	}
-	if err = part.Clear(); err != nil {
+		input.SetBase(p, nil)
+		return nil
+	})
+	if err != nil {
 		return ierror.Raise(err)
 	}
-	writer, err := part.WriteIterator()
+	return countByReduce[K](this, iCountMap[K](acum))
+}
+
+func CountByValue[T comparable, K any](this *IMathImpl) error {
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-
-	for key, value := range acum {
-		if err = writer.Write(*ipair.New(key, value)); err != nil {
+	logger.Info("Math: counting local values ", input.Size(), " partitions")
+	normalize := utils.Normalizer[T]()
+	acum, err := countByThreads[T](this, input.Size(), func(p int, acum map[T]int64) error {
+		reader, err := input.Get(p).ReadIterator()
+		if err != nil {
 			return ierror.Raise(err)
 		}
+		for reader.HasNext() {
+			elem, err := reader.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if normalize != nil {
+				elem.Second = normalize(elem.Second)
+			}
+			acum[elem.Second]++
+		}
+		input.SetBase(p, nil)
+		return nil
+	})
+	if err != nil {
+		return ierror.Raise(err)
 	}
+	return countByReduce[T](this, iCountMap[T](acum))
+}
 
+/*CountByKey for keys that are not comparable, keys are compared by their serialization, so it must be deterministic*/
+func CountBySerializedKey[K any, T any](this *IMathImpl) error {
+	logger.Info("Math: counting local serialized keys")
+	return countBySerialized[K, T, K](this, func(elem *ipair.IPair[K, T]) K { return elem.First })
+}
+
+/*CountByValue for values that are not comparable, values are compared by their serialization*/
+func CountBySerializedValue[K any, T any](this *IMathImpl) error {
+	logger.Info("Math: counting local serialized values")
+	return countBySerialized[K, T, T](this, func(elem *ipair.IPair[K, T]) T { return elem.Second })
+}
+
+func countBySerialized[K any, T any, C any](this *IMathImpl, field func(elem *ipair.IPair[K, T]) C) error {
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	threads := this.executorData.GetCores()
+	acum := make([]*iSerializedCount[C], threads)
+	if err = ithreads.ParallelT(threads, func(rctx ithreads.IRuntimeContext) error {
+		id := rctx.ThreadId()
+		acum[id] = newISerializedCount[C]()
+		if err := rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = acum[id].add(field(&elem), 1); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		distance := 1
+		order := 1
+		for order < threads {
+			rctx.Barrier()
+			order *= 2
+			if id%order == 0 {
+				other := id + distance
+				if other < threads {
+					if err := acum[id].table.Merge(acum[other].table, countSum); err != nil {
+						return ierror.Raise(err)
+					}
+					acum[other] = nil
+				}
+			}
+			distance = order
+		}
+		return nil
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	return countByReduce[C](this, acum[0])
+}
+
+func countByThreads[K comparable](this *IMathImpl, n int, f func(p int, acum map[K]int64) error) (map[K]int64, error) {
+	threads := this.executorData.GetCores()
+	acum := make([]map[K]int64, threads)
+	if err := ithreads.ParallelT(threads, func(rctx ithreads.IRuntimeContext) error {
+		id := rctx.ThreadId()
+		acum[id] = map[K]int64{}
+		if err := rctx.For().Dynamic().Run(n, func(p int) error {
+			return f(p, acum[id])
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		distance := 1
+		order := 1
+		for order < threads {
+			rctx.Barrier()
+			order *= 2
+			if id%order == 0 {
+				other := id + distance
+				if other < threads {
+					for key, value := range acum[other] {
+						acum[id][key] += value
+					}
+					acum[other] = nil
+				}
+			}
+			distance = order
+		}
+		return nil
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return acum[0], nil
+}
+
+func countByReduce[K any](this *IMathImpl, acum iKeyAcum[K, int64]) error {
+	limit, err := this.executorData.GetProperties().CountMaxKeys()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Math: reducing global counting")
+	return keyResultReduce[K, int64](this.Base(), acum, limit, "count")
+}
+
+/*
+Merges the results of every executor in a binary tree, the whole result is left in the executor 0 so it can be
+returned to the driver. The result can not have more than limit keys, zero disables the limit, nor be larger than
+ignis.modules.result.max.
+*/
+func keyResultReduce[K any, V any](this *IBaseImpl, acum iKeyAcum[K, V], limit int64, name string) error {
+	output, err := core.NewPartitionGroupDef[ipair.IPair[K, V]](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	executors := this.executorData.Mpi().Executors()
+	rank := this.executorData.Mpi().Rank()
+	exceeded := limit > 0 && int64(acum.Len()) > limit
+
+	distance := 1
+	order := 1
+	for order < executors {
+		order *= 2
+		if rank%order == 0 {
+			other := rank + distance
+			distance = order
+			if other >= executors {
+				continue
+			}
+			part, err := core.NewMemoryPartition[ipair.IPair[K, V]](this.executorData.GetPartitionTools(), 0)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = core.Recv[ipair.IPair[K, V]](this.executorData.Mpi(), part, other, 0); err != nil {
+				return ierror.Raise(err)
+			}
+			if exceeded {
+				continue
+			}
+			if err = acum.merge(part); err != nil {
+				return ierror.Raise(err)
+			}
+			exceeded = limit > 0 && int64(acum.Len()) > limit
+		} else {
+			part, err := core.NewMemoryPartition[ipair.IPair[K, V]](this.executorData.GetPartitionTools(), int64(acum.Len()))
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if !exceeded {
+				if err = acum.write(part); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			if err = core.Send[ipair.IPair[K, V]](this.executorData.Mpi(), part, rank-distance, 0); err != nil {
+				return ierror.Raise(err)
+			}
+			break
+		}
+	}
+
+	flag := impi.C_int(utils.Ternary(exceeded, 1, 0))
+	if err = impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&flag), 1, impi.MPI_INT, impi.MPI_MAX,
+		this.executorData.Mpi().Native()); err != nil {
+		return ierror.Raise(err)
+	}
+	if flag != 0 {
+		return ierror.RaiseMsg(name + " result has more than " + strconv.FormatInt(limit, 10) +
+			" keys, increase ignis.modules.count.max or use a distributed reduction")
+	}
+
+	if this.executorData.Mpi().IsRoot(0) {
+		part, err := core.NewMemoryPartition[ipair.IPair[K, V]](this.executorData.GetPartitionTools(), int64(acum.Len()))
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = acum.write(part); err != nil {
+			return ierror.Raise(err)
+		}
+		output.Add(part)
+	}
+	if err = resultSizeCheck(this, output, name); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*The serialized size of the result held by the executor 0 is shared so every executor fails when it is too large*/
+func resultSizeCheck[T any](this *IBaseImpl, result *storage.IPartitionGroup[T], name string) error {
+	limit, err := this.executorData.GetProperties().ResultMax()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if limit == 0 {
+		return nil
+	}
+	size := int64(0)
+	if this.executorData.Mpi().IsRoot(0) {
+		buffer := itransport.NewIMemoryBuffer()
+		for _, part := range result.Iter() {
+			if err = part.Write(buffer, 0); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		size = buffer.WriteEnd()
+	}
+	if err = impi.MPI_Bcast(impi.P(&size), 1, impi.MPI_INT64_T, 0, this.executorData.Mpi().Native()); err != nil {
+		return ierror.Raise(err)
+	}
+	if size > limit {
+		return ierror.RaiseMsg(name + " result has " + strconv.FormatInt(size, 10) + " bytes, it exceeds the " +
+			strconv.FormatInt(limit, 10) + " bytes of ignis.modules.result.max, increase it or use a distributed reduction")
+	}
+	return nil
+}
+
+/*Results of an executor by key, they are merged with the results of other executors in keyResultReduce*/
+type iKeyAcum[K any, V any] interface {
+	Len() int
+	merge(part storage.IPartition[ipair.IPair[K, V]]) error
+	write(part storage.IPartition[ipair.IPair[K, V]]) error
+}
+
+type iCountMap[K comparable] map[K]int64
+
+func (this iCountMap[K]) Len() int {
+	return len(this)
+}
+
+func (this iCountMap[K]) merge(part storage.IPartition[ipair.IPair[K, int64]]) error {
+	if array, ok := part.Inner().(storage.IList).Array().([]ipair.IPair[K, int64]); ok {
+		for i := 0; i < len(array); i++ {
+			this[array[i].First] += array[i].Second
+		}
+		return nil
+	}
+	reader, err := part.ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		this[elem.First] += elem.Second
+	}
+	return nil
+}
+
+func (this iCountMap[K]) write(part storage.IPartition[ipair.IPair[K, int64]]) error {
+	writer, err := part.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for key, value := range this {
+		if err = writer.Write(*ipair.New(key, value)); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+/*Counts of keys that are not comparable, indexed by their serialization*/
+type iSerializedCount[K any] struct {
+	encoder *iKeyEncoder[K]
+	table   *iKeyTable[K, int64]
+}
+
+func newISerializedCount[K any]() *iSerializedCount[K] {
+	return &iSerializedCount[K]{newIKeyEncoder[K](), newIKeyTable[K, int64]()}
+}
+
+func countSum(a int64, b int64) (int64, error) {
+	return a + b, nil
+}
+
+func (this *iSerializedCount[K]) add(key K, n int64) error {
+	data, err := this.encoder.Encode(key)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return this.table.Add(utils.HashBytes(data), data, key, n, countSum)
+}
+
+func (this *iSerializedCount[K]) Len() int {
+	return this.table.Len()
+}
+
+func (this *iSerializedCount[K]) merge(part storage.IPartition[ipair.IPair[K, int64]]) error {
+	reader, err := part.ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = this.add(elem.First, elem.Second); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+/*The counts are only written once, so the table is emptied*/
+func (this *iSerializedCount[K]) write(part storage.IPartition[ipair.IPair[K, int64]]) error {
+	writer, err := part.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return this.table.Flush(func(hash uint64, key K, value int64) error {
+		return writer.Write(*ipair.New(key, value))
+	})
+}
+
+type iSumFunction[T utils.Integer] struct {
+	function.IOnlyCall
+	mode string
+}
+
+func (this *iSumFunction[T]) Call(v1 T, v2 T, context api.IContext) (T, error) {
+	r, overflow := checkedAdd(v1, v2, this.mode)
+	if overflow {
+		return r, ierror.RaiseMsg("integer overflow in sum, use saturate or promote in ignis.modules.math.overflow")
+	}
+	return r, nil
+}
+
+type iBigSumFunction struct {
+	function.IOnlyCall
+}
+
+func (this *iBigSumFunction) Call(v1 *big.Int, v2 *big.Int, context api.IContext) (*big.Int, error) {
+	return new(big.Int).Add(v1, v2), nil
+}
+
+/*Sum of the integer elements, ignis.modules.math.overflow selects the behavior when the sum overflows*/
+func Sum[T any](this *IMathImpl) error {
+	var t any = new(T)
+	switch t.(type) {
+	case *int:
+		return sumInteger[int](this)
+	case *int8:
+		return sumInteger[int8](this)
+	case *int16:
+		return sumInteger[int16](this)
+	case *int32:
+		return sumInteger[int32](this)
+	case *int64:
+		return sumInteger[int64](this)
+	case *uint:
+		return sumInteger[uint](this)
+	case *uint8:
+		return sumInteger[uint8](this)
+	case *uint16:
+		return sumInteger[uint16](this)
+	case *uint32:
+		return sumInteger[uint32](this)
+	case *uint64:
+		return sumInteger[uint64](this)
+	}
+	return ierror.RaiseMsg(utils.TypeName[T]() + " is not an integer type")
+}
+
+/*Mean of the integer elements as float64, the sum follows ignis.modules.math.overflow*/
+func Mean[T any](this *IMathImpl) error {
+	var t any = new(T)
+	switch t.(type) {
+	case *int:
+		return meanInteger[int](this)
+	case *int8:
+		return meanInteger[int8](this)
+	case *int16:
+		return meanInteger[int16](this)
+	case *int32:
+		return meanInteger[int32](this)
+	case *int64:
+		return meanInteger[int64](this)
+	case *uint:
+		return meanInteger[uint](this)
+	case *uint8:
+		return meanInteger[uint8](this)
+	case *uint16:
+		return meanInteger[uint16](this)
+	case *uint32:
+		return meanInteger[uint32](this)
+	case *uint64:
+		return meanInteger[uint64](this)
+	}
+	return ierror.RaiseMsg(utils.TypeName[T]() + " is not an integer type")
+}
+
+/*Sum of the integer values of every key, ignis.modules.math.overflow selects the behavior when a sum overflows*/
+func SumByKey[K comparable, V any](this *IMathImpl, numPartitions int64) error {
+	var t any = new(V)
+	switch t.(type) {
+	case *int:
+		return sumByKeyInteger[K, int](this, numPartitions)
+	case *int8:
+		return sumByKeyInteger[K, int8](this, numPartitions)
+	case *int16:
+		return sumByKeyInteger[K, int16](this, numPartitions)
+	case *int32:
+		return sumByKeyInteger[K, int32](this, numPartitions)
+	case *int64:
+		return sumByKeyInteger[K, int64](this, numPartitions)
+	case *uint:
+		return sumByKeyInteger[K, uint](this, numPartitions)
+	case *uint8:
+		return sumByKeyInteger[K, uint8](this, numPartitions)
+	case *uint16:
+		return sumByKeyInteger[K, uint16](this, numPartitions)
+	case *uint32:
+		return sumByKeyInteger[K, uint32](this, numPartitions)
+	case *uint64:
+		return sumByKeyInteger[K, uint64](this, numPartitions)
+	}
+	return ierror.RaiseMsg(utils.TypeName[V]() + " is not an integer type")
+}
+
+func sumInteger[T utils.Integer](this *IMathImpl) error {
+	mode, err := this.executorData.GetProperties().MathOverflow()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Math: sum ", input.Size(), " partitions with ", mode, " overflow")
+	if mode == "promote" {
+		sum, _, err := bigSum[T](this, input)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		return ierror.Raise(sumOutput(this, sum))
+	}
+	sum, _, err := checkedSum[T](this, input, mode)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(sumOutput(this, sum))
+}
+
+func meanInteger[T utils.Integer](this *IMathImpl) error {
+	mode, err := this.executorData.GetProperties().MathOverflow()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Math: mean ", input.Size(), " partitions with ", mode, " overflow")
+	var mean float64
+	if mode == "promote" {
+		sum, count, err := bigSum[T](this, input)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if count > 0 {
+			mean, _ = new(big.Float).Quo(new(big.Float).SetInt(sum), big.NewFloat(float64(count))).Float64()
+		}
+	} else {
+		sum, count, err := checkedSum[T](this, input, mode)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if count > 0 {
+			mean = float64(sum) / float64(count)
+		}
+	}
+	return ierror.Raise(sumOutput(this, mean))
+}
+
+func sumByKeyInteger[K comparable, T utils.Integer](this *IMathImpl, numPartitions int64) error {
+	mode, err := this.executorData.GetProperties().MathOverflow()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	reduceImpl := NewIReduceImpl(this.executorData)
+	if mode != "promote" {
+		return ierror.Raise(ReduceByKey[K, T](reduceImpl, &iSumFunction[T]{mode: mode}, numPartitions, true))
+	}
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, *big.Int]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Math: promoting ", input.Size(), " partitions to big integers")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writer.Write(ipair.IPair[K, *big.Int]{elem.First, bigInt(elem.Second)}); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return ierror.Raise(ReduceByKey[K, *big.Int](reduceImpl, &iBigSumFunction{}, numPartitions, true))
+}
+
+func CountApproxDistinct[T any](this *IMathImpl, relativeSD float64) (int64, error) {
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return 0, ierror.Raise(err)
+	}
+	precision := ihyperloglog.PrecisionFor(relativeSD)
+	hasher := utils.GetHasher(utils.TypeObj[T]())
+	logger.Info("Math: estimating distinct elements with ", 1<<precision, " registers")
+	threads := this.executorData.GetCores()
+	sketches := make([]*ihyperloglog.IHyperLogLog, threads)
+	if err = ithreads.ParallelT(threads, func(rctx ithreads.IRuntimeContext) error {
+		sketch := ihyperloglog.New(precision)
+		sketches[rctx.ThreadId()] = sketch
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				sketch.Add(utils.Hash(elem, hasher))
+			}
+			return nil
+		})
+	}); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	for _, sketch := range sketches[1:] {
+		if err = sketches[0].Merge(sketch); err != nil {
+			return 0, ierror.Raise(err)
+		}
+	}
+	logger.Info("Math: merging global sketch")
+	if err = this.executorData.Mpi().ReduceRegisters(sketches[0].Registers(), 0); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	if !this.executorData.Mpi().IsRoot(0) {
+		return 0, nil
+	}
+	return sketches[0].Count(), nil
+}
+
+func CountApproxDistinctByKey[K comparable, T any](this *IMathImpl, relativeSD float64, numPartitions int64) error {
+	precision := ihyperloglog.PrecisionFor(relativeSD)
+	hasher := utils.GetHasher(utils.TypeObj[T]())
+	logger.Info("Math: estimating distinct values by key with ", 1<<precision, " registers")
+	if err := CombineByKey[K, T, []byte](NewIReduceImpl(this.executorData), &iSketchCreateFunction[T]{precision: precision, hasher: hasher},
+		&iSketchAddFunction[T]{hasher: hasher}, &iSketchMergeFunction{}, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, []byte]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, int64]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				sketch, err := ihyperloglog.FromRegisters(elem.Second)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writer.Write(ipair.IPair[K, int64]{elem.First, sketch.Count()}); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+type iSketchCreateFunction[T any] struct {
+	function.IOnlyCall
+	precision int
+	hasher    utils.Hasher
+}
+
+func (this *iSketchCreateFunction[T]) Call(v T, context api.IContext) ([]byte, error) {
+	sketch := ihyperloglog.New(this.precision)
+	sketch.Add(utils.Hash(v, this.hasher))
+	return sketch.Registers(), nil
+}
+
+type iSketchAddFunction[T any] struct {
+	function.IOnlyCall
+	hasher utils.Hasher
+}
+
+func (this *iSketchAddFunction[T]) Call(registers []byte, v T, context api.IContext) ([]byte, error) {
+	sketch, err := ihyperloglog.FromRegisters(registers)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	sketch.Add(utils.Hash(v, this.hasher))
+	return registers, nil
+}
+
+type iSketchMergeFunction struct {
+	function.IOnlyCall
+}
+
+func (this *iSketchMergeFunction) Call(a []byte, b []byte, context api.IContext) ([]byte, error) {
+	sketch, err := ihyperloglog.FromRegisters(a)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	other, err := ihyperloglog.FromRegisters(b)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err = sketch.Merge(other); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return a, nil
+}
+
+func TopN[T any](this *IMathImpl, n int64) error {
+	f, err := defaultCmp[T]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return takeOrderedHeap(this, func(a T, b T) bool { return f(b, a) }, n)
+}
+
+func TakeOrderedN[T any](this *IMathImpl, n int64) error {
+	f, err := defaultCmp[T]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return takeOrderedHeap(this, f, n)
+}
+
+func takeOrderedHeap[T any](this *IMathImpl, before func(T, T) bool, n int64) error {
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Math: top/takeOrdered ", n, " elements using bounded heaps")
+	threads := this.executorData.GetCores()
+	heaps := make([]*iBoundedHeap[T], threads)
+	if err = ithreads.ParallelT(threads, func(rctx ithreads.IRuntimeContext) error {
+		local := newIBoundedHeap(before, int(n))
+		heaps[rctx.ThreadId()] = local
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				local.add(elem)
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	for _, local := range heaps[1:] {
+		for _, elem := range local.elems {
+			heaps[0].add(elem)
+		}
+	}
+
+	logger.Info("Math: merging executor heaps")
+	top := storage.NewIMemoryPartitionArray(heaps[0].sorted())
+	if err := core.Gather[T](this.executorData.Mpi(), top, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	if this.executorData.Mpi().IsRoot(0) {
+		global := newIBoundedHeap(before, int(n))
+		reader, err := top.ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for reader.HasNext() {
+			elem, err := reader.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			global.add(elem)
+		}
+		output.Add(storage.NewIMemoryPartitionArray(global.sorted()))
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Keeps the n first elements according to before, the root of the heap is the last element kept*/
+type iBoundedHeap[T any] struct {
+	elems  []T
+	before func(T, T) bool
+	n      int
+}
+
+func newIBoundedHeap[T any](before func(T, T) bool, n int) *iBoundedHeap[T] {
+	return &iBoundedHeap[T]{make([]T, 0, n), before, n}
+}
+
+func (this *iBoundedHeap[T]) Len() int {
+	return len(this.elems)
+}
+
+func (this *iBoundedHeap[T]) Less(i, j int) bool {
+	return this.before(this.elems[j], this.elems[i])
+}
+
+func (this *iBoundedHeap[T]) Swap(i, j int) {
+	this.elems[i], this.elems[j] = this.elems[j], this.elems[i]
+}
+
+func (this *iBoundedHeap[T]) Push(x any) {
+	this.elems = append(this.elems, x.(T))
+}
+
+func (this *iBoundedHeap[T]) Pop() any {
+	last := this.elems[len(this.elems)-1]
+	this.elems = this.elems[:len(this.elems)-1]
+	return last
+}
+
+func (this *iBoundedHeap[T]) add(elem T) {
+	if len(this.elems) < this.n {
+		heap.Push(this, elem)
+	} else if this.n > 0 && this.before(elem, this.elems[0]) {
+		this.elems[0] = elem
+		heap.Fix(this, 0)
+	}
+}
+
+func (this *iBoundedHeap[T]) sorted() []T {
+	result := make([]T, len(this.elems))
+	for i := len(result) - 1; i >= 0; i-- {
+		result[i] = heap.Pop(this).(T)
+	}
+	return result
+}
+
+/*Buckets are evenly spaced between the minimum and the maximum, the output holds the lower bound and count of each*/
+func Histogram[T any](this *IMathImpl, buckets int64) error {
+	if buckets < 1 {
+		return ierror.RaiseMsg("histogram requires at least one bucket")
+	}
+	value, err := floatValue[T]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Math: histogram with ", buckets, " buckets")
+	threads := this.executorData.GetCores()
+	limits := make([][]float64, threads)
+	for i := range limits {
+		limits[i] = []float64{math.Inf(1), math.Inf(1)}
+	}
+	if err = forEachValue(input, threads, value, func(thread int, v float64) {
+		limits[thread][0] = math.Min(limits[thread][0], v)
+		limits[thread][1] = math.Min(limits[thread][1], -v)
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	for _, local := range limits[1:] {
+		limits[0][0] = math.Min(limits[0][0], local[0])
+		limits[0][1] = math.Min(limits[0][1], local[1])
+	}
+	if err = impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&limits[0][0]), 2, impi.MPI_DOUBLE, impi.MPI_MIN,
+		this.executorData.Mpi().Native()); err != nil {
+		return ierror.Raise(err)
+	}
+	min, max := limits[0][0], -limits[0][1]
+	width := (max - min) / float64(buckets)
+
+	counts := make([][]impi.C_int64, threads)
+	for i := range counts {
+		counts[i] = make([]impi.C_int64, buckets)
+	}
+	if min <= max {
+		if err = forEachValue(input, threads, value, func(thread int, v float64) {
+			b := int64(0)
+			if width > 0 {
+				b = utils.Min(int64((v-min)/width), buckets-1)
+			}
+			counts[thread][b]++
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	for _, local := range counts[1:] {
+		for b := range local {
+			counts[0][b] += local[b]
+		}
+	}
+	rank := this.executorData.Mpi().Rank()
+	if err = impi.MPI_Reduce(utils.Ternary(rank == 0, impi.MPI_IN_PLACE, impi.P(&counts[0][0])), impi.P(&counts[0][0]),
+		impi.C_int(buckets), impi.MPI_LONG_LONG_INT, impi.MPI_SUM, 0, this.executorData.Mpi().Native()); err != nil {
+		return ierror.Raise(err)
+	}
+
+	output, err := core.NewPartitionGroupDef[ipair.IPair[float64, int64]](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if rank == 0 && min <= max {
+		histogram := make([]ipair.IPair[float64, int64], buckets)
+		for b := range histogram {
+			histogram[b] = *ipair.New(min+float64(b)*width, int64(counts[0][b]))
+		}
+		output.Add(storage.NewIMemoryPartitionArray(histogram))
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Quantiles are within relativeError of the exact rank, the output holds one value for each probability*/
+func ApproxQuantile[T any](this *IMathImpl, probabilities []float64, relativeError float64) error {
+	if relativeError <= 0 || relativeError >= 1 {
+		return ierror.RaiseMsg("relativeError must be in (0, 1)")
+	}
+	for _, p := range probabilities {
+		if p < 0 || p > 1 {
+			return ierror.RaiseMsg("probabilities must be in [0, 1]")
+		}
+	}
+	value, err := floatValue[T]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Math: approxQuantile of ", len(probabilities), " probabilities with error ", relativeError)
+	threads := this.executorData.GetCores()
+	summaries := make([]*iQuantileSummary, threads)
+	for i := range summaries {
+		summaries[i] = newIQuantileSummary(relativeError)
+	}
+	if err = forEachValue(input, threads, value, func(thread int, v float64) {
+		summaries[thread].add(v)
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	for _, local := range summaries[1:] {
+		summaries[0].merge(local)
+	}
+
+	logger.Info("Math: merging executor sketches")
+	sketches := storage.NewIMemoryPartitionArray(summaries[0].encode())
+	if err = core.Gather[int64](this.executorData.Mpi(), sketches, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupDef[float64](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if this.executorData.Mpi().IsRoot(0) {
+		all, err := decodeIQuantileSummaries(relativeError, sketches.Inner().(*storage.IListImpl[int64]).Array().([]int64))
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		global := newIQuantileSummary(relativeError)
+		for _, summary := range all {
+			global.merge(summary)
+		}
+		quantiles := make([]float64, len(probabilities))
+		for i, p := range probabilities {
+			quantiles[i] = global.query(p)
+		}
+		output.Add(storage.NewIMemoryPartitionArray(quantiles))
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*NaN values are skipped, f receives the thread id so it can accumulate without locks*/
+func forEachValue[T any](input *storage.IPartitionGroup[T], threads int, value func(T) float64, f func(int, float64)) error {
+	return ithreads.ParallelT(threads, func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if v := value(elem); !math.IsNaN(v) {
+					f(rctx.ThreadId(), v)
+				}
+			}
+			return nil
+		})
+	})
+}
+
+func floatValue[T any]() (func(T) float64, error) {
+	var f any
+	var t any = new(T)
+	switch t.(type) {
+	case *int:
+		f = func(v int) float64 { return float64(v) }
+	case *int8:
+		f = func(v int8) float64 { return float64(v) }
+	case *int16:
+		f = func(v int16) float64 { return float64(v) }
+	case *int32:
+		f = func(v int32) float64 { return float64(v) }
+	case *int64:
+		f = func(v int64) float64 { return float64(v) }
+	case *uint:
+		f = func(v uint) float64 { return float64(v) }
+	case *uint8:
+		f = func(v uint8) float64 { return float64(v) }
+	case *uint16:
+		f = func(v uint16) float64 { return float64(v) }
+	case *uint32:
+		f = func(v uint32) float64 { return float64(v) }
+	case *uint64:
+		f = func(v uint64) float64 { return float64(v) }
+	case *float32:
+		f = func(v float32) float64 { return float64(v) }
+	case *float64:
+		f = func(v float64) float64 { return v }
+	default:
+		return nil, ierror.RaiseMsg(utils.TypeName[T]() + " is not a numeric type")
+	}
+	return f.(func(T) float64), nil
+}
+
+func checkedAdd[T utils.Integer](a T, b T, mode string) (T, bool) {
+	switch mode {
+	case "error":
+		return utils.AddOverflow(a, b)
+	case "saturate":
+		return utils.AddSaturate(a, b), false
+	}
+	return a + b, false
+}
+
+func bigInt[T utils.Integer](v T) *big.Int {
+	if utils.IsSigned[T]() {
+		return big.NewInt(int64(v))
+	}
+	return new(big.Int).SetUint64(uint64(v))
+}
+
+func checkedSum[T utils.Integer](this *IMathImpl, input *storage.IPartitionGroup[T], mode string) (T, int64, error) {
+	sums := make([]T, input.Size())
+	counts := make([]int64, input.Size())
+	overflows := make([]bool, input.Size())
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				var overflow bool
+				sums[p], overflow = checkedAdd(sums[p], elem, mode)
+				overflows[p] = overflows[p] || overflow
+				counts[p]++
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return 0, 0, ierror.Raise(err)
+	}
+
+	var sum T
+	data := []impi.C_int64{0, 0}
+	for p := range sums {
+		var overflow bool
+		sum, overflow = checkedAdd(sum, sums[p], mode)
+		if overflow || overflows[p] {
+			data[1] = 1
+		}
+		data[0] += impi.C_int64(counts[p])
+	}
+	if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&data[0]), 2, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		this.executorData.Mpi().Native()); err != nil {
+		return 0, 0, ierror.Raise(err)
+	}
+	if data[1] > 0 {
+		return 0, 0, ierror.RaiseMsg("integer overflow in sum, use saturate or promote in ignis.modules.math.overflow")
+	}
+
+	partial, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 1)
+	if err != nil {
+		return 0, 0, ierror.Raise(err)
+	}
+	writer, err := partial.WriteIterator()
+	if err != nil {
+		return 0, 0, ierror.Raise(err)
+	}
+	if err = writer.Write(sum); err != nil {
+		return 0, 0, ierror.Raise(err)
+	}
+	if err = core.Gather[T](this.executorData.Mpi(), partial, 0); err != nil {
+		return 0, 0, ierror.Raise(err)
+	}
+	/*Only the root sees the overflow of the executor totals, every executor must raise the same error*/
+	overflow := impi.C_int64(0)
+	if this.executorData.Mpi().IsRoot(0) {
+		sum = 0
+		for _, elem := range partial.Inner().(*storage.IListImpl[T]).Array().([]T) {
+			var elemOverflow bool
+			if sum, elemOverflow = checkedAdd(sum, elem, mode); elemOverflow {
+				overflow = 1
+				break
+			}
+		}
+	}
+	if err := impi.MPI_Bcast(impi.P(&overflow), 1, impi.MPI_LONG_LONG_INT, 0, this.executorData.Mpi().Native()); err != nil {
+		return 0, 0, ierror.Raise(err)
+	}
+	if overflow > 0 {
+		return 0, 0, ierror.RaiseMsg("integer overflow in sum, use saturate or promote in ignis.modules.math.overflow")
+	}
+	if !this.executorData.Mpi().IsRoot(0) {
+		return 0, int64(data[0]), nil
+	}
+	return sum, int64(data[0]), nil
+}
+
+func bigSum[T utils.Integer](this *IMathImpl, input *storage.IPartitionGroup[T]) (*big.Int, int64, error) {
+	sums := make([]*big.Int, input.Size())
+	counts := make([]int64, input.Size())
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			sums[p] = new(big.Int)
+			aux := new(big.Int)
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if utils.IsSigned[T]() {
+					aux.SetInt64(int64(elem))
+				} else {
+					aux.SetUint64(uint64(elem))
+				}
+				sums[p].Add(sums[p], aux)
+				counts[p]++
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return nil, 0, ierror.Raise(err)
+	}
+
+	sum := new(big.Int)
+	count := impi.C_int64(0)
+	for p := range sums {
+		sum.Add(sum, sums[p])
+		count += impi.C_int64(counts[p])
+	}
+	if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&count), 1, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		this.executorData.Mpi().Native()); err != nil {
+		return nil, 0, ierror.Raise(err)
+	}
+	partial, err := core.NewMemoryPartition[string](this.executorData.GetPartitionTools(), 1)
+	if err != nil {
+		return nil, 0, ierror.Raise(err)
+	}
+	writer, err := partial.WriteIterator()
+	if err != nil {
+		return nil, 0, ierror.Raise(err)
+	}
+	if err = writer.Write(sum.String()); err != nil {
+		return nil, 0, ierror.Raise(err)
+	}
+	if err = core.Gather[string](this.executorData.Mpi(), partial, 0); err != nil {
+		return nil, 0, ierror.Raise(err)
+	}
+	if !this.executorData.Mpi().IsRoot(0) {
+		return sum, int64(count), nil
+	}
+	sum.SetInt64(0)
+	aux := new(big.Int)
+	for _, elem := range partial.Inner().(*storage.IListImpl[string]).Array().([]string) {
+		if _, ok := aux.SetString(elem, 10); !ok {
+			return nil, 0, ierror.RaiseMsg("invalid partial sum " + elem)
+		}
+		sum.Add(sum, aux)
+	}
+	return sum, int64(count), nil
+}
+
+func sumOutput[T any](this *IMathImpl, result T) error {
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if this.executorData.Mpi().IsRoot(0) {
+		part, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 1)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		writer, err := part.WriteIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = writer.Write(result); err != nil {
+			return ierror.Raise(err)
+		}
+		output.Add(part)
+	}
 	core.SetPartitions(this.executorData, output)
 	return nil
 }
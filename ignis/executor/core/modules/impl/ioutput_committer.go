@@ -0,0 +1,184 @@
+package impl
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/ifs"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/logger"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const outputTemporary = "_temporary"
+
+/*
+Two phase commit of the files of an output directory. Every executor writes its files inside its own attempt
+directory, so retries never mix their files. Once all executors have written, they vote and only if all of them
+succeeded the files are moved to the output directory, then executor 0 marks the output with a _SUCCESS file.
+*/
+type iOutputCommitter struct {
+	io      *IIOImpl
+	path    string
+	attempt string
+	mutex   sync.Mutex
+	files   [][2]string
+}
+
+func (this *IIOImpl) newOutputCommitter(path string) *iOutputCommitter {
+	path = strings.TrimSuffix(path, "/")
+	id := strconv.Itoa(this.executorData.GetContext().ExecutorId()) + "-" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	return &iOutputCommitter{
+		io:      this,
+		path:    path,
+		attempt: path + "/" + outputTemporary + "/attempt" + id,
+	}
+}
+
+/*Checks that path can be written and returns its name inside the attempt directory*/
+func (this *iOutputCommitter) reserve(path string) (string, error) {
+	fsys, err := this.io.executorData.GetPartitionTools().FileSystem(path)
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	if err = this.io.checkOverwrite(fsys, path); err != nil {
+		return "", ierror.Raise(err)
+	}
+	if err = fsys.MkdirAll(this.attempt); err != nil {
+		return "", ierror.RaiseMsgCause("Unable to create directory "+this.attempt, err)
+	}
+	return this.attempt + strings.TrimPrefix(path, this.path), nil
+}
+
+/*The file is moved from tmp to path when the output is committed*/
+func (this *iOutputCommitter) add(tmp string, path string) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	this.files = append(this.files, [2]string{tmp, path})
+}
+
+/*Creates a file inside the attempt directory compressed with the codec of the extension of path*/
+func (this *iOutputCommitter) create(path string) (*iCommitWriter, error) {
+	tmp, err := this.reserve(path)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	fsys, err := this.io.executorData.GetPartitionTools().FileSystem(tmp)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	file, err := fsys.Create(tmp)
+	if err != nil {
+		return nil, ierror.RaiseMsgCause(tmp+" cannot be opened", err)
+	}
+	codec := ifs.FileCodecFromPath(path)
+	if codec == nil {
+		return &iCommitWriter{file, this, fsys, tmp, path, false}, nil
+	}
+	encoder, err := codec.NewWriter(file)
+	if err != nil {
+		file.Close()
+		_ = fsys.Remove(tmp)
+		return nil, ierror.RaiseMsgCause(path+" can not be compressed", err)
+	}
+	return &iCommitWriter{&iCodecWriter{encoder, file}, this, fsys, tmp, path, false}, nil
+}
+
+/*
+Every executor must call commit with the result of its writes. The output is only published when every executor
+succeeded, otherwise the files of all attempts are removed.
+*/
+func (this *iOutputCommitter) commit(err error) error {
+	fsys, err2 := this.io.executorData.GetPartitionTools().FileSystem(this.path)
+	if err2 != nil && err == nil {
+		err = err2
+	}
+	if ok, err2 := this.vote(err == nil); err2 != nil {
+		return ierror.Raise(err2)
+	} else if !ok {
+		if fsys != nil {
+			this.abort(fsys)
+		}
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		return ierror.RaiseMsg(this.path + " was not saved because another executor failed")
+	}
+
+	logger.Info("IO: committing ", len(this.files), " files of ", this.path)
+	for _, file := range this.files {
+		if err = fsys.Rename(file[0], file[1]); err != nil {
+			err = ierror.RaiseMsgCause(file[1]+" can not be committed", err)
+			break
+		}
+	}
+	_ = fsys.Remove(this.attempt)
+	_ = fsys.Remove(this.path + "/" + outputTemporary)
+	if ok, err2 := this.vote(err == nil); err2 != nil {
+		return ierror.Raise(err2)
+	} else if err != nil {
+		return err
+	} else if !ok {
+		return ierror.RaiseMsg(this.path + " was partially saved because another executor failed to commit")
+	}
+	if this.io.executorData.Mpi().IsRoot(0) {
+		marker, err := fsys.Create(this.path + "/_SUCCESS")
+		if err != nil {
+			return ierror.RaiseMsgCause(this.path+" can not be marked as saved", err)
+		}
+		return ierror.Raise(marker.Close())
+	}
+	return nil
+}
+
+func (this *iOutputCommitter) vote(ok bool) (bool, error) {
+	value := impi.C_int(0)
+	if ok {
+		value = 1
+	}
+	if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&value), 1, impi.MPI_INT, impi.MPI_MIN,
+		this.io.executorData.Mpi().Native()); err != nil {
+		return false, ierror.Raise(err)
+	}
+	return value == 1, nil
+}
+
+func (this *iOutputCommitter) abort(fsys ifs.IFileSystem) {
+	logger.Warn("IO: aborting ", len(this.files), " files of ", this.path)
+	for _, file := range this.files {
+		_ = fsys.Remove(file[0])
+	}
+	_ = fsys.Remove(this.attempt)
+	_ = fsys.Remove(this.path + "/" + outputTemporary)
+}
+
+/*File written inside the attempt directory, Commit closes it and leaves it to the output committer*/
+type iCommitWriter struct {
+	io.WriteCloser
+	committer *iOutputCommitter
+	fsys      ifs.IFileSystem
+	tmp       string
+	path      string
+	done      bool
+}
+
+func (this *iCommitWriter) Commit() error {
+	this.done = true
+	if err := this.WriteCloser.Close(); err != nil {
+		_ = this.fsys.Remove(this.tmp)
+		return err
+	}
+	this.committer.add(this.tmp, this.path)
+	return nil
+}
+
+/*Removes the file if it was not committed*/
+func (this *iCommitWriter) Abort() {
+	if !this.done {
+		this.done = true
+		_ = this.WriteCloser.Close()
+		_ = this.fsys.Remove(this.tmp)
+	}
+}
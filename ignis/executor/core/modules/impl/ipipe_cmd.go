@@ -0,0 +1,228 @@
+package impl
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"ignis/executor/api/iterator"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/iio"
+	"ignis/executor/core/ithreads"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/logger"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+/*
+External process started for each partition of a pipeCmd. The process reads every element of the partition from its
+stdin and the results are taken from its stdout. The executor and its partition number are exported as
+IGNIS_EXECUTOR and IGNIS_PARTITION.
+*/
+type IPipeCommand struct {
+	Args []string
+	Env  []string
+	Dir  string
+}
+
+/*Writes an element to the stdin of the process*/
+type IPipeEncoder[T any] func(w *bufio.Writer, elem T) error
+
+/*Reads the next result from the stdout of the process, io.EOF is returned when there are no more results*/
+type IPipeDecoder[R any] func(r *bufio.Reader) (R, error)
+
+/*One element per line, printed like saveAsTextFile*/
+func PipeTextEncoder[T any]() IPipeEncoder[T] {
+	return func(w *bufio.Writer, elem T) error {
+		if err := iio.Print(w, elem); err != nil {
+			return err
+		}
+		return w.WriteByte('\n')
+	}
+}
+
+func PipeJsonEncoder[T any]() IPipeEncoder[T] {
+	return func(w *bufio.Writer, elem T) error {
+		return json.NewEncoder(w).Encode(elem)
+	}
+}
+
+/*One result per line without the line terminator*/
+func PipeTextDecoder() IPipeDecoder[string] {
+	return func(r *bufio.Reader) (string, error) {
+		line, err := r.ReadString('\n')
+		if err == io.EOF && len(line) > 0 {
+			err = nil
+		} else if err != nil {
+			return "", err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		return strings.TrimSuffix(line, "\r"), nil
+	}
+}
+
+/*One json value per line, empty lines are skipped*/
+func PipeJsonDecoder[R any]() IPipeDecoder[R] {
+	text := PipeTextDecoder()
+	return func(r *bufio.Reader) (result R, err error) {
+		var line string
+		for len(strings.TrimSpace(line)) == 0 {
+			if line, err = text(r); err != nil {
+				return
+			}
+		}
+		if err = json.Unmarshal([]byte(line), &result); err != nil {
+			err = ierror.RaiseMsgCause("invalid json line '"+line+"'", err)
+		}
+		return
+	}
+}
+
+/*Pipes the elements with the encoding name, text elements are returned as string and json elements as any*/
+func PipeCmdEncoding[T any](this *IPipeImpl, command *IPipeCommand, encoding string) error {
+	switch encoding {
+	case "", "text":
+		return PipeCmd[T, string](this, command, PipeTextEncoder[T](), PipeTextDecoder())
+	case "json":
+		return PipeCmd[T, any](this, command, PipeJsonEncoder[T](), PipeJsonDecoder[any]())
+	}
+	return ierror.RaiseMsg("unknown pipe encoding: " + encoding)
+}
+
+func PipeCmd[T any, R any](this *IPipeImpl, command *IPipeCommand, encoder IPipeEncoder[T], decoder IPipeDecoder[R]) error {
+	if len(command.Args) == 0 {
+		return ierror.RaiseMsg("pipe requires a command")
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[R](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	executor := this.executorData.GetContext().ExecutorId()
+
+	logger.Info("General: pipe ", input.Size(), " partitions to ", command.Args[0])
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = pipeProcess(command, executor, p, reader, writer, encoder, decoder); err != nil {
+				return ierror.RaiseUser(err, int64(p))
+			}
+			input.Set(p, nil)
+			return output.Get(p).Fit()
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Keeps the end of the process stderr to report it if the process fails*/
+type iPipeStderr struct {
+	tail []byte
+}
+
+const pipeStderrTail = 4096
+
+func (this *iPipeStderr) Write(p []byte) (int, error) {
+	this.tail = append(this.tail, p...)
+	if len(this.tail) > pipeStderrTail {
+		this.tail = this.tail[len(this.tail)-pipeStderrTail:]
+	}
+	return len(p), nil
+}
+
+func pipeProcess[T any, R any](command *IPipeCommand, executor int, partition int, reader iterator.IReadIterator[T],
+	writer iterator.IWriteIterator[R], encoder IPipeEncoder[T], decoder IPipeDecoder[R]) error {
+	cmd := exec.Command(command.Args[0], command.Args[1:]...)
+	cmd.Dir = command.Dir
+	cmd.Env = append(append(os.Environ(), command.Env...),
+		"IGNIS_EXECUTOR="+strconv.Itoa(executor), "IGNIS_PARTITION="+strconv.Itoa(partition))
+	stderr := &iPipeStderr{}
+	cmd.Stderr = stderr
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = cmd.Start(); err != nil {
+		return ierror.RaiseMsgCause("pipe command "+command.Args[0]+" can not be started", err)
+	}
+
+	fed := make(chan error, 1)
+	go func() {
+		buffer := itransport.GetWriter(stdin)
+		err := func() error {
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return err
+				}
+				if err = encoder(buffer, elem); err != nil {
+					return err
+				}
+			}
+			return buffer.Flush()
+		}()
+		itransport.PutWriter(buffer)
+		if err2 := stdin.Close(); err == nil {
+			err = err2
+		}
+		fed <- err
+	}()
+
+	results := bufio.NewReader(stdout)
+	var readErr error
+	for {
+		elem, err := decoder(results)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			readErr = err
+			_ = cmd.Process.Kill()
+			break
+		}
+		if err = writer.Write(elem); err != nil {
+			readErr = err
+			_ = cmd.Process.Kill()
+			break
+		}
+	}
+	_, _ = io.Copy(io.Discard, stdout)
+	feedErr := <-fed
+	waitErr := cmd.Wait()
+
+	if readErr != nil {
+		return ierror.Raise(readErr)
+	}
+	if waitErr != nil {
+		msg := "pipe command " + command.Args[0] + " failed: " + waitErr.Error()
+		if len(stderr.tail) > 0 {
+			msg += "\n" + strings.TrimSpace(string(stderr.tail))
+		}
+		return ierror.RaiseMsgCause(msg, waitErr)
+	}
+	/*A process may exit successfully without reading all its input*/
+	if feedErr != nil && !errors.Is(feedErr, syscall.EPIPE) && !errors.Is(feedErr, os.ErrClosed) {
+		return ierror.Raise(feedErr)
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package impl
 
 import (
+	"ignis/executor/api"
 	"ignis/executor/api/function"
 	"ignis/executor/api/ipair"
 	"ignis/executor/api/iterator"
@@ -10,6 +11,9 @@ import (
 	"ignis/executor/core/ithreads"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
+	"ignis/executor/core/utils"
+	"reflect"
+	"strings"
 )
 
 type IPipeImpl struct {
@@ -104,33 +108,52 @@ func Map[T any, R any](this *IPipeImpl, f function.IFunction[T, R]) error {
 		return ierror.Raise(err)
 	}
 
+	speculation, err := this.executorData.GetProperties().Speculation()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
 	logger.Info("General: map ", +input.Size(), " partitions")
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
-		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			writer, err := ouput.Get(i).WriteIterator()
+		return rctx.For().Stealing().Speculative(speculation).RunPrivate(input.Size(), func(i int) (func() error, error) {
+			part := input.Get(i)
+			private, err := core.NewPartitionDef[R](this.executorData.GetPartitionTools())
 			if err != nil {
-				return ierror.Raise(err)
+				return nil, ierror.Raise(err)
 			}
-			for reader.HasNext() {
-				elem, err := reader.Next()
+			if err = runPartition(f, i, context, func() error {
+				reader, err := core.PrefetchReadIterator(this.executorData.GetPartitionTools(), part)
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				result, err := f.Call(elem, context)
+				defer storage.CloseIterator(reader)
+				writer, err := private.WriteIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if err = writer.Write(result); err != nil {
-					return ierror.Raise(err)
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					result, err := f.Call(elem, context)
+					if err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
+					if err = writer.Write(result); err != nil {
+						return ierror.Raise(err)
+					}
 				}
+				return nil
+			}); err != nil {
+				return nil, err
 			}
-			input.Set(i, nil)
-			return nil
+			return func() error {
+				ouput.Set(i, private)
+				input.Set(i, nil)
+				return nil
+			}, nil
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -159,32 +182,35 @@ func Filter[T any](this *IPipeImpl, f function.IFunction[T, bool]) error {
 	logger.Info("General: filter ", +input.Size(), " partitions")
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
-		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			writer, err := ouput.Get(i).WriteIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			for reader.HasNext() {
-				elem, err := reader.Next()
+		return rctx.For().Stealing().Run(input.Size(), func(i int) error {
+			return runPartition(f, i, context, func() error {
+				reader, err := core.PrefetchReadIterator(this.executorData.GetPartitionTools(), input.Get(i))
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				result, err := f.Call(elem, context)
+				defer storage.CloseIterator(reader)
+				writer, err := ouput.Get(i).WriteIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if result {
-					if err = writer.Write(elem); err != nil {
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
 						return ierror.Raise(err)
 					}
+					result, err := f.Call(elem, context)
+					if err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
+					if result {
+						if err = writer.Write(elem); err != nil {
+							return ierror.Raise(err)
+						}
+					}
 				}
-			}
-			input.Set(i, nil)
-			return nil
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -213,32 +239,34 @@ func Flatmap[T any, R any](this *IPipeImpl, f function.IFunction[T, []R]) error
 	logger.Info("General: flatmap ", +input.Size(), " partitions")
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
-		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			writer, err := ouput.Get(i).WriteIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			for reader.HasNext() {
-				elem, err := reader.Next()
+		return rctx.For().Stealing().Run(input.Size(), func(i int) error {
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				result, err := f.Call(elem, context)
+				writer, err := ouput.Get(i).WriteIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				for _, e2 := range result {
-					if err = writer.Write(e2); err != nil {
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
 						return ierror.Raise(err)
 					}
+					result, err := f.Call(elem, context)
+					if err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
+					for _, e2 := range result {
+						if err = writer.Write(e2); err != nil {
+							return ierror.Raise(err)
+						}
+					}
 				}
-			}
-			input.Set(i, nil)
-			return nil
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -268,29 +296,31 @@ func KeyBy[T any, R comparable](this *IPipeImpl, f function.IFunction[T, R]) err
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			writer, err := ouput.Get(i).WriteIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			for reader.HasNext() {
-				elem, err := reader.Next()
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				result, err := f.Call(elem, context)
+				writer, err := ouput.Get(i).WriteIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if err = writer.Write(*ipair.New(result, elem)); err != nil {
-					return ierror.Raise(err)
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					result, err := f.Call(elem, context)
+					if err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
+					if err = writer.Write(*ipair.New(result, elem)); err != nil {
+						return ierror.Raise(err)
+					}
 				}
-			}
-			input.Set(i, nil)
-			return nil
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -339,31 +369,33 @@ func MapWithIndex[T any, R any](this *IPipeImpl, f function.IFunction2[int64, T,
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			writer, err := ouput.Get(i).WriteIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			id := offset[i]
-			for reader.HasNext() {
-				elem, err := reader.Next()
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				result, err := f.Call(id, elem, context)
+				writer, err := ouput.Get(i).WriteIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				id++
-				if err = writer.Write(result); err != nil {
-					return ierror.Raise(err)
+				id := offset[i]
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					result, err := f.Call(id, elem, context)
+					if err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
+					id++
+					if err = writer.Write(result); err != nil {
+						return ierror.Raise(err)
+					}
 				}
-			}
-			input.Set(i, nil)
-			return nil
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -390,31 +422,83 @@ func MapPartitions[T any, R any](this *IPipeImpl, f function.IFunction[iterator.
 	}
 
 	logger.Info("General: mapPartitions ", +input.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Stealing().Run(input.Size(), func(i int) error {
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				writer, err := ouput.Get(i).WriteIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				result, err := f.Call(reader, context)
+				if err != nil {
+					return ierror.RaiseUser(err, int64(i))
+				}
+				for _, e2 := range result {
+					if err = writer.Write(e2); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+				input.Set(i, nil)
+				return nil
+			})
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, ouput)
+	return nil
+}
+
+/*
+The function pulls the input elements and pushes its results to the output partition as they are produced, neither
+the input nor the output is materialized in a slice, so the output can be much larger than the memory when the
+partitions are stored in disk.
+*/
+func MapPartitionsStream[T any, R any](this *IPipeImpl,
+	f function.IVoidFunction2[iterator.IReadIterator[T], iterator.IWriteIterator[R]]) error {
+	context := this.executorData.GetContext()
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	ouput, err := core.NewPartitionGroupWithSize[R](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("General: mapPartitions streaming ", +input.Size(), " partitions")
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			writer, err := ouput.Get(i).WriteIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			result, err := f.Call(reader, context)
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			for _, e2 := range result {
-				if err = writer.Write(e2); err != nil {
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
+				if err != nil {
 					return ierror.Raise(err)
 				}
-			}
-			input.Set(i, nil)
-			return nil
+				writer, err := ouput.Get(i).WriteIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = f.Call(reader, writer, context); err != nil {
+					return ierror.RaiseUser(err, int64(i))
+				}
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -457,25 +541,27 @@ func MapPartitionsWithIndex[T, R any](this *IPipeImpl, f function.IFunction2[int
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			writer, err := ouput.Get(i).WriteIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			result, err := f.Call(offset+int64(i), reader, context)
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			for _, e2 := range result {
-				if err = writer.Write(e2); err != nil {
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
+				if err != nil {
 					return ierror.Raise(err)
 				}
-			}
-			input.Set(i, nil)
-			return nil
+				writer, err := ouput.Get(i).WriteIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				result, err := f.Call(offset+int64(i), reader, context)
+				if err != nil {
+					return ierror.RaiseUser(err, int64(i))
+				}
+				for _, e2 := range result {
+					if err = writer.Write(e2); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -520,7 +606,7 @@ func MapExecutor[T any](this *IPipeImpl, f function.IVoidFunction[[][]T]) error
 	arg := make([][]T, input.Size())
 	for i, part := range input.Iter() {
 		list := part.Inner().(storage.IList)
-		if array, ok := list.Array().([]T); ok {
+		if array, ok := storage.SharedArray[T](list); ok {
 			arg[i] = array
 		} else {
 			array := make([]T, list.Size())
@@ -668,21 +754,23 @@ func Foreach[T any](this *IPipeImpl, f function.IVoidFunction[T]) error {
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			for reader.HasNext() {
-				elem, err := reader.Next()
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if err := f.Call(elem, context); err != nil {
-					return ierror.Raise(err)
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					if err := f.Call(elem, context); err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
 				}
-			}
-			input.Set(i, nil)
-			return nil
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -707,18 +795,20 @@ func ForeachPartition[T any](this *IPipeImpl, f function.IVoidFunction[iterator.
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
-			reader, err := input.Get(i).ReadIterator()
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			if err := f.Call(reader, context); err != nil {
-				return ierror.Raise(err)
-			}
-			input.Set(i, nil)
-			return nil
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err := f.Call(reader, context); err != nil {
+					return ierror.RaiseUser(err, int64(i))
+				}
+				input.Set(i, nil)
+				return nil
+			})
 		})
 	}); err != nil {
 		return ierror.Raise(err)
@@ -910,29 +1000,41 @@ func Values[T1 any, T2 any](this *IPipeImpl) error {
 	return nil
 }
 
-func MapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T, R]) error {
-	context := this.executorData.GetContext()
-	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+func Zip[T1 any, T2 any](this *IPipeImpl, other string) error {
+	input, err := core.GetAndDeletePartitions[T1](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	if err := f.Before(context); err != nil {
+	this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+	input2, err := core.GetPartitions[T2](this.executorData)
+	if err != nil {
 		return ierror.Raise(err)
 	}
-	ouput, err := core.NewPartitionGroupWithSize[ipair.IPair[K, R]](this.executorData.GetPartitionTools(), input.Size())
+	if input.Size() != input2.Size() {
+		return ierror.RaiseMsg("Can only zip datasets with the same number of partitions")
+	}
+	for i := 0; i < input.Size(); i++ {
+		if input.Get(i).Size() != input2.Get(i).Size() {
+			return ierror.RaiseMsg("Can only zip datasets with the same number of elements in each partition")
+		}
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[T1, T2]](this.executorData.GetPartitionTools(), input.Size())
 	if err != nil {
 		return ierror.Raise(err)
 	}
 
-	logger.Info("General: mapValues ", +input.Size(), " partitions")
+	logger.Info("General: zip ", +input.Size(), " partitions")
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		context := this.executorData.GetThreadContext(rctx.ThreadId())
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
 			reader, err := input.Get(i).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			writer, err := ouput.Get(i).WriteIterator()
+			reader2, err := input2.Get(i).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(i).WriteIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
@@ -941,11 +1043,11 @@ func MapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T, R])
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				result, err := f.Call(elem.Second, context)
+				elem2, err := reader2.Next()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if err = writer.Write(*ipair.New(elem.First, result)); err != nil {
+				if err = writer.Write(*ipair.New(elem, elem2)); err != nil {
 					return ierror.Raise(err)
 				}
 			}
@@ -955,23 +1057,392 @@ func MapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T, R])
 	}); err != nil {
 		return ierror.Raise(err)
 	}
-	if err := f.After(context); err != nil {
-		return ierror.Raise(err)
-	}
-	core.SetPartitions(this.executorData, ouput)
+	core.SetPartitions(this.executorData, output)
 	return nil
 }
 
-func FlatMapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T, []R]) error {
-	context := this.executorData.GetContext()
-	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+/*
+Concatenates the partitions of the current dataset and the datasets stored in the variables others. Partitions are
+shared instead of copied when their storage matches the first one, so the result is marked as cache if any input is.
+*/
+func UnionAll[T any](this *IPipeImpl, others []string, rebalance bool) error {
+	input, err := core.GetPartitions[T](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	if err := f.Before(context); err != nil {
-		return ierror.Raise(err)
-	}
-	ouput, err := core.NewPartitionGroupWithSize[ipair.IPair[K, R]](this.executorData.GetPartitionTools(), input.Size())
+	groups := []*storage.IPartitionGroup[T]{input}
+	for _, other := range others {
+		this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+		group, err := core.GetPartitions[T](this.executorData)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		groups = append(groups, group)
+	}
+	this.executorData.DeletePartitions()
+
+	var storageType string
+	cache := false
+	for _, group := range groups {
+		cache = cache || group.Cache()
+		if storageType == "" && group.Size() > 0 {
+			storageType = group.Get(0).Type()
+		}
+	}
+	if storageType == "" {
+		if storageType, err = this.executorData.GetProperties().PartitionType(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	copies := 0
+	for _, group := range groups {
+		for _, part := range group.Iter() {
+			if part.Type() != storageType {
+				newPart, err := core.NewPartitionWithName[T](this.executorData.GetPartitionTools(), storageType)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err := part.CopyTo(newPart); err != nil {
+					return ierror.Raise(err)
+				}
+				part = newPart
+				copies++
+			}
+			output.Add(part)
+		}
+	}
+	logger.Info("General: union of ", len(groups), " datasets with ", output.Size(), " partitions, ", copies, " copied")
+
+	if rebalance && this.executorData.Mpi().Executors() > 1 {
+		logger.Info("General: rebalancing union partitions")
+		if output, err = unionRebalance[T](this, output, storageType); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	output.SetCache(cache)
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Places the local partitions after the partitions of the previous executors so Exchange spreads them evenly*/
+func unionRebalance[T any](this *IPipeImpl, input *storage.IPartitionGroup[T], storageType string) (*storage.IPartitionGroup[T], error) {
+	executors := this.executorData.Mpi().Executors()
+	rank := this.executorData.Mpi().Rank()
+	count := impi.C_int64(input.Size())
+	counts := make([]impi.C_int64, executors)
+	if err := impi.MPI_Allgather(impi.P(&count), 1, impi.MPI_LONG_LONG_INT, impi.P(&counts[0]), 1,
+		impi.MPI_LONG_LONG_INT, this.executorData.Mpi().Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	global, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	for i := 0; i < executors; i++ {
+		if i == rank {
+			for _, part := range input.Iter() {
+				global.Add(part)
+			}
+			continue
+		}
+		for j := int64(0); j < int64(counts[i]); j++ {
+			part, err := core.NewPartitionWithName[T](this.executorData.GetPartitionTools(), storageType)
+			if err != nil {
+				return nil, ierror.Raise(err)
+			}
+			global.Add(part)
+		}
+	}
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err = Exchange(&this.IBaseImpl, global, output); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return output, nil
+}
+
+func ZipWithIndex[T any](this *IPipeImpl) error {
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[T, int64]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("General: zipWithIndex ", +input.Size(), " partitions")
+	offset, err := partitionOffsets(this.Base(), input)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
+			reader, err := input.Get(i).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(i).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			id := offset[i]
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writer.Write(*ipair.New(elem, id)); err != nil {
+					return ierror.Raise(err)
+				}
+				id++
+			}
+			input.Set(i, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Global index of the first element of every partition*/
+func partitionOffsets[T any](this *IBaseImpl, input *storage.IPartitionGroup[T]) ([]int64, error) {
+	elems := impi.C_int64(0)
+	for _, p := range input.Iter() {
+		elems += impi.C_int64(p.Size())
+	}
+	first := impi.C_int64(0)
+	if err := impi.MPI_Exscan(impi.P(&elems), impi.P(&first), 1, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		this.executorData.Mpi().Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if this.executorData.Mpi().Rank() == 0 {
+		first = 0 // MPI_Exscan leaves the recvbuf of rank 0 undefined
+	}
+	offset := make([]int64, input.Size())
+	for i := 0; i < input.Size(); i++ {
+		if i == 0 {
+			offset[i] = int64(first)
+		} else {
+			offset[i] = offset[i-1] + input.Get(i-1).Size()
+		}
+	}
+	return offset, nil
+}
+
+/*Windows of size consecutive elements starting every step elements, incomplete windows at the end are dropped*/
+func Sliding[T any](this *IPipeImpl, size int64, step int64) error {
+	if size < 1 || step < 1 {
+		return ierror.RaiseMsg("sliding window size and step must be positive")
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[[]T](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	offset, err := partitionOffsets(this.Base(), input)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	boundaries := make([]IBoundary[T], input.Size())
+	if size > 1 {
+		if boundaries, err = Boundaries[T](this.Base(), input, int(size-1)); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+
+	logger.Info("General: sliding ", +input.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
+			reader, err := input.Get(i).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(i).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			end := offset[i] + input.Get(i).Size()
+			window := make([]T, 0, size)
+			first := offset[i] // global index of window[0]
+			add := func(elem T) error {
+				window = append(window, elem)
+				if int64(len(window)) < size {
+					return nil
+				}
+				if err := writer.Write(append([]T{}, window...)); err != nil {
+					return ierror.Raise(err)
+				}
+				window = window[:copy(window, window[utils.Min(step, size):])]
+				first += step
+				return nil
+			}
+			for pos := offset[i]; reader.HasNext(); pos++ {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if len(window) == 0 {
+					if pos%step != 0 {
+						continue
+					}
+					first = pos
+				}
+				if err := add(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			for _, elem := range boundaries[i].Next {
+				if len(window) == 0 || first >= end {
+					break
+				}
+				if err := add(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.Set(i, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Calls the partition hooks of f, when it implements function.IPartitionFunction, around run*/
+func runPartition(f any, partition int, context api.IContext, run func() error) (err error) {
+	hooks, ok := f.(function.IPartitionFunction)
+	if !ok {
+		return run()
+	}
+	if err = hooks.BeforePartition(int64(partition), context); err != nil {
+		return ierror.RaiseUser(err, int64(partition))
+	}
+	defer func() {
+		if err2 := hooks.AfterPartition(int64(partition), context); err2 != nil && err == nil {
+			err = ierror.RaiseUser(err2, int64(partition))
+		}
+	}()
+	return run()
+}
+
+/*Forwards a partition hook to a wrapped function*/
+func runPartitionHook(f any, partition int64, context api.IContext, before bool) error {
+	if hooks, ok := f.(function.IPartitionFunction); !ok {
+		return nil
+	} else if before {
+		return hooks.BeforePartition(partition, context)
+	} else {
+		return hooks.AfterPartition(partition, context)
+	}
+}
+
+type iAnyInputFunction[T any, R any] struct {
+	f function.IFunction[any, R]
+}
+
+/*Adapts a function over any to a typed input, used when only the partition type is known*/
+func AnyInputFunction[T any, R any](f function.IFunction[any, R]) function.IFunction[T, R] {
+	return &iAnyInputFunction[T, R]{f}
+}
+
+func (this *iAnyInputFunction[T, R]) Before(context api.IContext) error {
+	return this.f.Before(context)
+}
+
+func (this *iAnyInputFunction[T, R]) Call(v T, context api.IContext) (R, error) {
+	return this.f.Call(v, context)
+}
+
+func (this *iAnyInputFunction[T, R]) After(context api.IContext) error {
+	return this.f.After(context)
+}
+
+func (this *iAnyInputFunction[T, R]) BeforePartition(partition int64, context api.IContext) error {
+	return runPartitionHook(this.f, partition, context, true)
+}
+
+func (this *iAnyInputFunction[T, R]) AfterPartition(partition int64, context api.IContext) error {
+	return runPartitionHook(this.f, partition, context, false)
+}
+
+func MapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T, R]) error {
+	context := this.executorData.GetContext()
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	ouput, err := core.NewPartitionGroupWithSize[ipair.IPair[K, R]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("General: mapValues ", +input.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				writer, err := ouput.Get(i).WriteIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					result, err := f.Call(elem.Second, context)
+					if err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
+					if err = writer.Write(*ipair.New(elem.First, result)); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+				input.Set(i, nil)
+				return nil
+			})
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, ouput)
+	return nil
+}
+
+func FlatMapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T, []R]) error {
+	context := this.executorData.GetContext()
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	ouput, err := core.NewPartitionGroupWithSize[ipair.IPair[K, R]](this.executorData.GetPartitionTools(), input.Size())
 	if err != nil {
 		return ierror.Raise(err)
 	}
@@ -979,12 +1450,109 @@ func FlatMapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T,
 	logger.Info("General: flatMapValues ", +input.Size(), " partitions")
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				writer, err := ouput.Get(i).WriteIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				for reader.HasNext() {
+					elem, err := reader.Next()
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					result, err := f.Call(elem.Second, context)
+					if err != nil {
+						return ierror.RaiseUser(err, int64(i))
+					}
+					for _, e2 := range result {
+						if err = writer.Write(*ipair.New(elem.First, e2)); err != nil {
+							return ierror.Raise(err)
+						}
+					}
+				}
+				input.Set(i, nil)
+				return nil
+			})
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, ouput)
+	return nil
+}
+
+func Select[T any](this *IPipeImpl, paths []string) error {
+	fieldPaths, err := newIFieldPaths(utils.TypeObj[T](), paths)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return selectImpl[T](this, func(v reflect.Value) ([]any, error) {
+		row := make([]any, len(fieldPaths))
+		for i, fieldPath := range fieldPaths {
+			row[i] = fieldPath.get(v).Interface()
+		}
+		return row, nil
+	})
+}
+
+func SelectTo[T any, R any](this *IPipeImpl, paths []string) error {
+	fieldPaths, err := newIFieldPaths(utils.TypeObj[T](), paths)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	rtp := utils.TypeObj[R]()
+	if rtp.Kind() != reflect.Struct {
+		if len(fieldPaths) != 1 || !fieldPaths[0].tp.ConvertibleTo(rtp) {
+			return ierror.RaiseMsg(utils.TypeName[R]() + " can not store " + strings.Join(paths, ", "))
+		}
+		return selectImpl[T](this, func(v reflect.Value) (R, error) {
+			return fieldPaths[0].get(v).Convert(rtp).Interface().(R), nil
+		})
+	}
+	if rtp.NumField() != len(fieldPaths) {
+		return ierror.RaiseMsg(utils.TypeName[R]() + " must have one field for each selected path")
+	}
+	for i, fieldPath := range fieldPaths {
+		if !rtp.Field(i).IsExported() || !fieldPath.tp.ConvertibleTo(rtp.Field(i).Type) {
+			return ierror.RaiseMsg(utils.TypeName[R]() + " field " + rtp.Field(i).Name + " can not store " + fieldPath.path)
+		}
+	}
+	return selectImpl[T](this, func(v reflect.Value) (R, error) {
+		var result R
+		rv := reflect.ValueOf(&result).Elem()
+		for i, fieldPath := range fieldPaths {
+			rv.Field(i).Set(fieldPath.get(v).Convert(rtp.Field(i).Type))
+		}
+		return result, nil
+	})
+}
+
+func selectImpl[T any, R any](this *IPipeImpl, f func(v reflect.Value) (R, error)) error {
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[R](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("General: select ", +input.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
 			reader, err := input.Get(i).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			writer, err := ouput.Get(i).WriteIterator()
+			writer, err := output.Get(i).WriteIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
@@ -993,12 +1561,96 @@ func FlatMapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T,
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				result, err := f.Call(elem.Second, context)
+				result, err := f(reflect.ValueOf(&elem).Elem())
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				for _, e2 := range result {
-					if err = writer.Write(*ipair.New(elem.First, e2)); err != nil {
+				if err = writer.Write(result); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.Set(i, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func Explode[T any, E any](this *IPipeImpl, path string) error {
+	fieldPath, err := explodePath[T, E](path)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return explodeImpl[T, ipair.IPair[T, E], E](this, fieldPath, func(elem *T, v reflect.Value, item E) ipair.IPair[T, E] {
+		return ipair.IPair[T, E]{*elem, item}
+	})
+}
+
+func ExplodeSelect[T any, E any](this *IPipeImpl, path string, paths []string) error {
+	fieldPath, err := explodePath[T, E](path)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	fieldPaths, err := newIFieldPaths(utils.TypeObj[T](), paths)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return explodeImpl[T, ipair.IPair[[]any, E], E](this, fieldPath, func(elem *T, v reflect.Value, item E) ipair.IPair[[]any, E] {
+		row := make([]any, len(fieldPaths))
+		for i, fieldPath := range fieldPaths {
+			row[i] = fieldPath.get(v).Interface()
+		}
+		return ipair.IPair[[]any, E]{row, item}
+	})
+}
+
+func explodePath[T any, E any](path string) (*iFieldPath, error) {
+	fieldPath, err := newIFieldPath(utils.TypeObj[T](), path)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if kind := fieldPath.tp.Kind(); kind != reflect.Slice && kind != reflect.Array {
+		return nil, ierror.RaiseMsg(path + " is not a slice field")
+	}
+	if fieldPath.tp.Elem() != utils.TypeObj[E]() {
+		return nil, ierror.RaiseMsg(path + " elements are not " + utils.TypeName[E]())
+	}
+	return fieldPath, nil
+}
+
+func explodeImpl[T any, R any, E any](this *IPipeImpl, fieldPath *iFieldPath, f func(elem *T, v reflect.Value, item E) R) error {
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[R](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("General: explode ", +input.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
+			reader, err := input.Get(i).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(i).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				v := reflect.ValueOf(&elem).Elem()
+				items := fieldPath.get(v)
+				for j := 0; j < items.Len(); j++ {
+					if err = writer.Write(f(&elem, v, items.Index(j).Interface().(E))); err != nil {
 						return ierror.Raise(err)
 					}
 				}
@@ -1009,6 +1661,57 @@ func FlatMapValues[K any, T any, R any](this *IPipeImpl, f function.IFunction[T,
 	}); err != nil {
 		return ierror.Raise(err)
 	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func MapPartitionsWithBoundary[T any, R any](this *IPipeImpl, k int64, f function.IFunction2[IBoundary[T], iterator.IReadIterator[T], []R]) error {
+	context := this.executorData.GetContext()
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	ouput, err := core.NewPartitionGroupWithSize[R](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	boundaries, err := Boundaries[T](this.Base(), input, int(k))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("General: mapPartitionsWithBoundary ", +input.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(input.Size(), func(i int) error {
+			return runPartition(f, i, context, func() error {
+				reader, err := input.Get(i).ReadIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				writer, err := ouput.Get(i).WriteIterator()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				result, err := f.Call(boundaries[i], reader, context)
+				if err != nil {
+					return ierror.RaiseUser(err, int64(i))
+				}
+				for _, e2 := range result {
+					if err = writer.Write(e2); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+				input.Set(i, nil)
+				return nil
+			})
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
 	if err := f.After(context); err != nil {
 		return ierror.Raise(err)
 	}
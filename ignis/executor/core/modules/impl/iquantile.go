@@ -0,0 +1,157 @@
+package impl
+
+import (
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/utils"
+	"math"
+	"sort"
+)
+
+/*
+Greenwald-Khanna summary kept as tuples with the minimum and maximum rank of each value. Summaries are mergeable,
+so every thread and executor builds its own and the root combines them.
+*/
+type iQuantileSummary struct {
+	eps    float64
+	n      int64
+	tuples []iQuantileTuple
+	buffer []float64
+}
+
+type iQuantileTuple struct {
+	v    float64
+	rmin int64
+	rmax int64
+}
+
+func newIQuantileSummary(eps float64) *iQuantileSummary {
+	return &iQuantileSummary{eps: eps}
+}
+
+func (this *iQuantileSummary) add(v float64) {
+	if math.IsNaN(v) {
+		return
+	}
+	this.buffer = append(this.buffer, v)
+	if len(this.buffer) >= int(1/(2*this.eps))+1 {
+		this.flush()
+	}
+}
+
+/*Buffered values are an exact summary, so they are merged like any other summary*/
+func (this *iQuantileSummary) flush() {
+	if len(this.buffer) == 0 {
+		return
+	}
+	sort.Float64s(this.buffer)
+	exact := &iQuantileSummary{eps: this.eps, n: int64(len(this.buffer))}
+	exact.tuples = make([]iQuantileTuple, len(this.buffer))
+	for i, v := range this.buffer {
+		exact.tuples[i] = iQuantileTuple{v, int64(i + 1), int64(i + 1)}
+	}
+	this.buffer = this.buffer[:0]
+	this.merge(exact)
+}
+
+func (this *iQuantileSummary) merge(other *iQuantileSummary) {
+	this.flush()
+	other.flush()
+	if other.n == 0 {
+		return
+	}
+	if this.n == 0 {
+		this.n = other.n
+		this.tuples = append([]iQuantileTuple(nil), other.tuples...)
+		return
+	}
+	a, b := this.tuples, other.tuples
+	merged := make([]iQuantileTuple, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		if j == len(b) || (i < len(a) && a[i].v <= b[j].v) {
+			merged = append(merged, combineTuple(a[i], b, j, other.n))
+			i++
+		} else {
+			merged = append(merged, combineTuple(b[j], a, i, this.n))
+			j++
+		}
+	}
+	this.n += other.n
+	this.tuples = merged
+	this.compress()
+}
+
+/*
+Ranks of t in the union: the element before position next in the other summary is smaller than t and the element
+at next is not, so it bounds how many of its values precede t.
+*/
+func combineTuple(t iQuantileTuple, other []iQuantileTuple, next int, n int64) iQuantileTuple {
+	if next > 0 {
+		t.rmin += other[next-1].rmin
+	}
+	if next < len(other) {
+		t.rmax += other[next].rmax - 1
+	} else {
+		t.rmax += n
+	}
+	return t
+}
+
+func (this *iQuantileSummary) compress() {
+	limit := int64(2 * this.eps * float64(this.n))
+	if len(this.tuples) < 3 {
+		return
+	}
+	kept := this.tuples[:1]
+	for i := 1; i < len(this.tuples)-1; i++ {
+		if this.tuples[i+1].rmax-kept[len(kept)-1].rmin > limit {
+			kept = append(kept, this.tuples[i])
+		}
+	}
+	this.tuples = append(kept, this.tuples[len(this.tuples)-1])
+}
+
+func (this *iQuantileSummary) query(p float64) float64 {
+	this.flush()
+	if this.n == 0 {
+		return math.NaN()
+	}
+	rank := int64(math.Ceil(p * float64(this.n)))
+	best := 0
+	bestErr := int64(math.MaxInt64)
+	for i, t := range this.tuples {
+		err := utils.Max(rank-t.rmin, t.rmax-rank)
+		if err < bestErr {
+			best, bestErr = i, err
+		}
+	}
+	return this.tuples[best].v
+}
+
+/*Summaries travel as int64 so they can be gathered as a contiguous partition*/
+func (this *iQuantileSummary) encode() []int64 {
+	this.flush()
+	data := make([]int64, 0, 2+3*len(this.tuples))
+	data = append(data, this.n, int64(len(this.tuples)))
+	for _, t := range this.tuples {
+		data = append(data, int64(math.Float64bits(t.v)), t.rmin, t.rmax)
+	}
+	return data
+}
+
+func decodeIQuantileSummaries(eps float64, data []int64) ([]*iQuantileSummary, error) {
+	var summaries []*iQuantileSummary
+	for len(data) > 0 {
+		if len(data) < 2 || int64(len(data)-2) < 3*data[1] {
+			return nil, ierror.RaiseMsg("corrupted quantile summary")
+		}
+		summary := &iQuantileSummary{eps: eps, n: data[0], tuples: make([]iQuantileTuple, data[1])}
+		data = data[2:]
+		for i := range summary.tuples {
+			summary.tuples[i] = iQuantileTuple{math.Float64frombits(uint64(data[0])), data[1], data[2]}
+			data = data[3:]
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
@@ -1,17 +1,24 @@
 package impl
 
 import (
+	"ignis/executor/api"
 	"ignis/executor/api/function"
 	"ignis/executor/api/ipair"
 	"ignis/executor/api/iterator"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
+	"ignis/executor/core/iio"
 	"ignis/executor/core/impi"
+	"ignis/executor/core/iprotocol"
 	"ignis/executor/core/ithreads"
+	"ignis/executor/core/itransport"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
 	"math"
+	"reflect"
+	"sort"
+	"strconv"
 )
 
 type IReduceImpl struct {
@@ -69,26 +76,84 @@ func basicFold[T any](this *IReduceImpl, f function.IFunction2[T, T, T], result
 		return ierror.Raise(err)
 	}
 	logger.Info("Reduce: folding ", input.Size(), " partitions locally")
+	return partitionAggregate(this, f, input, result)
+}
 
-	return ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		acum := core.GetVariable[T](this.executorData, "zero")
-		if err := rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+/*
+Aggregates each partition from its own copy of the zero value and keeps the partial results in partition order, so
+they are combined in the same order in every run.
+*/
+func partitionAggregate[T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T], input *storage.IPartitionGroup[T2],
+	result *storage.IMemoryPartition[T]) error {
+	zero, err := zeroCopier[T](this)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	partials := make([]T, input.Size())
+	done := make([]bool, input.Size())
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			if input.Get(p).Empty() {
 				return nil
 			}
-			if acum, err = aggregatePartition(this, f, input.Get(p), acum); err != nil {
+			acum, err := zero()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if partials[p], err = aggregatePartition(this, f, input.Get(p), acum); err != nil {
 				return ierror.Raise(err)
 			}
+			done[p] = true
 			input.SetBase(p, nil)
 			return nil
-		}); err != nil {
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	list := result.Inner().(storage.IList)
+	for p := range partials {
+		if done[p] {
+			list.AddAny(partials[p])
+		}
+	}
+	if list.Size() == 0 {
+		/*An executor without elements still contributes the zero value*/
+		acum, err := zero()
+		if err != nil {
 			return ierror.Raise(err)
 		}
-		return rctx.Critical(func() error {
-			result.Inner().(storage.IList).AddAny(acum)
-			return nil
-		})
-	})
+		list.AddAny(acum)
+	}
+	return nil
+}
+
+/*
+Returns copies of the zero value. Values with references, like slices, maps or pointers, are cloned through their
+native serialization, so partitions never modify the zero value of other partition.
+*/
+func zeroCopier[T any](this *IReduceImpl) (func() (T, error), error) {
+	zero := core.GetVariable[T](this.executorData, "zero")
+	if tp := reflect.TypeOf(zero); tp == nil || iio.IsContiguousType(tp) {
+		return func() (T, error) {
+			return zero, nil
+		}, nil
+	}
+	buffer := itransport.NewIMemoryBuffer()
+	if err := iprotocol.NewIObjectProtocol(buffer).WriteObjectWithNative(zero, true); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	data := buffer.Bytes()
+	return func() (T, error) {
+		wrapper := itransport.NewIMemoryBufferWrapper(data, int64(len(data)), itransport.OBSERVE)
+		obj, err := iprotocol.NewIObjectProtocol(wrapper).ReadObject()
+		if err != nil {
+			return zero, ierror.Raise(err)
+		}
+		if value, ok := obj.(T); ok {
+			return value, nil
+		}
+		return zero, ierror.RaiseMsg("zero value of type " + reflect.TypeOf(zero).String() + " can not be cloned")
+	}, nil
 }
 
 func Reduce[T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
@@ -158,33 +223,16 @@ func Aggregate[T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T]
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	input, err := core.GetPartitions[T2](this.executorData)
+	input, err := core.GetAndDeletePartitions[T2](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	partialReduce, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), int64(this.executorData.GetCores()))
+	partialReduce, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), int64(input.Size()))
 	if err != nil {
 		return ierror.Raise(err)
 	}
 	logger.Info("Reduce: aggregating ", input.Size(), " partitions locally")
-
-	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		acum := core.GetVariable[T](this.executorData, "zero")
-		if err := rctx.For().Dynamic().Run(input.Size(), func(p int) error {
-			if input.Get(p).Empty() {
-				return nil
-			}
-			var err error
-			acum, err = aggregatePartition(this, f, input.Get(p), acum)
-			return err
-		}); err != nil {
-			return ierror.Raise(err)
-		}
-		return rctx.Critical(func() error {
-			partialReduce.Inner().(storage.IList).AddAny(acum)
-			return nil
-		})
-	}); err != nil {
+	if err = partitionAggregate(this, f, input, partialReduce); err != nil {
 		return ierror.Raise(err)
 	}
 
@@ -243,7 +291,11 @@ func TreeFold[T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
 }
 
 func GroupByKey[T any, K comparable](this *IReduceImpl, numPartitions int64) error {
-	if err := keyHashing[K, T](this, numPartitions); err != nil {
+	skew, err := detectSkew[K, T](this)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := saltedKeyHashing[K, T](this, numPartitions, skew); err != nil {
 		return ierror.Raise(err)
 	}
 	if err := keyExchanging[K, T](this); err != nil {
@@ -258,10 +310,16 @@ func GroupByKey[T any, K comparable](this *IReduceImpl, numPartitions int64) err
 	if err != nil {
 		return ierror.Raise(err)
 	}
+	groupMax, err := this.executorData.GetProperties().ReduceGroupMax()
+	if err != nil {
+		return ierror.Raise(err)
+	}
 	logger.Info("Reduce: reducing key elements")
 
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		acum := map[K][]T{}
+		spills := map[K]*iGroupSpill[T]{}
+		keys := newINormalKeys[K]()
 		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			part := input.Get(p)
 			reader, err := part.ReadIterator()
@@ -273,18 +331,46 @@ func GroupByKey[T any, K comparable](this *IReduceImpl, numPartitions int64) err
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				acum[elem.First] = append(acum[elem.First], elem.Second)
+				key := keys.key(elem.First)
+				if spill, ok := spills[key]; ok {
+					if err := spill.writer.Write(elem.Second); err != nil {
+						return ierror.Raise(err)
+					}
+					continue
+				}
+				values := append(acum[key], elem.Second)
+				if groupMax > 0 && int64(len(values)) > groupMax {
+					spill, err := newGroupSpill[T](this.executorData.GetPartitionTools(), values)
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					spills[key] = spill
+					delete(acum, key)
+					continue
+				}
+				acum[key] = values
 			}
 			writer, err := output.Get(p).WriteIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
 			for key, values := range acum {
-				if err := writer.Write(*ipair.New(key, values)); err != nil {
+				if err := writer.Write(*ipair.New(keys.original(key), values)); err != nil {
 					return ierror.Raise(err)
 				}
 			}
 			acum = map[K][]T{}
+			for key, spill := range spills {
+				values, err := spill.values()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err := writer.Write(*ipair.New(keys.original(key), values)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			spills = map[K]*iGroupSpill[T]{}
+			keys.clear()
 			input.SetBase(p, nil)
 			return output.Get(p).Fit()
 		})
@@ -292,21 +378,80 @@ func GroupByKey[T any, K comparable](this *IReduceImpl, numPartitions int64) err
 		return ierror.Raise(err)
 	}
 	core.SetPartitions(this.executorData, output)
+	if skew != nil {
+		if err = unsaltKeys[K, []T](this, numPartitions, skew); err != nil {
+			return ierror.Raise(err)
+		}
+		return ierror.Raise(localReduceByKey[K, []T](this, &iConcatFunction[T]{}))
+	}
 	return nil
 }
 
+/*Values of a key that exceeded the group limit, they are kept on disk until the key is written*/
+type iGroupSpill[T any] struct {
+	part   *storage.IDiskPartition[T]
+	writer iterator.IWriteIterator[T]
+}
+
+func newGroupSpill[T any](tools *core.IPartitionTools, values []T) (*iGroupSpill[T], error) {
+	part, err := core.NewDiskPartitionDef[T](tools)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	writer, err := part.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	for _, value := range values {
+		if err := writer.Write(value); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	logger.Warn("Reduce: key with more than ", len(values)-1, " values spilled to disk")
+	return &iGroupSpill[T]{part, writer}, nil
+}
+
+func (this *iGroupSpill[T]) values() ([]T, error) {
+	values := make([]T, 0, this.part.Size())
+	reader, err := this.part.ReadIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	for reader.HasNext() {
+		value, err := reader.Next()
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		values = append(values, value)
+	}
+	return values, this.part.Clear()
+}
+
 func ReduceByKey[K comparable, T any](this *IReduceImpl, f function.IFunction2[T, T, T], numPartitions int64, localReduce bool) error {
 	context := this.Context()
 	if err := f.Before(context); err != nil {
 		return ierror.Raise(err)
 	}
 	if localReduce {
+		cache, err := this.executorData.GetProperties().ReduceCache()
+		if err != nil {
+			return ierror.Raise(err)
+		}
 		logger.Info("Reduce: local reducing key elements")
-		if err := localReduceByKey[K](this, f); err != nil {
+		if cache > 0 {
+			err = localCacheReduceByKey[K](this, f, int(cache))
+		} else {
+			err = localReduceByKey[K](this, f)
+		}
+		if err != nil {
 			return ierror.Raise(err)
 		}
 	}
-	if err := keyHashing[K, T](this, numPartitions); err != nil {
+	skew, err := detectSkew[K, T](this)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := saltedKeyHashing[K, T](this, numPartitions, skew); err != nil {
 		return ierror.Raise(err)
 	}
 	if err := keyExchanging[K, T](this); err != nil {
@@ -316,27 +461,86 @@ func ReduceByKey[K comparable, T any](this *IReduceImpl, f function.IFunction2[T
 	if err := localReduceByKey[K](this, f); err != nil {
 		return ierror.Raise(err)
 	}
+	if skew != nil {
+		if err = unsaltKeys[K, T](this, numPartitions, skew); err != nil {
+			return ierror.Raise(err)
+		}
+		if err = localReduceByKey[K](this, f); err != nil {
+			return ierror.Raise(err)
+		}
+	}
 	if err := f.After(context); err != nil {
 		return ierror.Raise(err)
 	}
 	return nil
 }
 
-func AggregateByKey[K comparable, T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T], numPartitions int64, hashing bool) error {
+type iAnyFunction2[T any] struct {
+	f function.IFunction2[any, any, any]
+}
+
+/*Adapts a reduce function over any to the value type of the partition*/
+func AnyFunction2[T any](f function.IFunction2[any, any, any]) function.IFunction2[T, T, T] {
+	return &iAnyFunction2[T]{f}
+}
+
+func (this *iAnyFunction2[T]) Before(context api.IContext) error {
+	return this.f.Before(context)
+}
+
+func (this *iAnyFunction2[T]) Call(v1 T, v2 T, context api.IContext) (T, error) {
+	r, err := this.f.Call(v1, v2, context)
+	if err != nil {
+		return v1, err
+	}
+	if v, ok := r.(T); ok {
+		return v, nil
+	}
+	return v1, ierror.RaiseMsg("function does not return a value of type " + utils.TypeName[T]())
+}
+
+func (this *iAnyFunction2[T]) After(context api.IContext) error {
+	return this.f.After(context)
+}
+
+type iAnySeqFunction[T any] struct {
+	f function.IFunction2[any, any, any]
+}
+
+/*Adapts an aggregate function over any to the element type of the partition, the accumulator is kept as any*/
+func AnySeqFunction[T any](f function.IFunction2[any, any, any]) function.IFunction2[any, T, any] {
+	return &iAnySeqFunction[T]{f}
+}
+
+func (this *iAnySeqFunction[T]) Before(context api.IContext) error {
+	return this.f.Before(context)
+}
+
+func (this *iAnySeqFunction[T]) Call(acum any, v T, context api.IContext) (any, error) {
+	return this.f.Call(acum, v, context)
+}
+
+func (this *iAnySeqFunction[T]) After(context api.IContext) error {
+	return this.f.After(context)
+}
+
+/*
+ReduceByKey for keys that are not comparable, keys are compared by their serialization, so it must be deterministic.
+Values are combined in typed tables before and after the shuffle instead of boxing the keys in interfaces.
+*/
+func ReduceBySerializedKey[K any, T any](this *IReduceImpl, f function.IFunction2[T, T, T], numPartitions int64, localReduce bool) error {
 	context := this.Context()
 	if err := f.Before(context); err != nil {
 		return ierror.Raise(err)
 	}
-	if hashing {
-		if err := keyHashing[K, T](this, numPartitions); err != nil {
-			return ierror.Raise(err)
-		}
-		if err := keyExchanging[K, T](this); err != nil {
-			return ierror.Raise(err)
-		}
+	if err := serializedKeyHashing[K](this, f, numPartitions, localReduce); err != nil {
+		return ierror.Raise(err)
 	}
-	logger.Info("Reduce: aggregating key elements")
-	if err := localAggregateByKey[K](this, f); err != nil {
+	if err := keyExchanging[K, T](this); err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: reducing serialized key elements")
+	if err := serializedReduceByKey[K](this, f); err != nil {
 		return ierror.Raise(err)
 	}
 	if err := f.After(context); err != nil {
@@ -345,96 +549,390 @@ func AggregateByKey[K comparable, T any, T2 any](this *IReduceImpl, f function.I
 	return nil
 }
 
-func FoldByKey[K comparable, T any](this *IReduceImpl, f function.IFunction2[T, T, T], numPartitions int64, localFold bool) error {
+/*
+ReduceByKey whose result is returned to the driver, the values of every key are reduced inside each executor and the
+results are merged in the executor 0 without exchanging the dataset.
+*/
+func ReduceByKeyLocally[K comparable, T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
+	return reduceByKeyLocallyImpl[K, T](this, f, func(context api.IContext) iReduceAcum[K, T] {
+		return &iReduceMap[K, T]{map[K]T{}, f, context}
+	})
+}
+
+/*ReduceByKeyLocally for keys that are not comparable, keys are reduced by their serialization*/
+func ReduceBySerializedKeyLocally[K any, T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
+	return reduceByKeyLocallyImpl[K, T](this, f, func(context api.IContext) iReduceAcum[K, T] {
+		return &iSerializedReduce[K, T]{newIKeyEncoder[K](), newIKeyTable[K, T](), func(a T, b T) (T, error) {
+			return f.Call(a, b, context)
+		}}
+	})
+}
+
+func reduceByKeyLocallyImpl[K any, T any](this *IReduceImpl, f function.IFunction2[T, T, T],
+	newAcum func(context api.IContext) iReduceAcum[K, T]) error {
 	context := this.Context()
 	if err := f.Before(context); err != nil {
 		return ierror.Raise(err)
 	}
-	if localFold {
-		logger.Info("Reduce: local folding key elements")
-		if err := localAggregateByKey[K](this, f); err != nil {
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: reducing key elements of ", input.Size(), " partitions locally")
+	threads := this.executorData.GetCores()
+	acum := make([]iReduceAcum[K, T], threads)
+	if err = ithreads.ParallelT(threads, func(rctx ithreads.IRuntimeContext) error {
+		id := rctx.ThreadId()
+		acum[id] = newAcum(this.executorData.GetThreadContext(id))
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			if err := acum[id].merge(input.Get(p)); err != nil {
+				return ierror.Raise(err)
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	for i := 1; i < threads; i++ {
+		if err = acum[0].mergeAcum(acum[i]); err != nil {
 			return ierror.Raise(err)
 		}
+		acum[i] = nil
 	}
-	if err := keyHashing[K, T](this, numPartitions); err != nil {
+
+	logger.Info("Reduce: reducing key elements globally")
+	if err = keyResultReduce[K, T](this.Base(), acum[0], 0, "reduceByKeyLocally"); err != nil {
 		return ierror.Raise(err)
 	}
-	if err := keyExchanging[K, T](this); err != nil {
+	if err = f.After(context); err != nil {
 		return ierror.Raise(err)
 	}
-	logger.Info("Reduce: folding key elements")
-	if err := localReduceByKey[K](this, f); err != nil {
-		return ierror.Raise(err)
+	return nil
+}
+
+/*Reduced values of an executor by key*/
+type iReduceAcum[K any, T any] interface {
+	iKeyAcum[K, T]
+	mergeAcum(other iReduceAcum[K, T]) error
+}
+
+type iReduceMap[K comparable, T any] struct {
+	values  map[K]T
+	f       function.IFunction2[T, T, T]
+	context api.IContext
+}
+
+func (this *iReduceMap[K, T]) add(key K, value T) (err error) {
+	if prev, present := this.values[key]; present {
+		if value, err = this.f.Call(prev, value, this.context); err != nil {
+			return ierror.Raise(err)
+		}
 	}
-	err := f.After(context)
+	this.values[key] = value
+	return nil
+}
+
+func (this *iReduceMap[K, T]) Len() int {
+	return len(this.values)
+}
+
+func (this *iReduceMap[K, T]) merge(part storage.IPartition[ipair.IPair[K, T]]) error {
+	reader, err := part.ReadIterator()
 	if err != nil {
 		return ierror.Raise(err)
 	}
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = this.add(elem.First, elem.Second); err != nil {
+			return ierror.Raise(err)
+		}
+	}
 	return nil
 }
 
-func Union[T any](this *IReduceImpl, other string, preserveOrder bool) error {
-	input, err := core.GetPartitions[T](this.executorData)
-	if err != nil {
-		return ierror.Raise(err)
+func (this *iReduceMap[K, T]) mergeAcum(other iReduceAcum[K, T]) error {
+	for key, value := range other.(*iReduceMap[K, T]).values {
+		if err := this.add(key, value); err != nil {
+			return ierror.Raise(err)
+		}
 	}
-	this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
-	input2, err := core.GetPartitions[T](this.executorData)
+	return nil
+}
+
+func (this *iReduceMap[K, T]) write(part storage.IPartition[ipair.IPair[K, T]]) error {
+	writer, err := part.WriteIterator()
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	for key, value := range this.values {
+		if err = writer.Write(*ipair.New(key, value)); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+/*Reduced values of keys that are not comparable, indexed by their serialization*/
+type iSerializedReduce[K any, T any] struct {
+	encoder *iKeyEncoder[K]
+	table   *iKeyTable[K, T]
+	f       func(T, T) (T, error)
+}
+
+func (this *iSerializedReduce[K, T]) Len() int {
+	return this.table.Len()
+}
+
+func (this *iSerializedReduce[K, T]) merge(part storage.IPartition[ipair.IPair[K, T]]) error {
+	reader, err := part.ReadIterator()
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	logger.Info("Reduce: union ", input.Size(), " and ", input2.Size(), " partitions")
-	var storage string
-	if input.Size() > 0 {
-		storage = input.Get(0).Type()
-		if input2.Size() > 0 {
-			if input.Get(0).Type() != input2.Get(0).Type() {
-				for i, part := range input2.Iter() {
-					newPart, err := core.NewPartitionWithName[T](this.executorData.GetPartitionTools(), storage)
-					if err != nil {
-						return ierror.Raise(err)
-					}
-					if err := part.CopyTo(newPart); err != nil {
-						return ierror.Raise(err)
-					}
-					input2.Set(i, newPart)
-				}
-			}
-		}
-	} else if input2.Size() > 0 {
-		storage = input2.Get(0).Type()
-	} else {
-		storage, err = this.executorData.GetProperties().PartitionType()
+	for reader.HasNext() {
+		elem, err := reader.Next()
 		if err != nil {
 			return ierror.Raise(err)
 		}
-	}
-
-	if preserveOrder {
-		logger.Info("Reduce: union using order mode")
-		executors := this.executorData.Mpi().Executors()
-		rank := this.executorData.Mpi().Rank()
-		count := input.Size()
-		globalCount := int64(0)
-		offset := int64(0)
-		execCounts := make([]impi.C_int64, executors)
-		count2 := input2.Size()
-		globalCount2 := int64(0)
-		offset2 := int64(0)
-		execCounts2 := make([]impi.C_int64, executors)
-		if err = impi.MPI_Allgather(impi.P(&count), 1, impi.MPI_LONG_LONG_INT, impi.P(&execCounts[0]), 1, impi.MPI_LONG_LONG_INT, this.executorData.Mpi().Native()); err != nil {
+		data, err := this.encoder.Encode(elem.First)
+		if err != nil {
 			return ierror.Raise(err)
 		}
-		if err = impi.MPI_Allgather(impi.P(&count2), 1, impi.MPI_LONG_LONG_INT, impi.P(&execCounts2[0]), 1, impi.MPI_LONG_LONG_INT, this.executorData.Mpi().Native()); err != nil {
+		if err = this.table.Add(utils.HashBytes(data), data, elem.First, elem.Second, this.f); err != nil {
 			return ierror.Raise(err)
 		}
+	}
+	return nil
+}
 
-		for i := 0; i < executors; i++ {
-			if i == rank {
+func (this *iSerializedReduce[K, T]) mergeAcum(other iReduceAcum[K, T]) error {
+	return this.table.Merge(other.(*iSerializedReduce[K, T]).table, this.f)
+}
+
+/*The values are only written once, so the table is emptied*/
+func (this *iSerializedReduce[K, T]) write(part storage.IPartition[ipair.IPair[K, T]]) error {
+	writer, err := part.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return this.table.Flush(func(hash uint64, key K, value T) error {
+		return writer.Write(*ipair.New(key, value))
+	})
+}
+
+/*GroupByKey for keys that are not comparable, keys are routed and grouped by their serialization*/
+func GroupBySerializedKey[K any, T any](this *IReduceImpl, numPartitions int64) error {
+	if err := serializedKeyHashing[K, T](this, nil, numPartitions, false); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, T](this); err != nil {
+		return ierror.Raise(err)
+	}
+
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, []T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: grouping serialized key elements")
+
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		encoder := newIKeyEncoder[K]()
+		acum := newIKeyTable[K, []T]()
+		concat := func(a []T, b []T) ([]T, error) {
+			return append(a, b...), nil
+		}
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				data, err := encoder.Encode(elem.First)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = acum.Add(utils.HashBytes(data), data, elem.First, []T{elem.Second}, concat); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = acum.Flush(func(hash uint64, key K, values []T) error {
+				return writer.Write(*ipair.New(key, values))
+			}); err != nil {
+				return ierror.Raise(err)
+			}
+			input.SetBase(p, nil)
+			return output.Get(p).Fit()
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func AggregateByKey[K comparable, T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T], numPartitions int64, hashing bool) error {
+	context := this.Context()
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if hashing {
+		if err := keyHashing[K, T](this, numPartitions); err != nil {
+			return ierror.Raise(err)
+		}
+		if err := keyExchanging[K, T](this); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	logger.Info("Reduce: aggregating key elements")
+	if err := localAggregateByKey[K](this, f, !hashing); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+func CombineByKey[K comparable, V any, C any](this *IReduceImpl, create function.IFunction[V, C], merge function.IFunction2[C, V, C],
+	combine function.IFunction2[C, C, C], numPartitions int64) error {
+	context := this.Context()
+	if err := create.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := merge.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := combine.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: local combining key elements")
+	if err := localCombineByKey[K](this, create.Call, merge.Call, true); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyHashing[K, C](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, C](this); err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: merging key combiners")
+	if err := localReduceByKey[K](this, combine); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := create.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := merge.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(combine.After(context))
+}
+
+func FoldByKey[K comparable, T any](this *IReduceImpl, f function.IFunction2[T, T, T], numPartitions int64, localFold bool) error {
+	context := this.Context()
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if localFold {
+		logger.Info("Reduce: local folding key elements")
+		if err := localAggregateByKey[K](this, f, true); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	if err := keyHashing[K, T](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, T](this); err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: folding key elements")
+	if err := localReduceByKey[K](this, f); err != nil {
+		return ierror.Raise(err)
+	}
+	err := f.After(context)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+func Union[T any](this *IReduceImpl, other string, preserveOrder bool) error {
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+	input2, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: union ", input.Size(), " and ", input2.Size(), " partitions")
+	var storage string
+	if input.Size() > 0 {
+		storage = input.Get(0).Type()
+		if input2.Size() > 0 {
+			if input.Get(0).Type() != input2.Get(0).Type() {
+				for i, part := range input2.Iter() {
+					newPart, err := core.NewPartitionWithName[T](this.executorData.GetPartitionTools(), storage)
+					if err != nil {
+						return ierror.Raise(err)
+					}
+					if err := part.CopyTo(newPart); err != nil {
+						return ierror.Raise(err)
+					}
+					input2.Set(i, newPart)
+				}
+			}
+		}
+	} else if input2.Size() > 0 {
+		storage = input2.Get(0).Type()
+	} else {
+		storage, err = this.executorData.GetProperties().PartitionType()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+	}
+
+	if preserveOrder {
+		logger.Info("Reduce: union using order mode")
+		executors := this.executorData.Mpi().Executors()
+		rank := this.executorData.Mpi().Rank()
+		count := input.Size()
+		globalCount := int64(0)
+		offset := int64(0)
+		execCounts := make([]impi.C_int64, executors)
+		count2 := input2.Size()
+		globalCount2 := int64(0)
+		offset2 := int64(0)
+		execCounts2 := make([]impi.C_int64, executors)
+		if err = impi.MPI_Allgather(impi.P(&count), 1, impi.MPI_LONG_LONG_INT, impi.P(&execCounts[0]), 1, impi.MPI_LONG_LONG_INT, this.executorData.Mpi().Native()); err != nil {
+			return ierror.Raise(err)
+		}
+		if err = impi.MPI_Allgather(impi.P(&count2), 1, impi.MPI_LONG_LONG_INT, impi.P(&execCounts2[0]), 1, impi.MPI_LONG_LONG_INT, this.executorData.Mpi().Native()); err != nil {
+			return ierror.Raise(err)
+		}
+
+		for i := 0; i < executors; i++ {
+			if i == rank {
 				offset = globalCount
 				offset2 = globalCount2
 			}
@@ -492,6 +990,42 @@ func Union[T any](this *IReduceImpl, other string, preserveOrder bool) error {
 }
 
 func Join[K comparable, T any](this *IReduceImpl, other string, numPartitions int64) error {
+	return joinImpl[K, T, T, T](this, other, numPartitions, false, false, joinValue[T], joinValue[T])
+}
+
+func LeftOuterJoin[K comparable, T any](this *IReduceImpl, other string, numPartitions int64) error {
+	return joinImpl[K, T, T, []T](this, other, numPartitions, true, false, joinValue[T], joinOptional[T])
+}
+
+func RightOuterJoin[K comparable, T any](this *IReduceImpl, other string, numPartitions int64) error {
+	return joinImpl[K, T, []T, T](this, other, numPartitions, false, true, joinOptional[T], joinValue[T])
+}
+
+func FullOuterJoin[K comparable, T any](this *IReduceImpl, other string, numPartitions int64) error {
+	return joinImpl[K, T, []T, []T](this, other, numPartitions, true, true, joinOptional[T], joinOptional[T])
+}
+
+func joinValue[T any](v *T) T {
+	return *v
+}
+
+func joinOptional[T any](v *T) []T {
+	if v == nil {
+		return nil
+	}
+	return []T{*v}
+}
+
+func joinImpl[K comparable, T any, A any, B any](this *IReduceImpl, other string, numPartitions int64, leftOuter bool,
+	rightOuter bool, left func(*T) A, right func(*T) B) error {
+	if less := sortMergeLess[K](this, other, numPartitions); less != nil {
+		return sortMergeJoin[K, T, A, B](this, other, less, leftOuter, rightOuter, left, right)
+	}
+	if done, err := broadcastJoin[K, T, A, B](this, other, leftOuter, rightOuter, left, right); err != nil {
+		return ierror.Raise(err)
+	} else if done {
+		return nil
+	}
 	logger.Info("Reduce: preparing first partitions")
 	if err := keyHashing[K, T](this, numPartitions); err != nil {
 		return ierror.Raise(err)
@@ -518,13 +1052,15 @@ func Join[K comparable, T any](this *IReduceImpl, other string, numPartitions in
 	}
 
 	logger.Info("Reduce: joining key elements")
-	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[T, T]]](this.executorData.GetPartitionTools(), int(numPartitions))
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[A, B]]](this.executorData.GetPartitionTools(), int(numPartitions))
 	if err != nil {
 		return ierror.Raise(err)
 	}
 
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		acum := map[K][]T{}
+		matched := map[K]bool{}
+		keys := newINormalKeys[K]()
 		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			writer, err := output.Get(p).WriteIterator()
 			if err != nil {
@@ -539,7 +1075,8 @@ func Join[K comparable, T any](this *IReduceImpl, other string, numPartitions in
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				acum[elem.First] = append(acum[elem.First], elem.Second)
+				key := keys.key(elem.First)
+				acum[key] = append(acum[key], elem.Second)
 			}
 
 			reader, err = input2.Get(p).ReadIterator()
@@ -551,16 +1088,37 @@ func Join[K comparable, T any](this *IReduceImpl, other string, numPartitions in
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if values, present := acum[elem.First]; present {
-					for _, value := range values {
-						if err = writer.Write(*ipair.New(elem.First, *ipair.New(value, elem.Second))); err != nil {
+				key := keys.find(elem.First)
+				if values, present := acum[key]; present {
+					for i := range values {
+						if err = writer.Write(*ipair.New(elem.First, *ipair.New(left(&values[i]), right(&elem.Second)))); err != nil {
+							return ierror.Raise(err)
+						}
+					}
+					if leftOuter {
+						matched[key] = true
+					}
+				} else if rightOuter {
+					if err = writer.Write(*ipair.New(elem.First, *ipair.New(left(nil), right(&elem.Second)))); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+			}
+			if leftOuter {
+				for key, values := range acum {
+					if matched[key] {
+						continue
+					}
+					for i := range values {
+						if err = writer.Write(*ipair.New(keys.original(key), *ipair.New(left(&values[i]), right(nil)))); err != nil {
 							return ierror.Raise(err)
 						}
-
 					}
 				}
+				matched = map[K]bool{}
 			}
 			acum = map[K][]T{}
+			keys.clear()
 			return nil
 		})
 	}); err != nil {
@@ -571,53 +1129,1266 @@ func Join[K comparable, T any](this *IReduceImpl, other string, numPartitions in
 	return nil
 }
 
-func Distinct[T comparable](this *IReduceImpl, numPartitions int64) error {
-	input, err := core.GetPartitions[T](this.executorData)
+/*
+Order of the keys when both datasets are already distributed by the hash partitioner of the join and sorted by key in
+the same direction, nil if a hash join is required.
+*/
+func sortMergeLess[K comparable](this *IReduceImpl, other string, numPartitions int64) func(K, K) bool {
+	layout := this.executorData.KeyLayout()
+	if layout.Order == 0 || layout.Partitioner != NewIHashPartitioner[K](numPartitions).Id() ||
+		layout != this.executorData.VariableKeyLayout(other) {
+		return nil
+	}
+	input := this.executorData.GetPartitionsAny()
+	input2 := core.GetVariable[storage.IPartitionGroupBase](this.executorData, other)
+	if input.Size() != input2.Size() {
+		return nil
+	}
+	less, err := defaultCmp[K]()
+	if err != nil {
+		return nil
+	}
+	if layout.Order < 0 {
+		return func(a, b K) bool { return less(b, a) }
+	}
+	return less
+}
+
+/*Streams both sorted partitions at the same time, only the values of the current left key are kept in memory*/
+func sortMergeJoin[K comparable, T any, A any, B any](this *IReduceImpl, other string, less func(K, K) bool,
+	leftOuter bool, rightOuter bool, left func(*T) A, right func(*T) B) error {
+	logger.Info("Reduce: partitions are co-partitioned and sorted by key, using sort-merge join")
+	layout := this.executorData.KeyLayout()
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	logger.Info("Reduce: distinct ", input.Size(), " partitions")
-	tmp, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), int(numPartitions))
+	this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+	input2, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	logger.Info("Reduce: creating ", numPartitions, " new partitions with hashing")
-	hasher := utils.GetHasher(utils.TypeObj[T]())
-	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		threadRanges, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), tmp.Size())
-		if err != nil {
-			return ierror.Raise(err)
-		}
-		writers := make([]iterator.IWriteIterator[T], tmp.Size())
-		for p := 0; p < tmp.Size(); p++ {
-			writers[p], err = threadRanges.Get(p).WriteIterator()
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[A, B]]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			writer, err := output.Get(p).WriteIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
-		}
-		if err = rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			reader, err := input.Get(p).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			for reader.HasNext() {
-				elem, err := reader.Next()
-				if err != nil {
+			reader2, err := input2.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			next := func(it iterator.IReadIterator[ipair.IPair[K, T]]) (*ipair.IPair[K, T], error) {
+				if !it.HasNext() {
+					return nil, nil
+				}
+				elem, err := it.Next()
+				return &elem, err
+			}
+			write := func(key K, a A, b B) error {
+				return writer.Write(*ipair.New(key, *ipair.New(a, b)))
+			}
+			l, err := next(reader)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			r, err := next(reader2)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			var values []T
+			for l != nil || r != nil {
+				if r == nil || (l != nil && less(l.First, r.First)) {
+					if leftOuter {
+						if err = write(l.First, left(&l.Second), right(nil)); err != nil {
+							return ierror.Raise(err)
+						}
+					}
+					if l, err = next(reader); err != nil {
+						return ierror.Raise(err)
+					}
+					continue
+				}
+				if l == nil || less(r.First, l.First) || r.First != l.First {
+					if rightOuter {
+						if err = write(r.First, left(nil), right(&r.Second)); err != nil {
+							return ierror.Raise(err)
+						}
+					}
+					if r, err = next(reader2); err != nil {
+						return ierror.Raise(err)
+					}
+					continue
+				}
+				key := l.First
+				values = values[:0]
+				for l != nil && l.First == key {
+					values = append(values, l.Second)
+					if l, err = next(reader); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+				for r != nil && r.First == key {
+					for i := range values {
+						if err = write(key, left(&values[i]), right(&r.Second)); err != nil {
+							return ierror.Raise(err)
+						}
+					}
+					if r, err = next(reader2); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	this.executorData.SetKeyLayout(layout)
+	return nil
+}
+
+/*
+Joins the local partitions with the whole second dataset when it is smaller than the broadcast threshold, the first
+dataset is never exchanged and keeps its partitions. Returns false if the hash join is required, a right outer join
+always needs it because the unmatched elements of the second dataset are only known globally.
+*/
+func broadcastJoin[K comparable, T any, A any, B any](this *IReduceImpl, other string, leftOuter bool, rightOuter bool,
+	left func(*T) A, right func(*T) B) (bool, error) {
+	threshold, err := this.executorData.GetProperties().JoinBroadcast()
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	if threshold == 0 || rightOuter {
+		return false, nil
+	}
+	input2, err := core.ConvertGroupPartitionTo[ipair.IPair[K, T]](this.executorData.GetPartitionTools(),
+		core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	bytes, err := approxBytes(this.executorData, input2)
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	if err = impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&bytes), 1, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		this.executorData.Mpi().Native()); err != nil {
+		return false, ierror.Raise(err)
+	}
+	if bytes > threshold {
+		return false, nil
+	}
+	logger.Info("Reduce: second dataset has ", bytes, " bytes, broadcasting it to every executor")
+	table, err := broadcastTable[K, T](this, input2)
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: joining key elements")
+	layout := this.executorData.KeyLayout()
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[A, B]]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				values, present := table[elem.First]
+				if !present && leftOuter {
+					if err = writer.Write(*ipair.New(elem.First, *ipair.New(left(&elem.Second), right(nil)))); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+				for i := range values {
+					if err = writer.Write(*ipair.New(elem.First, *ipair.New(left(&elem.Second), right(&values[i])))); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return false, ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	this.executorData.SetKeyLayout(layout)
+	return true, nil
+}
+
+/*
+Every executor broadcasts its elements in turn, messages are split in chunks of the broadcast chunk size. The result
+is the values of every key of the whole dataset.
+*/
+func broadcastTable[K comparable, T any](this *IReduceImpl,
+	group *storage.IPartitionGroup[ipair.IPair[K, T]]) (map[K][]T, error) {
+	table := map[K][]T{}
+	add := func(part storage.IPartition[ipair.IPair[K, T]]) error {
+		reader, err := part.ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for reader.HasNext() {
+			elem, err := reader.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			table[elem.First] = append(table[elem.First], elem.Second)
+		}
+		return nil
+	}
+	for _, part := range group.Iter() {
+		if err := add(part); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	mpi := this.executorData.Mpi()
+	if mpi.Executors() == 1 {
+		return table, nil
+	}
+
+	properties := this.executorData.GetProperties()
+	chunk, err := properties.BroadcastChunk()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	cmp, err := properties.MsgCompression()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	native, err := properties.NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	elems := int64(0)
+	for _, part := range group.Iter() {
+		elems += part.Size()
+	}
+	local, err := core.NewMemoryPartition[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), elems)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	writer, err := local.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	for key, values := range table {
+		for i := range values {
+			if err = writer.Write(*ipair.New(key, values[i])); err != nil {
+				return nil, ierror.Raise(err)
+			}
+		}
+	}
+	buffer := itransport.NewIMemoryBuffer()
+	if err = local.WriteWithNative(buffer, cmp, native); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	metrics := this.executorData.Metrics()
+	for root := 0; root < mpi.Executors(); root++ {
+		var data []byte
+		if mpi.IsRoot(root) {
+			data = buffer.Bytes()
+			metrics.Transfer(root, true, int64(len(data)))
+		}
+		if data, err = mpi.BcastBytes(data, int(chunk), root); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		if mpi.IsRoot(root) {
+			continue
+		}
+		metrics.Transfer(root, false, int64(len(data)))
+		part, err := core.NewMemoryPartition[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), 0)
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		if err = part.Read(itransport.NewIMemoryBufferWrapper(data, int64(len(data)), itransport.OBSERVE)); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		if err = add(part); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	return table, nil
+}
+
+func CoGroup[K comparable, V1 any, V2 any](this *IReduceImpl, other string, numPartitions int64) error {
+	logger.Info("Reduce: preparing first partitions")
+	if err := keyHashing[K, V1](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, V1](this); err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetPartitions[ipair.IPair[K, V1]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: preparing second partitions")
+	this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+	if err := keyHashing[K, V2](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, V2](this); err != nil {
+		return ierror.Raise(err)
+	}
+	input2, err := core.GetPartitions[ipair.IPair[K, V2]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: grouping key elements")
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[[]V1, []V2]]](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			acum := map[K]*ipair.IPair[[]V1, []V2]{}
+			keys := make([]K, 0)
+			normal := newINormalKeys[K]()
+			group := func(key K) *ipair.IPair[[]V1, []V2] {
+				key = normal.key(key)
+				values, present := acum[key]
+				if !present {
+					values = &ipair.IPair[[]V1, []V2]{}
+					acum[key] = values
+					keys = append(keys, key)
+				}
+				return values
+			}
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				values := group(elem.First)
+				values.First = append(values.First, elem.Second)
+			}
+			reader2, err := input2.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader2.HasNext() {
+				elem, err := reader2.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				values := group(elem.First)
+				values.Second = append(values.Second, elem.Second)
+			}
+
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for _, key := range keys {
+				if err = writer.Write(*ipair.New(normal.original(key), *acum[key])); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func Distinct[T comparable](this *IReduceImpl, numPartitions int64) error {
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: distinct ", input.Size(), " partitions")
+	tmp, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: creating ", numPartitions, " new partitions with hashing")
+	hasher := utils.GetHasher(utils.TypeObj[T]())
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		threadRanges, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), tmp.Size())
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		writers := make([]iterator.IWriteIterator[T], tmp.Size())
+		for p := 0; p < tmp.Size(); p++ {
+			writers[p], err = threadRanges.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		if err = rctx.For().Guided().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writers[utils.Hash(elem, hasher)%uint64(numPartitions)].Write(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+
+			return nil
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		return rctx.Critical(func() error {
+			for p, part := range threadRanges.Iter() {
+				if err := part.MoveTo(tmp.Get(p)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	if err = distinctFilter(this, tmp); err != nil {
+		return ierror.Raise(err)
+	}
+	var unique *storage.IPartitionGroup[T]
+	if bloom, err := this.executorData.GetProperties().DistinctBloom(); err != nil {
+		return ierror.Raise(err)
+	} else if bloom > 0 && this.executorData.Mpi().Executors() > 1 {
+		if unique, err = bloomUnique(this, tmp, bloom); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	if err = Exchange(this.Base(), tmp, output); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = distinctFilter(this, output); err != nil {
+		return ierror.Raise(err)
+	}
+	if unique != nil {
+		exchanged := output.Size()
+		for p, part := range unique.Iter() {
+			if exchanged == 0 {
+				output.Add(part)
+			} else if err = part.MoveTo(output.Get(p % exchanged)); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Distinct for elements that are not comparable, elements are routed and compared by their serialization*/
+func SerializedDistinct[T any](this *IReduceImpl, numPartitions int64) error {
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: distinct ", input.Size(), " partitions by serialization")
+	tmp, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: creating ", numPartitions, " new partitions with serialized hashing")
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		threadRanges, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), tmp.Size())
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		writers := make([]iterator.IWriteIterator[T], tmp.Size())
+		for p := 0; p < tmp.Size(); p++ {
+			writers[p], err = threadRanges.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		encoder := newIKeyEncoder[T]()
+		if err = rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				data, err := encoder.Encode(elem)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writers[utils.HashBytes(data)%uint64(numPartitions)].Write(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		return rctx.Critical(func() error {
+			for p, part := range threadRanges.Iter() {
+				if err := part.MoveTo(tmp.Get(p)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = serializedDistinctFilter(this, tmp); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = Exchange(this.Base(), tmp, output); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = serializedDistinctFilter(this, output); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func Intersection[T comparable](this *IReduceImpl, other string, numPartitions int64) error {
+	return setImpl[T](this, other, numPartitions, true)
+}
+
+func Subtract[T comparable](this *IReduceImpl, other string, numPartitions int64) error {
+	return setImpl[T](this, other, numPartitions, false)
+}
+
+/*
+Both datasets are hashed into the same partitions, so each partition of the first dataset is only compared with the
+hash set of the same partition of the second one. Intersection elements are distinct, Subtract keeps duplicates.
+*/
+func setImpl[T comparable](this *IReduceImpl, other string, numPartitions int64, intersection bool) error {
+	logger.Info("Reduce: preparing first partitions")
+	if err := valueHashing[T](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := valueExchanging[T](this); err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: preparing second partitions")
+	this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+	if err := valueHashing[T](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := valueExchanging[T](this); err != nil {
+		return ierror.Raise(err)
+	}
+	input2, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: ", utils.Ternary(intersection, "intersecting", "subtracting"), " ", input.Size(), " partitions")
+	output, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			set := map[T]bool{}
+			keys := newINormalKeys[T]()
+			reader, err := input2.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				set[keys.find(elem)] = true
+			}
+			input2.SetBase(p, nil)
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if reader, err = input.Get(p).ReadIterator(); err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				key := keys.find(elem)
+				if set[key] == intersection {
+					if err = writer.Write(elem); err != nil {
+						return ierror.Raise(err)
+					}
+					if intersection {
+						delete(set, key)
+					}
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*Removes the pairs whose key is present in the other dataset*/
+func SubtractByKey[K comparable, T any](this *IReduceImpl, other string, numPartitions int64) error {
+	logger.Info("Reduce: preparing first partitions")
+	if err := keyHashing[K, T](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, T](this); err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: preparing second partitions")
+	this.executorData.SetPartitionsAny(core.GetVariable[storage.IPartitionGroupBase](this.executorData, other))
+	if err := keyHashing[K, T](this, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, T](this); err != nil {
+		return ierror.Raise(err)
+	}
+	input2, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: subtracting keys of ", input.Size(), " partitions")
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			keys := map[K]bool{}
+			normal := newINormalKeys[K]()
+			reader, err := input2.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				keys[normal.find(elem.First)] = true
+			}
+			input2.SetBase(p, nil)
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if reader, err = input.Get(p).ReadIterator(); err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if !keys[normal.find(elem.First)] {
+					if err = writer.Write(elem); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func reducePartition[T any](this *IReduceImpl, f function.IFunction2[T, T, T], part storage.IPartition[T]) (T, error) {
+	context := this.executorData.GetContext()
+	reader, err := part.ReadIterator()
+	if err != nil {
+		return *new(T), ierror.Raise(err)
+	}
+	acum, err := reader.Next()
+	if err != nil {
+		return acum, ierror.Raise(err)
+	}
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return acum, ierror.Raise(err)
+		}
+		acum, err = f.Call(acum, elem, context)
+		if err != nil {
+			return acum, ierror.Raise(err)
+		}
+	}
+	return acum, nil
+}
+
+func finalReduce[T any](this *IReduceImpl, f function.IFunction2[T, T, T], partial *storage.IMemoryPartition[T]) error {
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Reduce: reducing all elements in the executor")
+	if partial.Size() > 1 {
+		elem, err := reducePartition[T](this, f, partial)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		list := partial.Inner().(storage.IList)
+		list.SetAny(0, elem)
+		list.Resize(1, true)
+	}
+
+	logger.Info("Reduce: gathering elements for an executor")
+	if err = core.Gather[T](this.executorData.Mpi(), partial, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	if this.executorData.Mpi().IsRoot(0) && !partial.Empty() {
+		logger.Info("Reduce: final reduce")
+		result, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 1)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		elem, err := reducePartition[T](this, f, partial)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		result.Inner().(storage.IList).AddAny(elem)
+		output.Add(result)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func finalTreeReduce[T any](this *IReduceImpl, f function.IFunction2[T, T, T], partial *storage.IMemoryPartition[T]) error {
+	executors := this.executorData.Mpi().Executors()
+	rank := this.executorData.Mpi().Rank()
+	context := this.Context()
+	partiall := partial.Inner().(storage.IList)
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: reducing all elements in the executor")
+	if partial.Size() > 1 {
+		if this.executorData.GetCores() == 1 {
+			elem, err := reducePartition[T](this, f, partial)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			list := partial.Inner().(storage.IList)
+			list.SetAny(0, elem)
+			list.Resize(1, true)
+		} else {
+			if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+				n := int(partial.Size())
+				n2 := n / 2
+				for n2 > 0 {
+					if err := rctx.For().Static().Run(n2, func(i int) error {
+						elem, err := f.Call(partiall.GetAny(i).(T), partiall.GetAny(n-i-1).(T), context)
+						if err != nil {
+							return ierror.Raise(err)
+						}
+						partiall.SetAny(i, elem)
+						return nil
+					}); err != nil {
+						return ierror.Raise(err)
+					}
+					n = int(math.Ceil(float64(n) / 2))
+					n2 = n / 2
+				}
+				return nil
+			}); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		partiall.Resize(1, false)
+	}
+
+	depth, err := this.executorData.GetProperties().ReduceTreeDepth()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	fanIn := 2
+	if depth > 0 {
+		fanIn = utils.Max(2, int(math.Ceil(math.Pow(float64(executors), 1/float64(depth)))))
+	}
+	logger.Info("Reduce: performing a final tree reduce with fan-in ", fanIn)
+	for distance := 1; distance < executors; distance *= fanIn {
+		order := distance * fanIn
+		if rank%order != 0 {
+			if err := core.Send[T](this.executorData.Mpi(), partial, rank-rank%order, 0); err != nil {
+				return ierror.Raise(err)
+			}
+			partiall.Resize(0, false)
+			break
+		}
+		for j := 1; j < fanIn && rank+j*distance < executors; j++ {
+			if err := core.Recv[T](this.executorData.Mpi(), partial, rank+j*distance, 0); err != nil {
+				return ierror.Raise(err)
+			}
+			if partial.Size() > 1 {
+				elem, err := f.Call(partiall.GetAny(0).(T), partiall.GetAny(1).(T), context)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				partiall.SetAny(0, elem)
+				partiall.Resize(1, false)
+			}
+		}
+	}
+
+	if this.executorData.Mpi().IsRoot(0) && partial.Size() > 0 {
+		result, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 1)
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		result.Inner().(storage.IList).AddAny(partiall.GetAny(0))
+		output.Add(result)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func aggregatePartition[T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T], part storage.IPartition[T2], acum T) (T, error) {
+	context := this.Context()
+	reader, err := part.ReadIterator()
+	if err != nil {
+		return acum, ierror.Raise(err)
+	}
+
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return acum, ierror.Raise(err)
+		}
+		acum, err = f.Call(acum, elem, context)
+		if err != nil {
+			return acum, ierror.Raise(err)
+		}
+	}
+
+	return acum, nil
+}
+
+func localReduceByKey[K comparable, T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output := input
+	if output.Cache() {
+		if output, err = core.NewPartitionGroupDef[ipair.IPair[K, T]](this.executorData.GetPartitionTools()); err != nil {
+			return ierror.Raise(err)
+		}
+		for p := 0; p < input.Size(); p++ {
+			part, err := core.NewPartitionWithName[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Get(0).Type())
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			output.Add(part)
+		}
+	}
+
+	context := this.executorData.GetContext()
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		acum := map[K]T{}
+		keys := newINormalKeys[K]()
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				key := keys.key(elem.First)
+				if val, present := acum[key]; !present {
+					acum[key] = elem.Second
+				} else {
+					acum[key], err = f.Call(val, elem.Second, context)
+					if err != nil {
+						return ierror.Raise(err)
+					}
+				}
+			}
+			if err = output.Get(p).Clear(); err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for key, value := range acum {
+				if err = writer.Write(*ipair.New(keys.original(key), value)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			acum = map[K]T{}
+			keys.clear()
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func localCacheReduceByKey[K comparable, T any](this *IReduceImpl, f function.IFunction2[T, T, T], capacity int) error {
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Reduce: using a cache of ", capacity, " hot keys")
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			keys := newINormalKeys[K]()
+			evict := func(key K, value T) error {
+				return writer.Write(*ipair.New(keys.original(key), value))
+			}
+			acum := newILruCache[K, T](capacity)
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = acum.Fold(keys.key(elem.First), elem.Second, func(a T, b T) (T, error) {
+					return f.Call(a, b, context)
+				}, evict); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			if err = acum.Flush(evict); err != nil {
+				return ierror.Raise(err)
+			}
+			if !input.Cache() {
+				input.Set(p, nil)
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func localAggregateByKey[K comparable, T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T], spill bool) error {
+	baseAcum := core.GetVariable[T](this.executorData, "zero")
+	return localCombineByKey[K](this, func(v T2, context api.IContext) (T, error) {
+		return f.Call(baseAcum, v, context)
+	}, f.Call, spill)
+}
+
+func localCombineByKey[K comparable, V any, C any](this *IReduceImpl, create func(V, api.IContext) (C, error),
+	merge func(C, V, api.IContext) (C, error), spill bool) error {
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, V]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, C]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	limit, threshold := int64(0), int64(0)
+	if spill {
+		if limit, err = this.executorData.GetProperties().ReduceCombineLimit(); err != nil {
+			return ierror.Raise(err)
+		}
+		if threshold, err = this.executorData.GetProperties().PartitionSpill(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	spills := 0
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		acum := newICombinerTable[K](func(v V) (C, error) {
+			return create(v, context)
+		}, func(c C, v V) (C, error) {
+			return merge(c, v, context)
+		}, int(limit), threshold)
+		if err := rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			keys := newINormalKeys[K]()
+			flush := func(key K, value C) error {
+				return writer.Write(*ipair.New(keys.original(key), value))
+			}
+			var evict func(K, C) error
+			if spill {
+				evict = flush
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = acum.Add(keys.key(elem.First), elem.Second, evict); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			if err = acum.Flush(flush); err != nil {
+				return ierror.Raise(err)
+			}
+			input.SetBase(p, nil)
+			return ierror.Raise(output.Get(p).Fit())
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		return rctx.Critical(func() error {
+			spills += acum.Spills()
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if spills > 0 {
+		logger.Info("Reduce: combiner tables spilled ", spills, " times")
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func keyHashing[K comparable, T any](this *IReduceImpl, numPartitions int64) error {
+	return saltedKeyHashing[K, T](this, numPartitions, nil)
+}
+
+/*Elements of hot keys are sent round-robin to the partitions of their salts*/
+func saltedKeyHashing[K comparable, T any](this *IReduceImpl, numPartitions int64, skew *iSkew) error {
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	hasher := utils.GetHasher(utils.TypeObj[K]())
+	logger.Info("Reduce: creating ", numPartitions, " new partitions with key hashing")
+
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		threadRanges, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), output.Size())
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		writers := make([]iterator.IWriteIterator[ipair.IPair[K, T]], threadRanges.Size())
+		for p := 0; p < threadRanges.Size(); p++ {
+			writers[p], err = threadRanges.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		next := int64(0)
+		if err = rctx.For().Guided().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				hash := utils.Hash(elem.First, hasher)
+				target := hash % uint64(numPartitions)
+				if skew != nil && skew.hot(hash) {
+					target = (target + uint64(next)) % uint64(numPartitions)
+					next = (next + 1) % skew.salt
+				}
+				if err = writers[target].Write(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		return rctx.Critical(func() error {
+			for p, part := range threadRanges.Iter() {
+				if err := part.MoveTo(output.Get(p)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*
+Elements are routed by the hash of their serialized key, combining the values of each partition if localReduce.
+f is only called with localReduce, so it can be nil without it.
+*/
+func serializedKeyHashing[K any, T any](this *IReduceImpl, f function.IFunction2[T, T, T], numPartitions int64, localReduce bool) error {
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if localReduce {
+		logger.Info("Reduce: local reducing key elements")
+	}
+	logger.Info("Reduce: creating ", numPartitions, " new partitions with serialized key hashing")
+
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		threadRanges, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), output.Size())
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		writers := make([]iterator.IWriteIterator[ipair.IPair[K, T]], threadRanges.Size())
+		for p := 0; p < threadRanges.Size(); p++ {
+			writers[p], err = threadRanges.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		encoder := newIKeyEncoder[K]()
+		acum := newIKeyTable[K, T]()
+		merge := func(a T, b T) (T, error) {
+			return f.Call(a, b, context)
+		}
+		emit := func(hash uint64, key K, value T) error {
+			return writers[hash%uint64(numPartitions)].Write(*ipair.New(key, value))
+		}
+		if err = rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
 					return ierror.Raise(err)
 				}
-				if err = writers[utils.Hash(elem, hasher)%uint64(numPartitions)].Write(elem); err != nil {
+				data, err := encoder.Encode(elem.First)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if localReduce {
+					err = acum.Add(utils.HashBytes(data), data, elem.First, elem.Second, merge)
+				} else {
+					err = emit(utils.HashBytes(data), elem.First, elem.Second)
+				}
+				if err != nil {
 					return ierror.Raise(err)
 				}
 			}
+			if err = acum.Flush(emit); err != nil {
+				return ierror.Raise(err)
+			}
 			input.SetBase(p, nil)
-
 			return nil
 		}); err != nil {
 			return ierror.Raise(err)
 		}
 		return rctx.Critical(func() error {
 			for p, part := range threadRanges.Iter() {
-				if err := part.MoveTo(tmp.Get(p)); err != nil {
+				if err := part.MoveTo(output.Get(p)); err != nil {
 					return ierror.Raise(err)
 				}
 			}
@@ -627,18 +2398,58 @@ func Distinct[T comparable](this *IReduceImpl, numPartitions int64) error {
 		return ierror.Raise(err)
 	}
 
-	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
-	if err != nil {
-		return ierror.Raise(err)
-	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
 
-	if err = distinctFilter(this, tmp); err != nil {
+func serializedReduceByKey[K any, T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
 		return ierror.Raise(err)
 	}
-	if err = Exchange(this.Base(), tmp, output); err != nil {
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
 		return ierror.Raise(err)
 	}
-	if err = distinctFilter(this, output); err != nil {
+
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		encoder := newIKeyEncoder[K]()
+		acum := newIKeyTable[K, T]()
+		merge := func(a T, b T) (T, error) {
+			return f.Call(a, b, context)
+		}
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				data, err := encoder.Encode(elem.First)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = acum.Add(utils.HashBytes(data), data, elem.First, elem.Second, merge); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = acum.Flush(func(hash uint64, key K, value T) error {
+				return writer.Write(*ipair.New(key, value))
+			}); err != nil {
+				return ierror.Raise(err)
+			}
+			input.SetBase(p, nil)
+			return nil
+		})
+	}); err != nil {
 		return ierror.Raise(err)
 	}
 
@@ -646,394 +2457,588 @@ func Distinct[T comparable](this *IReduceImpl, numPartitions int64) error {
 	return nil
 }
 
-func reducePartition[T any](this *IReduceImpl, f function.IFunction2[T, T, T], part storage.IPartition[T]) (T, error) {
-	context := this.executorData.GetContext()
-	reader, err := part.ReadIterator()
+func keyExchanging[K any, T any](this *IReduceImpl) error {
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
 	if err != nil {
-		return *new(T), ierror.Raise(err)
+		return ierror.Raise(err)
 	}
-	acum, err := reader.Next()
+	output, err := core.NewPartitionGroupDef[ipair.IPair[K, T]](this.executorData.GetPartitionTools())
 	if err != nil {
-		return acum, ierror.Raise(err)
+		return ierror.Raise(err)
 	}
-	for reader.HasNext() {
-		elem, err := reader.Next()
-		if err != nil {
-			return acum, ierror.Raise(err)
-		}
-		acum, err = f.Call(acum, elem, context)
-		if err != nil {
-			return acum, ierror.Raise(err)
-		}
+	logger.Info("Reduce: exchanging ", input.Size(), " partitions keys")
+
+	if err = Exchange(this.Base(), input, output); err != nil {
+		return ierror.Raise(err)
 	}
-	return acum, nil
+
+	core.SetPartitions(this.executorData, output)
+	return nil
 }
 
-func finalReduce[T any](this *IReduceImpl, f function.IFunction2[T, T, T], partial *storage.IMemoryPartition[T]) error {
-	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+func valueHashing[T comparable](this *IReduceImpl, numPartitions int64) error {
+	input, err := core.GetPartitions[T](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	logger.Info("Reduce: reducing all elements in the executor")
-	if partial.Size() > 1 {
-		elem, err := reducePartition[T](this, f, partial)
-		if err != nil {
-			return ierror.Raise(err)
-		}
-		list := partial.Inner().(storage.IList)
-		list.SetAny(0, elem)
-		list.Resize(1, true)
-	}
-
-	logger.Info("Reduce: gathering elements for an executor")
-	if err = core.Gather[T](this.executorData.Mpi(), partial, 0); err != nil {
+	output, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
 		return ierror.Raise(err)
 	}
-	if this.executorData.Mpi().IsRoot(0) && !partial.Empty() {
-		logger.Info("Reduce: final reduce")
-		result, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 1)
+	hasher := utils.GetHasher(utils.TypeObj[T]())
+	logger.Info("Reduce: creating ", numPartitions, " new partitions with hashing")
+
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		threadRanges, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), output.Size())
 		if err != nil {
 			return ierror.Raise(err)
 		}
-		elem, err := reducePartition[T](this, f, partial)
-		if err != nil {
+		writers := make([]iterator.IWriteIterator[T], threadRanges.Size())
+		for p := 0; p < threadRanges.Size(); p++ {
+			writers[p], err = threadRanges.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		if err = rctx.For().Guided().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writers[utils.Hash(elem, hasher)%uint64(numPartitions)].Write(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		}); err != nil {
 			return ierror.Raise(err)
 		}
-		result.Inner().(storage.IList).AddAny(elem)
-		output.Add(result)
+		return rctx.Critical(func() error {
+			for p, part := range threadRanges.Iter() {
+				if err := part.MoveTo(output.Get(p)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
 	}
+
 	core.SetPartitions(this.executorData, output)
 	return nil
 }
 
-func finalTreeReduce[T any](this *IReduceImpl, f function.IFunction2[T, T, T], partial *storage.IMemoryPartition[T]) error {
-	executors := this.executorData.Mpi().Executors()
-	rank := this.executorData.Mpi().Rank()
-	context := this.Context()
-	partiall := partial.Inner().(storage.IList)
+func valueExchanging[T any](this *IReduceImpl) error {
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
 	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
 	if err != nil {
 		return ierror.Raise(err)
 	}
+	logger.Info("Reduce: exchanging ", input.Size(), " partitions")
 
-	logger.Info("Reduce: reducing all elements in the executor")
-	if partial.Size() > 1 {
-		if this.executorData.GetCores() == 1 {
-			elem, err := reducePartition[T](this, f, partial)
+	if err = Exchange(this.Base(), input, output); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*
+Keys whose equal values have several representations are indexed by their normalized value, the first representation
+found is the one written. Hashing already uses the normalized value, so equal keys are always in the same partition.
+*/
+type iNormalKeys[K comparable] struct {
+	normalize func(e K) K
+	originals map[K]K
+}
+
+func newINormalKeys[K comparable]() *iNormalKeys[K] {
+	return &iNormalKeys[K]{normalize: utils.Normalizer[K]()}
+}
+
+/*Index of the key in a map, its representation is saved if it is the first*/
+func (this *iNormalKeys[K]) key(k K) K {
+	if this.normalize == nil {
+		return k
+	}
+	n := this.normalize(k)
+	if this.originals == nil {
+		this.originals = map[K]K{}
+	}
+	if _, present := this.originals[n]; !present {
+		this.originals[n] = k
+	}
+	return n
+}
+
+/*Index of the key in a map without saving its representation, for lookups*/
+func (this *iNormalKeys[K]) find(k K) K {
+	if this.normalize == nil {
+		return k
+	}
+	return this.normalize(k)
+}
+
+/*First representation of an index*/
+func (this *iNormalKeys[K]) original(n K) K {
+	if this.normalize == nil {
+		return n
+	}
+	return this.originals[n]
+}
+
+func (this *iNormalKeys[K]) clear() {
+	this.originals = nil
+}
+
+func distinctFilter[T comparable](this *IReduceImpl, parts *storage.IPartitionGroup[T]) error {
+	return ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		distinct := map[T]bool{}
+		keys := newINormalKeys[T]()
+		return rctx.For().Dynamic().Run(parts.Size(), func(p int) error {
+			newPart, err := core.NewPartitionDef[T](this.executorData.GetPartitionTools())
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			list := partial.Inner().(storage.IList)
-			list.SetAny(0, elem)
-			list.Resize(1, true)
-		} else {
-			if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-				n := int(partial.Size())
-				n2 := n / 2
-				for n2 > 0 {
-					if err := rctx.For().Static().Run(n2, func(i int) error {
-						elem, err := f.Call(partiall.GetAny(i).(T), partiall.GetAny(n-i-1).(T), context)
-						if err != nil {
-							return ierror.Raise(err)
-						}
-						partiall.SetAny(i, elem)
-						return nil
-					}); err != nil {
-						return ierror.Raise(err)
-					}
-					n = int(math.Ceil(float64(n) / 2))
-					n2 = n / 2
+			reader, err := parts.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := newPart.WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				distinct[keys.key(elem)] = true
+			}
+			for elem, _ := range distinct {
+				if err = writer.Write(keys.original(elem)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			parts.Set(p, newPart)
+			distinct = map[T]bool{}
+			keys.clear()
+			return nil
+		})
+	})
+}
+
+func serializedDistinctFilter[T any](this *IReduceImpl, parts *storage.IPartitionGroup[T]) error {
+	return ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		encoder := newIKeyEncoder[T]()
+		distinct := newIKeyTable[T, struct{}]()
+		keep := func(a struct{}, b struct{}) (struct{}, error) {
+			return a, nil
+		}
+		return rctx.For().Dynamic().Run(parts.Size(), func(p int) error {
+			newPart, err := core.NewPartitionDef[T](this.executorData.GetPartitionTools())
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			reader, err := parts.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := newPart.WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				data, err := encoder.Encode(elem)
+				if err != nil {
+					return ierror.Raise(err)
 				}
-				return nil
+				if err = distinct.Add(utils.HashBytes(data), data, elem, struct{}{}, keep); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			if err = distinct.Flush(func(hash uint64, elem T, _ struct{}) error {
+				return writer.Write(elem)
 			}); err != nil {
 				return ierror.Raise(err)
 			}
-		}
-		partiall.Resize(1, false)
+			parts.Set(p, newPart)
+			return nil
+		})
+	})
+}
+
+func Pivot[T any, K comparable, C comparable, V any](this *IReduceImpl, keyF function.IFunction[T, K], columnF function.IFunction[T, C],
+	aggF function.IFunction2[V, T, V], numPartitions int64) ([]C, error) {
+	context := this.Context()
+	if err := keyF.Before(context); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := columnF.Before(context); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := aggF.Before(context); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	pairs, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return nil, ierror.Raise(err)
 	}
 
-	logger.Info("Reduce: performing a final tree reduce")
-	distance := 1
-	order := 1
-	for order < executors {
-		order *= 2
-		if rank%order == 0 {
-			other := rank + distance
-			distance = order
-			if other >= executors {
-				continue
-			}
-			if err := core.Recv[T](this.executorData.Mpi(), partial, other, 0); err != nil {
+	logger.Info("Reduce: discovering pivot columns")
+	distinct := map[C]bool{}
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		local := map[C]bool{}
+		if err := rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
 				return ierror.Raise(err)
 			}
-			elem, err := f.Call(partiall.GetAny(0).(T), partiall.GetAny(1).(T), context)
+			writer, err := pairs.Get(p).WriteIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			partiall.SetAny(0, elem)
-			partiall.Resize(1, false)
-		} else {
-			other := rank * distance
-			if err := core.Send[T](this.executorData.Mpi(), partial, other, 0); err != nil {
-				return ierror.Raise(err)
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				key, err := keyF.Call(elem, context)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				column, err := columnF.Call(elem, context)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				local[column] = true
+				if err = writer.Write(ipair.IPair[K, T]{key, elem}); err != nil {
+					return ierror.Raise(err)
+				}
 			}
-			break
-		}
-	}
-
-	if this.executorData.Mpi().IsRoot(0) && partial.Size() > 0 {
-		result, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 1)
-		if err != nil {
+			input.Set(p, nil)
+			return nil
+		}); err != nil {
 			return ierror.Raise(err)
 		}
-		result.Inner().(storage.IList).AddAny(partiall.GetAny(0))
-		output.Add(result)
+		return rctx.Critical(func() error {
+			for column := range local {
+				distinct[column] = true
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
 	}
-	core.SetPartitions(this.executorData, output)
-	return nil
-}
-
-func aggregatePartition[T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T], part storage.IPartition[T2], acum T) (T, error) {
-	context := this.Context()
-	reader, err := part.ReadIterator()
+	columns, err := pivotColumns[C](this, distinct)
 	if err != nil {
-		return acum, ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
-
-	for reader.HasNext() {
-		elem, err := reader.Next()
-		if err != nil {
-			return acum, ierror.Raise(err)
-		}
-		acum, err = f.Call(acum, elem, context)
-		if err != nil {
-			return acum, ierror.Raise(err)
-		}
+	index := make(map[C]int, len(columns))
+	for i, column := range columns {
+		index[column] = i
 	}
 
-	return acum, nil
-}
-
-func localReduceByKey[K comparable, T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
-	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	core.SetPartitions(this.executorData, pairs)
+	if err := keyHashing[K, T](this, numPartitions); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := keyExchanging[K, T](this); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	exchanged, err := core.GetAndDeletePartitions[ipair.IPair[K, T]](this.executorData)
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
-	output := input
-	if output.Cache() {
-		if output, err = core.NewPartitionGroupDef[ipair.IPair[K, T]](this.executorData.GetPartitionTools()); err != nil {
-			return ierror.Raise(err)
-		}
-		for p := 0; p < input.Size(); p++ {
-			part, err := core.NewPartitionWithName[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Get(0).Type())
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			output.Add(part)
-		}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, []V]](this.executorData.GetPartitionTools(), exchanged.Size())
+	if err != nil {
+		return nil, ierror.Raise(err)
 	}
 
-	context := this.executorData.GetContext()
-	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		acum := map[K]T{}
-		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
-			reader, err := input.Get(p).ReadIterator()
+	logger.Info("Reduce: pivoting ", len(columns), " columns")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(exchanged.Size(), func(p int) error {
+			reader, err := exchanged.Get(p).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			rows := map[K][]V{}
+			keys := newINormalKeys[K]()
 			for reader.HasNext() {
 				elem, err := reader.Next()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if val, present := acum[elem.First]; !present {
-					acum[elem.First] = elem.Second
-				} else {
-					acum[elem.First], err = f.Call(val, elem.Second, context)
-					if err != nil {
-						return ierror.Raise(err)
-					}
+				column, err := columnF.Call(elem.Second, context)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				key := keys.key(elem.First)
+				row, present := rows[key]
+				if !present {
+					row = make([]V, len(columns))
+					rows[key] = row
+				}
+				i := index[column]
+				if row[i], err = aggF.Call(row[i], elem.Second, context); err != nil {
+					return ierror.Raise(err)
 				}
-			}
-			if err = output.Get(p).Clear(); err != nil {
-				return ierror.Raise(err)
 			}
 			writer, err := output.Get(p).WriteIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			for key, value := range acum {
-				if err = writer.Write(*ipair.New(key, value)); err != nil {
+			for key, row := range rows {
+				if err = writer.Write(ipair.IPair[K, []V]{keys.original(key), row}); err != nil {
 					return ierror.Raise(err)
 				}
 			}
-			acum = map[K]T{}
-			return nil
+			exchanged.SetBase(p, nil)
+			return output.Get(p).Fit()
 		})
 	}); err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
+	}
+	if err := keyF.After(context); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := columnF.After(context); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := aggF.After(context); err != nil {
+		return nil, ierror.Raise(err)
 	}
-
 	core.SetPartitions(this.executorData, output)
-	return nil
+	return columns, nil
 }
 
-func localAggregateByKey[K comparable, T any, T2 any](this *IReduceImpl, f function.IFunction2[T, T2, T]) error {
-	input, err := core.GetAndDeletePartitions[ipair.IPair[K, T2]](this.executorData)
+func Unpivot[K any, C any, V any](this *IReduceImpl, columns []C) error {
+	input, err := core.GetAndDeletePartitions[ipair.IPair[K, []V]](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[C, V]]](this.executorData.GetPartitionTools(), input.Size())
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	context := this.executorData.GetContext()
-	baseAcum := core.GetVariable[T](this.executorData, "zero")
-	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		acum := map[K]T{}
+
+	logger.Info("Reduce: unpivoting ", len(columns), " columns")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			reader, err := input.Get(p).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
 			for reader.HasNext() {
 				elem, err := reader.Next()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if val, present := acum[elem.First]; !present {
-					acum[elem.First], err = f.Call(baseAcum, elem.Second, context)
-				} else {
-					acum[elem.First], err = f.Call(val, elem.Second, context)
+				if len(elem.Second) != len(columns) {
+					return ierror.RaiseMsg("unpivot row has " + strconv.Itoa(len(elem.Second)) + " values but " +
+						strconv.Itoa(len(columns)) + " columns were given")
 				}
-				if err != nil {
-					return ierror.Raise(err)
+				for i, value := range elem.Second {
+					if err = writer.Write(ipair.IPair[K, ipair.IPair[C, V]]{elem.First, ipair.IPair[C, V]{columns[i], value}}); err != nil {
+						return ierror.Raise(err)
+					}
 				}
 			}
-			acum = map[K]T{}
-			input.SetBase(p, nil)
-			if err = output.Get(p).Fit(); err != nil {
-				return ierror.Raise(err)
-			}
+			input.Set(p, nil)
 			return nil
 		})
 	}); err != nil {
 		return ierror.Raise(err)
 	}
-
 	core.SetPartitions(this.executorData, output)
 	return nil
 }
 
-func keyHashing[K comparable, T any](this *IReduceImpl, numPartitions int64) error {
-	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+func pivotColumns[C comparable](this *IReduceImpl, distinct map[C]bool) ([]C, error) {
+	part, err := core.NewMemoryPartition[C](this.executorData.GetPartitionTools(), int64(len(distinct)))
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
-	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), int(numPartitions))
+	writer, err := part.WriteIterator()
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
-	hasher := utils.GetHasher(utils.TypeObj[K]())
-	logger.Info("Reduce: creating ", numPartitions, " new partitions with key hashing")
-
-	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		threadRanges, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), output.Size())
-		if err != nil {
-			return ierror.Raise(err)
+	for column := range distinct {
+		if err = writer.Write(column); err != nil {
+			return nil, ierror.Raise(err)
 		}
-		writers := make([]iterator.IWriteIterator[ipair.IPair[K, T]], threadRanges.Size())
-		for p := 0; p < threadRanges.Size(); p++ {
-			writers[p], err = threadRanges.Get(p).WriteIterator()
-			if err != nil {
-				return ierror.Raise(err)
+	}
+	if err = core.Gather[C](this.executorData.Mpi(), part, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if this.executorData.Mpi().IsRoot(0) {
+		columns := make([]C, 0)
+		seen := map[C]bool{}
+		for _, column := range part.Inner().(*storage.IListImpl[C]).Array().([]C) {
+			if !seen[column] {
+				seen[column] = true
+				columns = append(columns, column)
 			}
 		}
-		if err = rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+		if less, err := defaultCmp[C](); err == nil {
+			sort.Slice(columns, func(i, j int) bool {
+				return less(columns[i], columns[j])
+			})
+		}
+		part.Clear()
+		if writer, err = part.WriteIterator(); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		for _, column := range columns {
+			if err = writer.Write(column); err != nil {
+				return nil, ierror.Raise(err)
+			}
+		}
+	}
+	if err = core.Bcast[C](this.executorData.Mpi(), part, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return part.Inner().(*storage.IListImpl[C]).Array().([]C), nil
+}
+
+func Scan[T any](this *IReduceImpl, f function.IFunction2[T, T, T]) error {
+	context := this.Context()
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	zero := core.GetVariable[T](this.executorData, "zero")
+	totals := make([]T, input.Size())
+
+	logger.Info("Reduce: computing ", input.Size(), " partition totals")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
 			reader, err := input.Get(p).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			acum := zero
 			for reader.HasNext() {
 				elem, err := reader.Next()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				if err = writers[utils.Hash(elem.First, hasher)%uint64(numPartitions)].Write(elem); err != nil {
-					return ierror.Raise(err)
-				}
-			}
-			input.SetBase(p, nil)
-			return nil
-		}); err != nil {
-			return ierror.Raise(err)
-		}
-		return rctx.Critical(func() error {
-			for p, part := range threadRanges.Iter() {
-				if err := part.MoveTo(output.Get(p)); err != nil {
+				if acum, err = f.Call(acum, elem, context); err != nil {
 					return ierror.Raise(err)
 				}
 			}
+			totals[p] = acum
 			return nil
 		})
 	}); err != nil {
 		return ierror.Raise(err)
 	}
 
-	core.SetPartitions(this.executorData, output)
-	return nil
-}
-
-func keyExchanging[K comparable, T any](this *IReduceImpl) error {
-	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	logger.Info("Reduce: exclusive scan of executor totals")
+	total := zero
+	for _, partial := range totals {
+		if total, err = f.Call(total, partial, context); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	executorTotals, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 1)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	output, err := core.NewPartitionGroupDef[ipair.IPair[K, T]](this.executorData.GetPartitionTools())
+	writer, err := executorTotals.WriteIterator()
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	logger.Info("Reduce: exchanging ", input.Size(), " partitions keys")
-
-	if err = Exchange(this.Base(), input, output); err != nil {
+	if err = writer.Write(total); err != nil {
 		return ierror.Raise(err)
 	}
+	if err = core.Gather[T](this.executorData.Mpi(), executorTotals, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = core.Bcast[T](this.executorData.Mpi(), executorTotals, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	prefix := zero
+	for _, partial := range executorTotals.Inner().(*storage.IListImpl[T]).Array().([]T)[:this.executorData.Mpi().Rank()] {
+		if prefix, err = f.Call(prefix, partial, context); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	prefixes := make([]T, input.Size())
+	for p, partial := range totals {
+		prefixes[p] = prefix
+		if prefix, err = f.Call(prefix, partial, context); err != nil {
+			return ierror.Raise(err)
+		}
+	}
 
-	core.SetPartitions(this.executorData, output)
-	return nil
-}
-
-func distinctFilter[T comparable](this *IReduceImpl, parts *storage.IPartitionGroup[T]) error {
-	return ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
-		distinct := map[T]bool{}
-		return rctx.For().Dynamic().Run(parts.Size(), func(p int) error {
-			newPart, err := core.NewPartitionDef[T](this.executorData.GetPartitionTools())
-			if err != nil {
-				return ierror.Raise(err)
-			}
-			reader, err := parts.Get(p).ReadIterator()
+	logger.Info("Reduce: scanning ", input.Size(), " partitions locally")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
-			writer, err := newPart.WriteIterator()
+			writer, err := output.Get(p).WriteIterator()
 			if err != nil {
 				return ierror.Raise(err)
 			}
+			acum := prefixes[p]
 			for reader.HasNext() {
 				elem, err := reader.Next()
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				distinct[elem] = true
-			}
-			for elem, _ := range distinct {
-				if err = writer.Write(elem); err != nil {
+				if acum, err = f.Call(acum, elem, context); err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writer.Write(acum); err != nil {
 					return ierror.Raise(err)
 				}
 			}
-			parts.Set(p, newPart)
-			distinct = map[T]bool{}
+			input.Set(p, nil)
 			return nil
 		})
-	})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
 }
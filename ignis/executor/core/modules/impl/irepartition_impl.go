@@ -1,16 +1,21 @@
 package impl
 
 import (
+	"fmt"
 	"ignis/executor/api"
 	"ignis/executor/api/function"
+	"ignis/executor/api/ipair"
 	"ignis/executor/api/iterator"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
 	"ignis/executor/core/ithreads"
 	"ignis/executor/core/logger"
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
 	"math/rand"
+	"sort"
+	"strconv"
 )
 
 type IRepartitionImpl struct {
@@ -100,6 +105,89 @@ func local_repartition[T any](this *IRepartitionImpl, numPartitions int64) error
 	return nil
 }
 
+/*
+Without shuffle local partitions are merged in place and data only moves between executors when there are fewer
+partitions than executors. Coalesce never increases the number of partitions unless shuffle is set.
+*/
+func Coalesce[T any](this *IRepartitionImpl, numPartitions int64, shuffle bool) error {
+	if numPartitions < 1 {
+		return ierror.RaiseMsg("coalesce requires at least one partition")
+	}
+	if shuffle {
+		logger.Info("Repartition: coalesce with shuffle to ", numPartitions, " partitions")
+		rank := int64(this.executorData.Mpi().Rank())
+		return PartitionByImpl[T](this, func() Partitioner[T] {
+			return &RandomPartitioner[T]{r: rand.New(rand.NewSource(rank))}
+		}, numPartitions)
+	}
+	group, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	cached := group.Cache()
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	executors := int64(this.executorData.Mpi().Executors())
+	rank := int64(this.executorData.Mpi().Rank())
+	targets := numPartitions / executors
+	if numPartitions%executors > rank {
+		targets++
+	}
+	if targets == 0 {
+		targets = 1
+	}
+	if int64(input.Size()) <= targets && numPartitions >= executors {
+		logger.Info("Repartition: coalesce keeps ", input.Size(), " partitions")
+		core.SetPartitions(this.executorData, input)
+		return nil
+	}
+	logger.Info("Repartition: coalesce from ", input.Size(), " to ", targets, " local partitions")
+	output, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), int(utils.Min(targets,
+		utils.Max(int64(input.Size()), 1))))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for i, part := range input.Iter() {
+		target := output.Get(i * output.Size() / input.Size())
+		if cached {
+			err = part.CopyTo(target)
+		} else {
+			err = part.MoveTo(target)
+		}
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		input.Set(i, nil)
+	}
+	if numPartitions >= executors {
+		for _, part := range output.Iter() {
+			if err = part.Fit(); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		core.SetPartitions(this.executorData, output)
+		return nil
+	}
+
+	logger.Info("Repartition: coalesce below the number of executors, exchanging partitions")
+	global, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	global.Set(int(rank*numPartitions/executors), output.Get(0))
+	result, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = Exchange(this.Base(), global, result); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, result)
+	return nil
+}
+
 func ordered_repartition[T any](this *IRepartitionImpl, numPartitions int64) error {
 	return ierror.RaiseMsg("Not implemented yet") //TODO
 }
@@ -239,3 +327,386 @@ func PartitionByImpl[T any](this *IRepartitionImpl, fparticioner func() Partitio
 	core.SetPartitions(this.executorData, ouput)
 	return nil
 }
+
+/*Assigns keys to partitions, groups partitioned by partitioners with the same Id are co-partitioned*/
+type IPartitioner[K any] interface {
+	Partition(key K) (int64, error)
+	NumPartitions() int64
+	Id() string
+}
+
+type IHashPartitioner[K any] struct {
+	h             utils.Hasher
+	numPartitions int64
+}
+
+func NewIHashPartitioner[K any](numPartitions int64) *IHashPartitioner[K] {
+	return &IHashPartitioner[K]{h: utils.GetHasher(utils.TypeObj[K]()), numPartitions: numPartitions}
+}
+
+func (this *IHashPartitioner[K]) Partition(key K) (int64, error) {
+	return int64(utils.Hash(key, this.h) % uint64(this.numPartitions)), nil
+}
+
+func (this *IHashPartitioner[K]) NumPartitions() int64 {
+	return this.numPartitions
+}
+
+func (this *IHashPartitioner[K]) Id() string {
+	return "hash:" + utils.TypeName[K]() + ":" + strconv.FormatInt(this.numPartitions, 10)
+}
+
+/*Keys up to bounds[i] go to partition i, keys greater than every bound go to the last partition*/
+type IRangePartitioner[K any] struct {
+	bounds []K
+	less   func(K, K) bool
+}
+
+func NewIRangePartitioner[K any](bounds []K, less func(K, K) bool) *IRangePartitioner[K] {
+	return &IRangePartitioner[K]{bounds: bounds, less: less}
+}
+
+func (this *IRangePartitioner[K]) Partition(key K) (int64, error) {
+	return int64(sort.Search(len(this.bounds), func(i int) bool {
+		return !this.less(this.bounds[i], key)
+	})), nil
+}
+
+func (this *IRangePartitioner[K]) NumPartitions() int64 {
+	return int64(len(this.bounds) + 1)
+}
+
+func (this *IRangePartitioner[K]) Id() string {
+	return "range:" + utils.TypeName[K]() + ":" + fmt.Sprint(this.bounds)
+}
+
+type IFunctionPartitioner[K any] struct {
+	f             function.IFunction[K, int64]
+	context       api.IContext
+	name          string
+	numPartitions int64
+}
+
+/*The name identifies the function, partitions are co-partitioned only with groups partitioned by the same name*/
+func NewIFunctionPartitioner[K any](f function.IFunction[K, int64], context api.IContext, name string,
+	numPartitions int64) *IFunctionPartitioner[K] {
+	return &IFunctionPartitioner[K]{f: f, context: context, name: name, numPartitions: numPartitions}
+}
+
+func (this *IFunctionPartitioner[K]) Partition(key K) (int64, error) {
+	p, err := this.f.Call(key, this.context)
+	if p < 0 {
+		p *= -1
+	}
+	return p % this.numPartitions, err
+}
+
+func (this *IFunctionPartitioner[K]) NumPartitions() int64 {
+	return this.numPartitions
+}
+
+func (this *IFunctionPartitioner[K]) Id() string {
+	return "function:" + this.name + ":" + strconv.FormatInt(this.numPartitions, 10)
+}
+
+type iKeyPartitioner[T any, K any] struct {
+	partitioner IPartitioner[K]
+	key         func(T) K
+}
+
+func (this *iKeyPartitioner[T, K]) Call(e T, ctx api.IContext) (int64, error) {
+	return this.partitioner.Partition(this.key(e))
+}
+
+/*Skips the shuffle when the partitions are already distributed by an equivalent partitioner*/
+func PartitionByKey[T any, K any](this *IRepartitionImpl, partitioner IPartitioner[K]) error {
+	id := partitioner.Id()
+	if this.executorData.Partitioner() == id {
+		logger.Info("Repartition: partitions already partitioned by ", id, ", shuffle skipped")
+		return nil
+	}
+	var err error
+	if _, ok := this.executorData.GetPartitionsAny().First().(ipair.IPair[K, T]); ok {
+		err = PartitionByImpl[ipair.IPair[K, T]](this, func() Partitioner[ipair.IPair[K, T]] {
+			return &iKeyPartitioner[ipair.IPair[K, T], K]{partitioner, func(e ipair.IPair[K, T]) K { return e.First }}
+		}, partitioner.NumPartitions())
+	} else {
+		err = PartitionByImpl[ipair.IPair[any, any]](this, func() Partitioner[ipair.IPair[any, any]] {
+			return &iKeyPartitioner[ipair.IPair[any, any], K]{partitioner, func(e ipair.IPair[any, any]) K { return e.First.(K) }}
+		}, partitioner.NumPartitions())
+	}
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.executorData.SetPartitioner(id)
+	return nil
+}
+
+func PartitionByKeyHash[T any, K any](this *IRepartitionImpl, numPartitions int64) error {
+	return PartitionByKey[T, K](this, NewIHashPartitioner[K](numPartitions))
+}
+
+/*Bounds are taken from a sample of the keys, so partitions hold contiguous and balanced key ranges*/
+func PartitionByKeyRange[T any, K any](this *IRepartitionImpl, numPartitions int64) error {
+	less, err := defaultCmp[K]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	var keys []K
+	if _, ok := this.executorData.GetPartitionsAny().First().(ipair.IPair[K, T]); ok {
+		keys, err = sampleKeys[ipair.IPair[K, T], K](this, func(e ipair.IPair[K, T]) K { return e.First })
+	} else {
+		keys, err = sampleKeys[ipair.IPair[any, any], K](this, func(e ipair.IPair[any, any]) K { return e.First.(K) })
+	}
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	sort.SliceStable(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+	var bounds []K
+	for i := int64(1); i < numPartitions && len(keys) > 0; i++ {
+		bound := keys[utils.Min(int(int64(len(keys))*i/numPartitions), len(keys)-1)]
+		if len(bounds) == 0 || less(bounds[len(bounds)-1], bound) {
+			bounds = append(bounds, bound)
+		}
+	}
+	return PartitionByKey[T, K](this, NewIRangePartitioner(bounds, less))
+}
+
+func PartitionByKeyBy[T any, K any](this *IRepartitionImpl, f function.IFunction[K, int64], name string, numPartitions int64) error {
+	context := this.executorData.GetContext()
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := PartitionByKey[T, K](this, NewIFunctionPartitioner(f, context, name, numPartitions)); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+/*Every executor receives the same sample of keys, at most SortRangeMax in total*/
+func sampleKeys[T any, K any](this *IRepartitionImpl, key func(T) K) ([]K, error) {
+	input, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	maxSamples, err := this.executorData.GetProperties().SortRangeMax()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	mpi := this.executorData.Mpi()
+	elems := int64(0)
+	for _, part := range input.Iter() {
+		elems += part.Size()
+	}
+	step := utils.Max(elems/utils.Max(maxSamples/int64(mpi.Executors()), 1), 1)
+	sample, err := core.NewMemoryPartition[K](this.executorData.GetPartitionTools(), elems/step+1)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	writer, err := sample.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	i := int64(0)
+	for _, part := range input.Iter() {
+		reader, err := part.ReadIterator()
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		for reader.HasNext() {
+			elem, err := reader.Next()
+			if err != nil {
+				return nil, ierror.Raise(err)
+			}
+			if i%step == 0 {
+				if err = writer.Write(key(elem)); err != nil {
+					return nil, ierror.Raise(err)
+				}
+			}
+			i++
+		}
+	}
+	if err = core.Gather[K](mpi, sample, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err = core.Bcast[K](mpi, sample, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return sample.Inner().(*storage.IListImpl[K]).Array().([]K), nil
+}
+
+func ReorderPartitions[T any](this *IRepartitionImpl, order []int64) error {
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if len(order) != input.Size() {
+		return ierror.RaiseMsg("partition order must contain " + strconv.Itoa(input.Size()) + " indices")
+	}
+	logger.Info("Repartition: reordering ", input.Size(), " partitions by index")
+	output := storage.NewIPartitionGroup[T]()
+	used := make([]bool, input.Size())
+	for _, i := range order {
+		if i < 0 || int(i) >= input.Size() || used[i] {
+			return ierror.RaiseMsg("partition order is not a permutation")
+		}
+		used[i] = true
+		output.Add(input.Get(int(i)))
+	}
+	output.SetCache(input.Cache())
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+/*
+Partitions are numbered in the global order of their smallest element, empty partitions go last. Every executor
+keeps a block of consecutive positions of the new numbering.
+*/
+func ReorderPartitionsBy[T any](this *IRepartitionImpl, less func(T, T) bool) error {
+	group, err := core.GetPartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	cached := group.Cache()
+	offset, err := this.PartitionOffset()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	logger.Info("Repartition: reordering ", input.Size(), " partitions by key range")
+	mins := make([]T, input.Size())
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			first := true
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if first || less(elem, mins[p]) {
+					mins[p] = elem
+					first = false
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	mpi := this.executorData.Mpi()
+	total := impi.C_int64(input.Size())
+	if err = impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&total), 1, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		mpi.Native()); err != nil {
+		return ierror.Raise(err)
+	}
+	bounds, err := core.NewMemoryPartition[ipair.IPair[int64, T]](this.executorData.GetPartitionTools(), int64(input.Size()))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	writer, err := bounds.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for p, part := range input.Iter() {
+		if !part.Empty() {
+			if err = writer.Write(*ipair.New(offset+int64(p), mins[p])); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+	}
+	if err = core.Gather[ipair.IPair[int64, T]](mpi, bounds, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = core.Bcast[ipair.IPair[int64, T]](mpi, bounds, 0); err != nil {
+		return ierror.Raise(err)
+	}
+
+	order := bounds.Inner().(*storage.IListImpl[ipair.IPair[int64, T]]).Array().([]ipair.IPair[int64, T])
+	sort.SliceStable(order, func(i, j int) bool {
+		return less(order[i].Second, order[j].Second)
+	})
+	position := make([]int, total)
+	filled := make([]bool, total)
+	for i, bound := range order {
+		position[bound.First] = i
+		filled[bound.First] = true
+	}
+	next := len(order)
+	for i := range position {
+		if !filled[i] {
+			position[i] = next
+			next++
+		}
+	}
+
+	global, err := core.NewPartitionGroupWithSize[T](this.executorData.GetPartitionTools(), int(total))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for p, part := range input.Iter() {
+		target := global.Get(position[offset+int64(p)])
+		if cached {
+			err = part.CopyTo(target)
+		} else {
+			err = part.MoveTo(target)
+		}
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		input.Set(p, nil)
+	}
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = Exchange(this.Base(), global, output); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func ReorderPartitionsByValue[T any](this *IRepartitionImpl) error {
+	less, err := defaultCmp[T]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return ReorderPartitionsBy[T](this, less)
+}
+
+func ReorderPartitionsByKey[T any, K any](this *IRepartitionImpl) error {
+	less, err := defaultCmp[K]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return ReorderPartitionsBy[ipair.IPair[K, T]](this, func(a, b ipair.IPair[K, T]) bool {
+		return less(a.First, b.First)
+	})
+}
+
+func (this *IRepartitionImpl) PartitionOffset() (int64, error) {
+	parts := impi.C_int64(0)
+	if this.executorData.HasPartitions() {
+		parts = impi.C_int64(this.executorData.GetPartitionsAny().Size())
+	}
+	offset := impi.C_int64(0)
+	if err := impi.MPI_Exscan(impi.P(&parts), impi.P(&offset), 1, impi.MPI_LONG_LONG_INT, impi.MPI_SUM,
+		this.executorData.Mpi().Native()); err != nil {
+		return 0, ierror.Raise(err)
+	}
+	if this.executorData.Mpi().Rank() == 0 {
+		offset = 0
+	}
+	return int64(offset), nil
+}
@@ -0,0 +1,233 @@
+package impl
+
+import (
+	"bufio"
+	"debug/buildinfo"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/logger"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const selfTestLatencyIterations = 100
+const selfTestBandwidthIterations = 10
+const selfTestBandwidthBytes = 4 * 1024 * 1024
+const selfTestDiskBytes = 64 * 1024 * 1024
+
+type ISelfTestImpl struct {
+	IBaseImpl
+}
+
+func NewISelfTestImpl(executorData *core.IExecutorData) *ISelfTestImpl {
+	return &ISelfTestImpl{
+		IBaseImpl{executorData},
+	}
+}
+
+type IMpiPeerReport struct {
+	Rank      int           `json:"rank"`
+	Latency   time.Duration `json:"latency"`
+	Bandwidth float64       `json:"bandwidth"`
+}
+
+type ILibraryReport struct {
+	Path       string `json:"path"`
+	GoVersion  string `json:"goVersion"`
+	Compatible bool   `json:"compatible"`
+	Error      string `json:"error,omitempty"`
+}
+
+type ISelfTestReport struct {
+	Rank            int              `json:"rank"`
+	Executors       int              `json:"executors"`
+	Cores           int              `json:"cores"`
+	GoVersion       string           `json:"goVersion"`
+	Peers           []IMpiPeerReport `json:"peers"`
+	DiskPath        string           `json:"diskPath"`
+	DiskWrite       float64          `json:"diskWrite"`
+	DiskRead        float64          `json:"diskRead"`
+	DiskReadCached  bool             `json:"diskReadCached"`
+	MemoryTotal     int64            `json:"memoryTotal"`
+	MemoryAvailable int64            `json:"memoryAvailable"`
+	HeapInUse       int64            `json:"heapInUse"`
+	Libraries       []ILibraryReport `json:"libraries"`
+	Errors          []string         `json:"errors,omitempty"`
+}
+
+func (this *ISelfTestImpl) SelfTest() (*ISelfTestReport, error) {
+	logger.Info("SelfTest: running executor self tests")
+	report := &ISelfTestReport{
+		Rank:      this.executorData.Mpi().Rank(),
+		Executors: this.executorData.Mpi().Executors(),
+		Cores:     this.executorData.GetCores(),
+		GoVersion: runtime.Version(),
+	}
+
+	if err := this.mpiTest(report); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if err := this.diskTest(report); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+	if err := this.memoryTest(report); err != nil {
+		report.Errors = append(report.Errors, err.Error())
+	}
+	this.libraryTest(report)
+	return report, nil
+}
+
+func (this *ISelfTestImpl) mpiTest(report *ISelfTestReport) error {
+	logger.Info("SelfTest: mpi ping-pong")
+	comm := this.executorData.Mpi().Native()
+	buffer := make([]byte, selfTestBandwidthBytes)
+	pingPong := func(other int, bytes int, iterations int, first bool) error {
+		for i := 0; i < iterations; i++ {
+			if first {
+				if err := impi.MPI_Send(impi.P(&buffer[0]), impi.C_int(bytes), impi.MPI_BYTE, impi.C_int(other), 0, comm); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			if err := impi.MPI_Recv(impi.P(&buffer[0]), impi.C_int(bytes), impi.MPI_BYTE, impi.C_int(other), 0, comm,
+				impi.MPI_STATUS_IGNORE); err != nil {
+				return ierror.Raise(err)
+			}
+			if !first {
+				if err := impi.MPI_Send(impi.P(&buffer[0]), impi.C_int(bytes), impi.MPI_BYTE, impi.C_int(other), 0, comm); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+		}
+		return nil
+	}
+
+	for other := 1; other < report.Executors; other++ {
+		if report.Rank == 0 {
+			start := time.Now()
+			if err := pingPong(other, 1, selfTestLatencyIterations, true); err != nil {
+				return ierror.Raise(err)
+			}
+			latency := time.Since(start) / (2 * selfTestLatencyIterations)
+			start = time.Now()
+			if err := pingPong(other, selfTestBandwidthBytes, selfTestBandwidthIterations, true); err != nil {
+				return ierror.Raise(err)
+			}
+			elapsed := time.Since(start).Seconds()
+			report.Peers = append(report.Peers, IMpiPeerReport{
+				Rank:      other,
+				Latency:   latency,
+				Bandwidth: float64(2*selfTestBandwidthIterations*selfTestBandwidthBytes) / elapsed,
+			})
+		} else if report.Rank == other {
+			if err := pingPong(0, 1, selfTestLatencyIterations, false); err != nil {
+				return ierror.Raise(err)
+			}
+			if err := pingPong(0, selfTestBandwidthBytes, selfTestBandwidthIterations, false); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+	}
+	return ierror.Raise(this.executorData.Mpi().Barrier())
+}
+
+func (this *ISelfTestImpl) diskTest(report *ISelfTestReport) error {
+	logger.Info("SelfTest: disk throughput")
+	path, err := this.executorData.GetPartitionTools().Diskpath("selftest" + strconv.Itoa(report.Rank))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	report.DiskPath = path
+	defer os.Remove(path)
+
+	block := make([]byte, 1024*1024)
+	file, err := os.Create(path)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	start := time.Now()
+	for written := 0; written < selfTestDiskBytes; written += len(block) {
+		if _, err = file.Write(block); err != nil {
+			file.Close()
+			return ierror.Raise(err)
+		}
+	}
+	if err = file.Sync(); err != nil {
+		file.Close()
+		return ierror.Raise(err)
+	}
+	report.DiskWrite = float64(selfTestDiskBytes) / time.Since(start).Seconds()
+	/*The file was just written, without dropping its pages the read only measures the page cache*/
+	if err = dropFileCache(file); err != nil {
+		logger.Warn("SelfTest: disk read uses the page cache, ", err)
+		report.DiskReadCached = true
+	}
+	if err = file.Close(); err != nil {
+		return ierror.Raise(err)
+	}
+
+	file, err = os.Open(path)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	defer file.Close()
+	start = time.Now()
+	read := 0
+	for {
+		n, err := file.Read(block)
+		read += n
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	report.DiskRead = float64(read) / time.Since(start).Seconds()
+	return nil
+}
+
+func (this *ISelfTestImpl) memoryTest(report *ISelfTestReport) error {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	report.HeapInUse = int64(stats.HeapInuse)
+
+	file, err := os.Open("/proc/meminfo")
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		value, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		if fields[0] == "MemTotal:" {
+			report.MemoryTotal = value * 1024
+		} else if fields[0] == "MemAvailable:" {
+			report.MemoryAvailable = value * 1024
+		}
+	}
+	return ierror.Raise(scanner.Err())
+}
+
+func (this *ISelfTestImpl) libraryTest(report *ISelfTestReport) {
+	for _, path := range this.executorData.LoadedLibraries() {
+		library := ILibraryReport{Path: path}
+		if info, err := buildinfo.ReadFile(path); err != nil {
+			library.Error = err.Error()
+		} else {
+			library.GoVersion = info.GoVersion
+			library.Compatible = info.GoVersion == runtime.Version()
+		}
+		report.Libraries = append(report.Libraries, library)
+	}
+}
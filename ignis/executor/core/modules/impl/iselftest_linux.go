@@ -0,0 +1,18 @@
+package impl
+
+import (
+	"ignis/executor/core/ierror"
+	"os"
+	"syscall"
+)
+
+const posixFadvDontneed = 4
+
+/*Removes the clean pages of the file from the page cache, the file must be synced before*/
+func dropFileCache(file *os.File) error {
+	_, _, errno := syscall.Syscall6(syscall.SYS_FADVISE64, file.Fd(), 0, 0, posixFadvDontneed, 0, 0)
+	if errno != 0 {
+		return ierror.Raise(errno)
+	}
+	return nil
+}
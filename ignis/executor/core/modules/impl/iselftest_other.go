@@ -0,0 +1,12 @@
+//go:build !linux
+
+package impl
+
+import (
+	"ignis/executor/core/ierror"
+	"os"
+)
+
+func dropFileCache(file *os.File) error {
+	return ierror.RaiseMsg("page cache can only be dropped on linux")
+}
@@ -0,0 +1,231 @@
+package impl
+
+import (
+	"ignis/executor/api"
+	"ignis/executor/api/ipair"
+	"ignis/executor/api/iterator"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/ithreads"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/utils"
+)
+
+const (
+	countSketchDepth = 4
+	countSketchWidth = 1 << 12
+)
+
+/*Count-min sketch of key hashes, it never underestimates so every hot key is found*/
+type iCountSketch struct {
+	counters []int64
+}
+
+func newICountSketch() *iCountSketch {
+	return &iCountSketch{make([]int64, countSketchDepth*countSketchWidth)}
+}
+
+func (this *iCountSketch) cell(row int, hash uint64) int {
+	hash = (hash ^ uint64(row)*0x9e3779b97f4a7c15) * 0xbf58476d1ce4e5b9
+	return row*countSketchWidth + int((hash>>32)%countSketchWidth)
+}
+
+func (this *iCountSketch) Add(hash uint64) {
+	for row := 0; row < countSketchDepth; row++ {
+		this.counters[this.cell(row, hash)]++
+	}
+}
+
+func (this *iCountSketch) Merge(other *iCountSketch) {
+	for i, c := range other.counters {
+		this.counters[i] += c
+	}
+}
+
+func (this *iCountSketch) Estimate(hash uint64) int64 {
+	estimate := this.counters[this.cell(0, hash)]
+	for row := 1; row < countSketchDepth; row++ {
+		estimate = utils.Min(estimate, this.counters[this.cell(row, hash)])
+	}
+	return estimate
+}
+
+/*
+Hot keys are spread over salt consecutive partitions before the exchange, so they are reduced by several executors.
+Every executor builds the same global sketch, so all of them agree on which keys are hot.
+*/
+type iSkew struct {
+	sketch    *iCountSketch
+	threshold int64
+	salt      int64
+}
+
+func (this *iSkew) hot(hash uint64) bool {
+	return this.sketch.Estimate(hash) > this.threshold
+}
+
+/*Returns nil if salting is disabled*/
+func detectSkew[K comparable, T any](this *IReduceImpl) (*iSkew, error) {
+	salt, err := this.executorData.GetProperties().ReduceSkewSalt()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	fraction, err := this.executorData.GetProperties().ReduceSkewFraction()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if salt < 2 {
+		return nil, nil
+	}
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	hasher := utils.GetHasher(utils.TypeObj[K]())
+	sketches := make([]*iCountSketch, ithreads.DefaultCores())
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		sketch := newICountSketch()
+		sketches[rctx.ThreadId()] = sketch
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				sketch.Add(utils.Hash(elem.First, hasher))
+			}
+			return nil
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	skew := &iSkew{sketch: newICountSketch(), salt: salt}
+	for _, sketch := range sketches {
+		if sketch != nil {
+			skew.sketch.Merge(sketch)
+		}
+	}
+	if err = impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&skew.sketch.counters[0]), impi.C_int(len(skew.sketch.counters)),
+		impi.MPI_LONG_LONG_INT, impi.MPI_SUM, this.executorData.Mpi().Native()); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	total := int64(0)
+	for _, c := range skew.sketch.counters[:countSketchWidth] {
+		total += c
+	}
+	skew.threshold = utils.Max(int64(fraction*float64(total)), 1)
+	logger.Info("Reduce: keys with more than ", skew.threshold, " elements are salted into ", salt, " partitions")
+	return skew, nil
+}
+
+/*
+Sends the partial results of hot keys back to the partition of the unsalted key and appends them to it, so they can
+be combined again.
+*/
+func unsaltKeys[K comparable, T any](this *IReduceImpl, numPartitions int64, skew *iSkew) error {
+	input, err := core.GetPartitions[ipair.IPair[K, T]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	kept, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	moved, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), int(numPartitions))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	mpi := this.executorData.Mpi()
+	first := exchangeRanges(mpi.Executors(), int(numPartitions))[mpi.Rank()].First
+	hasher := utils.GetHasher(utils.TypeObj[K]())
+	logger.Info("Reduce: unsalting hot keys")
+
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		threadRanges, err := core.NewPartitionGroupWithSize[ipair.IPair[K, T]](this.executorData.GetPartitionTools(), moved.Size())
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		writers := make([]iterator.IWriteIterator[ipair.IPair[K, T]], threadRanges.Size())
+		for p := 0; p < threadRanges.Size(); p++ {
+			writers[p], err = threadRanges.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		if err = rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := kept.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				hash := utils.Hash(elem.First, hasher)
+				if home := int64(hash % uint64(numPartitions)); skew.hot(hash) && home != first+int64(p) {
+					err = writers[home].Write(elem)
+				} else {
+					err = writer.Write(elem)
+				}
+				if err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.SetBase(p, nil)
+			return nil
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		return rctx.Critical(func() error {
+			for p, part := range threadRanges.Iter() {
+				if err := part.MoveTo(moved.Get(p)); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	received, err := core.NewPartitionGroupDef[ipair.IPair[K, T]](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = Exchange(this.Base(), moved, received); err != nil {
+		return ierror.Raise(err)
+	}
+	for p := 0; p < received.Size() && p < kept.Size(); p++ {
+		if err = received.Get(p).MoveTo(kept.Get(p)); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	core.SetPartitions(this.executorData, kept)
+	return nil
+}
+
+/*Joins the partial groups of a salted key*/
+type iConcatFunction[T any] struct {
+}
+
+func (this *iConcatFunction[T]) Before(context api.IContext) error {
+	return nil
+}
+
+func (this *iConcatFunction[T]) Call(v1 []T, v2 []T, context api.IContext) ([]T, error) {
+	return append(v1, v2...), nil
+}
+
+func (this *iConcatFunction[T]) After(context api.IContext) error {
+	return nil
+}
@@ -1,10 +1,13 @@
 package impl
 
 import (
+	"container/heap"
+	"encoding/binary"
 	"ignis/executor/api"
 	"ignis/executor/api/function"
 	"ignis/executor/api/ipair"
 	"ignis/executor/api/iterator"
+	"ignis/executor/api/iuuid"
 	"ignis/executor/core"
 	"ignis/executor/core/ierror"
 	"ignis/executor/core/impi"
@@ -13,7 +16,10 @@ import (
 	"ignis/executor/core/storage"
 	"ignis/executor/core/utils"
 	"math"
+	"math/big"
+	"math/rand"
 	"sort"
+	"time"
 )
 
 type ISortImpl struct {
@@ -187,13 +193,18 @@ func SortByKeyWithPartitions[T any, K any](this *ISortImpl, ascending bool, part
 		pf := func(a, b ipair.IPair[K, T]) bool {
 			return f(a.First, b.First)
 		}
-		return sortImpl[ipair.IPair[K, T]](this, pf, ascending, partitions, true)
+		err = sortImpl[ipair.IPair[K, T]](this, pf, ascending, partitions, true)
 	} else {
 		pf := func(a, b ipair.IPair[any, any]) bool {
 			return f(a.GetFirst().(K), b.GetFirst().(K))
 		}
-		return sortImpl[ipair.IPair[any, any]](this, pf, ascending, partitions, true)
+		err = sortImpl[ipair.IPair[any, any]](this, pf, ascending, partitions, true)
 	}
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.executorData.SetKeyOrder(utils.Ternary(ascending, 1, -1))
+	return nil
 }
 
 func SortByKeyBy[T any, K any](this *ISortImpl, f function.IFunction2[K, K, bool], ascending bool) error {
@@ -234,12 +245,123 @@ func SortByKeyByWithPartitions[T any, K any](this *ISortImpl, f function.IFuncti
 	return nil
 }
 
+/*Partitions the pairs by key hash and sorts each partition by key, so equal keys are contiguous*/
+func RepartitionAndSortWithinPartitions[T any, K any](this *ISortImpl, numPartitions int64, ascending bool) error {
+	f, err := defaultCmp[K]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return sortWithinPartitions[T, K](this, numPartitions, f, nil, ascending)
+}
+
+/*
+Each key's values arrive contiguous and sorted, the partitions are sorted as a whole, using external runs if they
+are not in memory, so groups are never materialized.
+*/
+func GroupByKeyAndSortValues[T any, K any](this *ISortImpl, numPartitions int64, ascending bool) error {
+	keyLess, err := defaultCmp[K]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	valueLess, err := defaultCmp[T]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return sortWithinPartitions[T, K](this, numPartitions, keyLess, valueLess, ascending)
+}
+
+func GroupByKeyAndSortValuesBy[T any, K any](this *ISortImpl, f function.IFunction2[T, T, bool], numPartitions int64, ascending bool) error {
+	keyLess, err := defaultCmp[K]()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	context := this.Context()
+	if err := f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	valueLess := func(a, b T) bool {
+		less, err := f.Call(a, b, context)
+		if err != nil {
+			panic(err)
+		}
+		return less
+	}
+	if err := sortWithinPartitions[T, K](this, numPartitions, keyLess, valueLess, ascending); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
+
+/*Keys are always in ascending order, ascending only applies to the values when they are compared*/
+func sortWithinPartitions[T any, K any](this *ISortImpl, numPartitions int64, keyLess func(K, K) bool,
+	valueLess func(T, T) bool, ascending bool) error {
+	if numPartitions < 1 {
+		localPartitions := impi.C_int64(this.executorData.GetPartitionsAny().Size())
+		if err := impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&localPartitions), 1, impi.MPI_LONG_LONG_INT,
+			impi.MPI_SUM, this.executorData.Mpi().Native()); err != nil {
+			return ierror.Raise(err)
+		}
+		numPartitions = utils.Max(int64(localPartitions), 1)
+	}
+	partitioner := NewIHashPartitioner[K](numPartitions)
+	if err := PartitionByKey[T, K](NewIRepartitionImpl(this.executorData), partitioner); err != nil {
+		return ierror.Raise(err)
+	}
+	less := func(k1, k2 K, v1, v2 T) bool {
+		if keyLess(k1, k2) {
+			return true
+		} else if keyLess(k2, k1) || valueLess == nil {
+			return false
+		} else if ascending {
+			return valueLess(v1, v2)
+		}
+		return valueLess(v2, v1)
+	}
+	var err error
+	if _, ok := this.executorData.GetPartitionsAny().First().(ipair.IPair[K, T]); ok {
+		err = sortPartitions[ipair.IPair[K, T]](this, func(a, b ipair.IPair[K, T]) bool {
+			return less(a.First, b.First, a.Second, b.Second)
+		})
+	} else {
+		err = sortPartitions[ipair.IPair[any, any]](this, func(a, b ipair.IPair[any, any]) bool {
+			return less(a.First.(K), b.First.(K), a.Second.(T), b.Second.(T))
+		})
+	}
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.executorData.SetPartitioner(partitioner.Id())
+	this.executorData.SetKeyOrder(1)
+	return nil
+}
+
+/*Sorts every partition locally without exchanging elements*/
+func sortPartitions[T any](this *ISortImpl, f func(T, T) bool) error {
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if input.Cache() && this.executorData.GetPartitionTools().IsMemoryGroup(input) {
+		if input, err = input.Clone(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	logger.Info("Sort: sorting ", input.Size(), " partitions within")
+	if err := parallelLocalSort[T](this, f, input, true); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, input)
+	return nil
+}
+
 func sortImpl[T any](this *ISortImpl, f func(T, T) bool, ascending bool, partitions int64, localSort bool) error {
 	input, err := core.GetAndDeletePartitions[T](this.executorData)
 	if err != nil {
 		return ierror.Raise(err)
 	}
-	executors := this.executorData.Mpi().Executors()
 	/*Copy the data if they are reused*/
 	if input.Cache() {
 		/*Work directly on the array to improve performance*/
@@ -275,10 +397,67 @@ func sortImpl[T any](this *ISortImpl, f func(T, T) bool, ascending bool, partiti
 		partitions = int64(totalPartitions)
 	}
 
+	rangePartitioner, err := this.executorData.GetProperties().SortRange()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	var pivots *storage.IMemoryPartition[T]
+	if rangePartitioner && localSort {
+		logger.Info("Sort: sampling range bounds")
+		if pivots, err = rangePivots[T](this, f, input, ascending, partitions); err != nil {
+			return ierror.Raise(err)
+		}
+	} else if pivots, err = splitPivots[T](this, f, input, ascending, partitions, localSort); err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Sort: broadcasting pivots ranges")
+	if err = core.Bcast[T](this.executorData.Mpi(), pivots, 0); err != nil {
+		return ierror.Raise(err)
+	}
+
+	ranges, err := generateRanges[T](this, f, input, ascending, pivots)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	less := f
+	if !ascending {
+		less = func(a, b T) bool { return f(b, a) }
+	}
+	ranges.SetSorted(less)
+	if err = pivots.Clear(); err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Sort: exchanging ranges")
+	if err = Exchange(this.Base(), ranges, output); err != nil {
+		return ierror.Raise(err)
+	}
+
+	/*Sort final partitions, unless the exchange has already merged them*/
+	if output.Sorted() {
+		logger.Info("Sort: ", output.Size(), " partitions merged in the exchange")
+	} else {
+		logger.Info("Sort: sorting again ", output.Size(), " partitions locally")
+		if err := parallelLocalSort[T](this, f, output, ascending); err != nil {
+			return ierror.Raise(err)
+		}
+		output.SetSorted(less)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func splitPivots[T any](this *ISortImpl, f func(T, T) bool, input *storage.IPartitionGroup[T], ascending bool, partitions int64, localSort bool) (*storage.IMemoryPartition[T], error) {
+	executors := this.executorData.Mpi().Executors()
 	/*Generates pivots to separate the elements in order*/
 	sr, err := this.executorData.GetProperties().SortSamples()
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
 	samples := int64(0)
 	if sr > 1 || sr == 0 {
@@ -292,7 +471,7 @@ func sortImpl[T any](this *ISortImpl, f func(T, T) bool, ascending bool, partiti
 		}
 		if err := impi.MPI_Allreduce(impi.P(&send[0]), impi.P(&rcv[0]), 2, impi.MPI_LONG_LONG_INT,
 			impi.MPI_SUM, this.executorData.Mpi().Native()); err != nil {
-			return ierror.Raise(err)
+			return nil, ierror.Raise(err)
 		}
 		samples = int64(math.Ceil(float64(rcv[1]) / float64(rcv[0]) * sr))
 	}
@@ -301,96 +480,207 @@ func sortImpl[T any](this *ISortImpl, f func(T, T) bool, ascending bool, partiti
 	logger.Info("Sort: selecting ", samples, " pivots")
 	pivots, err := selectPivots[T](this, f, input, ascending, samples)
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
 
 	resampling, err := this.executorData.GetProperties().SortResampling()
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
 	if sr < 1 && resampling && executors > 1 && localSort {
 		logger.Info("Sort: -- resampling pivots begin --")
 		tmp, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
 		if err != nil {
-			return ierror.Raise(err)
+			return nil, ierror.Raise(err)
 		}
 		tmp.Add(pivots)
 		core.SetPartitions(this.executorData, tmp)
 		if err = sortImpl(this, f, ascending, int64(executors*this.executorData.GetCores()), false); err != nil {
-			return ierror.Raise(err)
+			return nil, ierror.Raise(err)
 		}
 		logger.Info("Sort: -- resampling pivots end --")
 		samples = partitions - 1
 		logger.Info("Sort: selecting ", samples, " partition pivots")
 		if pivots, err = parallelSelectPivots[T](this, samples); err != nil {
-			return ierror.Raise(err)
+			return nil, ierror.Raise(err)
 		}
 		logger.Info("Sort: collecting pivots")
 		if err = core.Gather[T](this.executorData.Mpi(), pivots, 0); err != nil {
-			return ierror.Raise(err)
+			return nil, ierror.Raise(err)
 		}
 	} else {
 		logger.Info("Sort: collecting pivots")
 		if err = core.Gather[T](this.executorData.Mpi(), pivots, 0); err != nil {
-			return ierror.Raise(err)
+			return nil, ierror.Raise(err)
 		}
 
 		if this.executorData.Mpi().IsRoot(0) {
 			group, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
 			if err != nil {
-				return ierror.Raise(err)
+				return nil, ierror.Raise(err)
 			}
 			group.Add(pivots)
 			if err := parallelLocalSort[T](this, f, group, ascending); err != nil {
-				return ierror.Raise(err)
+				return nil, ierror.Raise(err)
 			}
 			samples = partitions - 1
 
 			logger.Info("Sort: selecting ", samples, " partition pivots")
 			if pivots, err = selectPivots[T](this, f, group, ascending, samples); err != nil {
-				return ierror.Raise(err)
+				return nil, ierror.Raise(err)
 			}
 		}
 	}
+	return pivots, nil
+}
 
-	logger.Info("Sort: broadcasting pivots ranges")
-	if err = core.Bcast[T](this.executorData.Mpi(), pivots, 0); err != nil {
-		return ierror.Raise(err)
+/*
+Reservoir samples every partition and chooses bounds that split the total weight evenly, so skewed keys
+still produce partitions of similar size
+*/
+func rangePivots[T any](this *ISortImpl, f func(T, T) bool, input *storage.IPartitionGroup[T], ascending bool, partitions int64) (*storage.IMemoryPartition[T], error) {
+	fraction, err := this.executorData.GetProperties().SortRangeFraction()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	maxSamples, err := this.executorData.GetProperties().SortRangeMax()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	rank := this.executorData.Mpi().Rank()
+	elems := int64(0)
+	for _, part := range input.Iter() {
+		elems += part.Size()
 	}
+	target := utils.Max(int64(math.Ceil(float64(elems)*fraction)), partitions)
+	target = utils.Min(target, utils.Max(maxSamples/int64(this.executorData.Mpi().Executors()), 1))
 
-	ranges, err := generateRanges[T](this, f, input, ascending, pivots)
+	reservoirs := make([][]T, input.Size())
+	weights := make([]float64, input.Size())
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			part := input.Get(p)
+			if part.Size() == 0 {
+				return nil
+			}
+			k := utils.Min(int64(math.Ceil(float64(part.Size())*float64(target)/float64(elems))), part.Size())
+			rng := rand.New(rand.NewSource(int64(rank)*int64(input.Size()) + int64(p)))
+			reservoir := make([]T, 0, k)
+			reader, err := part.ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for i := int64(0); reader.HasNext(); i++ {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if i < k {
+					reservoir = append(reservoir, elem)
+				} else if j := rng.Int63n(i + 1); j < k {
+					reservoir[j] = elem
+				}
+			}
+			reservoirs[p] = reservoir
+			weights[p] = float64(part.Size()) / float64(k)
+			return nil
+		})
+	}); err != nil {
+		return nil, ierror.Raise(err)
+	}
+
+	samples, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), target)
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
 	}
-	if err = pivots.Clear(); err != nil {
-		return ierror.Raise(err)
+	writer, err := samples.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
 	}
-	output, err := core.NewPartitionGroupDef[T](this.executorData.GetPartitionTools())
+	sampleWeights := make([]byte, 0, 8*target)
+	for p, reservoir := range reservoirs {
+		for _, elem := range reservoir {
+			if err = writer.Write(elem); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			sampleWeights = binary.LittleEndian.AppendUint64(sampleWeights, math.Float64bits(weights[p]))
+		}
+	}
+
+	logger.Info("Sort: collecting ", samples.Size(), " samples")
+	if err = core.Gather[T](this.executorData.Mpi(), samples, 0); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	allWeights, err := this.executorData.Mpi().GatherBytes(sampleWeights, 0)
 	if err != nil {
-		return ierror.Raise(err)
+		return nil, ierror.Raise(err)
+	}
+	pivots, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), partitions)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if !this.executorData.Mpi().IsRoot(0) {
+		return pivots, nil
 	}
 
-	logger.Info("Sort: exchanging ranges")
-	if err = Exchange(this.Base(), ranges, output); err != nil {
-		return ierror.Raise(err)
+	list := samples.Inner().(storage.IList)
+	order := make([]int, 0, list.Size())
+	weight := make([]float64, 0, list.Size())
+	total := 0.0
+	for _, data := range allWeights {
+		for i := 0; i+8 <= len(data); i += 8 {
+			w := math.Float64frombits(binary.LittleEndian.Uint64(data[i:]))
+			order = append(order, len(order))
+			weight = append(weight, w)
+			total += w
+		}
 	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return f(list.GetAny(order[i]).(T), list.GetAny(order[j]).(T)) == ascending
+	})
 
-	/*Sort final partitions*/
-	logger.Info("Sort: sorting again ", output.Size(), " partitions locally")
-	if err := parallelLocalSort[T](this, f, output, ascending); err != nil {
-		return ierror.Raise(err)
+	step := total / float64(partitions)
+	writer, err = pivots.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
 	}
-	core.SetPartitions(this.executorData, output)
-	return nil
+	cumulative := 0.0
+	bound := step
+	var last T
+	for i := 0; i < len(order) && pivots.Size() < partitions-1; i++ {
+		elem := list.GetAny(order[i]).(T)
+		cumulative += weight[order[i]]
+		if cumulative < bound {
+			continue
+		}
+		/*Equal keys must never be split in two partitions*/
+		if pivots.Size() == 0 || f(last, elem) == ascending {
+			if err = writer.Write(elem); err != nil {
+				return nil, ierror.Raise(err)
+			}
+			last = elem
+			bound += step
+		}
+	}
+	logger.Info("Sort: ", pivots.Size(), " range bounds selected")
+	return pivots, nil
 }
 
 func parallelLocalSort[T any](this *ISortImpl, f func(T, T) bool, group *storage.IPartitionGroup[T], ascending bool) error {
 	inMemory := this.executorData.GetPartitionTools().IsMemoryGroup(group)
+	run, err := this.executorData.GetProperties().SortRun()
+	if err != nil {
+		return ierror.Raise(err)
+	}
 	/*Sort each partition locally*/
 	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
 		return rctx.For().Dynamic().Run(group.Size(), func(i int) error {
 			if inMemory {
 				sortPartition[T](this, f, group.Get(i).(*storage.IMemoryPartition[T]), ascending)
+			} else if group.Get(i).Size() > run {
+				if err := externalSortPartition[T](this, f, group.Get(i), ascending, run); err != nil {
+					return ierror.Raise(err)
+				}
 			} else {
 				tmp := storage.NewIMemoryPartition[T](group.Get(i).Size(), false)
 				if err := group.Get(i).CopyTo(tmp); err != nil {
@@ -412,7 +702,7 @@ func parallelLocalSort[T any](this *ISortImpl, f func(T, T) bool, group *storage
 func sortPartition[T any](this *ISortImpl, f func(T, T) bool, part *storage.IMemoryPartition[T], ascending bool) {
 	var data sort.Interface
 	list := part.Inner().(storage.IList)
-	if a, fast := list.Array().([]T); fast {
+	if a, fast := storage.SharedArray[T](list); fast {
 		data = &arrayCmp[T]{a, ascending, f}
 	} else {
 		data = &listCmp[T]{list, ascending, f}
@@ -420,6 +710,198 @@ func sortPartition[T any](this *ISortImpl, f func(T, T) bool, part *storage.IMem
 	sort.Sort(data)
 }
 
+/*Sorts runs of the partition in memory, spills them to disk and merges the runs back into the partition*/
+func externalSortPartition[T any](this *ISortImpl, f func(T, T) bool, part storage.IPartition[T], ascending bool, run int64) error {
+	reader, err := part.ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	var runs []storage.IPartition[T]
+	for reader.HasNext() {
+		tmp := storage.NewIMemoryPartition[T](run, false)
+		writer, err := tmp.WriteIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for n := int64(0); n < run && reader.HasNext(); n++ {
+			elem, err := reader.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = writer.Write(elem); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		sortPartition[T](this, f, tmp, ascending)
+		disk, err := core.NewDiskPartitionDef[T](this.executorData.GetPartitionTools())
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = tmp.CopyTo(disk); err != nil {
+			return ierror.Raise(err)
+		}
+		runs = append(runs, disk)
+	}
+	logger.Info("Sort: merging ", len(runs), " sorted runs of ", run, " elements")
+
+	merge := &iRunHeap[T]{less: func(a, b T) bool { return f(a, b) == ascending }}
+	for _, r := range runs {
+		it, err := r.ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if it.HasNext() {
+			head, err := it.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			merge.runs = append(merge.runs, iSortedRun[T]{head, it})
+		}
+	}
+	heap.Init(merge)
+	if err = part.Clear(); err != nil {
+		return ierror.Raise(err)
+	}
+	writer, err := part.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for merge.Len() > 0 {
+		top := &merge.runs[0]
+		if err = writer.Write(top.head); err != nil {
+			return ierror.Raise(err)
+		}
+		if top.it.HasNext() {
+			if top.head, err = top.it.Next(); err != nil {
+				return ierror.Raise(err)
+			}
+			heap.Fix(merge, 0)
+		} else {
+			heap.Pop(merge)
+		}
+	}
+	for _, r := range runs {
+		if err = r.Clear(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+/*
+Merges the sorted runs of a received partition. Partitions that are not in memory are only merged when they fit in a
+sort run, returns false when the partition was left unsorted.
+*/
+func mergeSortedRuns[T any](part storage.IPartition[T], less func(T, T) bool, run int64) (bool, error) {
+	if list, ok := part.Inner().(*storage.IListImpl[T]); ok && part.Type() == storage.IMemoryPartitionType {
+		array := list.Array().([]T)
+		copy(array, mergeRuns(array, less))
+		return true, nil
+	}
+	if part.Size() > run {
+		return false, nil
+	}
+	elems := make([]T, 0, part.Size())
+	reader, err := part.ReadIterator()
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return false, ierror.Raise(err)
+		}
+		elems = append(elems, elem)
+	}
+	if err = part.Clear(); err != nil {
+		return false, ierror.Raise(err)
+	}
+	writer, err := part.WriteIterator()
+	if err != nil {
+		return false, ierror.Raise(err)
+	}
+	for _, elem := range mergeRuns(elems, less) {
+		if err = writer.Write(elem); err != nil {
+			return false, ierror.Raise(err)
+		}
+	}
+	return true, nil
+}
+
+/*Adjacent runs are merged in pairs, so k runs take log k passes*/
+func mergeRuns[T any](elems []T, less func(T, T) bool) []T {
+	bounds := []int{0}
+	for i := 1; i < len(elems); i++ {
+		if less(elems[i], elems[i-1]) {
+			bounds = append(bounds, i)
+		}
+	}
+	bounds = append(bounds, len(elems))
+	if len(bounds) <= 2 {
+		return elems
+	}
+	buffer := make([]T, len(elems))
+	for len(bounds) > 2 {
+		next := []int{0}
+		for i := 0; i+1 < len(bounds); i += 2 {
+			if i+2 >= len(bounds) {
+				copy(buffer[bounds[i]:], elems[bounds[i]:bounds[i+1]])
+				next = append(next, bounds[i+1])
+				continue
+			}
+			a, b, k := bounds[i], bounds[i+1], bounds[i]
+			for a < bounds[i+1] && b < bounds[i+2] {
+				if less(elems[b], elems[a]) {
+					buffer[k] = elems[b]
+					b++
+				} else {
+					buffer[k] = elems[a]
+					a++
+				}
+				k++
+			}
+			k += copy(buffer[k:], elems[a:bounds[i+1]])
+			copy(buffer[k:], elems[b:bounds[i+2]])
+			next = append(next, bounds[i+2])
+		}
+		elems, buffer = buffer, elems
+		bounds = next
+	}
+	return elems
+}
+
+type iSortedRun[T any] struct {
+	head T
+	it   iterator.IReadIterator[T]
+}
+
+type iRunHeap[T any] struct {
+	runs []iSortedRun[T]
+	less func(T, T) bool
+}
+
+func (this *iRunHeap[T]) Len() int {
+	return len(this.runs)
+}
+
+func (this *iRunHeap[T]) Less(i, j int) bool {
+	return this.less(this.runs[i].head, this.runs[j].head)
+}
+
+func (this *iRunHeap[T]) Swap(i, j int) {
+	this.runs[i], this.runs[j] = this.runs[j], this.runs[i]
+}
+
+func (this *iRunHeap[T]) Push(x any) {
+	this.runs = append(this.runs, x.(iSortedRun[T]))
+}
+
+func (this *iRunHeap[T]) Pop() any {
+	last := this.runs[len(this.runs)-1]
+	this.runs = this.runs[:len(this.runs)-1]
+	return last
+}
+
 func selectPivots[T any](this *ISortImpl, f func(T, T) bool, group *storage.IPartitionGroup[T], ascending bool, samples int64) (*storage.IMemoryPartition[T], error) {
 	if this.executorData.GetPartitionTools().IsMemoryGroup(group) {
 		return selectMemoryPivots(this, f, group, ascending, samples)
@@ -733,7 +1215,7 @@ func generateMemoryRanges[T any](this *ISortImpl, f func(T, T) bool, group *stor
 				}
 
 				if first == r {
-					if array, ok := list.Array().([]T); ok {
+					if array, ok := storage.SharedArray[T](list); ok {
 						for i := start; i < mid; i++ {
 							if err := writers[r].Write(array[i]); err != nil {
 								return ierror.Raise(err)
@@ -752,7 +1234,7 @@ func generateMemoryRanges[T any](this *ISortImpl, f func(T, T) bool, group *stor
 				}
 
 				if r == last {
-					if array, ok := list.Array().([]T); ok {
+					if array, ok := storage.SharedArray[T](list); ok {
 						for i := mid + 1; i <= end; i++ {
 							if err := writers[r].Write(array[i]); err != nil {
 								return ierror.Raise(err)
@@ -1020,11 +1502,23 @@ func defaultCmp[T any]() (func(T, T) bool, error) {
 		f = func(a, b float64) bool { return a < b || (a != a && b == b) }
 	case *string:
 		f = func(a, b string) bool { return a < b }
+	case *time.Time:
+		f = func(a, b time.Time) bool { return a.Before(b) }
+	case *time.Duration:
+		f = func(a, b time.Duration) bool { return a < b }
+	case *iuuid.IUUID:
+		f = func(a, b iuuid.IUUID) bool { return a.Cmp(b) < 0 }
+	case **big.Int:
+		f = func(a, b *big.Int) bool { return a.Cmp(b) < 0 }
+	case **big.Float:
+		f = func(a, b *big.Float) bool { return a.Cmp(b) < 0 }
+	case **big.Rat:
+		f = func(a, b *big.Rat) bool { return a.Cmp(b) < 0 }
 	case api.Sortable[T]:
 		f = v.Less
 	default:
 		return nil, ierror.RaiseMsg(utils.TypeName[T]() + " is not [int, int8 , int16, int32 , int64, uint , uint8, uint16, " +
-			"uint32, uint64 , float32, float64, string] o implement api.Sortable")
+			"uint32, uint64 , float32, float64, string, time.Time, time.Duration, iuuid.IUUID, *big.Int, *big.Float, *big.Rat] o implement api.Sortable")
 	}
 	return f.(func(T, T) bool), nil
 }
@@ -1066,3 +1560,128 @@ func (this *listCmp[T]) Swap(i, j int) {
 	this.list.SetAny(i, this.list.GetAny(j))
 	this.list.SetAny(j, aux)
 }
+
+func GapsAndIslands[T any, K comparable](this *ISortImpl, keyF function.IFunction[T, K], seqF function.IFunction[T, int64],
+	maxGap int64, numPartitions int64) error {
+	context := this.Context()
+	if err := keyF.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := seqF.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	input, err := core.GetAndDeletePartitions[T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if numPartitions < 1 {
+		numPartitions = int64(input.Size())
+	}
+	pairs, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[int64, T]]](this.executorData.GetPartitionTools(), input.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Sort: keying ", input.Size(), " partitions for islands")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			writer, err := pairs.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				key, err := keyF.Call(elem, context)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				seq, err := seqF.Call(elem, context)
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if err = writer.Write(ipair.IPair[K, ipair.IPair[int64, T]]{key, ipair.IPair[int64, T]{seq, elem}}); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			input.Set(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	core.SetPartitions(this.executorData, pairs)
+	reduceImpl := NewIReduceImpl(this.executorData)
+	if err := keyHashing[K, ipair.IPair[int64, T]](reduceImpl, numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyExchanging[K, ipair.IPair[int64, T]](reduceImpl); err != nil {
+		return ierror.Raise(err)
+	}
+	exchanged, err := core.GetAndDeletePartitions[ipair.IPair[K, ipair.IPair[int64, T]]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupWithSize[ipair.IPair[K, ipair.IPair[int64, T]]](this.executorData.GetPartitionTools(), exchanged.Size())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Sort: labeling islands in ", exchanged.Size(), " partitions")
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(exchanged.Size(), func(p int) error {
+			reader, err := exchanged.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			groups := map[K][]ipair.IPair[int64, T]{}
+			keys := newINormalKeys[K]()
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				key := keys.key(elem.First)
+				groups[key] = append(groups[key], elem.Second)
+			}
+			exchanged.SetBase(p, nil)
+			writer, err := output.Get(p).WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for key, group := range groups {
+				sort.SliceStable(group, func(i, j int) bool {
+					return group[i].First < group[j].First
+				})
+				island := int64(0)
+				for i, e := range group {
+					if i > 0 && e.First-group[i-1].First > maxGap {
+						island++
+					}
+					if err = writer.Write(ipair.IPair[K, ipair.IPair[int64, T]]{keys.original(key), ipair.IPair[int64, T]{island, e.Second}}); err != nil {
+						return ierror.Raise(err)
+					}
+				}
+			}
+			return output.Get(p).Fit()
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := keyF.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if err := seqF.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
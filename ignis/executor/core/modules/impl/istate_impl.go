@@ -0,0 +1,271 @@
+package impl
+
+import (
+	"errors"
+	"ignis/executor/api"
+	"ignis/executor/api/function"
+	"ignis/executor/api/ipair"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/ithreads"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+/*
+Keyed states kept between jobs. A state is hash partitioned by key with a fixed number of partitions, so after the
+exchange of a batch every executor owns the same partitions of the batch and of the state and only the batch is
+shuffled. State partitions are persisted disk partitions that are rewritten when a batch updates them.
+*/
+type IStateImpl struct {
+	IBaseImpl
+	reduce *IReduceImpl
+	states map[string]*iState
+}
+
+type iState struct {
+	numPartitions int64
+	dir           string
+	group         storage.IPartitionGroupBase
+}
+
+const stateMeta = "state.meta"
+
+func NewIStateImpl(executorData *core.IExecutorData) *IStateImpl {
+	return &IStateImpl{
+		IBaseImpl: IBaseImpl{executorData},
+		reduce:    NewIReduceImpl(executorData),
+		states:    make(map[string]*iState),
+	}
+}
+
+func (this *IStateImpl) stateDirectory(name string) (string, error) {
+	props := this.executorData.GetProperties()
+	dir, err := props.StateDirectory()
+	if err != nil {
+		return "", ierror.Raise(err)
+	}
+	if dir == "" {
+		dirs, err := props.SpillDirectories()
+		if err != nil {
+			return "", ierror.Raise(err)
+		}
+		if len(dirs) > 0 {
+			dir = dirs[0]
+		} else if dir, err = props.ExecutorDirectory(); err != nil {
+			return "", ierror.Raise(err)
+		}
+	}
+	return filepath.Join(dir, "state", name), nil
+}
+
+/*
+The update function receives the new values of a key and its current state, nil for new keys, and returns the next
+state, nil removes the key. Keys without new values are updated with an empty slice. The partitions of a new state
+are fixed by numPartitions, existing states keep theirs. The updated state becomes the current partitions.
+*/
+func UpdateStateByKey[K comparable, V any, S any](this *IStateImpl, name string, f function.IFunction2[[]V, *S, *S],
+	numPartitions int64) error {
+	context := this.Context()
+	state, err := openState[K, S](this, name, numPartitions)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err = f.Before(context); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = keyHashing[K, V](this.reduce, state.numPartitions); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = keyExchanging[K, V](this.reduce); err != nil {
+		return ierror.Raise(err)
+	}
+	batch, err := core.GetAndDeletePartitions[ipair.IPair[K, V]](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	current := state.group.(*storage.IPartitionGroup[ipair.IPair[K, S]])
+	if batch.Size() != current.Size() {
+		return ierror.RaiseMsg("state " + name + " has " + strconv.Itoa(current.Size()) + " partitions in this executor, " +
+			"batch has " + strconv.Itoa(batch.Size()))
+	}
+	compression, err := this.executorData.GetProperties().PartitionCompression()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	native, err := this.executorData.GetProperties().NativeSerialization()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("State: updating ", batch.Size(), " partitions of state ", name)
+	if err = ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		context := this.executorData.GetThreadContext(rctx.ThreadId())
+		return rctx.For().Dynamic().Run(batch.Size(), func(p int) error {
+			old := current.Get(p).(*storage.IDiskPartition[ipair.IPair[K, S]])
+			updated, err := storage.NewIDiskPartition[ipair.IPair[K, S]](old.GetPath()+".tmp", compression, native, true, false)
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if err = updateStatePartition[K, V, S](f, context, batch.Get(p), old, updated); err != nil {
+				return ierror.RaiseUser(err, int64(p))
+			}
+			if err = updated.Sync(); err != nil {
+				return ierror.Raise(err)
+			}
+			if err = updated.Rename(old.GetPath()); err != nil {
+				return ierror.Raise(err)
+			}
+			current.Set(p, updated)
+			batch.Set(p, nil)
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = f.After(context); err != nil {
+		return ierror.Raise(err)
+	}
+
+	output := current.ShadowCopy()
+	output.SetCache(true)
+	core.SetPartitions(this.executorData, output)
+	this.executorData.SetPartitioner(NewIHashPartitioner[K](state.numPartitions).Id())
+	return nil
+}
+
+func updateStatePartition[K comparable, V any, S any](f function.IFunction2[[]V, *S, *S], context api.IContext,
+	batch storage.IPartition[ipair.IPair[K, V]], old storage.IPartition[ipair.IPair[K, S]],
+	updated storage.IPartition[ipair.IPair[K, S]]) error {
+	values := make(map[K][]V)
+	keys := newINormalKeys[K]()
+	reader, err := batch.ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		key := keys.key(elem.First)
+		values[key] = append(values[key], elem.Second)
+	}
+	writer, err := updated.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	update := func(key K, batchValues []V, value *S) error {
+		next, err := f.Call(batchValues, value, context)
+		if err != nil || next == nil {
+			return err
+		}
+		return writer.Write(*ipair.New(key, *next))
+	}
+
+	stateReader, err := old.ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for stateReader.HasNext() {
+		elem, err := stateReader.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		key := keys.find(elem.First)
+		batchValues := values[key]
+		delete(values, key)
+		if err = update(elem.First, batchValues, &elem.Second); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	for key, batchValues := range values {
+		if err = update(keys.original(key), batchValues, nil); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+/*States are opened from disk the first time they are used, the partitions of this executor are created if missing*/
+func openState[K comparable, S any](this *IStateImpl, name string, numPartitions int64) (*iState, error) {
+	if state, present := this.states[name]; present {
+		if _, ok := state.group.(*storage.IPartitionGroup[ipair.IPair[K, S]]); !ok {
+			return nil, ierror.RaiseMsg("state " + name + " does not hold " + state.group.Type().String())
+		}
+		return state, nil
+	}
+	dir, err := this.stateDirectory(name)
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, stateMeta)); err == nil {
+		if numPartitions, err = strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64); err != nil {
+			return nil, ierror.RaiseMsgCause("corrupted state "+name, err)
+		}
+		logger.Info("State: loading state ", name, " with ", numPartitions, " partitions")
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return nil, ierror.Raise(err)
+	} else if numPartitions <= 0 {
+		return nil, ierror.RaiseMsg("state " + name + " not found, the number of partitions is required to create it")
+	} else {
+		logger.Info("State: creating state ", name, " with ", numPartitions, " partitions")
+		if err = os.MkdirAll(dir, 0755); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		tmp := filepath.Join(dir, stateMeta+strconv.Itoa(this.executorData.Mpi().Rank()))
+		if err = os.WriteFile(tmp, []byte(strconv.FormatInt(numPartitions, 10)), 0644); err != nil {
+			return nil, ierror.Raise(err)
+		}
+		if err = os.Rename(tmp, filepath.Join(dir, stateMeta)); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	compression, err := this.executorData.GetProperties().PartitionCompression()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	native, err := this.executorData.GetProperties().NativeSerialization()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+
+	owned := exchangeRanges(this.executorData.Mpi().Executors(), int(numPartitions))[this.executorData.Mpi().Rank()]
+	group := storage.NewIPartitionGroup[ipair.IPair[K, S]]()
+	for p := owned.First; p < owned.Second; p++ {
+		path := filepath.Join(dir, "partition"+strconv.FormatInt(p, 10))
+		_, err := os.Stat(path + ".header")
+		part, err := storage.NewIDiskPartition[ipair.IPair[K, S]](path, compression, native, true, err == nil)
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		group.Add(part)
+	}
+	state := &iState{numPartitions: numPartitions, dir: dir, group: group}
+	this.states[name] = state
+	return state, nil
+}
+
+/*Removes the state and its files*/
+func (this *IStateImpl) DropState(name string) error {
+	dir := ""
+	if state, present := this.states[name]; present {
+		dir = state.dir
+		delete(this.states, name)
+	} else {
+		var err error
+		if dir, err = this.stateDirectory(name); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	logger.Info("State: dropping state ", name)
+	if err := os.RemoveAll(dir); err != nil {
+		return ierror.Raise(err)
+	}
+	return nil
+}
@@ -0,0 +1,367 @@
+package impl
+
+import (
+	"ignis/executor/api/ipair"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/impi"
+	"ignis/executor/core/ithreads"
+	"ignis/executor/core/logger"
+	"ignis/executor/core/storage"
+	"ignis/executor/core/utils"
+	"sort"
+)
+
+type IVectorImpl struct {
+	IBaseImpl
+	indexes map[string]any
+}
+
+func NewIVectorImpl(executorData *core.IExecutorData) *IVectorImpl {
+	return &IVectorImpl{
+		IBaseImpl{executorData},
+		make(map[string]any),
+	}
+}
+
+type IVectorNeighbor[T utils.Float] struct {
+	Query    int64
+	Id       int64
+	Distance T
+}
+
+type iIvfShard[T utils.Float] struct {
+	centroids [][]T
+	ids       [][]int64
+	vectors   [][][]T
+}
+
+type iIvfIndex[T utils.Float] struct {
+	dim    int
+	shards []*iIvfShard[T]
+}
+
+func (this *IVectorImpl) DropIndex(name string) error {
+	if _, present := this.indexes[name]; !present {
+		return ierror.RaiseMsg("vector index " + name + " not found")
+	}
+	logger.Info("Vector: dropping index ", name)
+	delete(this.indexes, name)
+	return nil
+}
+
+func BuildIvfIndex[T utils.Float](this *IVectorImpl, name string, lists int64, iterations int64) error {
+	input, err := core.GetPartitions[[]T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if lists < 1 {
+		return ierror.RaiseMsg("vector index requires at least one list")
+	}
+	logger.Info("Vector: building ivf index ", name, " over ", input.Size(), " partitions")
+
+	indices := make([]impi.C_int64, this.executorData.Mpi().Executors())
+	elems := impi.C_int64(0)
+	for _, p := range input.Iter() {
+		elems += impi.C_int64(p.Size())
+	}
+	if err = impi.MPI_Allgather(impi.P(&elems), 1, impi.MPI_LONG_LONG_INT, impi.P(&indices[0]), 1,
+		impi.MPI_LONG_LONG_INT, this.executorData.Mpi().Native()); err != nil {
+		return ierror.Raise(err)
+	}
+	offset := make([]int64, input.Size()+1)
+	for i := 0; i < this.executorData.Mpi().Rank(); i++ {
+		offset[0] += int64(indices[i])
+	}
+	for i := 0; i < input.Size(); i++ {
+		offset[i+1] = offset[i] + input.Get(i).Size()
+	}
+
+	index := &iIvfIndex[T]{shards: make([]*iIvfShard[T], input.Size())}
+	dims := make([]int, input.Size())
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		return rctx.For().Dynamic().Run(input.Size(), func(p int) error {
+			reader, err := input.Get(p).ReadIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			vectors := make([][]T, 0, input.Get(p).Size())
+			for reader.HasNext() {
+				elem, err := reader.Next()
+				if err != nil {
+					return ierror.Raise(err)
+				}
+				if len(vectors) > 0 && len(elem) != len(vectors[0]) {
+					return ierror.RaiseMsg("vectors must have the same dimension")
+				}
+				vectors = append(vectors, elem)
+			}
+			if len(vectors) > 0 {
+				dims[p] = len(vectors[0])
+			}
+			index.shards[p] = ivfTrain(vectors, offset[p], int(lists), int(iterations))
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	for _, dim := range dims {
+		if dim > 0 && index.dim > 0 && dim != index.dim {
+			return ierror.RaiseMsg("vectors must have the same dimension")
+		}
+		index.dim = utils.Max(index.dim, dim)
+	}
+	this.indexes[name] = index
+	return nil
+}
+
+func SearchIvfIndex[T utils.Float](this *IVectorImpl, name string, k int64, probes int64) error {
+	aindex, present := this.indexes[name]
+	if !present {
+		return ierror.RaiseMsg("vector index " + name + " not found")
+	}
+	index, ok := aindex.(*iIvfIndex[T])
+	if !ok {
+		return ierror.RaiseMsg("vector index " + name + " has a different element type")
+	}
+	if k < 1 {
+		return ierror.RaiseMsg("vector search requires at least one neighbor")
+	}
+	input, err := core.GetAndDeletePartitions[[]T](this.executorData)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	output, err := core.NewPartitionGroupDef[[]ipair.IPair[int64, T]](this.executorData.GetPartitionTools())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Vector: scattering queries")
+	dim := impi.C_int64(index.dim)
+	if err = impi.MPI_Allreduce(impi.MPI_IN_PLACE, impi.P(&dim), 1, impi.MPI_LONG_LONG_INT, impi.MPI_MAX,
+		this.executorData.Mpi().Native()); err != nil {
+		return ierror.Raise(err)
+	}
+	queries, err := core.NewMemoryPartition[T](this.executorData.GetPartitionTools(), 0)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	qwriter, err := queries.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for _, part := range input.Iter() {
+		reader, err := part.ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for reader.HasNext() {
+			elem, err := reader.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if len(elem) != int(dim) {
+				return ierror.RaiseMsg("query dimension does not match the index")
+			}
+			for _, v := range elem {
+				if err = qwriter.Write(v); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+		}
+	}
+	input.Clear()
+	if err = core.Gather[T](this.executorData.Mpi(), queries, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	if err = core.Bcast[T](this.executorData.Mpi(), queries, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	flat := queries.Inner().(*storage.IListImpl[T]).Array().([]T)
+	n := 0
+	if dim > 0 {
+		n = len(flat) / int(dim)
+	}
+
+	logger.Info("Vector: searching ", n, " queries in local shards")
+	neighbors, err := core.NewMemoryPartition[IVectorNeighbor[T]](this.executorData.GetPartitionTools(), k*int64(n))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if err := ithreads.Parallel(func(rctx ithreads.IRuntimeContext) error {
+		local := make([]IVectorNeighbor[T], 0)
+		if err := rctx.For().Dynamic().Run(n, func(q int) error {
+			query := flat[q*int(dim) : (q+1)*int(dim)]
+			top := make([]IVectorNeighbor[T], 0, k)
+			for _, shard := range index.shards {
+				top = ivfSearch(shard, query, int64(q), top, int(k), int(probes))
+			}
+			local = append(local, top...)
+			return nil
+		}); err != nil {
+			return ierror.Raise(err)
+		}
+		return rctx.Critical(func() error {
+			writer, err := neighbors.WriteIterator()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			for _, elem := range local {
+				if err = writer.Write(elem); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return ierror.Raise(err)
+	}
+
+	logger.Info("Vector: gathering neighbors")
+	if err = core.Gather[IVectorNeighbor[T]](this.executorData.Mpi(), neighbors, 0); err != nil {
+		return ierror.Raise(err)
+	}
+	if this.executorData.Mpi().IsRoot(0) {
+		results := make([][]IVectorNeighbor[T], n)
+		for _, elem := range neighbors.Inner().(*storage.IListImpl[IVectorNeighbor[T]]).Array().([]IVectorNeighbor[T]) {
+			results[elem.Query] = ivfInsert(results[elem.Query], elem, int(k))
+		}
+		part, err := core.NewMemoryPartition[[]ipair.IPair[int64, T]](this.executorData.GetPartitionTools(), int64(n))
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		writer, err := part.WriteIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		for _, result := range results {
+			elem := make([]ipair.IPair[int64, T], len(result))
+			for i, neighbor := range result {
+				elem[i] = ipair.IPair[int64, T]{First: neighbor.Id, Second: neighbor.Distance}
+			}
+			if err = writer.Write(elem); err != nil {
+				return ierror.Raise(err)
+			}
+		}
+		output.Add(part)
+	}
+	core.SetPartitions(this.executorData, output)
+	return nil
+}
+
+func ivfDistance[T utils.Float](a []T, b []T) T {
+	var d T
+	for i := range a {
+		aux := a[i] - b[i]
+		d += aux * aux
+	}
+	return d
+}
+
+func ivfNearest[T utils.Float](centroids [][]T, v []T) int {
+	best := 0
+	bestDist := ivfDistance(centroids[0], v)
+	for c := 1; c < len(centroids); c++ {
+		if d := ivfDistance(centroids[c], v); d < bestDist {
+			best = c
+			bestDist = d
+		}
+	}
+	return best
+}
+
+func ivfTrain[T utils.Float](vectors [][]T, offset int64, lists int, iterations int) *iIvfShard[T] {
+	shard := &iIvfShard[T]{}
+	if len(vectors) == 0 {
+		return shard
+	}
+	lists = utils.Min(lists, len(vectors))
+	dim := len(vectors[0])
+	shard.centroids = make([][]T, lists)
+	step := len(vectors) / lists
+	for c := range shard.centroids {
+		shard.centroids[c] = append([]T{}, vectors[c*step]...)
+	}
+
+	assign := make([]int, len(vectors))
+	for it := 0; it < iterations; it++ {
+		changed := false
+		for i, v := range vectors {
+			c := ivfNearest(shard.centroids, v)
+			if c != assign[i] || it == 0 {
+				changed = true
+			}
+			assign[i] = c
+		}
+		if !changed {
+			break
+		}
+		counts := make([]int, lists)
+		sums := make([][]T, lists)
+		for c := range sums {
+			sums[c] = make([]T, dim)
+		}
+		for i, v := range vectors {
+			counts[assign[i]]++
+			for j := range v {
+				sums[assign[i]][j] += v[j]
+			}
+		}
+		for c := range sums {
+			if counts[c] == 0 {
+				continue
+			}
+			for j := range sums[c] {
+				sums[c][j] /= T(counts[c])
+			}
+			shard.centroids[c] = sums[c]
+		}
+	}
+
+	shard.ids = make([][]int64, lists)
+	shard.vectors = make([][][]T, lists)
+	for i, v := range vectors {
+		c := ivfNearest(shard.centroids, v)
+		shard.ids[c] = append(shard.ids[c], offset+int64(i))
+		shard.vectors[c] = append(shard.vectors[c], v)
+	}
+	return shard
+}
+
+func ivfSearch[T utils.Float](shard *iIvfShard[T], query []T, q int64, top []IVectorNeighbor[T], k int, probes int) []IVectorNeighbor[T] {
+	if len(shard.centroids) == 0 {
+		return top
+	}
+	order := make([]int, len(shard.centroids))
+	dists := make([]T, len(shard.centroids))
+	for c := range shard.centroids {
+		order[c] = c
+		dists[c] = ivfDistance(shard.centroids[c], query)
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return dists[order[i]] < dists[order[j]]
+	})
+	probes = utils.Max(1, utils.Min(probes, len(order)))
+	for _, c := range order[:probes] {
+		for i, v := range shard.vectors[c] {
+			top = ivfInsert(top, IVectorNeighbor[T]{q, shard.ids[c][i], ivfDistance(v, query)}, k)
+		}
+	}
+	return top
+}
+
+func ivfInsert[T utils.Float](top []IVectorNeighbor[T], elem IVectorNeighbor[T], k int) []IVectorNeighbor[T] {
+	if len(top) == k && top[k-1].Distance <= elem.Distance {
+		return top
+	}
+	i := sort.Search(len(top), func(i int) bool {
+		return elem.Distance < top[i].Distance
+	})
+	if len(top) < k {
+		top = append(top, elem)
+	}
+	copy(top[i+1:], top[i:len(top)-1])
+	top[i] = elem
+	return top
+}
@@ -0,0 +1,42 @@
+package modules
+
+import (
+	"context"
+	"ignis/executor/api/base"
+	"ignis/executor/api/function"
+	"ignis/executor/core"
+	"ignis/executor/core/modules/impl"
+	"ignis/rpc"
+	"reflect"
+)
+
+type IStateModule struct {
+	IModule
+	impl *impl.IStateImpl
+}
+
+func NewIStateModule(executorData *core.IExecutorData) *IStateModule {
+	return &IStateModule{
+		IModule{executorData},
+		impl.NewIStateImpl(executorData),
+	}
+}
+
+func (this *IStateModule) UpdateStateByKey(ctx context.Context, src *rpc.ISource, name string, numPartitions int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	basefun, err := this.executorData.LoadLibrary(src)
+	if err != nil {
+		return this.PackError(err)
+	}
+	if fun, ok := basefun.(base.IUpdateStateByKeyAbs); ok {
+		return this.PackError(fun.RunUpdateStateByKey(this.impl, basefun, name, numPartitions))
+	} else if anyfun, ok := basefun.(function.IFunction2[[]any, *any, *any]); ok {
+		return this.PackError(impl.UpdateStateByKey[any, any, any](this.impl, name, anyfun, numPartitions))
+	}
+	return this.CompatibilityError(reflect.TypeOf(basefun), "updateStateByKey")
+}
+
+func (this *IStateModule) DropState(ctx context.Context, name string) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.PackError(this.impl.DropState(name))
+}
@@ -0,0 +1,60 @@
+package modules
+
+import (
+	"context"
+	"ignis/executor/core"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/modules/impl"
+	"ignis/executor/core/utils"
+)
+
+type IVectorModule struct {
+	IModule
+	impl *impl.IVectorImpl
+}
+
+func NewIVectorModule(executorData *core.IExecutorData) *IVectorModule {
+	return &IVectorModule{
+		IModule{executorData},
+		impl.NewIVectorImpl(executorData),
+	}
+}
+
+func (this *IVectorModule) vectorType() (bool, error) {
+	tp := this.executorData.GetPartitionsAny().Type()
+	if tp == utils.TypeObj[[]float64]() {
+		return true, nil
+	} else if tp == utils.TypeObj[[]float32]() {
+		return false, nil
+	}
+	return false, ierror.RaiseMsg(tp.String() + " is not a vector type")
+}
+
+func (this *IVectorModule) BuildIndex(ctx context.Context, name string, lists int64, iterations int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	double, err := this.vectorType()
+	if err != nil {
+		return this.PackError(err)
+	}
+	if double {
+		return this.PackError(impl.BuildIvfIndex[float64](this.impl, name, lists, iterations))
+	}
+	return this.PackError(impl.BuildIvfIndex[float32](this.impl, name, lists, iterations))
+}
+
+func (this *IVectorModule) SearchIndex(ctx context.Context, name string, k int64, probes int64) (_err error) {
+	defer this.moduleRecover(&_err)
+	double, err := this.vectorType()
+	if err != nil {
+		return this.PackError(err)
+	}
+	if double {
+		return this.PackError(impl.SearchIvfIndex[float64](this.impl, name, k, probes))
+	}
+	return this.PackError(impl.SearchIvfIndex[float32](this.impl, name, k, probes))
+}
+
+func (this *IVectorModule) DropIndex(ctx context.Context, name string) (_err error) {
+	defer this.moduleRecover(&_err)
+	return this.PackError(this.impl.DropIndex(name))
+}
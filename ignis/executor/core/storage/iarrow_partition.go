@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"context"
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/iio"
+	"ignis/executor/core/iprotocol"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/utils"
+)
+
+/*Elements per record batch when the partition is not stored in memory*/
+var ArrowBatch = 64 * 1024
+
+/*Writes the partition as an arrow stream, any partition can read it back with Read*/
+func WriteArrow[T any](part IPartition[T], transport thrift.TTransport, compression int8) error {
+	zlib, err := itransport.NewIZlibTransportWithLevel(transport, int(compression))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	proto := iprotocol.NewIObjectProtocol(zlib)
+	if err = proto.WriteArrowSerialization(); err != nil {
+		return ierror.Raise(err)
+	}
+	writer, err := iio.NewIArrowWriter(zlib, utils.TypeObj[T]())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if men, ok := part.(*IMemoryPartition[T]); ok {
+		if err = writer.Write(men.elems.Array()); err != nil {
+			return ierror.Raise(err)
+		}
+	} else if columnar, ok := part.(*IColumnarPartition[T]); ok {
+		if err = columnar.writeArrow(writer); err != nil {
+			return ierror.Raise(err)
+		}
+	} else {
+		it, err := part.ReadIterator()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		batch := make([]T, 0, utils.Min(ArrowBatch, int(part.Size())))
+		for it.HasNext() {
+			elem, err := it.Next()
+			if err != nil {
+				return ierror.Raise(err)
+			}
+			if batch = append(batch, elem); len(batch) == ArrowBatch || !it.HasNext() {
+				if err = writer.Write(batch); err != nil {
+					return ierror.Raise(err)
+				}
+				batch = batch[:0]
+			}
+		}
+	}
+	if err = writer.Close(); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(zlib.Flush(context.Background()))
+}
+
+func readArrow[T any](part IPartition[T], proto *iprotocol.IObjectProtocol) error {
+	table, err := iio.ReadArrow(proto.Transport())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	array, err := table.Array(utils.TypeObj[T]())
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	it, err := part.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for _, elem := range array.([]T) {
+		if err = it.Write(elem); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
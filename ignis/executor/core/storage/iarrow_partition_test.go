@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+	"ignis/executor/core/itransport"
+	"testing"
+)
+
+type arrowTestRow struct {
+	Key   string
+	Value float64
+}
+
+func TestArrowMemoryPartition(t *testing.T) {
+	rows := []arrowTestRow{{"a", 1}, {"b", 2.5}, {"c", -3}}
+	buffer := itransport.NewIMemoryBuffer()
+	require.Nil(t, WriteArrow[arrowTestRow](NewIMemoryPartitionArray(rows), buffer, 6))
+	part := NewIMemoryPartition[arrowTestRow](0, false)
+	require.Nil(t, part.Read(buffer))
+	require.Equal(t, rows, part.Inner().(IList).Array())
+}
+
+func TestArrowDiskPartition(t *testing.T) {
+	elems := []int64{5, -1, 7, 0, 3}
+	buffer := itransport.NewIMemoryBuffer()
+	require.Nil(t, WriteArrow[int64](NewIMemoryPartitionArray(elems), buffer, 0))
+	disk, err := NewIDiskPartition[int64](t.TempDir()+"/arrow", 0, false, false, false)
+	require.Nil(t, err)
+	require.Nil(t, disk.Read(buffer))
+	require.Equal(t, int64(len(elems)), disk.Size())
+
+	ArrowBatch = 2
+	defer func() { ArrowBatch = 64 * 1024 }()
+	buffer = itransport.NewIMemoryBuffer()
+	require.Nil(t, WriteArrow[int64](disk, buffer, 0))
+	part := NewIMemoryPartition[int64](0, false)
+	require.Nil(t, part.Read(buffer))
+	require.Equal(t, elems, part.Inner().(IList).Array())
+}
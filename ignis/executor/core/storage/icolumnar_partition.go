@@ -0,0 +1,447 @@
+package storage
+
+import (
+	"context"
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/api/iterator"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/iio"
+	"ignis/executor/core/iprotocol"
+	"ignis/executor/core/itransport"
+	"ignis/executor/core/utils"
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+const IColumnarPartitionType = "Columnar"
+
+type iColumnarField struct {
+	name   string
+	offset uintptr
+	size   uintptr
+	kind   reflect.Kind
+}
+
+var columnarLayouts sync.Map
+
+func columnarKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+/*
+Primitives are stored in a single column named value and flat structs in one column per field, named like the arrow
+columns. Any other type has no layout.
+*/
+func columnarLayout(tp reflect.Type) []iColumnarField {
+	if layout, ok := columnarLayouts.Load(tp); ok {
+		return layout.([]iColumnarField)
+	}
+	var layout []iColumnarField
+	if columnarKind(tp.Kind()) {
+		layout = []iColumnarField{{"value", 0, tp.Size(), tp.Kind()}}
+	} else if tp.Kind() == reflect.Struct && tp.NumField() > 0 {
+		for i := 0; i < tp.NumField(); i++ {
+			field := tp.Field(i)
+			if !field.IsExported() || field.Tag.Get("arrow") == "-" || !columnarKind(field.Type.Kind()) {
+				layout = nil
+				break
+			}
+			name := field.Name
+			if tag := field.Tag.Get("arrow"); tag != "" {
+				name = tag
+			}
+			layout = append(layout, iColumnarField{name, field.Offset, field.Type.Size(), field.Type.Kind()})
+		}
+	}
+	columnarLayouts.Store(tp, layout)
+	return layout
+}
+
+/*True if the elements of type T can be stored in an IColumnarPartition*/
+func IsColumnarType[T any]() bool {
+	return columnarLayout(utils.TypeObj[T]()) != nil
+}
+
+/*Columns are allocated as words so typed views of them are always aligned*/
+func columnarAlloc(bytes uintptr) []byte {
+	if bytes == 0 {
+		return nil
+	}
+	words := make([]uint64, (bytes+7)/8)
+	return unsafe.Slice((*byte)(unsafe.Pointer(unsafe.SliceData(words))), bytes)
+}
+
+/*Keeps every field of the elements in its own contiguous column, so a column can be filtered or mapped as a slice*/
+type IColumnarPartition[T any] struct {
+	fields  []iColumnarField
+	columns [][]byte
+	elems   int
+	native  bool
+}
+
+func NewIColumnarPartition[T any](sz int64, native bool) (*IColumnarPartition[T], error) {
+	fields := columnarLayout(utils.TypeObj[T]())
+	if fields == nil {
+		return nil, ierror.RaiseMsg(utils.TypeName[T]() + " can not be stored in columns")
+	}
+	this := &IColumnarPartition[T]{
+		fields:  fields,
+		columns: make([][]byte, len(fields)),
+		native:  native,
+	}
+	this.reserve(int(sz))
+	return this, nil
+}
+
+func (this *IColumnarPartition[T]) capacity() int {
+	return len(this.columns[0]) / int(this.fields[0].size)
+}
+
+func (this *IColumnarPartition[T]) resize(n int) {
+	for c, field := range this.fields {
+		column := columnarAlloc(uintptr(n) * field.size)
+		copy(column, this.columns[c][:uintptr(this.elems)*field.size])
+		this.columns[c] = column
+	}
+}
+
+func (this *IColumnarPartition[T]) reserve(n int) {
+	if n > this.capacity() {
+		this.resize(n)
+	}
+}
+
+func (this *IColumnarPartition[T]) grow(n int) {
+	if this.elems+n > this.capacity() {
+		this.resize(utils.Max(this.elems+n, int(float32(this.capacity())*1.5)))
+	}
+}
+
+/*Primitive elements are already stored as a []T, so the column can be used directly*/
+func (this *IColumnarPartition[T]) view() ([]T, bool) {
+	if len(this.fields) != 1 || this.fields[0].size != unsafe.Sizeof(*new(T)) {
+		return nil, false
+	}
+	if this.elems == 0 {
+		return []T{}, true
+	}
+	return unsafe.Slice((*T)(unsafe.Pointer(unsafe.SliceData(this.columns[0]))), this.elems), true
+}
+
+func (this *IColumnarPartition[T]) get(i int) (elem T) {
+	ptr := unsafe.Pointer(&elem)
+	for c, field := range this.fields {
+		copy(unsafe.Slice((*byte)(unsafe.Add(ptr, field.offset)), field.size), this.columns[c][uintptr(i)*field.size:])
+	}
+	return
+}
+
+func (this *IColumnarPartition[T]) set(i int, elem *T) {
+	ptr := unsafe.Pointer(elem)
+	for c, field := range this.fields {
+		copy(this.columns[c][uintptr(i)*field.size:], unsafe.Slice((*byte)(unsafe.Add(ptr, field.offset)), field.size))
+	}
+}
+
+func (this *IColumnarPartition[T]) add(elem *T) {
+	this.grow(1)
+	this.set(this.elems, elem)
+	this.elems++
+}
+
+func (this *IColumnarPartition[T]) addArray(array []T) {
+	this.grow(len(array))
+	if _, ok := this.view(); ok && len(array) > 0 {
+		size := uintptr(len(array)) * this.fields[0].size
+		copy(this.columns[0][uintptr(this.elems)*this.fields[0].size:], unsafe.Slice((*byte)(unsafe.Pointer(&array[0])), size))
+		this.elems += len(array)
+		return
+	}
+	for i := range array {
+		this.set(this.elems, &array[i])
+		this.elems++
+	}
+}
+
+/*Rows of the partition, primitive partitions return their column without copying it*/
+func (this *IColumnarPartition[T]) Array() []T {
+	if array, ok := this.view(); ok {
+		return array
+	}
+	array := make([]T, this.elems)
+	for i := range array {
+		array[i] = this.get(i)
+	}
+	return array
+}
+
+func (this *IColumnarPartition[T]) Read(transport thrift.TTransport) error {
+	zlibTrans, err := itransport.NewIZlibTransport(transport)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	proto := iprotocol.NewIObjectProtocol(zlibTrans)
+	elems, err := proto.ReadObject()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if table, ok := elems.(*iio.IArrowTable); ok {
+		if elems, err = table.Array(utils.TypeObj[T]()); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	if elems, err = iio.ReadTyped[T](elems); err != nil {
+		return ierror.Raise(err)
+	}
+	if array, ok := elems.([]T); ok {
+		this.addArray(array)
+		return nil
+	}
+	value := reflect.ValueOf(elems)
+	if value.Kind() != reflect.Slice {
+		return ierror.RaiseMsg("columnar partition expected []" + utils.TypeName[T]())
+	}
+	this.grow(value.Len())
+	for i := 0; i < value.Len(); i++ {
+		elem, ok := value.Index(i).Interface().(T)
+		if !ok {
+			return ierror.RaiseMsg("columnar partition expected " + utils.TypeName[T]())
+		}
+		this.add(&elem)
+	}
+	return nil
+}
+
+func (this *IColumnarPartition[T]) Write(transport thrift.TTransport, compression int8) error {
+	return this.WriteWithNative(transport, compression, this.native)
+}
+
+func (this *IColumnarPartition[T]) WriteWithNative(transport thrift.TTransport, compression int8, native bool) error {
+	zlibTrans, err := itransport.NewIZlibTransportWithLevel(transport, int(compression))
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	proto := iprotocol.NewIObjectProtocol(zlibTrans)
+	if err = proto.WriteObjectWithNative(this.Array(), native); err != nil {
+		return ierror.Raise(err)
+	}
+	return ierror.Raise(zlibTrans.Flush(context.Background()))
+}
+
+/*Writes the columns as a single arrow record batch without building the rows*/
+func (this *IColumnarPartition[T]) writeArrow(writer *iio.IArrowWriter) error {
+	columns := make([][]byte, len(this.fields))
+	for c, field := range this.fields {
+		columns[c] = this.columns[c][:uintptr(this.elems)*field.size]
+	}
+	return writer.WriteColumns(this.elems, columns)
+}
+
+func (this *IColumnarPartition[T]) Clone() (IPartitionBase, error) {
+	other := *this
+	other.columns = make([][]byte, len(this.fields))
+	other.resize(this.elems)
+	for c, field := range this.fields {
+		copy(other.columns[c], this.columns[c][:uintptr(this.elems)*field.size])
+	}
+	return &other, nil
+}
+
+func (this *IColumnarPartition[T]) CopyFrom(source IPartitionBase) error {
+	if other, ok := source.(*IColumnarPartition[T]); ok {
+		this.grow(other.elems)
+		for c, field := range this.fields {
+			copy(this.columns[c][uintptr(this.elems)*field.size:], other.columns[c][:uintptr(other.elems)*field.size])
+		}
+		this.elems += other.elems
+		return nil
+	}
+	if men, ok := source.(*IMemoryPartition[T]); ok {
+		if array, ok := men.elems.Array().([]T); ok {
+			this.addArray(array)
+			return nil
+		}
+	}
+	it, err := source.(IPartition[T]).ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.grow(int(source.Size()))
+	for it.HasNext() {
+		elem, err := it.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		this.add(&elem)
+	}
+	return nil
+}
+
+func (this *IColumnarPartition[T]) CopyTo(target IPartitionBase) error {
+	return target.CopyFrom(this)
+}
+
+func (this *IColumnarPartition[T]) MoveFrom(source IPartitionBase) error {
+	if other, ok := source.(*IColumnarPartition[T]); ok && this.Empty() {
+		this.columns, other.columns = other.columns, this.columns
+		this.elems, other.elems = other.elems, this.elems
+		return nil
+	}
+	if err := this.CopyFrom(source); err != nil {
+		return ierror.Raise(err)
+	}
+	return source.Clear()
+}
+
+func (this *IColumnarPartition[T]) MoveTo(target IPartitionBase) error {
+	return target.MoveFrom(this)
+}
+
+func (this *IColumnarPartition[T]) Size() int64 {
+	return int64(this.elems)
+}
+
+func (this *IColumnarPartition[T]) Empty() bool {
+	return this.elems == 0
+}
+
+func (this *IColumnarPartition[T]) Bytes() int64 {
+	sz := int64(0)
+	for _, field := range this.fields {
+		sz += int64(this.elems) * int64(field.size)
+	}
+	return sz
+}
+
+func (this *IColumnarPartition[T]) Clear() error {
+	this.elems = 0
+	return nil
+}
+
+func (this *IColumnarPartition[T]) Fit() error {
+	if this.elems != this.capacity() {
+		this.resize(this.elems)
+	}
+	return nil
+}
+
+func (this *IColumnarPartition[T]) Sync() error {
+	return nil
+}
+
+func (this *IColumnarPartition[T]) Type() string {
+	return IColumnarPartitionType
+}
+
+func (this *IColumnarPartition[T]) Inner() any {
+	panic(ierror.RaiseMsg("Not implemented in IColumnarPartition"))
+}
+
+func (this *IColumnarPartition[T]) Native() bool {
+	return this.native
+}
+
+func (this *IColumnarPartition[T]) Compression() int8 {
+	return 0
+}
+
+func (this *IColumnarPartition[T]) First() any {
+	if this.elems == 0 {
+		return nil
+	}
+	return this.get(0)
+}
+
+/*Names of the columns in field order*/
+func (this *IColumnarPartition[T]) Columns() []string {
+	names := make([]string, len(this.fields))
+	for c, field := range this.fields {
+		names[c] = field.name
+	}
+	return names
+}
+
+/*Keeps the elements whose flag in keep is true, every column is compacted in a single pass*/
+func (this *IColumnarPartition[T]) Filter(keep []bool) error {
+	if len(keep) != this.elems {
+		return ierror.RaiseMsg("filter mask and partition sizes differ")
+	}
+	for c, field := range this.fields {
+		column, size := this.columns[c], field.size
+		j := uintptr(0)
+		for i, ok := range keep {
+			if ok {
+				copy(column[j*size:(j+1)*size], column[uintptr(i)*size:])
+				j++
+			}
+		}
+	}
+	n := 0
+	for _, ok := range keep {
+		if ok {
+			n++
+		}
+	}
+	this.elems = n
+	return nil
+}
+
+/*
+Values of a column as a slice of C sharing the partition memory, writing the slice maps the column in place. The slice
+is invalidated when the partition grows.
+*/
+func Column[C any, T any](part *IColumnarPartition[T], name string) ([]C, error) {
+	tp := utils.TypeObj[C]()
+	for c, field := range part.fields {
+		if field.name != name {
+			continue
+		}
+		if tp.Kind() != field.kind || tp.Size() != field.size {
+			return nil, ierror.RaiseMsg("column " + name + " can not be used as " + tp.String())
+		}
+		if part.elems == 0 {
+			return []C{}, nil
+		}
+		return unsafe.Slice((*C)(unsafe.Pointer(unsafe.SliceData(part.columns[c]))), part.elems), nil
+	}
+	return nil, ierror.RaiseMsg("column " + name + " not found")
+}
+
+func (this *IColumnarPartition[T]) ReadIterator() (iterator.IReadIterator[T], error) {
+	return &iColumnarReadIterator[T]{0, this}, nil
+}
+
+func (this *IColumnarPartition[T]) WriteIterator() (iterator.IWriteIterator[T], error) {
+	return &iColumnarWriteIterator[T]{this}, nil
+}
+
+type iColumnarReadIterator[T any] struct {
+	i    int
+	part *IColumnarPartition[T]
+}
+
+func (this *iColumnarReadIterator[T]) HasNext() bool {
+	return this.i < this.part.elems
+}
+
+func (this *iColumnarReadIterator[T]) Next() (T, error) {
+	elem := this.part.get(this.i)
+	this.i++
+	return elem, nil
+}
+
+type iColumnarWriteIterator[T any] struct {
+	part *IColumnarPartition[T]
+}
+
+func (this *iColumnarWriteIterator[T]) Write(v T) error {
+	this.part.add(&v)
+	return nil
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+	"ignis/executor/core/itransport"
+	"math/rand"
+	"testing"
+)
+
+type columnarTestRow struct {
+	Id    int32
+	Score float64
+	Valid bool
+}
+
+func init() {
+	addPartitionTest(&IPartitionTest[int64]{
+		"IColumnarPartitionInt64Test",
+		func() IPartition[int64] {
+			part, err := NewIColumnarPartition[int64](10, false)
+			if err != nil {
+				panic(err)
+			}
+			return part
+		},
+		func(n int, seed int) []int64 {
+			array := make([]int64, n)
+			rand.Seed(int64(seed))
+			for i := 0; i < n; i++ {
+				array[i] = rand.Int63() % int64(n)
+			}
+			return array
+		},
+	})
+}
+
+func columnarTestRows(n int) []columnarTestRow {
+	rows := make([]columnarTestRow, n)
+	for i := range rows {
+		rows[i] = columnarTestRow{int32(i), float64(i) / 2, i%3 == 0}
+	}
+	return rows
+}
+
+func TestColumnarStruct(t *testing.T) {
+	require.False(t, IsColumnarType[string]())
+	require.False(t, IsColumnarType[arrowTestRow]())
+	require.True(t, IsColumnarType[columnarTestRow]())
+
+	rows := columnarTestRows(100)
+	part, err := NewIColumnarPartition[columnarTestRow](0, false)
+	require.Nil(t, err)
+	it, err := part.WriteIterator()
+	require.Nil(t, err)
+	for _, row := range rows {
+		require.Nil(t, it.Write(row))
+	}
+	require.Nil(t, part.Fit())
+	require.Equal(t, []string{"Id", "Score", "Valid"}, part.Columns())
+	require.Equal(t, int64(100*(4+8+1)), part.Bytes())
+	require.Equal(t, rows, part.Array())
+
+	scores, err := Column[float64](part, "Score")
+	require.Nil(t, err)
+	for i := range scores {
+		scores[i] *= 2
+	}
+	_, err = Column[int64](part, "Id")
+	require.NotNil(t, err)
+
+	valid, err := Column[bool](part, "Valid")
+	require.Nil(t, err)
+	require.Nil(t, part.Filter(append([]bool{}, valid...)))
+	require.Equal(t, int64(34), part.Size())
+	require.Equal(t, columnarTestRow{0, 0, true}, part.First())
+	require.Equal(t, columnarTestRow{3, 3, true}, part.Array()[1])
+}
+
+func TestColumnarArrow(t *testing.T) {
+	rows := columnarTestRows(50)
+	part, err := NewIColumnarPartition[columnarTestRow](0, false)
+	require.Nil(t, err)
+	part.addArray(rows)
+
+	buffer := itransport.NewIMemoryBuffer()
+	require.Nil(t, WriteArrow[columnarTestRow](part, buffer, 6))
+	memory := NewIMemoryPartition[columnarTestRow](0, false)
+	require.Nil(t, memory.Read(buffer))
+	require.Equal(t, rows, memory.Inner().(IList).Array())
+
+	buffer = itransport.NewIMemoryBuffer()
+	require.Nil(t, WriteArrow[columnarTestRow](memory, buffer, 0))
+	other, err := NewIColumnarPartition[columnarTestRow](0, false)
+	require.Nil(t, err)
+	require.Nil(t, other.Read(buffer))
+	require.Equal(t, rows, other.Array())
+}
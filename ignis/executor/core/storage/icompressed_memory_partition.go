@@ -0,0 +1,299 @@
+package storage
+
+import (
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/api/iterator"
+	"ignis/executor/core/ierror"
+	"ignis/executor/core/itransport"
+)
+
+const ICompressedMemoryPartitionType = "CompressedMemory"
+
+type iCompressedBlock struct {
+	data  []byte
+	raw   int
+	elems int64
+}
+
+/*Keeps the elements serialized in compressed blocks, only the block being iterated is decompressed*/
+type ICompressedMemoryPartition[T any] struct {
+	blocks      []iCompressedBlock
+	pending     *IMemoryPartition[T]
+	elems       int64
+	blockSize   int64
+	codec       itransport.ICodec
+	compression int8
+	native      bool
+}
+
+func NewICompressedMemoryPartition[T any](blockSize int64, codec itransport.ICodec, compression int8, native bool) *ICompressedMemoryPartition[T] {
+	if blockSize <= 0 {
+		blockSize = 1
+	}
+	return &ICompressedMemoryPartition[T]{
+		pending:     NewIMemoryPartition[T](blockSize, native),
+		blockSize:   blockSize,
+		codec:       codec,
+		compression: compression,
+		native:      native,
+	}
+}
+
+func (this *ICompressedMemoryPartition[T]) flush() error {
+	if this.pending.Empty() {
+		return nil
+	}
+	buffer := itransport.NewIPooledMemoryBuffer(itransport.MemoryDefaultSize)
+	defer buffer.Release()
+	if err := this.pending.WriteWithNative(buffer, 0, this.native); err != nil {
+		return ierror.Raise(err)
+	}
+	raw := buffer.Bytes()
+	data, err := this.codec.Compress(nil, raw)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	this.blocks = append(this.blocks, iCompressedBlock{data, len(raw), this.pending.Size()})
+	this.elems += this.pending.Size()
+	return this.pending.Clear()
+}
+
+func (this *ICompressedMemoryPartition[T]) block(block iCompressedBlock) (*IMemoryPartition[T], error) {
+	raw := itransport.GetBuffer(block.raw)
+	defer itransport.PutBuffer(raw)
+	if err := this.codec.Decompress(raw, block.data); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	part := NewIMemoryPartition[T](block.elems, this.native)
+	if err := part.Read(itransport.NewIMemoryBufferWrapper(raw, int64(len(raw)), itransport.OBSERVE)); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return part, nil
+}
+
+func (this *ICompressedMemoryPartition[T]) decompress() (*IMemoryPartition[T], error) {
+	part := NewIMemoryPartition[T](this.Size(), this.native)
+	for _, compressed := range this.blocks {
+		block, err := this.block(compressed)
+		if err != nil {
+			return nil, ierror.Raise(err)
+		}
+		if err = part.MoveFrom(block); err != nil {
+			return nil, ierror.Raise(err)
+		}
+	}
+	if err := part.CopyFrom(this.pending); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return part, nil
+}
+
+func (this *ICompressedMemoryPartition[T]) Read(transport thrift.TTransport) error {
+	if err := this.pending.Read(transport); err != nil {
+		return ierror.Raise(err)
+	}
+	if this.pending.Size() >= this.blockSize {
+		return this.flush()
+	}
+	return nil
+}
+
+func (this *ICompressedMemoryPartition[T]) Write(transport thrift.TTransport, compression int8) error {
+	return this.WriteWithNative(transport, compression, this.native)
+}
+
+func (this *ICompressedMemoryPartition[T]) WriteWithNative(transport thrift.TTransport, compression int8, native bool) error {
+	part, err := this.decompress()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	return part.WriteWithNative(transport, compression, native)
+}
+
+func (this *ICompressedMemoryPartition[T]) Clone() (IPartitionBase, error) {
+	pending, err := this.pending.Clone()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	other := *this
+	other.blocks = append([]iCompressedBlock{}, this.blocks...)
+	other.pending = pending.(*IMemoryPartition[T])
+	return &other, nil
+}
+
+func (this *ICompressedMemoryPartition[T]) CopyFrom(source IPartitionBase) error {
+	if other, ok := source.(*ICompressedMemoryPartition[T]); ok && other.codec.Name() == this.codec.Name() && other.native == this.native {
+		if err := this.flush(); err != nil {
+			return ierror.Raise(err)
+		}
+		/*Compressed blocks are never modified, they can be shared*/
+		this.blocks = append(this.blocks, other.blocks...)
+		this.elems += other.elems
+		return this.pending.CopyFrom(other.pending)
+	}
+	it, err := source.(IPartition[T]).ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	writer, err := this.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for it.HasNext() {
+		elem, err := it.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = writer.Write(elem); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
+
+func (this *ICompressedMemoryPartition[T]) CopyTo(target IPartitionBase) error {
+	return target.CopyFrom(this)
+}
+
+func (this *ICompressedMemoryPartition[T]) MoveFrom(source IPartitionBase) error {
+	if err := this.CopyFrom(source); err != nil {
+		return ierror.Raise(err)
+	}
+	return source.Clear()
+}
+
+func (this *ICompressedMemoryPartition[T]) MoveTo(target IPartitionBase) error {
+	return target.MoveFrom(this)
+}
+
+func (this *ICompressedMemoryPartition[T]) Size() int64 {
+	return this.elems + this.pending.Size()
+}
+
+func (this *ICompressedMemoryPartition[T]) Empty() bool {
+	return this.Size() == 0
+}
+
+func (this *ICompressedMemoryPartition[T]) Bytes() int64 {
+	sz := int64(0)
+	for _, block := range this.blocks {
+		sz += int64(len(block.data))
+	}
+	if !this.pending.Empty() {
+		sz += this.pending.Bytes()
+	}
+	return sz
+}
+
+func (this *ICompressedMemoryPartition[T]) Clear() error {
+	this.blocks = nil
+	this.elems = 0
+	return this.pending.Clear()
+}
+
+func (this *ICompressedMemoryPartition[T]) Fit() error {
+	if err := this.flush(); err != nil {
+		return ierror.Raise(err)
+	}
+	return this.pending.Fit()
+}
+
+func (this *ICompressedMemoryPartition[T]) Sync() error {
+	return nil
+}
+
+func (this *ICompressedMemoryPartition[T]) Type() string {
+	return ICompressedMemoryPartitionType
+}
+
+func (this *ICompressedMemoryPartition[T]) Inner() any {
+	panic(ierror.RaiseMsg("Not implemented in ICompressedMemoryPartition"))
+}
+
+func (this *ICompressedMemoryPartition[T]) Native() bool {
+	return this.native
+}
+
+func (this *ICompressedMemoryPartition[T]) Compression() int8 {
+	return this.compression
+}
+
+func (this *ICompressedMemoryPartition[T]) First() any {
+	if len(this.blocks) == 0 {
+		return this.pending.First()
+	}
+	block, err := this.block(this.blocks[0])
+	if err != nil {
+		return nil
+	}
+	return block.First()
+}
+
+func (this *ICompressedMemoryPartition[T]) ReadIterator() (iterator.IReadIterator[T], error) {
+	it, err := this.pending.ReadIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	if len(this.blocks) == 0 {
+		return it, nil
+	}
+	return &iCompressedReadIterator[T]{this, this.blocks, nil, it}, nil
+}
+
+func (this *ICompressedMemoryPartition[T]) WriteIterator() (iterator.IWriteIterator[T], error) {
+	it, err := this.pending.WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return &iCompressedWriteIterator[T]{this, it}, nil
+}
+
+type iCompressedReadIterator[T any] struct {
+	part    *ICompressedMemoryPartition[T]
+	blocks  []iCompressedBlock
+	current iterator.IReadIterator[T]
+	pending iterator.IReadIterator[T]
+}
+
+func (this *iCompressedReadIterator[T]) HasNext() bool {
+	return (this.current != nil && this.current.HasNext()) || len(this.blocks) > 0 || this.pending.HasNext()
+}
+
+func (this *iCompressedReadIterator[T]) Next() (T, error) {
+	for this.current == nil || !this.current.HasNext() {
+		if len(this.blocks) == 0 {
+			return this.pending.Next()
+		}
+		block, err := this.part.block(this.blocks[0])
+		this.blocks = this.blocks[1:]
+		if err != nil {
+			var zero T
+			return zero, ierror.Raise(err)
+		}
+		if this.current, err = block.ReadIterator(); err != nil {
+			var zero T
+			return zero, ierror.Raise(err)
+		}
+	}
+	return this.current.Next()
+}
+
+type iCompressedWriteIterator[T any] struct {
+	part *ICompressedMemoryPartition[T]
+	it   iterator.IWriteIterator[T]
+}
+
+func (this *iCompressedWriteIterator[T]) Write(v T) (err error) {
+	if err = this.it.Write(v); err != nil {
+		return ierror.Raise(err)
+	}
+	if this.part.pending.Size() >= this.part.blockSize {
+		if err = this.part.flush(); err != nil {
+			return ierror.Raise(err)
+		}
+		if this.it, err = this.part.pending.WriteIterator(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
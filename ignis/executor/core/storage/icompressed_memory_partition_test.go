@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+	"ignis/executor/core/itransport"
+	"testing"
+)
+
+func init() {
+	addPartitionTest(&IPartitionTest[int64]{
+		"ICompressedMemoryPartitionInt64Test",
+		func() IPartition[int64] {
+			codec, err := itransport.NewICodec("lz4", 0)
+			if err != nil {
+				panic(err)
+			}
+			return NewICompressedMemoryPartition[int64](32, codec, 6, false)
+		},
+		spillElements,
+	})
+	addPartitionTest(&IPartitionTest[int64]{
+		"ICompressedMemoryPartitionZlibInt64Test",
+		func() IPartition[int64] {
+			codec, err := itransport.NewICodec("zlib", 6)
+			if err != nil {
+				panic(err)
+			}
+			return NewICompressedMemoryPartition[int64](1000, codec, 6, false)
+		},
+		spillElements,
+	})
+}
+
+func TestCompressedMemoryBytes(t *testing.T) {
+	codec, err := itransport.NewICodec("lz4", 0)
+	require.Nil(t, err)
+	part := NewICompressedMemoryPartition[int64](1024, codec, 6, false)
+	it, err := part.WriteIterator()
+	require.Nil(t, err)
+	for i := 0; i < 10000; i++ {
+		require.Nil(t, it.Write(int64(i%16)))
+	}
+	require.Nil(t, part.Fit())
+	require.Equal(t, int64(10000), part.Size())
+	require.Less(t, part.Bytes(), int64(10000*8/4))
+	require.Equal(t, int64(0), part.First())
+
+	memory := NewIMemoryPartition[int64](0, false)
+	require.Nil(t, part.CopyTo(memory))
+	require.Equal(t, int64(10000), memory.Size())
+	require.Equal(t, int64(9999%16), memory.Inner().(*IListImpl[int64]).Get(9999))
+}
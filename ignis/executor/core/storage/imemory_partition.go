@@ -6,6 +6,7 @@ import (
 	"ignis/executor/api/ipair"
 	"ignis/executor/api/iterator"
 	"ignis/executor/core/ierror"
+	"ignis/executor/core/iio"
 	"ignis/executor/core/iprotocol"
 	"ignis/executor/core/itransport"
 	"ignis/executor/core/utils"
@@ -17,15 +18,21 @@ const IMemoryPartitionType = "Memory"
 type IMemoryPartition[T any] struct {
 	elems  IList
 	native bool
+	chunk  int64
 }
 
 func NewIMemoryPartition[T any](sz int64, native bool) *IMemoryPartition[T] {
 	return &IMemoryPartition[T]{
-		NewIList[T](int(sz)),
-		native,
+		elems:  NewIList[T](int(sz)),
+		native: native,
 	}
 }
 
+/*Fit splits the partition in chunks of bytes when it is larger, so it never needs a single big allocation*/
+func (this *IMemoryPartition[T]) SetChunk(bytes int64) {
+	this.chunk = bytes
+}
+
 func ConvIMemoryPartition[T any](other IPartitionBase) IPartitionBase {
 	t := utils.TypeObj[T]()
 	if ipair.IsPairType(t) {
@@ -49,15 +56,14 @@ func ConvIMemoryPartition[T any](other IPartitionBase) IPartitionBase {
 		return part
 	}
 	return &IMemoryPartition[T]{
-		other.Inner().(IList),
-		other.Native(),
+		elems:  other.Inner().(IList),
+		native: other.Native(),
 	}
 }
 
 func NewIMemoryPartitionArray[T any](array []T) *IMemoryPartition[T] {
 	return &IMemoryPartition[T]{
-		NewIListArray(array),
-		false,
+		elems: NewIListArray(array),
 	}
 }
 
@@ -71,6 +77,14 @@ func (this *IMemoryPartition[T]) Read(transport thrift.TTransport) error {
 	if err != nil {
 		return ierror.Raise(err)
 	}
+	if table, ok := elems.(*iio.IArrowTable); ok {
+		if elems, err = table.Array(utils.TypeObj[T]()); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	if elems, err = iio.ReadTyped[T](elems); err != nil {
+		return ierror.Raise(err)
+	}
 	if this.Size() == 0 && reflect.TypeOf((*T)(nil)).Elem().Kind() == reflect.Interface {
 		if constructor := registryList[reflect.TypeOf(elems).Elem().String()]; constructor != nil {
 			this.elems = constructor((this.elems).Cap())
@@ -92,7 +106,18 @@ func (this *IMemoryPartition[T]) WriteWithNative(transport thrift.TTransport, co
 		return ierror.Raise(err)
 	}
 	proto := iprotocol.NewIObjectProtocol(zlibTrans)
-	err = proto.WriteObjectWithNative(this.elems.Array(), native)
+	if chunked, ok := this.elems.(*IChunkedList[T]); ok {
+		if err = proto.WriteSerialization(native); err != nil {
+			return ierror.Raise(err)
+		}
+		if native {
+			err = iio.WriteNativeChunks(proto, chunked.Chunks())
+		} else {
+			err = iio.WriteChunks(proto, chunked.Chunks())
+		}
+	} else {
+		err = proto.WriteObjectWithNative(this.elems.Array(), native)
+	}
 	if err != nil {
 		return ierror.Raise(err)
 	}
@@ -105,13 +130,22 @@ func (this *IMemoryPartition[T]) WriteWithNative(transport thrift.TTransport, co
 
 func (this *IMemoryPartition[T]) Clone() (IPartitionBase, error) {
 	return &IMemoryPartition[T]{
-		this.elems.Copy(),
-		this.native,
+		elems:  this.elems.Copy(),
+		native: this.native,
+		chunk:  this.chunk,
 	}, nil
 }
 
 func (this *IMemoryPartition[T]) CopyFrom(source IPartitionBase) error {
 	if men, ok := source.(*IMemoryPartition[T]); ok {
+		if chunked, ok := men.elems.(*IChunkedList[T]); ok {
+			for _, chunk := range chunked.Chunks() {
+				if err := this.elems.Merge(chunk); err != nil {
+					return ierror.Raise(err)
+				}
+			}
+			return nil
+		}
 		return this.elems.Merge(men.elems.Array())
 	} else {
 		other := source.(IPartition[T])
@@ -127,7 +161,7 @@ func (this *IMemoryPartition[T]) CopyFrom(source IPartitionBase) error {
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				elem_impl.Set(offset, elem)
+				elem_impl.Set(offset+i, elem)
 			}
 		} else {
 			for i := 0; i < int(other.Size()); i++ {
@@ -135,7 +169,7 @@ func (this *IMemoryPartition[T]) CopyFrom(source IPartitionBase) error {
 				if err != nil {
 					return ierror.Raise(err)
 				}
-				elem_impl.SetAny(offset, elem)
+				this.elems.SetAny(offset+i, elem)
 			}
 		}
 	}
@@ -172,7 +206,10 @@ func (this *IMemoryPartition[T]) Empty() bool {
 }
 
 func (this *IMemoryPartition[T]) Bytes() int64 {
-	value := reflect.TypeOf(this.elems.Array())
+	value := reflect.TypeOf([]T(nil))
+	if _, ok := this.elems.(*IChunkedList[T]); !ok {
+		value = reflect.TypeOf(this.elems.Array())
+	}
 	sz := this.Size()
 
 	switch value.Elem().Kind() {
@@ -193,6 +230,12 @@ func (this *IMemoryPartition[T]) Clear() error {
 }
 
 func (this *IMemoryPartition[T]) Fit() error {
+	if elemSz := int64(utils.TypeObj[T]().Size()); this.chunk > 0 && elemSz > 0 && this.Size()*elemSz > this.chunk {
+		if list, ok := this.elems.(*IListImpl[T]); ok {
+			this.elems = NewIChunkedList[T](list.array[:list.pos], int(utils.Max(this.chunk/elemSz, 1)))
+			return nil
+		}
+	}
 	this.elems.Resize(this.elems.Size(), true)
 	return nil
 }
@@ -225,6 +268,9 @@ func (this *IMemoryPartition[T]) ReadIterator() (iterator.IReadIterator[T], erro
 	if elemsT, ok := this.elems.(*IListImpl[T]); ok {
 		return &iMemoryReadIterator[T]{0, int(this.Size()), elemsT}, nil
 	}
+	if chunked, ok := this.elems.(*IChunkedList[T]); ok {
+		return &iChunkedReadIterator[T]{chunked.Chunks(), 0}, nil
+	}
 	return &iMemoryAnyReadIterator[T]{0, int(this.Size()), this.elems}, nil
 }
 
@@ -233,6 +279,9 @@ func (this *IMemoryPartition[T]) WriteIterator() (iterator.IWriteIterator[T], er
 		(this.Size() > 0 || reflect.TypeOf((*T)(nil)).Elem().Kind() != reflect.Interface) {
 		return &iMemoryWriteIterator[T]{elemsT}, nil
 	}
+	if chunked, ok := this.elems.(*IChunkedList[T]); ok {
+		return &iChunkedWriteIterator[T]{chunked}, nil
+	}
 	return &iMemoryAnyWriteIterator[T]{false, &this.elems}, nil
 }
 
@@ -293,6 +342,33 @@ func (this *iMemoryAnyWriteIterator[T]) Write(v T) error {
 	return nil
 }
 
+type iChunkedReadIterator[T any] struct {
+	chunks [][]T
+	i      int
+}
+
+func (this *iChunkedReadIterator[T]) HasNext() bool {
+	return len(this.chunks) > 0
+}
+
+func (this *iChunkedReadIterator[T]) Next() (t T, err error) {
+	t = this.chunks[0][this.i]
+	if this.i++; this.i == len(this.chunks[0]) {
+		this.chunks = this.chunks[1:]
+		this.i = 0
+	}
+	return
+}
+
+type iChunkedWriteIterator[T any] struct {
+	elems *IChunkedList[T]
+}
+
+func (this *iChunkedWriteIterator[T]) Write(v T) error {
+	this.elems.Add(v)
+	return nil
+}
+
 //List impl
 
 var registryList = map[string]func(int) IList{}
@@ -433,3 +509,158 @@ func (this *IListImpl[T]) Add(value T) {
 	this.array[this.pos] = value
 	this.pos++
 }
+
+/*List stored in chunks of n elements, every chunk except the last one is full*/
+type IChunkedList[T any] struct {
+	chunks [][]T
+	n      int
+	size   int
+}
+
+func NewIChunkedList[T any](array []T, n int) *IChunkedList[T] {
+	this := &IChunkedList[T]{n: n}
+	for len(array) > 0 {
+		chunk := make([]T, utils.Min(n, len(array)))
+		copy(chunk, array)
+		this.chunks = append(this.chunks, chunk)
+		array = array[len(chunk):]
+		this.size += len(chunk)
+	}
+	return this
+}
+
+/*Elements of every chunk, the last chunk is cut to the list size*/
+func (this *IChunkedList[T]) Chunks() [][]T {
+	if this.size == 0 {
+		return nil
+	}
+	last := (this.size - 1) / this.n
+	chunks := append([][]T{}, this.chunks[:last+1]...)
+	chunks[last] = chunks[last][:this.size-last*this.n]
+	return chunks
+}
+
+/*The chunks are concatenated in a new array*/
+func (this *IChunkedList[T]) Array() any {
+	if len(this.chunks) == 1 {
+		return this.chunks[0][:this.size]
+	}
+	array := make([]T, 0, this.size)
+	for _, chunk := range this.Chunks() {
+		array = append(array, chunk...)
+	}
+	return array
+}
+
+/*
+Array that shares the memory of the list, so writing to it changes the list. False if the list has no such array,
+the Array of a list split in several chunks is only a copy.
+*/
+func SharedArray[T any](list IList) ([]T, bool) {
+	if chunked, ok := list.(*IChunkedList[T]); ok && len(chunked.chunks) > 1 {
+		return nil, false
+	}
+	array, ok := list.Array().([]T)
+	return array, ok
+}
+
+func (this *IChunkedList[T]) Size() int {
+	return this.size
+}
+
+func (this *IChunkedList[T]) Cap() int {
+	if len(this.chunks) == 0 {
+		return 0
+	}
+	return (len(this.chunks)-1)*this.n + len(this.chunks[len(this.chunks)-1])
+}
+
+func (this *IChunkedList[T]) Copy() IList {
+	other := &IChunkedList[T]{n: this.n, size: this.size}
+	for _, chunk := range this.Chunks() {
+		other.chunks = append(other.chunks, append([]T{}, chunk...))
+	}
+	return other
+}
+
+func (this *IChunkedList[T]) Resize(sz int, shrink bool) {
+	this.Reserve(sz)
+	if shrink {
+		used := (sz + this.n - 1) / this.n
+		this.chunks = this.chunks[:used]
+		if last := sz - (used-1)*this.n; used > 0 && last < len(this.chunks[used-1]) {
+			this.chunks[used-1] = append([]T{}, this.chunks[used-1][:last]...)
+		}
+	}
+	this.size = sz
+}
+
+func (this *IChunkedList[T]) Reserve(sz int) {
+	for this.Cap() < sz {
+		if last := len(this.chunks) - 1; last >= 0 && len(this.chunks[last]) < this.n {
+			chunk := make([]T, this.n)
+			copy(chunk, this.chunks[last])
+			this.chunks[last] = chunk
+		} else {
+			this.chunks = append(this.chunks, make([]T, this.n))
+		}
+	}
+}
+
+func (this *IChunkedList[T]) Insert(i int) {
+	this.Add(this.Get(0))
+	for j := this.size - 1; j > i; j-- {
+		this.Set(j, this.Get(j-1))
+	}
+}
+
+func (this *IChunkedList[T]) GetAny(i int) any {
+	return this.chunks[i/this.n][i%this.n]
+}
+
+func (this *IChunkedList[T]) Get(i int) T {
+	return this.chunks[i/this.n][i%this.n]
+}
+
+func (this *IChunkedList[T]) SetAny(i int, value any) {
+	this.chunks[i/this.n][i%this.n] = value.(T)
+}
+
+func (this *IChunkedList[T]) Set(i int, value T) {
+	this.chunks[i/this.n][i%this.n] = value
+}
+
+func (this *IChunkedList[T]) AddAny(value any) {
+	this.Add(value.(T))
+}
+
+func (this *IChunkedList[T]) Merge(array any) error {
+	if src, ok := array.([]T); ok {
+		this.Reserve(this.size + len(src))
+		for len(src) > 0 {
+			n := copy(this.chunks[this.size/this.n][this.size%this.n:], src)
+			src = src[n:]
+			this.size += n
+		}
+	} else if src, ok := array.([]any); ok {
+		this.Reserve(this.size + len(src))
+		for _, elem := range src {
+			this.Add(elem.(T))
+		}
+	} else {
+		src := reflect.ValueOf(array)
+		this.Reserve(this.size + src.Len())
+		for i := 0; i < src.Len(); i++ {
+			this.Add(src.Index(i).Interface().(T))
+		}
+	}
+	return nil
+}
+
+func (this *IChunkedList[T]) Add(value T) {
+	if this.size == this.Cap() {
+		this.Reserve(this.size + 1)
+	}
+	this.chunks[this.size/this.n][this.size%this.n] = value
+	this.size++
+}
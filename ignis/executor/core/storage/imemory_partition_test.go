@@ -1,9 +1,16 @@
 package storage
 
 import (
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/stretchr/testify/require"
+	"ignis/executor/api/idecimal"
+	"ignis/executor/api/iuuid"
 	"ignis/executor/core/iio"
+	"math/big"
 	"math/rand"
 	"strconv"
+	"testing"
+	"time"
 )
 
 func init() {
@@ -53,3 +60,86 @@ func init() {
 		},
 	})
 }
+
+func TestMemoryPartitionChunks(t *testing.T) {
+	elems := make([]int64, 1000)
+	for i := range elems {
+		elems[i] = int64(i * 7)
+	}
+	part := NewIMemoryPartitionArray(append([]int64{}, elems...))
+	part.SetChunk(8 * 64)
+	require.Nil(t, part.Fit())
+	chunked, ok := part.Inner().(*IChunkedList[int64])
+	require.True(t, ok)
+	require.Equal(t, 16, len(chunked.Chunks()))
+	require.Equal(t, elems, chunked.Array())
+	_, shared := SharedArray[int64](chunked)
+	require.False(t, shared)
+
+	it, err := part.WriteIterator()
+	require.Nil(t, err)
+	require.Nil(t, it.Write(-1))
+	elems = append(elems, -1)
+
+	for _, native := range []bool{false, true} {
+		buffer := thrift.NewTMemoryBuffer()
+		require.Nil(t, part.WriteWithNative(buffer, 0, native))
+		other := NewIMemoryPartition[int64](0, false)
+		require.Nil(t, other.Read(buffer))
+		require.Equal(t, elems, other.Inner().(IList).Array())
+	}
+
+	clone, err := part.Clone()
+	require.Nil(t, err)
+	memory := NewIMemoryPartition[int64](0, false)
+	require.Nil(t, clone.MoveTo(memory))
+	require.Equal(t, elems, memory.Inner().(IList).Array())
+}
+
+func roundTrip[T any](t *testing.T, elems []T) []T {
+	part := NewIMemoryPartitionArray(append([]T{}, elems...))
+	buffer := thrift.NewTMemoryBuffer()
+	require.Nil(t, part.WriteWithNative(buffer, 0, false))
+	other := NewIMemoryPartition[T](0, false)
+	require.Nil(t, other.Read(buffer))
+	return other.Inner().(IList).Array().([]T)
+}
+
+func TestMemoryPartitionBig(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	ints := roundTrip(t, []big.Int{*huge, *big.NewInt(7)})
+	require.Equal(t, 0, huge.Cmp(&ints[0]))
+	require.Equal(t, 0, big.NewInt(7).Cmp(&ints[1]))
+
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	floats := roundTrip(t, []big.Float{*third})
+	require.Equal(t, 0, third.Cmp(new(big.Float).SetPrec(third.Prec()).Set(&floats[0])))
+
+	rats := roundTrip(t, []big.Rat{*big.NewRat(-2, 6)})
+	require.Equal(t, 0, big.NewRat(-1, 3).Cmp(&rats[0]))
+
+	decimals := []idecimal.IDecimal{{Unscaled: 10, Scale: 1}, {Unscaled: -314, Scale: 2}}
+	require.Equal(t, decimals, roundTrip(t, decimals))
+}
+
+func TestMemoryPartitionTime(t *testing.T) {
+	zone := time.FixedZone("", 2*3600)
+	times := []time.Time{time.Date(2020, 2, 29, 23, 59, 59, 123456789, zone), time.Unix(0, 0).UTC()}
+	read := roundTrip(t, times)
+	for i := range times {
+		require.True(t, times[i].Equal(read[i]))
+		_, expected := times[i].Zone()
+		_, offset := read[i].Zone()
+		require.Equal(t, expected, offset)
+	}
+
+	durations := []time.Duration{time.Hour, -time.Nanosecond}
+	require.Equal(t, durations, roundTrip(t, durations))
+}
+
+func TestMemoryPartitionUUID(t *testing.T) {
+	id, err := iuuid.New()
+	require.Nil(t, err)
+	ids := []iuuid.IUUID{id, {}}
+	require.Equal(t, ids, roundTrip(t, ids))
+}
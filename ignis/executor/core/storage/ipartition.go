@@ -47,6 +47,7 @@ type IPartitionGroupBase interface {
 	ShadowCopyBase() IPartitionGroupBase
 	Cache() bool
 	SetCache(e bool)
+	Sorted() bool
 	First() any
 	Sync() error
 	Type() reflect.Type
@@ -54,11 +55,15 @@ type IPartitionGroupBase interface {
 	AddMemoryPartition(sz int64)
 	AddRawMemoryPartition(bytes int64, compression int8, native bool) error
 	AddDiskPartition(path string, compression int8, native bool) error
+	AddSpillPartition(sz int64, threshold int64, compression int8, native bool, path func() (string, error))
+	Spill() error
 }
 
 type IPartitionGroup[T any] struct {
 	partitions []IPartition[T]
 	_cache     bool
+	_sorted    func(a, b T) bool
+	dirty      []bool
 }
 
 func NewIPartitionGroup[T any]() *IPartitionGroup[T] {
@@ -90,6 +95,9 @@ func (this *IPartitionGroup[T]) GetBase(index int) IPartitionBase {
 
 func (this *IPartitionGroup[T]) Remove(index int) {
 	this.partitions = append(this.partitions[:index], this.partitions[index+1:]...)
+	if index < len(this.dirty) {
+		this.dirty = append(this.dirty[:index], this.dirty[index+1:]...)
+	}
 }
 
 func (this *IPartitionGroup[T]) Iter() []IPartition[T] {
@@ -114,6 +122,7 @@ func (this *IPartitionGroup[T]) AddBase(part IPartitionBase) {
 
 func (this *IPartitionGroup[T]) Clear() {
 	this.partitions = make([]IPartition[T], 0, 10)
+	this.dirty = nil
 }
 
 func (this *IPartitionGroup[T]) Clone() (*IPartitionGroup[T], error) {
@@ -125,6 +134,8 @@ func (this *IPartitionGroup[T]) Clone() (*IPartitionGroup[T], error) {
 		}
 		group.Add(other.(IPartition[T]))
 	}
+	group._sorted = this._sorted
+	group.dirty = append([]bool(nil), this.dirty...)
 	return group, nil
 }
 
@@ -137,6 +148,8 @@ func (this *IPartitionGroup[T]) ShadowCopy() *IPartitionGroup[T] {
 	for _, p := range this.partitions {
 		group.Add(p)
 	}
+	group._sorted = this._sorted
+	group.dirty = append([]bool(nil), this.dirty...)
 	return group
 }
 
@@ -152,6 +165,37 @@ func (this *IPartitionGroup[T]) SetCache(e bool) {
 	this._cache = e
 }
 
+/*Every partition is sorted by the less function of SortedBy*/
+func (this *IPartitionGroup[T]) Sorted() bool {
+	return this._sorted != nil
+}
+
+func (this *IPartitionGroup[T]) SortedBy() func(a, b T) bool {
+	return this._sorted
+}
+
+/*A nil less function marks the partitions as unsorted*/
+func (this *IPartitionGroup[T]) SetSorted(less func(a, b T) bool) {
+	this._sorted = less
+}
+
+/*A partition is dirty unless it was marked as unchanged since the previous iteration*/
+func (this *IPartitionGroup[T]) Dirty(index int) bool {
+	return index >= len(this.dirty) || this.dirty[index]
+}
+
+func (this *IPartitionGroup[T]) SetDirty(index int, dirty bool) {
+	for len(this.dirty) <= index {
+		this.dirty = append(this.dirty, true)
+	}
+	this.dirty[index] = dirty
+}
+
+/*Marks every partition as dirty*/
+func (this *IPartitionGroup[T]) ResetDirty() {
+	this.dirty = nil
+}
+
 func (this *IPartitionGroup[T]) First() any {
 	for _, part := range this.partitions {
 		if !part.Empty() {
@@ -194,6 +238,21 @@ func (this *IPartitionGroup[T]) AddDiskPartition(path string, compression int8,
 	return err
 }
 
+func (this *IPartitionGroup[T]) AddSpillPartition(sz int64, threshold int64, compression int8, native bool, path func() (string, error)) {
+	this.Add(NewISpillPartition[T](sz, threshold, compression, native, path))
+}
+
+func (this *IPartitionGroup[T]) Spill() error {
+	for _, part := range this.partitions {
+		if spill, ok := part.(*ISpillPartition[T]); ok {
+			if err := spill.Spill(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func Copy[T any](rit iterator.IReadIterator[T], wit iterator.IWriteIterator[T]) error {
 	for elem, err := rit.Next(); rit.HasNext(); elem, err = rit.Next() {
 		if err != nil {
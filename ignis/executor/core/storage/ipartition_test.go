@@ -90,6 +90,23 @@ func TestMove(t *testing.T) {
 	executeTest(t, (IPartitionTestAbs).TestMove)
 }
 
+func TestGroupDirty(t *testing.T) {
+	group := NewIPartitionGroup[int64]()
+	for i := 0; i < 3; i++ {
+		group.Add(NewIMemoryPartition[int64](10, false))
+	}
+	require.True(t, group.Dirty(1))
+	group.SetDirty(1, false)
+	require.True(t, group.Dirty(0))
+	require.False(t, group.Dirty(1))
+	require.True(t, group.Dirty(2))
+	require.False(t, group.ShadowCopy().Dirty(1))
+	group.Remove(0)
+	require.False(t, group.Dirty(0))
+	group.ResetDirty()
+	require.True(t, group.Dirty(0))
+}
+
 type IPartitionTest[T any] struct {
 	name    string
 	create  func() IPartition[T]
@@ -0,0 +1,106 @@
+package storage
+
+import (
+	"ignis/executor/api/iterator"
+	"io"
+)
+
+type iPrefetchBatch[T any] struct {
+	elems []T
+	err   error
+}
+
+/*Reads blocks of elements from another iterator in a background goroutine to overlap IO and compute*/
+type IPrefetchIterator[T any] struct {
+	batches chan iPrefetchBatch[T]
+	stop    chan bool
+	current iPrefetchBatch[T]
+	pos     int
+	closed  bool
+}
+
+func NewIPrefetchIterator[T any](it iterator.IReadIterator[T], n int) *IPrefetchIterator[T] {
+	if n <= 0 {
+		n = 1
+	}
+	this := &IPrefetchIterator[T]{
+		batches: make(chan iPrefetchBatch[T], 1),
+		stop:    make(chan bool),
+	}
+	go this.prefetch(it, n)
+	return this
+}
+
+func (this *IPrefetchIterator[T]) prefetch(it iterator.IReadIterator[T], n int) {
+	defer close(this.batches)
+	for it.HasNext() {
+		batch := iPrefetchBatch[T]{elems: make([]T, 0, n)}
+		for len(batch.elems) < n && it.HasNext() {
+			elem, err := it.Next()
+			if err != nil {
+				batch.err = err
+				break
+			}
+			batch.elems = append(batch.elems, elem)
+		}
+		select {
+		case this.batches <- batch:
+		case <-this.stop:
+			return
+		}
+		if batch.err != nil {
+			return
+		}
+	}
+}
+
+func (this *IPrefetchIterator[T]) HasNext() bool {
+	for this.pos == len(this.current.elems) {
+		if this.current.err != nil {
+			return true
+		}
+		if this.closed {
+			return false
+		}
+		batch, ok := <-this.batches
+		if !ok {
+			this.closed = true
+			return false
+		}
+		this.current = batch
+		this.pos = 0
+	}
+	return true
+}
+
+func (this *IPrefetchIterator[T]) Next() (T, error) {
+	if !this.HasNext() {
+		var zero T
+		return zero, io.EOF
+	}
+	if this.pos == len(this.current.elems) {
+		var zero T
+		return zero, this.current.err
+	}
+	elem := this.current.elems[this.pos]
+	this.pos++
+	return elem, nil
+}
+
+/*Stops the background goroutine when the iterator is not read until the end*/
+func (this *IPrefetchIterator[T]) Close() error {
+	if !this.closed {
+		this.closed = true
+		close(this.stop)
+	}
+	this.current = iPrefetchBatch[T]{}
+	this.pos = 0
+	return nil
+}
+
+func CloseIterator[T any](it iterator.IReadIterator[T]) error {
+	if closer, ok := it.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPrefetchIterator(t *testing.T) {
+	part := NewIMemoryPartition[int64](100, false)
+	writer, err := part.WriteIterator()
+	require.Nil(t, err)
+	elems := spillElements(1000, 0)
+	for _, e := range elems {
+		require.Nil(t, writer.Write(e))
+	}
+
+	reader, err := part.ReadIterator()
+	require.Nil(t, err)
+	it := NewIPrefetchIterator[int64](reader, 64)
+	for _, e := range elems {
+		require.True(t, it.HasNext())
+		v, err := it.Next()
+		require.Nil(t, err)
+		require.Equal(t, e, v)
+	}
+	require.False(t, it.HasNext())
+	require.Nil(t, it.Close())
+}
+
+func TestPrefetchIteratorClose(t *testing.T) {
+	part := NewIMemoryPartition[int64](100, false)
+	writer, err := part.WriteIterator()
+	require.Nil(t, err)
+	for _, e := range spillElements(1000, 0) {
+		require.Nil(t, writer.Write(e))
+	}
+
+	reader, err := part.ReadIterator()
+	require.Nil(t, err)
+	it := NewIPrefetchIterator[int64](reader, 10)
+	_, err = it.Next()
+	require.Nil(t, err)
+	require.Nil(t, CloseIterator[int64](it))
+	require.False(t, it.HasNext())
+}
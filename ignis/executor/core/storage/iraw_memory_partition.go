@@ -10,13 +10,28 @@ import (
 
 const IRawMemoryPartitionType = "RawMemory"
 
+/*Raw memory partition whose buffer is mapped outside the Go heap, it has the type of raw memory partitions*/
+const IMappedMemoryPartitionType = "MappedMemory"
+
 type IRawMemoryPartition[T any] struct {
 	IRawPartition[T]
 	buffer *itransport.IMemoryBuffer
 }
 
 func NewIRawMemoryPartition[T any](bytes int64, compression int8, native bool) (*IRawMemoryPartition[T], error) {
-	buffer := itransport.NewIMemoryBufferWithSize(bytes + int64(HEADER))
+	return newIRawMemoryPartition[T](itransport.NewIMemoryBufferWithSize(bytes+int64(HEADER)), compression, native)
+}
+
+/*The elements are stored outside the Go heap, the memory is released with Release or when the buffer is collected*/
+func NewIMappedMemoryPartition[T any](bytes int64, compression int8, native bool) (*IRawMemoryPartition[T], error) {
+	buffer, err := itransport.NewIMappedMemoryBuffer(bytes + int64(HEADER))
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return newIRawMemoryPartition[T](buffer, compression, native)
+}
+
+func newIRawMemoryPartition[T any](buffer *itransport.IMemoryBuffer, compression int8, native bool) (*IRawMemoryPartition[T], error) {
 	this := &IRawMemoryPartition[T]{
 		buffer: buffer,
 	}
@@ -58,7 +73,11 @@ func ConvIRawMemoryPartition[T any](other IPartitionBase) (IPartitionBase, error
 }
 
 func (this *IRawMemoryPartition[T]) Clone() (IPartitionBase, error) {
-	newPartition, err := NewIRawMemoryPartition[T](this.Bytes(), this.compression, this.Native())
+	create := NewIRawMemoryPartition[T]
+	if this.buffer.Mapped() {
+		create = NewIMappedMemoryPartition[T]
+	}
+	newPartition, err := create(this.Bytes(), this.compression, this.Native())
 	if err != nil {
 		return nil, ierror.Raise(err)
 	}
@@ -88,7 +107,10 @@ func (this *IRawMemoryPartition[T]) sync_() error {
 
 func (this *IRawMemoryPartition[T]) readTransport_() (thrift.TTransport, error) {
 	init := HEADER - this.headerSize
-	rbuffer := itransport.NewIMemoryBufferWrapper(this.buffer.GetBuffer(), this.buffer.WriteEnd(), itransport.OBSERVE)
+	if err := this.buffer.Advise(true); err != nil {
+		return nil, ierror.Raise(err)
+	}
+	rbuffer := this.buffer.Observe()
 	return rbuffer, rbuffer.SetReadBuffer(int64(init))
 }
 
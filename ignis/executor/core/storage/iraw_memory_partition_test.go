@@ -100,4 +100,22 @@ func init() {
 			return array
 		},
 	})
+	addPartitionTest(&IPartitionTest[int64]{
+		"IMappedMemoryPartitionInt64Test",
+		func() IPartition[int64] {
+			part, err := NewIMappedMemoryPartition[int64](100*8, 6, false)
+			if err != nil {
+				panic(err)
+			}
+			return part
+		},
+		func(n int, seed int) []int64 {
+			array := make([]int64, n)
+			rand.Seed(int64(seed))
+			for i := 0; i < n; i++ {
+				array[i] = rand.Int63() % int64(n)
+			}
+			return array
+		},
+	})
 }
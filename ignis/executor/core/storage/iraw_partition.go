@@ -129,8 +129,20 @@ func (this *IRawPartition[T]) Read(transport thrift.TTransport) error {
 	if err != nil {
 		return ierror.Raise(err)
 	}
+	proto := iprotocol.NewIObjectProtocol(zlib_in)
+	id, err := proto.ReadProtocol()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	if id == iprotocol.ARROW_PROTOCOL {
+		return readArrow[T](this, proto)
+	}
+	native, err := proto.Serialization(id)
+	if err != nil {
+		return ierror.Raise(err)
+	}
 	current_elems := this.elems
-	compatible, reader, _, err := this.readHeader(zlib_in)
+	compatible, reader, _, err := this.readHeaderWith(proto, native)
 	if err != nil {
 		return ierror.Raise(err)
 	}
@@ -500,6 +512,10 @@ func (this *IRawPartition[T]) readHeader(transport thrift.TTransport) (bool, iio
 	if err != nil {
 		return false, nil, nil, ierror.Raise(err)
 	}
+	return this.readHeaderWith(proto, native)
+}
+
+func (this *IRawPartition[T]) readHeaderWith(proto *iprotocol.IObjectProtocol, native bool) (bool, iio.IReader, iio.IWriter, error) {
 	compatible := this.native == native
 	header, err := getHeader(*new(T), proto, native)
 	if err != nil {
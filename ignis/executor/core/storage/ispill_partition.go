@@ -0,0 +1,268 @@
+package storage
+
+import (
+	"github.com/apache/thrift/lib/go/thrift"
+	"ignis/executor/api/iterator"
+	"ignis/executor/core/ierror"
+	"runtime/metrics"
+	"sync/atomic"
+)
+
+const ISpillPartitionType = "Spill"
+
+const spillCheck = 4096
+
+var heapMetric = []metrics.Sample{{Name: "/memory/classes/heap/objects:bytes"}}
+
+func HeapBytes() int64 {
+	sample := make([]metrics.Sample, 1)
+	copy(sample, heapMetric)
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return int64(sample[0].Value.Uint64())
+}
+
+var spilledPartitions, spilledElements atomic.Int64
+
+/*Number of partitions spilled to disk and elements written by them since the executor started*/
+func SpillStats() (int64, int64) {
+	return spilledPartitions.Load(), spilledElements.Load()
+}
+
+type ISpillPartition[T any] struct {
+	memory      *IMemoryPartition[T]
+	disk        *IDiskPartition[T]
+	path        func() (string, error)
+	threshold   int64
+	compression int8
+	native      bool
+}
+
+func NewISpillPartition[T any](sz int64, threshold int64, compression int8, native bool, path func() (string, error)) *ISpillPartition[T] {
+	return &ISpillPartition[T]{
+		memory:      NewIMemoryPartition[T](sz, native),
+		path:        path,
+		threshold:   threshold,
+		compression: compression,
+		native:      native,
+	}
+}
+
+func (this *ISpillPartition[T]) current() IPartition[T] {
+	if this.disk != nil {
+		return this.disk
+	}
+	return this.memory
+}
+
+func (this *ISpillPartition[T]) Current() IPartition[T] {
+	return this.current()
+}
+
+func (this *ISpillPartition[T]) unwrap(other IPartitionBase) IPartitionBase {
+	if spill, ok := other.(*ISpillPartition[T]); ok {
+		if spill.disk != nil {
+			return &spill.disk.IRawPartition
+		}
+		return spill.memory
+	}
+	return other
+}
+
+func (this *ISpillPartition[T]) Spilled() bool {
+	return this.disk != nil
+}
+
+func (this *ISpillPartition[T]) Pressure() bool {
+	return this.threshold > 0 && HeapBytes() > this.threshold
+}
+
+func (this *ISpillPartition[T]) Spill() error {
+	if this.disk != nil {
+		return nil
+	}
+	path, err := this.path()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	disk, err := NewIDiskPartition[T](path, this.compression, this.native, false, false)
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	reader, err := this.memory.ReadIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	writer, err := disk.WriteIterator()
+	if err != nil {
+		return ierror.Raise(err)
+	}
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		if err != nil {
+			return ierror.Raise(err)
+		}
+		if err = writer.Write(elem); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	spilledPartitions.Add(1)
+	spilledElements.Add(this.memory.Size())
+	this.disk = disk
+	this.memory = nil
+	return nil
+}
+
+func (this *ISpillPartition[T]) spillIfPressure() error {
+	if this.disk == nil && this.Pressure() {
+		return this.Spill()
+	}
+	return nil
+}
+
+func (this *ISpillPartition[T]) Read(transport thrift.TTransport) error {
+	if err := this.current().Read(transport); err != nil {
+		return ierror.Raise(err)
+	}
+	return this.spillIfPressure()
+}
+
+func (this *ISpillPartition[T]) Write(transport thrift.TTransport, compression int8) error {
+	return this.current().Write(transport, compression)
+}
+
+func (this *ISpillPartition[T]) WriteWithNative(transport thrift.TTransport, compression int8, native bool) error {
+	return this.current().WriteWithNative(transport, compression, native)
+}
+
+func (this *ISpillPartition[T]) Clone() (IPartitionBase, error) {
+	inner, err := this.current().Clone()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	other := &ISpillPartition[T]{
+		path:        this.path,
+		threshold:   this.threshold,
+		compression: this.compression,
+		native:      this.native,
+	}
+	if this.disk != nil {
+		other.disk = inner.(*IDiskPartition[T])
+	} else {
+		other.memory = inner.(*IMemoryPartition[T])
+	}
+	return other, nil
+}
+
+func (this *ISpillPartition[T]) CopyFrom(source IPartitionBase) error {
+	if err := this.current().CopyFrom(this.unwrap(source)); err != nil {
+		return ierror.Raise(err)
+	}
+	return this.spillIfPressure()
+}
+
+func (this *ISpillPartition[T]) CopyTo(target IPartitionBase) error {
+	return target.CopyFrom(this)
+}
+
+func (this *ISpillPartition[T]) MoveFrom(source IPartitionBase) error {
+	if err := this.current().MoveFrom(this.unwrap(source)); err != nil {
+		return ierror.Raise(err)
+	}
+	return this.spillIfPressure()
+}
+
+func (this *ISpillPartition[T]) MoveTo(target IPartitionBase) error {
+	return target.MoveFrom(this)
+}
+
+func (this *ISpillPartition[T]) Size() int64 {
+	return this.current().Size()
+}
+
+func (this *ISpillPartition[T]) Empty() bool {
+	return this.current().Empty()
+}
+
+func (this *ISpillPartition[T]) Bytes() int64 {
+	return this.current().Bytes()
+}
+
+func (this *ISpillPartition[T]) Clear() error {
+	if this.disk != nil {
+		if err := this.disk.Clear(); err != nil {
+			return ierror.Raise(err)
+		}
+		this.disk = nil
+		this.memory = NewIMemoryPartition[T](0, this.native)
+		return nil
+	}
+	return this.memory.Clear()
+}
+
+func (this *ISpillPartition[T]) Fit() error {
+	if this.disk == nil && this.Pressure() {
+		return this.Spill()
+	}
+	return this.current().Fit()
+}
+
+func (this *ISpillPartition[T]) Sync() error {
+	return this.current().Sync()
+}
+
+func (this *ISpillPartition[T]) Type() string {
+	return ISpillPartitionType
+}
+
+func (this *ISpillPartition[T]) Inner() any {
+	return this.current().Inner()
+}
+
+func (this *ISpillPartition[T]) Native() bool {
+	return this.native
+}
+
+func (this *ISpillPartition[T]) Compression() int8 {
+	return this.compression
+}
+
+func (this *ISpillPartition[T]) First() any {
+	return this.current().First()
+}
+
+func (this *ISpillPartition[T]) ReadIterator() (iterator.IReadIterator[T], error) {
+	return this.current().ReadIterator()
+}
+
+func (this *ISpillPartition[T]) WriteIterator() (iterator.IWriteIterator[T], error) {
+	it, err := this.current().WriteIterator()
+	if err != nil {
+		return nil, ierror.Raise(err)
+	}
+	return &iSpillWriteIterator[T]{this, it, 0}, nil
+}
+
+type iSpillWriteIterator[T any] struct {
+	part *ISpillPartition[T]
+	it   iterator.IWriteIterator[T]
+	n    int
+}
+
+func (this *iSpillWriteIterator[T]) Write(v T) (err error) {
+	if err = this.it.Write(v); err != nil {
+		return ierror.Raise(err)
+	}
+	this.n++
+	if this.n%spillCheck == 0 && !this.part.Spilled() && this.part.Pressure() {
+		if err = this.part.Spill(); err != nil {
+			return ierror.Raise(err)
+		}
+		if this.it, err = this.part.disk.WriteIterator(); err != nil {
+			return ierror.Raise(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+var spillID = 0
+
+func spillPath() (string, error) {
+	spillID++
+	return "/tmp/spillpartitionTest" + strconv.Itoa(spillID), nil
+}
+
+func spillElements(n int, seed int) []int64 {
+	array := make([]int64, n)
+	rand.Seed(int64(seed))
+	for i := 0; i < n; i++ {
+		array[i] = rand.Int63() % int64(n)
+	}
+	return array
+}
+
+func init() {
+	addPartitionTest(&IPartitionTest[int64]{
+		"ISpillPartitionInt64Test",
+		func() IPartition[int64] {
+			return NewISpillPartition[int64](100, 0, 6, false, spillPath)
+		},
+		spillElements,
+	})
+	addPartitionTest(&IPartitionTest[int64]{
+		"ISpillPartitionSpilledInt64Test",
+		func() IPartition[int64] {
+			part := NewISpillPartition[int64](100, 0, 6, false, spillPath)
+			if err := part.Spill(); err != nil {
+				panic(err)
+			}
+			return part
+		},
+		spillElements,
+	})
+}
+
+func TestSpillPressure(t *testing.T) {
+	part := NewISpillPartition[int64](100, 1, 6, false, spillPath)
+	elems := spillElements(3*spillCheck, 0)
+	it, err := part.WriteIterator()
+	require.Nil(t, err)
+	for _, e := range elems[:spillCheck-1] {
+		require.Nil(t, it.Write(e))
+	}
+	require.False(t, part.Spilled())
+	for _, e := range elems[spillCheck-1:] {
+		require.Nil(t, it.Write(e))
+	}
+	require.True(t, part.Spilled())
+	require.Equal(t, len(elems), int(part.Size()))
+
+	reader, err := part.ReadIterator()
+	require.Nil(t, err)
+	result := make([]int64, 0, len(elems))
+	for reader.HasNext() {
+		elem, err := reader.Next()
+		require.Nil(t, err)
+		result = append(result, elem)
+	}
+	require.Equal(t, elems, result)
+
+	require.Nil(t, part.Clear())
+	require.False(t, part.Spilled())
+}
+
+func TestSpillFit(t *testing.T) {
+	part := NewISpillPartition[int64](100, 1, 6, false, spillPath)
+	part.memory.elems.(*IListImpl[int64]).Add(1)
+	require.Nil(t, part.Fit())
+	require.True(t, part.Spilled())
+	require.Equal(t, int64(1), part.Size())
+}
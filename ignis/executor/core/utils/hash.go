@@ -93,7 +93,30 @@ func (this *errorHasher) Hash(p unsafe.Pointer) uint64 {
 	panic("type is not hashable")
 }
 
+type funcHasher[T any] struct {
+	f func(e *T) uint64
+}
+
+func (this *funcHasher[T]) Hash(p unsafe.Pointer) uint64 {
+	return this.f((*T)(p))
+}
+
+var hashers = map[reflect.Type]Hasher{}
+
+/*Types whose memory is not a valid hash register their own hasher, usually where their serialization is registered*/
+func SetHasher[T any](f func(e *T) uint64) {
+	hashers[TypeObj[T]()] = &funcHasher[T]{f}
+}
+
+/*Hashes a value with the same function used for its bytes, so close values are spread*/
+func HashValue[T any](e T) uint64 {
+	return memhash(unsafe.Pointer(&e), int(unsafe.Sizeof(e)))
+}
+
 func GetHasher(p reflect.Type) Hasher {
+	if h, present := hashers[p]; present {
+		return h
+	}
 	switch p.Kind() {
 	case reflect.Bool:
 		return &boolHasher{}
@@ -155,3 +178,8 @@ func GetHasher(p reflect.Type) Hasher {
 func Hash[T any](e T, h Hasher) uint64 {
 	return h.Hash(unsafe.Pointer(&e))
 }
+
+/*Same value in every executor, so it can be used to route serialized elements*/
+func HashBytes(data []byte) uint64 {
+	return xxHash64.Checksum(data, 0)
+}
@@ -0,0 +1,42 @@
+package utils
+
+import "unsafe"
+
+func IsSigned[T Integer]() bool {
+	var zero T
+	return ^zero < 0
+}
+
+func MaxValue[T Integer]() T {
+	var zero T
+	if IsSigned[T]() {
+		return T(1)<<(unsafe.Sizeof(zero)*8-1) - 1
+	}
+	return ^zero
+}
+
+func MinValue[T Integer]() T {
+	if IsSigned[T]() {
+		return -MaxValue[T]() - 1
+	}
+	return 0
+}
+
+func AddOverflow[T Integer](a T, b T) (T, bool) {
+	r := a + b
+	if IsSigned[T]() {
+		return r, (a > 0 && b > 0 && r < 0) || (a < 0 && b < 0 && r >= 0)
+	}
+	return r, r < a
+}
+
+func AddSaturate[T Integer](a T, b T) T {
+	r, overflow := AddOverflow(a, b)
+	if !overflow {
+		return r
+	}
+	if b > 0 {
+		return MaxValue[T]()
+	}
+	return MinValue[T]()
+}
@@ -34,6 +34,22 @@ func TypeName[T any]() string {
 	return TypeObj[T]().String()
 }
 
+/*Comparable types whose equal values have several representations, they are normalized before being compared*/
+type Normalizable[T any] interface {
+	Normalize() T
+}
+
+/*Returns nil when T is compared as it is*/
+func Normalizer[T any]() func(e T) T {
+	var zero T
+	if _, ok := any(zero).(Normalizable[T]); !ok {
+		return nil
+	}
+	return func(e T) T {
+		return any(e).(Normalizable[T]).Normalize()
+	}
+}
+
 type iface struct {
 	tab  *_type
 	data unsafe.Pointer
@@ -36,6 +36,9 @@ func _init_(args []string) error {
 		processor.RegisterProcessor("IIO", executor.NewIIOModuleProcessor(modules.NewIIOModule(executorData)))
 		processor.RegisterProcessor("ICacheContext", executor.NewICacheContextModuleProcessor(modules.NewICacheContextModule(executorData)))
 		processor.RegisterProcessor("IComm", executor.NewICommModuleProcessor(modules.NewICommModule(executorData)))
+		processor.RegisterProcessor("IVector", executor.NewIVectorModuleProcessor(modules.NewIVectorModule(executorData)))
+		processor.RegisterProcessor("IDiagnostic", executor.NewIDiagnosticModuleProcessor(modules.NewIDiagnosticModule(executorData)))
+		processor.RegisterProcessor("IState", executor.NewIStateModuleProcessor(modules.NewIStateModule(executorData)))
 
 		for _, dtype := range itype.DefaultTypes() {
 			executorData.RegisterType(dtype)
@@ -24,19 +24,28 @@ type ICacheContextModule interface {
   SaveContext(ctx context.Context) (_r int64, _err error)
   ClearContext(ctx context.Context) (_err error)
   // Parameters:
-  //  - ID
+  //  - Id
   LoadContext(ctx context.Context, id int64) (_err error)
   // Parameters:
-  //  - ID
+  //  - Id
   //  - Name
   LoadContextAsVariable(ctx context.Context, id int64, name string) (_err error)
   // Parameters:
-  //  - ID
+  //  - Id
   //  - Level
   Cache(ctx context.Context, id int64, level int8) (_err error)
   // Parameters:
-  //  - ID
+  //  - Id
+  Unpersist(ctx context.Context, id int64) (_err error)
+  // Parameters:
+  //  - Id
   LoadCache(ctx context.Context, id int64) (_err error)
+  // Parameters:
+  //  - Id
+  //  - Executor
+  LoadReplica(ctx context.Context, id int64, executor int64) (_err error)
+  Checkpoint(ctx context.Context) (_r int64, _err error)
+  RestoreCheckpoint(ctx context.Context) (_err error)
 }
 
 type ICacheContextModuleClient struct {
@@ -109,10 +118,10 @@ func (p *ICacheContextModuleClient) ClearContext(ctx context.Context) (_err erro
 }
 
 // Parameters:
-//  - ID
+//  - Id
 func (p *ICacheContextModuleClient) LoadContext(ctx context.Context, id int64) (_err error) {
   var _args6 ICacheContextModuleLoadContextArgs
-  _args6.ID = id
+  _args6.Id = id
   var _result8 ICacheContextModuleLoadContextResult
   var _meta7 thrift.ResponseMeta
   _meta7, _err = p.Client_().Call(ctx, "loadContext", &_args6, &_result8)
@@ -129,11 +138,11 @@ func (p *ICacheContextModuleClient) LoadContext(ctx context.Context, id int64) (
 }
 
 // Parameters:
-//  - ID
+//  - Id
 //  - Name
 func (p *ICacheContextModuleClient) LoadContextAsVariable(ctx context.Context, id int64, name string) (_err error) {
   var _args9 ICacheContextModuleLoadContextAsVariableArgs
-  _args9.ID = id
+  _args9.Id = id
   _args9.Name = name
   var _result11 ICacheContextModuleLoadContextAsVariableResult
   var _meta10 thrift.ResponseMeta
@@ -151,11 +160,11 @@ func (p *ICacheContextModuleClient) LoadContextAsVariable(ctx context.Context, i
 }
 
 // Parameters:
-//  - ID
+//  - Id
 //  - Level
 func (p *ICacheContextModuleClient) Cache(ctx context.Context, id int64, level int8) (_err error) {
   var _args12 ICacheContextModuleCacheArgs
-  _args12.ID = id
+  _args12.Id = id
   _args12.Level = level
   var _result14 ICacheContextModuleCacheResult
   var _meta13 thrift.ResponseMeta
@@ -173,13 +182,13 @@ func (p *ICacheContextModuleClient) Cache(ctx context.Context, id int64, level i
 }
 
 // Parameters:
-//  - ID
-func (p *ICacheContextModuleClient) LoadCache(ctx context.Context, id int64) (_err error) {
-  var _args15 ICacheContextModuleLoadCacheArgs
-  _args15.ID = id
-  var _result17 ICacheContextModuleLoadCacheResult
+//  - Id
+func (p *ICacheContextModuleClient) Unpersist(ctx context.Context, id int64) (_err error) {
+  var _args15 ICacheContextModuleUnpersistArgs
+  _args15.Id = id
+  var _result17 ICacheContextModuleUnpersistResult
   var _meta16 thrift.ResponseMeta
-  _meta16, _err = p.Client_().Call(ctx, "loadCache", &_args15, &_result17)
+  _meta16, _err = p.Client_().Call(ctx, "unpersist", &_args15, &_result17)
   p.SetLastResponseMeta_(_meta16)
   if _err != nil {
     return
@@ -192,6 +201,82 @@ func (p *ICacheContextModuleClient) LoadCache(ctx context.Context, id int64) (_e
   return nil
 }
 
+// Parameters:
+//  - Id
+func (p *ICacheContextModuleClient) LoadCache(ctx context.Context, id int64) (_err error) {
+  var _args18 ICacheContextModuleLoadCacheArgs
+  _args18.Id = id
+  var _result20 ICacheContextModuleLoadCacheResult
+  var _meta19 thrift.ResponseMeta
+  _meta19, _err = p.Client_().Call(ctx, "loadCache", &_args18, &_result20)
+  p.SetLastResponseMeta_(_meta19)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result20.Ex!= nil:
+    return _result20.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Id
+//  - Executor
+func (p *ICacheContextModuleClient) LoadReplica(ctx context.Context, id int64, executor int64) (_err error) {
+  var _args21 ICacheContextModuleLoadReplicaArgs
+  _args21.Id = id
+  _args21.Executor = executor
+  var _result23 ICacheContextModuleLoadReplicaResult
+  var _meta22 thrift.ResponseMeta
+  _meta22, _err = p.Client_().Call(ctx, "loadReplica", &_args21, &_result23)
+  p.SetLastResponseMeta_(_meta22)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result23.Ex!= nil:
+    return _result23.Ex
+  }
+
+  return nil
+}
+
+func (p *ICacheContextModuleClient) Checkpoint(ctx context.Context) (_r int64, _err error) {
+  var _args24 ICacheContextModuleCheckpointArgs
+  var _result26 ICacheContextModuleCheckpointResult
+  var _meta25 thrift.ResponseMeta
+  _meta25, _err = p.Client_().Call(ctx, "checkpoint", &_args24, &_result26)
+  p.SetLastResponseMeta_(_meta25)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result26.Ex!= nil:
+    return _r, _result26.Ex
+  }
+
+  return _result26.GetSuccess(), nil
+}
+
+func (p *ICacheContextModuleClient) RestoreCheckpoint(ctx context.Context) (_err error) {
+  var _args27 ICacheContextModuleRestoreCheckpointArgs
+  var _result29 ICacheContextModuleRestoreCheckpointResult
+  var _meta28 thrift.ResponseMeta
+  _meta28, _err = p.Client_().Call(ctx, "restoreCheckpoint", &_args27, &_result29)
+  p.SetLastResponseMeta_(_meta28)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result29.Ex!= nil:
+    return _result29.Ex
+  }
+
+  return nil
+}
+
 type ICacheContextModuleProcessor struct {
   processorMap map[string]thrift.TProcessorFunction
   handler ICacheContextModule
@@ -212,14 +297,18 @@ func (p *ICacheContextModuleProcessor) ProcessorMap() map[string]thrift.TProcess
 
 func NewICacheContextModuleProcessor(handler ICacheContextModule) *ICacheContextModuleProcessor {
 
-  self18 := &ICacheContextModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self18.processorMap["saveContext"] = &iCacheContextModuleProcessorSaveContext{handler:handler}
-  self18.processorMap["clearContext"] = &iCacheContextModuleProcessorClearContext{handler:handler}
-  self18.processorMap["loadContext"] = &iCacheContextModuleProcessorLoadContext{handler:handler}
-  self18.processorMap["loadContextAsVariable"] = &iCacheContextModuleProcessorLoadContextAsVariable{handler:handler}
-  self18.processorMap["cache"] = &iCacheContextModuleProcessorCache{handler:handler}
-  self18.processorMap["loadCache"] = &iCacheContextModuleProcessorLoadCache{handler:handler}
-return self18
+  self30 := &ICacheContextModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self30.processorMap["saveContext"] = &iCacheContextModuleProcessorSaveContext{handler:handler}
+  self30.processorMap["clearContext"] = &iCacheContextModuleProcessorClearContext{handler:handler}
+  self30.processorMap["loadContext"] = &iCacheContextModuleProcessorLoadContext{handler:handler}
+  self30.processorMap["loadContextAsVariable"] = &iCacheContextModuleProcessorLoadContextAsVariable{handler:handler}
+  self30.processorMap["cache"] = &iCacheContextModuleProcessorCache{handler:handler}
+  self30.processorMap["unpersist"] = &iCacheContextModuleProcessorUnpersist{handler:handler}
+  self30.processorMap["loadCache"] = &iCacheContextModuleProcessorLoadCache{handler:handler}
+  self30.processorMap["loadReplica"] = &iCacheContextModuleProcessorLoadReplica{handler:handler}
+  self30.processorMap["checkpoint"] = &iCacheContextModuleProcessorCheckpoint{handler:handler}
+  self30.processorMap["restoreCheckpoint"] = &iCacheContextModuleProcessorRestoreCheckpoint{handler:handler}
+return self30
 }
 
 func (p *ICacheContextModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -230,12 +319,12 @@ func (p *ICacheContextModuleProcessor) Process(ctx context.Context, iprot, oprot
   }
   iprot.Skip(ctx, thrift.STRUCT)
   iprot.ReadMessageEnd(ctx)
-  x19 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  x31 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
   oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-  x19.Write(ctx, oprot)
+  x31.Write(ctx, oprot)
   oprot.WriteMessageEnd(ctx)
   oprot.Flush(ctx)
-  return false, x19
+  return false, x31
 
 }
 
@@ -449,7 +538,7 @@ func (p *iCacheContextModuleProcessorLoadContext) Process(ctx context.Context, s
   }
 
   result := ICacheContextModuleLoadContextResult{}
-  if err2 = p.handler.LoadContext(ctx, args.ID); err2 != nil {
+  if err2 = p.handler.LoadContext(ctx, args.Id); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -530,7 +619,7 @@ func (p *iCacheContextModuleProcessorLoadContextAsVariable) Process(ctx context.
   }
 
   result := ICacheContextModuleLoadContextAsVariableResult{}
-  if err2 = p.handler.LoadContextAsVariable(ctx, args.ID, args.Name); err2 != nil {
+  if err2 = p.handler.LoadContextAsVariable(ctx, args.Id, args.Name); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -611,7 +700,7 @@ func (p *iCacheContextModuleProcessorCache) Process(ctx context.Context, seqId i
   }
 
   result := ICacheContextModuleCacheResult{}
-  if err2 = p.handler.Cache(ctx, args.ID, args.Level); err2 != nil {
+  if err2 = p.handler.Cache(ctx, args.Id, args.Level); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -647,6 +736,87 @@ func (p *iCacheContextModuleProcessorCache) Process(ctx context.Context, seqId i
   return true, err
 }
 
+type iCacheContextModuleProcessorUnpersist struct {
+  handler ICacheContextModule
+}
+
+func (p *iCacheContextModuleProcessorUnpersist) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ICacheContextModuleUnpersistArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "unpersist", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := ICacheContextModuleUnpersistResult{}
+  if err2 = p.handler.Unpersist(ctx, args.Id); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing unpersist: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "unpersist", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "unpersist", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
 type iCacheContextModuleProcessorLoadCache struct {
   handler ICacheContextModule
 }
@@ -692,7 +862,7 @@ func (p *iCacheContextModuleProcessorLoadCache) Process(ctx context.Context, seq
   }
 
   result := ICacheContextModuleLoadCacheResult{}
-  if err2 = p.handler.LoadCache(ctx, args.ID); err2 != nil {
+  if err2 = p.handler.LoadCache(ctx, args.Id); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -728,97 +898,343 @@ func (p *iCacheContextModuleProcessorLoadCache) Process(ctx context.Context, seq
   return true, err
 }
 
-
-// HELPER FUNCTIONS AND STRUCTURES
-
-type ICacheContextModuleSaveContextArgs struct {
-}
-
-func NewICacheContextModuleSaveContextArgs() *ICacheContextModuleSaveContextArgs {
-  return &ICacheContextModuleSaveContextArgs{}
+type iCacheContextModuleProcessorLoadReplica struct {
+  handler ICacheContextModule
 }
 
-func (p *ICacheContextModuleSaveContextArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+func (p *iCacheContextModuleProcessorLoadReplica) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ICacheContextModuleLoadReplicaArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "loadReplica", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
   }
+  iprot.ReadMessageEnd(ctx)
 
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
 
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-      return err
-    }
-    if err := iprot.ReadFieldEnd(ctx); err != nil {
-      return err
+  result := ICacheContextModuleLoadReplicaResult{}
+  if err2 = p.handler.LoadReplica(ctx, args.Id, args.Executor); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
     }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing loadReplica: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "loadReplica", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
   }
-  if err := iprot.ReadStructEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return nil
-}
-
-func (p *ICacheContextModuleSaveContextArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "saveContext_args"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "loadReplica", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
   }
-  if err := oprot.WriteFieldStop(ctx); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(ctx); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
-}
-
-func (p *ICacheContextModuleSaveContextArgs) String() string {
-  if p == nil {
-    return "<nil>"
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
   }
-  return fmt.Sprintf("ICacheContextModuleSaveContextArgs(%+v)", *p)
-}
-
-// Attributes:
-//  - Success
-//  - Ex
-type ICacheContextModuleSaveContextResult struct {
-  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
-  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
-}
-
-func NewICacheContextModuleSaveContextResult() *ICacheContextModuleSaveContextResult {
-  return &ICacheContextModuleSaveContextResult{}
-}
-
-var ICacheContextModuleSaveContextResult_Success_DEFAULT int64
-func (p *ICacheContextModuleSaveContextResult) GetSuccess() int64 {
-  if !p.IsSetSuccess() {
-    return ICacheContextModuleSaveContextResult_Success_DEFAULT
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
   }
-return *p.Success
-}
-var ICacheContextModuleSaveContextResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *ICacheContextModuleSaveContextResult) GetEx() *rpc.IExecutorException {
-  if !p.IsSetEx() {
-    return ICacheContextModuleSaveContextResult_Ex_DEFAULT
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
   }
-return p.Ex
-}
-func (p *ICacheContextModuleSaveContextResult) IsSetSuccess() bool {
-  return p.Success != nil
+  if err != nil {
+    return
+  }
+  return true, err
 }
 
-func (p *ICacheContextModuleSaveContextResult) IsSetEx() bool {
-  return p.Ex != nil
+type iCacheContextModuleProcessorCheckpoint struct {
+  handler ICacheContextModule
 }
 
-func (p *ICacheContextModuleSaveContextResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+func (p *iCacheContextModuleProcessorCheckpoint) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ICacheContextModuleCheckpointArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "checkpoint", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := ICacheContextModuleCheckpointResult{}
+  var retval int64
+  if retval, err2 = p.handler.Checkpoint(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing checkpoint: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "checkpoint", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  } else {
+    result.Success = &retval
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "checkpoint", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iCacheContextModuleProcessorRestoreCheckpoint struct {
+  handler ICacheContextModule
+}
+
+func (p *iCacheContextModuleProcessorRestoreCheckpoint) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ICacheContextModuleRestoreCheckpointArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "restoreCheckpoint", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := ICacheContextModuleRestoreCheckpointResult{}
+  if err2 = p.handler.RestoreCheckpoint(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing restoreCheckpoint: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "restoreCheckpoint", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "restoreCheckpoint", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+type ICacheContextModuleSaveContextArgs struct {
+}
+
+func NewICacheContextModuleSaveContextArgs() *ICacheContextModuleSaveContextArgs {
+  return &ICacheContextModuleSaveContextArgs{}
+}
+
+func (p *ICacheContextModuleSaveContextArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleSaveContextArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveContext_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleSaveContextArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleSaveContextArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ex
+type ICacheContextModuleSaveContextResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICacheContextModuleSaveContextResult() *ICacheContextModuleSaveContextResult {
+  return &ICacheContextModuleSaveContextResult{}
+}
+
+var ICacheContextModuleSaveContextResult_Success_DEFAULT int64
+func (p *ICacheContextModuleSaveContextResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return ICacheContextModuleSaveContextResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var ICacheContextModuleSaveContextResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICacheContextModuleSaveContextResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICacheContextModuleSaveContextResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICacheContextModuleSaveContextResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ICacheContextModuleSaveContextResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICacheContextModuleSaveContextResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
 
@@ -1079,9 +1495,9 @@ func (p *ICacheContextModuleClearContextResult) String() string {
 }
 
 // Attributes:
-//  - ID
+//  - Id
 type ICacheContextModuleLoadContextArgs struct {
-  ID int64 `thrift:"id,1" db:"id" json:"id"`
+  Id int64 `thrift:"id,1" db:"id" json:"id"`
 }
 
 func NewICacheContextModuleLoadContextArgs() *ICacheContextModuleLoadContextArgs {
@@ -1089,8 +1505,8 @@ func NewICacheContextModuleLoadContextArgs() *ICacheContextModuleLoadContextArgs
 }
 
 
-func (p *ICacheContextModuleLoadContextArgs) GetID() int64 {
-  return p.ID
+func (p *ICacheContextModuleLoadContextArgs) GetId() int64 {
+  return p.Id
 }
 func (p *ICacheContextModuleLoadContextArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
@@ -1134,7 +1550,7 @@ func (p *ICacheContextModuleLoadContextArgs)  ReadField1(ctx context.Context, ip
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ID = v
+  p.Id = v
 }
   return nil
 }
@@ -1155,7 +1571,7 @@ func (p *ICacheContextModuleLoadContextArgs) Write(ctx context.Context, oprot th
 func (p *ICacheContextModuleLoadContextArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "id", thrift.I64, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+  if err := oprot.WriteI64(ctx, int64(p.Id)); err != nil {
   return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
@@ -1270,10 +1686,10 @@ func (p *ICacheContextModuleLoadContextResult) String() string {
 }
 
 // Attributes:
-//  - ID
+//  - Id
 //  - Name
 type ICacheContextModuleLoadContextAsVariableArgs struct {
-  ID int64 `thrift:"id,1" db:"id" json:"id"`
+  Id int64 `thrift:"id,1" db:"id" json:"id"`
   Name string `thrift:"name,2" db:"name" json:"name"`
 }
 
@@ -1282,8 +1698,8 @@ func NewICacheContextModuleLoadContextAsVariableArgs() *ICacheContextModuleLoadC
 }
 
 
-func (p *ICacheContextModuleLoadContextAsVariableArgs) GetID() int64 {
-  return p.ID
+func (p *ICacheContextModuleLoadContextAsVariableArgs) GetId() int64 {
+  return p.Id
 }
 
 func (p *ICacheContextModuleLoadContextAsVariableArgs) GetName() string {
@@ -1341,7 +1757,7 @@ func (p *ICacheContextModuleLoadContextAsVariableArgs)  ReadField1(ctx context.C
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ID = v
+  p.Id = v
 }
   return nil
 }
@@ -1372,7 +1788,7 @@ func (p *ICacheContextModuleLoadContextAsVariableArgs) Write(ctx context.Context
 func (p *ICacheContextModuleLoadContextAsVariableArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "id", thrift.I64, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+  if err := oprot.WriteI64(ctx, int64(p.Id)); err != nil {
   return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
@@ -1497,10 +1913,10 @@ func (p *ICacheContextModuleLoadContextAsVariableResult) String() string {
 }
 
 // Attributes:
-//  - ID
+//  - Id
 //  - Level
 type ICacheContextModuleCacheArgs struct {
-  ID int64 `thrift:"id,1" db:"id" json:"id"`
+  Id int64 `thrift:"id,1" db:"id" json:"id"`
   Level int8 `thrift:"level,2" db:"level" json:"level"`
 }
 
@@ -1509,8 +1925,8 @@ func NewICacheContextModuleCacheArgs() *ICacheContextModuleCacheArgs {
 }
 
 
-func (p *ICacheContextModuleCacheArgs) GetID() int64 {
-  return p.ID
+func (p *ICacheContextModuleCacheArgs) GetId() int64 {
+  return p.Id
 }
 
 func (p *ICacheContextModuleCacheArgs) GetLevel() int8 {
@@ -1568,7 +1984,7 @@ func (p *ICacheContextModuleCacheArgs)  ReadField1(ctx context.Context, iprot th
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ID = v
+  p.Id = v
 }
   return nil
 }
@@ -1600,7 +2016,7 @@ func (p *ICacheContextModuleCacheArgs) Write(ctx context.Context, oprot thrift.T
 func (p *ICacheContextModuleCacheArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "id", thrift.I64, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+  if err := oprot.WriteI64(ctx, int64(p.Id)); err != nil {
   return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
@@ -1725,20 +2141,20 @@ func (p *ICacheContextModuleCacheResult) String() string {
 }
 
 // Attributes:
-//  - ID
-type ICacheContextModuleLoadCacheArgs struct {
-  ID int64 `thrift:"id,1" db:"id" json:"id"`
+//  - Id
+type ICacheContextModuleUnpersistArgs struct {
+  Id int64 `thrift:"id,1" db:"id" json:"id"`
 }
 
-func NewICacheContextModuleLoadCacheArgs() *ICacheContextModuleLoadCacheArgs {
-  return &ICacheContextModuleLoadCacheArgs{}
+func NewICacheContextModuleUnpersistArgs() *ICacheContextModuleUnpersistArgs {
+  return &ICacheContextModuleUnpersistArgs{}
 }
 
 
-func (p *ICacheContextModuleLoadCacheArgs) GetID() int64 {
-  return p.ID
+func (p *ICacheContextModuleUnpersistArgs) GetId() int64 {
+  return p.Id
 }
-func (p *ICacheContextModuleLoadCacheArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ICacheContextModuleUnpersistArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1776,17 +2192,17 @@ func (p *ICacheContextModuleLoadCacheArgs) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *ICacheContextModuleLoadCacheArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ICacheContextModuleUnpersistArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ID = v
+  p.Id = v
 }
   return nil
 }
 
-func (p *ICacheContextModuleLoadCacheArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "loadCache_args"); err != nil {
+func (p *ICacheContextModuleUnpersistArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "unpersist_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -1798,45 +2214,45 @@ func (p *ICacheContextModuleLoadCacheArgs) Write(ctx context.Context, oprot thri
   return nil
 }
 
-func (p *ICacheContextModuleLoadCacheArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *ICacheContextModuleUnpersistArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "id", thrift.I64, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.ID)); err != nil {
+  if err := oprot.WriteI64(ctx, int64(p.Id)); err != nil {
   return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
   return err
 }
 
-func (p *ICacheContextModuleLoadCacheArgs) String() string {
+func (p *ICacheContextModuleUnpersistArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ICacheContextModuleLoadCacheArgs(%+v)", *p)
+  return fmt.Sprintf("ICacheContextModuleUnpersistArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type ICacheContextModuleLoadCacheResult struct {
+type ICacheContextModuleUnpersistResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewICacheContextModuleLoadCacheResult() *ICacheContextModuleLoadCacheResult {
-  return &ICacheContextModuleLoadCacheResult{}
+func NewICacheContextModuleUnpersistResult() *ICacheContextModuleUnpersistResult {
+  return &ICacheContextModuleUnpersistResult{}
 }
 
-var ICacheContextModuleLoadCacheResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *ICacheContextModuleLoadCacheResult) GetEx() *rpc.IExecutorException {
+var ICacheContextModuleUnpersistResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICacheContextModuleUnpersistResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return ICacheContextModuleLoadCacheResult_Ex_DEFAULT
+    return ICacheContextModuleUnpersistResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *ICacheContextModuleLoadCacheResult) IsSetEx() bool {
+func (p *ICacheContextModuleUnpersistResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *ICacheContextModuleLoadCacheResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ICacheContextModuleUnpersistResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1874,7 +2290,7 @@ func (p *ICacheContextModuleLoadCacheResult) Read(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *ICacheContextModuleLoadCacheResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *ICacheContextModuleUnpersistResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -1882,8 +2298,8 @@ func (p *ICacheContextModuleLoadCacheResult)  ReadField1(ctx context.Context, ip
   return nil
 }
 
-func (p *ICacheContextModuleLoadCacheResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "loadCache_result"); err != nil {
+func (p *ICacheContextModuleUnpersistResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "unpersist_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -1895,7 +2311,7 @@ func (p *ICacheContextModuleLoadCacheResult) Write(ctx context.Context, oprot th
   return nil
 }
 
-func (p *ICacheContextModuleLoadCacheResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *ICacheContextModuleUnpersistResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -1908,11 +2324,776 @@ func (p *ICacheContextModuleLoadCacheResult) writeField1(ctx context.Context, op
   return err
 }
 
-func (p *ICacheContextModuleLoadCacheResult) String() string {
+func (p *ICacheContextModuleUnpersistResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ICacheContextModuleLoadCacheResult(%+v)", *p)
+  return fmt.Sprintf("ICacheContextModuleUnpersistResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Id
+type ICacheContextModuleLoadCacheArgs struct {
+  Id int64 `thrift:"id,1" db:"id" json:"id"`
+}
+
+func NewICacheContextModuleLoadCacheArgs() *ICacheContextModuleLoadCacheArgs {
+  return &ICacheContextModuleLoadCacheArgs{}
+}
+
+
+func (p *ICacheContextModuleLoadCacheArgs) GetId() int64 {
+  return p.Id
+}
+func (p *ICacheContextModuleLoadCacheArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadCacheArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Id = v
+}
+  return nil
+}
+
+func (p *ICacheContextModuleLoadCacheArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadCache_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadCacheArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "id", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.Id)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
+  return err
+}
+
+func (p *ICacheContextModuleLoadCacheArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleLoadCacheArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type ICacheContextModuleLoadCacheResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICacheContextModuleLoadCacheResult() *ICacheContextModuleLoadCacheResult {
+  return &ICacheContextModuleLoadCacheResult{}
+}
+
+var ICacheContextModuleLoadCacheResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICacheContextModuleLoadCacheResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICacheContextModuleLoadCacheResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICacheContextModuleLoadCacheResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICacheContextModuleLoadCacheResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadCacheResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadCacheResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadCache_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadCacheResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICacheContextModuleLoadCacheResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleLoadCacheResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Id
+//  - Executor
+type ICacheContextModuleLoadReplicaArgs struct {
+  Id int64 `thrift:"id,1" db:"id" json:"id"`
+  Executor int64 `thrift:"executor,2" db:"executor" json:"executor"`
+}
+
+func NewICacheContextModuleLoadReplicaArgs() *ICacheContextModuleLoadReplicaArgs {
+  return &ICacheContextModuleLoadReplicaArgs{}
+}
+
+
+func (p *ICacheContextModuleLoadReplicaArgs) GetId() int64 {
+  return p.Id
+}
+
+func (p *ICacheContextModuleLoadReplicaArgs) GetExecutor() int64 {
+  return p.Executor
+}
+func (p *ICacheContextModuleLoadReplicaArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadReplicaArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Id = v
+}
+  return nil
+}
+
+func (p *ICacheContextModuleLoadReplicaArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Executor = v
+}
+  return nil
+}
+
+func (p *ICacheContextModuleLoadReplicaArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadReplica_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadReplicaArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "id", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.Id)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
+  return err
+}
+
+func (p *ICacheContextModuleLoadReplicaArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "executor", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:executor: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.Executor)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.executor (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:executor: ", p), err) }
+  return err
+}
+
+func (p *ICacheContextModuleLoadReplicaArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleLoadReplicaArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type ICacheContextModuleLoadReplicaResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICacheContextModuleLoadReplicaResult() *ICacheContextModuleLoadReplicaResult {
+  return &ICacheContextModuleLoadReplicaResult{}
+}
+
+var ICacheContextModuleLoadReplicaResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICacheContextModuleLoadReplicaResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICacheContextModuleLoadReplicaResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICacheContextModuleLoadReplicaResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICacheContextModuleLoadReplicaResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadReplicaResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadReplicaResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadReplica_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleLoadReplicaResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICacheContextModuleLoadReplicaResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleLoadReplicaResult(%+v)", *p)
+}
+
+type ICacheContextModuleCheckpointArgs struct {
+}
+
+func NewICacheContextModuleCheckpointArgs() *ICacheContextModuleCheckpointArgs {
+  return &ICacheContextModuleCheckpointArgs{}
+}
+
+func (p *ICacheContextModuleCheckpointArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleCheckpointArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "checkpoint_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleCheckpointArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleCheckpointArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ex
+type ICacheContextModuleCheckpointResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICacheContextModuleCheckpointResult() *ICacheContextModuleCheckpointResult {
+  return &ICacheContextModuleCheckpointResult{}
+}
+
+var ICacheContextModuleCheckpointResult_Success_DEFAULT int64
+func (p *ICacheContextModuleCheckpointResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return ICacheContextModuleCheckpointResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var ICacheContextModuleCheckpointResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICacheContextModuleCheckpointResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICacheContextModuleCheckpointResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICacheContextModuleCheckpointResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ICacheContextModuleCheckpointResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICacheContextModuleCheckpointResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleCheckpointResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *ICacheContextModuleCheckpointResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleCheckpointResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "checkpoint_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleCheckpointResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICacheContextModuleCheckpointResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICacheContextModuleCheckpointResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleCheckpointResult(%+v)", *p)
+}
+
+type ICacheContextModuleRestoreCheckpointArgs struct {
+}
+
+func NewICacheContextModuleRestoreCheckpointArgs() *ICacheContextModuleRestoreCheckpointArgs {
+  return &ICacheContextModuleRestoreCheckpointArgs{}
+}
+
+func (p *ICacheContextModuleRestoreCheckpointArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleRestoreCheckpointArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "restoreCheckpoint_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleRestoreCheckpointArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleRestoreCheckpointArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type ICacheContextModuleRestoreCheckpointResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICacheContextModuleRestoreCheckpointResult() *ICacheContextModuleRestoreCheckpointResult {
+  return &ICacheContextModuleRestoreCheckpointResult{}
+}
+
+var ICacheContextModuleRestoreCheckpointResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICacheContextModuleRestoreCheckpointResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICacheContextModuleRestoreCheckpointResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICacheContextModuleRestoreCheckpointResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICacheContextModuleRestoreCheckpointResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleRestoreCheckpointResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *ICacheContextModuleRestoreCheckpointResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "restoreCheckpoint_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICacheContextModuleRestoreCheckpointResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICacheContextModuleRestoreCheckpointResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICacheContextModuleRestoreCheckpointResult(%+v)", *p)
 }
 
 
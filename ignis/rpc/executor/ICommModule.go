@@ -24,11 +24,11 @@ type ICommModule interface {
   OpenGroup(ctx context.Context) (_r string, _err error)
   CloseGroup(ctx context.Context) (_err error)
   // Parameters:
-  //  - ID
+  //  - Id
   //  - Leader
   JoinToGroup(ctx context.Context, id string, leader bool) (_err error)
   // Parameters:
-  //  - ID
+  //  - Id
   //  - Leader
   //  - Name
   JoinToGroupName(ctx context.Context, id string, leader bool, name string) (_err error)
@@ -82,6 +82,14 @@ type ICommModule interface {
   //  - Threads
   //  - Src
   ImportData4(ctx context.Context, group string, source bool, threads int64, src *rpc.ISource) (_err error)
+  // Parameters:
+  //  - Name
+  //  - Value
+  Broadcast(ctx context.Context, name string, value []byte) (_err error)
+  // Parameters:
+  //  - Name
+  RemoveBroadcast(ctx context.Context, name string) (_err error)
+  ReduceAccumulators(ctx context.Context) (_r map[string][]byte, _err error)
 }
 
 type ICommModuleClient struct {
@@ -154,11 +162,11 @@ func (p *ICommModuleClient) CloseGroup(ctx context.Context) (_err error) {
 }
 
 // Parameters:
-//  - ID
+//  - Id
 //  - Leader
 func (p *ICommModuleClient) JoinToGroup(ctx context.Context, id string, leader bool) (_err error) {
   var _args6 ICommModuleJoinToGroupArgs
-  _args6.ID = id
+  _args6.Id = id
   _args6.Leader = leader
   var _result8 ICommModuleJoinToGroupResult
   var _meta7 thrift.ResponseMeta
@@ -176,12 +184,12 @@ func (p *ICommModuleClient) JoinToGroup(ctx context.Context, id string, leader b
 }
 
 // Parameters:
-//  - ID
+//  - Id
 //  - Leader
 //  - Name
 func (p *ICommModuleClient) JoinToGroupName(ctx context.Context, id string, leader bool, name string) (_err error) {
   var _args9 ICommModuleJoinToGroupNameArgs
-  _args9.ID = id
+  _args9.Id = id
   _args9.Leader = leader
   _args9.Name = name
   var _result11 ICommModuleJoinToGroupNameResult
@@ -497,6 +505,65 @@ func (p *ICommModuleClient) ImportData4(ctx context.Context, group string, sourc
   return nil
 }
 
+// Parameters:
+//  - Name
+//  - Value
+func (p *ICommModuleClient) Broadcast(ctx context.Context, name string, value []byte) (_err error) {
+  var _args54 ICommModuleBroadcastArgs
+  _args54.Name = name
+  _args54.Value = value
+  var _result56 ICommModuleBroadcastResult
+  var _meta55 thrift.ResponseMeta
+  _meta55, _err = p.Client_().Call(ctx, "broadcast", &_args54, &_result56)
+  p.SetLastResponseMeta_(_meta55)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result56.Ex!= nil:
+    return _result56.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Name
+func (p *ICommModuleClient) RemoveBroadcast(ctx context.Context, name string) (_err error) {
+  var _args57 ICommModuleRemoveBroadcastArgs
+  _args57.Name = name
+  var _result59 ICommModuleRemoveBroadcastResult
+  var _meta58 thrift.ResponseMeta
+  _meta58, _err = p.Client_().Call(ctx, "removeBroadcast", &_args57, &_result59)
+  p.SetLastResponseMeta_(_meta58)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result59.Ex!= nil:
+    return _result59.Ex
+  }
+
+  return nil
+}
+
+func (p *ICommModuleClient) ReduceAccumulators(ctx context.Context) (_r map[string][]byte, _err error) {
+  var _args60 ICommModuleReduceAccumulatorsArgs
+  var _result62 ICommModuleReduceAccumulatorsResult
+  var _meta61 thrift.ResponseMeta
+  _meta61, _err = p.Client_().Call(ctx, "reduceAccumulators", &_args60, &_result62)
+  p.SetLastResponseMeta_(_meta61)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result62.Ex!= nil:
+    return _r, _result62.Ex
+  }
+
+  return _result62.GetSuccess(), nil
+}
+
 type ICommModuleProcessor struct {
   processorMap map[string]thrift.TProcessorFunction
   handler ICommModule
@@ -517,26 +584,29 @@ func (p *ICommModuleProcessor) ProcessorMap() map[string]thrift.TProcessorFuncti
 
 func NewICommModuleProcessor(handler ICommModule) *ICommModuleProcessor {
 
-  self54 := &ICommModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self54.processorMap["openGroup"] = &iCommModuleProcessorOpenGroup{handler:handler}
-  self54.processorMap["closeGroup"] = &iCommModuleProcessorCloseGroup{handler:handler}
-  self54.processorMap["joinToGroup"] = &iCommModuleProcessorJoinToGroup{handler:handler}
-  self54.processorMap["joinToGroupName"] = &iCommModuleProcessorJoinToGroupName{handler:handler}
-  self54.processorMap["hasGroup"] = &iCommModuleProcessorHasGroup{handler:handler}
-  self54.processorMap["destroyGroup"] = &iCommModuleProcessorDestroyGroup{handler:handler}
-  self54.processorMap["destroyGroups"] = &iCommModuleProcessorDestroyGroups{handler:handler}
-  self54.processorMap["getProtocol"] = &iCommModuleProcessorGetProtocol{handler:handler}
-  self54.processorMap["getPartitions"] = &iCommModuleProcessorGetPartitions{handler:handler}
-  self54.processorMap["getPartitions2"] = &iCommModuleProcessorGetPartitions2{handler:handler}
-  self54.processorMap["setPartitions"] = &iCommModuleProcessorSetPartitions{handler:handler}
-  self54.processorMap["setPartitions2"] = &iCommModuleProcessorSetPartitions2{handler:handler}
-  self54.processorMap["driverGather"] = &iCommModuleProcessorDriverGather{handler:handler}
-  self54.processorMap["driverGather0"] = &iCommModuleProcessorDriverGather0{handler:handler}
-  self54.processorMap["driverScatter"] = &iCommModuleProcessorDriverScatter{handler:handler}
-  self54.processorMap["driverScatter3"] = &iCommModuleProcessorDriverScatter3{handler:handler}
-  self54.processorMap["importData"] = &iCommModuleProcessorImportData{handler:handler}
-  self54.processorMap["importData4"] = &iCommModuleProcessorImportData4{handler:handler}
-return self54
+  self63 := &ICommModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self63.processorMap["openGroup"] = &iCommModuleProcessorOpenGroup{handler:handler}
+  self63.processorMap["closeGroup"] = &iCommModuleProcessorCloseGroup{handler:handler}
+  self63.processorMap["joinToGroup"] = &iCommModuleProcessorJoinToGroup{handler:handler}
+  self63.processorMap["joinToGroupName"] = &iCommModuleProcessorJoinToGroupName{handler:handler}
+  self63.processorMap["hasGroup"] = &iCommModuleProcessorHasGroup{handler:handler}
+  self63.processorMap["destroyGroup"] = &iCommModuleProcessorDestroyGroup{handler:handler}
+  self63.processorMap["destroyGroups"] = &iCommModuleProcessorDestroyGroups{handler:handler}
+  self63.processorMap["getProtocol"] = &iCommModuleProcessorGetProtocol{handler:handler}
+  self63.processorMap["getPartitions"] = &iCommModuleProcessorGetPartitions{handler:handler}
+  self63.processorMap["getPartitions2"] = &iCommModuleProcessorGetPartitions2{handler:handler}
+  self63.processorMap["setPartitions"] = &iCommModuleProcessorSetPartitions{handler:handler}
+  self63.processorMap["setPartitions2"] = &iCommModuleProcessorSetPartitions2{handler:handler}
+  self63.processorMap["driverGather"] = &iCommModuleProcessorDriverGather{handler:handler}
+  self63.processorMap["driverGather0"] = &iCommModuleProcessorDriverGather0{handler:handler}
+  self63.processorMap["driverScatter"] = &iCommModuleProcessorDriverScatter{handler:handler}
+  self63.processorMap["driverScatter3"] = &iCommModuleProcessorDriverScatter3{handler:handler}
+  self63.processorMap["importData"] = &iCommModuleProcessorImportData{handler:handler}
+  self63.processorMap["importData4"] = &iCommModuleProcessorImportData4{handler:handler}
+  self63.processorMap["broadcast"] = &iCommModuleProcessorBroadcast{handler:handler}
+  self63.processorMap["removeBroadcast"] = &iCommModuleProcessorRemoveBroadcast{handler:handler}
+  self63.processorMap["reduceAccumulators"] = &iCommModuleProcessorReduceAccumulators{handler:handler}
+return self63
 }
 
 func (p *ICommModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -547,12 +617,12 @@ func (p *ICommModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.
   }
   iprot.Skip(ctx, thrift.STRUCT)
   iprot.ReadMessageEnd(ctx)
-  x55 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  x64 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
   oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-  x55.Write(ctx, oprot)
+  x64.Write(ctx, oprot)
   oprot.WriteMessageEnd(ctx)
   oprot.Flush(ctx)
-  return false, x55
+  return false, x64
 
 }
 
@@ -766,7 +836,7 @@ func (p *iCommModuleProcessorJoinToGroup) Process(ctx context.Context, seqId int
   }
 
   result := ICommModuleJoinToGroupResult{}
-  if err2 = p.handler.JoinToGroup(ctx, args.ID, args.Leader); err2 != nil {
+  if err2 = p.handler.JoinToGroup(ctx, args.Id, args.Leader); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -847,7 +917,7 @@ func (p *iCommModuleProcessorJoinToGroupName) Process(ctx context.Context, seqId
   }
 
   result := ICommModuleJoinToGroupNameResult{}
-  if err2 = p.handler.JoinToGroupName(ctx, args.ID, args.Leader, args.Name); err2 != nil {
+  if err2 = p.handler.JoinToGroupName(ctx, args.Id, args.Leader, args.Name); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2029,6 +2099,252 @@ func (p *iCommModuleProcessorImportData4) Process(ctx context.Context, seqId int
   return true, err
 }
 
+type iCommModuleProcessorBroadcast struct {
+  handler ICommModule
+}
+
+func (p *iCommModuleProcessorBroadcast) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ICommModuleBroadcastArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "broadcast", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := ICommModuleBroadcastResult{}
+  if err2 = p.handler.Broadcast(ctx, args.Name, args.Value); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing broadcast: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "broadcast", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "broadcast", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iCommModuleProcessorRemoveBroadcast struct {
+  handler ICommModule
+}
+
+func (p *iCommModuleProcessorRemoveBroadcast) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ICommModuleRemoveBroadcastArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "removeBroadcast", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := ICommModuleRemoveBroadcastResult{}
+  if err2 = p.handler.RemoveBroadcast(ctx, args.Name); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing removeBroadcast: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "removeBroadcast", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "removeBroadcast", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iCommModuleProcessorReduceAccumulators struct {
+  handler ICommModule
+}
+
+func (p *iCommModuleProcessorReduceAccumulators) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ICommModuleReduceAccumulatorsArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "reduceAccumulators", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := ICommModuleReduceAccumulatorsResult{}
+  var retval map[string][]byte
+  if retval, err2 = p.handler.ReduceAccumulators(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reduceAccumulators: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "reduceAccumulators", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  } else {
+    result.Success = retval
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "reduceAccumulators", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
 
 // HELPER FUNCTIONS AND STRUCTURES
 
@@ -2380,10 +2696,10 @@ func (p *ICommModuleCloseGroupResult) String() string {
 }
 
 // Attributes:
-//  - ID
+//  - Id
 //  - Leader
 type ICommModuleJoinToGroupArgs struct {
-  ID string `thrift:"id,1" db:"id" json:"id"`
+  Id string `thrift:"id,1" db:"id" json:"id"`
   Leader bool `thrift:"leader,2" db:"leader" json:"leader"`
 }
 
@@ -2392,8 +2708,8 @@ func NewICommModuleJoinToGroupArgs() *ICommModuleJoinToGroupArgs {
 }
 
 
-func (p *ICommModuleJoinToGroupArgs) GetID() string {
-  return p.ID
+func (p *ICommModuleJoinToGroupArgs) GetId() string {
+  return p.Id
 }
 
 func (p *ICommModuleJoinToGroupArgs) GetLeader() bool {
@@ -2451,7 +2767,7 @@ func (p *ICommModuleJoinToGroupArgs)  ReadField1(ctx context.Context, iprot thri
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ID = v
+  p.Id = v
 }
   return nil
 }
@@ -2482,7 +2798,7 @@ func (p *ICommModuleJoinToGroupArgs) Write(ctx context.Context, oprot thrift.TPr
 func (p *ICommModuleJoinToGroupArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "id", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.ID)); err != nil {
+  if err := oprot.WriteString(ctx, string(p.Id)); err != nil {
   return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
@@ -2607,11 +2923,11 @@ func (p *ICommModuleJoinToGroupResult) String() string {
 }
 
 // Attributes:
-//  - ID
+//  - Id
 //  - Leader
 //  - Name
 type ICommModuleJoinToGroupNameArgs struct {
-  ID string `thrift:"id,1" db:"id" json:"id"`
+  Id string `thrift:"id,1" db:"id" json:"id"`
   Leader bool `thrift:"leader,2" db:"leader" json:"leader"`
   Name string `thrift:"name,3" db:"name" json:"name"`
 }
@@ -2621,8 +2937,8 @@ func NewICommModuleJoinToGroupNameArgs() *ICommModuleJoinToGroupNameArgs {
 }
 
 
-func (p *ICommModuleJoinToGroupNameArgs) GetID() string {
-  return p.ID
+func (p *ICommModuleJoinToGroupNameArgs) GetId() string {
+  return p.Id
 }
 
 func (p *ICommModuleJoinToGroupNameArgs) GetLeader() bool {
@@ -2694,7 +3010,7 @@ func (p *ICommModuleJoinToGroupNameArgs)  ReadField1(ctx context.Context, iprot
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ID = v
+  p.Id = v
 }
   return nil
 }
@@ -2735,7 +3051,7 @@ func (p *ICommModuleJoinToGroupNameArgs) Write(ctx context.Context, oprot thrift
 func (p *ICommModuleJoinToGroupNameArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "id", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:id: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.ID)); err != nil {
+  if err := oprot.WriteString(ctx, string(p.Id)); err != nil {
   return thrift.PrependError(fmt.Sprintf("%T.id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field end error 1:id: ", p), err) }
@@ -3824,13 +4140,13 @@ func (p *ICommModuleGetPartitionsResult)  ReadField0(ctx context.Context, iprot
   tSlice := make([][]byte, 0, size)
   p.Success =  tSlice
   for i := 0; i < size; i ++ {
-var _elem56 []byte
+var _elem65 []byte
     if v, err := iprot.ReadBinary(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _elem56 = v
+    _elem65 = v
 }
-    p.Success = append(p.Success, _elem56)
+    p.Success = append(p.Success, _elem65)
   }
   if err := iprot.ReadListEnd(ctx); err != nil {
     return thrift.PrependError("error reading list end: ", err)
@@ -4116,13 +4432,13 @@ func (p *ICommModuleGetPartitions2Result)  ReadField0(ctx context.Context, iprot
   tSlice := make([][]byte, 0, size)
   p.Success =  tSlice
   for i := 0; i < size; i ++ {
-var _elem57 []byte
+var _elem66 []byte
     if v, err := iprot.ReadBinary(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _elem57 = v
+    _elem66 = v
 }
-    p.Success = append(p.Success, _elem57)
+    p.Success = append(p.Success, _elem66)
   }
   if err := iprot.ReadListEnd(ctx); err != nil {
     return thrift.PrependError("error reading list end: ", err)
@@ -4252,13 +4568,13 @@ func (p *ICommModuleSetPartitionsArgs)  ReadField1(ctx context.Context, iprot th
   tSlice := make([][]byte, 0, size)
   p.Partitions =  tSlice
   for i := 0; i < size; i ++ {
-var _elem58 []byte
+var _elem67 []byte
     if v, err := iprot.ReadBinary(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _elem58 = v
+    _elem67 = v
 }
-    p.Partitions = append(p.Partitions, _elem58)
+    p.Partitions = append(p.Partitions, _elem67)
   }
   if err := iprot.ReadListEnd(ctx); err != nil {
     return thrift.PrependError("error reading list end: ", err)
@@ -4487,13 +4803,13 @@ func (p *ICommModuleSetPartitions2Args)  ReadField1(ctx context.Context, iprot t
   tSlice := make([][]byte, 0, size)
   p.Partitions =  tSlice
   for i := 0; i < size; i ++ {
-var _elem59 []byte
+var _elem68 []byte
     if v, err := iprot.ReadBinary(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _elem59 = v
+    _elem68 = v
 }
-    p.Partitions = append(p.Partitions, _elem59)
+    p.Partitions = append(p.Partitions, _elem68)
   }
   if err := iprot.ReadListEnd(ctx); err != nil {
     return thrift.PrependError("error reading list end: ", err)
@@ -6208,4 +6524,645 @@ func (p *ICommModuleImportData4Result) String() string {
   return fmt.Sprintf("ICommModuleImportData4Result(%+v)", *p)
 }
 
+// Attributes:
+//  - Name
+//  - Value
+type ICommModuleBroadcastArgs struct {
+  Name string `thrift:"name,1" db:"name" json:"name"`
+  Value []byte `thrift:"value,2" db:"value" json:"value"`
+}
+
+func NewICommModuleBroadcastArgs() *ICommModuleBroadcastArgs {
+  return &ICommModuleBroadcastArgs{}
+}
+
+
+func (p *ICommModuleBroadcastArgs) GetName() string {
+  return p.Name
+}
+
+func (p *ICommModuleBroadcastArgs) GetValue() []byte {
+  return p.Value
+}
+func (p *ICommModuleBroadcastArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleBroadcastArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Name = v
+}
+  return nil
+}
+
+func (p *ICommModuleBroadcastArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBinary(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Value = v
+}
+  return nil
+}
+
+func (p *ICommModuleBroadcastArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "broadcast_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICommModuleBroadcastArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err) }
+  return err
+}
+
+func (p *ICommModuleBroadcastArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "value", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:value: ", p), err) }
+  if err := oprot.WriteBinary(ctx, p.Value); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.value (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:value: ", p), err) }
+  return err
+}
+
+func (p *ICommModuleBroadcastArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICommModuleBroadcastArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type ICommModuleBroadcastResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICommModuleBroadcastResult() *ICommModuleBroadcastResult {
+  return &ICommModuleBroadcastResult{}
+}
+
+var ICommModuleBroadcastResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICommModuleBroadcastResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICommModuleBroadcastResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICommModuleBroadcastResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICommModuleBroadcastResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleBroadcastResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleBroadcastResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "broadcast_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICommModuleBroadcastResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICommModuleBroadcastResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICommModuleBroadcastResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Name
+type ICommModuleRemoveBroadcastArgs struct {
+  Name string `thrift:"name,1" db:"name" json:"name"`
+}
+
+func NewICommModuleRemoveBroadcastArgs() *ICommModuleRemoveBroadcastArgs {
+  return &ICommModuleRemoveBroadcastArgs{}
+}
+
+
+func (p *ICommModuleRemoveBroadcastArgs) GetName() string {
+  return p.Name
+}
+func (p *ICommModuleRemoveBroadcastArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleRemoveBroadcastArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Name = v
+}
+  return nil
+}
+
+func (p *ICommModuleRemoveBroadcastArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "removeBroadcast_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICommModuleRemoveBroadcastArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "name", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:name: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Name)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.name (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:name: ", p), err) }
+  return err
+}
+
+func (p *ICommModuleRemoveBroadcastArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICommModuleRemoveBroadcastArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type ICommModuleRemoveBroadcastResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICommModuleRemoveBroadcastResult() *ICommModuleRemoveBroadcastResult {
+  return &ICommModuleRemoveBroadcastResult{}
+}
+
+var ICommModuleRemoveBroadcastResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICommModuleRemoveBroadcastResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICommModuleRemoveBroadcastResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICommModuleRemoveBroadcastResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICommModuleRemoveBroadcastResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleRemoveBroadcastResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleRemoveBroadcastResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "removeBroadcast_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICommModuleRemoveBroadcastResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICommModuleRemoveBroadcastResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICommModuleRemoveBroadcastResult(%+v)", *p)
+}
+
+type ICommModuleReduceAccumulatorsArgs struct {
+}
+
+func NewICommModuleReduceAccumulatorsArgs() *ICommModuleReduceAccumulatorsArgs {
+  return &ICommModuleReduceAccumulatorsArgs{}
+}
+
+func (p *ICommModuleReduceAccumulatorsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleReduceAccumulatorsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reduceAccumulators_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICommModuleReduceAccumulatorsArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICommModuleReduceAccumulatorsArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ex
+type ICommModuleReduceAccumulatorsResult struct {
+  Success map[string][]byte `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewICommModuleReduceAccumulatorsResult() *ICommModuleReduceAccumulatorsResult {
+  return &ICommModuleReduceAccumulatorsResult{}
+}
+
+var ICommModuleReduceAccumulatorsResult_Success_DEFAULT map[string][]byte
+
+func (p *ICommModuleReduceAccumulatorsResult) GetSuccess() map[string][]byte {
+  return p.Success
+}
+var ICommModuleReduceAccumulatorsResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *ICommModuleReduceAccumulatorsResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return ICommModuleReduceAccumulatorsResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *ICommModuleReduceAccumulatorsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ICommModuleReduceAccumulatorsResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *ICommModuleReduceAccumulatorsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.MAP {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleReduceAccumulatorsResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  _, _, size, err := iprot.ReadMapBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading map begin: ", err)
+  }
+  tMap := make(map[string][]byte, size)
+  p.Success =  tMap
+  for i := 0; i < size; i ++ {
+var _key69 string
+    if v, err := iprot.ReadString(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _key69 = v
+}
+var _val70 []byte
+    if v, err := iprot.ReadBinary(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _val70 = v
+}
+    p.Success[_key69] = _val70
+  }
+  if err := iprot.ReadMapEnd(ctx); err != nil {
+    return thrift.PrependError("error reading map end: ", err)
+  }
+  return nil
+}
+
+func (p *ICommModuleReduceAccumulatorsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *ICommModuleReduceAccumulatorsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reduceAccumulators_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ICommModuleReduceAccumulatorsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.MAP, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteMapBegin(ctx, thrift.STRING, thrift.STRING, len(p.Success)); err != nil {
+      return thrift.PrependError("error writing map begin: ", err)
+    }
+    for k, v := range p.Success {
+      if err := oprot.WriteString(ctx, string(k)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+      if err := oprot.WriteBinary(ctx, v); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+    }
+    if err := oprot.WriteMapEnd(ctx); err != nil {
+      return thrift.PrependError("error writing map end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICommModuleReduceAccumulatorsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *ICommModuleReduceAccumulatorsResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ICommModuleReduceAccumulatorsResult(%+v)", *p)
+}
+
 
@@ -26,6 +26,9 @@ type IExecutorServerModule interface {
   //  - Env
   Start(ctx context.Context, properties map[string]string, env map[string]string) (_err error)
   Stop(ctx context.Context) (_err error)
+  // Parameters:
+  //  - Reason
+  Cancel(ctx context.Context, reason string) (_err error)
   Test(ctx context.Context) (_r bool, _err error)
 }
 
@@ -103,21 +106,41 @@ func (p *IExecutorServerModuleClient) Stop(ctx context.Context) (_err error) {
   return nil
 }
 
-func (p *IExecutorServerModuleClient) Test(ctx context.Context) (_r bool, _err error) {
-  var _args6 IExecutorServerModuleTestArgs
-  var _result8 IExecutorServerModuleTestResult
+// Parameters:
+//  - Reason
+func (p *IExecutorServerModuleClient) Cancel(ctx context.Context, reason string) (_err error) {
+  var _args6 IExecutorServerModuleCancelArgs
+  _args6.Reason = reason
+  var _result8 IExecutorServerModuleCancelResult
   var _meta7 thrift.ResponseMeta
-  _meta7, _err = p.Client_().Call(ctx, "test", &_args6, &_result8)
+  _meta7, _err = p.Client_().Call(ctx, "cancel", &_args6, &_result8)
   p.SetLastResponseMeta_(_meta7)
   if _err != nil {
     return
   }
   switch {
   case _result8.Ex!= nil:
-    return _r, _result8.Ex
+    return _result8.Ex
   }
 
-  return _result8.GetSuccess(), nil
+  return nil
+}
+
+func (p *IExecutorServerModuleClient) Test(ctx context.Context) (_r bool, _err error) {
+  var _args9 IExecutorServerModuleTestArgs
+  var _result11 IExecutorServerModuleTestResult
+  var _meta10 thrift.ResponseMeta
+  _meta10, _err = p.Client_().Call(ctx, "test", &_args9, &_result11)
+  p.SetLastResponseMeta_(_meta10)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result11.Ex!= nil:
+    return _r, _result11.Ex
+  }
+
+  return _result11.GetSuccess(), nil
 }
 
 type IExecutorServerModuleProcessor struct {
@@ -140,11 +163,12 @@ func (p *IExecutorServerModuleProcessor) ProcessorMap() map[string]thrift.TProce
 
 func NewIExecutorServerModuleProcessor(handler IExecutorServerModule) *IExecutorServerModuleProcessor {
 
-  self9 := &IExecutorServerModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self9.processorMap["start"] = &iExecutorServerModuleProcessorStart{handler:handler}
-  self9.processorMap["stop"] = &iExecutorServerModuleProcessorStop{handler:handler}
-  self9.processorMap["test"] = &iExecutorServerModuleProcessorTest{handler:handler}
-return self9
+  self12 := &IExecutorServerModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self12.processorMap["start"] = &iExecutorServerModuleProcessorStart{handler:handler}
+  self12.processorMap["stop"] = &iExecutorServerModuleProcessorStop{handler:handler}
+  self12.processorMap["cancel"] = &iExecutorServerModuleProcessorCancel{handler:handler}
+  self12.processorMap["test"] = &iExecutorServerModuleProcessorTest{handler:handler}
+return self12
 }
 
 func (p *IExecutorServerModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -155,12 +179,12 @@ func (p *IExecutorServerModuleProcessor) Process(ctx context.Context, iprot, opr
   }
   iprot.Skip(ctx, thrift.STRUCT)
   iprot.ReadMessageEnd(ctx)
-  x10 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  x13 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
   oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-  x10.Write(ctx, oprot)
+  x13.Write(ctx, oprot)
   oprot.WriteMessageEnd(ctx)
   oprot.Flush(ctx)
-  return false, x10
+  return false, x13
 
 }
 
@@ -326,6 +350,87 @@ func (p *iExecutorServerModuleProcessorStop) Process(ctx context.Context, seqId
   return true, err
 }
 
+type iExecutorServerModuleProcessorCancel struct {
+  handler IExecutorServerModule
+}
+
+func (p *iExecutorServerModuleProcessorCancel) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IExecutorServerModuleCancelArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "cancel", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IExecutorServerModuleCancelResult{}
+  if err2 = p.handler.Cancel(ctx, args.Reason); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing cancel: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "cancel", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "cancel", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
 type iExecutorServerModuleProcessorTest struct {
   handler IExecutorServerModule
 }
@@ -489,19 +594,19 @@ func (p *IExecutorServerModuleStartArgs)  ReadField1(ctx context.Context, iprot
   tMap := make(map[string]string, size)
   p.Properties =  tMap
   for i := 0; i < size; i ++ {
-var _key11 string
+var _key14 string
     if v, err := iprot.ReadString(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _key11 = v
+    _key14 = v
 }
-var _val12 string
+var _val15 string
     if v, err := iprot.ReadString(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _val12 = v
+    _val15 = v
 }
-    p.Properties[_key11] = _val12
+    p.Properties[_key14] = _val15
   }
   if err := iprot.ReadMapEnd(ctx); err != nil {
     return thrift.PrependError("error reading map end: ", err)
@@ -517,19 +622,19 @@ func (p *IExecutorServerModuleStartArgs)  ReadField2(ctx context.Context, iprot
   tMap := make(map[string]string, size)
   p.Env =  tMap
   for i := 0; i < size; i ++ {
-var _key13 string
+var _key16 string
     if v, err := iprot.ReadString(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _key13 = v
+    _key16 = v
 }
-var _val14 string
+var _val17 string
     if v, err := iprot.ReadString(ctx); err != nil {
     return thrift.PrependError("error reading field 0: ", err)
 } else {
-    _val14 = v
+    _val17 = v
 }
-    p.Env[_key13] = _val14
+    p.Env[_key16] = _val17
   }
   if err := iprot.ReadMapEnd(ctx); err != nil {
     return thrift.PrependError("error reading map end: ", err)
@@ -849,6 +954,197 @@ func (p *IExecutorServerModuleStopResult) String() string {
   return fmt.Sprintf("IExecutorServerModuleStopResult(%+v)", *p)
 }
 
+// Attributes:
+//  - Reason
+type IExecutorServerModuleCancelArgs struct {
+  Reason string `thrift:"reason,1" db:"reason" json:"reason"`
+}
+
+func NewIExecutorServerModuleCancelArgs() *IExecutorServerModuleCancelArgs {
+  return &IExecutorServerModuleCancelArgs{}
+}
+
+
+func (p *IExecutorServerModuleCancelArgs) GetReason() string {
+  return p.Reason
+}
+func (p *IExecutorServerModuleCancelArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IExecutorServerModuleCancelArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Reason = v
+}
+  return nil
+}
+
+func (p *IExecutorServerModuleCancelArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "cancel_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IExecutorServerModuleCancelArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "reason", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:reason: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Reason)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.reason (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:reason: ", p), err) }
+  return err
+}
+
+func (p *IExecutorServerModuleCancelArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IExecutorServerModuleCancelArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IExecutorServerModuleCancelResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIExecutorServerModuleCancelResult() *IExecutorServerModuleCancelResult {
+  return &IExecutorServerModuleCancelResult{}
+}
+
+var IExecutorServerModuleCancelResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IExecutorServerModuleCancelResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IExecutorServerModuleCancelResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IExecutorServerModuleCancelResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IExecutorServerModuleCancelResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IExecutorServerModuleCancelResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IExecutorServerModuleCancelResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "cancel_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IExecutorServerModuleCancelResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IExecutorServerModuleCancelResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IExecutorServerModuleCancelResult(%+v)", *p)
+}
+
 type IExecutorServerModuleTestArgs struct {
 }
 
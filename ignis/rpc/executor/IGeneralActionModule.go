@@ -77,6 +77,9 @@ type IGeneralActionModule interface {
   TakeOrdered2(ctx context.Context, num int64, cmp *rpc.ISource) (_err error)
   Keys(ctx context.Context) (_err error)
   Values(ctx context.Context) (_err error)
+  // Parameters:
+  //  - Src
+  ReduceByKeyLocally(ctx context.Context, src *rpc.ISource) (_err error)
 }
 
 type IGeneralActionModuleClient struct {
@@ -481,6 +484,26 @@ func (p *IGeneralActionModuleClient) Values(ctx context.Context) (_err error) {
   return nil
 }
 
+// Parameters:
+//  - Src
+func (p *IGeneralActionModuleClient) ReduceByKeyLocally(ctx context.Context, src *rpc.ISource) (_err error) {
+  var _args54 IGeneralActionModuleReduceByKeyLocallyArgs
+  _args54.Src = src
+  var _result56 IGeneralActionModuleReduceByKeyLocallyResult
+  var _meta55 thrift.ResponseMeta
+  _meta55, _err = p.Client_().Call(ctx, "reduceByKeyLocally", &_args54, &_result56)
+  p.SetLastResponseMeta_(_meta55)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result56.Ex!= nil:
+    return _result56.Ex
+  }
+
+  return nil
+}
+
 type IGeneralActionModuleProcessor struct {
   processorMap map[string]thrift.TProcessorFunction
   handler IGeneralActionModule
@@ -501,26 +524,27 @@ func (p *IGeneralActionModuleProcessor) ProcessorMap() map[string]thrift.TProces
 
 func NewIGeneralActionModuleProcessor(handler IGeneralActionModule) *IGeneralActionModuleProcessor {
 
-  self54 := &IGeneralActionModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self54.processorMap["execute"] = &iGeneralActionModuleProcessorExecute{handler:handler}
-  self54.processorMap["reduce"] = &iGeneralActionModuleProcessorReduce{handler:handler}
-  self54.processorMap["treeReduce"] = &iGeneralActionModuleProcessorTreeReduce{handler:handler}
-  self54.processorMap["collect"] = &iGeneralActionModuleProcessorCollect{handler:handler}
-  self54.processorMap["aggregate"] = &iGeneralActionModuleProcessorAggregate{handler:handler}
-  self54.processorMap["treeAggregate"] = &iGeneralActionModuleProcessorTreeAggregate{handler:handler}
-  self54.processorMap["fold"] = &iGeneralActionModuleProcessorFold{handler:handler}
-  self54.processorMap["treeFold"] = &iGeneralActionModuleProcessorTreeFold{handler:handler}
-  self54.processorMap["take"] = &iGeneralActionModuleProcessorTake{handler:handler}
-  self54.processorMap["foreach_"] = &iGeneralActionModuleProcessorForeach_{handler:handler}
-  self54.processorMap["foreachPartition"] = &iGeneralActionModuleProcessorForeachPartition{handler:handler}
-  self54.processorMap["foreachExecutor"] = &iGeneralActionModuleProcessorForeachExecutor{handler:handler}
-  self54.processorMap["top"] = &iGeneralActionModuleProcessorTop{handler:handler}
-  self54.processorMap["top2"] = &iGeneralActionModuleProcessorTop2{handler:handler}
-  self54.processorMap["takeOrdered"] = &iGeneralActionModuleProcessorTakeOrdered{handler:handler}
-  self54.processorMap["takeOrdered2"] = &iGeneralActionModuleProcessorTakeOrdered2{handler:handler}
-  self54.processorMap["keys"] = &iGeneralActionModuleProcessorKeys{handler:handler}
-  self54.processorMap["values"] = &iGeneralActionModuleProcessorValues{handler:handler}
-return self54
+  self57 := &IGeneralActionModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self57.processorMap["execute"] = &iGeneralActionModuleProcessorExecute{handler:handler}
+  self57.processorMap["reduce"] = &iGeneralActionModuleProcessorReduce{handler:handler}
+  self57.processorMap["treeReduce"] = &iGeneralActionModuleProcessorTreeReduce{handler:handler}
+  self57.processorMap["collect"] = &iGeneralActionModuleProcessorCollect{handler:handler}
+  self57.processorMap["aggregate"] = &iGeneralActionModuleProcessorAggregate{handler:handler}
+  self57.processorMap["treeAggregate"] = &iGeneralActionModuleProcessorTreeAggregate{handler:handler}
+  self57.processorMap["fold"] = &iGeneralActionModuleProcessorFold{handler:handler}
+  self57.processorMap["treeFold"] = &iGeneralActionModuleProcessorTreeFold{handler:handler}
+  self57.processorMap["take"] = &iGeneralActionModuleProcessorTake{handler:handler}
+  self57.processorMap["foreach_"] = &iGeneralActionModuleProcessorForeach_{handler:handler}
+  self57.processorMap["foreachPartition"] = &iGeneralActionModuleProcessorForeachPartition{handler:handler}
+  self57.processorMap["foreachExecutor"] = &iGeneralActionModuleProcessorForeachExecutor{handler:handler}
+  self57.processorMap["top"] = &iGeneralActionModuleProcessorTop{handler:handler}
+  self57.processorMap["top2"] = &iGeneralActionModuleProcessorTop2{handler:handler}
+  self57.processorMap["takeOrdered"] = &iGeneralActionModuleProcessorTakeOrdered{handler:handler}
+  self57.processorMap["takeOrdered2"] = &iGeneralActionModuleProcessorTakeOrdered2{handler:handler}
+  self57.processorMap["keys"] = &iGeneralActionModuleProcessorKeys{handler:handler}
+  self57.processorMap["values"] = &iGeneralActionModuleProcessorValues{handler:handler}
+  self57.processorMap["reduceByKeyLocally"] = &iGeneralActionModuleProcessorReduceByKeyLocally{handler:handler}
+return self57
 }
 
 func (p *IGeneralActionModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -531,12 +555,12 @@ func (p *IGeneralActionModuleProcessor) Process(ctx context.Context, iprot, opro
   }
   iprot.Skip(ctx, thrift.STRUCT)
   iprot.ReadMessageEnd(ctx)
-  x55 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  x58 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
   oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-  x55.Write(ctx, oprot)
+  x58.Write(ctx, oprot)
   oprot.WriteMessageEnd(ctx)
   oprot.Flush(ctx)
-  return false, x55
+  return false, x58
 
 }
 
@@ -1998,6 +2022,87 @@ func (p *iGeneralActionModuleProcessorValues) Process(ctx context.Context, seqId
   return true, err
 }
 
+type iGeneralActionModuleProcessorReduceByKeyLocally struct {
+  handler IGeneralActionModule
+}
+
+func (p *iGeneralActionModuleProcessorReduceByKeyLocally) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralActionModuleReduceByKeyLocallyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "reduceByKeyLocally", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralActionModuleReduceByKeyLocallyResult{}
+  if err2 = p.handler.ReduceByKeyLocally(ctx, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reduceByKeyLocally: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "reduceByKeyLocally", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "reduceByKeyLocally", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
 
 // HELPER FUNCTIONS AND STRUCTURES
 
@@ -5787,4 +5892,205 @@ func (p *IGeneralActionModuleValuesResult) String() string {
   return fmt.Sprintf("IGeneralActionModuleValuesResult(%+v)", *p)
 }
 
+// Attributes:
+//  - Src
+type IGeneralActionModuleReduceByKeyLocallyArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralActionModuleReduceByKeyLocallyArgs() *IGeneralActionModuleReduceByKeyLocallyArgs {
+  return &IGeneralActionModuleReduceByKeyLocallyArgs{}
+}
+
+var IGeneralActionModuleReduceByKeyLocallyArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralActionModuleReduceByKeyLocallyArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralActionModuleReduceByKeyLocallyArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralActionModuleReduceByKeyLocallyArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reduceByKeyLocally_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralActionModuleReduceByKeyLocallyArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralActionModuleReduceByKeyLocallyResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralActionModuleReduceByKeyLocallyResult() *IGeneralActionModuleReduceByKeyLocallyResult {
+  return &IGeneralActionModuleReduceByKeyLocallyResult{}
+}
+
+var IGeneralActionModuleReduceByKeyLocallyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralActionModuleReduceByKeyLocallyResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralActionModuleReduceByKeyLocallyResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralActionModuleReduceByKeyLocallyResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reduceByKeyLocally_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralActionModuleReduceByKeyLocallyResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralActionModuleReduceByKeyLocallyResult(%+v)", *p)
+}
+
 
@@ -47,11 +47,36 @@ type IGeneralModule interface {
   MapPartitionsWithIndex(ctx context.Context, src *rpc.ISource) (_err error)
   // Parameters:
   //  - Src
+  //  - K
+  MapPartitionsWithBoundary(ctx context.Context, src *rpc.ISource, k int64) (_err error)
+  // Parameters:
+  //  - Src
   MapExecutor(ctx context.Context, src *rpc.ISource) (_err error)
   // Parameters:
   //  - Src
   MapExecutorTo(ctx context.Context, src *rpc.ISource) (_err error)
   // Parameters:
+  //  - Command
+  //  - Env
+  //  - Encoding
+  PipeCmd(ctx context.Context, command []string, env []string, encoding string) (_err error)
+  // Parameters:
+  //  - Paths
+  Select(ctx context.Context, paths []string) (_err error)
+  // Parameters:
+  //  - Src
+  //  - Paths
+  SelectTo(ctx context.Context, src *rpc.ISource, paths []string) (_err error)
+  // Parameters:
+  //  - Src
+  //  - Path
+  Explode(ctx context.Context, src *rpc.ISource, path string) (_err error)
+  // Parameters:
+  //  - Src
+  //  - Path
+  //  - Paths
+  ExplodeSelect(ctx context.Context, src *rpc.ISource, path string, paths []string) (_err error)
+  // Parameters:
   //  - Src
   //  - NumPartitions
   GroupBy(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error)
@@ -81,6 +106,15 @@ type IGeneralModule interface {
   //  - Src
   Union2(ctx context.Context, other string, preserveOrder bool, src *rpc.ISource) (_err error)
   // Parameters:
+  //  - Others
+  //  - Rebalance
+  UnionAll(ctx context.Context, others []string, rebalance bool) (_err error)
+  // Parameters:
+  //  - Other
+  //  - Src
+  Zip(ctx context.Context, other string, src *rpc.ISource) (_err error)
+  ZipWithIndex(ctx context.Context) (_err error)
+  // Parameters:
   //  - Other
   //  - NumPartitions
   Join(ctx context.Context, other string, numPartitions int64) (_err error)
@@ -90,6 +124,22 @@ type IGeneralModule interface {
   //  - Src
   Join3(ctx context.Context, other string, numPartitions int64, src *rpc.ISource) (_err error)
   // Parameters:
+  //  - Other
+  //  - NumPartitions
+  LeftOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error)
+  // Parameters:
+  //  - Other
+  //  - NumPartitions
+  RightOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error)
+  // Parameters:
+  //  - Other
+  //  - NumPartitions
+  FullOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error)
+  // Parameters:
+  //  - Other
+  //  - NumPartitions
+  CoGroup(ctx context.Context, other string, numPartitions int64) (_err error)
+  // Parameters:
   //  - NumPartitions
   Distinct(ctx context.Context, numPartitions int64) (_err error)
   // Parameters:
@@ -97,12 +147,28 @@ type IGeneralModule interface {
   //  - Src
   Distinct2(ctx context.Context, numPartitions int64, src *rpc.ISource) (_err error)
   // Parameters:
+  //  - Other
+  //  - NumPartitions
+  Intersection(ctx context.Context, other string, numPartitions int64) (_err error)
+  // Parameters:
+  //  - Other
+  //  - NumPartitions
+  Subtract(ctx context.Context, other string, numPartitions int64) (_err error)
+  // Parameters:
+  //  - Other
+  //  - NumPartitions
+  SubtractByKey(ctx context.Context, other string, numPartitions int64) (_err error)
+  // Parameters:
   //  - NumPartitions
   //  - PreserveOrdering
   //  - Global_
   Repartition(ctx context.Context, numPartitions int64, preserveOrdering bool, global_ bool) (_err error)
   // Parameters:
   //  - NumPartitions
+  //  - Shuffle
+  Coalesce(ctx context.Context, numPartitions int64, shuffle bool) (_err error)
+  // Parameters:
+  //  - NumPartitions
   //  - Seed
   PartitionByRandom(ctx context.Context, numPartitions int64, seed int32) (_err error)
   // Parameters:
@@ -113,6 +179,21 @@ type IGeneralModule interface {
   //  - NumPartitions
   PartitionBy(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error)
   // Parameters:
+  //  - NumPartitions
+  PartitionByKeyHash(ctx context.Context, numPartitions int64) (_err error)
+  // Parameters:
+  //  - NumPartitions
+  PartitionByKeyRange(ctx context.Context, numPartitions int64) (_err error)
+  // Parameters:
+  //  - Src
+  //  - NumPartitions
+  PartitionByKey(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error)
+  // Parameters:
+  //  - Order
+  ReorderPartitions(ctx context.Context, order []int64) (_err error)
+  ReorderPartitionsBy(ctx context.Context) (_err error)
+  PartitionOffset(ctx context.Context) (_r int64, _err error)
+  // Parameters:
   //  - Src
   FlatMapValues(ctx context.Context, src *rpc.ISource) (_err error)
   // Parameters:
@@ -148,6 +229,20 @@ type IGeneralModule interface {
   //  - LocalFold
   FoldByKey(ctx context.Context, zero *rpc.ISource, src *rpc.ISource, numPartitions int64, localFold bool) (_err error)
   // Parameters:
+  //  - Key
+  //  - Column
+  //  - Agg
+  //  - NumPartitions
+  Pivot(ctx context.Context, key *rpc.ISource, column *rpc.ISource, agg *rpc.ISource, numPartitions int64) (_r string, _err error)
+  // Parameters:
+  //  - Src
+  //  - Columns
+  Unpivot(ctx context.Context, src *rpc.ISource, columns string) (_err error)
+  // Parameters:
+  //  - Zero
+  //  - Src
+  Scan(ctx context.Context, zero *rpc.ISource, src *rpc.ISource) (_err error)
+  // Parameters:
   //  - Ascending
   SortByKey(ctx context.Context, ascending bool) (_err error)
   // Parameters:
@@ -163,6 +258,27 @@ type IGeneralModule interface {
   //  - Ascending
   //  - NumPartitions
   SortByKey3(ctx context.Context, src *rpc.ISource, ascending bool, numPartitions int64) (_err error)
+  // Parameters:
+  //  - NumPartitions
+  //  - Ascending
+  RepartitionAndSortWithinPartitions(ctx context.Context, numPartitions int64, ascending bool) (_err error)
+  // Parameters:
+  //  - NumPartitions
+  //  - Ascending
+  GroupByKeyAndSortValues(ctx context.Context, numPartitions int64, ascending bool) (_err error)
+  // Parameters:
+  //  - Src
+  //  - NumPartitions
+  //  - Ascending
+  GroupByKeyAndSortValues3(ctx context.Context, src *rpc.ISource, numPartitions int64, ascending bool) (_err error)
+  // Parameters:
+  //  - Key
+  //  - Seq
+  //  - MaxGap
+  //  - NumPartitions
+  GapsAndIslands(ctx context.Context, key *rpc.ISource, seq *rpc.ISource, maxGap int64, numPartitions int64) (_err error)
+  RecomputePartitions(ctx context.Context) (_r int64, _err error)
+  PartitionStats(ctx context.Context) (_r string, _err error)
 }
 
 type IGeneralModuleClient struct {
@@ -362,12 +478,14 @@ func (p *IGeneralModuleClient) MapPartitionsWithIndex(ctx context.Context, src *
 
 // Parameters:
 //  - Src
-func (p *IGeneralModuleClient) MapExecutor(ctx context.Context, src *rpc.ISource) (_err error) {
-  var _args24 IGeneralModuleMapExecutorArgs
+//  - K
+func (p *IGeneralModuleClient) MapPartitionsWithBoundary(ctx context.Context, src *rpc.ISource, k int64) (_err error) {
+  var _args24 IGeneralModuleMapPartitionsWithBoundaryArgs
   _args24.Src = src
-  var _result26 IGeneralModuleMapExecutorResult
+  _args24.K = k
+  var _result26 IGeneralModuleMapPartitionsWithBoundaryResult
   var _meta25 thrift.ResponseMeta
-  _meta25, _err = p.Client_().Call(ctx, "mapExecutor", &_args24, &_result26)
+  _meta25, _err = p.Client_().Call(ctx, "mapPartitionsWithBoundary", &_args24, &_result26)
   p.SetLastResponseMeta_(_meta25)
   if _err != nil {
     return
@@ -382,12 +500,12 @@ func (p *IGeneralModuleClient) MapExecutor(ctx context.Context, src *rpc.ISource
 
 // Parameters:
 //  - Src
-func (p *IGeneralModuleClient) MapExecutorTo(ctx context.Context, src *rpc.ISource) (_err error) {
-  var _args27 IGeneralModuleMapExecutorToArgs
+func (p *IGeneralModuleClient) MapExecutor(ctx context.Context, src *rpc.ISource) (_err error) {
+  var _args27 IGeneralModuleMapExecutorArgs
   _args27.Src = src
-  var _result29 IGeneralModuleMapExecutorToResult
+  var _result29 IGeneralModuleMapExecutorResult
   var _meta28 thrift.ResponseMeta
-  _meta28, _err = p.Client_().Call(ctx, "mapExecutorTo", &_args27, &_result29)
+  _meta28, _err = p.Client_().Call(ctx, "mapExecutor", &_args27, &_result29)
   p.SetLastResponseMeta_(_meta28)
   if _err != nil {
     return
@@ -402,14 +520,12 @@ func (p *IGeneralModuleClient) MapExecutorTo(ctx context.Context, src *rpc.ISour
 
 // Parameters:
 //  - Src
-//  - NumPartitions
-func (p *IGeneralModuleClient) GroupBy(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error) {
-  var _args30 IGeneralModuleGroupByArgs
+func (p *IGeneralModuleClient) MapExecutorTo(ctx context.Context, src *rpc.ISource) (_err error) {
+  var _args30 IGeneralModuleMapExecutorToArgs
   _args30.Src = src
-  _args30.NumPartitions = numPartitions
-  var _result32 IGeneralModuleGroupByResult
+  var _result32 IGeneralModuleMapExecutorToResult
   var _meta31 thrift.ResponseMeta
-  _meta31, _err = p.Client_().Call(ctx, "groupBy", &_args30, &_result32)
+  _meta31, _err = p.Client_().Call(ctx, "mapExecutorTo", &_args30, &_result32)
   p.SetLastResponseMeta_(_meta31)
   if _err != nil {
     return
@@ -423,13 +539,17 @@ func (p *IGeneralModuleClient) GroupBy(ctx context.Context, src *rpc.ISource, nu
 }
 
 // Parameters:
-//  - Ascending
-func (p *IGeneralModuleClient) Sort(ctx context.Context, ascending bool) (_err error) {
-  var _args33 IGeneralModuleSortArgs
-  _args33.Ascending = ascending
-  var _result35 IGeneralModuleSortResult
+//  - Command
+//  - Env
+//  - Encoding
+func (p *IGeneralModuleClient) PipeCmd(ctx context.Context, command []string, env []string, encoding string) (_err error) {
+  var _args33 IGeneralModulePipeCmdArgs
+  _args33.Command = command
+  _args33.Env = env
+  _args33.Encoding = encoding
+  var _result35 IGeneralModulePipeCmdResult
   var _meta34 thrift.ResponseMeta
-  _meta34, _err = p.Client_().Call(ctx, "sort", &_args33, &_result35)
+  _meta34, _err = p.Client_().Call(ctx, "pipeCmd", &_args33, &_result35)
   p.SetLastResponseMeta_(_meta34)
   if _err != nil {
     return
@@ -443,15 +563,13 @@ func (p *IGeneralModuleClient) Sort(ctx context.Context, ascending bool) (_err e
 }
 
 // Parameters:
-//  - Ascending
-//  - NumPartitions
-func (p *IGeneralModuleClient) Sort2(ctx context.Context, ascending bool, numPartitions int64) (_err error) {
-  var _args36 IGeneralModuleSort2Args
-  _args36.Ascending = ascending
-  _args36.NumPartitions = numPartitions
-  var _result38 IGeneralModuleSort2Result
+//  - Paths
+func (p *IGeneralModuleClient) Select(ctx context.Context, paths []string) (_err error) {
+  var _args36 IGeneralModuleSelectArgs
+  _args36.Paths = paths
+  var _result38 IGeneralModuleSelectResult
   var _meta37 thrift.ResponseMeta
-  _meta37, _err = p.Client_().Call(ctx, "sort2", &_args36, &_result38)
+  _meta37, _err = p.Client_().Call(ctx, "select", &_args36, &_result38)
   p.SetLastResponseMeta_(_meta37)
   if _err != nil {
     return
@@ -466,14 +584,14 @@ func (p *IGeneralModuleClient) Sort2(ctx context.Context, ascending bool, numPar
 
 // Parameters:
 //  - Src
-//  - Ascending
-func (p *IGeneralModuleClient) SortBy(ctx context.Context, src *rpc.ISource, ascending bool) (_err error) {
-  var _args39 IGeneralModuleSortByArgs
+//  - Paths
+func (p *IGeneralModuleClient) SelectTo(ctx context.Context, src *rpc.ISource, paths []string) (_err error) {
+  var _args39 IGeneralModuleSelectToArgs
   _args39.Src = src
-  _args39.Ascending = ascending
-  var _result41 IGeneralModuleSortByResult
+  _args39.Paths = paths
+  var _result41 IGeneralModuleSelectToResult
   var _meta40 thrift.ResponseMeta
-  _meta40, _err = p.Client_().Call(ctx, "sortBy", &_args39, &_result41)
+  _meta40, _err = p.Client_().Call(ctx, "selectTo", &_args39, &_result41)
   p.SetLastResponseMeta_(_meta40)
   if _err != nil {
     return
@@ -488,16 +606,14 @@ func (p *IGeneralModuleClient) SortBy(ctx context.Context, src *rpc.ISource, asc
 
 // Parameters:
 //  - Src
-//  - Ascending
-//  - NumPartitions
-func (p *IGeneralModuleClient) SortBy3(ctx context.Context, src *rpc.ISource, ascending bool, numPartitions int64) (_err error) {
-  var _args42 IGeneralModuleSortBy3Args
+//  - Path
+func (p *IGeneralModuleClient) Explode(ctx context.Context, src *rpc.ISource, path string) (_err error) {
+  var _args42 IGeneralModuleExplodeArgs
   _args42.Src = src
-  _args42.Ascending = ascending
-  _args42.NumPartitions = numPartitions
-  var _result44 IGeneralModuleSortBy3Result
+  _args42.Path = path
+  var _result44 IGeneralModuleExplodeResult
   var _meta43 thrift.ResponseMeta
-  _meta43, _err = p.Client_().Call(ctx, "sortBy3", &_args42, &_result44)
+  _meta43, _err = p.Client_().Call(ctx, "explode", &_args42, &_result44)
   p.SetLastResponseMeta_(_meta43)
   if _err != nil {
     return
@@ -511,15 +627,17 @@ func (p *IGeneralModuleClient) SortBy3(ctx context.Context, src *rpc.ISource, as
 }
 
 // Parameters:
-//  - Other
-//  - PreserveOrder
-func (p *IGeneralModuleClient) Union_(ctx context.Context, other string, preserveOrder bool) (_err error) {
-  var _args45 IGeneralModuleUnion_Args
-  _args45.Other = other
-  _args45.PreserveOrder = preserveOrder
-  var _result47 IGeneralModuleUnion_Result
+//  - Src
+//  - Path
+//  - Paths
+func (p *IGeneralModuleClient) ExplodeSelect(ctx context.Context, src *rpc.ISource, path string, paths []string) (_err error) {
+  var _args45 IGeneralModuleExplodeSelectArgs
+  _args45.Src = src
+  _args45.Path = path
+  _args45.Paths = paths
+  var _result47 IGeneralModuleExplodeSelectResult
   var _meta46 thrift.ResponseMeta
-  _meta46, _err = p.Client_().Call(ctx, "union_", &_args45, &_result47)
+  _meta46, _err = p.Client_().Call(ctx, "explodeSelect", &_args45, &_result47)
   p.SetLastResponseMeta_(_meta46)
   if _err != nil {
     return
@@ -533,17 +651,15 @@ func (p *IGeneralModuleClient) Union_(ctx context.Context, other string, preserv
 }
 
 // Parameters:
-//  - Other
-//  - PreserveOrder
 //  - Src
-func (p *IGeneralModuleClient) Union2(ctx context.Context, other string, preserveOrder bool, src *rpc.ISource) (_err error) {
-  var _args48 IGeneralModuleUnion2Args
-  _args48.Other = other
-  _args48.PreserveOrder = preserveOrder
+//  - NumPartitions
+func (p *IGeneralModuleClient) GroupBy(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error) {
+  var _args48 IGeneralModuleGroupByArgs
   _args48.Src = src
-  var _result50 IGeneralModuleUnion2Result
+  _args48.NumPartitions = numPartitions
+  var _result50 IGeneralModuleGroupByResult
   var _meta49 thrift.ResponseMeta
-  _meta49, _err = p.Client_().Call(ctx, "union2", &_args48, &_result50)
+  _meta49, _err = p.Client_().Call(ctx, "groupBy", &_args48, &_result50)
   p.SetLastResponseMeta_(_meta49)
   if _err != nil {
     return
@@ -557,15 +673,13 @@ func (p *IGeneralModuleClient) Union2(ctx context.Context, other string, preserv
 }
 
 // Parameters:
-//  - Other
-//  - NumPartitions
-func (p *IGeneralModuleClient) Join(ctx context.Context, other string, numPartitions int64) (_err error) {
-  var _args51 IGeneralModuleJoinArgs
-  _args51.Other = other
-  _args51.NumPartitions = numPartitions
-  var _result53 IGeneralModuleJoinResult
+//  - Ascending
+func (p *IGeneralModuleClient) Sort(ctx context.Context, ascending bool) (_err error) {
+  var _args51 IGeneralModuleSortArgs
+  _args51.Ascending = ascending
+  var _result53 IGeneralModuleSortResult
   var _meta52 thrift.ResponseMeta
-  _meta52, _err = p.Client_().Call(ctx, "join", &_args51, &_result53)
+  _meta52, _err = p.Client_().Call(ctx, "sort", &_args51, &_result53)
   p.SetLastResponseMeta_(_meta52)
   if _err != nil {
     return
@@ -579,17 +693,15 @@ func (p *IGeneralModuleClient) Join(ctx context.Context, other string, numPartit
 }
 
 // Parameters:
-//  - Other
+//  - Ascending
 //  - NumPartitions
-//  - Src
-func (p *IGeneralModuleClient) Join3(ctx context.Context, other string, numPartitions int64, src *rpc.ISource) (_err error) {
-  var _args54 IGeneralModuleJoin3Args
-  _args54.Other = other
+func (p *IGeneralModuleClient) Sort2(ctx context.Context, ascending bool, numPartitions int64) (_err error) {
+  var _args54 IGeneralModuleSort2Args
+  _args54.Ascending = ascending
   _args54.NumPartitions = numPartitions
-  _args54.Src = src
-  var _result56 IGeneralModuleJoin3Result
+  var _result56 IGeneralModuleSort2Result
   var _meta55 thrift.ResponseMeta
-  _meta55, _err = p.Client_().Call(ctx, "join3", &_args54, &_result56)
+  _meta55, _err = p.Client_().Call(ctx, "sort2", &_args54, &_result56)
   p.SetLastResponseMeta_(_meta55)
   if _err != nil {
     return
@@ -603,13 +715,15 @@ func (p *IGeneralModuleClient) Join3(ctx context.Context, other string, numParti
 }
 
 // Parameters:
-//  - NumPartitions
-func (p *IGeneralModuleClient) Distinct(ctx context.Context, numPartitions int64) (_err error) {
-  var _args57 IGeneralModuleDistinctArgs
-  _args57.NumPartitions = numPartitions
-  var _result59 IGeneralModuleDistinctResult
+//  - Src
+//  - Ascending
+func (p *IGeneralModuleClient) SortBy(ctx context.Context, src *rpc.ISource, ascending bool) (_err error) {
+  var _args57 IGeneralModuleSortByArgs
+  _args57.Src = src
+  _args57.Ascending = ascending
+  var _result59 IGeneralModuleSortByResult
   var _meta58 thrift.ResponseMeta
-  _meta58, _err = p.Client_().Call(ctx, "distinct", &_args57, &_result59)
+  _meta58, _err = p.Client_().Call(ctx, "sortBy", &_args57, &_result59)
   p.SetLastResponseMeta_(_meta58)
   if _err != nil {
     return
@@ -623,15 +737,17 @@ func (p *IGeneralModuleClient) Distinct(ctx context.Context, numPartitions int64
 }
 
 // Parameters:
-//  - NumPartitions
 //  - Src
-func (p *IGeneralModuleClient) Distinct2(ctx context.Context, numPartitions int64, src *rpc.ISource) (_err error) {
-  var _args60 IGeneralModuleDistinct2Args
-  _args60.NumPartitions = numPartitions
+//  - Ascending
+//  - NumPartitions
+func (p *IGeneralModuleClient) SortBy3(ctx context.Context, src *rpc.ISource, ascending bool, numPartitions int64) (_err error) {
+  var _args60 IGeneralModuleSortBy3Args
   _args60.Src = src
-  var _result62 IGeneralModuleDistinct2Result
+  _args60.Ascending = ascending
+  _args60.NumPartitions = numPartitions
+  var _result62 IGeneralModuleSortBy3Result
   var _meta61 thrift.ResponseMeta
-  _meta61, _err = p.Client_().Call(ctx, "distinct2", &_args60, &_result62)
+  _meta61, _err = p.Client_().Call(ctx, "sortBy3", &_args60, &_result62)
   p.SetLastResponseMeta_(_meta61)
   if _err != nil {
     return
@@ -645,17 +761,15 @@ func (p *IGeneralModuleClient) Distinct2(ctx context.Context, numPartitions int6
 }
 
 // Parameters:
-//  - NumPartitions
-//  - PreserveOrdering
-//  - Global_
-func (p *IGeneralModuleClient) Repartition(ctx context.Context, numPartitions int64, preserveOrdering bool, global_ bool) (_err error) {
-  var _args63 IGeneralModuleRepartitionArgs
-  _args63.NumPartitions = numPartitions
-  _args63.PreserveOrdering = preserveOrdering
-  _args63.Global_ = global_
-  var _result65 IGeneralModuleRepartitionResult
+//  - Other
+//  - PreserveOrder
+func (p *IGeneralModuleClient) Union_(ctx context.Context, other string, preserveOrder bool) (_err error) {
+  var _args63 IGeneralModuleUnion_Args
+  _args63.Other = other
+  _args63.PreserveOrder = preserveOrder
+  var _result65 IGeneralModuleUnion_Result
   var _meta64 thrift.ResponseMeta
-  _meta64, _err = p.Client_().Call(ctx, "repartition", &_args63, &_result65)
+  _meta64, _err = p.Client_().Call(ctx, "union_", &_args63, &_result65)
   p.SetLastResponseMeta_(_meta64)
   if _err != nil {
     return
@@ -669,15 +783,17 @@ func (p *IGeneralModuleClient) Repartition(ctx context.Context, numPartitions in
 }
 
 // Parameters:
-//  - NumPartitions
-//  - Seed
-func (p *IGeneralModuleClient) PartitionByRandom(ctx context.Context, numPartitions int64, seed int32) (_err error) {
-  var _args66 IGeneralModulePartitionByRandomArgs
-  _args66.NumPartitions = numPartitions
-  _args66.Seed = seed
-  var _result68 IGeneralModulePartitionByRandomResult
+//  - Other
+//  - PreserveOrder
+//  - Src
+func (p *IGeneralModuleClient) Union2(ctx context.Context, other string, preserveOrder bool, src *rpc.ISource) (_err error) {
+  var _args66 IGeneralModuleUnion2Args
+  _args66.Other = other
+  _args66.PreserveOrder = preserveOrder
+  _args66.Src = src
+  var _result68 IGeneralModuleUnion2Result
   var _meta67 thrift.ResponseMeta
-  _meta67, _err = p.Client_().Call(ctx, "partitionByRandom", &_args66, &_result68)
+  _meta67, _err = p.Client_().Call(ctx, "union2", &_args66, &_result68)
   p.SetLastResponseMeta_(_meta67)
   if _err != nil {
     return
@@ -691,13 +807,15 @@ func (p *IGeneralModuleClient) PartitionByRandom(ctx context.Context, numPartiti
 }
 
 // Parameters:
-//  - NumPartitions
-func (p *IGeneralModuleClient) PartitionByHash(ctx context.Context, numPartitions int64) (_err error) {
-  var _args69 IGeneralModulePartitionByHashArgs
-  _args69.NumPartitions = numPartitions
-  var _result71 IGeneralModulePartitionByHashResult
+//  - Others
+//  - Rebalance
+func (p *IGeneralModuleClient) UnionAll(ctx context.Context, others []string, rebalance bool) (_err error) {
+  var _args69 IGeneralModuleUnionAllArgs
+  _args69.Others = others
+  _args69.Rebalance = rebalance
+  var _result71 IGeneralModuleUnionAllResult
   var _meta70 thrift.ResponseMeta
-  _meta70, _err = p.Client_().Call(ctx, "partitionByHash", &_args69, &_result71)
+  _meta70, _err = p.Client_().Call(ctx, "unionAll", &_args69, &_result71)
   p.SetLastResponseMeta_(_meta70)
   if _err != nil {
     return
@@ -711,15 +829,15 @@ func (p *IGeneralModuleClient) PartitionByHash(ctx context.Context, numPartition
 }
 
 // Parameters:
+//  - Other
 //  - Src
-//  - NumPartitions
-func (p *IGeneralModuleClient) PartitionBy(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error) {
-  var _args72 IGeneralModulePartitionByArgs
+func (p *IGeneralModuleClient) Zip(ctx context.Context, other string, src *rpc.ISource) (_err error) {
+  var _args72 IGeneralModuleZipArgs
+  _args72.Other = other
   _args72.Src = src
-  _args72.NumPartitions = numPartitions
-  var _result74 IGeneralModulePartitionByResult
+  var _result74 IGeneralModuleZipResult
   var _meta73 thrift.ResponseMeta
-  _meta73, _err = p.Client_().Call(ctx, "partitionBy", &_args72, &_result74)
+  _meta73, _err = p.Client_().Call(ctx, "zip", &_args72, &_result74)
   p.SetLastResponseMeta_(_meta73)
   if _err != nil {
     return
@@ -732,14 +850,11 @@ func (p *IGeneralModuleClient) PartitionBy(ctx context.Context, src *rpc.ISource
   return nil
 }
 
-// Parameters:
-//  - Src
-func (p *IGeneralModuleClient) FlatMapValues(ctx context.Context, src *rpc.ISource) (_err error) {
-  var _args75 IGeneralModuleFlatMapValuesArgs
-  _args75.Src = src
-  var _result77 IGeneralModuleFlatMapValuesResult
+func (p *IGeneralModuleClient) ZipWithIndex(ctx context.Context) (_err error) {
+  var _args75 IGeneralModuleZipWithIndexArgs
+  var _result77 IGeneralModuleZipWithIndexResult
   var _meta76 thrift.ResponseMeta
-  _meta76, _err = p.Client_().Call(ctx, "flatMapValues", &_args75, &_result77)
+  _meta76, _err = p.Client_().Call(ctx, "zipWithIndex", &_args75, &_result77)
   p.SetLastResponseMeta_(_meta76)
   if _err != nil {
     return
@@ -753,13 +868,15 @@ func (p *IGeneralModuleClient) FlatMapValues(ctx context.Context, src *rpc.ISour
 }
 
 // Parameters:
-//  - Src
-func (p *IGeneralModuleClient) MapValues(ctx context.Context, src *rpc.ISource) (_err error) {
-  var _args78 IGeneralModuleMapValuesArgs
-  _args78.Src = src
-  var _result80 IGeneralModuleMapValuesResult
+//  - Other
+//  - NumPartitions
+func (p *IGeneralModuleClient) Join(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args78 IGeneralModuleJoinArgs
+  _args78.Other = other
+  _args78.NumPartitions = numPartitions
+  var _result80 IGeneralModuleJoinResult
   var _meta79 thrift.ResponseMeta
-  _meta79, _err = p.Client_().Call(ctx, "mapValues", &_args78, &_result80)
+  _meta79, _err = p.Client_().Call(ctx, "join", &_args78, &_result80)
   p.SetLastResponseMeta_(_meta79)
   if _err != nil {
     return
@@ -773,13 +890,17 @@ func (p *IGeneralModuleClient) MapValues(ctx context.Context, src *rpc.ISource)
 }
 
 // Parameters:
+//  - Other
 //  - NumPartitions
-func (p *IGeneralModuleClient) GroupByKey(ctx context.Context, numPartitions int64) (_err error) {
-  var _args81 IGeneralModuleGroupByKeyArgs
+//  - Src
+func (p *IGeneralModuleClient) Join3(ctx context.Context, other string, numPartitions int64, src *rpc.ISource) (_err error) {
+  var _args81 IGeneralModuleJoin3Args
+  _args81.Other = other
   _args81.NumPartitions = numPartitions
-  var _result83 IGeneralModuleGroupByKeyResult
+  _args81.Src = src
+  var _result83 IGeneralModuleJoin3Result
   var _meta82 thrift.ResponseMeta
-  _meta82, _err = p.Client_().Call(ctx, "groupByKey", &_args81, &_result83)
+  _meta82, _err = p.Client_().Call(ctx, "join3", &_args81, &_result83)
   p.SetLastResponseMeta_(_meta82)
   if _err != nil {
     return
@@ -793,15 +914,15 @@ func (p *IGeneralModuleClient) GroupByKey(ctx context.Context, numPartitions int
 }
 
 // Parameters:
+//  - Other
 //  - NumPartitions
-//  - Src
-func (p *IGeneralModuleClient) GroupByKey2(ctx context.Context, numPartitions int64, src *rpc.ISource) (_err error) {
-  var _args84 IGeneralModuleGroupByKey2Args
+func (p *IGeneralModuleClient) LeftOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args84 IGeneralModuleLeftOuterJoinArgs
+  _args84.Other = other
   _args84.NumPartitions = numPartitions
-  _args84.Src = src
-  var _result86 IGeneralModuleGroupByKey2Result
+  var _result86 IGeneralModuleLeftOuterJoinResult
   var _meta85 thrift.ResponseMeta
-  _meta85, _err = p.Client_().Call(ctx, "groupByKey2", &_args84, &_result86)
+  _meta85, _err = p.Client_().Call(ctx, "leftOuterJoin", &_args84, &_result86)
   p.SetLastResponseMeta_(_meta85)
   if _err != nil {
     return
@@ -815,17 +936,15 @@ func (p *IGeneralModuleClient) GroupByKey2(ctx context.Context, numPartitions in
 }
 
 // Parameters:
-//  - Src
+//  - Other
 //  - NumPartitions
-//  - LocalReduce
-func (p *IGeneralModuleClient) ReduceByKey(ctx context.Context, src *rpc.ISource, numPartitions int64, localReduce bool) (_err error) {
-  var _args87 IGeneralModuleReduceByKeyArgs
-  _args87.Src = src
+func (p *IGeneralModuleClient) RightOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args87 IGeneralModuleRightOuterJoinArgs
+  _args87.Other = other
   _args87.NumPartitions = numPartitions
-  _args87.LocalReduce = localReduce
-  var _result89 IGeneralModuleReduceByKeyResult
+  var _result89 IGeneralModuleRightOuterJoinResult
   var _meta88 thrift.ResponseMeta
-  _meta88, _err = p.Client_().Call(ctx, "reduceByKey", &_args87, &_result89)
+  _meta88, _err = p.Client_().Call(ctx, "rightOuterJoin", &_args87, &_result89)
   p.SetLastResponseMeta_(_meta88)
   if _err != nil {
     return
@@ -839,17 +958,15 @@ func (p *IGeneralModuleClient) ReduceByKey(ctx context.Context, src *rpc.ISource
 }
 
 // Parameters:
-//  - Zero
-//  - SeqOp
+//  - Other
 //  - NumPartitions
-func (p *IGeneralModuleClient) AggregateByKey(ctx context.Context, zero *rpc.ISource, seqOp *rpc.ISource, numPartitions int64) (_err error) {
-  var _args90 IGeneralModuleAggregateByKeyArgs
-  _args90.Zero = zero
-  _args90.SeqOp = seqOp
+func (p *IGeneralModuleClient) FullOuterJoin(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args90 IGeneralModuleFullOuterJoinArgs
+  _args90.Other = other
   _args90.NumPartitions = numPartitions
-  var _result92 IGeneralModuleAggregateByKeyResult
+  var _result92 IGeneralModuleFullOuterJoinResult
   var _meta91 thrift.ResponseMeta
-  _meta91, _err = p.Client_().Call(ctx, "aggregateByKey", &_args90, &_result92)
+  _meta91, _err = p.Client_().Call(ctx, "fullOuterJoin", &_args90, &_result92)
   p.SetLastResponseMeta_(_meta91)
   if _err != nil {
     return
@@ -863,19 +980,15 @@ func (p *IGeneralModuleClient) AggregateByKey(ctx context.Context, zero *rpc.ISo
 }
 
 // Parameters:
-//  - Zero
-//  - SeqOp
-//  - CombOp
+//  - Other
 //  - NumPartitions
-func (p *IGeneralModuleClient) AggregateByKey4(ctx context.Context, zero *rpc.ISource, seqOp *rpc.ISource, combOp *rpc.ISource, numPartitions int64) (_err error) {
-  var _args93 IGeneralModuleAggregateByKey4Args
-  _args93.Zero = zero
-  _args93.SeqOp = seqOp
-  _args93.CombOp = combOp
+func (p *IGeneralModuleClient) CoGroup(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args93 IGeneralModuleCoGroupArgs
+  _args93.Other = other
   _args93.NumPartitions = numPartitions
-  var _result95 IGeneralModuleAggregateByKey4Result
+  var _result95 IGeneralModuleCoGroupResult
   var _meta94 thrift.ResponseMeta
-  _meta94, _err = p.Client_().Call(ctx, "aggregateByKey4", &_args93, &_result95)
+  _meta94, _err = p.Client_().Call(ctx, "coGroup", &_args93, &_result95)
   p.SetLastResponseMeta_(_meta94)
   if _err != nil {
     return
@@ -889,19 +1002,13 @@ func (p *IGeneralModuleClient) AggregateByKey4(ctx context.Context, zero *rpc.IS
 }
 
 // Parameters:
-//  - Zero
-//  - Src
 //  - NumPartitions
-//  - LocalFold
-func (p *IGeneralModuleClient) FoldByKey(ctx context.Context, zero *rpc.ISource, src *rpc.ISource, numPartitions int64, localFold bool) (_err error) {
-  var _args96 IGeneralModuleFoldByKeyArgs
-  _args96.Zero = zero
-  _args96.Src = src
+func (p *IGeneralModuleClient) Distinct(ctx context.Context, numPartitions int64) (_err error) {
+  var _args96 IGeneralModuleDistinctArgs
   _args96.NumPartitions = numPartitions
-  _args96.LocalFold = localFold
-  var _result98 IGeneralModuleFoldByKeyResult
+  var _result98 IGeneralModuleDistinctResult
   var _meta97 thrift.ResponseMeta
-  _meta97, _err = p.Client_().Call(ctx, "foldByKey", &_args96, &_result98)
+  _meta97, _err = p.Client_().Call(ctx, "distinct", &_args96, &_result98)
   p.SetLastResponseMeta_(_meta97)
   if _err != nil {
     return
@@ -915,13 +1022,15 @@ func (p *IGeneralModuleClient) FoldByKey(ctx context.Context, zero *rpc.ISource,
 }
 
 // Parameters:
-//  - Ascending
-func (p *IGeneralModuleClient) SortByKey(ctx context.Context, ascending bool) (_err error) {
-  var _args99 IGeneralModuleSortByKeyArgs
-  _args99.Ascending = ascending
-  var _result101 IGeneralModuleSortByKeyResult
+//  - NumPartitions
+//  - Src
+func (p *IGeneralModuleClient) Distinct2(ctx context.Context, numPartitions int64, src *rpc.ISource) (_err error) {
+  var _args99 IGeneralModuleDistinct2Args
+  _args99.NumPartitions = numPartitions
+  _args99.Src = src
+  var _result101 IGeneralModuleDistinct2Result
   var _meta100 thrift.ResponseMeta
-  _meta100, _err = p.Client_().Call(ctx, "sortByKey", &_args99, &_result101)
+  _meta100, _err = p.Client_().Call(ctx, "distinct2", &_args99, &_result101)
   p.SetLastResponseMeta_(_meta100)
   if _err != nil {
     return
@@ -935,15 +1044,15 @@ func (p *IGeneralModuleClient) SortByKey(ctx context.Context, ascending bool) (_
 }
 
 // Parameters:
-//  - Ascending
+//  - Other
 //  - NumPartitions
-func (p *IGeneralModuleClient) SortByKey2a(ctx context.Context, ascending bool, numPartitions int64) (_err error) {
-  var _args102 IGeneralModuleSortByKey2aArgs
-  _args102.Ascending = ascending
+func (p *IGeneralModuleClient) Intersection(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args102 IGeneralModuleIntersectionArgs
+  _args102.Other = other
   _args102.NumPartitions = numPartitions
-  var _result104 IGeneralModuleSortByKey2aResult
+  var _result104 IGeneralModuleIntersectionResult
   var _meta103 thrift.ResponseMeta
-  _meta103, _err = p.Client_().Call(ctx, "sortByKey2a", &_args102, &_result104)
+  _meta103, _err = p.Client_().Call(ctx, "intersection", &_args102, &_result104)
   p.SetLastResponseMeta_(_meta103)
   if _err != nil {
     return
@@ -957,15 +1066,15 @@ func (p *IGeneralModuleClient) SortByKey2a(ctx context.Context, ascending bool,
 }
 
 // Parameters:
-//  - Src
-//  - Ascending
-func (p *IGeneralModuleClient) SortByKey2b(ctx context.Context, src *rpc.ISource, ascending bool) (_err error) {
-  var _args105 IGeneralModuleSortByKey2bArgs
-  _args105.Src = src
-  _args105.Ascending = ascending
-  var _result107 IGeneralModuleSortByKey2bResult
+//  - Other
+//  - NumPartitions
+func (p *IGeneralModuleClient) Subtract(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args105 IGeneralModuleSubtractArgs
+  _args105.Other = other
+  _args105.NumPartitions = numPartitions
+  var _result107 IGeneralModuleSubtractResult
   var _meta106 thrift.ResponseMeta
-  _meta106, _err = p.Client_().Call(ctx, "sortByKey2b", &_args105, &_result107)
+  _meta106, _err = p.Client_().Call(ctx, "subtract", &_args105, &_result107)
   p.SetLastResponseMeta_(_meta106)
   if _err != nil {
     return
@@ -979,17 +1088,15 @@ func (p *IGeneralModuleClient) SortByKey2b(ctx context.Context, src *rpc.ISource
 }
 
 // Parameters:
-//  - Src
-//  - Ascending
+//  - Other
 //  - NumPartitions
-func (p *IGeneralModuleClient) SortByKey3(ctx context.Context, src *rpc.ISource, ascending bool, numPartitions int64) (_err error) {
-  var _args108 IGeneralModuleSortByKey3Args
-  _args108.Src = src
-  _args108.Ascending = ascending
+func (p *IGeneralModuleClient) SubtractByKey(ctx context.Context, other string, numPartitions int64) (_err error) {
+  var _args108 IGeneralModuleSubtractByKeyArgs
+  _args108.Other = other
   _args108.NumPartitions = numPartitions
-  var _result110 IGeneralModuleSortByKey3Result
+  var _result110 IGeneralModuleSubtractByKeyResult
   var _meta109 thrift.ResponseMeta
-  _meta109, _err = p.Client_().Call(ctx, "sortByKey3", &_args108, &_result110)
+  _meta109, _err = p.Client_().Call(ctx, "subtractByKey", &_args108, &_result110)
   p.SetLastResponseMeta_(_meta109)
   if _err != nil {
     return
@@ -1002,338 +1109,821 @@ func (p *IGeneralModuleClient) SortByKey3(ctx context.Context, src *rpc.ISource,
   return nil
 }
 
-type IGeneralModuleProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler IGeneralModule
-}
+// Parameters:
+//  - NumPartitions
+//  - PreserveOrdering
+//  - Global_
+func (p *IGeneralModuleClient) Repartition(ctx context.Context, numPartitions int64, preserveOrdering bool, global_ bool) (_err error) {
+  var _args111 IGeneralModuleRepartitionArgs
+  _args111.NumPartitions = numPartitions
+  _args111.PreserveOrdering = preserveOrdering
+  _args111.Global_ = global_
+  var _result113 IGeneralModuleRepartitionResult
+  var _meta112 thrift.ResponseMeta
+  _meta112, _err = p.Client_().Call(ctx, "repartition", &_args111, &_result113)
+  p.SetLastResponseMeta_(_meta112)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result113.Ex!= nil:
+    return _result113.Ex
+  }
 
-func (p *IGeneralModuleProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
+  return nil
 }
 
-func (p *IGeneralModuleProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
-}
+// Parameters:
+//  - NumPartitions
+//  - Shuffle
+func (p *IGeneralModuleClient) Coalesce(ctx context.Context, numPartitions int64, shuffle bool) (_err error) {
+  var _args114 IGeneralModuleCoalesceArgs
+  _args114.NumPartitions = numPartitions
+  _args114.Shuffle = shuffle
+  var _result116 IGeneralModuleCoalesceResult
+  var _meta115 thrift.ResponseMeta
+  _meta115, _err = p.Client_().Call(ctx, "coalesce", &_args114, &_result116)
+  p.SetLastResponseMeta_(_meta115)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result116.Ex!= nil:
+    return _result116.Ex
+  }
 
-func (p *IGeneralModuleProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
+  return nil
 }
 
-func NewIGeneralModuleProcessor(handler IGeneralModule) *IGeneralModuleProcessor {
+// Parameters:
+//  - NumPartitions
+//  - Seed
+func (p *IGeneralModuleClient) PartitionByRandom(ctx context.Context, numPartitions int64, seed int32) (_err error) {
+  var _args117 IGeneralModulePartitionByRandomArgs
+  _args117.NumPartitions = numPartitions
+  _args117.Seed = seed
+  var _result119 IGeneralModulePartitionByRandomResult
+  var _meta118 thrift.ResponseMeta
+  _meta118, _err = p.Client_().Call(ctx, "partitionByRandom", &_args117, &_result119)
+  p.SetLastResponseMeta_(_meta118)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result119.Ex!= nil:
+    return _result119.Ex
+  }
 
-  self111 := &IGeneralModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self111.processorMap["executeTo"] = &iGeneralModuleProcessorExecuteTo{handler:handler}
-  self111.processorMap["map_"] = &iGeneralModuleProcessorMap_{handler:handler}
-  self111.processorMap["filter"] = &iGeneralModuleProcessorFilter{handler:handler}
-  self111.processorMap["flatmap"] = &iGeneralModuleProcessorFlatmap{handler:handler}
-  self111.processorMap["keyBy"] = &iGeneralModuleProcessorKeyBy{handler:handler}
-  self111.processorMap["mapWithIndex"] = &iGeneralModuleProcessorMapWithIndex{handler:handler}
-  self111.processorMap["mapPartitions"] = &iGeneralModuleProcessorMapPartitions{handler:handler}
-  self111.processorMap["mapPartitionsWithIndex"] = &iGeneralModuleProcessorMapPartitionsWithIndex{handler:handler}
-  self111.processorMap["mapExecutor"] = &iGeneralModuleProcessorMapExecutor{handler:handler}
-  self111.processorMap["mapExecutorTo"] = &iGeneralModuleProcessorMapExecutorTo{handler:handler}
-  self111.processorMap["groupBy"] = &iGeneralModuleProcessorGroupBy{handler:handler}
-  self111.processorMap["sort"] = &iGeneralModuleProcessorSort{handler:handler}
-  self111.processorMap["sort2"] = &iGeneralModuleProcessorSort2{handler:handler}
-  self111.processorMap["sortBy"] = &iGeneralModuleProcessorSortBy{handler:handler}
-  self111.processorMap["sortBy3"] = &iGeneralModuleProcessorSortBy3{handler:handler}
-  self111.processorMap["union_"] = &iGeneralModuleProcessorUnion_{handler:handler}
-  self111.processorMap["union2"] = &iGeneralModuleProcessorUnion2{handler:handler}
-  self111.processorMap["join"] = &iGeneralModuleProcessorJoin{handler:handler}
-  self111.processorMap["join3"] = &iGeneralModuleProcessorJoin3{handler:handler}
-  self111.processorMap["distinct"] = &iGeneralModuleProcessorDistinct{handler:handler}
-  self111.processorMap["distinct2"] = &iGeneralModuleProcessorDistinct2{handler:handler}
-  self111.processorMap["repartition"] = &iGeneralModuleProcessorRepartition{handler:handler}
-  self111.processorMap["partitionByRandom"] = &iGeneralModuleProcessorPartitionByRandom{handler:handler}
-  self111.processorMap["partitionByHash"] = &iGeneralModuleProcessorPartitionByHash{handler:handler}
-  self111.processorMap["partitionBy"] = &iGeneralModuleProcessorPartitionBy{handler:handler}
-  self111.processorMap["flatMapValues"] = &iGeneralModuleProcessorFlatMapValues{handler:handler}
-  self111.processorMap["mapValues"] = &iGeneralModuleProcessorMapValues{handler:handler}
-  self111.processorMap["groupByKey"] = &iGeneralModuleProcessorGroupByKey{handler:handler}
-  self111.processorMap["groupByKey2"] = &iGeneralModuleProcessorGroupByKey2{handler:handler}
-  self111.processorMap["reduceByKey"] = &iGeneralModuleProcessorReduceByKey{handler:handler}
-  self111.processorMap["aggregateByKey"] = &iGeneralModuleProcessorAggregateByKey{handler:handler}
-  self111.processorMap["aggregateByKey4"] = &iGeneralModuleProcessorAggregateByKey4{handler:handler}
-  self111.processorMap["foldByKey"] = &iGeneralModuleProcessorFoldByKey{handler:handler}
-  self111.processorMap["sortByKey"] = &iGeneralModuleProcessorSortByKey{handler:handler}
-  self111.processorMap["sortByKey2a"] = &iGeneralModuleProcessorSortByKey2a{handler:handler}
-  self111.processorMap["sortByKey2b"] = &iGeneralModuleProcessorSortByKey2b{handler:handler}
-  self111.processorMap["sortByKey3"] = &iGeneralModuleProcessorSortByKey3{handler:handler}
-return self111
+  return nil
 }
 
-func (p *IGeneralModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err2 := iprot.ReadMessageBegin(ctx)
-  if err2 != nil { return false, thrift.WrapTException(err2) }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(ctx, seqId, iprot, oprot)
+// Parameters:
+//  - NumPartitions
+func (p *IGeneralModuleClient) PartitionByHash(ctx context.Context, numPartitions int64) (_err error) {
+  var _args120 IGeneralModulePartitionByHashArgs
+  _args120.NumPartitions = numPartitions
+  var _result122 IGeneralModulePartitionByHashResult
+  var _meta121 thrift.ResponseMeta
+  _meta121, _err = p.Client_().Call(ctx, "partitionByHash", &_args120, &_result122)
+  p.SetLastResponseMeta_(_meta121)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result122.Ex!= nil:
+    return _result122.Ex
   }
-  iprot.Skip(ctx, thrift.STRUCT)
-  iprot.ReadMessageEnd(ctx)
-  x112 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-  x112.Write(ctx, oprot)
-  oprot.WriteMessageEnd(ctx)
-  oprot.Flush(ctx)
-  return false, x112
 
+  return nil
 }
 
-type iGeneralModuleProcessorExecuteTo struct {
-  handler IGeneralModule
+// Parameters:
+//  - Src
+//  - NumPartitions
+func (p *IGeneralModuleClient) PartitionBy(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error) {
+  var _args123 IGeneralModulePartitionByArgs
+  _args123.Src = src
+  _args123.NumPartitions = numPartitions
+  var _result125 IGeneralModulePartitionByResult
+  var _meta124 thrift.ResponseMeta
+  _meta124, _err = p.Client_().Call(ctx, "partitionBy", &_args123, &_result125)
+  p.SetLastResponseMeta_(_meta124)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result125.Ex!= nil:
+    return _result125.Ex
+  }
+
+  return nil
 }
 
-func (p *iGeneralModuleProcessorExecuteTo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleExecuteToArgs{}
-  var err2 error
-  if err2 = args.Read(ctx, iprot); err2 != nil {
-    iprot.ReadMessageEnd(ctx)
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "executeTo", thrift.EXCEPTION, seqId)
-    x.Write(ctx, oprot)
-    oprot.WriteMessageEnd(ctx)
-    oprot.Flush(ctx)
-    return false, thrift.WrapTException(err2)
+// Parameters:
+//  - NumPartitions
+func (p *IGeneralModuleClient) PartitionByKeyHash(ctx context.Context, numPartitions int64) (_err error) {
+  var _args126 IGeneralModulePartitionByKeyHashArgs
+  _args126.NumPartitions = numPartitions
+  var _result128 IGeneralModulePartitionByKeyHashResult
+  var _meta127 thrift.ResponseMeta
+  _meta127, _err = p.Client_().Call(ctx, "partitionByKeyHash", &_args126, &_result128)
+  p.SetLastResponseMeta_(_meta127)
+  if _err != nil {
+    return
   }
-  iprot.ReadMessageEnd(ctx)
-
-  tickerCancel := func() {}
-  // Start a goroutine to do server side connectivity check.
-  if thrift.ServerConnectivityCheckInterval > 0 {
-    var cancel context.CancelFunc
-    ctx, cancel = context.WithCancel(ctx)
-    defer cancel()
-    var tickerCtx context.Context
-    tickerCtx, tickerCancel = context.WithCancel(context.Background())
-    defer tickerCancel()
-    go func(ctx context.Context, cancel context.CancelFunc) {
-      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-      defer ticker.Stop()
-      for {
-        select {
-        case <-ctx.Done():
-          return
-        case <-ticker.C:
-          if !iprot.Transport().IsOpen() {
-            cancel()
-            return
-          }
-        }
-      }
-    }(tickerCtx, cancel)
+  switch {
+  case _result128.Ex!= nil:
+    return _result128.Ex
   }
 
-  result := IGeneralModuleExecuteToResult{}
-  if err2 = p.handler.ExecuteTo(ctx, args.Src); err2 != nil {
-    tickerCancel()
-  switch v := err2.(type) {
-    case *rpc.IExecutorException:
-  result.Ex = v
-    default:
-    if err2 == thrift.ErrAbandonRequest {
-      return false, thrift.WrapTException(err2)
-    }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing executeTo: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "executeTo", thrift.EXCEPTION, seqId)
-    x.Write(ctx, oprot)
-    oprot.WriteMessageEnd(ctx)
-    oprot.Flush(ctx)
-    return true, thrift.WrapTException(err2)
+  return nil
+}
+
+// Parameters:
+//  - NumPartitions
+func (p *IGeneralModuleClient) PartitionByKeyRange(ctx context.Context, numPartitions int64) (_err error) {
+  var _args129 IGeneralModulePartitionByKeyRangeArgs
+  _args129.NumPartitions = numPartitions
+  var _result131 IGeneralModulePartitionByKeyRangeResult
+  var _meta130 thrift.ResponseMeta
+  _meta130, _err = p.Client_().Call(ctx, "partitionByKeyRange", &_args129, &_result131)
+  p.SetLastResponseMeta_(_meta130)
+  if _err != nil {
+    return
   }
+  switch {
+  case _result131.Ex!= nil:
+    return _result131.Ex
   }
-  tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "executeTo", thrift.REPLY, seqId); err2 != nil {
-    err = thrift.WrapTException(err2)
+
+  return nil
+}
+
+// Parameters:
+//  - Src
+//  - NumPartitions
+func (p *IGeneralModuleClient) PartitionByKey(ctx context.Context, src *rpc.ISource, numPartitions int64) (_err error) {
+  var _args132 IGeneralModulePartitionByKeyArgs
+  _args132.Src = src
+  _args132.NumPartitions = numPartitions
+  var _result134 IGeneralModulePartitionByKeyResult
+  var _meta133 thrift.ResponseMeta
+  _meta133, _err = p.Client_().Call(ctx, "partitionByKey", &_args132, &_result134)
+  p.SetLastResponseMeta_(_meta133)
+  if _err != nil {
+    return
   }
-  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  switch {
+  case _result134.Ex!= nil:
+    return _result134.Ex
   }
-  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+
+  return nil
+}
+
+// Parameters:
+//  - Order
+func (p *IGeneralModuleClient) ReorderPartitions(ctx context.Context, order []int64) (_err error) {
+  var _args135 IGeneralModuleReorderPartitionsArgs
+  _args135.Order = order
+  var _result137 IGeneralModuleReorderPartitionsResult
+  var _meta136 thrift.ResponseMeta
+  _meta136, _err = p.Client_().Call(ctx, "reorderPartitions", &_args135, &_result137)
+  p.SetLastResponseMeta_(_meta136)
+  if _err != nil {
+    return
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  switch {
+  case _result137.Ex!= nil:
+    return _result137.Ex
   }
-  if err != nil {
+
+  return nil
+}
+
+func (p *IGeneralModuleClient) ReorderPartitionsBy(ctx context.Context) (_err error) {
+  var _args138 IGeneralModuleReorderPartitionsByArgs
+  var _result140 IGeneralModuleReorderPartitionsByResult
+  var _meta139 thrift.ResponseMeta
+  _meta139, _err = p.Client_().Call(ctx, "reorderPartitionsBy", &_args138, &_result140)
+  p.SetLastResponseMeta_(_meta139)
+  if _err != nil {
     return
   }
-  return true, err
-}
+  switch {
+  case _result140.Ex!= nil:
+    return _result140.Ex
+  }
 
-type iGeneralModuleProcessorMap_ struct {
-  handler IGeneralModule
+  return nil
 }
 
-func (p *iGeneralModuleProcessorMap_) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleMap_Args{}
-  var err2 error
-  if err2 = args.Read(ctx, iprot); err2 != nil {
-    iprot.ReadMessageEnd(ctx)
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "map_", thrift.EXCEPTION, seqId)
-    x.Write(ctx, oprot)
-    oprot.WriteMessageEnd(ctx)
-    oprot.Flush(ctx)
-    return false, thrift.WrapTException(err2)
+func (p *IGeneralModuleClient) PartitionOffset(ctx context.Context) (_r int64, _err error) {
+  var _args141 IGeneralModulePartitionOffsetArgs
+  var _result143 IGeneralModulePartitionOffsetResult
+  var _meta142 thrift.ResponseMeta
+  _meta142, _err = p.Client_().Call(ctx, "partitionOffset", &_args141, &_result143)
+  p.SetLastResponseMeta_(_meta142)
+  if _err != nil {
+    return
   }
-  iprot.ReadMessageEnd(ctx)
-
-  tickerCancel := func() {}
-  // Start a goroutine to do server side connectivity check.
-  if thrift.ServerConnectivityCheckInterval > 0 {
-    var cancel context.CancelFunc
-    ctx, cancel = context.WithCancel(ctx)
-    defer cancel()
-    var tickerCtx context.Context
-    tickerCtx, tickerCancel = context.WithCancel(context.Background())
-    defer tickerCancel()
-    go func(ctx context.Context, cancel context.CancelFunc) {
-      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-      defer ticker.Stop()
-      for {
-        select {
-        case <-ctx.Done():
-          return
-        case <-ticker.C:
-          if !iprot.Transport().IsOpen() {
-            cancel()
-            return
-          }
-        }
-      }
-    }(tickerCtx, cancel)
+  switch {
+  case _result143.Ex!= nil:
+    return _r, _result143.Ex
   }
 
-  result := IGeneralModuleMap_Result{}
-  if err2 = p.handler.Map_(ctx, args.Src); err2 != nil {
-    tickerCancel()
-  switch v := err2.(type) {
-    case *rpc.IExecutorException:
-  result.Ex = v
-    default:
-    if err2 == thrift.ErrAbandonRequest {
-      return false, thrift.WrapTException(err2)
-    }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing map_: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "map_", thrift.EXCEPTION, seqId)
-    x.Write(ctx, oprot)
-    oprot.WriteMessageEnd(ctx)
-    oprot.Flush(ctx)
-    return true, thrift.WrapTException(err2)
+  return _result143.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Src
+func (p *IGeneralModuleClient) FlatMapValues(ctx context.Context, src *rpc.ISource) (_err error) {
+  var _args144 IGeneralModuleFlatMapValuesArgs
+  _args144.Src = src
+  var _result146 IGeneralModuleFlatMapValuesResult
+  var _meta145 thrift.ResponseMeta
+  _meta145, _err = p.Client_().Call(ctx, "flatMapValues", &_args144, &_result146)
+  p.SetLastResponseMeta_(_meta145)
+  if _err != nil {
+    return
   }
+  switch {
+  case _result146.Ex!= nil:
+    return _result146.Ex
   }
-  tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "map_", thrift.REPLY, seqId); err2 != nil {
-    err = thrift.WrapTException(err2)
+
+  return nil
+}
+
+// Parameters:
+//  - Src
+func (p *IGeneralModuleClient) MapValues(ctx context.Context, src *rpc.ISource) (_err error) {
+  var _args147 IGeneralModuleMapValuesArgs
+  _args147.Src = src
+  var _result149 IGeneralModuleMapValuesResult
+  var _meta148 thrift.ResponseMeta
+  _meta148, _err = p.Client_().Call(ctx, "mapValues", &_args147, &_result149)
+  p.SetLastResponseMeta_(_meta148)
+  if _err != nil {
+    return
   }
-  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  switch {
+  case _result149.Ex!= nil:
+    return _result149.Ex
   }
-  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+
+  return nil
+}
+
+// Parameters:
+//  - NumPartitions
+func (p *IGeneralModuleClient) GroupByKey(ctx context.Context, numPartitions int64) (_err error) {
+  var _args150 IGeneralModuleGroupByKeyArgs
+  _args150.NumPartitions = numPartitions
+  var _result152 IGeneralModuleGroupByKeyResult
+  var _meta151 thrift.ResponseMeta
+  _meta151, _err = p.Client_().Call(ctx, "groupByKey", &_args150, &_result152)
+  p.SetLastResponseMeta_(_meta151)
+  if _err != nil {
+    return
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  switch {
+  case _result152.Ex!= nil:
+    return _result152.Ex
   }
-  if err != nil {
+
+  return nil
+}
+
+// Parameters:
+//  - NumPartitions
+//  - Src
+func (p *IGeneralModuleClient) GroupByKey2(ctx context.Context, numPartitions int64, src *rpc.ISource) (_err error) {
+  var _args153 IGeneralModuleGroupByKey2Args
+  _args153.NumPartitions = numPartitions
+  _args153.Src = src
+  var _result155 IGeneralModuleGroupByKey2Result
+  var _meta154 thrift.ResponseMeta
+  _meta154, _err = p.Client_().Call(ctx, "groupByKey2", &_args153, &_result155)
+  p.SetLastResponseMeta_(_meta154)
+  if _err != nil {
     return
   }
-  return true, err
+  switch {
+  case _result155.Ex!= nil:
+    return _result155.Ex
+  }
+
+  return nil
 }
 
-type iGeneralModuleProcessorFilter struct {
-  handler IGeneralModule
+// Parameters:
+//  - Src
+//  - NumPartitions
+//  - LocalReduce
+func (p *IGeneralModuleClient) ReduceByKey(ctx context.Context, src *rpc.ISource, numPartitions int64, localReduce bool) (_err error) {
+  var _args156 IGeneralModuleReduceByKeyArgs
+  _args156.Src = src
+  _args156.NumPartitions = numPartitions
+  _args156.LocalReduce = localReduce
+  var _result158 IGeneralModuleReduceByKeyResult
+  var _meta157 thrift.ResponseMeta
+  _meta157, _err = p.Client_().Call(ctx, "reduceByKey", &_args156, &_result158)
+  p.SetLastResponseMeta_(_meta157)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result158.Ex!= nil:
+    return _result158.Ex
+  }
+
+  return nil
 }
 
-func (p *iGeneralModuleProcessorFilter) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleFilterArgs{}
-  var err2 error
-  if err2 = args.Read(ctx, iprot); err2 != nil {
-    iprot.ReadMessageEnd(ctx)
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "filter", thrift.EXCEPTION, seqId)
-    x.Write(ctx, oprot)
-    oprot.WriteMessageEnd(ctx)
-    oprot.Flush(ctx)
-    return false, thrift.WrapTException(err2)
+// Parameters:
+//  - Zero
+//  - SeqOp
+//  - NumPartitions
+func (p *IGeneralModuleClient) AggregateByKey(ctx context.Context, zero *rpc.ISource, seqOp *rpc.ISource, numPartitions int64) (_err error) {
+  var _args159 IGeneralModuleAggregateByKeyArgs
+  _args159.Zero = zero
+  _args159.SeqOp = seqOp
+  _args159.NumPartitions = numPartitions
+  var _result161 IGeneralModuleAggregateByKeyResult
+  var _meta160 thrift.ResponseMeta
+  _meta160, _err = p.Client_().Call(ctx, "aggregateByKey", &_args159, &_result161)
+  p.SetLastResponseMeta_(_meta160)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result161.Ex!= nil:
+    return _result161.Ex
   }
-  iprot.ReadMessageEnd(ctx)
 
-  tickerCancel := func() {}
-  // Start a goroutine to do server side connectivity check.
-  if thrift.ServerConnectivityCheckInterval > 0 {
-    var cancel context.CancelFunc
-    ctx, cancel = context.WithCancel(ctx)
-    defer cancel()
-    var tickerCtx context.Context
-    tickerCtx, tickerCancel = context.WithCancel(context.Background())
-    defer tickerCancel()
-    go func(ctx context.Context, cancel context.CancelFunc) {
-      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-      defer ticker.Stop()
-      for {
-        select {
-        case <-ctx.Done():
-          return
-        case <-ticker.C:
-          if !iprot.Transport().IsOpen() {
-            cancel()
-            return
-          }
-        }
-      }
-    }(tickerCtx, cancel)
+  return nil
+}
+
+// Parameters:
+//  - Zero
+//  - SeqOp
+//  - CombOp
+//  - NumPartitions
+func (p *IGeneralModuleClient) AggregateByKey4(ctx context.Context, zero *rpc.ISource, seqOp *rpc.ISource, combOp *rpc.ISource, numPartitions int64) (_err error) {
+  var _args162 IGeneralModuleAggregateByKey4Args
+  _args162.Zero = zero
+  _args162.SeqOp = seqOp
+  _args162.CombOp = combOp
+  _args162.NumPartitions = numPartitions
+  var _result164 IGeneralModuleAggregateByKey4Result
+  var _meta163 thrift.ResponseMeta
+  _meta163, _err = p.Client_().Call(ctx, "aggregateByKey4", &_args162, &_result164)
+  p.SetLastResponseMeta_(_meta163)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result164.Ex!= nil:
+    return _result164.Ex
   }
 
-  result := IGeneralModuleFilterResult{}
-  if err2 = p.handler.Filter(ctx, args.Src); err2 != nil {
-    tickerCancel()
-  switch v := err2.(type) {
-    case *rpc.IExecutorException:
-  result.Ex = v
-    default:
-    if err2 == thrift.ErrAbandonRequest {
-      return false, thrift.WrapTException(err2)
-    }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing filter: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "filter", thrift.EXCEPTION, seqId)
-    x.Write(ctx, oprot)
-    oprot.WriteMessageEnd(ctx)
-    oprot.Flush(ctx)
-    return true, thrift.WrapTException(err2)
+  return nil
+}
+
+// Parameters:
+//  - Zero
+//  - Src
+//  - NumPartitions
+//  - LocalFold
+func (p *IGeneralModuleClient) FoldByKey(ctx context.Context, zero *rpc.ISource, src *rpc.ISource, numPartitions int64, localFold bool) (_err error) {
+  var _args165 IGeneralModuleFoldByKeyArgs
+  _args165.Zero = zero
+  _args165.Src = src
+  _args165.NumPartitions = numPartitions
+  _args165.LocalFold = localFold
+  var _result167 IGeneralModuleFoldByKeyResult
+  var _meta166 thrift.ResponseMeta
+  _meta166, _err = p.Client_().Call(ctx, "foldByKey", &_args165, &_result167)
+  p.SetLastResponseMeta_(_meta166)
+  if _err != nil {
+    return
   }
+  switch {
+  case _result167.Ex!= nil:
+    return _result167.Ex
   }
-  tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "filter", thrift.REPLY, seqId); err2 != nil {
-    err = thrift.WrapTException(err2)
+
+  return nil
+}
+
+// Parameters:
+//  - Key
+//  - Column
+//  - Agg
+//  - NumPartitions
+func (p *IGeneralModuleClient) Pivot(ctx context.Context, key *rpc.ISource, column *rpc.ISource, agg *rpc.ISource, numPartitions int64) (_r string, _err error) {
+  var _args168 IGeneralModulePivotArgs
+  _args168.Key = key
+  _args168.Column = column
+  _args168.Agg = agg
+  _args168.NumPartitions = numPartitions
+  var _result170 IGeneralModulePivotResult
+  var _meta169 thrift.ResponseMeta
+  _meta169, _err = p.Client_().Call(ctx, "pivot", &_args168, &_result170)
+  p.SetLastResponseMeta_(_meta169)
+  if _err != nil {
+    return
   }
-  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  switch {
+  case _result170.Ex!= nil:
+    return _r, _result170.Ex
   }
-  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+
+  return _result170.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Src
+//  - Columns
+func (p *IGeneralModuleClient) Unpivot(ctx context.Context, src *rpc.ISource, columns string) (_err error) {
+  var _args171 IGeneralModuleUnpivotArgs
+  _args171.Src = src
+  _args171.Columns = columns
+  var _result173 IGeneralModuleUnpivotResult
+  var _meta172 thrift.ResponseMeta
+  _meta172, _err = p.Client_().Call(ctx, "unpivot", &_args171, &_result173)
+  p.SetLastResponseMeta_(_meta172)
+  if _err != nil {
+    return
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  switch {
+  case _result173.Ex!= nil:
+    return _result173.Ex
   }
-  if err != nil {
+
+  return nil
+}
+
+// Parameters:
+//  - Zero
+//  - Src
+func (p *IGeneralModuleClient) Scan(ctx context.Context, zero *rpc.ISource, src *rpc.ISource) (_err error) {
+  var _args174 IGeneralModuleScanArgs
+  _args174.Zero = zero
+  _args174.Src = src
+  var _result176 IGeneralModuleScanResult
+  var _meta175 thrift.ResponseMeta
+  _meta175, _err = p.Client_().Call(ctx, "scan", &_args174, &_result176)
+  p.SetLastResponseMeta_(_meta175)
+  if _err != nil {
     return
   }
-  return true, err
+  switch {
+  case _result176.Ex!= nil:
+    return _result176.Ex
+  }
+
+  return nil
 }
 
-type iGeneralModuleProcessorFlatmap struct {
+// Parameters:
+//  - Ascending
+func (p *IGeneralModuleClient) SortByKey(ctx context.Context, ascending bool) (_err error) {
+  var _args177 IGeneralModuleSortByKeyArgs
+  _args177.Ascending = ascending
+  var _result179 IGeneralModuleSortByKeyResult
+  var _meta178 thrift.ResponseMeta
+  _meta178, _err = p.Client_().Call(ctx, "sortByKey", &_args177, &_result179)
+  p.SetLastResponseMeta_(_meta178)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result179.Ex!= nil:
+    return _result179.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Ascending
+//  - NumPartitions
+func (p *IGeneralModuleClient) SortByKey2a(ctx context.Context, ascending bool, numPartitions int64) (_err error) {
+  var _args180 IGeneralModuleSortByKey2aArgs
+  _args180.Ascending = ascending
+  _args180.NumPartitions = numPartitions
+  var _result182 IGeneralModuleSortByKey2aResult
+  var _meta181 thrift.ResponseMeta
+  _meta181, _err = p.Client_().Call(ctx, "sortByKey2a", &_args180, &_result182)
+  p.SetLastResponseMeta_(_meta181)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result182.Ex!= nil:
+    return _result182.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Src
+//  - Ascending
+func (p *IGeneralModuleClient) SortByKey2b(ctx context.Context, src *rpc.ISource, ascending bool) (_err error) {
+  var _args183 IGeneralModuleSortByKey2bArgs
+  _args183.Src = src
+  _args183.Ascending = ascending
+  var _result185 IGeneralModuleSortByKey2bResult
+  var _meta184 thrift.ResponseMeta
+  _meta184, _err = p.Client_().Call(ctx, "sortByKey2b", &_args183, &_result185)
+  p.SetLastResponseMeta_(_meta184)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result185.Ex!= nil:
+    return _result185.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Src
+//  - Ascending
+//  - NumPartitions
+func (p *IGeneralModuleClient) SortByKey3(ctx context.Context, src *rpc.ISource, ascending bool, numPartitions int64) (_err error) {
+  var _args186 IGeneralModuleSortByKey3Args
+  _args186.Src = src
+  _args186.Ascending = ascending
+  _args186.NumPartitions = numPartitions
+  var _result188 IGeneralModuleSortByKey3Result
+  var _meta187 thrift.ResponseMeta
+  _meta187, _err = p.Client_().Call(ctx, "sortByKey3", &_args186, &_result188)
+  p.SetLastResponseMeta_(_meta187)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result188.Ex!= nil:
+    return _result188.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - NumPartitions
+//  - Ascending
+func (p *IGeneralModuleClient) RepartitionAndSortWithinPartitions(ctx context.Context, numPartitions int64, ascending bool) (_err error) {
+  var _args189 IGeneralModuleRepartitionAndSortWithinPartitionsArgs
+  _args189.NumPartitions = numPartitions
+  _args189.Ascending = ascending
+  var _result191 IGeneralModuleRepartitionAndSortWithinPartitionsResult
+  var _meta190 thrift.ResponseMeta
+  _meta190, _err = p.Client_().Call(ctx, "repartitionAndSortWithinPartitions", &_args189, &_result191)
+  p.SetLastResponseMeta_(_meta190)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result191.Ex!= nil:
+    return _result191.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - NumPartitions
+//  - Ascending
+func (p *IGeneralModuleClient) GroupByKeyAndSortValues(ctx context.Context, numPartitions int64, ascending bool) (_err error) {
+  var _args192 IGeneralModuleGroupByKeyAndSortValuesArgs
+  _args192.NumPartitions = numPartitions
+  _args192.Ascending = ascending
+  var _result194 IGeneralModuleGroupByKeyAndSortValuesResult
+  var _meta193 thrift.ResponseMeta
+  _meta193, _err = p.Client_().Call(ctx, "groupByKeyAndSortValues", &_args192, &_result194)
+  p.SetLastResponseMeta_(_meta193)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result194.Ex!= nil:
+    return _result194.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Src
+//  - NumPartitions
+//  - Ascending
+func (p *IGeneralModuleClient) GroupByKeyAndSortValues3(ctx context.Context, src *rpc.ISource, numPartitions int64, ascending bool) (_err error) {
+  var _args195 IGeneralModuleGroupByKeyAndSortValues3Args
+  _args195.Src = src
+  _args195.NumPartitions = numPartitions
+  _args195.Ascending = ascending
+  var _result197 IGeneralModuleGroupByKeyAndSortValues3Result
+  var _meta196 thrift.ResponseMeta
+  _meta196, _err = p.Client_().Call(ctx, "groupByKeyAndSortValues3", &_args195, &_result197)
+  p.SetLastResponseMeta_(_meta196)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result197.Ex!= nil:
+    return _result197.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Key
+//  - Seq
+//  - MaxGap
+//  - NumPartitions
+func (p *IGeneralModuleClient) GapsAndIslands(ctx context.Context, key *rpc.ISource, seq *rpc.ISource, maxGap int64, numPartitions int64) (_err error) {
+  var _args198 IGeneralModuleGapsAndIslandsArgs
+  _args198.Key = key
+  _args198.Seq = seq
+  _args198.MaxGap = maxGap
+  _args198.NumPartitions = numPartitions
+  var _result200 IGeneralModuleGapsAndIslandsResult
+  var _meta199 thrift.ResponseMeta
+  _meta199, _err = p.Client_().Call(ctx, "gapsAndIslands", &_args198, &_result200)
+  p.SetLastResponseMeta_(_meta199)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result200.Ex!= nil:
+    return _result200.Ex
+  }
+
+  return nil
+}
+
+func (p *IGeneralModuleClient) RecomputePartitions(ctx context.Context) (_r int64, _err error) {
+  var _args201 IGeneralModuleRecomputePartitionsArgs
+  var _result203 IGeneralModuleRecomputePartitionsResult
+  var _meta202 thrift.ResponseMeta
+  _meta202, _err = p.Client_().Call(ctx, "recomputePartitions", &_args201, &_result203)
+  p.SetLastResponseMeta_(_meta202)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result203.Ex!= nil:
+    return _r, _result203.Ex
+  }
+
+  return _result203.GetSuccess(), nil
+}
+
+func (p *IGeneralModuleClient) PartitionStats(ctx context.Context) (_r string, _err error) {
+  var _args204 IGeneralModulePartitionStatsArgs
+  var _result206 IGeneralModulePartitionStatsResult
+  var _meta205 thrift.ResponseMeta
+  _meta205, _err = p.Client_().Call(ctx, "partitionStats", &_args204, &_result206)
+  p.SetLastResponseMeta_(_meta205)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result206.Ex!= nil:
+    return _r, _result206.Ex
+  }
+
+  return _result206.GetSuccess(), nil
+}
+
+type IGeneralModuleProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorFlatmap) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleFlatmapArgs{}
+func (p *IGeneralModuleProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *IGeneralModuleProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *IGeneralModuleProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewIGeneralModuleProcessor(handler IGeneralModule) *IGeneralModuleProcessor {
+
+  self207 := &IGeneralModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self207.processorMap["executeTo"] = &iGeneralModuleProcessorExecuteTo{handler:handler}
+  self207.processorMap["map_"] = &iGeneralModuleProcessorMap_{handler:handler}
+  self207.processorMap["filter"] = &iGeneralModuleProcessorFilter{handler:handler}
+  self207.processorMap["flatmap"] = &iGeneralModuleProcessorFlatmap{handler:handler}
+  self207.processorMap["keyBy"] = &iGeneralModuleProcessorKeyBy{handler:handler}
+  self207.processorMap["mapWithIndex"] = &iGeneralModuleProcessorMapWithIndex{handler:handler}
+  self207.processorMap["mapPartitions"] = &iGeneralModuleProcessorMapPartitions{handler:handler}
+  self207.processorMap["mapPartitionsWithIndex"] = &iGeneralModuleProcessorMapPartitionsWithIndex{handler:handler}
+  self207.processorMap["mapPartitionsWithBoundary"] = &iGeneralModuleProcessorMapPartitionsWithBoundary{handler:handler}
+  self207.processorMap["mapExecutor"] = &iGeneralModuleProcessorMapExecutor{handler:handler}
+  self207.processorMap["mapExecutorTo"] = &iGeneralModuleProcessorMapExecutorTo{handler:handler}
+  self207.processorMap["pipeCmd"] = &iGeneralModuleProcessorPipeCmd{handler:handler}
+  self207.processorMap["select"] = &iGeneralModuleProcessorSelect{handler:handler}
+  self207.processorMap["selectTo"] = &iGeneralModuleProcessorSelectTo{handler:handler}
+  self207.processorMap["explode"] = &iGeneralModuleProcessorExplode{handler:handler}
+  self207.processorMap["explodeSelect"] = &iGeneralModuleProcessorExplodeSelect{handler:handler}
+  self207.processorMap["groupBy"] = &iGeneralModuleProcessorGroupBy{handler:handler}
+  self207.processorMap["sort"] = &iGeneralModuleProcessorSort{handler:handler}
+  self207.processorMap["sort2"] = &iGeneralModuleProcessorSort2{handler:handler}
+  self207.processorMap["sortBy"] = &iGeneralModuleProcessorSortBy{handler:handler}
+  self207.processorMap["sortBy3"] = &iGeneralModuleProcessorSortBy3{handler:handler}
+  self207.processorMap["union_"] = &iGeneralModuleProcessorUnion_{handler:handler}
+  self207.processorMap["union2"] = &iGeneralModuleProcessorUnion2{handler:handler}
+  self207.processorMap["unionAll"] = &iGeneralModuleProcessorUnionAll{handler:handler}
+  self207.processorMap["zip"] = &iGeneralModuleProcessorZip{handler:handler}
+  self207.processorMap["zipWithIndex"] = &iGeneralModuleProcessorZipWithIndex{handler:handler}
+  self207.processorMap["join"] = &iGeneralModuleProcessorJoin{handler:handler}
+  self207.processorMap["join3"] = &iGeneralModuleProcessorJoin3{handler:handler}
+  self207.processorMap["leftOuterJoin"] = &iGeneralModuleProcessorLeftOuterJoin{handler:handler}
+  self207.processorMap["rightOuterJoin"] = &iGeneralModuleProcessorRightOuterJoin{handler:handler}
+  self207.processorMap["fullOuterJoin"] = &iGeneralModuleProcessorFullOuterJoin{handler:handler}
+  self207.processorMap["coGroup"] = &iGeneralModuleProcessorCoGroup{handler:handler}
+  self207.processorMap["distinct"] = &iGeneralModuleProcessorDistinct{handler:handler}
+  self207.processorMap["distinct2"] = &iGeneralModuleProcessorDistinct2{handler:handler}
+  self207.processorMap["intersection"] = &iGeneralModuleProcessorIntersection{handler:handler}
+  self207.processorMap["subtract"] = &iGeneralModuleProcessorSubtract{handler:handler}
+  self207.processorMap["subtractByKey"] = &iGeneralModuleProcessorSubtractByKey{handler:handler}
+  self207.processorMap["repartition"] = &iGeneralModuleProcessorRepartition{handler:handler}
+  self207.processorMap["coalesce"] = &iGeneralModuleProcessorCoalesce{handler:handler}
+  self207.processorMap["partitionByRandom"] = &iGeneralModuleProcessorPartitionByRandom{handler:handler}
+  self207.processorMap["partitionByHash"] = &iGeneralModuleProcessorPartitionByHash{handler:handler}
+  self207.processorMap["partitionBy"] = &iGeneralModuleProcessorPartitionBy{handler:handler}
+  self207.processorMap["partitionByKeyHash"] = &iGeneralModuleProcessorPartitionByKeyHash{handler:handler}
+  self207.processorMap["partitionByKeyRange"] = &iGeneralModuleProcessorPartitionByKeyRange{handler:handler}
+  self207.processorMap["partitionByKey"] = &iGeneralModuleProcessorPartitionByKey{handler:handler}
+  self207.processorMap["reorderPartitions"] = &iGeneralModuleProcessorReorderPartitions{handler:handler}
+  self207.processorMap["reorderPartitionsBy"] = &iGeneralModuleProcessorReorderPartitionsBy{handler:handler}
+  self207.processorMap["partitionOffset"] = &iGeneralModuleProcessorPartitionOffset{handler:handler}
+  self207.processorMap["flatMapValues"] = &iGeneralModuleProcessorFlatMapValues{handler:handler}
+  self207.processorMap["mapValues"] = &iGeneralModuleProcessorMapValues{handler:handler}
+  self207.processorMap["groupByKey"] = &iGeneralModuleProcessorGroupByKey{handler:handler}
+  self207.processorMap["groupByKey2"] = &iGeneralModuleProcessorGroupByKey2{handler:handler}
+  self207.processorMap["reduceByKey"] = &iGeneralModuleProcessorReduceByKey{handler:handler}
+  self207.processorMap["aggregateByKey"] = &iGeneralModuleProcessorAggregateByKey{handler:handler}
+  self207.processorMap["aggregateByKey4"] = &iGeneralModuleProcessorAggregateByKey4{handler:handler}
+  self207.processorMap["foldByKey"] = &iGeneralModuleProcessorFoldByKey{handler:handler}
+  self207.processorMap["pivot"] = &iGeneralModuleProcessorPivot{handler:handler}
+  self207.processorMap["unpivot"] = &iGeneralModuleProcessorUnpivot{handler:handler}
+  self207.processorMap["scan"] = &iGeneralModuleProcessorScan{handler:handler}
+  self207.processorMap["sortByKey"] = &iGeneralModuleProcessorSortByKey{handler:handler}
+  self207.processorMap["sortByKey2a"] = &iGeneralModuleProcessorSortByKey2a{handler:handler}
+  self207.processorMap["sortByKey2b"] = &iGeneralModuleProcessorSortByKey2b{handler:handler}
+  self207.processorMap["sortByKey3"] = &iGeneralModuleProcessorSortByKey3{handler:handler}
+  self207.processorMap["repartitionAndSortWithinPartitions"] = &iGeneralModuleProcessorRepartitionAndSortWithinPartitions{handler:handler}
+  self207.processorMap["groupByKeyAndSortValues"] = &iGeneralModuleProcessorGroupByKeyAndSortValues{handler:handler}
+  self207.processorMap["groupByKeyAndSortValues3"] = &iGeneralModuleProcessorGroupByKeyAndSortValues3{handler:handler}
+  self207.processorMap["gapsAndIslands"] = &iGeneralModuleProcessorGapsAndIslands{handler:handler}
+  self207.processorMap["recomputePartitions"] = &iGeneralModuleProcessorRecomputePartitions{handler:handler}
+  self207.processorMap["partitionStats"] = &iGeneralModuleProcessorPartitionStats{handler:handler}
+return self207
+}
+
+func (p *IGeneralModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err2 := iprot.ReadMessageBegin(ctx)
+  if err2 != nil { return false, thrift.WrapTException(err2) }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(ctx, seqId, iprot, oprot)
+  }
+  iprot.Skip(ctx, thrift.STRUCT)
+  iprot.ReadMessageEnd(ctx)
+  x208 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
+  x208.Write(ctx, oprot)
+  oprot.WriteMessageEnd(ctx)
+  oprot.Flush(ctx)
+  return false, x208
+
+}
+
+type iGeneralModuleProcessorExecuteTo struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorExecuteTo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleExecuteToArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "flatmap", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "executeTo", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1367,8 +1957,8 @@ func (p *iGeneralModuleProcessorFlatmap) Process(ctx context.Context, seqId int3
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleFlatmapResult{}
-  if err2 = p.handler.Flatmap(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleExecuteToResult{}
+  if err2 = p.handler.ExecuteTo(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1377,8 +1967,8 @@ func (p *iGeneralModuleProcessorFlatmap) Process(ctx context.Context, seqId int3
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing flatmap: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "flatmap", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing executeTo: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "executeTo", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1386,7 +1976,7 @@ func (p *iGeneralModuleProcessorFlatmap) Process(ctx context.Context, seqId int3
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "flatmap", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "executeTo", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1404,17 +1994,17 @@ func (p *iGeneralModuleProcessorFlatmap) Process(ctx context.Context, seqId int3
   return true, err
 }
 
-type iGeneralModuleProcessorKeyBy struct {
+type iGeneralModuleProcessorMap_ struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorKeyBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleKeyByArgs{}
+func (p *iGeneralModuleProcessorMap_) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMap_Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "keyBy", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "map_", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1448,8 +2038,8 @@ func (p *iGeneralModuleProcessorKeyBy) Process(ctx context.Context, seqId int32,
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleKeyByResult{}
-  if err2 = p.handler.KeyBy(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleMap_Result{}
+  if err2 = p.handler.Map_(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1458,8 +2048,8 @@ func (p *iGeneralModuleProcessorKeyBy) Process(ctx context.Context, seqId int32,
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing keyBy: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "keyBy", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing map_: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "map_", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1467,7 +2057,7 @@ func (p *iGeneralModuleProcessorKeyBy) Process(ctx context.Context, seqId int32,
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "keyBy", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "map_", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1485,17 +2075,17 @@ func (p *iGeneralModuleProcessorKeyBy) Process(ctx context.Context, seqId int32,
   return true, err
 }
 
-type iGeneralModuleProcessorMapWithIndex struct {
+type iGeneralModuleProcessorFilter struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorMapWithIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleMapWithIndexArgs{}
+func (p *iGeneralModuleProcessorFilter) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleFilterArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapWithIndex", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "filter", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1529,8 +2119,8 @@ func (p *iGeneralModuleProcessorMapWithIndex) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleMapWithIndexResult{}
-  if err2 = p.handler.MapWithIndex(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleFilterResult{}
+  if err2 = p.handler.Filter(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1539,8 +2129,8 @@ func (p *iGeneralModuleProcessorMapWithIndex) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapWithIndex: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapWithIndex", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing filter: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "filter", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1548,7 +2138,7 @@ func (p *iGeneralModuleProcessorMapWithIndex) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "mapWithIndex", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "filter", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1566,17 +2156,17 @@ func (p *iGeneralModuleProcessorMapWithIndex) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorMapPartitions struct {
+type iGeneralModuleProcessorFlatmap struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorMapPartitions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleMapPartitionsArgs{}
+func (p *iGeneralModuleProcessorFlatmap) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleFlatmapArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapPartitions", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "flatmap", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1610,8 +2200,8 @@ func (p *iGeneralModuleProcessorMapPartitions) Process(ctx context.Context, seqI
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleMapPartitionsResult{}
-  if err2 = p.handler.MapPartitions(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleFlatmapResult{}
+  if err2 = p.handler.Flatmap(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1620,8 +2210,8 @@ func (p *iGeneralModuleProcessorMapPartitions) Process(ctx context.Context, seqI
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapPartitions: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapPartitions", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing flatmap: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "flatmap", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1629,7 +2219,7 @@ func (p *iGeneralModuleProcessorMapPartitions) Process(ctx context.Context, seqI
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "mapPartitions", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "flatmap", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1647,17 +2237,17 @@ func (p *iGeneralModuleProcessorMapPartitions) Process(ctx context.Context, seqI
   return true, err
 }
 
-type iGeneralModuleProcessorMapPartitionsWithIndex struct {
+type iGeneralModuleProcessorKeyBy struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorMapPartitionsWithIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleMapPartitionsWithIndexArgs{}
+func (p *iGeneralModuleProcessorKeyBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleKeyByArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapPartitionsWithIndex", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "keyBy", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1691,8 +2281,8 @@ func (p *iGeneralModuleProcessorMapPartitionsWithIndex) Process(ctx context.Cont
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleMapPartitionsWithIndexResult{}
-  if err2 = p.handler.MapPartitionsWithIndex(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleKeyByResult{}
+  if err2 = p.handler.KeyBy(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1701,8 +2291,8 @@ func (p *iGeneralModuleProcessorMapPartitionsWithIndex) Process(ctx context.Cont
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapPartitionsWithIndex: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapPartitionsWithIndex", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing keyBy: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "keyBy", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1710,7 +2300,7 @@ func (p *iGeneralModuleProcessorMapPartitionsWithIndex) Process(ctx context.Cont
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "mapPartitionsWithIndex", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "keyBy", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1728,17 +2318,17 @@ func (p *iGeneralModuleProcessorMapPartitionsWithIndex) Process(ctx context.Cont
   return true, err
 }
 
-type iGeneralModuleProcessorMapExecutor struct {
+type iGeneralModuleProcessorMapWithIndex struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorMapExecutor) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleMapExecutorArgs{}
+func (p *iGeneralModuleProcessorMapWithIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMapWithIndexArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapExecutor", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "mapWithIndex", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1772,8 +2362,8 @@ func (p *iGeneralModuleProcessorMapExecutor) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleMapExecutorResult{}
-  if err2 = p.handler.MapExecutor(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleMapWithIndexResult{}
+  if err2 = p.handler.MapWithIndex(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1782,8 +2372,8 @@ func (p *iGeneralModuleProcessorMapExecutor) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapExecutor: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapExecutor", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapWithIndex: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapWithIndex", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1791,7 +2381,7 @@ func (p *iGeneralModuleProcessorMapExecutor) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "mapExecutor", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "mapWithIndex", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1809,17 +2399,17 @@ func (p *iGeneralModuleProcessorMapExecutor) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorMapExecutorTo struct {
+type iGeneralModuleProcessorMapPartitions struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorMapExecutorTo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleMapExecutorToArgs{}
+func (p *iGeneralModuleProcessorMapPartitions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMapPartitionsArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapExecutorTo", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "mapPartitions", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1853,8 +2443,8 @@ func (p *iGeneralModuleProcessorMapExecutorTo) Process(ctx context.Context, seqI
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleMapExecutorToResult{}
-  if err2 = p.handler.MapExecutorTo(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleMapPartitionsResult{}
+  if err2 = p.handler.MapPartitions(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1863,8 +2453,8 @@ func (p *iGeneralModuleProcessorMapExecutorTo) Process(ctx context.Context, seqI
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapExecutorTo: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapExecutorTo", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapPartitions: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapPartitions", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1872,7 +2462,7 @@ func (p *iGeneralModuleProcessorMapExecutorTo) Process(ctx context.Context, seqI
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "mapExecutorTo", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "mapPartitions", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1890,17 +2480,17 @@ func (p *iGeneralModuleProcessorMapExecutorTo) Process(ctx context.Context, seqI
   return true, err
 }
 
-type iGeneralModuleProcessorGroupBy struct {
+type iGeneralModuleProcessorMapPartitionsWithIndex struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorGroupBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleGroupByArgs{}
+func (p *iGeneralModuleProcessorMapPartitionsWithIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMapPartitionsWithIndexArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "groupBy", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "mapPartitionsWithIndex", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1934,8 +2524,8 @@ func (p *iGeneralModuleProcessorGroupBy) Process(ctx context.Context, seqId int3
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleGroupByResult{}
-  if err2 = p.handler.GroupBy(ctx, args.Src, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleMapPartitionsWithIndexResult{}
+  if err2 = p.handler.MapPartitionsWithIndex(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1944,8 +2534,8 @@ func (p *iGeneralModuleProcessorGroupBy) Process(ctx context.Context, seqId int3
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupBy: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "groupBy", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapPartitionsWithIndex: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapPartitionsWithIndex", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1953,7 +2543,7 @@ func (p *iGeneralModuleProcessorGroupBy) Process(ctx context.Context, seqId int3
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "groupBy", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "mapPartitionsWithIndex", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1971,17 +2561,17 @@ func (p *iGeneralModuleProcessorGroupBy) Process(ctx context.Context, seqId int3
   return true, err
 }
 
-type iGeneralModuleProcessorSort struct {
+type iGeneralModuleProcessorMapPartitionsWithBoundary struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSort) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSortArgs{}
+func (p *iGeneralModuleProcessorMapPartitionsWithBoundary) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMapPartitionsWithBoundaryArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sort", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "mapPartitionsWithBoundary", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2015,8 +2605,8 @@ func (p *iGeneralModuleProcessorSort) Process(ctx context.Context, seqId int32,
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleSortResult{}
-  if err2 = p.handler.Sort(ctx, args.Ascending); err2 != nil {
+  result := IGeneralModuleMapPartitionsWithBoundaryResult{}
+  if err2 = p.handler.MapPartitionsWithBoundary(ctx, args.Src, args.K); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2025,8 +2615,8 @@ func (p *iGeneralModuleProcessorSort) Process(ctx context.Context, seqId int32,
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sort: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sort", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapPartitionsWithBoundary: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapPartitionsWithBoundary", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2034,7 +2624,7 @@ func (p *iGeneralModuleProcessorSort) Process(ctx context.Context, seqId int32,
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sort", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "mapPartitionsWithBoundary", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2052,17 +2642,17 @@ func (p *iGeneralModuleProcessorSort) Process(ctx context.Context, seqId int32,
   return true, err
 }
 
-type iGeneralModuleProcessorSort2 struct {
+type iGeneralModuleProcessorMapExecutor struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSort2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSort2Args{}
+func (p *iGeneralModuleProcessorMapExecutor) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMapExecutorArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sort2", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "mapExecutor", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2096,8 +2686,8 @@ func (p *iGeneralModuleProcessorSort2) Process(ctx context.Context, seqId int32,
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleSort2Result{}
-  if err2 = p.handler.Sort2(ctx, args.Ascending, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleMapExecutorResult{}
+  if err2 = p.handler.MapExecutor(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2106,8 +2696,8 @@ func (p *iGeneralModuleProcessorSort2) Process(ctx context.Context, seqId int32,
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sort2: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sort2", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapExecutor: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapExecutor", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2115,7 +2705,7 @@ func (p *iGeneralModuleProcessorSort2) Process(ctx context.Context, seqId int32,
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sort2", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "mapExecutor", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2133,17 +2723,17 @@ func (p *iGeneralModuleProcessorSort2) Process(ctx context.Context, seqId int32,
   return true, err
 }
 
-type iGeneralModuleProcessorSortBy struct {
+type iGeneralModuleProcessorMapExecutorTo struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSortBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSortByArgs{}
+func (p *iGeneralModuleProcessorMapExecutorTo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMapExecutorToArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortBy", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "mapExecutorTo", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2177,8 +2767,8 @@ func (p *iGeneralModuleProcessorSortBy) Process(ctx context.Context, seqId int32
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleSortByResult{}
-  if err2 = p.handler.SortBy(ctx, args.Src, args.Ascending); err2 != nil {
+  result := IGeneralModuleMapExecutorToResult{}
+  if err2 = p.handler.MapExecutorTo(ctx, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2187,8 +2777,8 @@ func (p *iGeneralModuleProcessorSortBy) Process(ctx context.Context, seqId int32
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortBy: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortBy", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapExecutorTo: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapExecutorTo", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2196,7 +2786,7 @@ func (p *iGeneralModuleProcessorSortBy) Process(ctx context.Context, seqId int32
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sortBy", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "mapExecutorTo", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2214,17 +2804,17 @@ func (p *iGeneralModuleProcessorSortBy) Process(ctx context.Context, seqId int32
   return true, err
 }
 
-type iGeneralModuleProcessorSortBy3 struct {
+type iGeneralModuleProcessorPipeCmd struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSortBy3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSortBy3Args{}
+func (p *iGeneralModuleProcessorPipeCmd) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePipeCmdArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortBy3", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "pipeCmd", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2258,8 +2848,8 @@ func (p *iGeneralModuleProcessorSortBy3) Process(ctx context.Context, seqId int3
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleSortBy3Result{}
-  if err2 = p.handler.SortBy3(ctx, args.Src, args.Ascending, args.NumPartitions); err2 != nil {
+  result := IGeneralModulePipeCmdResult{}
+  if err2 = p.handler.PipeCmd(ctx, args.Command, args.Env, args.Encoding); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2268,8 +2858,8 @@ func (p *iGeneralModuleProcessorSortBy3) Process(ctx context.Context, seqId int3
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortBy3: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortBy3", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing pipeCmd: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "pipeCmd", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2277,7 +2867,7 @@ func (p *iGeneralModuleProcessorSortBy3) Process(ctx context.Context, seqId int3
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sortBy3", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "pipeCmd", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2295,17 +2885,17 @@ func (p *iGeneralModuleProcessorSortBy3) Process(ctx context.Context, seqId int3
   return true, err
 }
 
-type iGeneralModuleProcessorUnion_ struct {
+type iGeneralModuleProcessorSelect struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorUnion_) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleUnion_Args{}
+func (p *iGeneralModuleProcessorSelect) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSelectArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "union_", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "select", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2339,8 +2929,8 @@ func (p *iGeneralModuleProcessorUnion_) Process(ctx context.Context, seqId int32
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleUnion_Result{}
-  if err2 = p.handler.Union_(ctx, args.Other, args.PreserveOrder); err2 != nil {
+  result := IGeneralModuleSelectResult{}
+  if err2 = p.handler.Select(ctx, args.Paths); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2349,8 +2939,8 @@ func (p *iGeneralModuleProcessorUnion_) Process(ctx context.Context, seqId int32
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing union_: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "union_", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing select: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "select", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2358,7 +2948,7 @@ func (p *iGeneralModuleProcessorUnion_) Process(ctx context.Context, seqId int32
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "union_", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "select", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2376,17 +2966,17 @@ func (p *iGeneralModuleProcessorUnion_) Process(ctx context.Context, seqId int32
   return true, err
 }
 
-type iGeneralModuleProcessorUnion2 struct {
+type iGeneralModuleProcessorSelectTo struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorUnion2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleUnion2Args{}
+func (p *iGeneralModuleProcessorSelectTo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSelectToArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "union2", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "selectTo", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2420,8 +3010,8 @@ func (p *iGeneralModuleProcessorUnion2) Process(ctx context.Context, seqId int32
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleUnion2Result{}
-  if err2 = p.handler.Union2(ctx, args.Other, args.PreserveOrder, args.Src); err2 != nil {
+  result := IGeneralModuleSelectToResult{}
+  if err2 = p.handler.SelectTo(ctx, args.Src, args.Paths); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2430,8 +3020,8 @@ func (p *iGeneralModuleProcessorUnion2) Process(ctx context.Context, seqId int32
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing union2: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "union2", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing selectTo: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "selectTo", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2439,7 +3029,7 @@ func (p *iGeneralModuleProcessorUnion2) Process(ctx context.Context, seqId int32
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "union2", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "selectTo", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2457,17 +3047,17 @@ func (p *iGeneralModuleProcessorUnion2) Process(ctx context.Context, seqId int32
   return true, err
 }
 
-type iGeneralModuleProcessorJoin struct {
+type iGeneralModuleProcessorExplode struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorJoin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleJoinArgs{}
+func (p *iGeneralModuleProcessorExplode) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleExplodeArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "join", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "explode", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2501,8 +3091,8 @@ func (p *iGeneralModuleProcessorJoin) Process(ctx context.Context, seqId int32,
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleJoinResult{}
-  if err2 = p.handler.Join(ctx, args.Other, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleExplodeResult{}
+  if err2 = p.handler.Explode(ctx, args.Src, args.Path); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2511,8 +3101,8 @@ func (p *iGeneralModuleProcessorJoin) Process(ctx context.Context, seqId int32,
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing join: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "join", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing explode: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "explode", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2520,7 +3110,7 @@ func (p *iGeneralModuleProcessorJoin) Process(ctx context.Context, seqId int32,
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "join", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "explode", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2538,17 +3128,17 @@ func (p *iGeneralModuleProcessorJoin) Process(ctx context.Context, seqId int32,
   return true, err
 }
 
-type iGeneralModuleProcessorJoin3 struct {
+type iGeneralModuleProcessorExplodeSelect struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorJoin3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleJoin3Args{}
+func (p *iGeneralModuleProcessorExplodeSelect) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleExplodeSelectArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "join3", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "explodeSelect", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2582,8 +3172,8 @@ func (p *iGeneralModuleProcessorJoin3) Process(ctx context.Context, seqId int32,
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleJoin3Result{}
-  if err2 = p.handler.Join3(ctx, args.Other, args.NumPartitions, args.Src); err2 != nil {
+  result := IGeneralModuleExplodeSelectResult{}
+  if err2 = p.handler.ExplodeSelect(ctx, args.Src, args.Path, args.Paths); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2592,8 +3182,8 @@ func (p *iGeneralModuleProcessorJoin3) Process(ctx context.Context, seqId int32,
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing join3: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "join3", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing explodeSelect: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "explodeSelect", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2601,7 +3191,7 @@ func (p *iGeneralModuleProcessorJoin3) Process(ctx context.Context, seqId int32,
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "join3", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "explodeSelect", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2619,17 +3209,17 @@ func (p *iGeneralModuleProcessorJoin3) Process(ctx context.Context, seqId int32,
   return true, err
 }
 
-type iGeneralModuleProcessorDistinct struct {
+type iGeneralModuleProcessorGroupBy struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorDistinct) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleDistinctArgs{}
+func (p *iGeneralModuleProcessorGroupBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleGroupByArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "distinct", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "groupBy", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2663,8 +3253,8 @@ func (p *iGeneralModuleProcessorDistinct) Process(ctx context.Context, seqId int
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleDistinctResult{}
-  if err2 = p.handler.Distinct(ctx, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleGroupByResult{}
+  if err2 = p.handler.GroupBy(ctx, args.Src, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2673,8 +3263,8 @@ func (p *iGeneralModuleProcessorDistinct) Process(ctx context.Context, seqId int
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing distinct: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "distinct", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupBy: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupBy", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2682,7 +3272,7 @@ func (p *iGeneralModuleProcessorDistinct) Process(ctx context.Context, seqId int
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "distinct", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "groupBy", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2700,17 +3290,17 @@ func (p *iGeneralModuleProcessorDistinct) Process(ctx context.Context, seqId int
   return true, err
 }
 
-type iGeneralModuleProcessorDistinct2 struct {
+type iGeneralModuleProcessorSort struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorDistinct2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleDistinct2Args{}
+func (p *iGeneralModuleProcessorSort) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSortArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "distinct2", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "sort", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2744,8 +3334,8 @@ func (p *iGeneralModuleProcessorDistinct2) Process(ctx context.Context, seqId in
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleDistinct2Result{}
-  if err2 = p.handler.Distinct2(ctx, args.NumPartitions, args.Src); err2 != nil {
+  result := IGeneralModuleSortResult{}
+  if err2 = p.handler.Sort(ctx, args.Ascending); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2754,8 +3344,8 @@ func (p *iGeneralModuleProcessorDistinct2) Process(ctx context.Context, seqId in
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing distinct2: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "distinct2", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sort: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sort", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2763,7 +3353,7 @@ func (p *iGeneralModuleProcessorDistinct2) Process(ctx context.Context, seqId in
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "distinct2", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "sort", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2781,17 +3371,17 @@ func (p *iGeneralModuleProcessorDistinct2) Process(ctx context.Context, seqId in
   return true, err
 }
 
-type iGeneralModuleProcessorRepartition struct {
+type iGeneralModuleProcessorSort2 struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorRepartition) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleRepartitionArgs{}
+func (p *iGeneralModuleProcessorSort2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSort2Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "repartition", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "sort2", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2825,8 +3415,8 @@ func (p *iGeneralModuleProcessorRepartition) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleRepartitionResult{}
-  if err2 = p.handler.Repartition(ctx, args.NumPartitions, args.PreserveOrdering, args.Global_); err2 != nil {
+  result := IGeneralModuleSort2Result{}
+  if err2 = p.handler.Sort2(ctx, args.Ascending, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2835,8 +3425,8 @@ func (p *iGeneralModuleProcessorRepartition) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing repartition: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "repartition", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sort2: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sort2", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2844,7 +3434,7 @@ func (p *iGeneralModuleProcessorRepartition) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "repartition", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "sort2", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2862,17 +3452,17 @@ func (p *iGeneralModuleProcessorRepartition) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorPartitionByRandom struct {
+type iGeneralModuleProcessorSortBy struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorPartitionByRandom) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModulePartitionByRandomArgs{}
+func (p *iGeneralModuleProcessorSortBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSortByArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionByRandom", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "sortBy", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2906,8 +3496,8 @@ func (p *iGeneralModuleProcessorPartitionByRandom) Process(ctx context.Context,
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModulePartitionByRandomResult{}
-  if err2 = p.handler.PartitionByRandom(ctx, args.NumPartitions, args.Seed); err2 != nil {
+  result := IGeneralModuleSortByResult{}
+  if err2 = p.handler.SortBy(ctx, args.Src, args.Ascending); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2916,8 +3506,8 @@ func (p *iGeneralModuleProcessorPartitionByRandom) Process(ctx context.Context,
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionByRandom: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionByRandom", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortBy: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortBy", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2925,7 +3515,7 @@ func (p *iGeneralModuleProcessorPartitionByRandom) Process(ctx context.Context,
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionByRandom", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "sortBy", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -2943,17 +3533,17 @@ func (p *iGeneralModuleProcessorPartitionByRandom) Process(ctx context.Context,
   return true, err
 }
 
-type iGeneralModuleProcessorPartitionByHash struct {
+type iGeneralModuleProcessorSortBy3 struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorPartitionByHash) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModulePartitionByHashArgs{}
+func (p *iGeneralModuleProcessorSortBy3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSortBy3Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionByHash", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "sortBy3", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -2987,8 +3577,8 @@ func (p *iGeneralModuleProcessorPartitionByHash) Process(ctx context.Context, se
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModulePartitionByHashResult{}
-  if err2 = p.handler.PartitionByHash(ctx, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleSortBy3Result{}
+  if err2 = p.handler.SortBy3(ctx, args.Src, args.Ascending, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -2997,8 +3587,8 @@ func (p *iGeneralModuleProcessorPartitionByHash) Process(ctx context.Context, se
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionByHash: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionByHash", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortBy3: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortBy3", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3006,7 +3596,7 @@ func (p *iGeneralModuleProcessorPartitionByHash) Process(ctx context.Context, se
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionByHash", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "sortBy3", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3024,17 +3614,17 @@ func (p *iGeneralModuleProcessorPartitionByHash) Process(ctx context.Context, se
   return true, err
 }
 
-type iGeneralModuleProcessorPartitionBy struct {
+type iGeneralModuleProcessorUnion_ struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorPartitionBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModulePartitionByArgs{}
+func (p *iGeneralModuleProcessorUnion_) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleUnion_Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionBy", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "union_", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3068,8 +3658,8 @@ func (p *iGeneralModuleProcessorPartitionBy) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModulePartitionByResult{}
-  if err2 = p.handler.PartitionBy(ctx, args.Src, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleUnion_Result{}
+  if err2 = p.handler.Union_(ctx, args.Other, args.PreserveOrder); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3078,8 +3668,8 @@ func (p *iGeneralModuleProcessorPartitionBy) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionBy: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionBy", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing union_: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "union_", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3087,7 +3677,7 @@ func (p *iGeneralModuleProcessorPartitionBy) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionBy", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "union_", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3105,17 +3695,17 @@ func (p *iGeneralModuleProcessorPartitionBy) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorFlatMapValues struct {
+type iGeneralModuleProcessorUnion2 struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorFlatMapValues) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleFlatMapValuesArgs{}
+func (p *iGeneralModuleProcessorUnion2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleUnion2Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "flatMapValues", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "union2", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3149,8 +3739,8 @@ func (p *iGeneralModuleProcessorFlatMapValues) Process(ctx context.Context, seqI
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleFlatMapValuesResult{}
-  if err2 = p.handler.FlatMapValues(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleUnion2Result{}
+  if err2 = p.handler.Union2(ctx, args.Other, args.PreserveOrder, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3159,8 +3749,8 @@ func (p *iGeneralModuleProcessorFlatMapValues) Process(ctx context.Context, seqI
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing flatMapValues: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "flatMapValues", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing union2: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "union2", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3168,7 +3758,7 @@ func (p *iGeneralModuleProcessorFlatMapValues) Process(ctx context.Context, seqI
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "flatMapValues", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "union2", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3186,17 +3776,17 @@ func (p *iGeneralModuleProcessorFlatMapValues) Process(ctx context.Context, seqI
   return true, err
 }
 
-type iGeneralModuleProcessorMapValues struct {
+type iGeneralModuleProcessorUnionAll struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorMapValues) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleMapValuesArgs{}
+func (p *iGeneralModuleProcessorUnionAll) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleUnionAllArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapValues", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "unionAll", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3230,8 +3820,8 @@ func (p *iGeneralModuleProcessorMapValues) Process(ctx context.Context, seqId in
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleMapValuesResult{}
-  if err2 = p.handler.MapValues(ctx, args.Src); err2 != nil {
+  result := IGeneralModuleUnionAllResult{}
+  if err2 = p.handler.UnionAll(ctx, args.Others, args.Rebalance); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3240,8 +3830,8 @@ func (p *iGeneralModuleProcessorMapValues) Process(ctx context.Context, seqId in
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapValues: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "mapValues", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing unionAll: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "unionAll", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3249,7 +3839,7 @@ func (p *iGeneralModuleProcessorMapValues) Process(ctx context.Context, seqId in
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "mapValues", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "unionAll", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3267,17 +3857,17 @@ func (p *iGeneralModuleProcessorMapValues) Process(ctx context.Context, seqId in
   return true, err
 }
 
-type iGeneralModuleProcessorGroupByKey struct {
+type iGeneralModuleProcessorZip struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorGroupByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleGroupByKeyArgs{}
+func (p *iGeneralModuleProcessorZip) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleZipArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "groupByKey", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "zip", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3311,8 +3901,8 @@ func (p *iGeneralModuleProcessorGroupByKey) Process(ctx context.Context, seqId i
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleGroupByKeyResult{}
-  if err2 = p.handler.GroupByKey(ctx, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleZipResult{}
+  if err2 = p.handler.Zip(ctx, args.Other, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3321,8 +3911,8 @@ func (p *iGeneralModuleProcessorGroupByKey) Process(ctx context.Context, seqId i
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupByKey: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "groupByKey", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing zip: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "zip", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3330,7 +3920,7 @@ func (p *iGeneralModuleProcessorGroupByKey) Process(ctx context.Context, seqId i
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "groupByKey", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "zip", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3348,17 +3938,17 @@ func (p *iGeneralModuleProcessorGroupByKey) Process(ctx context.Context, seqId i
   return true, err
 }
 
-type iGeneralModuleProcessorGroupByKey2 struct {
+type iGeneralModuleProcessorZipWithIndex struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorGroupByKey2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleGroupByKey2Args{}
+func (p *iGeneralModuleProcessorZipWithIndex) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleZipWithIndexArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "groupByKey2", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "zipWithIndex", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3392,8 +3982,8 @@ func (p *iGeneralModuleProcessorGroupByKey2) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleGroupByKey2Result{}
-  if err2 = p.handler.GroupByKey2(ctx, args.NumPartitions, args.Src); err2 != nil {
+  result := IGeneralModuleZipWithIndexResult{}
+  if err2 = p.handler.ZipWithIndex(ctx); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3402,8 +3992,8 @@ func (p *iGeneralModuleProcessorGroupByKey2) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupByKey2: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "groupByKey2", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing zipWithIndex: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "zipWithIndex", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3411,7 +4001,7 @@ func (p *iGeneralModuleProcessorGroupByKey2) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "groupByKey2", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "zipWithIndex", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3429,17 +4019,17 @@ func (p *iGeneralModuleProcessorGroupByKey2) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorReduceByKey struct {
+type iGeneralModuleProcessorJoin struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorReduceByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleReduceByKeyArgs{}
+func (p *iGeneralModuleProcessorJoin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleJoinArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "reduceByKey", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "join", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3473,8 +4063,8 @@ func (p *iGeneralModuleProcessorReduceByKey) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleReduceByKeyResult{}
-  if err2 = p.handler.ReduceByKey(ctx, args.Src, args.NumPartitions, args.LocalReduce); err2 != nil {
+  result := IGeneralModuleJoinResult{}
+  if err2 = p.handler.Join(ctx, args.Other, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3483,8 +4073,8 @@ func (p *iGeneralModuleProcessorReduceByKey) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reduceByKey: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "reduceByKey", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing join: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "join", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3492,7 +4082,7 @@ func (p *iGeneralModuleProcessorReduceByKey) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "reduceByKey", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "join", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3510,17 +4100,17 @@ func (p *iGeneralModuleProcessorReduceByKey) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorAggregateByKey struct {
+type iGeneralModuleProcessorJoin3 struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorAggregateByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleAggregateByKeyArgs{}
+func (p *iGeneralModuleProcessorJoin3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleJoin3Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "aggregateByKey", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "join3", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3554,8 +4144,8 @@ func (p *iGeneralModuleProcessorAggregateByKey) Process(ctx context.Context, seq
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleAggregateByKeyResult{}
-  if err2 = p.handler.AggregateByKey(ctx, args.Zero, args.SeqOp, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleJoin3Result{}
+  if err2 = p.handler.Join3(ctx, args.Other, args.NumPartitions, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3564,8 +4154,8 @@ func (p *iGeneralModuleProcessorAggregateByKey) Process(ctx context.Context, seq
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateByKey: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "aggregateByKey", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing join3: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "join3", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3573,7 +4163,7 @@ func (p *iGeneralModuleProcessorAggregateByKey) Process(ctx context.Context, seq
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "aggregateByKey", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "join3", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3591,17 +4181,17 @@ func (p *iGeneralModuleProcessorAggregateByKey) Process(ctx context.Context, seq
   return true, err
 }
 
-type iGeneralModuleProcessorAggregateByKey4 struct {
+type iGeneralModuleProcessorLeftOuterJoin struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorAggregateByKey4) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleAggregateByKey4Args{}
+func (p *iGeneralModuleProcessorLeftOuterJoin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleLeftOuterJoinArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "aggregateByKey4", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "leftOuterJoin", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3635,8 +4225,8 @@ func (p *iGeneralModuleProcessorAggregateByKey4) Process(ctx context.Context, se
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleAggregateByKey4Result{}
-  if err2 = p.handler.AggregateByKey4(ctx, args.Zero, args.SeqOp, args.CombOp, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleLeftOuterJoinResult{}
+  if err2 = p.handler.LeftOuterJoin(ctx, args.Other, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3645,8 +4235,8 @@ func (p *iGeneralModuleProcessorAggregateByKey4) Process(ctx context.Context, se
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateByKey4: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "aggregateByKey4", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing leftOuterJoin: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "leftOuterJoin", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3654,7 +4244,7 @@ func (p *iGeneralModuleProcessorAggregateByKey4) Process(ctx context.Context, se
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "aggregateByKey4", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "leftOuterJoin", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3672,17 +4262,17 @@ func (p *iGeneralModuleProcessorAggregateByKey4) Process(ctx context.Context, se
   return true, err
 }
 
-type iGeneralModuleProcessorFoldByKey struct {
+type iGeneralModuleProcessorRightOuterJoin struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorFoldByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleFoldByKeyArgs{}
+func (p *iGeneralModuleProcessorRightOuterJoin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleRightOuterJoinArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "foldByKey", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "rightOuterJoin", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3716,8 +4306,8 @@ func (p *iGeneralModuleProcessorFoldByKey) Process(ctx context.Context, seqId in
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleFoldByKeyResult{}
-  if err2 = p.handler.FoldByKey(ctx, args.Zero, args.Src, args.NumPartitions, args.LocalFold); err2 != nil {
+  result := IGeneralModuleRightOuterJoinResult{}
+  if err2 = p.handler.RightOuterJoin(ctx, args.Other, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3726,8 +4316,8 @@ func (p *iGeneralModuleProcessorFoldByKey) Process(ctx context.Context, seqId in
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing foldByKey: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "foldByKey", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing rightOuterJoin: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "rightOuterJoin", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3735,7 +4325,7 @@ func (p *iGeneralModuleProcessorFoldByKey) Process(ctx context.Context, seqId in
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "foldByKey", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "rightOuterJoin", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3753,17 +4343,17 @@ func (p *iGeneralModuleProcessorFoldByKey) Process(ctx context.Context, seqId in
   return true, err
 }
 
-type iGeneralModuleProcessorSortByKey struct {
+type iGeneralModuleProcessorFullOuterJoin struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSortByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSortByKeyArgs{}
+func (p *iGeneralModuleProcessorFullOuterJoin) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleFullOuterJoinArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "fullOuterJoin", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3797,8 +4387,8 @@ func (p *iGeneralModuleProcessorSortByKey) Process(ctx context.Context, seqId in
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleSortByKeyResult{}
-  if err2 = p.handler.SortByKey(ctx, args.Ascending); err2 != nil {
+  result := IGeneralModuleFullOuterJoinResult{}
+  if err2 = p.handler.FullOuterJoin(ctx, args.Other, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3807,8 +4397,8 @@ func (p *iGeneralModuleProcessorSortByKey) Process(ctx context.Context, seqId in
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing fullOuterJoin: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "fullOuterJoin", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3816,7 +4406,7 @@ func (p *iGeneralModuleProcessorSortByKey) Process(ctx context.Context, seqId in
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "fullOuterJoin", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3834,17 +4424,17 @@ func (p *iGeneralModuleProcessorSortByKey) Process(ctx context.Context, seqId in
   return true, err
 }
 
-type iGeneralModuleProcessorSortByKey2a struct {
+type iGeneralModuleProcessorCoGroup struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSortByKey2a) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSortByKey2aArgs{}
+func (p *iGeneralModuleProcessorCoGroup) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleCoGroupArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey2a", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "coGroup", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3878,8 +4468,8 @@ func (p *iGeneralModuleProcessorSortByKey2a) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleSortByKey2aResult{}
-  if err2 = p.handler.SortByKey2a(ctx, args.Ascending, args.NumPartitions); err2 != nil {
+  result := IGeneralModuleCoGroupResult{}
+  if err2 = p.handler.CoGroup(ctx, args.Other, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3888,8 +4478,8 @@ func (p *iGeneralModuleProcessorSortByKey2a) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey2a: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey2a", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing coGroup: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "coGroup", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3897,7 +4487,7 @@ func (p *iGeneralModuleProcessorSortByKey2a) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey2a", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "coGroup", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3915,17 +4505,17 @@ func (p *iGeneralModuleProcessorSortByKey2a) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorSortByKey2b struct {
+type iGeneralModuleProcessorDistinct struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSortByKey2b) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSortByKey2bArgs{}
+func (p *iGeneralModuleProcessorDistinct) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleDistinctArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey2b", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "distinct", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3959,8 +4549,8 @@ func (p *iGeneralModuleProcessorSortByKey2b) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IGeneralModuleSortByKey2bResult{}
-  if err2 = p.handler.SortByKey2b(ctx, args.Src, args.Ascending); err2 != nil {
+  result := IGeneralModuleDistinctResult{}
+  if err2 = p.handler.Distinct(ctx, args.NumPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -3969,8 +4559,8 @@ func (p *iGeneralModuleProcessorSortByKey2b) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey2b: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey2b", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing distinct: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "distinct", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -3978,7 +4568,7 @@ func (p *iGeneralModuleProcessorSortByKey2b) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey2b", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "distinct", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -3996,112 +4586,9766 @@ func (p *iGeneralModuleProcessorSortByKey2b) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iGeneralModuleProcessorSortByKey3 struct {
+type iGeneralModuleProcessorDistinct2 struct {
   handler IGeneralModule
 }
 
-func (p *iGeneralModuleProcessorSortByKey3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IGeneralModuleSortByKey3Args{}
+func (p *iGeneralModuleProcessorDistinct2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleDistinct2Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey3", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "distinct2", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
     return false, thrift.WrapTException(err2)
   }
-  iprot.ReadMessageEnd(ctx)
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleDistinct2Result{}
+  if err2 = p.handler.Distinct2(ctx, args.NumPartitions, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing distinct2: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "distinct2", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "distinct2", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorIntersection struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorIntersection) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleIntersectionArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "intersection", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleIntersectionResult{}
+  if err2 = p.handler.Intersection(ctx, args.Other, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing intersection: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "intersection", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "intersection", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorSubtract struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorSubtract) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSubtractArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "subtract", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleSubtractResult{}
+  if err2 = p.handler.Subtract(ctx, args.Other, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing subtract: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "subtract", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "subtract", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorSubtractByKey struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorSubtractByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSubtractByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "subtractByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleSubtractByKeyResult{}
+  if err2 = p.handler.SubtractByKey(ctx, args.Other, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing subtractByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "subtractByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "subtractByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorRepartition struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorRepartition) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleRepartitionArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "repartition", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleRepartitionResult{}
+  if err2 = p.handler.Repartition(ctx, args.NumPartitions, args.PreserveOrdering, args.Global_); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing repartition: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "repartition", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "repartition", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorCoalesce struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorCoalesce) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleCoalesceArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "coalesce", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleCoalesceResult{}
+  if err2 = p.handler.Coalesce(ctx, args.NumPartitions, args.Shuffle); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing coalesce: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "coalesce", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "coalesce", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionByRandom struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionByRandom) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionByRandomArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByRandom", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionByRandomResult{}
+  if err2 = p.handler.PartitionByRandom(ctx, args.NumPartitions, args.Seed); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionByRandom: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByRandom", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionByRandom", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionByHash struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionByHash) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionByHashArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByHash", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionByHashResult{}
+  if err2 = p.handler.PartitionByHash(ctx, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionByHash: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByHash", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionByHash", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionBy struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionByArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionBy", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionByResult{}
+  if err2 = p.handler.PartitionBy(ctx, args.Src, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionBy: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionBy", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionBy", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionByKeyHash struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionByKeyHash) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionByKeyHashArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByKeyHash", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionByKeyHashResult{}
+  if err2 = p.handler.PartitionByKeyHash(ctx, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionByKeyHash: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByKeyHash", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionByKeyHash", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionByKeyRange struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionByKeyRange) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionByKeyRangeArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByKeyRange", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionByKeyRangeResult{}
+  if err2 = p.handler.PartitionByKeyRange(ctx, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionByKeyRange: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByKeyRange", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionByKeyRange", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionByKey struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionByKeyResult{}
+  if err2 = p.handler.PartitionByKey(ctx, args.Src, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorReorderPartitions struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorReorderPartitions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleReorderPartitionsArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "reorderPartitions", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleReorderPartitionsResult{}
+  if err2 = p.handler.ReorderPartitions(ctx, args.Order); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reorderPartitions: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "reorderPartitions", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "reorderPartitions", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorReorderPartitionsBy struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorReorderPartitionsBy) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleReorderPartitionsByArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "reorderPartitionsBy", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleReorderPartitionsByResult{}
+  if err2 = p.handler.ReorderPartitionsBy(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reorderPartitionsBy: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "reorderPartitionsBy", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "reorderPartitionsBy", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionOffset struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionOffset) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionOffsetArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionOffset", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionOffsetResult{}
+  var retval int64
+  if retval, err2 = p.handler.PartitionOffset(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionOffset: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionOffset", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  } else {
+    result.Success = &retval
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionOffset", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorFlatMapValues struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorFlatMapValues) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleFlatMapValuesArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "flatMapValues", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleFlatMapValuesResult{}
+  if err2 = p.handler.FlatMapValues(ctx, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing flatMapValues: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "flatMapValues", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "flatMapValues", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorMapValues struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorMapValues) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleMapValuesArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapValues", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleMapValuesResult{}
+  if err2 = p.handler.MapValues(ctx, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mapValues: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mapValues", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "mapValues", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorGroupByKey struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorGroupByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleGroupByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleGroupByKeyResult{}
+  if err2 = p.handler.GroupByKey(ctx, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "groupByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorGroupByKey2 struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorGroupByKey2) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleGroupByKey2Args{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKey2", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleGroupByKey2Result{}
+  if err2 = p.handler.GroupByKey2(ctx, args.NumPartitions, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupByKey2: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKey2", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "groupByKey2", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorReduceByKey struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorReduceByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleReduceByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "reduceByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleReduceByKeyResult{}
+  if err2 = p.handler.ReduceByKey(ctx, args.Src, args.NumPartitions, args.LocalReduce); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing reduceByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "reduceByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "reduceByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorAggregateByKey struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorAggregateByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleAggregateByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "aggregateByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleAggregateByKeyResult{}
+  if err2 = p.handler.AggregateByKey(ctx, args.Zero, args.SeqOp, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "aggregateByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "aggregateByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorAggregateByKey4 struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorAggregateByKey4) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleAggregateByKey4Args{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "aggregateByKey4", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleAggregateByKey4Result{}
+  if err2 = p.handler.AggregateByKey4(ctx, args.Zero, args.SeqOp, args.CombOp, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing aggregateByKey4: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "aggregateByKey4", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "aggregateByKey4", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorFoldByKey struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorFoldByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleFoldByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "foldByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleFoldByKeyResult{}
+  if err2 = p.handler.FoldByKey(ctx, args.Zero, args.Src, args.NumPartitions, args.LocalFold); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing foldByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "foldByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "foldByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPivot struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPivot) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePivotArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "pivot", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePivotResult{}
+  var retval string
+  if retval, err2 = p.handler.Pivot(ctx, args.Key, args.Column, args.Agg, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing pivot: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "pivot", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  } else {
+    result.Success = &retval
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "pivot", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorUnpivot struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorUnpivot) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleUnpivotArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "unpivot", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleUnpivotResult{}
+  if err2 = p.handler.Unpivot(ctx, args.Src, args.Columns); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing unpivot: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "unpivot", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "unpivot", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorScan struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorScan) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleScanArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "scan", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleScanResult{}
+  if err2 = p.handler.Scan(ctx, args.Zero, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing scan: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "scan", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "scan", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorSortByKey struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorSortByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSortByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleSortByKeyResult{}
+  if err2 = p.handler.SortByKey(ctx, args.Ascending); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorSortByKey2a struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorSortByKey2a) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSortByKey2aArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey2a", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleSortByKey2aResult{}
+  if err2 = p.handler.SortByKey2a(ctx, args.Ascending, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey2a: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey2a", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey2a", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorSortByKey2b struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorSortByKey2b) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSortByKey2bArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey2b", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleSortByKey2bResult{}
+  if err2 = p.handler.SortByKey2b(ctx, args.Src, args.Ascending); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey2b: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey2b", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey2b", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorSortByKey3 struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorSortByKey3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleSortByKey3Args{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey3", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleSortByKey3Result{}
+  if err2 = p.handler.SortByKey3(ctx, args.Src, args.Ascending, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey3: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sortByKey3", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey3", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorRepartitionAndSortWithinPartitions struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorRepartitionAndSortWithinPartitions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleRepartitionAndSortWithinPartitionsArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "repartitionAndSortWithinPartitions", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleRepartitionAndSortWithinPartitionsResult{}
+  if err2 = p.handler.RepartitionAndSortWithinPartitions(ctx, args.NumPartitions, args.Ascending); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing repartitionAndSortWithinPartitions: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "repartitionAndSortWithinPartitions", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "repartitionAndSortWithinPartitions", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorGroupByKeyAndSortValues struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorGroupByKeyAndSortValues) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleGroupByKeyAndSortValuesArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKeyAndSortValues", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleGroupByKeyAndSortValuesResult{}
+  if err2 = p.handler.GroupByKeyAndSortValues(ctx, args.NumPartitions, args.Ascending); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupByKeyAndSortValues: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKeyAndSortValues", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "groupByKeyAndSortValues", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorGroupByKeyAndSortValues3 struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorGroupByKeyAndSortValues3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleGroupByKeyAndSortValues3Args{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKeyAndSortValues3", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleGroupByKeyAndSortValues3Result{}
+  if err2 = p.handler.GroupByKeyAndSortValues3(ctx, args.Src, args.NumPartitions, args.Ascending); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing groupByKeyAndSortValues3: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "groupByKeyAndSortValues3", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "groupByKeyAndSortValues3", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorGapsAndIslands struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorGapsAndIslands) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleGapsAndIslandsArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "gapsAndIslands", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleGapsAndIslandsResult{}
+  if err2 = p.handler.GapsAndIslands(ctx, args.Key, args.Seq, args.MaxGap, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing gapsAndIslands: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "gapsAndIslands", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "gapsAndIslands", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorRecomputePartitions struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorRecomputePartitions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModuleRecomputePartitionsArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "recomputePartitions", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModuleRecomputePartitionsResult{}
+  var retval int64
+  if retval, err2 = p.handler.RecomputePartitions(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing recomputePartitions: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "recomputePartitions", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  } else {
+    result.Success = &retval
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "recomputePartitions", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iGeneralModuleProcessorPartitionStats struct {
+  handler IGeneralModule
+}
+
+func (p *iGeneralModuleProcessorPartitionStats) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IGeneralModulePartitionStatsArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionStats", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IGeneralModulePartitionStatsResult{}
+  var retval string
+  if retval, err2 = p.handler.PartitionStats(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionStats: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionStats", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  } else {
+    result.Success = &retval
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionStats", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - Src
+type IGeneralModuleExecuteToArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleExecuteToArgs() *IGeneralModuleExecuteToArgs {
+  return &IGeneralModuleExecuteToArgs{}
+}
+
+var IGeneralModuleExecuteToArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleExecuteToArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleExecuteToArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleExecuteToArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleExecuteToArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExecuteToArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExecuteToArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "executeTo_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleExecuteToArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleExecuteToArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleExecuteToArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleExecuteToResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleExecuteToResult() *IGeneralModuleExecuteToResult {
+  return &IGeneralModuleExecuteToResult{}
+}
+
+var IGeneralModuleExecuteToResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleExecuteToResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleExecuteToResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleExecuteToResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleExecuteToResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExecuteToResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExecuteToResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "executeTo_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleExecuteToResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleExecuteToResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleExecuteToResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleMap_Args struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleMap_Args() *IGeneralModuleMap_Args {
+  return &IGeneralModuleMap_Args{}
+}
+
+var IGeneralModuleMap_Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMap_Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleMap_Args_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleMap_Args) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleMap_Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMap_Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMap_Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "map__args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMap_Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMap_Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMap_Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleMap_Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleMap_Result() *IGeneralModuleMap_Result {
+  return &IGeneralModuleMap_Result{}
+}
+
+var IGeneralModuleMap_Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMap_Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleMap_Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleMap_Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleMap_Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMap_Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMap_Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "map__result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMap_Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleMap_Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMap_Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleFilterArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleFilterArgs() *IGeneralModuleFilterArgs {
+  return &IGeneralModuleFilterArgs{}
+}
+
+var IGeneralModuleFilterArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleFilterArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleFilterArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleFilterArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleFilterArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFilterArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFilterArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "filter_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleFilterArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleFilterArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleFilterArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleFilterResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleFilterResult() *IGeneralModuleFilterResult {
+  return &IGeneralModuleFilterResult{}
+}
+
+var IGeneralModuleFilterResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleFilterResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleFilterResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleFilterResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleFilterResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFilterResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFilterResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "filter_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleFilterResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleFilterResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleFilterResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleFlatmapArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleFlatmapArgs() *IGeneralModuleFlatmapArgs {
+  return &IGeneralModuleFlatmapArgs{}
+}
+
+var IGeneralModuleFlatmapArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleFlatmapArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleFlatmapArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleFlatmapArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleFlatmapArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFlatmapArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFlatmapArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "flatmap_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleFlatmapArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleFlatmapArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleFlatmapArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleFlatmapResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleFlatmapResult() *IGeneralModuleFlatmapResult {
+  return &IGeneralModuleFlatmapResult{}
+}
+
+var IGeneralModuleFlatmapResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleFlatmapResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleFlatmapResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleFlatmapResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleFlatmapResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFlatmapResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFlatmapResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "flatmap_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleFlatmapResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleFlatmapResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleFlatmapResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleKeyByArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleKeyByArgs() *IGeneralModuleKeyByArgs {
+  return &IGeneralModuleKeyByArgs{}
+}
+
+var IGeneralModuleKeyByArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleKeyByArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleKeyByArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleKeyByArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleKeyByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleKeyByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleKeyByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "keyBy_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleKeyByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleKeyByArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleKeyByArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleKeyByResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleKeyByResult() *IGeneralModuleKeyByResult {
+  return &IGeneralModuleKeyByResult{}
+}
+
+var IGeneralModuleKeyByResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleKeyByResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleKeyByResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleKeyByResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleKeyByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleKeyByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleKeyByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "keyBy_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleKeyByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleKeyByResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleKeyByResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleMapWithIndexArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleMapWithIndexArgs() *IGeneralModuleMapWithIndexArgs {
+  return &IGeneralModuleMapWithIndexArgs{}
+}
+
+var IGeneralModuleMapWithIndexArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMapWithIndexArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleMapWithIndexArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleMapWithIndexArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleMapWithIndexArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapWithIndexArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapWithIndexArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapWithIndex_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapWithIndexArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMapWithIndexArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapWithIndexArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleMapWithIndexResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleMapWithIndexResult() *IGeneralModuleMapWithIndexResult {
+  return &IGeneralModuleMapWithIndexResult{}
+}
+
+var IGeneralModuleMapWithIndexResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMapWithIndexResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleMapWithIndexResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleMapWithIndexResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleMapWithIndexResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapWithIndexResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapWithIndexResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapWithIndex_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapWithIndexResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleMapWithIndexResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapWithIndexResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleMapPartitionsArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleMapPartitionsArgs() *IGeneralModuleMapPartitionsArgs {
+  return &IGeneralModuleMapPartitionsArgs{}
+}
+
+var IGeneralModuleMapPartitionsArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMapPartitionsArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleMapPartitionsArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleMapPartitionsArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleMapPartitionsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapPartitions_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMapPartitionsArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapPartitionsArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleMapPartitionsResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleMapPartitionsResult() *IGeneralModuleMapPartitionsResult {
+  return &IGeneralModuleMapPartitionsResult{}
+}
+
+var IGeneralModuleMapPartitionsResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMapPartitionsResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleMapPartitionsResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleMapPartitionsResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleMapPartitionsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapPartitions_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleMapPartitionsResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapPartitionsResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleMapPartitionsWithIndexArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleMapPartitionsWithIndexArgs() *IGeneralModuleMapPartitionsWithIndexArgs {
+  return &IGeneralModuleMapPartitionsWithIndexArgs{}
+}
+
+var IGeneralModuleMapPartitionsWithIndexArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMapPartitionsWithIndexArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleMapPartitionsWithIndexArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleMapPartitionsWithIndexArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapPartitionsWithIndex_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapPartitionsWithIndexArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleMapPartitionsWithIndexResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleMapPartitionsWithIndexResult() *IGeneralModuleMapPartitionsWithIndexResult {
+  return &IGeneralModuleMapPartitionsWithIndexResult{}
+}
+
+var IGeneralModuleMapPartitionsWithIndexResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMapPartitionsWithIndexResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleMapPartitionsWithIndexResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleMapPartitionsWithIndexResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapPartitionsWithIndex_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleMapPartitionsWithIndexResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapPartitionsWithIndexResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+//  - K
+type IGeneralModuleMapPartitionsWithBoundaryArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  K int64 `thrift:"k,2" db:"k" json:"k"`
+}
+
+func NewIGeneralModuleMapPartitionsWithBoundaryArgs() *IGeneralModuleMapPartitionsWithBoundaryArgs {
+  return &IGeneralModuleMapPartitionsWithBoundaryArgs{}
+}
+
+var IGeneralModuleMapPartitionsWithBoundaryArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleMapPartitionsWithBoundaryArgs_Src_DEFAULT
+  }
+return p.Src
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) GetK() int64 {
+  return p.K
+}
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.K = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapPartitionsWithBoundary_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "k", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:k: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.K)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.k (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:k: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapPartitionsWithBoundaryArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleMapPartitionsWithBoundaryResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleMapPartitionsWithBoundaryResult() *IGeneralModuleMapPartitionsWithBoundaryResult {
+  return &IGeneralModuleMapPartitionsWithBoundaryResult{}
+}
+
+var IGeneralModuleMapPartitionsWithBoundaryResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMapPartitionsWithBoundaryResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleMapPartitionsWithBoundaryResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleMapPartitionsWithBoundaryResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapPartitionsWithBoundary_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleMapPartitionsWithBoundaryResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapPartitionsWithBoundaryResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleMapExecutorArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleMapExecutorArgs() *IGeneralModuleMapExecutorArgs {
+  return &IGeneralModuleMapExecutorArgs{}
+}
+
+var IGeneralModuleMapExecutorArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMapExecutorArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleMapExecutorArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleMapExecutorArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleMapExecutorArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapExecutor_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMapExecutorArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapExecutorArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleMapExecutorResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleMapExecutorResult() *IGeneralModuleMapExecutorResult {
+  return &IGeneralModuleMapExecutorResult{}
+}
+
+var IGeneralModuleMapExecutorResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMapExecutorResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleMapExecutorResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleMapExecutorResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleMapExecutorResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapExecutor_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleMapExecutorResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapExecutorResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+type IGeneralModuleMapExecutorToArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleMapExecutorToArgs() *IGeneralModuleMapExecutorToArgs {
+  return &IGeneralModuleMapExecutorToArgs{}
+}
+
+var IGeneralModuleMapExecutorToArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMapExecutorToArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleMapExecutorToArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleMapExecutorToArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleMapExecutorToArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorToArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorToArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapExecutorTo_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorToArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleMapExecutorToArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapExecutorToArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleMapExecutorToResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleMapExecutorToResult() *IGeneralModuleMapExecutorToResult {
+  return &IGeneralModuleMapExecutorToResult{}
+}
+
+var IGeneralModuleMapExecutorToResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMapExecutorToResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleMapExecutorToResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleMapExecutorToResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleMapExecutorToResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorToResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorToResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapExecutorTo_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleMapExecutorToResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleMapExecutorToResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleMapExecutorToResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Command
+//  - Env
+//  - Encoding
+type IGeneralModulePipeCmdArgs struct {
+  Command []string `thrift:"command,1" db:"command" json:"command"`
+  Env []string `thrift:"env,2" db:"env" json:"env"`
+  Encoding string `thrift:"encoding,3" db:"encoding" json:"encoding"`
+}
+
+func NewIGeneralModulePipeCmdArgs() *IGeneralModulePipeCmdArgs {
+  return &IGeneralModulePipeCmdArgs{}
+}
+
+
+func (p *IGeneralModulePipeCmdArgs) GetCommand() []string {
+  return p.Command
+}
+
+func (p *IGeneralModulePipeCmdArgs) GetEnv() []string {
+  return p.Env
+}
+
+func (p *IGeneralModulePipeCmdArgs) GetEncoding() string {
+  return p.Encoding
+}
+func (p *IGeneralModulePipeCmdArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Command =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem209 string
+    if v, err := iprot.ReadString(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem209 = v
+}
+    p.Command = append(p.Command, _elem209)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Env =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem210 string
+    if v, err := iprot.ReadString(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem210 = v
+}
+    p.Env = append(p.Env, _elem210)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Encoding = v
+}
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "pipeCmd_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "command", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:command: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Command)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Command {
+    if err := oprot.WriteString(ctx, string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:command: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePipeCmdArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "env", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:env: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Env)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Env {
+    if err := oprot.WriteString(ctx, string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:env: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePipeCmdArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "encoding", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:encoding: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Encoding)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.encoding (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:encoding: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePipeCmdArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModulePipeCmdArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModulePipeCmdResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModulePipeCmdResult() *IGeneralModulePipeCmdResult {
+  return &IGeneralModulePipeCmdResult{}
+}
+
+var IGeneralModulePipeCmdResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePipeCmdResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModulePipeCmdResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModulePipeCmdResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModulePipeCmdResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "pipeCmd_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModulePipeCmdResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModulePipeCmdResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModulePipeCmdResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Paths
+type IGeneralModuleSelectArgs struct {
+  Paths []string `thrift:"paths,1" db:"paths" json:"paths"`
+}
+
+func NewIGeneralModuleSelectArgs() *IGeneralModuleSelectArgs {
+  return &IGeneralModuleSelectArgs{}
+}
+
+
+func (p *IGeneralModuleSelectArgs) GetPaths() []string {
+  return p.Paths
+}
+func (p *IGeneralModuleSelectArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Paths =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem211 string
+    if v, err := iprot.ReadString(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem211 = v
+}
+    p.Paths = append(p.Paths, _elem211)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "select_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSelectArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "paths", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:paths: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Paths)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Paths {
+    if err := oprot.WriteString(ctx, string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:paths: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSelectArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSelectArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleSelectResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleSelectResult() *IGeneralModuleSelectResult {
+  return &IGeneralModuleSelectResult{}
+}
+
+var IGeneralModuleSelectResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSelectResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleSelectResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleSelectResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleSelectResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "select_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSelectResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleSelectResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSelectResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+//  - Paths
+type IGeneralModuleSelectToArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Paths []string `thrift:"paths,2" db:"paths" json:"paths"`
+}
+
+func NewIGeneralModuleSelectToArgs() *IGeneralModuleSelectToArgs {
+  return &IGeneralModuleSelectToArgs{}
+}
+
+var IGeneralModuleSelectToArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleSelectToArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleSelectToArgs_Src_DEFAULT
+  }
+return p.Src
+}
+
+func (p *IGeneralModuleSelectToArgs) GetPaths() []string {
+  return p.Paths
+}
+func (p *IGeneralModuleSelectToArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleSelectToArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectToArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectToArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Paths =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem212 string
+    if v, err := iprot.ReadString(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem212 = v
+}
+    p.Paths = append(p.Paths, _elem212)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectToArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "selectTo_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSelectToArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSelectToArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "paths", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:paths: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Paths)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Paths {
+    if err := oprot.WriteString(ctx, string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:paths: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSelectToArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSelectToArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleSelectToResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleSelectToResult() *IGeneralModuleSelectToResult {
+  return &IGeneralModuleSelectToResult{}
+}
+
+var IGeneralModuleSelectToResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSelectToResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleSelectToResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleSelectToResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleSelectToResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectToResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSelectToResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "selectTo_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSelectToResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleSelectToResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSelectToResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+//  - Path
+type IGeneralModuleExplodeArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Path string `thrift:"path,2" db:"path" json:"path"`
+}
+
+func NewIGeneralModuleExplodeArgs() *IGeneralModuleExplodeArgs {
+  return &IGeneralModuleExplodeArgs{}
+}
+
+var IGeneralModuleExplodeArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleExplodeArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleExplodeArgs_Src_DEFAULT
+  }
+return p.Src
+}
+
+func (p *IGeneralModuleExplodeArgs) GetPath() string {
+  return p.Path
+}
+func (p *IGeneralModuleExplodeArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleExplodeArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleExplodeArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "explode_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleExplodeArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:path: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleExplodeArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleExplodeArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleExplodeResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleExplodeResult() *IGeneralModuleExplodeResult {
+  return &IGeneralModuleExplodeResult{}
+}
+
+var IGeneralModuleExplodeResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleExplodeResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleExplodeResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleExplodeResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleExplodeResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "explode_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleExplodeResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleExplodeResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+//  - Path
+//  - Paths
+type IGeneralModuleExplodeSelectArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Path string `thrift:"path,2" db:"path" json:"path"`
+  Paths []string `thrift:"paths,3" db:"paths" json:"paths"`
+}
+
+func NewIGeneralModuleExplodeSelectArgs() *IGeneralModuleExplodeSelectArgs {
+  return &IGeneralModuleExplodeSelectArgs{}
+}
+
+var IGeneralModuleExplodeSelectArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleExplodeSelectArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleExplodeSelectArgs_Src_DEFAULT
+  }
+return p.Src
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) GetPath() string {
+  return p.Path
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) GetPaths() []string {
+  return p.Paths
+}
+func (p *IGeneralModuleExplodeSelectArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Paths =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem213 string
+    if v, err := iprot.ReadString(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem213 = v
+}
+    p.Paths = append(p.Paths, _elem213)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "explodeSelect_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:path: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "paths", thrift.LIST, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:paths: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Paths)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Paths {
+    if err := oprot.WriteString(ctx, string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:paths: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleExplodeSelectArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleExplodeSelectArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleExplodeSelectResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleExplodeSelectResult() *IGeneralModuleExplodeSelectResult {
+  return &IGeneralModuleExplodeSelectResult{}
+}
+
+var IGeneralModuleExplodeSelectResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleExplodeSelectResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleExplodeSelectResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleExplodeSelectResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleExplodeSelectResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "explodeSelect_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleExplodeSelectResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleExplodeSelectResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleExplodeSelectResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+//  - NumPartitions
+type IGeneralModuleGroupByArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+}
+
+func NewIGeneralModuleGroupByArgs() *IGeneralModuleGroupByArgs {
+  return &IGeneralModuleGroupByArgs{}
+}
+
+var IGeneralModuleGroupByArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleGroupByArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleGroupByArgs_Src_DEFAULT
+  }
+return p.Src
+}
+
+func (p *IGeneralModuleGroupByArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleGroupByArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleGroupByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleGroupByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleGroupByArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleGroupByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupBy_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleGroupByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleGroupByArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleGroupByArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleGroupByArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleGroupByResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleGroupByResult() *IGeneralModuleGroupByResult {
+  return &IGeneralModuleGroupByResult{}
+}
+
+var IGeneralModuleGroupByResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleGroupByResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleGroupByResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleGroupByResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleGroupByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleGroupByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleGroupByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupBy_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleGroupByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleGroupByResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleGroupByResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Ascending
+type IGeneralModuleSortArgs struct {
+  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
+}
+
+func NewIGeneralModuleSortArgs() *IGeneralModuleSortArgs {
+  return &IGeneralModuleSortArgs{}
+}
+
+
+func (p *IGeneralModuleSortArgs) GetAscending() bool {
+  return p.Ascending
+}
+func (p *IGeneralModuleSortArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Ascending = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSortArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sort_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSortArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSortArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSortArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleSortResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleSortResult() *IGeneralModuleSortResult {
+  return &IGeneralModuleSortResult{}
+}
+
+var IGeneralModuleSortResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSortResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleSortResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleSortResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleSortResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sort_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSortResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleSortResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSortResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Ascending
+//  - NumPartitions
+type IGeneralModuleSort2Args struct {
+  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+}
+
+func NewIGeneralModuleSort2Args() *IGeneralModuleSort2Args {
+  return &IGeneralModuleSort2Args{}
+}
+
+
+func (p *IGeneralModuleSort2Args) GetAscending() bool {
+  return p.Ascending
+}
+
+func (p *IGeneralModuleSort2Args) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleSort2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSort2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Ascending = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSort2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSort2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sort2_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSort2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSort2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSort2Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSort2Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleSort2Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleSort2Result() *IGeneralModuleSort2Result {
+  return &IGeneralModuleSort2Result{}
+}
+
+var IGeneralModuleSort2Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSort2Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleSort2Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleSort2Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleSort2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSort2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSort2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sort2_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSort2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleSort2Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSort2Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+//  - Ascending
+type IGeneralModuleSortByArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
+}
+
+func NewIGeneralModuleSortByArgs() *IGeneralModuleSortByArgs {
+  return &IGeneralModuleSortByArgs{}
+}
+
+var IGeneralModuleSortByArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleSortByArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleSortByArgs_Src_DEFAULT
+  }
+return p.Src
+}
+
+func (p *IGeneralModuleSortByArgs) GetAscending() bool {
+  return p.Ascending
+}
+func (p *IGeneralModuleSortByArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleSortByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortByArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Ascending = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSortByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortBy_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSortByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSortByArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSortByArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSortByArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleSortByResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleSortByResult() *IGeneralModuleSortByResult {
+  return &IGeneralModuleSortByResult{}
+}
+
+var IGeneralModuleSortByResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSortByResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleSortByResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleSortByResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleSortByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortBy_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSortByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleSortByResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSortByResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Src
+//  - Ascending
+//  - NumPartitions
+type IGeneralModuleSortBy3Args struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
+  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
+}
+
+func NewIGeneralModuleSortBy3Args() *IGeneralModuleSortBy3Args {
+  return &IGeneralModuleSortBy3Args{}
+}
+
+var IGeneralModuleSortBy3Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleSortBy3Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleSortBy3Args_Src_DEFAULT
+  }
+return p.Src
+}
+
+func (p *IGeneralModuleSortBy3Args) GetAscending() bool {
+  return p.Ascending
+}
+
+func (p *IGeneralModuleSortBy3Args) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleSortBy3Args) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleSortBy3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Ascending = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortBy3_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSortBy3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSortBy3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSortBy3Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSortBy3Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleSortBy3Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleSortBy3Result() *IGeneralModuleSortBy3Result {
+  return &IGeneralModuleSortBy3Result{}
+}
+
+var IGeneralModuleSortBy3Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSortBy3Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleSortBy3Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleSortBy3Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleSortBy3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortBy3_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleSortBy3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleSortBy3Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleSortBy3Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Other
+//  - PreserveOrder
+type IGeneralModuleUnion_Args struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  PreserveOrder bool `thrift:"preserveOrder,2" db:"preserveOrder" json:"preserveOrder"`
+}
+
+func NewIGeneralModuleUnion_Args() *IGeneralModuleUnion_Args {
+  return &IGeneralModuleUnion_Args{}
+}
+
+
+func (p *IGeneralModuleUnion_Args) GetOther() string {
+  return p.Other
+}
+
+func (p *IGeneralModuleUnion_Args) GetPreserveOrder() bool {
+  return p.PreserveOrder
+}
+func (p *IGeneralModuleUnion_Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnion_Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleUnion_Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.PreserveOrder = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleUnion_Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "union__args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleUnion_Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnion_Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "preserveOrder", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:preserveOrder: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.PreserveOrder)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.preserveOrder (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:preserveOrder: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnion_Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleUnion_Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleUnion_Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleUnion_Result() *IGeneralModuleUnion_Result {
+  return &IGeneralModuleUnion_Result{}
+}
+
+var IGeneralModuleUnion_Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleUnion_Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleUnion_Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleUnion_Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleUnion_Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnion_Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnion_Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "union__result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleUnion_Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleUnion_Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleUnion_Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Other
+//  - PreserveOrder
+//  - Src
+type IGeneralModuleUnion2Args struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  PreserveOrder bool `thrift:"preserveOrder,2" db:"preserveOrder" json:"preserveOrder"`
+  Src *rpc.ISource `thrift:"src,3" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleUnion2Args() *IGeneralModuleUnion2Args {
+  return &IGeneralModuleUnion2Args{}
+}
+
+
+func (p *IGeneralModuleUnion2Args) GetOther() string {
+  return p.Other
+}
+
+func (p *IGeneralModuleUnion2Args) GetPreserveOrder() bool {
+  return p.PreserveOrder
+}
+var IGeneralModuleUnion2Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleUnion2Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleUnion2Args_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleUnion2Args) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleUnion2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.PreserveOrder = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "union2_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnion2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "preserveOrder", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:preserveOrder: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.PreserveOrder)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.preserveOrder (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:preserveOrder: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnion2Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnion2Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleUnion2Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleUnion2Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleUnion2Result() *IGeneralModuleUnion2Result {
+  return &IGeneralModuleUnion2Result{}
+}
+
+var IGeneralModuleUnion2Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleUnion2Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleUnion2Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleUnion2Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleUnion2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "union2_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleUnion2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleUnion2Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleUnion2Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Others
+//  - Rebalance
+type IGeneralModuleUnionAllArgs struct {
+  Others []string `thrift:"others,1" db:"others" json:"others"`
+  Rebalance bool `thrift:"rebalance,2" db:"rebalance" json:"rebalance"`
+}
+
+func NewIGeneralModuleUnionAllArgs() *IGeneralModuleUnionAllArgs {
+  return &IGeneralModuleUnionAllArgs{}
+}
+
+
+func (p *IGeneralModuleUnionAllArgs) GetOthers() []string {
+  return p.Others
+}
+
+func (p *IGeneralModuleUnionAllArgs) GetRebalance() bool {
+  return p.Rebalance
+}
+func (p *IGeneralModuleUnionAllArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnionAllArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Others =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem214 string
+    if v, err := iprot.ReadString(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem214 = v
+}
+    p.Others = append(p.Others, _elem214)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnionAllArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Rebalance = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleUnionAllArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "unionAll_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleUnionAllArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "others", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:others: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.STRING, len(p.Others)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Others {
+    if err := oprot.WriteString(ctx, string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:others: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnionAllArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "rebalance", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:rebalance: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Rebalance)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.rebalance (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:rebalance: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnionAllArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleUnionAllArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleUnionAllResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleUnionAllResult() *IGeneralModuleUnionAllResult {
+  return &IGeneralModuleUnionAllResult{}
+}
+
+var IGeneralModuleUnionAllResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleUnionAllResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleUnionAllResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleUnionAllResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleUnionAllResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnionAllResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleUnionAllResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "unionAll_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleUnionAllResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleUnionAllResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleUnionAllResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Other
+//  - Src
+type IGeneralModuleZipArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleZipArgs() *IGeneralModuleZipArgs {
+  return &IGeneralModuleZipArgs{}
+}
+
+
+func (p *IGeneralModuleZipArgs) GetOther() string {
+  return p.Other
+}
+var IGeneralModuleZipArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleZipArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleZipArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleZipArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleZipArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleZipArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleZipArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleZipArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "zip_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleZipArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleZipArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleZipArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleZipArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleZipResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleZipResult() *IGeneralModuleZipResult {
+  return &IGeneralModuleZipResult{}
+}
+
+var IGeneralModuleZipResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleZipResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleZipResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleZipResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleZipResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleZipResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleZipResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "zip_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleZipResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleZipResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleZipResult(%+v)", *p)
+}
+
+type IGeneralModuleZipWithIndexArgs struct {
+}
+
+func NewIGeneralModuleZipWithIndexArgs() *IGeneralModuleZipWithIndexArgs {
+  return &IGeneralModuleZipWithIndexArgs{}
+}
+
+func (p *IGeneralModuleZipWithIndexArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleZipWithIndexArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "zipWithIndex_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleZipWithIndexArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleZipWithIndexArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleZipWithIndexResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleZipWithIndexResult() *IGeneralModuleZipWithIndexResult {
+  return &IGeneralModuleZipWithIndexResult{}
+}
+
+var IGeneralModuleZipWithIndexResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleZipWithIndexResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleZipWithIndexResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleZipWithIndexResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleZipWithIndexResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleZipWithIndexResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleZipWithIndexResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "zipWithIndex_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleZipWithIndexResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleZipWithIndexResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleZipWithIndexResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Other
+//  - NumPartitions
+type IGeneralModuleJoinArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+}
+
+func NewIGeneralModuleJoinArgs() *IGeneralModuleJoinArgs {
+  return &IGeneralModuleJoinArgs{}
+}
+
+
+func (p *IGeneralModuleJoinArgs) GetOther() string {
+  return p.Other
+}
+
+func (p *IGeneralModuleJoinArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleJoinArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleJoinArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleJoinArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleJoinArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "join_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleJoinArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleJoinArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleJoinArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleJoinArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleJoinResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleJoinResult() *IGeneralModuleJoinResult {
+  return &IGeneralModuleJoinResult{}
+}
+
+var IGeneralModuleJoinResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleJoinResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleJoinResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleJoinResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleJoinResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleJoinResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleJoinResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "join_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleJoinResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleJoinResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleJoinResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Other
+//  - NumPartitions
+//  - Src
+type IGeneralModuleJoin3Args struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+  Src *rpc.ISource `thrift:"src,3" db:"src" json:"src"`
+}
+
+func NewIGeneralModuleJoin3Args() *IGeneralModuleJoin3Args {
+  return &IGeneralModuleJoin3Args{}
+}
+
+
+func (p *IGeneralModuleJoin3Args) GetOther() string {
+  return p.Other
+}
+
+func (p *IGeneralModuleJoin3Args) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+var IGeneralModuleJoin3Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleJoin3Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleJoin3Args_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleJoin3Args) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleJoin3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "join3_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleJoin3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleJoin3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleJoin3Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleJoin3Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleJoin3Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleJoin3Result() *IGeneralModuleJoin3Result {
+  return &IGeneralModuleJoin3Result{}
+}
+
+var IGeneralModuleJoin3Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleJoin3Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleJoin3Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleJoin3Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleJoin3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "join3_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleJoin3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleJoin3Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleJoin3Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Other
+//  - NumPartitions
+type IGeneralModuleLeftOuterJoinArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+}
+
+func NewIGeneralModuleLeftOuterJoinArgs() *IGeneralModuleLeftOuterJoinArgs {
+  return &IGeneralModuleLeftOuterJoinArgs{}
+}
+
+
+func (p *IGeneralModuleLeftOuterJoinArgs) GetOther() string {
+  return p.Other
+}
+
+func (p *IGeneralModuleLeftOuterJoinArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleLeftOuterJoinArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "leftOuterJoin_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleLeftOuterJoinArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleLeftOuterJoinArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleLeftOuterJoinArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleLeftOuterJoinResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleLeftOuterJoinResult() *IGeneralModuleLeftOuterJoinResult {
+  return &IGeneralModuleLeftOuterJoinResult{}
+}
+
+var IGeneralModuleLeftOuterJoinResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleLeftOuterJoinResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleLeftOuterJoinResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleLeftOuterJoinResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "leftOuterJoin_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleLeftOuterJoinResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModuleLeftOuterJoinResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleLeftOuterJoinResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Other
+//  - NumPartitions
+type IGeneralModuleRightOuterJoinArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+}
+
+func NewIGeneralModuleRightOuterJoinArgs() *IGeneralModuleRightOuterJoinArgs {
+  return &IGeneralModuleRightOuterJoinArgs{}
+}
+
+
+func (p *IGeneralModuleRightOuterJoinArgs) GetOther() string {
+  return p.Other
+}
+
+func (p *IGeneralModuleRightOuterJoinArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleRightOuterJoinArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleRightOuterJoinArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleRightOuterJoinArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleRightOuterJoinArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "rightOuterJoin_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleRightOuterJoinArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleRightOuterJoinArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleRightOuterJoinArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleRightOuterJoinArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleRightOuterJoinResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleRightOuterJoinResult() *IGeneralModuleRightOuterJoinResult {
+  return &IGeneralModuleRightOuterJoinResult{}
+}
+
+var IGeneralModuleRightOuterJoinResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleRightOuterJoinResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleRightOuterJoinResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleRightOuterJoinResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleRightOuterJoinResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
 
-  tickerCancel := func() {}
-  // Start a goroutine to do server side connectivity check.
-  if thrift.ServerConnectivityCheckInterval > 0 {
-    var cancel context.CancelFunc
-    ctx, cancel = context.WithCancel(ctx)
-    defer cancel()
-    var tickerCtx context.Context
-    tickerCtx, tickerCancel = context.WithCancel(context.Background())
-    defer tickerCancel()
-    go func(ctx context.Context, cancel context.CancelFunc) {
-      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
-      defer ticker.Stop()
-      for {
-        select {
-        case <-ctx.Done():
-          return
-        case <-ticker.C:
-          if !iprot.Transport().IsOpen() {
-            cancel()
-            return
-          }
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
         }
       }
-    }(tickerCtx, cancel)
-  }
-
-  result := IGeneralModuleSortByKey3Result{}
-  if err2 = p.handler.SortByKey3(ctx, args.Src, args.Ascending, args.NumPartitions); err2 != nil {
-    tickerCancel()
-  switch v := err2.(type) {
-    case *rpc.IExecutorException:
-  result.Ex = v
     default:
-    if err2 == thrift.ErrAbandonRequest {
-      return false, thrift.WrapTException(err2)
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sortByKey3: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "sortByKey3", thrift.EXCEPTION, seqId)
-    x.Write(ctx, oprot)
-    oprot.WriteMessageEnd(ctx)
-    oprot.Flush(ctx)
-    return true, thrift.WrapTException(err2)
-  }
-  }
-  tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "sortByKey3", thrift.REPLY, seqId); err2 != nil {
-    err = thrift.WrapTException(err2)
-  }
-  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
   }
-  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  return nil
+}
+
+func (p *IGeneralModuleRightOuterJoinResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
   }
-  if err != nil {
-    return
+  return nil
+}
+
+func (p *IGeneralModuleRightOuterJoinResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "rightOuterJoin_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
-  return true, err
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
+func (p *IGeneralModuleRightOuterJoinResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *IGeneralModuleRightOuterJoinResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleRightOuterJoinResult(%+v)", *p)
+}
 
 // Attributes:
-//  - Src
-type IGeneralModuleExecuteToArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - Other
+//  - NumPartitions
+type IGeneralModuleFullOuterJoinArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleExecuteToArgs() *IGeneralModuleExecuteToArgs {
-  return &IGeneralModuleExecuteToArgs{}
+func NewIGeneralModuleFullOuterJoinArgs() *IGeneralModuleFullOuterJoinArgs {
+  return &IGeneralModuleFullOuterJoinArgs{}
 }
 
-var IGeneralModuleExecuteToArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleExecuteToArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleExecuteToArgs_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleExecuteToArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+func (p *IGeneralModuleFullOuterJoinArgs) GetOther() string {
+  return p.Other
 }
 
-func (p *IGeneralModuleExecuteToArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFullOuterJoinArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleFullOuterJoinArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4115,7 +14359,7 @@ func (p *IGeneralModuleExecuteToArgs) Read(ctx context.Context, iprot thrift.TPr
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -4124,6 +14368,16 @@ func (p *IGeneralModuleExecuteToArgs) Read(ctx context.Context, iprot thrift.TPr
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -4139,22 +14393,30 @@ func (p *IGeneralModuleExecuteToArgs) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleExecuteToArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleFullOuterJoinArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleExecuteToArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "executeTo_args"); err != nil {
+func (p *IGeneralModuleFullOuterJoinArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleFullOuterJoinArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "fullOuterJoin_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4163,46 +14425,55 @@ func (p *IGeneralModuleExecuteToArgs) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleExecuteToArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleFullOuterJoinArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleExecuteToArgs) String() string {
+func (p *IGeneralModuleFullOuterJoinArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleFullOuterJoinArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleExecuteToArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleFullOuterJoinArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleExecuteToResult struct {
+type IGeneralModuleFullOuterJoinResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleExecuteToResult() *IGeneralModuleExecuteToResult {
-  return &IGeneralModuleExecuteToResult{}
+func NewIGeneralModuleFullOuterJoinResult() *IGeneralModuleFullOuterJoinResult {
+  return &IGeneralModuleFullOuterJoinResult{}
 }
 
-var IGeneralModuleExecuteToResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleExecuteToResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleFullOuterJoinResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleFullOuterJoinResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleExecuteToResult_Ex_DEFAULT
+    return IGeneralModuleFullOuterJoinResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleExecuteToResult) IsSetEx() bool {
+func (p *IGeneralModuleFullOuterJoinResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleExecuteToResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFullOuterJoinResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4240,7 +14511,7 @@ func (p *IGeneralModuleExecuteToResult) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleExecuteToResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFullOuterJoinResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4248,8 +14519,8 @@ func (p *IGeneralModuleExecuteToResult)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModuleExecuteToResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "executeTo_result"); err != nil {
+func (p *IGeneralModuleFullOuterJoinResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "fullOuterJoin_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4261,7 +14532,7 @@ func (p *IGeneralModuleExecuteToResult) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleExecuteToResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleFullOuterJoinResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4274,35 +14545,34 @@ func (p *IGeneralModuleExecuteToResult) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IGeneralModuleExecuteToResult) String() string {
+func (p *IGeneralModuleFullOuterJoinResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleExecuteToResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleFullOuterJoinResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleMap_Args struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - Other
+//  - NumPartitions
+type IGeneralModuleCoGroupArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleMap_Args() *IGeneralModuleMap_Args {
-  return &IGeneralModuleMap_Args{}
+func NewIGeneralModuleCoGroupArgs() *IGeneralModuleCoGroupArgs {
+  return &IGeneralModuleCoGroupArgs{}
 }
 
-var IGeneralModuleMap_Args_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleMap_Args) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleMap_Args_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleMap_Args) IsSetSrc() bool {
-  return p.Src != nil
+
+func (p *IGeneralModuleCoGroupArgs) GetOther() string {
+  return p.Other
 }
 
-func (p *IGeneralModuleMap_Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleCoGroupArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleCoGroupArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4316,7 +14586,7 @@ func (p *IGeneralModuleMap_Args) Read(ctx context.Context, iprot thrift.TProtoco
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -4325,6 +14595,16 @@ func (p *IGeneralModuleMap_Args) Read(ctx context.Context, iprot thrift.TProtoco
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -4340,22 +14620,30 @@ func (p *IGeneralModuleMap_Args) Read(ctx context.Context, iprot thrift.TProtoco
   return nil
 }
 
-func (p *IGeneralModuleMap_Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleCoGroupArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleCoGroupArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleMap_Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "map__args"); err != nil {
+func (p *IGeneralModuleCoGroupArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "coGroup_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4364,46 +14652,55 @@ func (p *IGeneralModuleMap_Args) Write(ctx context.Context, oprot thrift.TProtoc
   return nil
 }
 
-func (p *IGeneralModuleMap_Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleCoGroupArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleMap_Args) String() string {
+func (p *IGeneralModuleCoGroupArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleCoGroupArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMap_Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleCoGroupArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleMap_Result struct {
+type IGeneralModuleCoGroupResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleMap_Result() *IGeneralModuleMap_Result {
-  return &IGeneralModuleMap_Result{}
+func NewIGeneralModuleCoGroupResult() *IGeneralModuleCoGroupResult {
+  return &IGeneralModuleCoGroupResult{}
 }
 
-var IGeneralModuleMap_Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleMap_Result) GetEx() *rpc.IExecutorException {
+var IGeneralModuleCoGroupResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleCoGroupResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleMap_Result_Ex_DEFAULT
+    return IGeneralModuleCoGroupResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleMap_Result) IsSetEx() bool {
+func (p *IGeneralModuleCoGroupResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleMap_Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleCoGroupResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4441,7 +14738,7 @@ func (p *IGeneralModuleMap_Result) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleMap_Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleCoGroupResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4449,8 +14746,8 @@ func (p *IGeneralModuleMap_Result)  ReadField1(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleMap_Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "map__result"); err != nil {
+func (p *IGeneralModuleCoGroupResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "coGroup_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4462,7 +14759,7 @@ func (p *IGeneralModuleMap_Result) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleMap_Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleCoGroupResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4475,35 +14772,28 @@ func (p *IGeneralModuleMap_Result) writeField1(ctx context.Context, oprot thrift
   return err
 }
 
-func (p *IGeneralModuleMap_Result) String() string {
+func (p *IGeneralModuleCoGroupResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMap_Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleCoGroupResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleFilterArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - NumPartitions
+type IGeneralModuleDistinctArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleFilterArgs() *IGeneralModuleFilterArgs {
-  return &IGeneralModuleFilterArgs{}
+func NewIGeneralModuleDistinctArgs() *IGeneralModuleDistinctArgs {
+  return &IGeneralModuleDistinctArgs{}
 }
 
-var IGeneralModuleFilterArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleFilterArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleFilterArgs_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleFilterArgs) IsSetSrc() bool {
-  return p.Src != nil
-}
 
-func (p *IGeneralModuleFilterArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleDistinctArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleDistinctArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4517,7 +14807,7 @@ func (p *IGeneralModuleFilterArgs) Read(ctx context.Context, iprot thrift.TProto
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -4541,19 +14831,17 @@ func (p *IGeneralModuleFilterArgs) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleFilterArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleDistinctArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.NumPartitions = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleFilterArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "filter_args"); err != nil {
+func (p *IGeneralModuleDistinctArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "distinct_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4565,46 +14853,45 @@ func (p *IGeneralModuleFilterArgs) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleFilterArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleDistinctArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleFilterArgs) String() string {
+func (p *IGeneralModuleDistinctArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFilterArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleDistinctArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleFilterResult struct {
+type IGeneralModuleDistinctResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleFilterResult() *IGeneralModuleFilterResult {
-  return &IGeneralModuleFilterResult{}
+func NewIGeneralModuleDistinctResult() *IGeneralModuleDistinctResult {
+  return &IGeneralModuleDistinctResult{}
 }
 
-var IGeneralModuleFilterResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleFilterResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleDistinctResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleDistinctResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleFilterResult_Ex_DEFAULT
+    return IGeneralModuleDistinctResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleFilterResult) IsSetEx() bool {
+func (p *IGeneralModuleDistinctResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleFilterResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleDistinctResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4642,7 +14929,7 @@ func (p *IGeneralModuleFilterResult) Read(ctx context.Context, iprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleFilterResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleDistinctResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4650,8 +14937,8 @@ func (p *IGeneralModuleFilterResult)  ReadField1(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleFilterResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "filter_result"); err != nil {
+func (p *IGeneralModuleDistinctResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "distinct_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4663,7 +14950,7 @@ func (p *IGeneralModuleFilterResult) Write(ctx context.Context, oprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleFilterResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleDistinctResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4676,35 +14963,41 @@ func (p *IGeneralModuleFilterResult) writeField1(ctx context.Context, oprot thri
   return err
 }
 
-func (p *IGeneralModuleFilterResult) String() string {
+func (p *IGeneralModuleDistinctResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFilterResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleDistinctResult(%+v)", *p)
 }
 
 // Attributes:
+//  - NumPartitions
 //  - Src
-type IGeneralModuleFlatmapArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+type IGeneralModuleDistinct2Args struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+  Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
 }
 
-func NewIGeneralModuleFlatmapArgs() *IGeneralModuleFlatmapArgs {
-  return &IGeneralModuleFlatmapArgs{}
+func NewIGeneralModuleDistinct2Args() *IGeneralModuleDistinct2Args {
+  return &IGeneralModuleDistinct2Args{}
 }
 
-var IGeneralModuleFlatmapArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleFlatmapArgs) GetSrc() *rpc.ISource {
+
+func (p *IGeneralModuleDistinct2Args) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+var IGeneralModuleDistinct2Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleDistinct2Args) GetSrc() *rpc.ISource {
   if !p.IsSetSrc() {
-    return IGeneralModuleFlatmapArgs_Src_DEFAULT
+    return IGeneralModuleDistinct2Args_Src_DEFAULT
   }
 return p.Src
 }
-func (p *IGeneralModuleFlatmapArgs) IsSetSrc() bool {
+func (p *IGeneralModuleDistinct2Args) IsSetSrc() bool {
   return p.Src != nil
 }
 
-func (p *IGeneralModuleFlatmapArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleDistinct2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4718,7 +15011,7 @@ func (p *IGeneralModuleFlatmapArgs) Read(ctx context.Context, iprot thrift.TProt
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -4727,6 +15020,16 @@ func (p *IGeneralModuleFlatmapArgs) Read(ctx context.Context, iprot thrift.TProt
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -4742,7 +15045,16 @@ func (p *IGeneralModuleFlatmapArgs) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleFlatmapArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleDistinct2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleDistinct2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
@@ -4753,11 +15065,12 @@ func (p *IGeneralModuleFlatmapArgs)  ReadField1(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IGeneralModuleFlatmapArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "flatmap_args"); err != nil {
+func (p *IGeneralModuleDistinct2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "distinct2_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4766,46 +15079,56 @@ func (p *IGeneralModuleFlatmapArgs) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleFlatmapArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+func (p *IGeneralModuleDistinct2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleDistinct2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
   if err := p.Src.Write(ctx, oprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleFlatmapArgs) String() string {
+func (p *IGeneralModuleDistinct2Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFlatmapArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleDistinct2Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleFlatmapResult struct {
+type IGeneralModuleDistinct2Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleFlatmapResult() *IGeneralModuleFlatmapResult {
-  return &IGeneralModuleFlatmapResult{}
+func NewIGeneralModuleDistinct2Result() *IGeneralModuleDistinct2Result {
+  return &IGeneralModuleDistinct2Result{}
 }
 
-var IGeneralModuleFlatmapResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleFlatmapResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleDistinct2Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleDistinct2Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleFlatmapResult_Ex_DEFAULT
+    return IGeneralModuleDistinct2Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleFlatmapResult) IsSetEx() bool {
+func (p *IGeneralModuleDistinct2Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleFlatmapResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleDistinct2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4843,7 +15166,7 @@ func (p *IGeneralModuleFlatmapResult) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleFlatmapResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleDistinct2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4851,8 +15174,8 @@ func (p *IGeneralModuleFlatmapResult)  ReadField1(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IGeneralModuleFlatmapResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "flatmap_result"); err != nil {
+func (p *IGeneralModuleDistinct2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "distinct2_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4864,7 +15187,7 @@ func (p *IGeneralModuleFlatmapResult) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleFlatmapResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleDistinct2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4877,35 +15200,34 @@ func (p *IGeneralModuleFlatmapResult) writeField1(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IGeneralModuleFlatmapResult) String() string {
+func (p *IGeneralModuleDistinct2Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFlatmapResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleDistinct2Result(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleKeyByArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
-}
-
-func NewIGeneralModuleKeyByArgs() *IGeneralModuleKeyByArgs {
-  return &IGeneralModuleKeyByArgs{}
+//  - Other
+//  - NumPartitions
+type IGeneralModuleIntersectionArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
 }
 
-var IGeneralModuleKeyByArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleKeyByArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleKeyByArgs_Src_DEFAULT
-  }
-return p.Src
+func NewIGeneralModuleIntersectionArgs() *IGeneralModuleIntersectionArgs {
+  return &IGeneralModuleIntersectionArgs{}
 }
-func (p *IGeneralModuleKeyByArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+
+func (p *IGeneralModuleIntersectionArgs) GetOther() string {
+  return p.Other
 }
 
-func (p *IGeneralModuleKeyByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleIntersectionArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleIntersectionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4919,7 +15241,7 @@ func (p *IGeneralModuleKeyByArgs) Read(ctx context.Context, iprot thrift.TProtoc
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -4928,6 +15250,16 @@ func (p *IGeneralModuleKeyByArgs) Read(ctx context.Context, iprot thrift.TProtoc
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -4943,22 +15275,30 @@ func (p *IGeneralModuleKeyByArgs) Read(ctx context.Context, iprot thrift.TProtoc
   return nil
 }
 
-func (p *IGeneralModuleKeyByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleIntersectionArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleKeyByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "keyBy_args"); err != nil {
+func (p *IGeneralModuleIntersectionArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleIntersectionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "intersection_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4967,46 +15307,55 @@ func (p *IGeneralModuleKeyByArgs) Write(ctx context.Context, oprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleKeyByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleIntersectionArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleKeyByArgs) String() string {
+func (p *IGeneralModuleIntersectionArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleIntersectionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleKeyByArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleIntersectionArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleKeyByResult struct {
+type IGeneralModuleIntersectionResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleKeyByResult() *IGeneralModuleKeyByResult {
-  return &IGeneralModuleKeyByResult{}
+func NewIGeneralModuleIntersectionResult() *IGeneralModuleIntersectionResult {
+  return &IGeneralModuleIntersectionResult{}
 }
 
-var IGeneralModuleKeyByResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleKeyByResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleIntersectionResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleIntersectionResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleKeyByResult_Ex_DEFAULT
+    return IGeneralModuleIntersectionResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleKeyByResult) IsSetEx() bool {
+func (p *IGeneralModuleIntersectionResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleKeyByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleIntersectionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5044,7 +15393,7 @@ func (p *IGeneralModuleKeyByResult) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleKeyByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleIntersectionResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5052,8 +15401,8 @@ func (p *IGeneralModuleKeyByResult)  ReadField1(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IGeneralModuleKeyByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "keyBy_result"); err != nil {
+func (p *IGeneralModuleIntersectionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "intersection_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5065,7 +15414,7 @@ func (p *IGeneralModuleKeyByResult) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleKeyByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleIntersectionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5078,35 +15427,34 @@ func (p *IGeneralModuleKeyByResult) writeField1(ctx context.Context, oprot thrif
   return err
 }
 
-func (p *IGeneralModuleKeyByResult) String() string {
+func (p *IGeneralModuleIntersectionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleKeyByResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleIntersectionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleMapWithIndexArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - Other
+//  - NumPartitions
+type IGeneralModuleSubtractArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleMapWithIndexArgs() *IGeneralModuleMapWithIndexArgs {
-  return &IGeneralModuleMapWithIndexArgs{}
+func NewIGeneralModuleSubtractArgs() *IGeneralModuleSubtractArgs {
+  return &IGeneralModuleSubtractArgs{}
 }
 
-var IGeneralModuleMapWithIndexArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleMapWithIndexArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleMapWithIndexArgs_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleMapWithIndexArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+func (p *IGeneralModuleSubtractArgs) GetOther() string {
+  return p.Other
 }
 
-func (p *IGeneralModuleMapWithIndexArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSubtractArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleSubtractArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5120,7 +15468,7 @@ func (p *IGeneralModuleMapWithIndexArgs) Read(ctx context.Context, iprot thrift.
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -5129,6 +15477,16 @@ func (p *IGeneralModuleMapWithIndexArgs) Read(ctx context.Context, iprot thrift.
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -5144,22 +15502,30 @@ func (p *IGeneralModuleMapWithIndexArgs) Read(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleMapWithIndexArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleSubtractArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleMapWithIndexArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapWithIndex_args"); err != nil {
+func (p *IGeneralModuleSubtractArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSubtractArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "subtract_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5168,46 +15534,55 @@ func (p *IGeneralModuleMapWithIndexArgs) Write(ctx context.Context, oprot thrift
   return nil
 }
 
-func (p *IGeneralModuleMapWithIndexArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleSubtractArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleMapWithIndexArgs) String() string {
+func (p *IGeneralModuleSubtractArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSubtractArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapWithIndexArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSubtractArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleMapWithIndexResult struct {
+type IGeneralModuleSubtractResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleMapWithIndexResult() *IGeneralModuleMapWithIndexResult {
-  return &IGeneralModuleMapWithIndexResult{}
+func NewIGeneralModuleSubtractResult() *IGeneralModuleSubtractResult {
+  return &IGeneralModuleSubtractResult{}
 }
 
-var IGeneralModuleMapWithIndexResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleMapWithIndexResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleSubtractResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSubtractResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleMapWithIndexResult_Ex_DEFAULT
+    return IGeneralModuleSubtractResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleMapWithIndexResult) IsSetEx() bool {
+func (p *IGeneralModuleSubtractResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleMapWithIndexResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSubtractResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5245,7 +15620,7 @@ func (p *IGeneralModuleMapWithIndexResult) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IGeneralModuleMapWithIndexResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSubtractResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5253,8 +15628,8 @@ func (p *IGeneralModuleMapWithIndexResult)  ReadField1(ctx context.Context, ipro
   return nil
 }
 
-func (p *IGeneralModuleMapWithIndexResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapWithIndex_result"); err != nil {
+func (p *IGeneralModuleSubtractResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "subtract_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5266,7 +15641,7 @@ func (p *IGeneralModuleMapWithIndexResult) Write(ctx context.Context, oprot thri
   return nil
 }
 
-func (p *IGeneralModuleMapWithIndexResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleSubtractResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5279,35 +15654,34 @@ func (p *IGeneralModuleMapWithIndexResult) writeField1(ctx context.Context, opro
   return err
 }
 
-func (p *IGeneralModuleMapWithIndexResult) String() string {
+func (p *IGeneralModuleSubtractResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapWithIndexResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSubtractResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleMapPartitionsArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - Other
+//  - NumPartitions
+type IGeneralModuleSubtractByKeyArgs struct {
+  Other string `thrift:"other,1" db:"other" json:"other"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleMapPartitionsArgs() *IGeneralModuleMapPartitionsArgs {
-  return &IGeneralModuleMapPartitionsArgs{}
+func NewIGeneralModuleSubtractByKeyArgs() *IGeneralModuleSubtractByKeyArgs {
+  return &IGeneralModuleSubtractByKeyArgs{}
 }
 
-var IGeneralModuleMapPartitionsArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleMapPartitionsArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleMapPartitionsArgs_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleMapPartitionsArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+func (p *IGeneralModuleSubtractByKeyArgs) GetOther() string {
+  return p.Other
 }
 
-func (p *IGeneralModuleMapPartitionsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSubtractByKeyArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleSubtractByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5321,7 +15695,7 @@ func (p *IGeneralModuleMapPartitionsArgs) Read(ctx context.Context, iprot thrift
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -5330,6 +15704,16 @@ func (p *IGeneralModuleMapPartitionsArgs) Read(ctx context.Context, iprot thrift
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -5345,22 +15729,30 @@ func (p *IGeneralModuleMapPartitionsArgs) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleSubtractByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Other = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapPartitions_args"); err != nil {
+func (p *IGeneralModuleSubtractByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleSubtractByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "subtractByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5369,46 +15761,55 @@ func (p *IGeneralModuleMapPartitionsArgs) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleSubtractByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleMapPartitionsArgs) String() string {
+func (p *IGeneralModuleSubtractByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleSubtractByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapPartitionsArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSubtractByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleMapPartitionsResult struct {
+type IGeneralModuleSubtractByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleMapPartitionsResult() *IGeneralModuleMapPartitionsResult {
-  return &IGeneralModuleMapPartitionsResult{}
+func NewIGeneralModuleSubtractByKeyResult() *IGeneralModuleSubtractByKeyResult {
+  return &IGeneralModuleSubtractByKeyResult{}
 }
 
-var IGeneralModuleMapPartitionsResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleMapPartitionsResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleSubtractByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSubtractByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleMapPartitionsResult_Ex_DEFAULT
+    return IGeneralModuleSubtractByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleMapPartitionsResult) IsSetEx() bool {
+func (p *IGeneralModuleSubtractByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleMapPartitionsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSubtractByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5446,7 +15847,7 @@ func (p *IGeneralModuleMapPartitionsResult) Read(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSubtractByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5454,8 +15855,8 @@ func (p *IGeneralModuleMapPartitionsResult)  ReadField1(ctx context.Context, ipr
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapPartitions_result"); err != nil {
+func (p *IGeneralModuleSubtractByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "subtractByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5467,7 +15868,7 @@ func (p *IGeneralModuleMapPartitionsResult) Write(ctx context.Context, oprot thr
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleSubtractByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5480,35 +15881,40 @@ func (p *IGeneralModuleMapPartitionsResult) writeField1(ctx context.Context, opr
   return err
 }
 
-func (p *IGeneralModuleMapPartitionsResult) String() string {
+func (p *IGeneralModuleSubtractByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapPartitionsResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSubtractByKeyResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleMapPartitionsWithIndexArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - NumPartitions
+//  - PreserveOrdering
+//  - Global_
+type IGeneralModuleRepartitionArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+  PreserveOrdering bool `thrift:"preserveOrdering,2" db:"preserveOrdering" json:"preserveOrdering"`
+  Global_ bool `thrift:"global_,3" db:"global_" json:"global_"`
 }
 
-func NewIGeneralModuleMapPartitionsWithIndexArgs() *IGeneralModuleMapPartitionsWithIndexArgs {
-  return &IGeneralModuleMapPartitionsWithIndexArgs{}
+func NewIGeneralModuleRepartitionArgs() *IGeneralModuleRepartitionArgs {
+  return &IGeneralModuleRepartitionArgs{}
 }
 
-var IGeneralModuleMapPartitionsWithIndexArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleMapPartitionsWithIndexArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleMapPartitionsWithIndexArgs_Src_DEFAULT
-  }
-return p.Src
+
+func (p *IGeneralModuleRepartitionArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
 }
-func (p *IGeneralModuleMapPartitionsWithIndexArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+func (p *IGeneralModuleRepartitionArgs) GetPreserveOrdering() bool {
+  return p.PreserveOrdering
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionArgs) GetGlobal_() bool {
+  return p.Global_
+}
+func (p *IGeneralModuleRepartitionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5522,7 +15928,7 @@ func (p *IGeneralModuleMapPartitionsWithIndexArgs) Read(ctx context.Context, ipr
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -5531,6 +15937,26 @@ func (p *IGeneralModuleMapPartitionsWithIndexArgs) Read(ctx context.Context, ipr
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -5546,22 +15972,40 @@ func (p *IGeneralModuleMapPartitionsWithIndexArgs) Read(ctx context.Context, ipr
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleRepartitionArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.NumPartitions = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapPartitionsWithIndex_args"); err != nil {
+func (p *IGeneralModuleRepartitionArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.PreserveOrdering = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleRepartitionArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Global_ = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleRepartitionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "repartition_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5570,46 +16014,65 @@ func (p *IGeneralModuleMapPartitionsWithIndexArgs) Write(ctx context.Context, op
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleRepartitionArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexArgs) String() string {
+func (p *IGeneralModuleRepartitionArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "preserveOrdering", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:preserveOrdering: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.PreserveOrdering)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.preserveOrdering (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:preserveOrdering: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleRepartitionArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "global_", thrift.BOOL, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:global_: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Global_)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.global_ (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:global_: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleRepartitionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapPartitionsWithIndexArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleRepartitionArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleMapPartitionsWithIndexResult struct {
+type IGeneralModuleRepartitionResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleMapPartitionsWithIndexResult() *IGeneralModuleMapPartitionsWithIndexResult {
-  return &IGeneralModuleMapPartitionsWithIndexResult{}
+func NewIGeneralModuleRepartitionResult() *IGeneralModuleRepartitionResult {
+  return &IGeneralModuleRepartitionResult{}
 }
 
-var IGeneralModuleMapPartitionsWithIndexResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleMapPartitionsWithIndexResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleRepartitionResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleRepartitionResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleMapPartitionsWithIndexResult_Ex_DEFAULT
+    return IGeneralModuleRepartitionResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleMapPartitionsWithIndexResult) IsSetEx() bool {
+func (p *IGeneralModuleRepartitionResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5647,7 +16110,7 @@ func (p *IGeneralModuleMapPartitionsWithIndexResult) Read(ctx context.Context, i
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5655,8 +16118,8 @@ func (p *IGeneralModuleMapPartitionsWithIndexResult)  ReadField1(ctx context.Con
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapPartitionsWithIndex_result"); err != nil {
+func (p *IGeneralModuleRepartitionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "repartition_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5668,7 +16131,7 @@ func (p *IGeneralModuleMapPartitionsWithIndexResult) Write(ctx context.Context,
   return nil
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleRepartitionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5681,35 +16144,34 @@ func (p *IGeneralModuleMapPartitionsWithIndexResult) writeField1(ctx context.Con
   return err
 }
 
-func (p *IGeneralModuleMapPartitionsWithIndexResult) String() string {
+func (p *IGeneralModuleRepartitionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapPartitionsWithIndexResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleRepartitionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleMapExecutorArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - NumPartitions
+//  - Shuffle
+type IGeneralModuleCoalesceArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+  Shuffle bool `thrift:"shuffle,2" db:"shuffle" json:"shuffle"`
 }
 
-func NewIGeneralModuleMapExecutorArgs() *IGeneralModuleMapExecutorArgs {
-  return &IGeneralModuleMapExecutorArgs{}
+func NewIGeneralModuleCoalesceArgs() *IGeneralModuleCoalesceArgs {
+  return &IGeneralModuleCoalesceArgs{}
 }
 
-var IGeneralModuleMapExecutorArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleMapExecutorArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleMapExecutorArgs_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleMapExecutorArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+func (p *IGeneralModuleCoalesceArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
 }
 
-func (p *IGeneralModuleMapExecutorArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleCoalesceArgs) GetShuffle() bool {
+  return p.Shuffle
+}
+func (p *IGeneralModuleCoalesceArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5723,7 +16185,7 @@ func (p *IGeneralModuleMapExecutorArgs) Read(ctx context.Context, iprot thrift.T
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -5732,6 +16194,16 @@ func (p *IGeneralModuleMapExecutorArgs) Read(ctx context.Context, iprot thrift.T
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -5747,22 +16219,30 @@ func (p *IGeneralModuleMapExecutorArgs) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleCoalesceArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.NumPartitions = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapExecutor_args"); err != nil {
+func (p *IGeneralModuleCoalesceArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Shuffle = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleCoalesceArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "coalesce_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5771,46 +16251,55 @@ func (p *IGeneralModuleMapExecutorArgs) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleCoalesceArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleMapExecutorArgs) String() string {
+func (p *IGeneralModuleCoalesceArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "shuffle", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:shuffle: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Shuffle)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.shuffle (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:shuffle: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleCoalesceArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapExecutorArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleCoalesceArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleMapExecutorResult struct {
+type IGeneralModuleCoalesceResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleMapExecutorResult() *IGeneralModuleMapExecutorResult {
-  return &IGeneralModuleMapExecutorResult{}
+func NewIGeneralModuleCoalesceResult() *IGeneralModuleCoalesceResult {
+  return &IGeneralModuleCoalesceResult{}
 }
 
-var IGeneralModuleMapExecutorResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleMapExecutorResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleCoalesceResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleCoalesceResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleMapExecutorResult_Ex_DEFAULT
+    return IGeneralModuleCoalesceResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleMapExecutorResult) IsSetEx() bool {
+func (p *IGeneralModuleCoalesceResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleMapExecutorResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleCoalesceResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5848,7 +16337,7 @@ func (p *IGeneralModuleMapExecutorResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleCoalesceResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5856,8 +16345,8 @@ func (p *IGeneralModuleMapExecutorResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapExecutor_result"); err != nil {
+func (p *IGeneralModuleCoalesceResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "coalesce_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5869,7 +16358,7 @@ func (p *IGeneralModuleMapExecutorResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleCoalesceResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5882,35 +16371,34 @@ func (p *IGeneralModuleMapExecutorResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleMapExecutorResult) String() string {
+func (p *IGeneralModuleCoalesceResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapExecutorResult(%+v)", *p)
-}
-
-// Attributes:
-//  - Src
-type IGeneralModuleMapExecutorToArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  return fmt.Sprintf("IGeneralModuleCoalesceResult(%+v)", *p)
 }
 
-func NewIGeneralModuleMapExecutorToArgs() *IGeneralModuleMapExecutorToArgs {
-  return &IGeneralModuleMapExecutorToArgs{}
+// Attributes:
+//  - NumPartitions
+//  - Seed
+type IGeneralModulePartitionByRandomArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+  Seed int32 `thrift:"seed,2" db:"seed" json:"seed"`
 }
 
-var IGeneralModuleMapExecutorToArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleMapExecutorToArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleMapExecutorToArgs_Src_DEFAULT
-  }
-return p.Src
+func NewIGeneralModulePartitionByRandomArgs() *IGeneralModulePartitionByRandomArgs {
+  return &IGeneralModulePartitionByRandomArgs{}
 }
-func (p *IGeneralModuleMapExecutorToArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+
+func (p *IGeneralModulePartitionByRandomArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
 }
 
-func (p *IGeneralModuleMapExecutorToArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByRandomArgs) GetSeed() int32 {
+  return p.Seed
+}
+func (p *IGeneralModulePartitionByRandomArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5924,7 +16412,7 @@ func (p *IGeneralModuleMapExecutorToArgs) Read(ctx context.Context, iprot thrift
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -5933,6 +16421,16 @@ func (p *IGeneralModuleMapExecutorToArgs) Read(ctx context.Context, iprot thrift
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -5948,22 +16446,30 @@ func (p *IGeneralModuleMapExecutorToArgs) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorToArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModulePartitionByRandomArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.NumPartitions = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorToArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapExecutorTo_args"); err != nil {
+func (p *IGeneralModulePartitionByRandomArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Seed = v
+}
+  return nil
+}
+
+func (p *IGeneralModulePartitionByRandomArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByRandom_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5972,46 +16478,55 @@ func (p *IGeneralModuleMapExecutorToArgs) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorToArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModulePartitionByRandomArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleMapExecutorToArgs) String() string {
+func (p *IGeneralModulePartitionByRandomArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "seed", thrift.I32, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seed: ", p), err) }
+  if err := oprot.WriteI32(ctx, int32(p.Seed)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.seed (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seed: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePartitionByRandomArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapExecutorToArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByRandomArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleMapExecutorToResult struct {
+type IGeneralModulePartitionByRandomResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleMapExecutorToResult() *IGeneralModuleMapExecutorToResult {
-  return &IGeneralModuleMapExecutorToResult{}
+func NewIGeneralModulePartitionByRandomResult() *IGeneralModulePartitionByRandomResult {
+  return &IGeneralModulePartitionByRandomResult{}
 }
 
-var IGeneralModuleMapExecutorToResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleMapExecutorToResult) GetEx() *rpc.IExecutorException {
+var IGeneralModulePartitionByRandomResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionByRandomResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleMapExecutorToResult_Ex_DEFAULT
+    return IGeneralModulePartitionByRandomResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleMapExecutorToResult) IsSetEx() bool {
+func (p *IGeneralModulePartitionByRandomResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleMapExecutorToResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByRandomResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6049,7 +16564,7 @@ func (p *IGeneralModuleMapExecutorToResult) Read(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorToResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByRandomResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -6057,8 +16572,8 @@ func (p *IGeneralModuleMapExecutorToResult)  ReadField1(ctx context.Context, ipr
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorToResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapExecutorTo_result"); err != nil {
+func (p *IGeneralModulePartitionByRandomResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByRandom_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -6070,7 +16585,7 @@ func (p *IGeneralModuleMapExecutorToResult) Write(ctx context.Context, oprot thr
   return nil
 }
 
-func (p *IGeneralModuleMapExecutorToResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionByRandomResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -6083,41 +16598,28 @@ func (p *IGeneralModuleMapExecutorToResult) writeField1(ctx context.Context, opr
   return err
 }
 
-func (p *IGeneralModuleMapExecutorToResult) String() string {
+func (p *IGeneralModulePartitionByRandomResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapExecutorToResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByRandomResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
 //  - NumPartitions
-type IGeneralModuleGroupByArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
-  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+type IGeneralModulePartitionByHashArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleGroupByArgs() *IGeneralModuleGroupByArgs {
-  return &IGeneralModuleGroupByArgs{}
+func NewIGeneralModulePartitionByHashArgs() *IGeneralModulePartitionByHashArgs {
+  return &IGeneralModulePartitionByHashArgs{}
 }
 
-var IGeneralModuleGroupByArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleGroupByArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleGroupByArgs_Src_DEFAULT
-  }
-return p.Src
-}
 
-func (p *IGeneralModuleGroupByArgs) GetNumPartitions() int64 {
+func (p *IGeneralModulePartitionByHashArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-func (p *IGeneralModuleGroupByArgs) IsSetSrc() bool {
-  return p.Src != nil
-}
-
-func (p *IGeneralModuleGroupByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByHashArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6131,18 +16633,8 @@ func (p *IGeneralModuleGroupByArgs) Read(ctx context.Context, iprot thrift.TProt
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField1(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
       if fieldTypeId == thrift.I64 {
-        if err := p.ReadField2(ctx, iprot); err != nil {
+        if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -6165,32 +16657,20 @@ func (p *IGeneralModuleGroupByArgs) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleGroupByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleGroupByArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByHashArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+  return thrift.PrependError("error reading field 1: ", err)
 } else {
   p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleGroupByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "groupBy_args"); err != nil {
+func (p *IGeneralModulePartitionByHashArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByHash_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6199,56 +16679,45 @@ func (p *IGeneralModuleGroupByArgs) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleGroupByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleGroupByArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+func (p *IGeneralModulePartitionByHashArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleGroupByArgs) String() string {
+func (p *IGeneralModulePartitionByHashArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleGroupByArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByHashArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleGroupByResult struct {
+type IGeneralModulePartitionByHashResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleGroupByResult() *IGeneralModuleGroupByResult {
-  return &IGeneralModuleGroupByResult{}
+func NewIGeneralModulePartitionByHashResult() *IGeneralModulePartitionByHashResult {
+  return &IGeneralModulePartitionByHashResult{}
 }
 
-var IGeneralModuleGroupByResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleGroupByResult) GetEx() *rpc.IExecutorException {
+var IGeneralModulePartitionByHashResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionByHashResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleGroupByResult_Ex_DEFAULT
+    return IGeneralModulePartitionByHashResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleGroupByResult) IsSetEx() bool {
+func (p *IGeneralModulePartitionByHashResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleGroupByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByHashResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6286,7 +16755,7 @@ func (p *IGeneralModuleGroupByResult) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleGroupByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByHashResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -6294,8 +16763,8 @@ func (p *IGeneralModuleGroupByResult)  ReadField1(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IGeneralModuleGroupByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "groupBy_result"); err != nil {
+func (p *IGeneralModulePartitionByHashResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByHash_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -6307,7 +16776,7 @@ func (p *IGeneralModuleGroupByResult) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleGroupByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionByHashResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -6320,28 +16789,41 @@ func (p *IGeneralModuleGroupByResult) writeField1(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IGeneralModuleGroupByResult) String() string {
+func (p *IGeneralModulePartitionByHashResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleGroupByResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByHashResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Ascending
-type IGeneralModuleSortArgs struct {
-  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
+//  - Src
+//  - NumPartitions
+type IGeneralModulePartitionByArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleSortArgs() *IGeneralModuleSortArgs {
-  return &IGeneralModuleSortArgs{}
+func NewIGeneralModulePartitionByArgs() *IGeneralModulePartitionByArgs {
+  return &IGeneralModulePartitionByArgs{}
 }
 
+var IGeneralModulePartitionByArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModulePartitionByArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModulePartitionByArgs_Src_DEFAULT
+  }
+return p.Src
+}
 
-func (p *IGeneralModuleSortArgs) GetAscending() bool {
-  return p.Ascending
+func (p *IGeneralModulePartitionByArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModulePartitionByArgs) IsSetSrc() bool {
+  return p.Src != nil
 }
-func (p *IGeneralModuleSortArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IGeneralModulePartitionByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6355,7 +16837,7 @@ func (p *IGeneralModuleSortArgs) Read(ctx context.Context, iprot thrift.TProtoco
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.BOOL {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -6364,6 +16846,16 @@ func (p *IGeneralModuleSortArgs) Read(ctx context.Context, iprot thrift.TProtoco
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -6379,20 +16871,32 @@ func (p *IGeneralModuleSortArgs) Read(ctx context.Context, iprot thrift.TProtoco
   return nil
 }
 
-func (p *IGeneralModuleSortArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
+func (p *IGeneralModulePartitionByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePartitionByArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.Ascending = v
+  p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleSortArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sort_args"); err != nil {
+func (p *IGeneralModulePartitionByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionBy_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6401,45 +16905,56 @@ func (p *IGeneralModuleSortArgs) Write(ctx context.Context, oprot thrift.TProtoc
   return nil
 }
 
-func (p *IGeneralModuleSortArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
+func (p *IGeneralModulePartitionByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortArgs) String() string {
+func (p *IGeneralModulePartitionByArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePartitionByArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleSortResult struct {
+type IGeneralModulePartitionByResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSortResult() *IGeneralModuleSortResult {
-  return &IGeneralModuleSortResult{}
+func NewIGeneralModulePartitionByResult() *IGeneralModulePartitionByResult {
+  return &IGeneralModulePartitionByResult{}
 }
 
-var IGeneralModuleSortResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSortResult) GetEx() *rpc.IExecutorException {
+var IGeneralModulePartitionByResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionByResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSortResult_Ex_DEFAULT
+    return IGeneralModulePartitionByResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSortResult) IsSetEx() bool {
+func (p *IGeneralModulePartitionByResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSortResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6477,7 +16992,7 @@ func (p *IGeneralModuleSortResult) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleSortResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -6485,8 +17000,8 @@ func (p *IGeneralModuleSortResult)  ReadField1(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleSortResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sort_result"); err != nil {
+func (p *IGeneralModulePartitionByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionBy_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -6498,7 +17013,7 @@ func (p *IGeneralModuleSortResult) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleSortResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -6511,34 +17026,28 @@ func (p *IGeneralModuleSortResult) writeField1(ctx context.Context, oprot thrift
   return err
 }
 
-func (p *IGeneralModuleSortResult) String() string {
+func (p *IGeneralModulePartitionByResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Ascending
 //  - NumPartitions
-type IGeneralModuleSort2Args struct {
-  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
-  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+type IGeneralModulePartitionByKeyHashArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleSort2Args() *IGeneralModuleSort2Args {
-  return &IGeneralModuleSort2Args{}
+func NewIGeneralModulePartitionByKeyHashArgs() *IGeneralModulePartitionByKeyHashArgs {
+  return &IGeneralModulePartitionByKeyHashArgs{}
 }
 
 
-func (p *IGeneralModuleSort2Args) GetAscending() bool {
-  return p.Ascending
-}
-
-func (p *IGeneralModuleSort2Args) GetNumPartitions() int64 {
+func (p *IGeneralModulePartitionByKeyHashArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-func (p *IGeneralModuleSort2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyHashArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6552,18 +17061,8 @@ func (p *IGeneralModuleSort2Args) Read(ctx context.Context, iprot thrift.TProtoc
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField1(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
       if fieldTypeId == thrift.I64 {
-        if err := p.ReadField2(ctx, iprot); err != nil {
+        if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -6586,30 +17085,20 @@ func (p *IGeneralModuleSort2Args) Read(ctx context.Context, iprot thrift.TProtoc
   return nil
 }
 
-func (p *IGeneralModuleSort2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Ascending = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleSort2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyHashArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+  return thrift.PrependError("error reading field 1: ", err)
 } else {
   p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleSort2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sort2_args"); err != nil {
+func (p *IGeneralModulePartitionByKeyHashArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByKeyHash_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6618,55 +17107,45 @@ func (p *IGeneralModuleSort2Args) Write(ctx context.Context, oprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleSort2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleSort2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+func (p *IGeneralModulePartitionByKeyHashArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSort2Args) String() string {
+func (p *IGeneralModulePartitionByKeyHashArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSort2Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByKeyHashArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleSort2Result struct {
+type IGeneralModulePartitionByKeyHashResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSort2Result() *IGeneralModuleSort2Result {
-  return &IGeneralModuleSort2Result{}
+func NewIGeneralModulePartitionByKeyHashResult() *IGeneralModulePartitionByKeyHashResult {
+  return &IGeneralModulePartitionByKeyHashResult{}
 }
 
-var IGeneralModuleSort2Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSort2Result) GetEx() *rpc.IExecutorException {
+var IGeneralModulePartitionByKeyHashResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionByKeyHashResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSort2Result_Ex_DEFAULT
+    return IGeneralModulePartitionByKeyHashResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSort2Result) IsSetEx() bool {
+func (p *IGeneralModulePartitionByKeyHashResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSort2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyHashResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6704,7 +17183,7 @@ func (p *IGeneralModuleSort2Result) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleSort2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyHashResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -6712,8 +17191,8 @@ func (p *IGeneralModuleSort2Result)  ReadField1(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IGeneralModuleSort2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sort2_result"); err != nil {
+func (p *IGeneralModulePartitionByKeyHashResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByKeyHash_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -6725,7 +17204,7 @@ func (p *IGeneralModuleSort2Result) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleSort2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionByKeyHashResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -6738,41 +17217,28 @@ func (p *IGeneralModuleSort2Result) writeField1(ctx context.Context, oprot thrif
   return err
 }
 
-func (p *IGeneralModuleSort2Result) String() string {
+func (p *IGeneralModulePartitionByKeyHashResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSort2Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByKeyHashResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-//  - Ascending
-type IGeneralModuleSortByArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
-  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
+//  - NumPartitions
+type IGeneralModulePartitionByKeyRangeArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleSortByArgs() *IGeneralModuleSortByArgs {
-  return &IGeneralModuleSortByArgs{}
+func NewIGeneralModulePartitionByKeyRangeArgs() *IGeneralModulePartitionByKeyRangeArgs {
+  return &IGeneralModulePartitionByKeyRangeArgs{}
 }
 
-var IGeneralModuleSortByArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleSortByArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleSortByArgs_Src_DEFAULT
-  }
-return p.Src
-}
 
-func (p *IGeneralModuleSortByArgs) GetAscending() bool {
-  return p.Ascending
-}
-func (p *IGeneralModuleSortByArgs) IsSetSrc() bool {
-  return p.Src != nil
+func (p *IGeneralModulePartitionByKeyRangeArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
 }
-
-func (p *IGeneralModuleSortByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyRangeArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6786,7 +17252,7 @@ func (p *IGeneralModuleSortByArgs) Read(ctx context.Context, iprot thrift.TProto
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -6795,16 +17261,6 @@ func (p *IGeneralModuleSortByArgs) Read(ctx context.Context, iprot thrift.TProto
           return err
         }
       }
-    case 2:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -6820,32 +17276,20 @@ func (p *IGeneralModuleSortByArgs) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleSortByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleSortByArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+func (p *IGeneralModulePartitionByKeyRangeArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Ascending = v
+  p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleSortByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortBy_args"); err != nil {
+func (p *IGeneralModulePartitionByKeyRangeArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByKeyRange_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6854,56 +17298,45 @@ func (p *IGeneralModuleSortByArgs) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleSortByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleSortByArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
+func (p *IGeneralModulePartitionByKeyRangeArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortByArgs) String() string {
+func (p *IGeneralModulePartitionByKeyRangeArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByKeyRangeArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleSortByResult struct {
+type IGeneralModulePartitionByKeyRangeResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSortByResult() *IGeneralModuleSortByResult {
-  return &IGeneralModuleSortByResult{}
+func NewIGeneralModulePartitionByKeyRangeResult() *IGeneralModulePartitionByKeyRangeResult {
+  return &IGeneralModulePartitionByKeyRangeResult{}
 }
-
-var IGeneralModuleSortByResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSortByResult) GetEx() *rpc.IExecutorException {
+
+var IGeneralModulePartitionByKeyRangeResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionByKeyRangeResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSortByResult_Ex_DEFAULT
+    return IGeneralModulePartitionByKeyRangeResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSortByResult) IsSetEx() bool {
+func (p *IGeneralModulePartitionByKeyRangeResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSortByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyRangeResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6941,7 +17374,7 @@ func (p *IGeneralModuleSortByResult) Read(ctx context.Context, iprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleSortByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyRangeResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -6949,8 +17382,8 @@ func (p *IGeneralModuleSortByResult)  ReadField1(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleSortByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortBy_result"); err != nil {
+func (p *IGeneralModulePartitionByKeyRangeResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByKeyRange_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -6962,7 +17395,7 @@ func (p *IGeneralModuleSortByResult) Write(ctx context.Context, oprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleSortByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionByKeyRangeResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -6975,47 +17408,41 @@ func (p *IGeneralModuleSortByResult) writeField1(ctx context.Context, oprot thri
   return err
 }
 
-func (p *IGeneralModuleSortByResult) String() string {
+func (p *IGeneralModulePartitionByKeyRangeResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByKeyRangeResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Src
-//  - Ascending
 //  - NumPartitions
-type IGeneralModuleSortBy3Args struct {
+type IGeneralModulePartitionByKeyArgs struct {
   Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
-  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
-  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleSortBy3Args() *IGeneralModuleSortBy3Args {
-  return &IGeneralModuleSortBy3Args{}
+func NewIGeneralModulePartitionByKeyArgs() *IGeneralModulePartitionByKeyArgs {
+  return &IGeneralModulePartitionByKeyArgs{}
 }
 
-var IGeneralModuleSortBy3Args_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleSortBy3Args) GetSrc() *rpc.ISource {
+var IGeneralModulePartitionByKeyArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModulePartitionByKeyArgs) GetSrc() *rpc.ISource {
   if !p.IsSetSrc() {
-    return IGeneralModuleSortBy3Args_Src_DEFAULT
+    return IGeneralModulePartitionByKeyArgs_Src_DEFAULT
   }
 return p.Src
 }
 
-func (p *IGeneralModuleSortBy3Args) GetAscending() bool {
-  return p.Ascending
-}
-
-func (p *IGeneralModuleSortBy3Args) GetNumPartitions() int64 {
+func (p *IGeneralModulePartitionByKeyArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-func (p *IGeneralModuleSortBy3Args) IsSetSrc() bool {
+func (p *IGeneralModulePartitionByKeyArgs) IsSetSrc() bool {
   return p.Src != nil
 }
 
-func (p *IGeneralModuleSortBy3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7039,18 +17466,8 @@ func (p *IGeneralModuleSortBy3Args) Read(ctx context.Context, iprot thrift.TProt
         }
       }
     case 2:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
       if fieldTypeId == thrift.I64 {
-        if err := p.ReadField3(ctx, iprot); err != nil {
+        if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -7073,7 +17490,7 @@ func (p *IGeneralModuleSortBy3Args) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleSortBy3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
@@ -7084,31 +17501,21 @@ func (p *IGeneralModuleSortBy3Args)  ReadField1(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IGeneralModuleSortBy3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.Ascending = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleSortBy3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
+  return thrift.PrependError("error reading field 2: ", err)
 } else {
   p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleSortBy3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortBy3_args"); err != nil {
+func (p *IGeneralModulePartitionByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7117,7 +17524,7 @@ func (p *IGeneralModuleSortBy3Args) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleSortBy3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
   if err := p.Src.Write(ctx, oprot); err != nil {
@@ -7128,55 +17535,45 @@ func (p *IGeneralModuleSortBy3Args) writeField1(ctx context.Context, oprot thrif
   return err
 }
 
-func (p *IGeneralModuleSortBy3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleSortBy3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
+func (p *IGeneralModulePartitionByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortBy3Args) String() string {
+func (p *IGeneralModulePartitionByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortBy3Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleSortBy3Result struct {
+type IGeneralModulePartitionByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSortBy3Result() *IGeneralModuleSortBy3Result {
-  return &IGeneralModuleSortBy3Result{}
+func NewIGeneralModulePartitionByKeyResult() *IGeneralModulePartitionByKeyResult {
+  return &IGeneralModulePartitionByKeyResult{}
 }
 
-var IGeneralModuleSortBy3Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSortBy3Result) GetEx() *rpc.IExecutorException {
+var IGeneralModulePartitionByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSortBy3Result_Ex_DEFAULT
+    return IGeneralModulePartitionByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSortBy3Result) IsSetEx() bool {
+func (p *IGeneralModulePartitionByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSortBy3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7214,7 +17611,7 @@ func (p *IGeneralModuleSortBy3Result) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleSortBy3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -7222,8 +17619,8 @@ func (p *IGeneralModuleSortBy3Result)  ReadField1(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IGeneralModuleSortBy3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortBy3_result"); err != nil {
+func (p *IGeneralModulePartitionByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -7235,7 +17632,7 @@ func (p *IGeneralModuleSortBy3Result) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleSortBy3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -7248,34 +17645,28 @@ func (p *IGeneralModuleSortBy3Result) writeField1(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IGeneralModuleSortBy3Result) String() string {
+func (p *IGeneralModulePartitionByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortBy3Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionByKeyResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Other
-//  - PreserveOrder
-type IGeneralModuleUnion_Args struct {
-  Other string `thrift:"other,1" db:"other" json:"other"`
-  PreserveOrder bool `thrift:"preserveOrder,2" db:"preserveOrder" json:"preserveOrder"`
+//  - Order
+type IGeneralModuleReorderPartitionsArgs struct {
+  Order []int64 `thrift:"order,1" db:"order" json:"order"`
 }
 
-func NewIGeneralModuleUnion_Args() *IGeneralModuleUnion_Args {
-  return &IGeneralModuleUnion_Args{}
+func NewIGeneralModuleReorderPartitionsArgs() *IGeneralModuleReorderPartitionsArgs {
+  return &IGeneralModuleReorderPartitionsArgs{}
 }
 
 
-func (p *IGeneralModuleUnion_Args) GetOther() string {
-  return p.Other
-}
-
-func (p *IGeneralModuleUnion_Args) GetPreserveOrder() bool {
-  return p.PreserveOrder
+func (p *IGeneralModuleReorderPartitionsArgs) GetOrder() []int64 {
+  return p.Order
 }
-func (p *IGeneralModuleUnion_Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleReorderPartitionsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7289,7 +17680,7 @@ func (p *IGeneralModuleUnion_Args) Read(ctx context.Context, iprot thrift.TProto
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.LIST {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -7298,9 +17689,118 @@ func (p *IGeneralModuleUnion_Args) Read(ctx context.Context, iprot thrift.TProto
           return err
         }
       }
-    case 2:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField2(ctx, iprot); err != nil {
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleReorderPartitionsArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]int64, 0, size)
+  p.Order =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem215 int64
+    if v, err := iprot.ReadI64(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem215 = v
+}
+    p.Order = append(p.Order, _elem215)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleReorderPartitionsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reorderPartitions_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleReorderPartitionsArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "order", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:order: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.Order)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Order {
+    if err := oprot.WriteI64(ctx, int64(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:order: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleReorderPartitionsArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleReorderPartitionsArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IGeneralModuleReorderPartitionsResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIGeneralModuleReorderPartitionsResult() *IGeneralModuleReorderPartitionsResult {
+  return &IGeneralModuleReorderPartitionsResult{}
+}
+
+var IGeneralModuleReorderPartitionsResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleReorderPartitionsResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleReorderPartitionsResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleReorderPartitionsResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleReorderPartitionsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -7323,30 +17823,19 @@ func (p *IGeneralModuleUnion_Args) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleUnion_Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Other = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleUnion_Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.PreserveOrder = v
-}
+func (p *IGeneralModuleReorderPartitionsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
   return nil
 }
 
-func (p *IGeneralModuleUnion_Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "union__args"); err != nil {
+func (p *IGeneralModuleReorderPartitionsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reorderPartitions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7355,55 +17844,99 @@ func (p *IGeneralModuleUnion_Args) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleUnion_Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
+func (p *IGeneralModuleReorderPartitionsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
   return err
 }
 
-func (p *IGeneralModuleUnion_Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "preserveOrder", thrift.BOOL, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:preserveOrder: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.PreserveOrder)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.preserveOrder (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:preserveOrder: ", p), err) }
-  return err
+func (p *IGeneralModuleReorderPartitionsResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleReorderPartitionsResult(%+v)", *p)
+}
+
+type IGeneralModuleReorderPartitionsByArgs struct {
+}
+
+func NewIGeneralModuleReorderPartitionsByArgs() *IGeneralModuleReorderPartitionsByArgs {
+  return &IGeneralModuleReorderPartitionsByArgs{}
+}
+
+func (p *IGeneralModuleReorderPartitionsByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-func (p *IGeneralModuleUnion_Args) String() string {
+func (p *IGeneralModuleReorderPartitionsByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reorderPartitionsBy_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleReorderPartitionsByArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleUnion_Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleReorderPartitionsByArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleUnion_Result struct {
+type IGeneralModuleReorderPartitionsByResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleUnion_Result() *IGeneralModuleUnion_Result {
-  return &IGeneralModuleUnion_Result{}
+func NewIGeneralModuleReorderPartitionsByResult() *IGeneralModuleReorderPartitionsByResult {
+  return &IGeneralModuleReorderPartitionsByResult{}
 }
 
-var IGeneralModuleUnion_Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleUnion_Result) GetEx() *rpc.IExecutorException {
+var IGeneralModuleReorderPartitionsByResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleReorderPartitionsByResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleUnion_Result_Ex_DEFAULT
+    return IGeneralModuleReorderPartitionsByResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleUnion_Result) IsSetEx() bool {
+func (p *IGeneralModuleReorderPartitionsByResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleUnion_Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleReorderPartitionsByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7441,7 +17974,7 @@ func (p *IGeneralModuleUnion_Result) Read(ctx context.Context, iprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleUnion_Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleReorderPartitionsByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -7449,8 +17982,8 @@ func (p *IGeneralModuleUnion_Result)  ReadField1(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleUnion_Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "union__result"); err != nil {
+func (p *IGeneralModuleReorderPartitionsByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reorderPartitionsBy_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -7462,7 +17995,7 @@ func (p *IGeneralModuleUnion_Result) Write(ctx context.Context, oprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleUnion_Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleReorderPartitionsByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -7475,47 +18008,21 @@ func (p *IGeneralModuleUnion_Result) writeField1(ctx context.Context, oprot thri
   return err
 }
 
-func (p *IGeneralModuleUnion_Result) String() string {
+func (p *IGeneralModuleReorderPartitionsByResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleUnion_Result(%+v)", *p)
-}
-
-// Attributes:
-//  - Other
-//  - PreserveOrder
-//  - Src
-type IGeneralModuleUnion2Args struct {
-  Other string `thrift:"other,1" db:"other" json:"other"`
-  PreserveOrder bool `thrift:"preserveOrder,2" db:"preserveOrder" json:"preserveOrder"`
-  Src *rpc.ISource `thrift:"src,3" db:"src" json:"src"`
+  return fmt.Sprintf("IGeneralModuleReorderPartitionsByResult(%+v)", *p)
 }
 
-func NewIGeneralModuleUnion2Args() *IGeneralModuleUnion2Args {
-  return &IGeneralModuleUnion2Args{}
-}
-
-
-func (p *IGeneralModuleUnion2Args) GetOther() string {
-  return p.Other
+type IGeneralModulePartitionOffsetArgs struct {
 }
 
-func (p *IGeneralModuleUnion2Args) GetPreserveOrder() bool {
-  return p.PreserveOrder
-}
-var IGeneralModuleUnion2Args_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleUnion2Args) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleUnion2Args_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleUnion2Args) IsSetSrc() bool {
-  return p.Src != nil
+func NewIGeneralModulePartitionOffsetArgs() *IGeneralModulePartitionOffsetArgs {
+  return &IGeneralModulePartitionOffsetArgs{}
 }
 
-func (p *IGeneralModuleUnion2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionOffsetArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7527,41 +18034,8 @@ func (p *IGeneralModuleUnion2Args) Read(ctx context.Context, iprot thrift.TProto
       return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
     if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField1(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField3(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    default:
-      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-        return err
-      }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
     }
     if err := iprot.ReadFieldEnd(ctx); err != nil {
       return err
@@ -7573,42 +18047,10 @@ func (p *IGeneralModuleUnion2Args) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleUnion2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Other = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleUnion2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.PreserveOrder = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleUnion2Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleUnion2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "union2_args"); err != nil {
+func (p *IGeneralModulePartitionOffsetArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionOffset_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7617,66 +18059,48 @@ func (p *IGeneralModuleUnion2Args) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleUnion2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleUnion2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "preserveOrder", thrift.BOOL, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:preserveOrder: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.PreserveOrder)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.preserveOrder (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:preserveOrder: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleUnion2Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:src: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleUnion2Args) String() string {
+func (p *IGeneralModulePartitionOffsetArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleUnion2Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionOffsetArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - Ex
-type IGeneralModuleUnion2Result struct {
+type IGeneralModulePartitionOffsetResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleUnion2Result() *IGeneralModuleUnion2Result {
-  return &IGeneralModuleUnion2Result{}
+func NewIGeneralModulePartitionOffsetResult() *IGeneralModulePartitionOffsetResult {
+  return &IGeneralModulePartitionOffsetResult{}
 }
 
-var IGeneralModuleUnion2Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleUnion2Result) GetEx() *rpc.IExecutorException {
+var IGeneralModulePartitionOffsetResult_Success_DEFAULT int64
+func (p *IGeneralModulePartitionOffsetResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return IGeneralModulePartitionOffsetResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IGeneralModulePartitionOffsetResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionOffsetResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleUnion2Result_Ex_DEFAULT
+    return IGeneralModulePartitionOffsetResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleUnion2Result) IsSetEx() bool {
+func (p *IGeneralModulePartitionOffsetResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IGeneralModulePartitionOffsetResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleUnion2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionOffsetResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7689,6 +18113,16 @@ func (p *IGeneralModuleUnion2Result) Read(ctx context.Context, iprot thrift.TPro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     case 1:
       if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
@@ -7714,7 +18148,16 @@ func (p *IGeneralModuleUnion2Result) Read(ctx context.Context, iprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleUnion2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionOffsetResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *IGeneralModulePartitionOffsetResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -7722,10 +18165,11 @@ func (p *IGeneralModuleUnion2Result)  ReadField1(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleUnion2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "union2_result"); err != nil {
+func (p *IGeneralModulePartitionOffsetResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionOffset_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
@@ -7735,7 +18179,19 @@ func (p *IGeneralModuleUnion2Result) Write(ctx context.Context, oprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleUnion2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionOffsetResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModulePartitionOffsetResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -7748,34 +18204,35 @@ func (p *IGeneralModuleUnion2Result) writeField1(ctx context.Context, oprot thri
   return err
 }
 
-func (p *IGeneralModuleUnion2Result) String() string {
+func (p *IGeneralModulePartitionOffsetResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleUnion2Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionOffsetResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Other
-//  - NumPartitions
-type IGeneralModuleJoinArgs struct {
-  Other string `thrift:"other,1" db:"other" json:"other"`
-  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+//  - Src
+type IGeneralModuleFlatMapValuesArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
 }
 
-func NewIGeneralModuleJoinArgs() *IGeneralModuleJoinArgs {
-  return &IGeneralModuleJoinArgs{}
+func NewIGeneralModuleFlatMapValuesArgs() *IGeneralModuleFlatMapValuesArgs {
+  return &IGeneralModuleFlatMapValuesArgs{}
 }
 
-
-func (p *IGeneralModuleJoinArgs) GetOther() string {
-  return p.Other
+var IGeneralModuleFlatMapValuesArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleFlatMapValuesArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleFlatMapValuesArgs_Src_DEFAULT
+  }
+return p.Src
 }
-
-func (p *IGeneralModuleJoinArgs) GetNumPartitions() int64 {
-  return p.NumPartitions
+func (p *IGeneralModuleFlatMapValuesArgs) IsSetSrc() bool {
+  return p.Src != nil
 }
-func (p *IGeneralModuleJoinArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IGeneralModuleFlatMapValuesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7789,7 +18246,7 @@ func (p *IGeneralModuleJoinArgs) Read(ctx context.Context, iprot thrift.TProtoco
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -7798,16 +18255,6 @@ func (p *IGeneralModuleJoinArgs) Read(ctx context.Context, iprot thrift.TProtoco
           return err
         }
       }
-    case 2:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -7822,31 +18269,23 @@ func (p *IGeneralModuleJoinArgs) Read(ctx context.Context, iprot thrift.TProtoco
   }
   return nil
 }
-
-func (p *IGeneralModuleJoinArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Other = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleJoinArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.NumPartitions = v
+
+func (p *IGeneralModuleFlatMapValuesArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
 }
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
   return nil
 }
 
-func (p *IGeneralModuleJoinArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "join_args"); err != nil {
+func (p *IGeneralModuleFlatMapValuesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "flatMapValues_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7855,55 +18294,46 @@ func (p *IGeneralModuleJoinArgs) Write(ctx context.Context, oprot thrift.TProtoc
   return nil
 }
 
-func (p *IGeneralModuleJoinArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleJoinArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+func (p *IGeneralModuleFlatMapValuesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleJoinArgs) String() string {
+func (p *IGeneralModuleFlatMapValuesArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleJoinArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleFlatMapValuesArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleJoinResult struct {
+type IGeneralModuleFlatMapValuesResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleJoinResult() *IGeneralModuleJoinResult {
-  return &IGeneralModuleJoinResult{}
+func NewIGeneralModuleFlatMapValuesResult() *IGeneralModuleFlatMapValuesResult {
+  return &IGeneralModuleFlatMapValuesResult{}
 }
 
-var IGeneralModuleJoinResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleJoinResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleFlatMapValuesResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleFlatMapValuesResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleJoinResult_Ex_DEFAULT
+    return IGeneralModuleFlatMapValuesResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleJoinResult) IsSetEx() bool {
+func (p *IGeneralModuleFlatMapValuesResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleJoinResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFlatMapValuesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7941,7 +18371,7 @@ func (p *IGeneralModuleJoinResult) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleJoinResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFlatMapValuesResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -7949,8 +18379,8 @@ func (p *IGeneralModuleJoinResult)  ReadField1(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleJoinResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "join_result"); err != nil {
+func (p *IGeneralModuleFlatMapValuesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "flatMapValues_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -7962,7 +18392,7 @@ func (p *IGeneralModuleJoinResult) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleJoinResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleFlatMapValuesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -7975,47 +18405,35 @@ func (p *IGeneralModuleJoinResult) writeField1(ctx context.Context, oprot thrift
   return err
 }
 
-func (p *IGeneralModuleJoinResult) String() string {
+func (p *IGeneralModuleFlatMapValuesResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleJoinResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleFlatMapValuesResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Other
-//  - NumPartitions
 //  - Src
-type IGeneralModuleJoin3Args struct {
-  Other string `thrift:"other,1" db:"other" json:"other"`
-  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
-  Src *rpc.ISource `thrift:"src,3" db:"src" json:"src"`
-}
-
-func NewIGeneralModuleJoin3Args() *IGeneralModuleJoin3Args {
-  return &IGeneralModuleJoin3Args{}
+type IGeneralModuleMapValuesArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
 }
 
-
-func (p *IGeneralModuleJoin3Args) GetOther() string {
-  return p.Other
+func NewIGeneralModuleMapValuesArgs() *IGeneralModuleMapValuesArgs {
+  return &IGeneralModuleMapValuesArgs{}
 }
 
-func (p *IGeneralModuleJoin3Args) GetNumPartitions() int64 {
-  return p.NumPartitions
-}
-var IGeneralModuleJoin3Args_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleJoin3Args) GetSrc() *rpc.ISource {
+var IGeneralModuleMapValuesArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleMapValuesArgs) GetSrc() *rpc.ISource {
   if !p.IsSetSrc() {
-    return IGeneralModuleJoin3Args_Src_DEFAULT
+    return IGeneralModuleMapValuesArgs_Src_DEFAULT
   }
 return p.Src
 }
-func (p *IGeneralModuleJoin3Args) IsSetSrc() bool {
+func (p *IGeneralModuleMapValuesArgs) IsSetSrc() bool {
   return p.Src != nil
 }
 
-func (p *IGeneralModuleJoin3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleMapValuesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8029,28 +18447,8 @@ func (p *IGeneralModuleJoin3Args) Read(ctx context.Context, iprot thrift.TProtoc
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField1(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
       if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField3(ctx, iprot); err != nil {
+        if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -8073,25 +18471,7 @@ func (p *IGeneralModuleJoin3Args) Read(ctx context.Context, iprot thrift.TProtoc
   return nil
 }
 
-func (p *IGeneralModuleJoin3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Other = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleJoin3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.NumPartitions = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleJoin3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleMapValuesArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
@@ -8102,13 +18482,11 @@ func (p *IGeneralModuleJoin3Args)  ReadField3(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleJoin3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "join3_args"); err != nil {
+func (p *IGeneralModuleMapValuesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapValues_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -8117,66 +18495,46 @@ func (p *IGeneralModuleJoin3Args) Write(ctx context.Context, oprot thrift.TProto
   return nil
 }
 
-func (p *IGeneralModuleJoin3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "other", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:other: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.Other)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.other (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:other: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleJoin3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleJoin3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:src: ", p), err) }
+func (p *IGeneralModuleMapValuesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
   if err := p.Src.Write(ctx, oprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleJoin3Args) String() string {
+func (p *IGeneralModuleMapValuesArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleJoin3Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleMapValuesArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleJoin3Result struct {
+type IGeneralModuleMapValuesResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleJoin3Result() *IGeneralModuleJoin3Result {
-  return &IGeneralModuleJoin3Result{}
+func NewIGeneralModuleMapValuesResult() *IGeneralModuleMapValuesResult {
+  return &IGeneralModuleMapValuesResult{}
 }
 
-var IGeneralModuleJoin3Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleJoin3Result) GetEx() *rpc.IExecutorException {
+var IGeneralModuleMapValuesResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleMapValuesResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleJoin3Result_Ex_DEFAULT
+    return IGeneralModuleMapValuesResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleJoin3Result) IsSetEx() bool {
+func (p *IGeneralModuleMapValuesResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleJoin3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleMapValuesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8214,7 +18572,7 @@ func (p *IGeneralModuleJoin3Result) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IGeneralModuleJoin3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleMapValuesResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -8222,8 +18580,8 @@ func (p *IGeneralModuleJoin3Result)  ReadField1(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IGeneralModuleJoin3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "join3_result"); err != nil {
+func (p *IGeneralModuleMapValuesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mapValues_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -8235,7 +18593,7 @@ func (p *IGeneralModuleJoin3Result) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleJoin3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleMapValuesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -8248,28 +18606,28 @@ func (p *IGeneralModuleJoin3Result) writeField1(ctx context.Context, oprot thrif
   return err
 }
 
-func (p *IGeneralModuleJoin3Result) String() string {
+func (p *IGeneralModuleMapValuesResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleJoin3Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleMapValuesResult(%+v)", *p)
 }
 
 // Attributes:
 //  - NumPartitions
-type IGeneralModuleDistinctArgs struct {
+type IGeneralModuleGroupByKeyArgs struct {
   NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleDistinctArgs() *IGeneralModuleDistinctArgs {
-  return &IGeneralModuleDistinctArgs{}
+func NewIGeneralModuleGroupByKeyArgs() *IGeneralModuleGroupByKeyArgs {
+  return &IGeneralModuleGroupByKeyArgs{}
 }
 
 
-func (p *IGeneralModuleDistinctArgs) GetNumPartitions() int64 {
+func (p *IGeneralModuleGroupByKeyArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-func (p *IGeneralModuleDistinctArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8307,7 +18665,7 @@ func (p *IGeneralModuleDistinctArgs) Read(ctx context.Context, iprot thrift.TPro
   return nil
 }
 
-func (p *IGeneralModuleDistinctArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -8316,8 +18674,8 @@ func (p *IGeneralModuleDistinctArgs)  ReadField1(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleDistinctArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "distinct_args"); err != nil {
+func (p *IGeneralModuleGroupByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -8329,7 +18687,7 @@ func (p *IGeneralModuleDistinctArgs) Write(ctx context.Context, oprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleDistinctArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
@@ -8339,35 +18697,35 @@ func (p *IGeneralModuleDistinctArgs) writeField1(ctx context.Context, oprot thri
   return err
 }
 
-func (p *IGeneralModuleDistinctArgs) String() string {
+func (p *IGeneralModuleGroupByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleDistinctArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleDistinctResult struct {
+type IGeneralModuleGroupByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleDistinctResult() *IGeneralModuleDistinctResult {
-  return &IGeneralModuleDistinctResult{}
+func NewIGeneralModuleGroupByKeyResult() *IGeneralModuleGroupByKeyResult {
+  return &IGeneralModuleGroupByKeyResult{}
 }
 
-var IGeneralModuleDistinctResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleDistinctResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleGroupByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleGroupByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleDistinctResult_Ex_DEFAULT
+    return IGeneralModuleGroupByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleDistinctResult) IsSetEx() bool {
+func (p *IGeneralModuleGroupByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleDistinctResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8405,7 +18763,7 @@ func (p *IGeneralModuleDistinctResult) Read(ctx context.Context, iprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleDistinctResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -8413,8 +18771,8 @@ func (p *IGeneralModuleDistinctResult)  ReadField1(ctx context.Context, iprot th
   return nil
 }
 
-func (p *IGeneralModuleDistinctResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "distinct_result"); err != nil {
+func (p *IGeneralModuleGroupByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -8426,7 +18784,7 @@ func (p *IGeneralModuleDistinctResult) Write(ctx context.Context, oprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleDistinctResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -8439,41 +18797,41 @@ func (p *IGeneralModuleDistinctResult) writeField1(ctx context.Context, oprot th
   return err
 }
 
-func (p *IGeneralModuleDistinctResult) String() string {
+func (p *IGeneralModuleGroupByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleDistinctResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKeyResult(%+v)", *p)
 }
 
 // Attributes:
 //  - NumPartitions
 //  - Src
-type IGeneralModuleDistinct2Args struct {
+type IGeneralModuleGroupByKey2Args struct {
   NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
   Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
 }
 
-func NewIGeneralModuleDistinct2Args() *IGeneralModuleDistinct2Args {
-  return &IGeneralModuleDistinct2Args{}
+func NewIGeneralModuleGroupByKey2Args() *IGeneralModuleGroupByKey2Args {
+  return &IGeneralModuleGroupByKey2Args{}
 }
 
 
-func (p *IGeneralModuleDistinct2Args) GetNumPartitions() int64 {
+func (p *IGeneralModuleGroupByKey2Args) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-var IGeneralModuleDistinct2Args_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleDistinct2Args) GetSrc() *rpc.ISource {
+var IGeneralModuleGroupByKey2Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleGroupByKey2Args) GetSrc() *rpc.ISource {
   if !p.IsSetSrc() {
-    return IGeneralModuleDistinct2Args_Src_DEFAULT
+    return IGeneralModuleGroupByKey2Args_Src_DEFAULT
   }
 return p.Src
 }
-func (p *IGeneralModuleDistinct2Args) IsSetSrc() bool {
+func (p *IGeneralModuleGroupByKey2Args) IsSetSrc() bool {
   return p.Src != nil
 }
 
-func (p *IGeneralModuleDistinct2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKey2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8521,7 +18879,7 @@ func (p *IGeneralModuleDistinct2Args) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleDistinct2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKey2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -8530,7 +18888,7 @@ func (p *IGeneralModuleDistinct2Args)  ReadField1(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IGeneralModuleDistinct2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKey2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
@@ -8541,8 +18899,8 @@ func (p *IGeneralModuleDistinct2Args)  ReadField2(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IGeneralModuleDistinct2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "distinct2_args"); err != nil {
+func (p *IGeneralModuleGroupByKey2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKey2_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -8555,7 +18913,7 @@ func (p *IGeneralModuleDistinct2Args) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleDistinct2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKey2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
@@ -8565,7 +18923,7 @@ func (p *IGeneralModuleDistinct2Args) writeField1(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IGeneralModuleDistinct2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKey2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
   if err := p.Src.Write(ctx, oprot); err != nil {
@@ -8576,35 +18934,35 @@ func (p *IGeneralModuleDistinct2Args) writeField2(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IGeneralModuleDistinct2Args) String() string {
+func (p *IGeneralModuleGroupByKey2Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleDistinct2Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKey2Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleDistinct2Result struct {
+type IGeneralModuleGroupByKey2Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleDistinct2Result() *IGeneralModuleDistinct2Result {
-  return &IGeneralModuleDistinct2Result{}
+func NewIGeneralModuleGroupByKey2Result() *IGeneralModuleGroupByKey2Result {
+  return &IGeneralModuleGroupByKey2Result{}
 }
 
-var IGeneralModuleDistinct2Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleDistinct2Result) GetEx() *rpc.IExecutorException {
+var IGeneralModuleGroupByKey2Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleGroupByKey2Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleDistinct2Result_Ex_DEFAULT
+    return IGeneralModuleGroupByKey2Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleDistinct2Result) IsSetEx() bool {
+func (p *IGeneralModuleGroupByKey2Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleDistinct2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKey2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8642,7 +19000,7 @@ func (p *IGeneralModuleDistinct2Result) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleDistinct2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKey2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -8650,8 +19008,8 @@ func (p *IGeneralModuleDistinct2Result)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModuleDistinct2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "distinct2_result"); err != nil {
+func (p *IGeneralModuleGroupByKey2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKey2_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -8663,7 +19021,7 @@ func (p *IGeneralModuleDistinct2Result) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleDistinct2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKey2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -8676,40 +19034,47 @@ func (p *IGeneralModuleDistinct2Result) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IGeneralModuleDistinct2Result) String() string {
+func (p *IGeneralModuleGroupByKey2Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleDistinct2Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKey2Result(%+v)", *p)
 }
 
 // Attributes:
+//  - Src
 //  - NumPartitions
-//  - PreserveOrdering
-//  - Global_
-type IGeneralModuleRepartitionArgs struct {
-  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
-  PreserveOrdering bool `thrift:"preserveOrdering,2" db:"preserveOrdering" json:"preserveOrdering"`
-  Global_ bool `thrift:"global_,3" db:"global_" json:"global_"`
+//  - LocalReduce
+type IGeneralModuleReduceByKeyArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+  LocalReduce bool `thrift:"localReduce,3" db:"localReduce" json:"localReduce"`
 }
 
-func NewIGeneralModuleRepartitionArgs() *IGeneralModuleRepartitionArgs {
-  return &IGeneralModuleRepartitionArgs{}
+func NewIGeneralModuleReduceByKeyArgs() *IGeneralModuleReduceByKeyArgs {
+  return &IGeneralModuleReduceByKeyArgs{}
 }
 
+var IGeneralModuleReduceByKeyArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleReduceByKeyArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleReduceByKeyArgs_Src_DEFAULT
+  }
+return p.Src
+}
 
-func (p *IGeneralModuleRepartitionArgs) GetNumPartitions() int64 {
+func (p *IGeneralModuleReduceByKeyArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
 
-func (p *IGeneralModuleRepartitionArgs) GetPreserveOrdering() bool {
-  return p.PreserveOrdering
+func (p *IGeneralModuleReduceByKeyArgs) GetLocalReduce() bool {
+  return p.LocalReduce
 }
-
-func (p *IGeneralModuleRepartitionArgs) GetGlobal_() bool {
-  return p.Global_
+func (p *IGeneralModuleReduceByKeyArgs) IsSetSrc() bool {
+  return p.Src != nil
 }
-func (p *IGeneralModuleRepartitionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IGeneralModuleReduceByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8723,7 +19088,7 @@ func (p *IGeneralModuleRepartitionArgs) Read(ctx context.Context, iprot thrift.T
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -8733,7 +19098,7 @@ func (p *IGeneralModuleRepartitionArgs) Read(ctx context.Context, iprot thrift.T
         }
       }
     case 2:
-      if fieldTypeId == thrift.BOOL {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -8767,35 +19132,37 @@ func (p *IGeneralModuleRepartitionArgs) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleRepartitionArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.NumPartitions = v
+func (p *IGeneralModuleReduceByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
 }
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
   return nil
 }
 
-func (p *IGeneralModuleRepartitionArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
+func (p *IGeneralModuleReduceByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.PreserveOrdering = v
+  p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleRepartitionArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleReduceByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBool(ctx); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Global_ = v
+  p.LocalReduce = v
 }
   return nil
 }
 
-func (p *IGeneralModuleRepartitionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "repartition_args"); err != nil {
+func (p *IGeneralModuleReduceByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reduceByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -8809,65 +19176,66 @@ func (p *IGeneralModuleRepartitionArgs) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleRepartitionArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
+func (p *IGeneralModuleReduceByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleRepartitionArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "preserveOrdering", thrift.BOOL, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:preserveOrdering: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.PreserveOrdering)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.preserveOrdering (2) field write error: ", p), err) }
+func (p *IGeneralModuleReduceByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:preserveOrdering: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleRepartitionArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "global_", thrift.BOOL, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:global_: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Global_)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.global_ (3) field write error: ", p), err) }
+func (p *IGeneralModuleReduceByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "localReduce", thrift.BOOL, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:localReduce: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.LocalReduce)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.localReduce (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:global_: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:localReduce: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleRepartitionArgs) String() string {
+func (p *IGeneralModuleReduceByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleRepartitionArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleReduceByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleRepartitionResult struct {
+type IGeneralModuleReduceByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleRepartitionResult() *IGeneralModuleRepartitionResult {
-  return &IGeneralModuleRepartitionResult{}
+func NewIGeneralModuleReduceByKeyResult() *IGeneralModuleReduceByKeyResult {
+  return &IGeneralModuleReduceByKeyResult{}
 }
 
-var IGeneralModuleRepartitionResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleRepartitionResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleReduceByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleReduceByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleRepartitionResult_Ex_DEFAULT
+    return IGeneralModuleReduceByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleRepartitionResult) IsSetEx() bool {
+func (p *IGeneralModuleReduceByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleRepartitionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleReduceByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8905,7 +19273,7 @@ func (p *IGeneralModuleRepartitionResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleRepartitionResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleReduceByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -8913,8 +19281,8 @@ func (p *IGeneralModuleRepartitionResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleRepartitionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "repartition_result"); err != nil {
+func (p *IGeneralModuleReduceByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "reduceByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -8926,7 +19294,7 @@ func (p *IGeneralModuleRepartitionResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleRepartitionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleReduceByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -8939,34 +19307,54 @@ func (p *IGeneralModuleRepartitionResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleRepartitionResult) String() string {
+func (p *IGeneralModuleReduceByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleRepartitionResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleReduceByKeyResult(%+v)", *p)
 }
 
 // Attributes:
+//  - Zero
+//  - SeqOp
 //  - NumPartitions
-//  - Seed
-type IGeneralModulePartitionByRandomArgs struct {
-  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
-  Seed int32 `thrift:"seed,2" db:"seed" json:"seed"`
+type IGeneralModuleAggregateByKeyArgs struct {
+  Zero *rpc.ISource `thrift:"zero,1" db:"zero" json:"zero"`
+  SeqOp *rpc.ISource `thrift:"seqOp,2" db:"seqOp" json:"seqOp"`
+  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModulePartitionByRandomArgs() *IGeneralModulePartitionByRandomArgs {
-  return &IGeneralModulePartitionByRandomArgs{}
+func NewIGeneralModuleAggregateByKeyArgs() *IGeneralModuleAggregateByKeyArgs {
+  return &IGeneralModuleAggregateByKeyArgs{}
 }
 
+var IGeneralModuleAggregateByKeyArgs_Zero_DEFAULT *rpc.ISource
+func (p *IGeneralModuleAggregateByKeyArgs) GetZero() *rpc.ISource {
+  if !p.IsSetZero() {
+    return IGeneralModuleAggregateByKeyArgs_Zero_DEFAULT
+  }
+return p.Zero
+}
+var IGeneralModuleAggregateByKeyArgs_SeqOp_DEFAULT *rpc.ISource
+func (p *IGeneralModuleAggregateByKeyArgs) GetSeqOp() *rpc.ISource {
+  if !p.IsSetSeqOp() {
+    return IGeneralModuleAggregateByKeyArgs_SeqOp_DEFAULT
+  }
+return p.SeqOp
+}
 
-func (p *IGeneralModulePartitionByRandomArgs) GetNumPartitions() int64 {
+func (p *IGeneralModuleAggregateByKeyArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
+func (p *IGeneralModuleAggregateByKeyArgs) IsSetZero() bool {
+  return p.Zero != nil
+}
 
-func (p *IGeneralModulePartitionByRandomArgs) GetSeed() int32 {
-  return p.Seed
+func (p *IGeneralModuleAggregateByKeyArgs) IsSetSeqOp() bool {
+  return p.SeqOp != nil
 }
-func (p *IGeneralModulePartitionByRandomArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IGeneralModuleAggregateByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8980,7 +19368,7 @@ func (p *IGeneralModulePartitionByRandomArgs) Read(ctx context.Context, iprot th
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -8990,7 +19378,7 @@ func (p *IGeneralModulePartitionByRandomArgs) Read(ctx context.Context, iprot th
         }
       }
     case 2:
-      if fieldTypeId == thrift.I32 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -8999,6 +19387,16 @@ func (p *IGeneralModulePartitionByRandomArgs) Read(ctx context.Context, iprot th
           return err
         }
       }
+    case 3:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -9014,30 +19412,44 @@ func (p *IGeneralModulePartitionByRandomArgs) Read(ctx context.Context, iprot th
   return nil
 }
 
-func (p *IGeneralModulePartitionByRandomArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.NumPartitions = v
+func (p *IGeneralModuleAggregateByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Zero = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Zero.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Zero), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleAggregateByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.SeqOp = &rpc.ISource{
+  Params: map[string][]byte{
+  },
 }
+  if err := p.SeqOp.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SeqOp), err)
+  }
   return nil
 }
 
-func (p *IGeneralModulePartitionByRandomArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+func (p *IGeneralModuleAggregateByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Seed = v
+  p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModulePartitionByRandomArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionByRandom_args"); err != nil {
+func (p *IGeneralModuleAggregateByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "aggregateByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -9046,55 +19458,67 @@ func (p *IGeneralModulePartitionByRandomArgs) Write(ctx context.Context, oprot t
   return nil
 }
 
-func (p *IGeneralModulePartitionByRandomArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
+func (p *IGeneralModuleAggregateByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "zero", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:zero: ", p), err) }
+  if err := p.Zero.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Zero), err)
+  }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:zero: ", p), err) }
   return err
 }
 
-func (p *IGeneralModulePartitionByRandomArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "seed", thrift.I32, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seed: ", p), err) }
-  if err := oprot.WriteI32(ctx, int32(p.Seed)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.seed (2) field write error: ", p), err) }
+func (p *IGeneralModuleAggregateByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "seqOp", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seqOp: ", p), err) }
+  if err := p.SeqOp.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SeqOp), err)
+  }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seed: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seqOp: ", p), err) }
   return err
 }
 
-func (p *IGeneralModulePartitionByRandomArgs) String() string {
+func (p *IGeneralModuleAggregateByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleAggregateByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModulePartitionByRandomArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleAggregateByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModulePartitionByRandomResult struct {
+type IGeneralModuleAggregateByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModulePartitionByRandomResult() *IGeneralModulePartitionByRandomResult {
-  return &IGeneralModulePartitionByRandomResult{}
+func NewIGeneralModuleAggregateByKeyResult() *IGeneralModuleAggregateByKeyResult {
+  return &IGeneralModuleAggregateByKeyResult{}
 }
 
-var IGeneralModulePartitionByRandomResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModulePartitionByRandomResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleAggregateByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleAggregateByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModulePartitionByRandomResult_Ex_DEFAULT
+    return IGeneralModuleAggregateByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModulePartitionByRandomResult) IsSetEx() bool {
+func (p *IGeneralModuleAggregateByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModulePartitionByRandomResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleAggregateByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9132,7 +19556,7 @@ func (p *IGeneralModulePartitionByRandomResult) Read(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModulePartitionByRandomResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleAggregateByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -9140,8 +19564,8 @@ func (p *IGeneralModulePartitionByRandomResult)  ReadField1(ctx context.Context,
   return nil
 }
 
-func (p *IGeneralModulePartitionByRandomResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionByRandom_result"); err != nil {
+func (p *IGeneralModuleAggregateByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "aggregateByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -9153,7 +19577,7 @@ func (p *IGeneralModulePartitionByRandomResult) Write(ctx context.Context, oprot
   return nil
 }
 
-func (p *IGeneralModulePartitionByRandomResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleAggregateByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -9166,28 +19590,67 @@ func (p *IGeneralModulePartitionByRandomResult) writeField1(ctx context.Context,
   return err
 }
 
-func (p *IGeneralModulePartitionByRandomResult) String() string {
+func (p *IGeneralModuleAggregateByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModulePartitionByRandomResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleAggregateByKeyResult(%+v)", *p)
 }
 
 // Attributes:
+//  - Zero
+//  - SeqOp
+//  - CombOp
 //  - NumPartitions
-type IGeneralModulePartitionByHashArgs struct {
-  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+type IGeneralModuleAggregateByKey4Args struct {
+  Zero *rpc.ISource `thrift:"zero,1" db:"zero" json:"zero"`
+  SeqOp *rpc.ISource `thrift:"seqOp,2" db:"seqOp" json:"seqOp"`
+  CombOp *rpc.ISource `thrift:"combOp,3" db:"combOp" json:"combOp"`
+  NumPartitions int64 `thrift:"numPartitions,4" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModulePartitionByHashArgs() *IGeneralModulePartitionByHashArgs {
-  return &IGeneralModulePartitionByHashArgs{}
+func NewIGeneralModuleAggregateByKey4Args() *IGeneralModuleAggregateByKey4Args {
+  return &IGeneralModuleAggregateByKey4Args{}
 }
 
+var IGeneralModuleAggregateByKey4Args_Zero_DEFAULT *rpc.ISource
+func (p *IGeneralModuleAggregateByKey4Args) GetZero() *rpc.ISource {
+  if !p.IsSetZero() {
+    return IGeneralModuleAggregateByKey4Args_Zero_DEFAULT
+  }
+return p.Zero
+}
+var IGeneralModuleAggregateByKey4Args_SeqOp_DEFAULT *rpc.ISource
+func (p *IGeneralModuleAggregateByKey4Args) GetSeqOp() *rpc.ISource {
+  if !p.IsSetSeqOp() {
+    return IGeneralModuleAggregateByKey4Args_SeqOp_DEFAULT
+  }
+return p.SeqOp
+}
+var IGeneralModuleAggregateByKey4Args_CombOp_DEFAULT *rpc.ISource
+func (p *IGeneralModuleAggregateByKey4Args) GetCombOp() *rpc.ISource {
+  if !p.IsSetCombOp() {
+    return IGeneralModuleAggregateByKey4Args_CombOp_DEFAULT
+  }
+return p.CombOp
+}
 
-func (p *IGeneralModulePartitionByHashArgs) GetNumPartitions() int64 {
+func (p *IGeneralModuleAggregateByKey4Args) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-func (p *IGeneralModulePartitionByHashArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleAggregateByKey4Args) IsSetZero() bool {
+  return p.Zero != nil
+}
+
+func (p *IGeneralModuleAggregateByKey4Args) IsSetSeqOp() bool {
+  return p.SeqOp != nil
+}
+
+func (p *IGeneralModuleAggregateByKey4Args) IsSetCombOp() bool {
+  return p.CombOp != nil
+}
+
+func (p *IGeneralModuleAggregateByKey4Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9201,7 +19664,7 @@ func (p *IGeneralModulePartitionByHashArgs) Read(ctx context.Context, iprot thri
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -9210,6 +19673,36 @@ func (p *IGeneralModulePartitionByHashArgs) Read(ctx context.Context, iprot thri
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField4(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -9225,67 +19718,136 @@ func (p *IGeneralModulePartitionByHashArgs) Read(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModulePartitionByHashArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleAggregateByKey4Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Zero = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Zero.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Zero), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleAggregateByKey4Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.SeqOp = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.SeqOp.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SeqOp), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleAggregateByKey4Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  p.CombOp = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.CombOp.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CombOp), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleAggregateByKey4Args)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
+  return thrift.PrependError("error reading field 4: ", err)
 } else {
   p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModulePartitionByHashArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionByHash_args"); err != nil {
+func (p *IGeneralModuleAggregateByKey4Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "aggregateByKey4_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModuleAggregateByKey4Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "zero", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:zero: ", p), err) }
+  if err := p.Zero.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Zero), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:zero: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleAggregateByKey4Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "seqOp", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seqOp: ", p), err) }
+  if err := p.SeqOp.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SeqOp), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seqOp: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleAggregateByKey4Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "combOp", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:combOp: ", p), err) }
+  if err := p.CombOp.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CombOp), err)
   }
-  if err := oprot.WriteFieldStop(ctx); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(ctx); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:combOp: ", p), err) }
+  return err
 }
 
-func (p *IGeneralModulePartitionByHashArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+func (p *IGeneralModuleAggregateByKey4Args) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModulePartitionByHashArgs) String() string {
+func (p *IGeneralModuleAggregateByKey4Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModulePartitionByHashArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleAggregateByKey4Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModulePartitionByHashResult struct {
+type IGeneralModuleAggregateByKey4Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModulePartitionByHashResult() *IGeneralModulePartitionByHashResult {
-  return &IGeneralModulePartitionByHashResult{}
+func NewIGeneralModuleAggregateByKey4Result() *IGeneralModuleAggregateByKey4Result {
+  return &IGeneralModuleAggregateByKey4Result{}
 }
 
-var IGeneralModulePartitionByHashResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModulePartitionByHashResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleAggregateByKey4Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleAggregateByKey4Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModulePartitionByHashResult_Ex_DEFAULT
+    return IGeneralModuleAggregateByKey4Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModulePartitionByHashResult) IsSetEx() bool {
+func (p *IGeneralModuleAggregateByKey4Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModulePartitionByHashResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleAggregateByKey4Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9323,7 +19885,7 @@ func (p *IGeneralModulePartitionByHashResult) Read(ctx context.Context, iprot th
   return nil
 }
 
-func (p *IGeneralModulePartitionByHashResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleAggregateByKey4Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -9331,8 +19893,8 @@ func (p *IGeneralModulePartitionByHashResult)  ReadField1(ctx context.Context, i
   return nil
 }
 
-func (p *IGeneralModulePartitionByHashResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionByHash_result"); err != nil {
+func (p *IGeneralModuleAggregateByKey4Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "aggregateByKey4_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -9344,7 +19906,7 @@ func (p *IGeneralModulePartitionByHashResult) Write(ctx context.Context, oprot t
   return nil
 }
 
-func (p *IGeneralModulePartitionByHashResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleAggregateByKey4Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -9357,41 +19919,60 @@ func (p *IGeneralModulePartitionByHashResult) writeField1(ctx context.Context, o
   return err
 }
 
-func (p *IGeneralModulePartitionByHashResult) String() string {
+func (p *IGeneralModuleAggregateByKey4Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModulePartitionByHashResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleAggregateByKey4Result(%+v)", *p)
 }
 
 // Attributes:
+//  - Zero
 //  - Src
 //  - NumPartitions
-type IGeneralModulePartitionByArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
-  NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+//  - LocalFold
+type IGeneralModuleFoldByKeyArgs struct {
+  Zero *rpc.ISource `thrift:"zero,1" db:"zero" json:"zero"`
+  Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
+  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
+  LocalFold bool `thrift:"localFold,4" db:"localFold" json:"localFold"`
 }
 
-func NewIGeneralModulePartitionByArgs() *IGeneralModulePartitionByArgs {
-  return &IGeneralModulePartitionByArgs{}
+func NewIGeneralModuleFoldByKeyArgs() *IGeneralModuleFoldByKeyArgs {
+  return &IGeneralModuleFoldByKeyArgs{}
 }
 
-var IGeneralModulePartitionByArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModulePartitionByArgs) GetSrc() *rpc.ISource {
+var IGeneralModuleFoldByKeyArgs_Zero_DEFAULT *rpc.ISource
+func (p *IGeneralModuleFoldByKeyArgs) GetZero() *rpc.ISource {
+  if !p.IsSetZero() {
+    return IGeneralModuleFoldByKeyArgs_Zero_DEFAULT
+  }
+return p.Zero
+}
+var IGeneralModuleFoldByKeyArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleFoldByKeyArgs) GetSrc() *rpc.ISource {
   if !p.IsSetSrc() {
-    return IGeneralModulePartitionByArgs_Src_DEFAULT
+    return IGeneralModuleFoldByKeyArgs_Src_DEFAULT
   }
 return p.Src
 }
 
-func (p *IGeneralModulePartitionByArgs) GetNumPartitions() int64 {
+func (p *IGeneralModuleFoldByKeyArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-func (p *IGeneralModulePartitionByArgs) IsSetSrc() bool {
+
+func (p *IGeneralModuleFoldByKeyArgs) GetLocalFold() bool {
+  return p.LocalFold
+}
+func (p *IGeneralModuleFoldByKeyArgs) IsSetZero() bool {
+  return p.Zero != nil
+}
+
+func (p *IGeneralModuleFoldByKeyArgs) IsSetSrc() bool {
   return p.Src != nil
 }
 
-func (p *IGeneralModulePartitionByArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFoldByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9415,7 +19996,7 @@ func (p *IGeneralModulePartitionByArgs) Read(ctx context.Context, iprot thrift.T
         }
       }
     case 2:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -9424,6 +20005,26 @@ func (p *IGeneralModulePartitionByArgs) Read(ctx context.Context, iprot thrift.T
           return err
         }
       }
+    case 3:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField4(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -9439,7 +20040,18 @@ func (p *IGeneralModulePartitionByArgs) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModulePartitionByArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFoldByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Zero = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Zero.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Zero), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleFoldByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
@@ -9450,21 +20062,32 @@ func (p *IGeneralModulePartitionByArgs)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModulePartitionByArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFoldByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+  return thrift.PrependError("error reading field 3: ", err)
 } else {
   p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModulePartitionByArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionBy_args"); err != nil {
+func (p *IGeneralModuleFoldByKeyArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.LocalFold = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleFoldByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "foldByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -9473,56 +20096,77 @@ func (p *IGeneralModulePartitionByArgs) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModulePartitionByArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+func (p *IGeneralModuleFoldByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "zero", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:zero: ", p), err) }
+  if err := p.Zero.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Zero), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:zero: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleFoldByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
   if err := p.Src.Write(ctx, oprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModulePartitionByArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
+func (p *IGeneralModuleFoldByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (2) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModulePartitionByArgs) String() string {
+func (p *IGeneralModuleFoldByKeyArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "localFold", thrift.BOOL, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:localFold: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.LocalFold)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.localFold (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:localFold: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleFoldByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModulePartitionByArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleFoldByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModulePartitionByResult struct {
+type IGeneralModuleFoldByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModulePartitionByResult() *IGeneralModulePartitionByResult {
-  return &IGeneralModulePartitionByResult{}
+func NewIGeneralModuleFoldByKeyResult() *IGeneralModuleFoldByKeyResult {
+  return &IGeneralModuleFoldByKeyResult{}
 }
 
-var IGeneralModulePartitionByResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModulePartitionByResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleFoldByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleFoldByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModulePartitionByResult_Ex_DEFAULT
+    return IGeneralModuleFoldByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModulePartitionByResult) IsSetEx() bool {
+func (p *IGeneralModuleFoldByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModulePartitionByResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFoldByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9560,7 +20204,7 @@ func (p *IGeneralModulePartitionByResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModulePartitionByResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleFoldByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -9568,8 +20212,8 @@ func (p *IGeneralModulePartitionByResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModulePartitionByResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionBy_result"); err != nil {
+func (p *IGeneralModuleFoldByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "foldByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -9581,7 +20225,7 @@ func (p *IGeneralModulePartitionByResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModulePartitionByResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleFoldByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -9594,35 +20238,67 @@ func (p *IGeneralModulePartitionByResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModulePartitionByResult) String() string {
+func (p *IGeneralModuleFoldByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModulePartitionByResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleFoldByKeyResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-type IGeneralModuleFlatMapValuesArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - Key
+//  - Column
+//  - Agg
+//  - NumPartitions
+type IGeneralModulePivotArgs struct {
+  Key *rpc.ISource `thrift:"key,1" db:"key" json:"key"`
+  Column *rpc.ISource `thrift:"column,2" db:"column" json:"column"`
+  Agg *rpc.ISource `thrift:"agg,3" db:"agg" json:"agg"`
+  NumPartitions int64 `thrift:"numPartitions,4" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleFlatMapValuesArgs() *IGeneralModuleFlatMapValuesArgs {
-  return &IGeneralModuleFlatMapValuesArgs{}
+func NewIGeneralModulePivotArgs() *IGeneralModulePivotArgs {
+  return &IGeneralModulePivotArgs{}
 }
 
-var IGeneralModuleFlatMapValuesArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleFlatMapValuesArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleFlatMapValuesArgs_Src_DEFAULT
+var IGeneralModulePivotArgs_Key_DEFAULT *rpc.ISource
+func (p *IGeneralModulePivotArgs) GetKey() *rpc.ISource {
+  if !p.IsSetKey() {
+    return IGeneralModulePivotArgs_Key_DEFAULT
   }
-return p.Src
+return p.Key
 }
-func (p *IGeneralModuleFlatMapValuesArgs) IsSetSrc() bool {
-  return p.Src != nil
+var IGeneralModulePivotArgs_Column_DEFAULT *rpc.ISource
+func (p *IGeneralModulePivotArgs) GetColumn() *rpc.ISource {
+  if !p.IsSetColumn() {
+    return IGeneralModulePivotArgs_Column_DEFAULT
+  }
+return p.Column
+}
+var IGeneralModulePivotArgs_Agg_DEFAULT *rpc.ISource
+func (p *IGeneralModulePivotArgs) GetAgg() *rpc.ISource {
+  if !p.IsSetAgg() {
+    return IGeneralModulePivotArgs_Agg_DEFAULT
+  }
+return p.Agg
 }
 
-func (p *IGeneralModuleFlatMapValuesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePivotArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModulePivotArgs) IsSetKey() bool {
+  return p.Key != nil
+}
+
+func (p *IGeneralModulePivotArgs) IsSetColumn() bool {
+  return p.Column != nil
+}
+
+func (p *IGeneralModulePivotArgs) IsSetAgg() bool {
+  return p.Agg != nil
+}
+
+func (p *IGeneralModulePivotArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9637,7 +20313,37 @@ func (p *IGeneralModuleFlatMapValuesArgs) Read(ctx context.Context, iprot thrift
     switch fieldId {
     case 1:
       if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField1(ctx, iprot); err != nil {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField4(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -9660,22 +20366,56 @@ func (p *IGeneralModuleFlatMapValuesArgs) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleFlatMapValuesArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
+func (p *IGeneralModulePivotArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Key = &rpc.ISource{
   Params: map[string][]byte{
   },
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  if err := p.Key.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Key), err)
   }
   return nil
 }
 
-func (p *IGeneralModuleFlatMapValuesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "flatMapValues_args"); err != nil {
+func (p *IGeneralModulePivotArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Column = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Column.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Column), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePivotArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Agg = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Agg.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Agg), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePivotArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModulePivotArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "pivot_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -9684,46 +20424,91 @@ func (p *IGeneralModuleFlatMapValuesArgs) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleFlatMapValuesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+func (p *IGeneralModulePivotArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "key", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:key: ", p), err) }
+  if err := p.Key.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Key), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:key: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleFlatMapValuesArgs) String() string {
+func (p *IGeneralModulePivotArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "column", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:column: ", p), err) }
+  if err := p.Column.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Column), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:column: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePivotArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "agg", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:agg: ", p), err) }
+  if err := p.Agg.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Agg), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:agg: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePivotArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModulePivotArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFlatMapValuesArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePivotArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - Ex
-type IGeneralModuleFlatMapValuesResult struct {
+type IGeneralModulePivotResult struct {
+  Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleFlatMapValuesResult() *IGeneralModuleFlatMapValuesResult {
-  return &IGeneralModuleFlatMapValuesResult{}
+func NewIGeneralModulePivotResult() *IGeneralModulePivotResult {
+  return &IGeneralModulePivotResult{}
 }
 
-var IGeneralModuleFlatMapValuesResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleFlatMapValuesResult) GetEx() *rpc.IExecutorException {
+var IGeneralModulePivotResult_Success_DEFAULT string
+func (p *IGeneralModulePivotResult) GetSuccess() string {
+  if !p.IsSetSuccess() {
+    return IGeneralModulePivotResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IGeneralModulePivotResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePivotResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleFlatMapValuesResult_Ex_DEFAULT
+    return IGeneralModulePivotResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleFlatMapValuesResult) IsSetEx() bool {
+func (p *IGeneralModulePivotResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IGeneralModulePivotResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleFlatMapValuesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePivotResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9736,6 +20521,16 @@ func (p *IGeneralModuleFlatMapValuesResult) Read(ctx context.Context, iprot thri
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     case 1:
       if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
@@ -9761,7 +20556,16 @@ func (p *IGeneralModuleFlatMapValuesResult) Read(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleFlatMapValuesResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePivotResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *IGeneralModulePivotResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -9769,10 +20573,11 @@ func (p *IGeneralModuleFlatMapValuesResult)  ReadField1(ctx context.Context, ipr
   return nil
 }
 
-func (p *IGeneralModuleFlatMapValuesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "flatMapValues_result"); err != nil {
+func (p *IGeneralModulePivotResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "pivot_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
@@ -9782,7 +20587,19 @@ func (p *IGeneralModuleFlatMapValuesResult) Write(ctx context.Context, oprot thr
   return nil
 }
 
-func (p *IGeneralModuleFlatMapValuesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePivotResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRING, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteString(ctx, string(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModulePivotResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -9795,35 +20612,41 @@ func (p *IGeneralModuleFlatMapValuesResult) writeField1(ctx context.Context, opr
   return err
 }
 
-func (p *IGeneralModuleFlatMapValuesResult) String() string {
+func (p *IGeneralModulePivotResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFlatMapValuesResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePivotResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Src
-type IGeneralModuleMapValuesArgs struct {
+//  - Columns
+type IGeneralModuleUnpivotArgs struct {
   Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Columns string `thrift:"columns,2" db:"columns" json:"columns"`
 }
 
-func NewIGeneralModuleMapValuesArgs() *IGeneralModuleMapValuesArgs {
-  return &IGeneralModuleMapValuesArgs{}
+func NewIGeneralModuleUnpivotArgs() *IGeneralModuleUnpivotArgs {
+  return &IGeneralModuleUnpivotArgs{}
 }
 
-var IGeneralModuleMapValuesArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleMapValuesArgs) GetSrc() *rpc.ISource {
+var IGeneralModuleUnpivotArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleUnpivotArgs) GetSrc() *rpc.ISource {
   if !p.IsSetSrc() {
-    return IGeneralModuleMapValuesArgs_Src_DEFAULT
+    return IGeneralModuleUnpivotArgs_Src_DEFAULT
   }
 return p.Src
 }
-func (p *IGeneralModuleMapValuesArgs) IsSetSrc() bool {
+
+func (p *IGeneralModuleUnpivotArgs) GetColumns() string {
+  return p.Columns
+}
+func (p *IGeneralModuleUnpivotArgs) IsSetSrc() bool {
   return p.Src != nil
 }
 
-func (p *IGeneralModuleMapValuesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleUnpivotArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9846,6 +20669,16 @@ func (p *IGeneralModuleMapValuesArgs) Read(ctx context.Context, iprot thrift.TPr
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -9861,7 +20694,7 @@ func (p *IGeneralModuleMapValuesArgs) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleMapValuesArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleUnpivotArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
@@ -9872,11 +20705,21 @@ func (p *IGeneralModuleMapValuesArgs)  ReadField1(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IGeneralModuleMapValuesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapValues_args"); err != nil {
+func (p *IGeneralModuleUnpivotArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Columns = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleUnpivotArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "unpivot_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -9885,7 +20728,7 @@ func (p *IGeneralModuleMapValuesArgs) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleMapValuesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleUnpivotArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
   if err := p.Src.Write(ctx, oprot); err != nil {
@@ -9896,35 +20739,45 @@ func (p *IGeneralModuleMapValuesArgs) writeField1(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IGeneralModuleMapValuesArgs) String() string {
+func (p *IGeneralModuleUnpivotArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "columns", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:columns: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Columns)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.columns (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:columns: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleUnpivotArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapValuesArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleUnpivotArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleMapValuesResult struct {
+type IGeneralModuleUnpivotResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleMapValuesResult() *IGeneralModuleMapValuesResult {
-  return &IGeneralModuleMapValuesResult{}
+func NewIGeneralModuleUnpivotResult() *IGeneralModuleUnpivotResult {
+  return &IGeneralModuleUnpivotResult{}
 }
 
-var IGeneralModuleMapValuesResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleMapValuesResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleUnpivotResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleUnpivotResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleMapValuesResult_Ex_DEFAULT
+    return IGeneralModuleUnpivotResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleMapValuesResult) IsSetEx() bool {
+func (p *IGeneralModuleUnpivotResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleMapValuesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleUnpivotResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9962,7 +20815,7 @@ func (p *IGeneralModuleMapValuesResult) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleMapValuesResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleUnpivotResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -9970,8 +20823,8 @@ func (p *IGeneralModuleMapValuesResult)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModuleMapValuesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "mapValues_result"); err != nil {
+func (p *IGeneralModuleUnpivotResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "unpivot_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -9983,7 +20836,7 @@ func (p *IGeneralModuleMapValuesResult) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleMapValuesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleUnpivotResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -9996,28 +20849,48 @@ func (p *IGeneralModuleMapValuesResult) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IGeneralModuleMapValuesResult) String() string {
+func (p *IGeneralModuleUnpivotResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleMapValuesResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleUnpivotResult(%+v)", *p)
 }
 
 // Attributes:
-//  - NumPartitions
-type IGeneralModuleGroupByKeyArgs struct {
-  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+//  - Zero
+//  - Src
+type IGeneralModuleScanArgs struct {
+  Zero *rpc.ISource `thrift:"zero,1" db:"zero" json:"zero"`
+  Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
 }
 
-func NewIGeneralModuleGroupByKeyArgs() *IGeneralModuleGroupByKeyArgs {
-  return &IGeneralModuleGroupByKeyArgs{}
+func NewIGeneralModuleScanArgs() *IGeneralModuleScanArgs {
+  return &IGeneralModuleScanArgs{}
 }
 
+var IGeneralModuleScanArgs_Zero_DEFAULT *rpc.ISource
+func (p *IGeneralModuleScanArgs) GetZero() *rpc.ISource {
+  if !p.IsSetZero() {
+    return IGeneralModuleScanArgs_Zero_DEFAULT
+  }
+return p.Zero
+}
+var IGeneralModuleScanArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleScanArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleScanArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IGeneralModuleScanArgs) IsSetZero() bool {
+  return p.Zero != nil
+}
 
-func (p *IGeneralModuleGroupByKeyArgs) GetNumPartitions() int64 {
-  return p.NumPartitions
+func (p *IGeneralModuleScanArgs) IsSetSrc() bool {
+  return p.Src != nil
 }
-func (p *IGeneralModuleGroupByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IGeneralModuleScanArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10031,7 +20904,7 @@ func (p *IGeneralModuleGroupByKeyArgs) Read(ctx context.Context, iprot thrift.TP
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -10040,6 +20913,16 @@ func (p *IGeneralModuleGroupByKeyArgs) Read(ctx context.Context, iprot thrift.TP
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -10055,20 +20938,34 @@ func (p *IGeneralModuleGroupByKeyArgs) Read(ctx context.Context, iprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleGroupByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.NumPartitions = v
+func (p *IGeneralModuleScanArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Zero = &rpc.ISource{
+  Params: map[string][]byte{
+  },
 }
+  if err := p.Zero.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Zero), err)
+  }
   return nil
 }
 
-func (p *IGeneralModuleGroupByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "groupByKey_args"); err != nil {
+func (p *IGeneralModuleScanArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleScanArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "scan_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -10077,45 +20974,57 @@ func (p *IGeneralModuleGroupByKeyArgs) Write(ctx context.Context, oprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleGroupByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
+func (p *IGeneralModuleScanArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "zero", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:zero: ", p), err) }
+  if err := p.Zero.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Zero), err)
+  }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:zero: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleGroupByKeyArgs) String() string {
+func (p *IGeneralModuleScanArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:src: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleScanArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleGroupByKeyArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleScanArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleGroupByKeyResult struct {
+type IGeneralModuleScanResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleGroupByKeyResult() *IGeneralModuleGroupByKeyResult {
-  return &IGeneralModuleGroupByKeyResult{}
+func NewIGeneralModuleScanResult() *IGeneralModuleScanResult {
+  return &IGeneralModuleScanResult{}
 }
 
-var IGeneralModuleGroupByKeyResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleGroupByKeyResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleScanResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleScanResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleGroupByKeyResult_Ex_DEFAULT
+    return IGeneralModuleScanResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleGroupByKeyResult) IsSetEx() bool {
+func (p *IGeneralModuleScanResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleGroupByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleScanResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10153,7 +21062,7 @@ func (p *IGeneralModuleGroupByKeyResult) Read(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleGroupByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleScanResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -10161,8 +21070,8 @@ func (p *IGeneralModuleGroupByKeyResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleGroupByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "groupByKey_result"); err != nil {
+func (p *IGeneralModuleScanResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "scan_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -10174,7 +21083,7 @@ func (p *IGeneralModuleGroupByKeyResult) Write(ctx context.Context, oprot thrift
   return nil
 }
 
-func (p *IGeneralModuleGroupByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleScanResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -10187,41 +21096,28 @@ func (p *IGeneralModuleGroupByKeyResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleGroupByKeyResult) String() string {
+func (p *IGeneralModuleScanResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleGroupByKeyResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleScanResult(%+v)", *p)
 }
 
 // Attributes:
-//  - NumPartitions
-//  - Src
-type IGeneralModuleGroupByKey2Args struct {
-  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
-  Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
+//  - Ascending
+type IGeneralModuleSortByKeyArgs struct {
+  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
 }
 
-func NewIGeneralModuleGroupByKey2Args() *IGeneralModuleGroupByKey2Args {
-  return &IGeneralModuleGroupByKey2Args{}
+func NewIGeneralModuleSortByKeyArgs() *IGeneralModuleSortByKeyArgs {
+  return &IGeneralModuleSortByKeyArgs{}
 }
 
 
-func (p *IGeneralModuleGroupByKey2Args) GetNumPartitions() int64 {
-  return p.NumPartitions
-}
-var IGeneralModuleGroupByKey2Args_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleGroupByKey2Args) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleGroupByKey2Args_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IGeneralModuleGroupByKey2Args) IsSetSrc() bool {
-  return p.Src != nil
+func (p *IGeneralModuleSortByKeyArgs) GetAscending() bool {
+  return p.Ascending
 }
-
-func (p *IGeneralModuleGroupByKey2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10235,7 +21131,7 @@ func (p *IGeneralModuleGroupByKey2Args) Read(ctx context.Context, iprot thrift.T
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.BOOL {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -10244,16 +21140,6 @@ func (p *IGeneralModuleGroupByKey2Args) Read(ctx context.Context, iprot thrift.T
           return err
         }
       }
-    case 2:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -10269,32 +21155,20 @@ func (p *IGeneralModuleGroupByKey2Args) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleGroupByKey2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *IGeneralModuleSortByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.NumPartitions = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleGroupByKey2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+  p.Ascending = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleGroupByKey2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "groupByKey2_args"); err != nil {
+func (p *IGeneralModuleSortByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -10303,56 +21177,45 @@ func (p *IGeneralModuleGroupByKey2Args) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleGroupByKey2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleGroupByKey2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleSortByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleGroupByKey2Args) String() string {
+func (p *IGeneralModuleSortByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleGroupByKey2Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleGroupByKey2Result struct {
+type IGeneralModuleSortByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleGroupByKey2Result() *IGeneralModuleGroupByKey2Result {
-  return &IGeneralModuleGroupByKey2Result{}
+func NewIGeneralModuleSortByKeyResult() *IGeneralModuleSortByKeyResult {
+  return &IGeneralModuleSortByKeyResult{}
 }
 
-var IGeneralModuleGroupByKey2Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleGroupByKey2Result) GetEx() *rpc.IExecutorException {
+var IGeneralModuleSortByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSortByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleGroupByKey2Result_Ex_DEFAULT
+    return IGeneralModuleSortByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleGroupByKey2Result) IsSetEx() bool {
+func (p *IGeneralModuleSortByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleGroupByKey2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10390,7 +21253,7 @@ func (p *IGeneralModuleGroupByKey2Result) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleGroupByKey2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -10398,8 +21261,8 @@ func (p *IGeneralModuleGroupByKey2Result)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleGroupByKey2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "groupByKey2_result"); err != nil {
+func (p *IGeneralModuleSortByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -10411,7 +21274,7 @@ func (p *IGeneralModuleGroupByKey2Result) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleGroupByKey2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleSortByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -10424,47 +21287,34 @@ func (p *IGeneralModuleGroupByKey2Result) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleGroupByKey2Result) String() string {
+func (p *IGeneralModuleSortByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleGroupByKey2Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKeyResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
+//  - Ascending
 //  - NumPartitions
-//  - LocalReduce
-type IGeneralModuleReduceByKeyArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+type IGeneralModuleSortByKey2aArgs struct {
+  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
   NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
-  LocalReduce bool `thrift:"localReduce,3" db:"localReduce" json:"localReduce"`
 }
 
-func NewIGeneralModuleReduceByKeyArgs() *IGeneralModuleReduceByKeyArgs {
-  return &IGeneralModuleReduceByKeyArgs{}
+func NewIGeneralModuleSortByKey2aArgs() *IGeneralModuleSortByKey2aArgs {
+  return &IGeneralModuleSortByKey2aArgs{}
 }
 
-var IGeneralModuleReduceByKeyArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleReduceByKeyArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleReduceByKeyArgs_Src_DEFAULT
-  }
-return p.Src
-}
 
-func (p *IGeneralModuleReduceByKeyArgs) GetNumPartitions() int64 {
-  return p.NumPartitions
+func (p *IGeneralModuleSortByKey2aArgs) GetAscending() bool {
+  return p.Ascending
 }
 
-func (p *IGeneralModuleReduceByKeyArgs) GetLocalReduce() bool {
-  return p.LocalReduce
-}
-func (p *IGeneralModuleReduceByKeyArgs) IsSetSrc() bool {
-  return p.Src != nil
+func (p *IGeneralModuleSortByKey2aArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
 }
-
-func (p *IGeneralModuleReduceByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey2aArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10478,7 +21328,7 @@ func (p *IGeneralModuleReduceByKeyArgs) Read(ctx context.Context, iprot thrift.T
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.BOOL {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -10497,16 +21347,6 @@ func (p *IGeneralModuleReduceByKeyArgs) Read(ctx context.Context, iprot thrift.T
           return err
         }
       }
-    case 3:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField3(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -10522,18 +21362,16 @@ func (p *IGeneralModuleReduceByKeyArgs) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleReduceByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleSortByKey2aArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Ascending = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleReduceByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey2aArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
@@ -10542,22 +21380,12 @@ func (p *IGeneralModuleReduceByKeyArgs)  ReadField2(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModuleReduceByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
-} else {
-  p.LocalReduce = v
-}
-  return nil
-}
-
-func (p *IGeneralModuleReduceByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "reduceByKey_args"); err != nil {
+func (p *IGeneralModuleSortByKey2aArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey2a_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -10566,18 +21394,17 @@ func (p *IGeneralModuleReduceByKeyArgs) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleReduceByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IGeneralModuleSortByKey2aArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleReduceByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleSortByKey2aArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
@@ -10587,45 +21414,35 @@ func (p *IGeneralModuleReduceByKeyArgs) writeField2(ctx context.Context, oprot t
   return err
 }
 
-func (p *IGeneralModuleReduceByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "localReduce", thrift.BOOL, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:localReduce: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.LocalReduce)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.localReduce (3) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:localReduce: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleReduceByKeyArgs) String() string {
+func (p *IGeneralModuleSortByKey2aArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleReduceByKeyArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKey2aArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleReduceByKeyResult struct {
+type IGeneralModuleSortByKey2aResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleReduceByKeyResult() *IGeneralModuleReduceByKeyResult {
-  return &IGeneralModuleReduceByKeyResult{}
+func NewIGeneralModuleSortByKey2aResult() *IGeneralModuleSortByKey2aResult {
+  return &IGeneralModuleSortByKey2aResult{}
 }
 
-var IGeneralModuleReduceByKeyResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleReduceByKeyResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleSortByKey2aResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSortByKey2aResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleReduceByKeyResult_Ex_DEFAULT
+    return IGeneralModuleSortByKey2aResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleReduceByKeyResult) IsSetEx() bool {
+func (p *IGeneralModuleSortByKey2aResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleReduceByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey2aResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10663,7 +21480,7 @@ func (p *IGeneralModuleReduceByKeyResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleReduceByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey2aResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -10671,8 +21488,8 @@ func (p *IGeneralModuleReduceByKeyResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleReduceByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "reduceByKey_result"); err != nil {
+func (p *IGeneralModuleSortByKey2aResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey2a_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -10684,7 +21501,7 @@ func (p *IGeneralModuleReduceByKeyResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleReduceByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleSortByKey2aResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -10697,54 +21514,41 @@ func (p *IGeneralModuleReduceByKeyResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleReduceByKeyResult) String() string {
+func (p *IGeneralModuleSortByKey2aResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleReduceByKeyResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKey2aResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Zero
-//  - SeqOp
-//  - NumPartitions
-type IGeneralModuleAggregateByKeyArgs struct {
-  Zero *rpc.ISource `thrift:"zero,1" db:"zero" json:"zero"`
-  SeqOp *rpc.ISource `thrift:"seqOp,2" db:"seqOp" json:"seqOp"`
-  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
+//  - Src
+//  - Ascending
+type IGeneralModuleSortByKey2bArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
 }
 
-func NewIGeneralModuleAggregateByKeyArgs() *IGeneralModuleAggregateByKeyArgs {
-  return &IGeneralModuleAggregateByKeyArgs{}
+func NewIGeneralModuleSortByKey2bArgs() *IGeneralModuleSortByKey2bArgs {
+  return &IGeneralModuleSortByKey2bArgs{}
 }
 
-var IGeneralModuleAggregateByKeyArgs_Zero_DEFAULT *rpc.ISource
-func (p *IGeneralModuleAggregateByKeyArgs) GetZero() *rpc.ISource {
-  if !p.IsSetZero() {
-    return IGeneralModuleAggregateByKeyArgs_Zero_DEFAULT
-  }
-return p.Zero
-}
-var IGeneralModuleAggregateByKeyArgs_SeqOp_DEFAULT *rpc.ISource
-func (p *IGeneralModuleAggregateByKeyArgs) GetSeqOp() *rpc.ISource {
-  if !p.IsSetSeqOp() {
-    return IGeneralModuleAggregateByKeyArgs_SeqOp_DEFAULT
+var IGeneralModuleSortByKey2bArgs_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleSortByKey2bArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleSortByKey2bArgs_Src_DEFAULT
   }
-return p.SeqOp
+return p.Src
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs) GetNumPartitions() int64 {
-  return p.NumPartitions
-}
-func (p *IGeneralModuleAggregateByKeyArgs) IsSetZero() bool {
-  return p.Zero != nil
+func (p *IGeneralModuleSortByKey2bArgs) GetAscending() bool {
+  return p.Ascending
 }
-
-func (p *IGeneralModuleAggregateByKeyArgs) IsSetSeqOp() bool {
-  return p.SeqOp != nil
+func (p *IGeneralModuleSortByKey2bArgs) IsSetSrc() bool {
+  return p.Src != nil
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey2bArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10768,7 +21572,7 @@ func (p *IGeneralModuleAggregateByKeyArgs) Read(ctx context.Context, iprot thrif
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.BOOL {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -10777,16 +21581,6 @@ func (p *IGeneralModuleAggregateByKeyArgs) Read(ctx context.Context, iprot thrif
           return err
         }
       }
-    case 3:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField3(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -10802,44 +21596,32 @@ func (p *IGeneralModuleAggregateByKeyArgs) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Zero = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Zero.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Zero), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleAggregateByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  p.SeqOp = &rpc.ISource{
+func (p *IGeneralModuleSortByKey2bArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
 }
-  if err := p.SeqOp.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SeqOp), err)
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
   }
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
+func (p *IGeneralModuleSortByKey2bArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.NumPartitions = v
+  p.Ascending = v
 }
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "aggregateByKey_args"); err != nil {
+func (p *IGeneralModuleSortByKey2bArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey2b_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -10848,67 +21630,56 @@ func (p *IGeneralModuleAggregateByKeyArgs) Write(ctx context.Context, oprot thri
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "zero", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:zero: ", p), err) }
-  if err := p.Zero.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Zero), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:zero: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleAggregateByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "seqOp", thrift.STRUCT, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seqOp: ", p), err) }
-  if err := p.SeqOp.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SeqOp), err)
+func (p *IGeneralModuleSortByKey2bArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seqOp: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
+func (p *IGeneralModuleSortByKey2bArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleAggregateByKeyArgs) String() string {
+func (p *IGeneralModuleSortByKey2bArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleAggregateByKeyArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKey2bArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleAggregateByKeyResult struct {
+type IGeneralModuleSortByKey2bResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleAggregateByKeyResult() *IGeneralModuleAggregateByKeyResult {
-  return &IGeneralModuleAggregateByKeyResult{}
+func NewIGeneralModuleSortByKey2bResult() *IGeneralModuleSortByKey2bResult {
+  return &IGeneralModuleSortByKey2bResult{}
 }
 
-var IGeneralModuleAggregateByKeyResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleAggregateByKeyResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleSortByKey2bResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSortByKey2bResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleAggregateByKeyResult_Ex_DEFAULT
+    return IGeneralModuleSortByKey2bResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleAggregateByKeyResult) IsSetEx() bool {
+func (p *IGeneralModuleSortByKey2bResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleAggregateByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey2bResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10946,7 +21717,7 @@ func (p *IGeneralModuleAggregateByKeyResult) Read(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey2bResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -10954,8 +21725,8 @@ func (p *IGeneralModuleAggregateByKeyResult)  ReadField1(ctx context.Context, ip
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "aggregateByKey_result"); err != nil {
+func (p *IGeneralModuleSortByKey2bResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey2b_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -10967,7 +21738,7 @@ func (p *IGeneralModuleAggregateByKeyResult) Write(ctx context.Context, oprot th
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleSortByKey2bResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -10980,67 +21751,47 @@ func (p *IGeneralModuleAggregateByKeyResult) writeField1(ctx context.Context, op
   return err
 }
 
-func (p *IGeneralModuleAggregateByKeyResult) String() string {
+func (p *IGeneralModuleSortByKey2bResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleAggregateByKeyResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKey2bResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Zero
-//  - SeqOp
-//  - CombOp
+//  - Src
+//  - Ascending
 //  - NumPartitions
-type IGeneralModuleAggregateByKey4Args struct {
-  Zero *rpc.ISource `thrift:"zero,1" db:"zero" json:"zero"`
-  SeqOp *rpc.ISource `thrift:"seqOp,2" db:"seqOp" json:"seqOp"`
-  CombOp *rpc.ISource `thrift:"combOp,3" db:"combOp" json:"combOp"`
-  NumPartitions int64 `thrift:"numPartitions,4" db:"numPartitions" json:"numPartitions"`
+type IGeneralModuleSortByKey3Args struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
+  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleAggregateByKey4Args() *IGeneralModuleAggregateByKey4Args {
-  return &IGeneralModuleAggregateByKey4Args{}
+func NewIGeneralModuleSortByKey3Args() *IGeneralModuleSortByKey3Args {
+  return &IGeneralModuleSortByKey3Args{}
 }
 
-var IGeneralModuleAggregateByKey4Args_Zero_DEFAULT *rpc.ISource
-func (p *IGeneralModuleAggregateByKey4Args) GetZero() *rpc.ISource {
-  if !p.IsSetZero() {
-    return IGeneralModuleAggregateByKey4Args_Zero_DEFAULT
-  }
-return p.Zero
-}
-var IGeneralModuleAggregateByKey4Args_SeqOp_DEFAULT *rpc.ISource
-func (p *IGeneralModuleAggregateByKey4Args) GetSeqOp() *rpc.ISource {
-  if !p.IsSetSeqOp() {
-    return IGeneralModuleAggregateByKey4Args_SeqOp_DEFAULT
-  }
-return p.SeqOp
-}
-var IGeneralModuleAggregateByKey4Args_CombOp_DEFAULT *rpc.ISource
-func (p *IGeneralModuleAggregateByKey4Args) GetCombOp() *rpc.ISource {
-  if !p.IsSetCombOp() {
-    return IGeneralModuleAggregateByKey4Args_CombOp_DEFAULT
+var IGeneralModuleSortByKey3Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleSortByKey3Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleSortByKey3Args_Src_DEFAULT
   }
-return p.CombOp
+return p.Src
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) GetNumPartitions() int64 {
-  return p.NumPartitions
-}
-func (p *IGeneralModuleAggregateByKey4Args) IsSetZero() bool {
-  return p.Zero != nil
+func (p *IGeneralModuleSortByKey3Args) GetAscending() bool {
+  return p.Ascending
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) IsSetSeqOp() bool {
-  return p.SeqOp != nil
+func (p *IGeneralModuleSortByKey3Args) GetNumPartitions() int64 {
+  return p.NumPartitions
 }
-
-func (p *IGeneralModuleAggregateByKey4Args) IsSetCombOp() bool {
-  return p.CombOp != nil
+func (p *IGeneralModuleSortByKey3Args) IsSetSrc() bool {
+  return p.Src != nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11064,7 +21815,7 @@ func (p *IGeneralModuleAggregateByKey4Args) Read(ctx context.Context, iprot thri
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.BOOL {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -11074,18 +21825,8 @@ func (p *IGeneralModuleAggregateByKey4Args) Read(ctx context.Context, iprot thri
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField3(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 4:
       if fieldTypeId == thrift.I64 {
-        if err := p.ReadField4(ctx, iprot); err != nil {
+        if err := p.ReadField3(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -11108,56 +21849,42 @@ func (p *IGeneralModuleAggregateByKey4Args) Read(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Zero = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Zero.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Zero), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleAggregateByKey4Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  p.SeqOp = &rpc.ISource{
+func (p *IGeneralModuleSortByKey3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
 }
-  if err := p.SeqOp.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SeqOp), err)
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
   }
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  p.CombOp = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IGeneralModuleSortByKey3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Ascending = v
 }
-  if err := p.CombOp.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CombOp), err)
-  }
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Args)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 4: ", err)
+  return thrift.PrependError("error reading field 3: ", err)
 } else {
   p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "aggregateByKey4_args"); err != nil {
+func (p *IGeneralModuleSortByKey3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey3_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
     if err := p.writeField3(ctx, oprot); err != nil { return err }
-    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -11166,78 +21893,66 @@ func (p *IGeneralModuleAggregateByKey4Args) Write(ctx context.Context, oprot thr
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "zero", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:zero: ", p), err) }
-  if err := p.Zero.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Zero), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:zero: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleAggregateByKey4Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "seqOp", thrift.STRUCT, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seqOp: ", p), err) }
-  if err := p.SeqOp.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SeqOp), err)
+func (p *IGeneralModuleSortByKey3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seqOp: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "combOp", thrift.STRUCT, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:combOp: ", p), err) }
-  if err := p.CombOp.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CombOp), err)
-  }
+func (p *IGeneralModuleSortByKey3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:combOp: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:numPartitions: ", p), err) }
+func (p *IGeneralModuleSortByKey3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (4) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleAggregateByKey4Args) String() string {
+func (p *IGeneralModuleSortByKey3Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleAggregateByKey4Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKey3Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleAggregateByKey4Result struct {
+type IGeneralModuleSortByKey3Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleAggregateByKey4Result() *IGeneralModuleAggregateByKey4Result {
-  return &IGeneralModuleAggregateByKey4Result{}
+func NewIGeneralModuleSortByKey3Result() *IGeneralModuleSortByKey3Result {
+  return &IGeneralModuleSortByKey3Result{}
 }
 
-var IGeneralModuleAggregateByKey4Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleAggregateByKey4Result) GetEx() *rpc.IExecutorException {
+var IGeneralModuleSortByKey3Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleSortByKey3Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleAggregateByKey4Result_Ex_DEFAULT
+    return IGeneralModuleSortByKey3Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleAggregateByKey4Result) IsSetEx() bool {
+func (p *IGeneralModuleSortByKey3Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11275,7 +21990,7 @@ func (p *IGeneralModuleAggregateByKey4Result) Read(ctx context.Context, iprot th
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleSortByKey3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -11283,8 +21998,8 @@ func (p *IGeneralModuleAggregateByKey4Result)  ReadField1(ctx context.Context, i
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "aggregateByKey4_result"); err != nil {
+func (p *IGeneralModuleSortByKey3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sortByKey3_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -11296,7 +22011,7 @@ func (p *IGeneralModuleAggregateByKey4Result) Write(ctx context.Context, oprot t
   return nil
 }
 
-func (p *IGeneralModuleAggregateByKey4Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleSortByKey3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -11309,60 +22024,34 @@ func (p *IGeneralModuleAggregateByKey4Result) writeField1(ctx context.Context, o
   return err
 }
 
-func (p *IGeneralModuleAggregateByKey4Result) String() string {
+func (p *IGeneralModuleSortByKey3Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleAggregateByKey4Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleSortByKey3Result(%+v)", *p)
 }
 
 // Attributes:
-//  - Zero
-//  - Src
 //  - NumPartitions
-//  - LocalFold
-type IGeneralModuleFoldByKeyArgs struct {
-  Zero *rpc.ISource `thrift:"zero,1" db:"zero" json:"zero"`
-  Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
-  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
-  LocalFold bool `thrift:"localFold,4" db:"localFold" json:"localFold"`
+//  - Ascending
+type IGeneralModuleRepartitionAndSortWithinPartitionsArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
 }
 
-func NewIGeneralModuleFoldByKeyArgs() *IGeneralModuleFoldByKeyArgs {
-  return &IGeneralModuleFoldByKeyArgs{}
+func NewIGeneralModuleRepartitionAndSortWithinPartitionsArgs() *IGeneralModuleRepartitionAndSortWithinPartitionsArgs {
+  return &IGeneralModuleRepartitionAndSortWithinPartitionsArgs{}
 }
 
-var IGeneralModuleFoldByKeyArgs_Zero_DEFAULT *rpc.ISource
-func (p *IGeneralModuleFoldByKeyArgs) GetZero() *rpc.ISource {
-  if !p.IsSetZero() {
-    return IGeneralModuleFoldByKeyArgs_Zero_DEFAULT
-  }
-return p.Zero
-}
-var IGeneralModuleFoldByKeyArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleFoldByKeyArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleFoldByKeyArgs_Src_DEFAULT
-  }
-return p.Src
-}
 
-func (p *IGeneralModuleFoldByKeyArgs) GetNumPartitions() int64 {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs) GetNumPartitions() int64 {
   return p.NumPartitions
 }
 
-func (p *IGeneralModuleFoldByKeyArgs) GetLocalFold() bool {
-  return p.LocalFold
-}
-func (p *IGeneralModuleFoldByKeyArgs) IsSetZero() bool {
-  return p.Zero != nil
-}
-
-func (p *IGeneralModuleFoldByKeyArgs) IsSetSrc() bool {
-  return p.Src != nil
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs) GetAscending() bool {
+  return p.Ascending
 }
-
-func (p *IGeneralModuleFoldByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11376,7 +22065,7 @@ func (p *IGeneralModuleFoldByKeyArgs) Read(ctx context.Context, iprot thrift.TPr
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -11386,28 +22075,8 @@ func (p *IGeneralModuleFoldByKeyArgs) Read(ctx context.Context, iprot thrift.TPr
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField3(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 4:
       if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField4(ctx, iprot); err != nil {
+        if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -11430,54 +22099,30 @@ func (p *IGeneralModuleFoldByKeyArgs) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleFoldByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Zero = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Zero.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Zero), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleFoldByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleFoldByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
+  return thrift.PrependError("error reading field 1: ", err)
 } else {
   p.NumPartitions = v
 }
   return nil
 }
 
-func (p *IGeneralModuleFoldByKeyArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 4: ", err)
+  return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.LocalFold = v
+  p.Ascending = v
 }
   return nil
 }
 
-func (p *IGeneralModuleFoldByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "foldByKey_args"); err != nil {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "repartitionAndSortWithinPartitions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
-    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -11486,77 +22131,55 @@ func (p *IGeneralModuleFoldByKeyArgs) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleFoldByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "zero", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:zero: ", p), err) }
-  if err := p.Zero.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Zero), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:zero: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleFoldByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:src: ", p), err) }
-  return err
-}
-
-func (p *IGeneralModuleFoldByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleFoldByKeyArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "localFold", thrift.BOOL, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:localFold: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.LocalFold)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.localFold (4) field write error: ", p), err) }
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:localFold: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleFoldByKeyArgs) String() string {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFoldByKeyArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleRepartitionAndSortWithinPartitionsArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleFoldByKeyResult struct {
+type IGeneralModuleRepartitionAndSortWithinPartitionsResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleFoldByKeyResult() *IGeneralModuleFoldByKeyResult {
-  return &IGeneralModuleFoldByKeyResult{}
+func NewIGeneralModuleRepartitionAndSortWithinPartitionsResult() *IGeneralModuleRepartitionAndSortWithinPartitionsResult {
+  return &IGeneralModuleRepartitionAndSortWithinPartitionsResult{}
 }
 
-var IGeneralModuleFoldByKeyResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleFoldByKeyResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleRepartitionAndSortWithinPartitionsResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleFoldByKeyResult_Ex_DEFAULT
+    return IGeneralModuleRepartitionAndSortWithinPartitionsResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleFoldByKeyResult) IsSetEx() bool {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleFoldByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11594,7 +22217,7 @@ func (p *IGeneralModuleFoldByKeyResult) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleFoldByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -11602,8 +22225,8 @@ func (p *IGeneralModuleFoldByKeyResult)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModuleFoldByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "foldByKey_result"); err != nil {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "repartitionAndSortWithinPartitions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -11615,7 +22238,7 @@ func (p *IGeneralModuleFoldByKeyResult) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleFoldByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -11628,28 +22251,34 @@ func (p *IGeneralModuleFoldByKeyResult) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IGeneralModuleFoldByKeyResult) String() string {
+func (p *IGeneralModuleRepartitionAndSortWithinPartitionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleFoldByKeyResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleRepartitionAndSortWithinPartitionsResult(%+v)", *p)
 }
 
 // Attributes:
+//  - NumPartitions
 //  - Ascending
-type IGeneralModuleSortByKeyArgs struct {
-  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
+type IGeneralModuleGroupByKeyAndSortValuesArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
+  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
 }
 
-func NewIGeneralModuleSortByKeyArgs() *IGeneralModuleSortByKeyArgs {
-  return &IGeneralModuleSortByKeyArgs{}
+func NewIGeneralModuleGroupByKeyAndSortValuesArgs() *IGeneralModuleGroupByKeyAndSortValuesArgs {
+  return &IGeneralModuleGroupByKeyAndSortValuesArgs{}
 }
 
 
-func (p *IGeneralModuleSortByKeyArgs) GetAscending() bool {
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs) GetAscending() bool {
   return p.Ascending
 }
-func (p *IGeneralModuleSortByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11663,7 +22292,7 @@ func (p *IGeneralModuleSortByKeyArgs) Read(ctx context.Context, iprot thrift.TPr
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.BOOL {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -11672,6 +22301,16 @@ func (p *IGeneralModuleSortByKeyArgs) Read(ctx context.Context, iprot thrift.TPr
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -11687,20 +22326,30 @@ func (p *IGeneralModuleSortByKeyArgs) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IGeneralModuleSortByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
 } else {
   p.Ascending = v
 }
   return nil
 }
 
-func (p *IGeneralModuleSortByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey_args"); err != nil {
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKeyAndSortValues_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -11709,45 +22358,55 @@ func (p *IGeneralModuleSortByKeyArgs) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleSortByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
   if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortByKeyArgs) String() string {
+func (p *IGeneralModuleGroupByKeyAndSortValuesArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKeyArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKeyAndSortValuesArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleSortByKeyResult struct {
+type IGeneralModuleGroupByKeyAndSortValuesResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSortByKeyResult() *IGeneralModuleSortByKeyResult {
-  return &IGeneralModuleSortByKeyResult{}
+func NewIGeneralModuleGroupByKeyAndSortValuesResult() *IGeneralModuleGroupByKeyAndSortValuesResult {
+  return &IGeneralModuleGroupByKeyAndSortValuesResult{}
 }
 
-var IGeneralModuleSortByKeyResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSortByKeyResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleGroupByKeyAndSortValuesResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleGroupByKeyAndSortValuesResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSortByKeyResult_Ex_DEFAULT
+    return IGeneralModuleGroupByKeyAndSortValuesResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSortByKeyResult) IsSetEx() bool {
+func (p *IGeneralModuleGroupByKeyAndSortValuesResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSortByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyAndSortValuesResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11785,7 +22444,7 @@ func (p *IGeneralModuleSortByKeyResult) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleSortByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyAndSortValuesResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -11793,8 +22452,8 @@ func (p *IGeneralModuleSortByKeyResult)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModuleSortByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey_result"); err != nil {
+func (p *IGeneralModuleGroupByKeyAndSortValuesResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKeyAndSortValues_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -11806,7 +22465,7 @@ func (p *IGeneralModuleSortByKeyResult) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleSortByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKeyAndSortValuesResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -11819,34 +22478,47 @@ func (p *IGeneralModuleSortByKeyResult) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IGeneralModuleSortByKeyResult) String() string {
+func (p *IGeneralModuleGroupByKeyAndSortValuesResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKeyResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKeyAndSortValuesResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Ascending
+//  - Src
 //  - NumPartitions
-type IGeneralModuleSortByKey2aArgs struct {
-  Ascending bool `thrift:"ascending,1" db:"ascending" json:"ascending"`
+//  - Ascending
+type IGeneralModuleGroupByKeyAndSortValues3Args struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
   NumPartitions int64 `thrift:"numPartitions,2" db:"numPartitions" json:"numPartitions"`
+  Ascending bool `thrift:"ascending,3" db:"ascending" json:"ascending"`
 }
 
-func NewIGeneralModuleSortByKey2aArgs() *IGeneralModuleSortByKey2aArgs {
-  return &IGeneralModuleSortByKey2aArgs{}
+func NewIGeneralModuleGroupByKeyAndSortValues3Args() *IGeneralModuleGroupByKeyAndSortValues3Args {
+  return &IGeneralModuleGroupByKeyAndSortValues3Args{}
 }
 
-
-func (p *IGeneralModuleSortByKey2aArgs) GetAscending() bool {
-  return p.Ascending
+var IGeneralModuleGroupByKeyAndSortValues3Args_Src_DEFAULT *rpc.ISource
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IGeneralModuleGroupByKeyAndSortValues3Args_Src_DEFAULT
+  }
+return p.Src
 }
 
-func (p *IGeneralModuleSortByKey2aArgs) GetNumPartitions() int64 {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) GetNumPartitions() int64 {
   return p.NumPartitions
 }
-func (p *IGeneralModuleSortByKey2aArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) GetAscending() bool {
+  return p.Ascending
+}
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -11860,7 +22532,7 @@ func (p *IGeneralModuleSortByKey2aArgs) Read(ctx context.Context, iprot thrift.T
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.BOOL {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -11879,6 +22551,16 @@ func (p *IGeneralModuleSortByKey2aArgs) Read(ctx context.Context, iprot thrift.T
           return err
         }
       }
+    case 3:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -11894,16 +22576,18 @@ func (p *IGeneralModuleSortByKey2aArgs) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2aArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Ascending = v
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
 }
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2aArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
@@ -11912,12 +22596,22 @@ func (p *IGeneralModuleSortByKey2aArgs)  ReadField2(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2aArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey2a_args"); err != nil {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Ascending = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKeyAndSortValues3_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -11926,17 +22620,18 @@ func (p *IGeneralModuleSortByKey2aArgs) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2aArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ascending: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (1) field write error: ", p), err) }
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ascending: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortByKey2aArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 2); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:numPartitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
@@ -11946,35 +22641,45 @@ func (p *IGeneralModuleSortByKey2aArgs) writeField2(ctx context.Context, oprot t
   return err
 }
 
-func (p *IGeneralModuleSortByKey2aArgs) String() string {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:ascending: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ascending (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:ascending: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleGroupByKeyAndSortValues3Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKey2aArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKeyAndSortValues3Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleSortByKey2aResult struct {
+type IGeneralModuleGroupByKeyAndSortValues3Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSortByKey2aResult() *IGeneralModuleSortByKey2aResult {
-  return &IGeneralModuleSortByKey2aResult{}
+func NewIGeneralModuleGroupByKeyAndSortValues3Result() *IGeneralModuleGroupByKeyAndSortValues3Result {
+  return &IGeneralModuleGroupByKeyAndSortValues3Result{}
 }
 
-var IGeneralModuleSortByKey2aResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSortByKey2aResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleGroupByKeyAndSortValues3Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleGroupByKeyAndSortValues3Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSortByKey2aResult_Ex_DEFAULT
+    return IGeneralModuleGroupByKeyAndSortValues3Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSortByKey2aResult) IsSetEx() bool {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSortByKey2aResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12012,7 +22717,7 @@ func (p *IGeneralModuleSortByKey2aResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2aResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -12020,8 +22725,8 @@ func (p *IGeneralModuleSortByKey2aResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2aResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey2a_result"); err != nil {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "groupByKeyAndSortValues3_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -12033,7 +22738,7 @@ func (p *IGeneralModuleSortByKey2aResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2aResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -12046,41 +22751,60 @@ func (p *IGeneralModuleSortByKey2aResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleSortByKey2aResult) String() string {
+func (p *IGeneralModuleGroupByKeyAndSortValues3Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKey2aResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGroupByKeyAndSortValues3Result(%+v)", *p)
 }
 
 // Attributes:
-//  - Src
-//  - Ascending
-type IGeneralModuleSortByKey2bArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
-  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
+//  - Key
+//  - Seq
+//  - MaxGap
+//  - NumPartitions
+type IGeneralModuleGapsAndIslandsArgs struct {
+  Key *rpc.ISource `thrift:"key,1" db:"key" json:"key"`
+  Seq *rpc.ISource `thrift:"seq,2" db:"seq" json:"seq"`
+  MaxGap int64 `thrift:"maxGap,3" db:"maxGap" json:"maxGap"`
+  NumPartitions int64 `thrift:"numPartitions,4" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIGeneralModuleSortByKey2bArgs() *IGeneralModuleSortByKey2bArgs {
-  return &IGeneralModuleSortByKey2bArgs{}
+func NewIGeneralModuleGapsAndIslandsArgs() *IGeneralModuleGapsAndIslandsArgs {
+  return &IGeneralModuleGapsAndIslandsArgs{}
 }
 
-var IGeneralModuleSortByKey2bArgs_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleSortByKey2bArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleSortByKey2bArgs_Src_DEFAULT
+var IGeneralModuleGapsAndIslandsArgs_Key_DEFAULT *rpc.ISource
+func (p *IGeneralModuleGapsAndIslandsArgs) GetKey() *rpc.ISource {
+  if !p.IsSetKey() {
+    return IGeneralModuleGapsAndIslandsArgs_Key_DEFAULT
   }
-return p.Src
+return p.Key
+}
+var IGeneralModuleGapsAndIslandsArgs_Seq_DEFAULT *rpc.ISource
+func (p *IGeneralModuleGapsAndIslandsArgs) GetSeq() *rpc.ISource {
+  if !p.IsSetSeq() {
+    return IGeneralModuleGapsAndIslandsArgs_Seq_DEFAULT
+  }
+return p.Seq
 }
 
-func (p *IGeneralModuleSortByKey2bArgs) GetAscending() bool {
-  return p.Ascending
+func (p *IGeneralModuleGapsAndIslandsArgs) GetMaxGap() int64 {
+  return p.MaxGap
 }
-func (p *IGeneralModuleSortByKey2bArgs) IsSetSrc() bool {
-  return p.Src != nil
+
+func (p *IGeneralModuleGapsAndIslandsArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IGeneralModuleGapsAndIslandsArgs) IsSetKey() bool {
+  return p.Key != nil
 }
 
-func (p *IGeneralModuleSortByKey2bArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGapsAndIslandsArgs) IsSetSeq() bool {
+  return p.Seq != nil
+}
+
+func (p *IGeneralModuleGapsAndIslandsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12104,7 +22828,7 @@ func (p *IGeneralModuleSortByKey2bArgs) Read(ctx context.Context, iprot thrift.T
         }
       }
     case 2:
-      if fieldTypeId == thrift.BOOL {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -12113,6 +22837,26 @@ func (p *IGeneralModuleSortByKey2bArgs) Read(ctx context.Context, iprot thrift.T
           return err
         }
       }
+    case 3:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField4(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -12128,32 +22872,54 @@ func (p *IGeneralModuleSortByKey2bArgs) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2bArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
+func (p *IGeneralModuleGapsAndIslandsArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Key = &rpc.ISource{
   Params: map[string][]byte{
   },
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  if err := p.Key.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Key), err)
   }
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2bArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+func (p *IGeneralModuleGapsAndIslandsArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Seq = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Seq.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Seq), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModuleGapsAndIslandsArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Ascending = v
+  p.MaxGap = v
 }
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2bArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey2b_args"); err != nil {
+func (p *IGeneralModuleGapsAndIslandsArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IGeneralModuleGapsAndIslandsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "gapsAndIslands_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -12162,56 +22928,77 @@ func (p *IGeneralModuleSortByKey2bArgs) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2bArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+func (p *IGeneralModuleGapsAndIslandsArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "key", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:key: ", p), err) }
+  if err := p.Key.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Key), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:key: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortByKey2bArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
+func (p *IGeneralModuleGapsAndIslandsArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "seq", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:seq: ", p), err) }
+  if err := p.Seq.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Seq), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:seq: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleGapsAndIslandsArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "maxGap", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:maxGap: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.MaxGap)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.maxGap (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:maxGap: ", p), err) }
+  return err
+}
+
+func (p *IGeneralModuleGapsAndIslandsArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:numPartitions: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortByKey2bArgs) String() string {
+func (p *IGeneralModuleGapsAndIslandsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKey2bArgs(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGapsAndIslandsArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IGeneralModuleSortByKey2bResult struct {
+type IGeneralModuleGapsAndIslandsResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSortByKey2bResult() *IGeneralModuleSortByKey2bResult {
-  return &IGeneralModuleSortByKey2bResult{}
+func NewIGeneralModuleGapsAndIslandsResult() *IGeneralModuleGapsAndIslandsResult {
+  return &IGeneralModuleGapsAndIslandsResult{}
 }
 
-var IGeneralModuleSortByKey2bResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSortByKey2bResult) GetEx() *rpc.IExecutorException {
+var IGeneralModuleGapsAndIslandsResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleGapsAndIslandsResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSortByKey2bResult_Ex_DEFAULT
+    return IGeneralModuleGapsAndIslandsResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSortByKey2bResult) IsSetEx() bool {
+func (p *IGeneralModuleGapsAndIslandsResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSortByKey2bResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGapsAndIslandsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12249,7 +23036,7 @@ func (p *IGeneralModuleSortByKey2bResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2bResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModuleGapsAndIslandsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -12257,8 +23044,8 @@ func (p *IGeneralModuleSortByKey2bResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2bResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey2b_result"); err != nil {
+func (p *IGeneralModuleGapsAndIslandsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "gapsAndIslands_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -12270,7 +23057,7 @@ func (p *IGeneralModuleSortByKey2bResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IGeneralModuleSortByKey2bResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModuleGapsAndIslandsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -12283,47 +23070,99 @@ func (p *IGeneralModuleSortByKey2bResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleSortByKey2bResult) String() string {
+func (p *IGeneralModuleGapsAndIslandsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKey2bResult(%+v)", *p)
+  return fmt.Sprintf("IGeneralModuleGapsAndIslandsResult(%+v)", *p)
 }
 
-// Attributes:
-//  - Src
-//  - Ascending
-//  - NumPartitions
-type IGeneralModuleSortByKey3Args struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
-  Ascending bool `thrift:"ascending,2" db:"ascending" json:"ascending"`
-  NumPartitions int64 `thrift:"numPartitions,3" db:"numPartitions" json:"numPartitions"`
+type IGeneralModuleRecomputePartitionsArgs struct {
 }
 
-func NewIGeneralModuleSortByKey3Args() *IGeneralModuleSortByKey3Args {
-  return &IGeneralModuleSortByKey3Args{}
+func NewIGeneralModuleRecomputePartitionsArgs() *IGeneralModuleRecomputePartitionsArgs {
+  return &IGeneralModuleRecomputePartitionsArgs{}
 }
 
-var IGeneralModuleSortByKey3Args_Src_DEFAULT *rpc.ISource
-func (p *IGeneralModuleSortByKey3Args) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IGeneralModuleSortByKey3Args_Src_DEFAULT
+func (p *IGeneralModuleRecomputePartitionsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-return p.Src
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-func (p *IGeneralModuleSortByKey3Args) GetAscending() bool {
-  return p.Ascending
+func (p *IGeneralModuleRecomputePartitionsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "recomputePartitions_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *IGeneralModuleSortByKey3Args) GetNumPartitions() int64 {
-  return p.NumPartitions
+func (p *IGeneralModuleRecomputePartitionsArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleRecomputePartitionsArgs(%+v)", *p)
 }
-func (p *IGeneralModuleSortByKey3Args) IsSetSrc() bool {
-  return p.Src != nil
+
+// Attributes:
+//  - Success
+//  - Ex
+type IGeneralModuleRecomputePartitionsResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func (p *IGeneralModuleSortByKey3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func NewIGeneralModuleRecomputePartitionsResult() *IGeneralModuleRecomputePartitionsResult {
+  return &IGeneralModuleRecomputePartitionsResult{}
+}
+
+var IGeneralModuleRecomputePartitionsResult_Success_DEFAULT int64
+func (p *IGeneralModuleRecomputePartitionsResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return IGeneralModuleRecomputePartitionsResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IGeneralModuleRecomputePartitionsResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModuleRecomputePartitionsResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IGeneralModuleRecomputePartitionsResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IGeneralModuleRecomputePartitionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IGeneralModuleRecomputePartitionsResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IGeneralModuleRecomputePartitionsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12336,19 +23175,9 @@ func (p *IGeneralModuleSortByKey3Args) Read(ctx context.Context, iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField1(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField2(ctx, iprot); err != nil {
+    case 0:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField0(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -12356,9 +23185,9 @@ func (p *IGeneralModuleSortByKey3Args) Read(ctx context.Context, iprot thrift.TP
           return err
         }
       }
-    case 3:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField3(ctx, iprot); err != nil {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -12381,42 +23210,29 @@ func (p *IGeneralModuleSortByKey3Args) Read(ctx context.Context, iprot thrift.TP
   return nil
 }
 
-func (p *IGeneralModuleSortByKey3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
-  return nil
-}
-
-func (p *IGeneralModuleSortByKey3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+func (p *IGeneralModuleRecomputePartitionsResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
 } else {
-  p.Ascending = v
+  p.Success = &v
 }
   return nil
 }
 
-func (p *IGeneralModuleSortByKey3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
-} else {
-  p.NumPartitions = v
-}
+func (p *IGeneralModuleRecomputePartitionsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
   return nil
 }
 
-func (p *IGeneralModuleSortByKey3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey3_args"); err != nil {
+func (p *IGeneralModuleRecomputePartitionsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "recomputePartitions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -12425,66 +23241,124 @@ func (p *IGeneralModuleSortByKey3Args) Write(ctx context.Context, oprot thrift.T
   return nil
 }
 
-func (p *IGeneralModuleSortByKey3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+func (p *IGeneralModuleRecomputePartitionsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
   }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
   return err
 }
 
-func (p *IGeneralModuleSortByKey3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "ascending", thrift.BOOL, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:ascending: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.Ascending)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ascending (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:ascending: ", p), err) }
+func (p *IGeneralModuleRecomputePartitionsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
   return err
 }
 
-func (p *IGeneralModuleSortByKey3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:numPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (3) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:numPartitions: ", p), err) }
-  return err
+func (p *IGeneralModuleRecomputePartitionsResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IGeneralModuleRecomputePartitionsResult(%+v)", *p)
 }
 
-func (p *IGeneralModuleSortByKey3Args) String() string {
+type IGeneralModulePartitionStatsArgs struct {
+}
+
+func NewIGeneralModulePartitionStatsArgs() *IGeneralModulePartitionStatsArgs {
+  return &IGeneralModulePartitionStatsArgs{}
+}
+
+func (p *IGeneralModulePartitionStatsArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IGeneralModulePartitionStatsArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionStats_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IGeneralModulePartitionStatsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKey3Args(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionStatsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - Ex
-type IGeneralModuleSortByKey3Result struct {
+type IGeneralModulePartitionStatsResult struct {
+  Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIGeneralModuleSortByKey3Result() *IGeneralModuleSortByKey3Result {
-  return &IGeneralModuleSortByKey3Result{}
+func NewIGeneralModulePartitionStatsResult() *IGeneralModulePartitionStatsResult {
+  return &IGeneralModulePartitionStatsResult{}
 }
 
-var IGeneralModuleSortByKey3Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IGeneralModuleSortByKey3Result) GetEx() *rpc.IExecutorException {
+var IGeneralModulePartitionStatsResult_Success_DEFAULT string
+func (p *IGeneralModulePartitionStatsResult) GetSuccess() string {
+  if !p.IsSetSuccess() {
+    return IGeneralModulePartitionStatsResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IGeneralModulePartitionStatsResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IGeneralModulePartitionStatsResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IGeneralModuleSortByKey3Result_Ex_DEFAULT
+    return IGeneralModulePartitionStatsResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IGeneralModuleSortByKey3Result) IsSetEx() bool {
+func (p *IGeneralModulePartitionStatsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IGeneralModulePartitionStatsResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IGeneralModuleSortByKey3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionStatsResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -12497,6 +23371,16 @@ func (p *IGeneralModuleSortByKey3Result) Read(ctx context.Context, iprot thrift.
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     case 1:
       if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
@@ -12522,7 +23406,16 @@ func (p *IGeneralModuleSortByKey3Result) Read(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IGeneralModuleSortByKey3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IGeneralModulePartitionStatsResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *IGeneralModulePartitionStatsResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -12530,10 +23423,11 @@ func (p *IGeneralModuleSortByKey3Result)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IGeneralModuleSortByKey3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sortByKey3_result"); err != nil {
+func (p *IGeneralModulePartitionStatsResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionStats_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
@@ -12543,7 +23437,19 @@ func (p *IGeneralModuleSortByKey3Result) Write(ctx context.Context, oprot thrift
   return nil
 }
 
-func (p *IGeneralModuleSortByKey3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IGeneralModulePartitionStatsResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRING, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteString(ctx, string(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IGeneralModulePartitionStatsResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -12556,11 +23462,11 @@ func (p *IGeneralModuleSortByKey3Result) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IGeneralModuleSortByKey3Result) String() string {
+func (p *IGeneralModulePartitionStatsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IGeneralModuleSortByKey3Result(%+v)", *p)
+  return fmt.Sprintf("IGeneralModulePartitionStatsResult(%+v)", *p)
 }
 
 
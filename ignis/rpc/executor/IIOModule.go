@@ -48,6 +48,28 @@ type IIOModule interface {
   TextFile2(ctx context.Context, path string, minPartitions int64) (_err error)
   // Parameters:
   //  - Path
+  //  - MinPartitions
+  SequenceFile(ctx context.Context, path string, minPartitions int64) (_err error)
+  // Parameters:
+  //  - Path
+  //  - Src
+  BinaryFile(ctx context.Context, path string, src *rpc.ISource) (_err error)
+  // Parameters:
+  //  - Path
+  //  - MinPartitions
+  //  - Delimiter
+  //  - Quote
+  //  - Header
+  //  - Src
+  CsvFile(ctx context.Context, path string, minPartitions int64, delimiter string, quote string, header bool, src *rpc.ISource) (_err error)
+  // Parameters:
+  //  - Path
+  //  - MinPartitions
+  //  - Permissive
+  //  - Src
+  JsonFile(ctx context.Context, path string, minPartitions int64, permissive bool, src *rpc.ISource) (_r string, _err error)
+  // Parameters:
+  //  - Path
   //  - First
   //  - Partitions
   PartitionObjectFile(ctx context.Context, path string, first int64, partitions int64) (_err error)
@@ -81,11 +103,26 @@ type IIOModule interface {
   SaveAsObjectFile(ctx context.Context, path string, compression int8, first int64) (_err error)
   // Parameters:
   //  - Path
+  //  - Compression
+  //  - First
+  SaveAsSequenceFile(ctx context.Context, path string, compression int8, first int64) (_err error)
+  // Parameters:
+  //  - Path
+  //  - Compression
+  //  - First
+  SaveAsBinaryFile(ctx context.Context, path string, compression int8, first int64) (_err error)
+  // Parameters:
+  //  - Path
   //  - First
   SaveAsTextFile(ctx context.Context, path string, first int64) (_err error)
   // Parameters:
   //  - Path
   //  - First
+  //  - Src
+  SaveAsTextFile3(ctx context.Context, path string, first int64, src *rpc.ISource) (_err error)
+  // Parameters:
+  //  - Path
+  //  - First
   //  - Pretty
   SaveAsJsonFile(ctx context.Context, path string, first int64, pretty bool) (_err error)
 }
@@ -306,16 +343,14 @@ func (p *IIOModuleClient) TextFile2(ctx context.Context, path string, minPartiti
 
 // Parameters:
 //  - Path
-//  - First
-//  - Partitions
-func (p *IIOModuleClient) PartitionObjectFile(ctx context.Context, path string, first int64, partitions int64) (_err error) {
-  var _args27 IIOModulePartitionObjectFileArgs
+//  - MinPartitions
+func (p *IIOModuleClient) SequenceFile(ctx context.Context, path string, minPartitions int64) (_err error) {
+  var _args27 IIOModuleSequenceFileArgs
   _args27.Path = path
-  _args27.First = first
-  _args27.Partitions = partitions
-  var _result29 IIOModulePartitionObjectFileResult
+  _args27.MinPartitions = minPartitions
+  var _result29 IIOModuleSequenceFileResult
   var _meta28 thrift.ResponseMeta
-  _meta28, _err = p.Client_().Call(ctx, "partitionObjectFile", &_args27, &_result29)
+  _meta28, _err = p.Client_().Call(ctx, "sequenceFile", &_args27, &_result29)
   p.SetLastResponseMeta_(_meta28)
   if _err != nil {
     return
@@ -330,18 +365,14 @@ func (p *IIOModuleClient) PartitionObjectFile(ctx context.Context, path string,
 
 // Parameters:
 //  - Path
-//  - First
-//  - Partitions
 //  - Src
-func (p *IIOModuleClient) PartitionObjectFile4(ctx context.Context, path string, first int64, partitions int64, src *rpc.ISource) (_err error) {
-  var _args30 IIOModulePartitionObjectFile4Args
+func (p *IIOModuleClient) BinaryFile(ctx context.Context, path string, src *rpc.ISource) (_err error) {
+  var _args30 IIOModuleBinaryFileArgs
   _args30.Path = path
-  _args30.First = first
-  _args30.Partitions = partitions
   _args30.Src = src
-  var _result32 IIOModulePartitionObjectFile4Result
+  var _result32 IIOModuleBinaryFileResult
   var _meta31 thrift.ResponseMeta
-  _meta31, _err = p.Client_().Call(ctx, "partitionObjectFile4", &_args30, &_result32)
+  _meta31, _err = p.Client_().Call(ctx, "binaryFile", &_args30, &_result32)
   p.SetLastResponseMeta_(_meta31)
   if _err != nil {
     return
@@ -356,16 +387,22 @@ func (p *IIOModuleClient) PartitionObjectFile4(ctx context.Context, path string,
 
 // Parameters:
 //  - Path
-//  - First
-//  - Partitions
-func (p *IIOModuleClient) PartitionTextFile(ctx context.Context, path string, first int64, partitions int64) (_err error) {
-  var _args33 IIOModulePartitionTextFileArgs
+//  - MinPartitions
+//  - Delimiter
+//  - Quote
+//  - Header
+//  - Src
+func (p *IIOModuleClient) CsvFile(ctx context.Context, path string, minPartitions int64, delimiter string, quote string, header bool, src *rpc.ISource) (_err error) {
+  var _args33 IIOModuleCsvFileArgs
   _args33.Path = path
-  _args33.First = first
-  _args33.Partitions = partitions
-  var _result35 IIOModulePartitionTextFileResult
+  _args33.MinPartitions = minPartitions
+  _args33.Delimiter = delimiter
+  _args33.Quote = quote
+  _args33.Header = header
+  _args33.Src = src
+  var _result35 IIOModuleCsvFileResult
   var _meta34 thrift.ResponseMeta
-  _meta34, _err = p.Client_().Call(ctx, "partitionTextFile", &_args33, &_result35)
+  _meta34, _err = p.Client_().Call(ctx, "csvFile", &_args33, &_result35)
   p.SetLastResponseMeta_(_meta34)
   if _err != nil {
     return
@@ -380,44 +417,42 @@ func (p *IIOModuleClient) PartitionTextFile(ctx context.Context, path string, fi
 
 // Parameters:
 //  - Path
-//  - First
-//  - Partitions
-//  - ObjectMapping
-func (p *IIOModuleClient) PartitionJsonFile4a(ctx context.Context, path string, first int64, partitions int64, objectMapping bool) (_err error) {
-  var _args36 IIOModulePartitionJsonFile4aArgs
+//  - MinPartitions
+//  - Permissive
+//  - Src
+func (p *IIOModuleClient) JsonFile(ctx context.Context, path string, minPartitions int64, permissive bool, src *rpc.ISource) (_r string, _err error) {
+  var _args36 IIOModuleJsonFileArgs
   _args36.Path = path
-  _args36.First = first
-  _args36.Partitions = partitions
-  _args36.ObjectMapping = objectMapping
-  var _result38 IIOModulePartitionJsonFile4aResult
+  _args36.MinPartitions = minPartitions
+  _args36.Permissive = permissive
+  _args36.Src = src
+  var _result38 IIOModuleJsonFileResult
   var _meta37 thrift.ResponseMeta
-  _meta37, _err = p.Client_().Call(ctx, "partitionJsonFile4a", &_args36, &_result38)
+  _meta37, _err = p.Client_().Call(ctx, "jsonFile", &_args36, &_result38)
   p.SetLastResponseMeta_(_meta37)
   if _err != nil {
     return
   }
   switch {
   case _result38.Ex!= nil:
-    return _result38.Ex
+    return _r, _result38.Ex
   }
 
-  return nil
+  return _result38.GetSuccess(), nil
 }
 
 // Parameters:
 //  - Path
 //  - First
 //  - Partitions
-//  - Src
-func (p *IIOModuleClient) PartitionJsonFile4b(ctx context.Context, path string, first int64, partitions int64, src *rpc.ISource) (_err error) {
-  var _args39 IIOModulePartitionJsonFile4bArgs
+func (p *IIOModuleClient) PartitionObjectFile(ctx context.Context, path string, first int64, partitions int64) (_err error) {
+  var _args39 IIOModulePartitionObjectFileArgs
   _args39.Path = path
   _args39.First = first
   _args39.Partitions = partitions
-  _args39.Src = src
-  var _result41 IIOModulePartitionJsonFile4bResult
+  var _result41 IIOModulePartitionObjectFileResult
   var _meta40 thrift.ResponseMeta
-  _meta40, _err = p.Client_().Call(ctx, "partitionJsonFile4b", &_args39, &_result41)
+  _meta40, _err = p.Client_().Call(ctx, "partitionObjectFile", &_args39, &_result41)
   p.SetLastResponseMeta_(_meta40)
   if _err != nil {
     return
@@ -432,16 +467,18 @@ func (p *IIOModuleClient) PartitionJsonFile4b(ctx context.Context, path string,
 
 // Parameters:
 //  - Path
-//  - Compression
 //  - First
-func (p *IIOModuleClient) SaveAsObjectFile(ctx context.Context, path string, compression int8, first int64) (_err error) {
-  var _args42 IIOModuleSaveAsObjectFileArgs
+//  - Partitions
+//  - Src
+func (p *IIOModuleClient) PartitionObjectFile4(ctx context.Context, path string, first int64, partitions int64, src *rpc.ISource) (_err error) {
+  var _args42 IIOModulePartitionObjectFile4Args
   _args42.Path = path
-  _args42.Compression = compression
   _args42.First = first
-  var _result44 IIOModuleSaveAsObjectFileResult
+  _args42.Partitions = partitions
+  _args42.Src = src
+  var _result44 IIOModulePartitionObjectFile4Result
   var _meta43 thrift.ResponseMeta
-  _meta43, _err = p.Client_().Call(ctx, "saveAsObjectFile", &_args42, &_result44)
+  _meta43, _err = p.Client_().Call(ctx, "partitionObjectFile4", &_args42, &_result44)
   p.SetLastResponseMeta_(_meta43)
   if _err != nil {
     return
@@ -457,13 +494,15 @@ func (p *IIOModuleClient) SaveAsObjectFile(ctx context.Context, path string, com
 // Parameters:
 //  - Path
 //  - First
-func (p *IIOModuleClient) SaveAsTextFile(ctx context.Context, path string, first int64) (_err error) {
-  var _args45 IIOModuleSaveAsTextFileArgs
+//  - Partitions
+func (p *IIOModuleClient) PartitionTextFile(ctx context.Context, path string, first int64, partitions int64) (_err error) {
+  var _args45 IIOModulePartitionTextFileArgs
   _args45.Path = path
   _args45.First = first
-  var _result47 IIOModuleSaveAsTextFileResult
+  _args45.Partitions = partitions
+  var _result47 IIOModulePartitionTextFileResult
   var _meta46 thrift.ResponseMeta
-  _meta46, _err = p.Client_().Call(ctx, "saveAsTextFile", &_args45, &_result47)
+  _meta46, _err = p.Client_().Call(ctx, "partitionTextFile", &_args45, &_result47)
   p.SetLastResponseMeta_(_meta46)
   if _err != nil {
     return
@@ -479,15 +518,17 @@ func (p *IIOModuleClient) SaveAsTextFile(ctx context.Context, path string, first
 // Parameters:
 //  - Path
 //  - First
-//  - Pretty
-func (p *IIOModuleClient) SaveAsJsonFile(ctx context.Context, path string, first int64, pretty bool) (_err error) {
-  var _args48 IIOModuleSaveAsJsonFileArgs
+//  - Partitions
+//  - ObjectMapping
+func (p *IIOModuleClient) PartitionJsonFile4a(ctx context.Context, path string, first int64, partitions int64, objectMapping bool) (_err error) {
+  var _args48 IIOModulePartitionJsonFile4aArgs
   _args48.Path = path
   _args48.First = first
-  _args48.Pretty = pretty
-  var _result50 IIOModuleSaveAsJsonFileResult
+  _args48.Partitions = partitions
+  _args48.ObjectMapping = objectMapping
+  var _result50 IIOModulePartitionJsonFile4aResult
   var _meta49 thrift.ResponseMeta
-  _meta49, _err = p.Client_().Call(ctx, "saveAsJsonFile", &_args48, &_result50)
+  _meta49, _err = p.Client_().Call(ctx, "partitionJsonFile4a", &_args48, &_result50)
   p.SetLastResponseMeta_(_meta49)
   if _err != nil {
     return
@@ -500,6 +541,174 @@ func (p *IIOModuleClient) SaveAsJsonFile(ctx context.Context, path string, first
   return nil
 }
 
+// Parameters:
+//  - Path
+//  - First
+//  - Partitions
+//  - Src
+func (p *IIOModuleClient) PartitionJsonFile4b(ctx context.Context, path string, first int64, partitions int64, src *rpc.ISource) (_err error) {
+  var _args51 IIOModulePartitionJsonFile4bArgs
+  _args51.Path = path
+  _args51.First = first
+  _args51.Partitions = partitions
+  _args51.Src = src
+  var _result53 IIOModulePartitionJsonFile4bResult
+  var _meta52 thrift.ResponseMeta
+  _meta52, _err = p.Client_().Call(ctx, "partitionJsonFile4b", &_args51, &_result53)
+  p.SetLastResponseMeta_(_meta52)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result53.Ex!= nil:
+    return _result53.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Path
+//  - Compression
+//  - First
+func (p *IIOModuleClient) SaveAsObjectFile(ctx context.Context, path string, compression int8, first int64) (_err error) {
+  var _args54 IIOModuleSaveAsObjectFileArgs
+  _args54.Path = path
+  _args54.Compression = compression
+  _args54.First = first
+  var _result56 IIOModuleSaveAsObjectFileResult
+  var _meta55 thrift.ResponseMeta
+  _meta55, _err = p.Client_().Call(ctx, "saveAsObjectFile", &_args54, &_result56)
+  p.SetLastResponseMeta_(_meta55)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result56.Ex!= nil:
+    return _result56.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Path
+//  - Compression
+//  - First
+func (p *IIOModuleClient) SaveAsSequenceFile(ctx context.Context, path string, compression int8, first int64) (_err error) {
+  var _args57 IIOModuleSaveAsSequenceFileArgs
+  _args57.Path = path
+  _args57.Compression = compression
+  _args57.First = first
+  var _result59 IIOModuleSaveAsSequenceFileResult
+  var _meta58 thrift.ResponseMeta
+  _meta58, _err = p.Client_().Call(ctx, "saveAsSequenceFile", &_args57, &_result59)
+  p.SetLastResponseMeta_(_meta58)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result59.Ex!= nil:
+    return _result59.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Path
+//  - Compression
+//  - First
+func (p *IIOModuleClient) SaveAsBinaryFile(ctx context.Context, path string, compression int8, first int64) (_err error) {
+  var _args60 IIOModuleSaveAsBinaryFileArgs
+  _args60.Path = path
+  _args60.Compression = compression
+  _args60.First = first
+  var _result62 IIOModuleSaveAsBinaryFileResult
+  var _meta61 thrift.ResponseMeta
+  _meta61, _err = p.Client_().Call(ctx, "saveAsBinaryFile", &_args60, &_result62)
+  p.SetLastResponseMeta_(_meta61)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result62.Ex!= nil:
+    return _result62.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Path
+//  - First
+func (p *IIOModuleClient) SaveAsTextFile(ctx context.Context, path string, first int64) (_err error) {
+  var _args63 IIOModuleSaveAsTextFileArgs
+  _args63.Path = path
+  _args63.First = first
+  var _result65 IIOModuleSaveAsTextFileResult
+  var _meta64 thrift.ResponseMeta
+  _meta64, _err = p.Client_().Call(ctx, "saveAsTextFile", &_args63, &_result65)
+  p.SetLastResponseMeta_(_meta64)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result65.Ex!= nil:
+    return _result65.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Path
+//  - First
+//  - Src
+func (p *IIOModuleClient) SaveAsTextFile3(ctx context.Context, path string, first int64, src *rpc.ISource) (_err error) {
+  var _args66 IIOModuleSaveAsTextFile3Args
+  _args66.Path = path
+  _args66.First = first
+  _args66.Src = src
+  var _result68 IIOModuleSaveAsTextFile3Result
+  var _meta67 thrift.ResponseMeta
+  _meta67, _err = p.Client_().Call(ctx, "saveAsTextFile3", &_args66, &_result68)
+  p.SetLastResponseMeta_(_meta67)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result68.Ex!= nil:
+    return _result68.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Path
+//  - First
+//  - Pretty
+func (p *IIOModuleClient) SaveAsJsonFile(ctx context.Context, path string, first int64, pretty bool) (_err error) {
+  var _args69 IIOModuleSaveAsJsonFileArgs
+  _args69.Path = path
+  _args69.First = first
+  _args69.Pretty = pretty
+  var _result71 IIOModuleSaveAsJsonFileResult
+  var _meta70 thrift.ResponseMeta
+  _meta70, _err = p.Client_().Call(ctx, "saveAsJsonFile", &_args69, &_result71)
+  p.SetLastResponseMeta_(_meta70)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result71.Ex!= nil:
+    return _result71.Ex
+  }
+
+  return nil
+}
+
 type IIOModuleProcessor struct {
   processorMap map[string]thrift.TProcessorFunction
   handler IIOModule
@@ -520,25 +729,32 @@ func (p *IIOModuleProcessor) ProcessorMap() map[string]thrift.TProcessorFunction
 
 func NewIIOModuleProcessor(handler IIOModule) *IIOModuleProcessor {
 
-  self51 := &IIOModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self51.processorMap["loadClass"] = &iIOModuleProcessorLoadClass{handler:handler}
-  self51.processorMap["loadLibrary"] = &iIOModuleProcessorLoadLibrary{handler:handler}
-  self51.processorMap["partitionCount"] = &iIOModuleProcessorPartitionCount{handler:handler}
-  self51.processorMap["countByPartition"] = &iIOModuleProcessorCountByPartition{handler:handler}
-  self51.processorMap["partitionApproxSize"] = &iIOModuleProcessorPartitionApproxSize{handler:handler}
-  self51.processorMap["plainFile"] = &iIOModuleProcessorPlainFile{handler:handler}
-  self51.processorMap["plainFile3"] = &iIOModuleProcessorPlainFile3{handler:handler}
-  self51.processorMap["textFile"] = &iIOModuleProcessorTextFile{handler:handler}
-  self51.processorMap["textFile2"] = &iIOModuleProcessorTextFile2{handler:handler}
-  self51.processorMap["partitionObjectFile"] = &iIOModuleProcessorPartitionObjectFile{handler:handler}
-  self51.processorMap["partitionObjectFile4"] = &iIOModuleProcessorPartitionObjectFile4{handler:handler}
-  self51.processorMap["partitionTextFile"] = &iIOModuleProcessorPartitionTextFile{handler:handler}
-  self51.processorMap["partitionJsonFile4a"] = &iIOModuleProcessorPartitionJsonFile4a{handler:handler}
-  self51.processorMap["partitionJsonFile4b"] = &iIOModuleProcessorPartitionJsonFile4b{handler:handler}
-  self51.processorMap["saveAsObjectFile"] = &iIOModuleProcessorSaveAsObjectFile{handler:handler}
-  self51.processorMap["saveAsTextFile"] = &iIOModuleProcessorSaveAsTextFile{handler:handler}
-  self51.processorMap["saveAsJsonFile"] = &iIOModuleProcessorSaveAsJsonFile{handler:handler}
-return self51
+  self72 := &IIOModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self72.processorMap["loadClass"] = &iIOModuleProcessorLoadClass{handler:handler}
+  self72.processorMap["loadLibrary"] = &iIOModuleProcessorLoadLibrary{handler:handler}
+  self72.processorMap["partitionCount"] = &iIOModuleProcessorPartitionCount{handler:handler}
+  self72.processorMap["countByPartition"] = &iIOModuleProcessorCountByPartition{handler:handler}
+  self72.processorMap["partitionApproxSize"] = &iIOModuleProcessorPartitionApproxSize{handler:handler}
+  self72.processorMap["plainFile"] = &iIOModuleProcessorPlainFile{handler:handler}
+  self72.processorMap["plainFile3"] = &iIOModuleProcessorPlainFile3{handler:handler}
+  self72.processorMap["textFile"] = &iIOModuleProcessorTextFile{handler:handler}
+  self72.processorMap["textFile2"] = &iIOModuleProcessorTextFile2{handler:handler}
+  self72.processorMap["sequenceFile"] = &iIOModuleProcessorSequenceFile{handler:handler}
+  self72.processorMap["binaryFile"] = &iIOModuleProcessorBinaryFile{handler:handler}
+  self72.processorMap["csvFile"] = &iIOModuleProcessorCsvFile{handler:handler}
+  self72.processorMap["jsonFile"] = &iIOModuleProcessorJsonFile{handler:handler}
+  self72.processorMap["partitionObjectFile"] = &iIOModuleProcessorPartitionObjectFile{handler:handler}
+  self72.processorMap["partitionObjectFile4"] = &iIOModuleProcessorPartitionObjectFile4{handler:handler}
+  self72.processorMap["partitionTextFile"] = &iIOModuleProcessorPartitionTextFile{handler:handler}
+  self72.processorMap["partitionJsonFile4a"] = &iIOModuleProcessorPartitionJsonFile4a{handler:handler}
+  self72.processorMap["partitionJsonFile4b"] = &iIOModuleProcessorPartitionJsonFile4b{handler:handler}
+  self72.processorMap["saveAsObjectFile"] = &iIOModuleProcessorSaveAsObjectFile{handler:handler}
+  self72.processorMap["saveAsSequenceFile"] = &iIOModuleProcessorSaveAsSequenceFile{handler:handler}
+  self72.processorMap["saveAsBinaryFile"] = &iIOModuleProcessorSaveAsBinaryFile{handler:handler}
+  self72.processorMap["saveAsTextFile"] = &iIOModuleProcessorSaveAsTextFile{handler:handler}
+  self72.processorMap["saveAsTextFile3"] = &iIOModuleProcessorSaveAsTextFile3{handler:handler}
+  self72.processorMap["saveAsJsonFile"] = &iIOModuleProcessorSaveAsJsonFile{handler:handler}
+return self72
 }
 
 func (p *IIOModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -549,12 +765,12 @@ func (p *IIOModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TP
   }
   iprot.Skip(ctx, thrift.STRUCT)
   iprot.ReadMessageEnd(ctx)
-  x52 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  x73 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
   oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-  x52.Write(ctx, oprot)
+  x73.Write(ctx, oprot)
   oprot.WriteMessageEnd(ctx)
   oprot.Flush(ctx)
-  return false, x52
+  return false, x73
 
 }
 
@@ -1296,17 +1512,17 @@ func (p *iIOModuleProcessorTextFile2) Process(ctx context.Context, seqId int32,
   return true, err
 }
 
-type iIOModuleProcessorPartitionObjectFile struct {
+type iIOModuleProcessorSequenceFile struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorPartitionObjectFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModulePartitionObjectFileArgs{}
+func (p *iIOModuleProcessorSequenceFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleSequenceFileArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionObjectFile", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "sequenceFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1340,8 +1556,8 @@ func (p *iIOModuleProcessorPartitionObjectFile) Process(ctx context.Context, seq
     }(tickerCtx, cancel)
   }
 
-  result := IIOModulePartitionObjectFileResult{}
-  if err2 = p.handler.PartitionObjectFile(ctx, args.Path, args.First, args.Partitions); err2 != nil {
+  result := IIOModuleSequenceFileResult{}
+  if err2 = p.handler.SequenceFile(ctx, args.Path, args.MinPartitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1350,8 +1566,8 @@ func (p *iIOModuleProcessorPartitionObjectFile) Process(ctx context.Context, seq
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionObjectFile: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionObjectFile", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sequenceFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sequenceFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1359,7 +1575,7 @@ func (p *iIOModuleProcessorPartitionObjectFile) Process(ctx context.Context, seq
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionObjectFile", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "sequenceFile", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1377,17 +1593,17 @@ func (p *iIOModuleProcessorPartitionObjectFile) Process(ctx context.Context, seq
   return true, err
 }
 
-type iIOModuleProcessorPartitionObjectFile4 struct {
+type iIOModuleProcessorBinaryFile struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorPartitionObjectFile4) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModulePartitionObjectFile4Args{}
+func (p *iIOModuleProcessorBinaryFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleBinaryFileArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionObjectFile4", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "binaryFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1421,8 +1637,8 @@ func (p *iIOModuleProcessorPartitionObjectFile4) Process(ctx context.Context, se
     }(tickerCtx, cancel)
   }
 
-  result := IIOModulePartitionObjectFile4Result{}
-  if err2 = p.handler.PartitionObjectFile4(ctx, args.Path, args.First, args.Partitions, args.Src); err2 != nil {
+  result := IIOModuleBinaryFileResult{}
+  if err2 = p.handler.BinaryFile(ctx, args.Path, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1431,8 +1647,8 @@ func (p *iIOModuleProcessorPartitionObjectFile4) Process(ctx context.Context, se
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionObjectFile4: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionObjectFile4", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing binaryFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "binaryFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1440,7 +1656,7 @@ func (p *iIOModuleProcessorPartitionObjectFile4) Process(ctx context.Context, se
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionObjectFile4", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "binaryFile", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1458,17 +1674,17 @@ func (p *iIOModuleProcessorPartitionObjectFile4) Process(ctx context.Context, se
   return true, err
 }
 
-type iIOModuleProcessorPartitionTextFile struct {
+type iIOModuleProcessorCsvFile struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorPartitionTextFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModulePartitionTextFileArgs{}
+func (p *iIOModuleProcessorCsvFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleCsvFileArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionTextFile", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "csvFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1502,8 +1718,8 @@ func (p *iIOModuleProcessorPartitionTextFile) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IIOModulePartitionTextFileResult{}
-  if err2 = p.handler.PartitionTextFile(ctx, args.Path, args.First, args.Partitions); err2 != nil {
+  result := IIOModuleCsvFileResult{}
+  if err2 = p.handler.CsvFile(ctx, args.Path, args.MinPartitions, args.Delimiter, args.Quote, args.Header, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1512,8 +1728,8 @@ func (p *iIOModuleProcessorPartitionTextFile) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionTextFile: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionTextFile", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing csvFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "csvFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1521,7 +1737,7 @@ func (p *iIOModuleProcessorPartitionTextFile) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionTextFile", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "csvFile", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1539,17 +1755,17 @@ func (p *iIOModuleProcessorPartitionTextFile) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iIOModuleProcessorPartitionJsonFile4a struct {
+type iIOModuleProcessorJsonFile struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorPartitionJsonFile4a) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModulePartitionJsonFile4aArgs{}
+func (p *iIOModuleProcessorJsonFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleJsonFileArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionJsonFile4a", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "jsonFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1583,8 +1799,9 @@ func (p *iIOModuleProcessorPartitionJsonFile4a) Process(ctx context.Context, seq
     }(tickerCtx, cancel)
   }
 
-  result := IIOModulePartitionJsonFile4aResult{}
-  if err2 = p.handler.PartitionJsonFile4a(ctx, args.Path, args.First, args.Partitions, args.ObjectMapping); err2 != nil {
+  result := IIOModuleJsonFileResult{}
+  var retval string
+  if retval, err2 = p.handler.JsonFile(ctx, args.Path, args.MinPartitions, args.Permissive, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1593,16 +1810,18 @@ func (p *iIOModuleProcessorPartitionJsonFile4a) Process(ctx context.Context, seq
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionJsonFile4a: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionJsonFile4a", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing jsonFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "jsonFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
     return true, thrift.WrapTException(err2)
   }
+  } else {
+    result.Success = &retval
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionJsonFile4a", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "jsonFile", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1620,17 +1839,17 @@ func (p *iIOModuleProcessorPartitionJsonFile4a) Process(ctx context.Context, seq
   return true, err
 }
 
-type iIOModuleProcessorPartitionJsonFile4b struct {
+type iIOModuleProcessorPartitionObjectFile struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorPartitionJsonFile4b) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModulePartitionJsonFile4bArgs{}
+func (p *iIOModuleProcessorPartitionObjectFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModulePartitionObjectFileArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionJsonFile4b", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "partitionObjectFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1664,8 +1883,8 @@ func (p *iIOModuleProcessorPartitionJsonFile4b) Process(ctx context.Context, seq
     }(tickerCtx, cancel)
   }
 
-  result := IIOModulePartitionJsonFile4bResult{}
-  if err2 = p.handler.PartitionJsonFile4b(ctx, args.Path, args.First, args.Partitions, args.Src); err2 != nil {
+  result := IIOModulePartitionObjectFileResult{}
+  if err2 = p.handler.PartitionObjectFile(ctx, args.Path, args.First, args.Partitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1674,8 +1893,8 @@ func (p *iIOModuleProcessorPartitionJsonFile4b) Process(ctx context.Context, seq
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionJsonFile4b: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "partitionJsonFile4b", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionObjectFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionObjectFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1683,7 +1902,7 @@ func (p *iIOModuleProcessorPartitionJsonFile4b) Process(ctx context.Context, seq
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "partitionJsonFile4b", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionObjectFile", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1701,17 +1920,17 @@ func (p *iIOModuleProcessorPartitionJsonFile4b) Process(ctx context.Context, seq
   return true, err
 }
 
-type iIOModuleProcessorSaveAsObjectFile struct {
+type iIOModuleProcessorPartitionObjectFile4 struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorSaveAsObjectFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModuleSaveAsObjectFileArgs{}
+func (p *iIOModuleProcessorPartitionObjectFile4) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModulePartitionObjectFile4Args{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "saveAsObjectFile", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "partitionObjectFile4", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1745,8 +1964,8 @@ func (p *iIOModuleProcessorSaveAsObjectFile) Process(ctx context.Context, seqId
     }(tickerCtx, cancel)
   }
 
-  result := IIOModuleSaveAsObjectFileResult{}
-  if err2 = p.handler.SaveAsObjectFile(ctx, args.Path, args.Compression, args.First); err2 != nil {
+  result := IIOModulePartitionObjectFile4Result{}
+  if err2 = p.handler.PartitionObjectFile4(ctx, args.Path, args.First, args.Partitions, args.Src); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1755,8 +1974,8 @@ func (p *iIOModuleProcessorSaveAsObjectFile) Process(ctx context.Context, seqId
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsObjectFile: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "saveAsObjectFile", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionObjectFile4: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionObjectFile4", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1764,7 +1983,7 @@ func (p *iIOModuleProcessorSaveAsObjectFile) Process(ctx context.Context, seqId
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "saveAsObjectFile", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionObjectFile4", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1782,17 +2001,17 @@ func (p *iIOModuleProcessorSaveAsObjectFile) Process(ctx context.Context, seqId
   return true, err
 }
 
-type iIOModuleProcessorSaveAsTextFile struct {
+type iIOModuleProcessorPartitionTextFile struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorSaveAsTextFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModuleSaveAsTextFileArgs{}
+func (p *iIOModuleProcessorPartitionTextFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModulePartitionTextFileArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "saveAsTextFile", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "partitionTextFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1826,8 +2045,8 @@ func (p *iIOModuleProcessorSaveAsTextFile) Process(ctx context.Context, seqId in
     }(tickerCtx, cancel)
   }
 
-  result := IIOModuleSaveAsTextFileResult{}
-  if err2 = p.handler.SaveAsTextFile(ctx, args.Path, args.First); err2 != nil {
+  result := IIOModulePartitionTextFileResult{}
+  if err2 = p.handler.PartitionTextFile(ctx, args.Path, args.First, args.Partitions); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1836,8 +2055,8 @@ func (p *iIOModuleProcessorSaveAsTextFile) Process(ctx context.Context, seqId in
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsTextFile: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "saveAsTextFile", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionTextFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionTextFile", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1845,7 +2064,7 @@ func (p *iIOModuleProcessorSaveAsTextFile) Process(ctx context.Context, seqId in
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "saveAsTextFile", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionTextFile", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1863,17 +2082,17 @@ func (p *iIOModuleProcessorSaveAsTextFile) Process(ctx context.Context, seqId in
   return true, err
 }
 
-type iIOModuleProcessorSaveAsJsonFile struct {
+type iIOModuleProcessorPartitionJsonFile4a struct {
   handler IIOModule
 }
 
-func (p *iIOModuleProcessorSaveAsJsonFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := IIOModuleSaveAsJsonFileArgs{}
+func (p *iIOModuleProcessorPartitionJsonFile4a) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModulePartitionJsonFile4aArgs{}
   var err2 error
   if err2 = args.Read(ctx, iprot); err2 != nil {
     iprot.ReadMessageEnd(ctx)
     x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
-    oprot.WriteMessageBegin(ctx, "saveAsJsonFile", thrift.EXCEPTION, seqId)
+    oprot.WriteMessageBegin(ctx, "partitionJsonFile4a", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1907,8 +2126,8 @@ func (p *iIOModuleProcessorSaveAsJsonFile) Process(ctx context.Context, seqId in
     }(tickerCtx, cancel)
   }
 
-  result := IIOModuleSaveAsJsonFileResult{}
-  if err2 = p.handler.SaveAsJsonFile(ctx, args.Path, args.First, args.Pretty); err2 != nil {
+  result := IIOModulePartitionJsonFile4aResult{}
+  if err2 = p.handler.PartitionJsonFile4a(ctx, args.Path, args.First, args.Partitions, args.ObjectMapping); err2 != nil {
     tickerCancel()
   switch v := err2.(type) {
     case *rpc.IExecutorException:
@@ -1917,8 +2136,8 @@ func (p *iIOModuleProcessorSaveAsJsonFile) Process(ctx context.Context, seqId in
     if err2 == thrift.ErrAbandonRequest {
       return false, thrift.WrapTException(err2)
     }
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsJsonFile: " + err2.Error())
-    oprot.WriteMessageBegin(ctx, "saveAsJsonFile", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionJsonFile4a: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionJsonFile4a", thrift.EXCEPTION, seqId)
     x.Write(ctx, oprot)
     oprot.WriteMessageEnd(ctx)
     oprot.Flush(ctx)
@@ -1926,7 +2145,250 @@ func (p *iIOModuleProcessorSaveAsJsonFile) Process(ctx context.Context, seqId in
   }
   }
   tickerCancel()
-  if err2 = oprot.WriteMessageBegin(ctx, "saveAsJsonFile", thrift.REPLY, seqId); err2 != nil {
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionJsonFile4a", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iIOModuleProcessorPartitionJsonFile4b struct {
+  handler IIOModule
+}
+
+func (p *iIOModuleProcessorPartitionJsonFile4b) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModulePartitionJsonFile4bArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionJsonFile4b", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IIOModulePartitionJsonFile4bResult{}
+  if err2 = p.handler.PartitionJsonFile4b(ctx, args.Path, args.First, args.Partitions, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing partitionJsonFile4b: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "partitionJsonFile4b", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "partitionJsonFile4b", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iIOModuleProcessorSaveAsObjectFile struct {
+  handler IIOModule
+}
+
+func (p *iIOModuleProcessorSaveAsObjectFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleSaveAsObjectFileArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsObjectFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IIOModuleSaveAsObjectFileResult{}
+  if err2 = p.handler.SaveAsObjectFile(ctx, args.Path, args.Compression, args.First); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsObjectFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsObjectFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "saveAsObjectFile", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iIOModuleProcessorSaveAsSequenceFile struct {
+  handler IIOModule
+}
+
+func (p *iIOModuleProcessorSaveAsSequenceFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleSaveAsSequenceFileArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsSequenceFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IIOModuleSaveAsSequenceFileResult{}
+  if err2 = p.handler.SaveAsSequenceFile(ctx, args.Path, args.Compression, args.First); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsSequenceFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsSequenceFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "saveAsSequenceFile", thrift.REPLY, seqId); err2 != nil {
     err = thrift.WrapTException(err2)
   }
   if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
@@ -1935,40 +2397,1944 @@ func (p *iIOModuleProcessorSaveAsJsonFile) Process(ctx context.Context, seqId in
   if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
     err = thrift.WrapTException(err2)
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = thrift.WrapTException(err2)
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iIOModuleProcessorSaveAsBinaryFile struct {
+  handler IIOModule
+}
+
+func (p *iIOModuleProcessorSaveAsBinaryFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleSaveAsBinaryFileArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsBinaryFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IIOModuleSaveAsBinaryFileResult{}
+  if err2 = p.handler.SaveAsBinaryFile(ctx, args.Path, args.Compression, args.First); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsBinaryFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsBinaryFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "saveAsBinaryFile", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iIOModuleProcessorSaveAsTextFile struct {
+  handler IIOModule
+}
+
+func (p *iIOModuleProcessorSaveAsTextFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleSaveAsTextFileArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsTextFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IIOModuleSaveAsTextFileResult{}
+  if err2 = p.handler.SaveAsTextFile(ctx, args.Path, args.First); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsTextFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsTextFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "saveAsTextFile", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iIOModuleProcessorSaveAsTextFile3 struct {
+  handler IIOModule
+}
+
+func (p *iIOModuleProcessorSaveAsTextFile3) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleSaveAsTextFile3Args{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsTextFile3", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IIOModuleSaveAsTextFile3Result{}
+  if err2 = p.handler.SaveAsTextFile3(ctx, args.Path, args.First, args.Src); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsTextFile3: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsTextFile3", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "saveAsTextFile3", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iIOModuleProcessorSaveAsJsonFile struct {
+  handler IIOModule
+}
+
+func (p *iIOModuleProcessorSaveAsJsonFile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IIOModuleSaveAsJsonFileArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsJsonFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IIOModuleSaveAsJsonFileResult{}
+  if err2 = p.handler.SaveAsJsonFile(ctx, args.Path, args.First, args.Pretty); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing saveAsJsonFile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "saveAsJsonFile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "saveAsJsonFile", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - Src
+type IIOModuleLoadClassArgs struct {
+  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+}
+
+func NewIIOModuleLoadClassArgs() *IIOModuleLoadClassArgs {
+  return &IIOModuleLoadClassArgs{}
+}
+
+var IIOModuleLoadClassArgs_Src_DEFAULT *rpc.ISource
+func (p *IIOModuleLoadClassArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IIOModuleLoadClassArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IIOModuleLoadClassArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IIOModuleLoadClassArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleLoadClassArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleLoadClassArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadClass_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleLoadClassArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleLoadClassArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleLoadClassArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IIOModuleLoadClassResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIIOModuleLoadClassResult() *IIOModuleLoadClassResult {
+  return &IIOModuleLoadClassResult{}
+}
+
+var IIOModuleLoadClassResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleLoadClassResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModuleLoadClassResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IIOModuleLoadClassResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IIOModuleLoadClassResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleLoadClassResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleLoadClassResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadClass_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleLoadClassResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModuleLoadClassResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleLoadClassResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Path
+type IIOModuleLoadLibraryArgs struct {
+  Path string `thrift:"path,1" db:"path" json:"path"`
+}
+
+func NewIIOModuleLoadLibraryArgs() *IIOModuleLoadLibraryArgs {
+  return &IIOModuleLoadLibraryArgs{}
+}
+
+
+func (p *IIOModuleLoadLibraryArgs) GetPath() string {
+  return p.Path
+}
+func (p *IIOModuleLoadLibraryArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleLoadLibraryArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IIOModuleLoadLibraryArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadLibrary_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleLoadLibraryArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleLoadLibraryArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleLoadLibraryArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IIOModuleLoadLibraryResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIIOModuleLoadLibraryResult() *IIOModuleLoadLibraryResult {
+  return &IIOModuleLoadLibraryResult{}
+}
+
+var IIOModuleLoadLibraryResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleLoadLibraryResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModuleLoadLibraryResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IIOModuleLoadLibraryResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IIOModuleLoadLibraryResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleLoadLibraryResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleLoadLibraryResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "loadLibrary_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleLoadLibraryResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModuleLoadLibraryResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleLoadLibraryResult(%+v)", *p)
+}
+
+type IIOModulePartitionCountArgs struct {
+}
+
+func NewIIOModulePartitionCountArgs() *IIOModulePartitionCountArgs {
+  return &IIOModulePartitionCountArgs{}
+}
+
+func (p *IIOModulePartitionCountArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePartitionCountArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionCount_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePartitionCountArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePartitionCountArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ex
+type IIOModulePartitionCountResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIIOModulePartitionCountResult() *IIOModulePartitionCountResult {
+  return &IIOModulePartitionCountResult{}
+}
+
+var IIOModulePartitionCountResult_Success_DEFAULT int64
+func (p *IIOModulePartitionCountResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return IIOModulePartitionCountResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IIOModulePartitionCountResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePartitionCountResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModulePartitionCountResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IIOModulePartitionCountResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IIOModulePartitionCountResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IIOModulePartitionCountResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePartitionCountResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *IIOModulePartitionCountResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePartitionCountResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionCount_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePartitionCountResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModulePartitionCountResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModulePartitionCountResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePartitionCountResult(%+v)", *p)
+}
+
+type IIOModuleCountByPartitionArgs struct {
+}
+
+func NewIIOModuleCountByPartitionArgs() *IIOModuleCountByPartitionArgs {
+  return &IIOModuleCountByPartitionArgs{}
+}
+
+func (p *IIOModuleCountByPartitionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleCountByPartitionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "countByPartition_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleCountByPartitionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleCountByPartitionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ex
+type IIOModuleCountByPartitionResult struct {
+  Success []int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIIOModuleCountByPartitionResult() *IIOModuleCountByPartitionResult {
+  return &IIOModuleCountByPartitionResult{}
+}
+
+var IIOModuleCountByPartitionResult_Success_DEFAULT []int64
+
+func (p *IIOModuleCountByPartitionResult) GetSuccess() []int64 {
+  return p.Success
+}
+var IIOModuleCountByPartitionResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleCountByPartitionResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModuleCountByPartitionResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IIOModuleCountByPartitionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IIOModuleCountByPartitionResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IIOModuleCountByPartitionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleCountByPartitionResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]int64, 0, size)
+  p.Success =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem74 int64
+    if v, err := iprot.ReadI64(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem74 = v
+}
+    p.Success = append(p.Success, _elem74)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IIOModuleCountByPartitionResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleCountByPartitionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "countByPartition_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleCountByPartitionResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.Success)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Success {
+      if err := oprot.WriteI64(ctx, int64(v)); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+    }
+    if err := oprot.WriteListEnd(ctx); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModuleCountByPartitionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModuleCountByPartitionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleCountByPartitionResult(%+v)", *p)
+}
+
+type IIOModulePartitionApproxSizeArgs struct {
+}
+
+func NewIIOModulePartitionApproxSizeArgs() *IIOModulePartitionApproxSizeArgs {
+  return &IIOModulePartitionApproxSizeArgs{}
+}
+
+func (p *IIOModulePartitionApproxSizeArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePartitionApproxSizeArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionApproxSize_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePartitionApproxSizeArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePartitionApproxSizeArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ex
+type IIOModulePartitionApproxSizeResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIIOModulePartitionApproxSizeResult() *IIOModulePartitionApproxSizeResult {
+  return &IIOModulePartitionApproxSizeResult{}
+}
+
+var IIOModulePartitionApproxSizeResult_Success_DEFAULT int64
+func (p *IIOModulePartitionApproxSizeResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return IIOModulePartitionApproxSizeResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IIOModulePartitionApproxSizeResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePartitionApproxSizeResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModulePartitionApproxSizeResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IIOModulePartitionApproxSizeResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IIOModulePartitionApproxSizeResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IIOModulePartitionApproxSizeResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePartitionApproxSizeResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *IIOModulePartitionApproxSizeResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePartitionApproxSizeResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionApproxSize_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePartitionApproxSizeResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModulePartitionApproxSizeResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModulePartitionApproxSizeResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePartitionApproxSizeResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Path
+//  - Delim
+type IIOModulePlainFileArgs struct {
+  Path string `thrift:"path,1" db:"path" json:"path"`
+  Delim string `thrift:"delim,2" db:"delim" json:"delim"`
+}
+
+func NewIIOModulePlainFileArgs() *IIOModulePlainFileArgs {
+  return &IIOModulePlainFileArgs{}
+}
+
+
+func (p *IIOModulePlainFileArgs) GetPath() string {
+  return p.Path
+}
+
+func (p *IIOModulePlainFileArgs) GetDelim() string {
+  return p.Delim
+}
+func (p *IIOModulePlainFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePlainFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IIOModulePlainFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Delim = v
+}
+  return nil
+}
+
+func (p *IIOModulePlainFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "plainFile_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePlainFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePlainFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "delim", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:delim: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Delim)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.delim (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:delim: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePlainFileArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePlainFileArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IIOModulePlainFileResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIIOModulePlainFileResult() *IIOModulePlainFileResult {
+  return &IIOModulePlainFileResult{}
+}
+
+var IIOModulePlainFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePlainFileResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModulePlainFileResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IIOModulePlainFileResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IIOModulePlainFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePlainFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePlainFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "plainFile_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePlainFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModulePlainFileResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePlainFileResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Path
+//  - MinPartitions
+//  - Delim
+type IIOModulePlainFile3Args struct {
+  Path string `thrift:"path,1" db:"path" json:"path"`
+  MinPartitions int64 `thrift:"minPartitions,2" db:"minPartitions" json:"minPartitions"`
+  Delim string `thrift:"delim,3" db:"delim" json:"delim"`
+}
+
+func NewIIOModulePlainFile3Args() *IIOModulePlainFile3Args {
+  return &IIOModulePlainFile3Args{}
+}
+
+
+func (p *IIOModulePlainFile3Args) GetPath() string {
+  return p.Path
+}
+
+func (p *IIOModulePlainFile3Args) GetMinPartitions() int64 {
+  return p.MinPartitions
+}
+
+func (p *IIOModulePlainFile3Args) GetDelim() string {
+  return p.Delim
+}
+func (p *IIOModulePlainFile3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePlainFile3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IIOModulePlainFile3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.MinPartitions = v
+}
+  return nil
+}
+
+func (p *IIOModulePlainFile3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Delim = v
+}
+  return nil
+}
+
+func (p *IIOModulePlainFile3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "plainFile3_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePlainFile3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePlainFile3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "minPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:minPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.MinPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.minPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:minPartitions: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePlainFile3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "delim", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:delim: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Delim)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.delim (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:delim: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePlainFile3Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePlainFile3Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IIOModulePlainFile3Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIIOModulePlainFile3Result() *IIOModulePlainFile3Result {
+  return &IIOModulePlainFile3Result{}
+}
+
+var IIOModulePlainFile3Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePlainFile3Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModulePlainFile3Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IIOModulePlainFile3Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IIOModulePlainFile3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePlainFile3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePlainFile3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "plainFile3_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModulePlainFile3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModulePlainFile3Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModulePlainFile3Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Path
+type IIOModuleTextFileArgs struct {
+  Path string `thrift:"path,1" db:"path" json:"path"`
+}
+
+func NewIIOModuleTextFileArgs() *IIOModuleTextFileArgs {
+  return &IIOModuleTextFileArgs{}
+}
+
+
+func (p *IIOModuleTextFileArgs) GetPath() string {
+  return p.Path
+}
+func (p *IIOModuleTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err != nil {
-    return
+  return nil
+}
+
+func (p *IIOModuleTextFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IIOModuleTextFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "textFile_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
-  return true, err
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
+func (p *IIOModuleTextFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path: ", p), err) }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *IIOModuleTextFileArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleTextFileArgs(%+v)", *p)
+}
 
 // Attributes:
-//  - Src
-type IIOModuleLoadClassArgs struct {
-  Src *rpc.ISource `thrift:"src,1" db:"src" json:"src"`
+//  - Ex
+type IIOModuleTextFileResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleLoadClassArgs() *IIOModuleLoadClassArgs {
-  return &IIOModuleLoadClassArgs{}
+func NewIIOModuleTextFileResult() *IIOModuleTextFileResult {
+  return &IIOModuleTextFileResult{}
 }
 
-var IIOModuleLoadClassArgs_Src_DEFAULT *rpc.ISource
-func (p *IIOModuleLoadClassArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IIOModuleLoadClassArgs_Src_DEFAULT
+var IIOModuleTextFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleTextFileResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IIOModuleTextFileResult_Ex_DEFAULT
   }
-return p.Src
+return p.Ex
 }
-func (p *IIOModuleLoadClassArgs) IsSetSrc() bool {
-  return p.Src != nil
+func (p *IIOModuleTextFileResult) IsSetEx() bool {
+  return p.Ex != nil
 }
 
-func (p *IIOModuleLoadClassArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleTextFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2006,19 +4372,16 @@ func (p *IIOModuleLoadClassArgs) Read(ctx context.Context, iprot thrift.TProtoco
   return nil
 }
 
-func (p *IIOModuleLoadClassArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+func (p *IIOModuleTextFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
   }
   return nil
 }
 
-func (p *IIOModuleLoadClassArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "loadClass_args"); err != nil {
+func (p *IIOModuleTextFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "textFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2030,46 +4393,175 @@ func (p *IIOModuleLoadClassArgs) Write(ctx context.Context, oprot thrift.TProtoc
   return nil
 }
 
-func (p *IIOModuleLoadClassArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+func (p *IIOModuleTextFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModuleTextFileResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IIOModuleTextFileResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Path
+//  - MinPartitions
+type IIOModuleTextFile2Args struct {
+  Path string `thrift:"path,1" db:"path" json:"path"`
+  MinPartitions int64 `thrift:"minPartitions,2" db:"minPartitions" json:"minPartitions"`
+}
+
+func NewIIOModuleTextFile2Args() *IIOModuleTextFile2Args {
+  return &IIOModuleTextFile2Args{}
+}
+
+
+func (p *IIOModuleTextFile2Args) GetPath() string {
+  return p.Path
+}
+
+func (p *IIOModuleTextFile2Args) GetMinPartitions() int64 {
+  return p.MinPartitions
+}
+func (p *IIOModuleTextFile2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleTextFile2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IIOModuleTextFile2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.MinPartitions = v
+}
+  return nil
+}
+
+func (p *IIOModuleTextFile2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "textFile2_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleTextFile2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path: ", p), err) }
   return err
 }
 
-func (p *IIOModuleLoadClassArgs) String() string {
+func (p *IIOModuleTextFile2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "minPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:minPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.MinPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.minPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:minPartitions: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleTextFile2Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleLoadClassArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleTextFile2Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModuleLoadClassResult struct {
+type IIOModuleTextFile2Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleLoadClassResult() *IIOModuleLoadClassResult {
-  return &IIOModuleLoadClassResult{}
+func NewIIOModuleTextFile2Result() *IIOModuleTextFile2Result {
+  return &IIOModuleTextFile2Result{}
 }
 
-var IIOModuleLoadClassResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModuleLoadClassResult) GetEx() *rpc.IExecutorException {
+var IIOModuleTextFile2Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleTextFile2Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModuleLoadClassResult_Ex_DEFAULT
+    return IIOModuleTextFile2Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModuleLoadClassResult) IsSetEx() bool {
+func (p *IIOModuleTextFile2Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModuleLoadClassResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleTextFile2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2107,7 +4599,7 @@ func (p *IIOModuleLoadClassResult) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IIOModuleLoadClassResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleTextFile2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2115,8 +4607,8 @@ func (p *IIOModuleLoadClassResult)  ReadField1(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IIOModuleLoadClassResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "loadClass_result"); err != nil {
+func (p *IIOModuleTextFile2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "textFile2_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2128,7 +4620,7 @@ func (p *IIOModuleLoadClassResult) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IIOModuleLoadClassResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleTextFile2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2141,28 +4633,34 @@ func (p *IIOModuleLoadClassResult) writeField1(ctx context.Context, oprot thrift
   return err
 }
 
-func (p *IIOModuleLoadClassResult) String() string {
+func (p *IIOModuleTextFile2Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleLoadClassResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleTextFile2Result(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
-type IIOModuleLoadLibraryArgs struct {
+//  - MinPartitions
+type IIOModuleSequenceFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
+  MinPartitions int64 `thrift:"minPartitions,2" db:"minPartitions" json:"minPartitions"`
 }
 
-func NewIIOModuleLoadLibraryArgs() *IIOModuleLoadLibraryArgs {
-  return &IIOModuleLoadLibraryArgs{}
+func NewIIOModuleSequenceFileArgs() *IIOModuleSequenceFileArgs {
+  return &IIOModuleSequenceFileArgs{}
 }
 
 
-func (p *IIOModuleLoadLibraryArgs) GetPath() string {
+func (p *IIOModuleSequenceFileArgs) GetPath() string {
   return p.Path
 }
-func (p *IIOModuleLoadLibraryArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IIOModuleSequenceFileArgs) GetMinPartitions() int64 {
+  return p.MinPartitions
+}
+func (p *IIOModuleSequenceFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2185,6 +4683,16 @@ func (p *IIOModuleLoadLibraryArgs) Read(ctx context.Context, iprot thrift.TProto
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -2200,7 +4708,7 @@ func (p *IIOModuleLoadLibraryArgs) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IIOModuleLoadLibraryArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSequenceFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -2209,11 +4717,21 @@ func (p *IIOModuleLoadLibraryArgs)  ReadField1(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IIOModuleLoadLibraryArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "loadLibrary_args"); err != nil {
+func (p *IIOModuleSequenceFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.MinPartitions = v
+}
+  return nil
+}
+
+func (p *IIOModuleSequenceFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sequenceFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2222,7 +4740,7 @@ func (p *IIOModuleLoadLibraryArgs) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IIOModuleLoadLibraryArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSequenceFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -2232,35 +4750,45 @@ func (p *IIOModuleLoadLibraryArgs) writeField1(ctx context.Context, oprot thrift
   return err
 }
 
-func (p *IIOModuleLoadLibraryArgs) String() string {
+func (p *IIOModuleSequenceFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "minPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:minPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.MinPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.minPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:minPartitions: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleSequenceFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleLoadLibraryArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSequenceFileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModuleLoadLibraryResult struct {
+type IIOModuleSequenceFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleLoadLibraryResult() *IIOModuleLoadLibraryResult {
-  return &IIOModuleLoadLibraryResult{}
+func NewIIOModuleSequenceFileResult() *IIOModuleSequenceFileResult {
+  return &IIOModuleSequenceFileResult{}
 }
 
-var IIOModuleLoadLibraryResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModuleLoadLibraryResult) GetEx() *rpc.IExecutorException {
+var IIOModuleSequenceFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleSequenceFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModuleLoadLibraryResult_Ex_DEFAULT
+    return IIOModuleSequenceFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModuleLoadLibraryResult) IsSetEx() bool {
+func (p *IIOModuleSequenceFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModuleLoadLibraryResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSequenceFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2298,7 +4826,7 @@ func (p *IIOModuleLoadLibraryResult) Read(ctx context.Context, iprot thrift.TPro
   return nil
 }
 
-func (p *IIOModuleLoadLibraryResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSequenceFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2306,8 +4834,8 @@ func (p *IIOModuleLoadLibraryResult)  ReadField1(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IIOModuleLoadLibraryResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "loadLibrary_result"); err != nil {
+func (p *IIOModuleSequenceFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sequenceFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2319,7 +4847,7 @@ func (p *IIOModuleLoadLibraryResult) Write(ctx context.Context, oprot thrift.TPr
   return nil
 }
 
-func (p *IIOModuleLoadLibraryResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSequenceFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2332,99 +4860,41 @@ func (p *IIOModuleLoadLibraryResult) writeField1(ctx context.Context, oprot thri
   return err
 }
 
-func (p *IIOModuleLoadLibraryResult) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("IIOModuleLoadLibraryResult(%+v)", *p)
-}
-
-type IIOModulePartitionCountArgs struct {
-}
-
-func NewIIOModulePartitionCountArgs() *IIOModulePartitionCountArgs {
-  return &IIOModulePartitionCountArgs{}
-}
-
-func (p *IIOModulePartitionCountArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-      return err
-    }
-    if err := iprot.ReadFieldEnd(ctx); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
-}
-
-func (p *IIOModulePartitionCountArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionCount_args"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-  }
-  if err := oprot.WriteFieldStop(ctx); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(ctx); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
-}
-
-func (p *IIOModulePartitionCountArgs) String() string {
+func (p *IIOModuleSequenceFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionCountArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSequenceFileResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
-//  - Ex
-type IIOModulePartitionCountResult struct {
-  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
-  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+//  - Path
+//  - Src
+type IIOModuleBinaryFileArgs struct {
+  Path string `thrift:"path,1" db:"path" json:"path"`
+  Src *rpc.ISource `thrift:"src,2" db:"src" json:"src"`
 }
 
-func NewIIOModulePartitionCountResult() *IIOModulePartitionCountResult {
-  return &IIOModulePartitionCountResult{}
+func NewIIOModuleBinaryFileArgs() *IIOModuleBinaryFileArgs {
+  return &IIOModuleBinaryFileArgs{}
 }
 
-var IIOModulePartitionCountResult_Success_DEFAULT int64
-func (p *IIOModulePartitionCountResult) GetSuccess() int64 {
-  if !p.IsSetSuccess() {
-    return IIOModulePartitionCountResult_Success_DEFAULT
-  }
-return *p.Success
+
+func (p *IIOModuleBinaryFileArgs) GetPath() string {
+  return p.Path
 }
-var IIOModulePartitionCountResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePartitionCountResult) GetEx() *rpc.IExecutorException {
-  if !p.IsSetEx() {
-    return IIOModulePartitionCountResult_Ex_DEFAULT
+var IIOModuleBinaryFileArgs_Src_DEFAULT *rpc.ISource
+func (p *IIOModuleBinaryFileArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IIOModuleBinaryFileArgs_Src_DEFAULT
   }
-return p.Ex
-}
-func (p *IIOModulePartitionCountResult) IsSetSuccess() bool {
-  return p.Success != nil
+return p.Src
 }
-
-func (p *IIOModulePartitionCountResult) IsSetEx() bool {
-  return p.Ex != nil
+func (p *IIOModuleBinaryFileArgs) IsSetSrc() bool {
+  return p.Src != nil
 }
 
-func (p *IIOModulePartitionCountResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleBinaryFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2437,9 +4907,9 @@ func (p *IIOModulePartitionCountResult) Read(ctx context.Context, iprot thrift.T
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField0(ctx, iprot); err != nil {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -2447,9 +4917,9 @@ func (p *IIOModulePartitionCountResult) Read(ctx context.Context, iprot thrift.T
           return err
         }
       }
-    case 1:
+    case 2:
       if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField1(ctx, iprot); err != nil {
+        if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -2472,29 +4942,32 @@ func (p *IIOModulePartitionCountResult) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IIOModulePartitionCountResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 0: ", err)
+func (p *IIOModuleBinaryFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Success = &v
+  p.Path = v
 }
   return nil
 }
 
-func (p *IIOModulePartitionCountResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Ex = &rpc.IExecutorException{}
-  if err := p.Ex.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+func (p *IIOModuleBinaryFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
   }
   return nil
 }
 
-func (p *IIOModulePartitionCountResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionCount_result"); err != nil {
+func (p *IIOModuleBinaryFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "binaryFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2503,122 +4976,56 @@ func (p *IIOModulePartitionCountResult) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IIOModulePartitionCountResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(ctx); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *IIOModulePartitionCountResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if p.IsSetEx() {
-    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
-    if err := p.Ex.Write(ctx, oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
-    }
-    if err := oprot.WriteFieldEnd(ctx); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
-  }
+func (p *IIOModuleBinaryFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path: ", p), err) }
   return err
 }
 
-func (p *IIOModulePartitionCountResult) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("IIOModulePartitionCountResult(%+v)", *p)
-}
-
-type IIOModuleCountByPartitionArgs struct {
-}
-
-func NewIIOModuleCountByPartitionArgs() *IIOModuleCountByPartitionArgs {
-  return &IIOModuleCountByPartitionArgs{}
-}
-
-func (p *IIOModuleCountByPartitionArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-      return err
-    }
-    if err := iprot.ReadFieldEnd(ctx); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
-}
-
-func (p *IIOModuleCountByPartitionArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "countByPartition_args"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
+func (p *IIOModuleBinaryFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
   }
-  if err := oprot.WriteFieldStop(ctx); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(ctx); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:src: ", p), err) }
+  return err
 }
 
-func (p *IIOModuleCountByPartitionArgs) String() string {
+func (p *IIOModuleBinaryFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleCountByPartitionArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleBinaryFileArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - Ex
-type IIOModuleCountByPartitionResult struct {
-  Success []int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+type IIOModuleBinaryFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleCountByPartitionResult() *IIOModuleCountByPartitionResult {
-  return &IIOModuleCountByPartitionResult{}
+func NewIIOModuleBinaryFileResult() *IIOModuleBinaryFileResult {
+  return &IIOModuleBinaryFileResult{}
 }
 
-var IIOModuleCountByPartitionResult_Success_DEFAULT []int64
-
-func (p *IIOModuleCountByPartitionResult) GetSuccess() []int64 {
-  return p.Success
-}
-var IIOModuleCountByPartitionResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModuleCountByPartitionResult) GetEx() *rpc.IExecutorException {
+var IIOModuleBinaryFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleBinaryFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModuleCountByPartitionResult_Ex_DEFAULT
+    return IIOModuleBinaryFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModuleCountByPartitionResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *IIOModuleCountByPartitionResult) IsSetEx() bool {
+func (p *IIOModuleBinaryFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModuleCountByPartitionResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleBinaryFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2631,16 +5038,6 @@ func (p *IIOModuleCountByPartitionResult) Read(ctx context.Context, iprot thrift
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if fieldTypeId == thrift.LIST {
-        if err := p.ReadField0(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     case 1:
       if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
@@ -2666,29 +5063,7 @@ func (p *IIOModuleCountByPartitionResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IIOModuleCountByPartitionResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin(ctx)
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]int64, 0, size)
-  p.Success =  tSlice
-  for i := 0; i < size; i ++ {
-var _elem53 int64
-    if v, err := iprot.ReadI64(ctx); err != nil {
-    return thrift.PrependError("error reading field 0: ", err)
-} else {
-    _elem53 = v
-}
-    p.Success = append(p.Success, _elem53)
-  }
-  if err := iprot.ReadListEnd(ctx); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
-  return nil
-}
-
-func (p *IIOModuleCountByPartitionResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleBinaryFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2696,11 +5071,10 @@ func (p *IIOModuleCountByPartitionResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IIOModuleCountByPartitionResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "countByPartition_result"); err != nil {
+func (p *IIOModuleBinaryFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "binaryFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
@@ -2710,27 +5084,7 @@ func (p *IIOModuleCountByPartitionResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IIOModuleCountByPartitionResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin(ctx, "success", thrift.LIST, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.Success)); err != nil {
-      return thrift.PrependError("error writing list begin: ", err)
-    }
-    for _, v := range p.Success {
-      if err := oprot.WriteI64(ctx, int64(v)); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
-    }
-    if err := oprot.WriteListEnd(ctx); err != nil {
-      return thrift.PrependError("error writing list end: ", err)
-    }
-    if err := oprot.WriteFieldEnd(ctx); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *IIOModuleCountByPartitionResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleBinaryFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2743,21 +5097,65 @@ func (p *IIOModuleCountByPartitionResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IIOModuleCountByPartitionResult) String() string {
+func (p *IIOModuleBinaryFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleCountByPartitionResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleBinaryFileResult(%+v)", *p)
 }
 
-type IIOModulePartitionApproxSizeArgs struct {
+// Attributes:
+//  - Path
+//  - MinPartitions
+//  - Delimiter
+//  - Quote
+//  - Header
+//  - Src
+type IIOModuleCsvFileArgs struct {
+  Path string `thrift:"path,1" db:"path" json:"path"`
+  MinPartitions int64 `thrift:"minPartitions,2" db:"minPartitions" json:"minPartitions"`
+  Delimiter string `thrift:"delimiter,3" db:"delimiter" json:"delimiter"`
+  Quote string `thrift:"quote,4" db:"quote" json:"quote"`
+  Header bool `thrift:"header,5" db:"header" json:"header"`
+  Src *rpc.ISource `thrift:"src,6" db:"src" json:"src"`
 }
 
-func NewIIOModulePartitionApproxSizeArgs() *IIOModulePartitionApproxSizeArgs {
-  return &IIOModulePartitionApproxSizeArgs{}
+func NewIIOModuleCsvFileArgs() *IIOModuleCsvFileArgs {
+  return &IIOModuleCsvFileArgs{}
 }
 
-func (p *IIOModulePartitionApproxSizeArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IIOModuleCsvFileArgs) GetPath() string {
+  return p.Path
+}
+
+func (p *IIOModuleCsvFileArgs) GetMinPartitions() int64 {
+  return p.MinPartitions
+}
+
+func (p *IIOModuleCsvFileArgs) GetDelimiter() string {
+  return p.Delimiter
+}
+
+func (p *IIOModuleCsvFileArgs) GetQuote() string {
+  return p.Quote
+}
+
+func (p *IIOModuleCsvFileArgs) GetHeader() bool {
+  return p.Header
+}
+var IIOModuleCsvFileArgs_Src_DEFAULT *rpc.ISource
+func (p *IIOModuleCsvFileArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IIOModuleCsvFileArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IIOModuleCsvFileArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IIOModuleCsvFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2769,8 +5167,71 @@ func (p *IIOModulePartitionApproxSizeArgs) Read(ctx context.Context, iprot thrif
       return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
     if fieldTypeId == thrift.STOP { break; }
-    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-      return err
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField5(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField6(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
     }
     if err := iprot.ReadFieldEnd(ctx); err != nil {
       return err
@@ -2782,60 +5243,170 @@ func (p *IIOModulePartitionApproxSizeArgs) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IIOModulePartitionApproxSizeArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionApproxSize_args"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
+func (p *IIOModuleCsvFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Path = v
+}
+  return nil
+}
+
+func (p *IIOModuleCsvFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.MinPartitions = v
+}
+  return nil
+}
+
+func (p *IIOModuleCsvFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Delimiter = v
+}
+  return nil
+}
+
+func (p *IIOModuleCsvFileArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Quote = v
+}
+  return nil
+}
+
+func (p *IIOModuleCsvFileArgs)  ReadField5(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.Header = v
+}
+  return nil
+}
+
+func (p *IIOModuleCsvFileArgs)  ReadField6(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleCsvFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "csvFile_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
+    if err := p.writeField5(ctx, oprot); err != nil { return err }
+    if err := p.writeField6(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IIOModuleCsvFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.path (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:path: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleCsvFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "minPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:minPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.MinPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.minPartitions (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:minPartitions: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleCsvFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "delimiter", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:delimiter: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Delimiter)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.delimiter (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:delimiter: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleCsvFileArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "quote", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:quote: ", p), err) }
+  if err := oprot.WriteString(ctx, string(p.Quote)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.quote (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:quote: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleCsvFileArgs) writeField5(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "header", thrift.BOOL, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:header: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Header)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.header (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:header: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleCsvFileArgs) writeField6(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
   }
-  if err := oprot.WriteFieldStop(ctx); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(ctx); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:src: ", p), err) }
+  return err
 }
 
-func (p *IIOModulePartitionApproxSizeArgs) String() string {
+func (p *IIOModuleCsvFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionApproxSizeArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleCsvFileArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - Ex
-type IIOModulePartitionApproxSizeResult struct {
-  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+type IIOModuleCsvFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePartitionApproxSizeResult() *IIOModulePartitionApproxSizeResult {
-  return &IIOModulePartitionApproxSizeResult{}
+func NewIIOModuleCsvFileResult() *IIOModuleCsvFileResult {
+  return &IIOModuleCsvFileResult{}
 }
 
-var IIOModulePartitionApproxSizeResult_Success_DEFAULT int64
-func (p *IIOModulePartitionApproxSizeResult) GetSuccess() int64 {
-  if !p.IsSetSuccess() {
-    return IIOModulePartitionApproxSizeResult_Success_DEFAULT
-  }
-return *p.Success
-}
-var IIOModulePartitionApproxSizeResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePartitionApproxSizeResult) GetEx() *rpc.IExecutorException {
+var IIOModuleCsvFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleCsvFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePartitionApproxSizeResult_Ex_DEFAULT
+    return IIOModuleCsvFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePartitionApproxSizeResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *IIOModulePartitionApproxSizeResult) IsSetEx() bool {
+func (p *IIOModuleCsvFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePartitionApproxSizeResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleCsvFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2848,16 +5419,6 @@ func (p *IIOModulePartitionApproxSizeResult) Read(ctx context.Context, iprot thr
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField0(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     case 1:
       if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
@@ -2883,16 +5444,7 @@ func (p *IIOModulePartitionApproxSizeResult) Read(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IIOModulePartitionApproxSizeResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 0: ", err)
-} else {
-  p.Success = &v
-}
-  return nil
-}
-
-func (p *IIOModulePartitionApproxSizeResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleCsvFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2900,11 +5452,10 @@ func (p *IIOModulePartitionApproxSizeResult)  ReadField1(ctx context.Context, ip
   return nil
 }
 
-func (p *IIOModulePartitionApproxSizeResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionApproxSize_result"); err != nil {
+func (p *IIOModuleCsvFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "csvFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
@@ -2914,19 +5465,7 @@ func (p *IIOModulePartitionApproxSizeResult) Write(ctx context.Context, oprot th
   return nil
 }
 
-func (p *IIOModulePartitionApproxSizeResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(ctx); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *IIOModulePartitionApproxSizeResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleCsvFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2939,34 +5478,53 @@ func (p *IIOModulePartitionApproxSizeResult) writeField1(ctx context.Context, op
   return err
 }
 
-func (p *IIOModulePartitionApproxSizeResult) String() string {
+func (p *IIOModuleCsvFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionApproxSizeResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleCsvFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
-//  - Delim
-type IIOModulePlainFileArgs struct {
+//  - MinPartitions
+//  - Permissive
+//  - Src
+type IIOModuleJsonFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
-  Delim string `thrift:"delim,2" db:"delim" json:"delim"`
+  MinPartitions int64 `thrift:"minPartitions,2" db:"minPartitions" json:"minPartitions"`
+  Permissive bool `thrift:"permissive,3" db:"permissive" json:"permissive"`
+  Src *rpc.ISource `thrift:"src,4" db:"src" json:"src"`
 }
 
-func NewIIOModulePlainFileArgs() *IIOModulePlainFileArgs {
-  return &IIOModulePlainFileArgs{}
+func NewIIOModuleJsonFileArgs() *IIOModuleJsonFileArgs {
+  return &IIOModuleJsonFileArgs{}
 }
 
 
-func (p *IIOModulePlainFileArgs) GetPath() string {
+func (p *IIOModuleJsonFileArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModulePlainFileArgs) GetDelim() string {
-  return p.Delim
+func (p *IIOModuleJsonFileArgs) GetMinPartitions() int64 {
+  return p.MinPartitions
 }
-func (p *IIOModulePlainFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IIOModuleJsonFileArgs) GetPermissive() bool {
+  return p.Permissive
+}
+var IIOModuleJsonFileArgs_Src_DEFAULT *rpc.ISource
+func (p *IIOModuleJsonFileArgs) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IIOModuleJsonFileArgs_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IIOModuleJsonFileArgs) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IIOModuleJsonFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2990,7 +5548,7 @@ func (p *IIOModulePlainFileArgs) Read(ctx context.Context, iprot thrift.TProtoco
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -2999,6 +5557,26 @@ func (p *IIOModulePlainFileArgs) Read(ctx context.Context, iprot thrift.TProtoco
           return err
         }
       }
+    case 3:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField4(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -3014,7 +5592,7 @@ func (p *IIOModulePlainFileArgs) Read(ctx context.Context, iprot thrift.TProtoco
   return nil
 }
 
-func (p *IIOModulePlainFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleJsonFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -3023,21 +5601,43 @@ func (p *IIOModulePlainFileArgs)  ReadField1(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IIOModulePlainFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(ctx); err != nil {
+func (p *IIOModuleJsonFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.Delim = v
+  p.MinPartitions = v
 }
   return nil
 }
 
-func (p *IIOModulePlainFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "plainFile_args"); err != nil {
+func (p *IIOModuleJsonFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Permissive = v
+}
+  return nil
+}
+
+func (p *IIOModuleJsonFileArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleJsonFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "jsonFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -3046,7 +5646,7 @@ func (p *IIOModulePlainFileArgs) Write(ctx context.Context, oprot thrift.TProtoc
   return nil
 }
 
-func (p *IIOModulePlainFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleJsonFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -3056,45 +5656,79 @@ func (p *IIOModulePlainFileArgs) writeField1(ctx context.Context, oprot thrift.T
   return err
 }
 
-func (p *IIOModulePlainFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "delim", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:delim: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.Delim)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.delim (2) field write error: ", p), err) }
+func (p *IIOModuleJsonFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "minPartitions", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:minPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.MinPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.minPartitions (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:delim: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:minPartitions: ", p), err) }
   return err
 }
 
-func (p *IIOModulePlainFileArgs) String() string {
+func (p *IIOModuleJsonFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "permissive", thrift.BOOL, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:permissive: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.Permissive)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.permissive (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:permissive: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleJsonFileArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:src: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleJsonFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePlainFileArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleJsonFileArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - Ex
-type IIOModulePlainFileResult struct {
+type IIOModuleJsonFileResult struct {
+  Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePlainFileResult() *IIOModulePlainFileResult {
-  return &IIOModulePlainFileResult{}
+func NewIIOModuleJsonFileResult() *IIOModuleJsonFileResult {
+  return &IIOModuleJsonFileResult{}
 }
 
-var IIOModulePlainFileResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePlainFileResult) GetEx() *rpc.IExecutorException {
+var IIOModuleJsonFileResult_Success_DEFAULT string
+func (p *IIOModuleJsonFileResult) GetSuccess() string {
+  if !p.IsSetSuccess() {
+    return IIOModuleJsonFileResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IIOModuleJsonFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleJsonFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePlainFileResult_Ex_DEFAULT
+    return IIOModuleJsonFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePlainFileResult) IsSetEx() bool {
+func (p *IIOModuleJsonFileResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IIOModuleJsonFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePlainFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleJsonFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3107,6 +5741,16 @@ func (p *IIOModulePlainFileResult) Read(ctx context.Context, iprot thrift.TProto
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     case 1:
       if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
@@ -3132,7 +5776,16 @@ func (p *IIOModulePlainFileResult) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IIOModulePlainFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleJsonFileResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *IIOModuleJsonFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -3140,10 +5793,11 @@ func (p *IIOModulePlainFileResult)  ReadField1(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IIOModulePlainFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "plainFile_result"); err != nil {
+func (p *IIOModuleJsonFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "jsonFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
@@ -3153,7 +5807,19 @@ func (p *IIOModulePlainFileResult) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IIOModulePlainFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleJsonFileResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.STRING, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteString(ctx, string(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IIOModuleJsonFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -3166,40 +5832,40 @@ func (p *IIOModulePlainFileResult) writeField1(ctx context.Context, oprot thrift
   return err
 }
 
-func (p *IIOModulePlainFileResult) String() string {
+func (p *IIOModuleJsonFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePlainFileResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleJsonFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
-//  - MinPartitions
-//  - Delim
-type IIOModulePlainFile3Args struct {
+//  - First
+//  - Partitions
+type IIOModulePartitionObjectFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
-  MinPartitions int64 `thrift:"minPartitions,2" db:"minPartitions" json:"minPartitions"`
-  Delim string `thrift:"delim,3" db:"delim" json:"delim"`
+  First int64 `thrift:"first,2" db:"first" json:"first"`
+  Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
 }
 
-func NewIIOModulePlainFile3Args() *IIOModulePlainFile3Args {
-  return &IIOModulePlainFile3Args{}
+func NewIIOModulePartitionObjectFileArgs() *IIOModulePartitionObjectFileArgs {
+  return &IIOModulePartitionObjectFileArgs{}
 }
 
 
-func (p *IIOModulePlainFile3Args) GetPath() string {
+func (p *IIOModulePartitionObjectFileArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModulePlainFile3Args) GetMinPartitions() int64 {
-  return p.MinPartitions
+func (p *IIOModulePartitionObjectFileArgs) GetFirst() int64 {
+  return p.First
 }
 
-func (p *IIOModulePlainFile3Args) GetDelim() string {
-  return p.Delim
+func (p *IIOModulePartitionObjectFileArgs) GetPartitions() int64 {
+  return p.Partitions
 }
-func (p *IIOModulePlainFile3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3233,7 +5899,7 @@ func (p *IIOModulePlainFile3Args) Read(ctx context.Context, iprot thrift.TProtoc
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField3(ctx, iprot); err != nil {
           return err
         }
@@ -3257,7 +5923,7 @@ func (p *IIOModulePlainFile3Args) Read(ctx context.Context, iprot thrift.TProtoc
   return nil
 }
 
-func (p *IIOModulePlainFile3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -3266,26 +5932,26 @@ func (p *IIOModulePlainFile3Args)  ReadField1(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IIOModulePlainFile3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.MinPartitions = v
+  p.First = v
 }
   return nil
 }
 
-func (p *IIOModulePlainFile3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(ctx); err != nil {
+func (p *IIOModulePartitionObjectFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Delim = v
+  p.Partitions = v
 }
   return nil
 }
 
-func (p *IIOModulePlainFile3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "plainFile3_args"); err != nil {
+func (p *IIOModulePartitionObjectFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -3299,7 +5965,7 @@ func (p *IIOModulePlainFile3Args) Write(ctx context.Context, oprot thrift.TProto
   return nil
 }
 
-func (p *IIOModulePlainFile3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionObjectFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -3309,55 +5975,55 @@ func (p *IIOModulePlainFile3Args) writeField1(ctx context.Context, oprot thrift.
   return err
 }
 
-func (p *IIOModulePlainFile3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "minPartitions", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:minPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.MinPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.minPartitions (2) field write error: ", p), err) }
+func (p *IIOModulePartitionObjectFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.first (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:minPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:first: ", p), err) }
   return err
 }
 
-func (p *IIOModulePlainFile3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "delim", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:delim: ", p), err) }
-  if err := oprot.WriteString(ctx, string(p.Delim)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.delim (3) field write error: ", p), err) }
+func (p *IIOModulePartitionObjectFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.partitions (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:delim: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:partitions: ", p), err) }
   return err
 }
 
-func (p *IIOModulePlainFile3Args) String() string {
+func (p *IIOModulePartitionObjectFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePlainFile3Args(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionObjectFileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModulePlainFile3Result struct {
+type IIOModulePartitionObjectFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePlainFile3Result() *IIOModulePlainFile3Result {
-  return &IIOModulePlainFile3Result{}
+func NewIIOModulePartitionObjectFileResult() *IIOModulePartitionObjectFileResult {
+  return &IIOModulePartitionObjectFileResult{}
 }
 
-var IIOModulePlainFile3Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePlainFile3Result) GetEx() *rpc.IExecutorException {
+var IIOModulePartitionObjectFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePartitionObjectFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePlainFile3Result_Ex_DEFAULT
+    return IIOModulePartitionObjectFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePlainFile3Result) IsSetEx() bool {
+func (p *IIOModulePartitionObjectFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePlainFile3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3395,7 +6061,7 @@ func (p *IIOModulePlainFile3Result) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IIOModulePlainFile3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -3403,8 +6069,8 @@ func (p *IIOModulePlainFile3Result)  ReadField1(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IIOModulePlainFile3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "plainFile3_result"); err != nil {
+func (p *IIOModulePartitionObjectFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -3416,7 +6082,7 @@ func (p *IIOModulePlainFile3Result) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IIOModulePlainFile3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionObjectFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -3429,28 +6095,53 @@ func (p *IIOModulePlainFile3Result) writeField1(ctx context.Context, oprot thrif
   return err
 }
 
-func (p *IIOModulePlainFile3Result) String() string {
+func (p *IIOModulePartitionObjectFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePlainFile3Result(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionObjectFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
-type IIOModuleTextFileArgs struct {
+//  - First
+//  - Partitions
+//  - Src
+type IIOModulePartitionObjectFile4Args struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
+  First int64 `thrift:"first,2" db:"first" json:"first"`
+  Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
+  Src *rpc.ISource `thrift:"src,4" db:"src" json:"src"`
 }
 
-func NewIIOModuleTextFileArgs() *IIOModuleTextFileArgs {
-  return &IIOModuleTextFileArgs{}
+func NewIIOModulePartitionObjectFile4Args() *IIOModulePartitionObjectFile4Args {
+  return &IIOModulePartitionObjectFile4Args{}
 }
 
 
-func (p *IIOModuleTextFileArgs) GetPath() string {
+func (p *IIOModulePartitionObjectFile4Args) GetPath() string {
   return p.Path
 }
-func (p *IIOModuleTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IIOModulePartitionObjectFile4Args) GetFirst() int64 {
+  return p.First
+}
+
+func (p *IIOModulePartitionObjectFile4Args) GetPartitions() int64 {
+  return p.Partitions
+}
+var IIOModulePartitionObjectFile4Args_Src_DEFAULT *rpc.ISource
+func (p *IIOModulePartitionObjectFile4Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IIOModulePartitionObjectFile4Args_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IIOModulePartitionObjectFile4Args) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IIOModulePartitionObjectFile4Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3473,6 +6164,36 @@ func (p *IIOModuleTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField4(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -3488,7 +6209,7 @@ func (p *IIOModuleTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol
   return nil
 }
 
-func (p *IIOModuleTextFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFile4Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -3497,11 +6218,43 @@ func (p *IIOModuleTextFileArgs)  ReadField1(ctx context.Context, iprot thrift.TP
   return nil
 }
 
-func (p *IIOModuleTextFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "textFile_args"); err != nil {
+func (p *IIOModulePartitionObjectFile4Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.First = v
+}
+  return nil
+}
+
+func (p *IIOModulePartitionObjectFile4Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Partitions = v
+}
+  return nil
+}
+
+func (p *IIOModulePartitionObjectFile4Args)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IIOModulePartitionObjectFile4Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile4_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -3510,7 +6263,7 @@ func (p *IIOModuleTextFileArgs) Write(ctx context.Context, oprot thrift.TProtoco
   return nil
 }
 
-func (p *IIOModuleTextFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionObjectFile4Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -3520,35 +6273,66 @@ func (p *IIOModuleTextFileArgs) writeField1(ctx context.Context, oprot thrift.TP
   return err
 }
 
-func (p *IIOModuleTextFileArgs) String() string {
+func (p *IIOModulePartitionObjectFile4Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.first (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:first: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePartitionObjectFile4Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.partitions (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:partitions: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePartitionObjectFile4Args) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:src: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePartitionObjectFile4Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleTextFileArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionObjectFile4Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModuleTextFileResult struct {
+type IIOModulePartitionObjectFile4Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleTextFileResult() *IIOModuleTextFileResult {
-  return &IIOModuleTextFileResult{}
+func NewIIOModulePartitionObjectFile4Result() *IIOModulePartitionObjectFile4Result {
+  return &IIOModulePartitionObjectFile4Result{}
 }
 
-var IIOModuleTextFileResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModuleTextFileResult) GetEx() *rpc.IExecutorException {
+var IIOModulePartitionObjectFile4Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePartitionObjectFile4Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModuleTextFileResult_Ex_DEFAULT
+    return IIOModulePartitionObjectFile4Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModuleTextFileResult) IsSetEx() bool {
+func (p *IIOModulePartitionObjectFile4Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModuleTextFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFile4Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3586,7 +6370,7 @@ func (p *IIOModuleTextFileResult) Read(ctx context.Context, iprot thrift.TProtoc
   return nil
 }
 
-func (p *IIOModuleTextFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionObjectFile4Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -3594,8 +6378,8 @@ func (p *IIOModuleTextFileResult)  ReadField1(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IIOModuleTextFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "textFile_result"); err != nil {
+func (p *IIOModulePartitionObjectFile4Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile4_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -3607,7 +6391,7 @@ func (p *IIOModuleTextFileResult) Write(ctx context.Context, oprot thrift.TProto
   return nil
 }
 
-func (p *IIOModuleTextFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionObjectFile4Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -3620,34 +6404,40 @@ func (p *IIOModuleTextFileResult) writeField1(ctx context.Context, oprot thrift.
   return err
 }
 
-func (p *IIOModuleTextFileResult) String() string {
+func (p *IIOModulePartitionObjectFile4Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleTextFileResult(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionObjectFile4Result(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
-//  - MinPartitions
-type IIOModuleTextFile2Args struct {
+//  - First
+//  - Partitions
+type IIOModulePartitionTextFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
-  MinPartitions int64 `thrift:"minPartitions,2" db:"minPartitions" json:"minPartitions"`
+  First int64 `thrift:"first,2" db:"first" json:"first"`
+  Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
 }
 
-func NewIIOModuleTextFile2Args() *IIOModuleTextFile2Args {
-  return &IIOModuleTextFile2Args{}
+func NewIIOModulePartitionTextFileArgs() *IIOModulePartitionTextFileArgs {
+  return &IIOModulePartitionTextFileArgs{}
 }
 
 
-func (p *IIOModuleTextFile2Args) GetPath() string {
+func (p *IIOModulePartitionTextFileArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModuleTextFile2Args) GetMinPartitions() int64 {
-  return p.MinPartitions
+func (p *IIOModulePartitionTextFileArgs) GetFirst() int64 {
+  return p.First
 }
-func (p *IIOModuleTextFile2Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IIOModulePartitionTextFileArgs) GetPartitions() int64 {
+  return p.Partitions
+}
+func (p *IIOModulePartitionTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3680,6 +6470,16 @@ func (p *IIOModuleTextFile2Args) Read(ctx context.Context, iprot thrift.TProtoco
           return err
         }
       }
+    case 3:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -3695,7 +6495,7 @@ func (p *IIOModuleTextFile2Args) Read(ctx context.Context, iprot thrift.TProtoco
   return nil
 }
 
-func (p *IIOModuleTextFile2Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionTextFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -3704,21 +6504,31 @@ func (p *IIOModuleTextFile2Args)  ReadField1(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IIOModuleTextFile2Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionTextFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.MinPartitions = v
+  p.First = v
 }
   return nil
 }
 
-func (p *IIOModuleTextFile2Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "textFile2_args"); err != nil {
+func (p *IIOModulePartitionTextFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Partitions = v
+}
+  return nil
+}
+
+func (p *IIOModulePartitionTextFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionTextFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -3727,7 +6537,7 @@ func (p *IIOModuleTextFile2Args) Write(ctx context.Context, oprot thrift.TProtoc
   return nil
 }
 
-func (p *IIOModuleTextFile2Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionTextFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -3737,45 +6547,55 @@ func (p *IIOModuleTextFile2Args) writeField1(ctx context.Context, oprot thrift.T
   return err
 }
 
-func (p *IIOModuleTextFile2Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "minPartitions", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:minPartitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.MinPartitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.minPartitions (2) field write error: ", p), err) }
+func (p *IIOModulePartitionTextFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.first (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:minPartitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:first: ", p), err) }
   return err
 }
 
-func (p *IIOModuleTextFile2Args) String() string {
+func (p *IIOModulePartitionTextFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.partitions (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:partitions: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePartitionTextFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleTextFile2Args(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionTextFileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModuleTextFile2Result struct {
+type IIOModulePartitionTextFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleTextFile2Result() *IIOModuleTextFile2Result {
-  return &IIOModuleTextFile2Result{}
+func NewIIOModulePartitionTextFileResult() *IIOModulePartitionTextFileResult {
+  return &IIOModulePartitionTextFileResult{}
 }
 
-var IIOModuleTextFile2Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModuleTextFile2Result) GetEx() *rpc.IExecutorException {
+var IIOModulePartitionTextFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePartitionTextFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModuleTextFile2Result_Ex_DEFAULT
+    return IIOModulePartitionTextFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModuleTextFile2Result) IsSetEx() bool {
+func (p *IIOModulePartitionTextFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModuleTextFile2Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionTextFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3813,7 +6633,7 @@ func (p *IIOModuleTextFile2Result) Read(ctx context.Context, iprot thrift.TProto
   return nil
 }
 
-func (p *IIOModuleTextFile2Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionTextFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -3821,8 +6641,8 @@ func (p *IIOModuleTextFile2Result)  ReadField1(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IIOModuleTextFile2Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "textFile2_result"); err != nil {
+func (p *IIOModulePartitionTextFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionTextFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -3834,7 +6654,7 @@ func (p *IIOModuleTextFile2Result) Write(ctx context.Context, oprot thrift.TProt
   return nil
 }
 
-func (p *IIOModuleTextFile2Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionTextFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -3847,40 +6667,46 @@ func (p *IIOModuleTextFile2Result) writeField1(ctx context.Context, oprot thrift
   return err
 }
 
-func (p *IIOModuleTextFile2Result) String() string {
+func (p *IIOModulePartitionTextFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleTextFile2Result(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionTextFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
 //  - First
 //  - Partitions
-type IIOModulePartitionObjectFileArgs struct {
+//  - ObjectMapping
+type IIOModulePartitionJsonFile4aArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
   First int64 `thrift:"first,2" db:"first" json:"first"`
   Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
+  ObjectMapping bool `thrift:"objectMapping,4" db:"objectMapping" json:"objectMapping"`
 }
 
-func NewIIOModulePartitionObjectFileArgs() *IIOModulePartitionObjectFileArgs {
-  return &IIOModulePartitionObjectFileArgs{}
+func NewIIOModulePartitionJsonFile4aArgs() *IIOModulePartitionJsonFile4aArgs {
+  return &IIOModulePartitionJsonFile4aArgs{}
 }
 
 
-func (p *IIOModulePartitionObjectFileArgs) GetPath() string {
+func (p *IIOModulePartitionJsonFile4aArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModulePartitionObjectFileArgs) GetFirst() int64 {
+func (p *IIOModulePartitionJsonFile4aArgs) GetFirst() int64 {
   return p.First
 }
 
-func (p *IIOModulePartitionObjectFileArgs) GetPartitions() int64 {
+func (p *IIOModulePartitionJsonFile4aArgs) GetPartitions() int64 {
   return p.Partitions
 }
-func (p *IIOModulePartitionObjectFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IIOModulePartitionJsonFile4aArgs) GetObjectMapping() bool {
+  return p.ObjectMapping
+}
+func (p *IIOModulePartitionJsonFile4aArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3923,6 +6749,16 @@ func (p *IIOModulePartitionObjectFileArgs) Read(ctx context.Context, iprot thrif
           return err
         }
       }
+    case 4:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField4(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -3938,7 +6774,7 @@ func (p *IIOModulePartitionObjectFileArgs) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4aArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -3947,7 +6783,7 @@ func (p *IIOModulePartitionObjectFileArgs)  ReadField1(ctx context.Context, ipro
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4aArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
@@ -3956,7 +6792,7 @@ func (p *IIOModulePartitionObjectFileArgs)  ReadField2(ctx context.Context, ipro
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4aArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
@@ -3965,13 +6801,23 @@ func (p *IIOModulePartitionObjectFileArgs)  ReadField3(ctx context.Context, ipro
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile_args"); err != nil {
+func (p *IIOModulePartitionJsonFile4aArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.ObjectMapping = v
+}
+  return nil
+}
+
+func (p *IIOModulePartitionJsonFile4aArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4a_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
     if err := p.writeField3(ctx, oprot); err != nil { return err }
+    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -3980,7 +6826,7 @@ func (p *IIOModulePartitionObjectFileArgs) Write(ctx context.Context, oprot thri
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4aArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -3990,7 +6836,7 @@ func (p *IIOModulePartitionObjectFileArgs) writeField1(ctx context.Context, opro
   return err
 }
 
-func (p *IIOModulePartitionObjectFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4aArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
@@ -4000,7 +6846,7 @@ func (p *IIOModulePartitionObjectFileArgs) writeField2(ctx context.Context, opro
   return err
 }
 
-func (p *IIOModulePartitionObjectFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4aArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
@@ -4010,35 +6856,45 @@ func (p *IIOModulePartitionObjectFileArgs) writeField3(ctx context.Context, opro
   return err
 }
 
-func (p *IIOModulePartitionObjectFileArgs) String() string {
+func (p *IIOModulePartitionJsonFile4aArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "objectMapping", thrift.BOOL, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:objectMapping: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.ObjectMapping)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.objectMapping (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:objectMapping: ", p), err) }
+  return err
+}
+
+func (p *IIOModulePartitionJsonFile4aArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionObjectFileArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionJsonFile4aArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModulePartitionObjectFileResult struct {
+type IIOModulePartitionJsonFile4aResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePartitionObjectFileResult() *IIOModulePartitionObjectFileResult {
-  return &IIOModulePartitionObjectFileResult{}
+func NewIIOModulePartitionJsonFile4aResult() *IIOModulePartitionJsonFile4aResult {
+  return &IIOModulePartitionJsonFile4aResult{}
 }
 
-var IIOModulePartitionObjectFileResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePartitionObjectFileResult) GetEx() *rpc.IExecutorException {
+var IIOModulePartitionJsonFile4aResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePartitionJsonFile4aResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePartitionObjectFileResult_Ex_DEFAULT
+    return IIOModulePartitionJsonFile4aResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePartitionObjectFileResult) IsSetEx() bool {
+func (p *IIOModulePartitionJsonFile4aResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePartitionObjectFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4aResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4076,7 +6932,7 @@ func (p *IIOModulePartitionObjectFileResult) Read(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4aResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4084,8 +6940,8 @@ func (p *IIOModulePartitionObjectFileResult)  ReadField1(ctx context.Context, ip
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile_result"); err != nil {
+func (p *IIOModulePartitionJsonFile4aResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4a_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4097,7 +6953,7 @@ func (p *IIOModulePartitionObjectFileResult) Write(ctx context.Context, oprot th
   return nil
 }
 
-func (p *IIOModulePartitionObjectFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4aResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4110,11 +6966,11 @@ func (p *IIOModulePartitionObjectFileResult) writeField1(ctx context.Context, op
   return err
 }
 
-func (p *IIOModulePartitionObjectFileResult) String() string {
+func (p *IIOModulePartitionJsonFile4aResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionObjectFileResult(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionJsonFile4aResult(%+v)", *p)
 }
 
 // Attributes:
@@ -4122,41 +6978,41 @@ func (p *IIOModulePartitionObjectFileResult) String() string {
 //  - First
 //  - Partitions
 //  - Src
-type IIOModulePartitionObjectFile4Args struct {
+type IIOModulePartitionJsonFile4bArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
   First int64 `thrift:"first,2" db:"first" json:"first"`
   Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
   Src *rpc.ISource `thrift:"src,4" db:"src" json:"src"`
 }
 
-func NewIIOModulePartitionObjectFile4Args() *IIOModulePartitionObjectFile4Args {
-  return &IIOModulePartitionObjectFile4Args{}
+func NewIIOModulePartitionJsonFile4bArgs() *IIOModulePartitionJsonFile4bArgs {
+  return &IIOModulePartitionJsonFile4bArgs{}
 }
 
 
-func (p *IIOModulePartitionObjectFile4Args) GetPath() string {
+func (p *IIOModulePartitionJsonFile4bArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModulePartitionObjectFile4Args) GetFirst() int64 {
+func (p *IIOModulePartitionJsonFile4bArgs) GetFirst() int64 {
   return p.First
 }
 
-func (p *IIOModulePartitionObjectFile4Args) GetPartitions() int64 {
+func (p *IIOModulePartitionJsonFile4bArgs) GetPartitions() int64 {
   return p.Partitions
 }
-var IIOModulePartitionObjectFile4Args_Src_DEFAULT *rpc.ISource
-func (p *IIOModulePartitionObjectFile4Args) GetSrc() *rpc.ISource {
+var IIOModulePartitionJsonFile4bArgs_Src_DEFAULT *rpc.ISource
+func (p *IIOModulePartitionJsonFile4bArgs) GetSrc() *rpc.ISource {
   if !p.IsSetSrc() {
-    return IIOModulePartitionObjectFile4Args_Src_DEFAULT
+    return IIOModulePartitionJsonFile4bArgs_Src_DEFAULT
   }
 return p.Src
 }
-func (p *IIOModulePartitionObjectFile4Args) IsSetSrc() bool {
+func (p *IIOModulePartitionJsonFile4bArgs) IsSetSrc() bool {
   return p.Src != nil
 }
 
-func (p *IIOModulePartitionObjectFile4Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4bArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4224,7 +7080,7 @@ func (p *IIOModulePartitionObjectFile4Args) Read(ctx context.Context, iprot thri
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4bArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -4233,7 +7089,7 @@ func (p *IIOModulePartitionObjectFile4Args)  ReadField1(ctx context.Context, ipr
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4bArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
@@ -4242,7 +7098,7 @@ func (p *IIOModulePartitionObjectFile4Args)  ReadField2(ctx context.Context, ipr
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4bArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
@@ -4251,7 +7107,7 @@ func (p *IIOModulePartitionObjectFile4Args)  ReadField3(ctx context.Context, ipr
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Args)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4bArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
   p.Src = &rpc.ISource{
   Params: map[string][]byte{
   },
@@ -4262,8 +7118,8 @@ func (p *IIOModulePartitionObjectFile4Args)  ReadField4(ctx context.Context, ipr
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile4_args"); err != nil {
+func (p *IIOModulePartitionJsonFile4bArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4b_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4278,7 +7134,7 @@ func (p *IIOModulePartitionObjectFile4Args) Write(ctx context.Context, oprot thr
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4bArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -4288,7 +7144,7 @@ func (p *IIOModulePartitionObjectFile4Args) writeField1(ctx context.Context, opr
   return err
 }
 
-func (p *IIOModulePartitionObjectFile4Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4bArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
@@ -4298,7 +7154,7 @@ func (p *IIOModulePartitionObjectFile4Args) writeField2(ctx context.Context, opr
   return err
 }
 
-func (p *IIOModulePartitionObjectFile4Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4bArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
@@ -4308,7 +7164,7 @@ func (p *IIOModulePartitionObjectFile4Args) writeField3(ctx context.Context, opr
   return err
 }
 
-func (p *IIOModulePartitionObjectFile4Args) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4bArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 4); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:src: ", p), err) }
   if err := p.Src.Write(ctx, oprot); err != nil {
@@ -4319,35 +7175,35 @@ func (p *IIOModulePartitionObjectFile4Args) writeField4(ctx context.Context, opr
   return err
 }
 
-func (p *IIOModulePartitionObjectFile4Args) String() string {
+func (p *IIOModulePartitionJsonFile4bArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionObjectFile4Args(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionJsonFile4bArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModulePartitionObjectFile4Result struct {
+type IIOModulePartitionJsonFile4bResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePartitionObjectFile4Result() *IIOModulePartitionObjectFile4Result {
-  return &IIOModulePartitionObjectFile4Result{}
+func NewIIOModulePartitionJsonFile4bResult() *IIOModulePartitionJsonFile4bResult {
+  return &IIOModulePartitionJsonFile4bResult{}
 }
 
-var IIOModulePartitionObjectFile4Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePartitionObjectFile4Result) GetEx() *rpc.IExecutorException {
+var IIOModulePartitionJsonFile4bResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModulePartitionJsonFile4bResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePartitionObjectFile4Result_Ex_DEFAULT
+    return IIOModulePartitionJsonFile4bResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePartitionObjectFile4Result) IsSetEx() bool {
+func (p *IIOModulePartitionJsonFile4bResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePartitionObjectFile4Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4bResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4385,7 +7241,7 @@ func (p *IIOModulePartitionObjectFile4Result) Read(ctx context.Context, iprot th
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModulePartitionJsonFile4bResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4393,8 +7249,8 @@ func (p *IIOModulePartitionObjectFile4Result)  ReadField1(ctx context.Context, i
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionObjectFile4_result"); err != nil {
+func (p *IIOModulePartitionJsonFile4bResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4b_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4406,7 +7262,7 @@ func (p *IIOModulePartitionObjectFile4Result) Write(ctx context.Context, oprot t
   return nil
 }
 
-func (p *IIOModulePartitionObjectFile4Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModulePartitionJsonFile4bResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4419,40 +7275,40 @@ func (p *IIOModulePartitionObjectFile4Result) writeField1(ctx context.Context, o
   return err
 }
 
-func (p *IIOModulePartitionObjectFile4Result) String() string {
+func (p *IIOModulePartitionJsonFile4bResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionObjectFile4Result(%+v)", *p)
+  return fmt.Sprintf("IIOModulePartitionJsonFile4bResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
+//  - Compression
 //  - First
-//  - Partitions
-type IIOModulePartitionTextFileArgs struct {
+type IIOModuleSaveAsObjectFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
-  First int64 `thrift:"first,2" db:"first" json:"first"`
-  Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
+  Compression int8 `thrift:"compression,2" db:"compression" json:"compression"`
+  First int64 `thrift:"first,3" db:"first" json:"first"`
 }
 
-func NewIIOModulePartitionTextFileArgs() *IIOModulePartitionTextFileArgs {
-  return &IIOModulePartitionTextFileArgs{}
+func NewIIOModuleSaveAsObjectFileArgs() *IIOModuleSaveAsObjectFileArgs {
+  return &IIOModuleSaveAsObjectFileArgs{}
 }
 
 
-func (p *IIOModulePartitionTextFileArgs) GetPath() string {
+func (p *IIOModuleSaveAsObjectFileArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModulePartitionTextFileArgs) GetFirst() int64 {
-  return p.First
+func (p *IIOModuleSaveAsObjectFileArgs) GetCompression() int8 {
+  return p.Compression
 }
 
-func (p *IIOModulePartitionTextFileArgs) GetPartitions() int64 {
-  return p.Partitions
+func (p *IIOModuleSaveAsObjectFileArgs) GetFirst() int64 {
+  return p.First
 }
-func (p *IIOModulePartitionTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsObjectFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4476,7 +7332,7 @@ func (p *IIOModulePartitionTextFileArgs) Read(ctx context.Context, iprot thrift.
         }
       }
     case 2:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.BYTE {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -4510,7 +7366,7 @@ func (p *IIOModulePartitionTextFileArgs) Read(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IIOModulePartitionTextFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsObjectFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -4519,26 +7375,27 @@ func (p *IIOModulePartitionTextFileArgs)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IIOModulePartitionTextFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *IIOModuleSaveAsObjectFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadByte(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.First = v
+  temp := int8(v)
+  p.Compression = temp
 }
   return nil
 }
 
-func (p *IIOModulePartitionTextFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsObjectFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Partitions = v
+  p.First = v
 }
   return nil
 }
 
-func (p *IIOModulePartitionTextFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionTextFile_args"); err != nil {
+func (p *IIOModuleSaveAsObjectFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsObjectFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4552,7 +7409,7 @@ func (p *IIOModulePartitionTextFileArgs) Write(ctx context.Context, oprot thrift
   return nil
 }
 
-func (p *IIOModulePartitionTextFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsObjectFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -4562,55 +7419,55 @@ func (p *IIOModulePartitionTextFileArgs) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IIOModulePartitionTextFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.first (2) field write error: ", p), err) }
+func (p *IIOModuleSaveAsObjectFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "compression", thrift.BYTE, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compression: ", p), err) }
+  if err := oprot.WriteByte(ctx, int8(p.Compression)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.compression (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:first: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compression: ", p), err) }
   return err
 }
 
-func (p *IIOModulePartitionTextFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.partitions (3) field write error: ", p), err) }
+func (p *IIOModuleSaveAsObjectFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.first (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:partitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first: ", p), err) }
   return err
 }
 
-func (p *IIOModulePartitionTextFileArgs) String() string {
+func (p *IIOModuleSaveAsObjectFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionTextFileArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsObjectFileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModulePartitionTextFileResult struct {
+type IIOModuleSaveAsObjectFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePartitionTextFileResult() *IIOModulePartitionTextFileResult {
-  return &IIOModulePartitionTextFileResult{}
+func NewIIOModuleSaveAsObjectFileResult() *IIOModuleSaveAsObjectFileResult {
+  return &IIOModuleSaveAsObjectFileResult{}
 }
 
-var IIOModulePartitionTextFileResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePartitionTextFileResult) GetEx() *rpc.IExecutorException {
+var IIOModuleSaveAsObjectFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleSaveAsObjectFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePartitionTextFileResult_Ex_DEFAULT
+    return IIOModuleSaveAsObjectFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePartitionTextFileResult) IsSetEx() bool {
+func (p *IIOModuleSaveAsObjectFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePartitionTextFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsObjectFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4648,7 +7505,7 @@ func (p *IIOModulePartitionTextFileResult) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IIOModulePartitionTextFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsObjectFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4656,8 +7513,8 @@ func (p *IIOModulePartitionTextFileResult)  ReadField1(ctx context.Context, ipro
   return nil
 }
 
-func (p *IIOModulePartitionTextFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionTextFile_result"); err != nil {
+func (p *IIOModuleSaveAsObjectFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsObjectFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4669,7 +7526,7 @@ func (p *IIOModulePartitionTextFileResult) Write(ctx context.Context, oprot thri
   return nil
 }
 
-func (p *IIOModulePartitionTextFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsObjectFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4682,46 +7539,40 @@ func (p *IIOModulePartitionTextFileResult) writeField1(ctx context.Context, opro
   return err
 }
 
-func (p *IIOModulePartitionTextFileResult) String() string {
+func (p *IIOModuleSaveAsObjectFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionTextFileResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsObjectFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
+//  - Compression
 //  - First
-//  - Partitions
-//  - ObjectMapping
-type IIOModulePartitionJsonFile4aArgs struct {
+type IIOModuleSaveAsSequenceFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
-  First int64 `thrift:"first,2" db:"first" json:"first"`
-  Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
-  ObjectMapping bool `thrift:"objectMapping,4" db:"objectMapping" json:"objectMapping"`
+  Compression int8 `thrift:"compression,2" db:"compression" json:"compression"`
+  First int64 `thrift:"first,3" db:"first" json:"first"`
 }
 
-func NewIIOModulePartitionJsonFile4aArgs() *IIOModulePartitionJsonFile4aArgs {
-  return &IIOModulePartitionJsonFile4aArgs{}
+func NewIIOModuleSaveAsSequenceFileArgs() *IIOModuleSaveAsSequenceFileArgs {
+  return &IIOModuleSaveAsSequenceFileArgs{}
 }
 
 
-func (p *IIOModulePartitionJsonFile4aArgs) GetPath() string {
+func (p *IIOModuleSaveAsSequenceFileArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs) GetFirst() int64 {
-  return p.First
-}
-
-func (p *IIOModulePartitionJsonFile4aArgs) GetPartitions() int64 {
-  return p.Partitions
+func (p *IIOModuleSaveAsSequenceFileArgs) GetCompression() int8 {
+  return p.Compression
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs) GetObjectMapping() bool {
-  return p.ObjectMapping
+func (p *IIOModuleSaveAsSequenceFileArgs) GetFirst() int64 {
+  return p.First
 }
-func (p *IIOModulePartitionJsonFile4aArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsSequenceFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4745,7 +7596,7 @@ func (p *IIOModulePartitionJsonFile4aArgs) Read(ctx context.Context, iprot thrif
         }
       }
     case 2:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.BYTE {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -4764,16 +7615,6 @@ func (p *IIOModulePartitionJsonFile4aArgs) Read(ctx context.Context, iprot thrif
           return err
         }
       }
-    case 4:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField4(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -4789,7 +7630,7 @@ func (p *IIOModulePartitionJsonFile4aArgs) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsSequenceFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -4798,41 +7639,32 @@ func (p *IIOModulePartitionJsonFile4aArgs)  ReadField1(ctx context.Context, ipro
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *IIOModuleSaveAsSequenceFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadByte(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.First = v
+  temp := int8(v)
+  p.Compression = temp
 }
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsSequenceFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Partitions = v
-}
-  return nil
-}
-
-func (p *IIOModulePartitionJsonFile4aArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 4: ", err)
-} else {
-  p.ObjectMapping = v
+  p.First = v
 }
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4a_args"); err != nil {
+func (p *IIOModuleSaveAsSequenceFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsSequenceFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
     if err := p.writeField3(ctx, oprot); err != nil { return err }
-    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4841,7 +7673,7 @@ func (p *IIOModulePartitionJsonFile4aArgs) Write(ctx context.Context, oprot thri
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsSequenceFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -4851,65 +7683,55 @@ func (p *IIOModulePartitionJsonFile4aArgs) writeField1(ctx context.Context, opro
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.first (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:first: ", p), err) }
-  return err
-}
-
-func (p *IIOModulePartitionJsonFile4aArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.partitions (3) field write error: ", p), err) }
+func (p *IIOModuleSaveAsSequenceFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "compression", thrift.BYTE, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compression: ", p), err) }
+  if err := oprot.WriteByte(ctx, int8(p.Compression)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.compression (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:partitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compression: ", p), err) }
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "objectMapping", thrift.BOOL, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:objectMapping: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.ObjectMapping)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.objectMapping (4) field write error: ", p), err) }
+func (p *IIOModuleSaveAsSequenceFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.first (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:objectMapping: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first: ", p), err) }
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4aArgs) String() string {
+func (p *IIOModuleSaveAsSequenceFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionJsonFile4aArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsSequenceFileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModulePartitionJsonFile4aResult struct {
+type IIOModuleSaveAsSequenceFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePartitionJsonFile4aResult() *IIOModulePartitionJsonFile4aResult {
-  return &IIOModulePartitionJsonFile4aResult{}
+func NewIIOModuleSaveAsSequenceFileResult() *IIOModuleSaveAsSequenceFileResult {
+  return &IIOModuleSaveAsSequenceFileResult{}
 }
 
-var IIOModulePartitionJsonFile4aResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePartitionJsonFile4aResult) GetEx() *rpc.IExecutorException {
+var IIOModuleSaveAsSequenceFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleSaveAsSequenceFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePartitionJsonFile4aResult_Ex_DEFAULT
+    return IIOModuleSaveAsSequenceFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePartitionJsonFile4aResult) IsSetEx() bool {
+func (p *IIOModuleSaveAsSequenceFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePartitionJsonFile4aResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsSequenceFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4947,7 +7769,7 @@ func (p *IIOModulePartitionJsonFile4aResult) Read(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsSequenceFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -4955,8 +7777,8 @@ func (p *IIOModulePartitionJsonFile4aResult)  ReadField1(ctx context.Context, ip
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4a_result"); err != nil {
+func (p *IIOModuleSaveAsSequenceFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsSequenceFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -4968,7 +7790,7 @@ func (p *IIOModulePartitionJsonFile4aResult) Write(ctx context.Context, oprot th
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4aResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsSequenceFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -4981,53 +7803,40 @@ func (p *IIOModulePartitionJsonFile4aResult) writeField1(ctx context.Context, op
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4aResult) String() string {
+func (p *IIOModuleSaveAsSequenceFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionJsonFile4aResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsSequenceFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
+//  - Compression
 //  - First
-//  - Partitions
-//  - Src
-type IIOModulePartitionJsonFile4bArgs struct {
+type IIOModuleSaveAsBinaryFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
-  First int64 `thrift:"first,2" db:"first" json:"first"`
-  Partitions int64 `thrift:"partitions,3" db:"partitions" json:"partitions"`
-  Src *rpc.ISource `thrift:"src,4" db:"src" json:"src"`
+  Compression int8 `thrift:"compression,2" db:"compression" json:"compression"`
+  First int64 `thrift:"first,3" db:"first" json:"first"`
 }
 
-func NewIIOModulePartitionJsonFile4bArgs() *IIOModulePartitionJsonFile4bArgs {
-  return &IIOModulePartitionJsonFile4bArgs{}
+func NewIIOModuleSaveAsBinaryFileArgs() *IIOModuleSaveAsBinaryFileArgs {
+  return &IIOModuleSaveAsBinaryFileArgs{}
 }
 
 
-func (p *IIOModulePartitionJsonFile4bArgs) GetPath() string {
+func (p *IIOModuleSaveAsBinaryFileArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs) GetFirst() int64 {
-  return p.First
+func (p *IIOModuleSaveAsBinaryFileArgs) GetCompression() int8 {
+  return p.Compression
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs) GetPartitions() int64 {
-  return p.Partitions
-}
-var IIOModulePartitionJsonFile4bArgs_Src_DEFAULT *rpc.ISource
-func (p *IIOModulePartitionJsonFile4bArgs) GetSrc() *rpc.ISource {
-  if !p.IsSetSrc() {
-    return IIOModulePartitionJsonFile4bArgs_Src_DEFAULT
-  }
-return p.Src
-}
-func (p *IIOModulePartitionJsonFile4bArgs) IsSetSrc() bool {
-  return p.Src != nil
+func (p *IIOModuleSaveAsBinaryFileArgs) GetFirst() int64 {
+  return p.First
 }
-
-func (p *IIOModulePartitionJsonFile4bArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsBinaryFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5051,7 +7860,7 @@ func (p *IIOModulePartitionJsonFile4bArgs) Read(ctx context.Context, iprot thrif
         }
       }
     case 2:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.BYTE {
         if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
@@ -5070,16 +7879,6 @@ func (p *IIOModulePartitionJsonFile4bArgs) Read(ctx context.Context, iprot thrif
           return err
         }
       }
-    case 4:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField4(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -5095,7 +7894,7 @@ func (p *IIOModulePartitionJsonFile4bArgs) Read(ctx context.Context, iprot thrif
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsBinaryFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -5104,43 +7903,32 @@ func (p *IIOModulePartitionJsonFile4bArgs)  ReadField1(ctx context.Context, ipro
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
+func (p *IIOModuleSaveAsBinaryFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadByte(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.First = v
+  temp := int8(v)
+  p.Compression = temp
 }
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsBinaryFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Partitions = v
-}
-  return nil
-}
-
-func (p *IIOModulePartitionJsonFile4bArgs)  ReadField4(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Src = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+  p.First = v
 }
-  if err := p.Src.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
-  }
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4b_args"); err != nil {
+func (p *IIOModuleSaveAsBinaryFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsBinaryFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
     if err := p.writeField3(ctx, oprot); err != nil { return err }
-    if err := p.writeField4(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5149,7 +7937,7 @@ func (p *IIOModulePartitionJsonFile4bArgs) Write(ctx context.Context, oprot thri
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsBinaryFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -5159,66 +7947,55 @@ func (p *IIOModulePartitionJsonFile4bArgs) writeField1(ctx context.Context, opro
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.first (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:first: ", p), err) }
-  return err
-}
-
-func (p *IIOModulePartitionJsonFile4bArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "partitions", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:partitions: ", p), err) }
-  if err := oprot.WriteI64(ctx, int64(p.Partitions)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.partitions (3) field write error: ", p), err) }
+func (p *IIOModuleSaveAsBinaryFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "compression", thrift.BYTE, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compression: ", p), err) }
+  if err := oprot.WriteByte(ctx, int8(p.Compression)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.compression (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:partitions: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compression: ", p), err) }
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs) writeField4(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:src: ", p), err) }
-  if err := p.Src.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
-  }
+func (p *IIOModuleSaveAsBinaryFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.first (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:src: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first: ", p), err) }
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4bArgs) String() string {
+func (p *IIOModuleSaveAsBinaryFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionJsonFile4bArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsBinaryFileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModulePartitionJsonFile4bResult struct {
+type IIOModuleSaveAsBinaryFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModulePartitionJsonFile4bResult() *IIOModulePartitionJsonFile4bResult {
-  return &IIOModulePartitionJsonFile4bResult{}
+func NewIIOModuleSaveAsBinaryFileResult() *IIOModuleSaveAsBinaryFileResult {
+  return &IIOModuleSaveAsBinaryFileResult{}
 }
 
-var IIOModulePartitionJsonFile4bResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModulePartitionJsonFile4bResult) GetEx() *rpc.IExecutorException {
+var IIOModuleSaveAsBinaryFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleSaveAsBinaryFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModulePartitionJsonFile4bResult_Ex_DEFAULT
+    return IIOModuleSaveAsBinaryFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModulePartitionJsonFile4bResult) IsSetEx() bool {
+func (p *IIOModuleSaveAsBinaryFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModulePartitionJsonFile4bResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsBinaryFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5256,7 +8033,7 @@ func (p *IIOModulePartitionJsonFile4bResult) Read(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsBinaryFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5264,8 +8041,8 @@ func (p *IIOModulePartitionJsonFile4bResult)  ReadField1(ctx context.Context, ip
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "partitionJsonFile4b_result"); err != nil {
+func (p *IIOModuleSaveAsBinaryFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsBinaryFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5277,7 +8054,7 @@ func (p *IIOModulePartitionJsonFile4bResult) Write(ctx context.Context, oprot th
   return nil
 }
 
-func (p *IIOModulePartitionJsonFile4bResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsBinaryFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5290,40 +8067,34 @@ func (p *IIOModulePartitionJsonFile4bResult) writeField1(ctx context.Context, op
   return err
 }
 
-func (p *IIOModulePartitionJsonFile4bResult) String() string {
+func (p *IIOModuleSaveAsBinaryFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModulePartitionJsonFile4bResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsBinaryFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
-//  - Compression
 //  - First
-type IIOModuleSaveAsObjectFileArgs struct {
+type IIOModuleSaveAsTextFileArgs struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
-  Compression int8 `thrift:"compression,2" db:"compression" json:"compression"`
-  First int64 `thrift:"first,3" db:"first" json:"first"`
+  First int64 `thrift:"first,2" db:"first" json:"first"`
 }
 
-func NewIIOModuleSaveAsObjectFileArgs() *IIOModuleSaveAsObjectFileArgs {
-  return &IIOModuleSaveAsObjectFileArgs{}
+func NewIIOModuleSaveAsTextFileArgs() *IIOModuleSaveAsTextFileArgs {
+  return &IIOModuleSaveAsTextFileArgs{}
 }
 
 
-func (p *IIOModuleSaveAsObjectFileArgs) GetPath() string {
+func (p *IIOModuleSaveAsTextFileArgs) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModuleSaveAsObjectFileArgs) GetCompression() int8 {
-  return p.Compression
-}
-
-func (p *IIOModuleSaveAsObjectFileArgs) GetFirst() int64 {
+func (p *IIOModuleSaveAsTextFileArgs) GetFirst() int64 {
   return p.First
 }
-func (p *IIOModuleSaveAsObjectFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5347,18 +8118,8 @@ func (p *IIOModuleSaveAsObjectFileArgs) Read(ctx context.Context, iprot thrift.T
         }
       }
     case 2:
-      if fieldTypeId == thrift.BYTE {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
       if fieldTypeId == thrift.I64 {
-        if err := p.ReadField3(ctx, iprot); err != nil {
+        if err := p.ReadField2(ctx, iprot); err != nil {
           return err
         }
       } else {
@@ -5381,7 +8142,7 @@ func (p *IIOModuleSaveAsObjectFileArgs) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IIOModuleSaveAsObjectFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -5390,32 +8151,21 @@ func (p *IIOModuleSaveAsObjectFileArgs)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IIOModuleSaveAsObjectFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadByte(ctx); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  temp := int8(v)
-  p.Compression = temp
-}
-  return nil
-}
-
-func (p *IIOModuleSaveAsObjectFileArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
+  return thrift.PrependError("error reading field 2: ", err)
 } else {
   p.First = v
 }
   return nil
 }
 
-func (p *IIOModuleSaveAsObjectFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "saveAsObjectFile_args"); err != nil {
+func (p *IIOModuleSaveAsTextFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsTextFile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5424,7 +8174,7 @@ func (p *IIOModuleSaveAsObjectFileArgs) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IIOModuleSaveAsObjectFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsTextFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -5434,55 +8184,45 @@ func (p *IIOModuleSaveAsObjectFileArgs) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IIOModuleSaveAsObjectFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "compression", thrift.BYTE, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:compression: ", p), err) }
-  if err := oprot.WriteByte(ctx, int8(p.Compression)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.compression (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:compression: ", p), err) }
-  return err
-}
-
-func (p *IIOModuleSaveAsObjectFileArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:first: ", p), err) }
+func (p *IIOModuleSaveAsTextFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.first (3) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.first (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:first: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:first: ", p), err) }
   return err
 }
 
-func (p *IIOModuleSaveAsObjectFileArgs) String() string {
+func (p *IIOModuleSaveAsTextFileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleSaveAsObjectFileArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsTextFileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModuleSaveAsObjectFileResult struct {
+type IIOModuleSaveAsTextFileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleSaveAsObjectFileResult() *IIOModuleSaveAsObjectFileResult {
-  return &IIOModuleSaveAsObjectFileResult{}
+func NewIIOModuleSaveAsTextFileResult() *IIOModuleSaveAsTextFileResult {
+  return &IIOModuleSaveAsTextFileResult{}
 }
 
-var IIOModuleSaveAsObjectFileResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModuleSaveAsObjectFileResult) GetEx() *rpc.IExecutorException {
+var IIOModuleSaveAsTextFileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleSaveAsTextFileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModuleSaveAsObjectFileResult_Ex_DEFAULT
+    return IIOModuleSaveAsTextFileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModuleSaveAsObjectFileResult) IsSetEx() bool {
+func (p *IIOModuleSaveAsTextFileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModuleSaveAsObjectFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5520,7 +8260,7 @@ func (p *IIOModuleSaveAsObjectFileResult) Read(ctx context.Context, iprot thrift
   return nil
 }
 
-func (p *IIOModuleSaveAsObjectFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5528,8 +8268,8 @@ func (p *IIOModuleSaveAsObjectFileResult)  ReadField1(ctx context.Context, iprot
   return nil
 }
 
-func (p *IIOModuleSaveAsObjectFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "saveAsObjectFile_result"); err != nil {
+func (p *IIOModuleSaveAsTextFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsTextFile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5541,7 +8281,7 @@ func (p *IIOModuleSaveAsObjectFileResult) Write(ctx context.Context, oprot thrif
   return nil
 }
 
-func (p *IIOModuleSaveAsObjectFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsTextFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5554,34 +8294,47 @@ func (p *IIOModuleSaveAsObjectFileResult) writeField1(ctx context.Context, oprot
   return err
 }
 
-func (p *IIOModuleSaveAsObjectFileResult) String() string {
+func (p *IIOModuleSaveAsTextFileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleSaveAsObjectFileResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsTextFileResult(%+v)", *p)
 }
 
 // Attributes:
 //  - Path
 //  - First
-type IIOModuleSaveAsTextFileArgs struct {
+//  - Src
+type IIOModuleSaveAsTextFile3Args struct {
   Path string `thrift:"path,1" db:"path" json:"path"`
   First int64 `thrift:"first,2" db:"first" json:"first"`
+  Src *rpc.ISource `thrift:"src,3" db:"src" json:"src"`
 }
 
-func NewIIOModuleSaveAsTextFileArgs() *IIOModuleSaveAsTextFileArgs {
-  return &IIOModuleSaveAsTextFileArgs{}
+func NewIIOModuleSaveAsTextFile3Args() *IIOModuleSaveAsTextFile3Args {
+  return &IIOModuleSaveAsTextFile3Args{}
 }
 
 
-func (p *IIOModuleSaveAsTextFileArgs) GetPath() string {
+func (p *IIOModuleSaveAsTextFile3Args) GetPath() string {
   return p.Path
 }
 
-func (p *IIOModuleSaveAsTextFileArgs) GetFirst() int64 {
+func (p *IIOModuleSaveAsTextFile3Args) GetFirst() int64 {
   return p.First
 }
-func (p *IIOModuleSaveAsTextFileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+var IIOModuleSaveAsTextFile3Args_Src_DEFAULT *rpc.ISource
+func (p *IIOModuleSaveAsTextFile3Args) GetSrc() *rpc.ISource {
+  if !p.IsSetSrc() {
+    return IIOModuleSaveAsTextFile3Args_Src_DEFAULT
+  }
+return p.Src
+}
+func (p *IIOModuleSaveAsTextFile3Args) IsSetSrc() bool {
+  return p.Src != nil
+}
+
+func (p *IIOModuleSaveAsTextFile3Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5614,6 +8367,16 @@ func (p *IIOModuleSaveAsTextFileArgs) Read(ctx context.Context, iprot thrift.TPr
           return err
         }
       }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -5629,7 +8392,7 @@ func (p *IIOModuleSaveAsTextFileArgs) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IIOModuleSaveAsTextFileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFile3Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(ctx); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -5638,7 +8401,7 @@ func (p *IIOModuleSaveAsTextFileArgs)  ReadField1(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IIOModuleSaveAsTextFileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFile3Args)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
   if v, err := iprot.ReadI64(ctx); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
@@ -5647,12 +8410,24 @@ func (p *IIOModuleSaveAsTextFileArgs)  ReadField2(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IIOModuleSaveAsTextFileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "saveAsTextFile_args"); err != nil {
+func (p *IIOModuleSaveAsTextFile3Args)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Src = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Src.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Src), err)
+  }
+  return nil
+}
+
+func (p *IIOModuleSaveAsTextFile3Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsTextFile3_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
     if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5661,7 +8436,7 @@ func (p *IIOModuleSaveAsTextFileArgs) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IIOModuleSaveAsTextFileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsTextFile3Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "path", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:path: ", p), err) }
   if err := oprot.WriteString(ctx, string(p.Path)); err != nil {
@@ -5671,7 +8446,7 @@ func (p *IIOModuleSaveAsTextFileArgs) writeField1(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IIOModuleSaveAsTextFileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsTextFile3Args) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin(ctx, "first", thrift.I64, 2); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:first: ", p), err) }
   if err := oprot.WriteI64(ctx, int64(p.First)); err != nil {
@@ -5681,35 +8456,46 @@ func (p *IIOModuleSaveAsTextFileArgs) writeField2(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IIOModuleSaveAsTextFileArgs) String() string {
+func (p *IIOModuleSaveAsTextFile3Args) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "src", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:src: ", p), err) }
+  if err := p.Src.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Src), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:src: ", p), err) }
+  return err
+}
+
+func (p *IIOModuleSaveAsTextFile3Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleSaveAsTextFileArgs(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsTextFile3Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IIOModuleSaveAsTextFileResult struct {
+type IIOModuleSaveAsTextFile3Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIIOModuleSaveAsTextFileResult() *IIOModuleSaveAsTextFileResult {
-  return &IIOModuleSaveAsTextFileResult{}
+func NewIIOModuleSaveAsTextFile3Result() *IIOModuleSaveAsTextFile3Result {
+  return &IIOModuleSaveAsTextFile3Result{}
 }
 
-var IIOModuleSaveAsTextFileResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IIOModuleSaveAsTextFileResult) GetEx() *rpc.IExecutorException {
+var IIOModuleSaveAsTextFile3Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IIOModuleSaveAsTextFile3Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IIOModuleSaveAsTextFileResult_Ex_DEFAULT
+    return IIOModuleSaveAsTextFile3Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IIOModuleSaveAsTextFileResult) IsSetEx() bool {
+func (p *IIOModuleSaveAsTextFile3Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IIOModuleSaveAsTextFileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFile3Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5747,7 +8533,7 @@ func (p *IIOModuleSaveAsTextFileResult) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IIOModuleSaveAsTextFileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IIOModuleSaveAsTextFile3Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -5755,8 +8541,8 @@ func (p *IIOModuleSaveAsTextFileResult)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IIOModuleSaveAsTextFileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "saveAsTextFile_result"); err != nil {
+func (p *IIOModuleSaveAsTextFile3Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "saveAsTextFile3_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -5768,7 +8554,7 @@ func (p *IIOModuleSaveAsTextFileResult) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IIOModuleSaveAsTextFileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IIOModuleSaveAsTextFile3Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -5781,11 +8567,11 @@ func (p *IIOModuleSaveAsTextFileResult) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IIOModuleSaveAsTextFileResult) String() string {
+func (p *IIOModuleSaveAsTextFile3Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IIOModuleSaveAsTextFileResult(%+v)", *p)
+  return fmt.Sprintf("IIOModuleSaveAsTextFile3Result(%+v)", *p)
 }
 
 // Attributes:
@@ -42,6 +42,18 @@ type IMathModule interface {
   SampleByKey(ctx context.Context, withReplacement bool, fractions *rpc.ISource, seed int32) (_err error)
   CountByKey(ctx context.Context) (_err error)
   CountByValue(ctx context.Context) (_err error)
+  // Parameters:
+  //  - Buckets
+  Histogram(ctx context.Context, buckets int64) (_err error)
+  // Parameters:
+  //  - Probabilities
+  //  - RelativeError
+  ApproxQuantile(ctx context.Context, probabilities []float64, relativeError float64) (_err error)
+  Sum(ctx context.Context) (_err error)
+  Mean(ctx context.Context) (_err error)
+  // Parameters:
+  //  - NumPartitions
+  SumByKey(ctx context.Context, numPartitions int64) (_err error)
 }
 
 type IMathModuleClient struct {
@@ -252,6 +264,102 @@ func (p *IMathModuleClient) CountByValue(ctx context.Context) (_err error) {
   return nil
 }
 
+// Parameters:
+//  - Buckets
+func (p *IMathModuleClient) Histogram(ctx context.Context, buckets int64) (_err error) {
+  var _args27 IMathModuleHistogramArgs
+  _args27.Buckets = buckets
+  var _result29 IMathModuleHistogramResult
+  var _meta28 thrift.ResponseMeta
+  _meta28, _err = p.Client_().Call(ctx, "histogram", &_args27, &_result29)
+  p.SetLastResponseMeta_(_meta28)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result29.Ex!= nil:
+    return _result29.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - Probabilities
+//  - RelativeError
+func (p *IMathModuleClient) ApproxQuantile(ctx context.Context, probabilities []float64, relativeError float64) (_err error) {
+  var _args30 IMathModuleApproxQuantileArgs
+  _args30.Probabilities = probabilities
+  _args30.RelativeError = relativeError
+  var _result32 IMathModuleApproxQuantileResult
+  var _meta31 thrift.ResponseMeta
+  _meta31, _err = p.Client_().Call(ctx, "approxQuantile", &_args30, &_result32)
+  p.SetLastResponseMeta_(_meta31)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result32.Ex!= nil:
+    return _result32.Ex
+  }
+
+  return nil
+}
+
+func (p *IMathModuleClient) Sum(ctx context.Context) (_err error) {
+  var _args33 IMathModuleSumArgs
+  var _result35 IMathModuleSumResult
+  var _meta34 thrift.ResponseMeta
+  _meta34, _err = p.Client_().Call(ctx, "sum", &_args33, &_result35)
+  p.SetLastResponseMeta_(_meta34)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result35.Ex!= nil:
+    return _result35.Ex
+  }
+
+  return nil
+}
+
+func (p *IMathModuleClient) Mean(ctx context.Context) (_err error) {
+  var _args36 IMathModuleMeanArgs
+  var _result38 IMathModuleMeanResult
+  var _meta37 thrift.ResponseMeta
+  _meta37, _err = p.Client_().Call(ctx, "mean", &_args36, &_result38)
+  p.SetLastResponseMeta_(_meta37)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result38.Ex!= nil:
+    return _result38.Ex
+  }
+
+  return nil
+}
+
+// Parameters:
+//  - NumPartitions
+func (p *IMathModuleClient) SumByKey(ctx context.Context, numPartitions int64) (_err error) {
+  var _args39 IMathModuleSumByKeyArgs
+  _args39.NumPartitions = numPartitions
+  var _result41 IMathModuleSumByKeyResult
+  var _meta40 thrift.ResponseMeta
+  _meta40, _err = p.Client_().Call(ctx, "sumByKey", &_args39, &_result41)
+  p.SetLastResponseMeta_(_meta40)
+  if _err != nil {
+    return
+  }
+  switch {
+  case _result41.Ex!= nil:
+    return _result41.Ex
+  }
+
+  return nil
+}
+
 type IMathModuleProcessor struct {
   processorMap map[string]thrift.TProcessorFunction
   handler IMathModule
@@ -272,17 +380,22 @@ func (p *IMathModuleProcessor) ProcessorMap() map[string]thrift.TProcessorFuncti
 
 func NewIMathModuleProcessor(handler IMathModule) *IMathModuleProcessor {
 
-  self27 := &IMathModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self27.processorMap["sample"] = &iMathModuleProcessorSample{handler:handler}
-  self27.processorMap["count"] = &iMathModuleProcessorCount{handler:handler}
-  self27.processorMap["max"] = &iMathModuleProcessorMax{handler:handler}
-  self27.processorMap["min"] = &iMathModuleProcessorMin{handler:handler}
-  self27.processorMap["max1"] = &iMathModuleProcessorMax1{handler:handler}
-  self27.processorMap["min1"] = &iMathModuleProcessorMin1{handler:handler}
-  self27.processorMap["sampleByKey"] = &iMathModuleProcessorSampleByKey{handler:handler}
-  self27.processorMap["countByKey"] = &iMathModuleProcessorCountByKey{handler:handler}
-  self27.processorMap["countByValue"] = &iMathModuleProcessorCountByValue{handler:handler}
-return self27
+  self42 := &IMathModuleProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self42.processorMap["sample"] = &iMathModuleProcessorSample{handler:handler}
+  self42.processorMap["count"] = &iMathModuleProcessorCount{handler:handler}
+  self42.processorMap["max"] = &iMathModuleProcessorMax{handler:handler}
+  self42.processorMap["min"] = &iMathModuleProcessorMin{handler:handler}
+  self42.processorMap["max1"] = &iMathModuleProcessorMax1{handler:handler}
+  self42.processorMap["min1"] = &iMathModuleProcessorMin1{handler:handler}
+  self42.processorMap["sampleByKey"] = &iMathModuleProcessorSampleByKey{handler:handler}
+  self42.processorMap["countByKey"] = &iMathModuleProcessorCountByKey{handler:handler}
+  self42.processorMap["countByValue"] = &iMathModuleProcessorCountByValue{handler:handler}
+  self42.processorMap["histogram"] = &iMathModuleProcessorHistogram{handler:handler}
+  self42.processorMap["approxQuantile"] = &iMathModuleProcessorApproxQuantile{handler:handler}
+  self42.processorMap["sum"] = &iMathModuleProcessorSum{handler:handler}
+  self42.processorMap["mean"] = &iMathModuleProcessorMean{handler:handler}
+  self42.processorMap["sumByKey"] = &iMathModuleProcessorSumByKey{handler:handler}
+return self42
 }
 
 func (p *IMathModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -293,12 +406,12 @@ func (p *IMathModuleProcessor) Process(ctx context.Context, iprot, oprot thrift.
   }
   iprot.Skip(ctx, thrift.STRUCT)
   iprot.ReadMessageEnd(ctx)
-  x28 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  x43 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
   oprot.WriteMessageBegin(ctx, name, thrift.EXCEPTION, seqId)
-  x28.Write(ctx, oprot)
+  x43.Write(ctx, oprot)
   oprot.WriteMessageEnd(ctx)
   oprot.Flush(ctx)
-  return false, x28
+  return false, x43
 
 }
 
@@ -1034,140 +1147,1472 @@ func (p *iMathModuleProcessorCountByValue) Process(ctx context.Context, seqId in
   return true, err
 }
 
-
-// HELPER FUNCTIONS AND STRUCTURES
-
-// Attributes:
-//  - WithReplacement
-//  - Num
-//  - Seed
-type IMathModuleSampleArgs struct {
-  WithReplacement bool `thrift:"withReplacement,1" db:"withReplacement" json:"withReplacement"`
-  Num []int64 `thrift:"num,2" db:"num" json:"num"`
-  Seed int32 `thrift:"seed,3" db:"seed" json:"seed"`
+type iMathModuleProcessorHistogram struct {
+  handler IMathModule
 }
 
-func NewIMathModuleSampleArgs() *IMathModuleSampleArgs {
-  return &IMathModuleSampleArgs{}
-}
+func (p *iMathModuleProcessorHistogram) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IMathModuleHistogramArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "histogram", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
 
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
 
-func (p *IMathModuleSampleArgs) GetWithReplacement() bool {
-  return p.WithReplacement
+  result := IMathModuleHistogramResult{}
+  if err2 = p.handler.Histogram(ctx, args.Buckets); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing histogram: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "histogram", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "histogram", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
 }
 
-func (p *IMathModuleSampleArgs) GetNum() []int64 {
-  return p.Num
+type iMathModuleProcessorApproxQuantile struct {
+  handler IMathModule
 }
 
-func (p *IMathModuleSampleArgs) GetSeed() int32 {
-  return p.Seed
-}
-func (p *IMathModuleSampleArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+func (p *iMathModuleProcessorApproxQuantile) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IMathModuleApproxQuantileArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "approxQuantile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
   }
+  iprot.ReadMessageEnd(ctx)
 
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField1(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.LIST {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
-      if fieldTypeId == thrift.I32 {
-        if err := p.ReadField3(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
         }
       }
+    }(tickerCtx, cancel)
+  }
+
+  result := IMathModuleApproxQuantileResult{}
+  if err2 = p.handler.ApproxQuantile(ctx, args.Probabilities, args.RelativeError); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
     default:
-      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(ctx); err != nil {
-      return err
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
     }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing approxQuantile: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "approxQuantile", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
   }
-  if err := iprot.ReadStructEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return nil
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "approxQuantile", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
 }
 
-func (p *IMathModuleSampleArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.WithReplacement = v
-}
-  return nil
+type iMathModuleProcessorSum struct {
+  handler IMathModule
 }
 
-func (p *IMathModuleSampleArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin(ctx)
+func (p *iMathModuleProcessorSum) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IMathModuleSumArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "sum", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IMathModuleSumResult{}
+  if err2 = p.handler.Sum(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sum: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sum", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "sum", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
   if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
+    return
   }
-  tSlice := make([]int64, 0, size)
-  p.Num =  tSlice
-  for i := 0; i < size; i ++ {
-var _elem29 int64
-    if v, err := iprot.ReadI64(ctx); err != nil {
-    return thrift.PrependError("error reading field 0: ", err)
-} else {
-    _elem29 = v
+  return true, err
+}
+
+type iMathModuleProcessorMean struct {
+  handler IMathModule
+}
+
+func (p *iMathModuleProcessorMean) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IMathModuleMeanArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "mean", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IMathModuleMeanResult{}
+  if err2 = p.handler.Mean(ctx); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing mean: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "mean", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "mean", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type iMathModuleProcessorSumByKey struct {
+  handler IMathModule
+}
+
+func (p *iMathModuleProcessorSumByKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := IMathModuleSumByKeyArgs{}
+  var err2 error
+  if err2 = args.Read(ctx, iprot); err2 != nil {
+    iprot.ReadMessageEnd(ctx)
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err2.Error())
+    oprot.WriteMessageBegin(ctx, "sumByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return false, thrift.WrapTException(err2)
+  }
+  iprot.ReadMessageEnd(ctx)
+
+  tickerCancel := func() {}
+  // Start a goroutine to do server side connectivity check.
+  if thrift.ServerConnectivityCheckInterval > 0 {
+    var cancel context.CancelFunc
+    ctx, cancel = context.WithCancel(ctx)
+    defer cancel()
+    var tickerCtx context.Context
+    tickerCtx, tickerCancel = context.WithCancel(context.Background())
+    defer tickerCancel()
+    go func(ctx context.Context, cancel context.CancelFunc) {
+      ticker := time.NewTicker(thrift.ServerConnectivityCheckInterval)
+      defer ticker.Stop()
+      for {
+        select {
+        case <-ctx.Done():
+          return
+        case <-ticker.C:
+          if !iprot.Transport().IsOpen() {
+            cancel()
+            return
+          }
+        }
+      }
+    }(tickerCtx, cancel)
+  }
+
+  result := IMathModuleSumByKeyResult{}
+  if err2 = p.handler.SumByKey(ctx, args.NumPartitions); err2 != nil {
+    tickerCancel()
+  switch v := err2.(type) {
+    case *rpc.IExecutorException:
+  result.Ex = v
+    default:
+    if err2 == thrift.ErrAbandonRequest {
+      return false, thrift.WrapTException(err2)
+    }
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sumByKey: " + err2.Error())
+    oprot.WriteMessageBegin(ctx, "sumByKey", thrift.EXCEPTION, seqId)
+    x.Write(ctx, oprot)
+    oprot.WriteMessageEnd(ctx)
+    oprot.Flush(ctx)
+    return true, thrift.WrapTException(err2)
+  }
+  }
+  tickerCancel()
+  if err2 = oprot.WriteMessageBegin(ctx, "sumByKey", thrift.REPLY, seqId); err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = result.Write(ctx, oprot); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.WriteMessageEnd(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = thrift.WrapTException(err2)
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - WithReplacement
+//  - Num
+//  - Seed
+type IMathModuleSampleArgs struct {
+  WithReplacement bool `thrift:"withReplacement,1" db:"withReplacement" json:"withReplacement"`
+  Num []int64 `thrift:"num,2" db:"num" json:"num"`
+  Seed int32 `thrift:"seed,3" db:"seed" json:"seed"`
+}
+
+func NewIMathModuleSampleArgs() *IMathModuleSampleArgs {
+  return &IMathModuleSampleArgs{}
+}
+
+
+func (p *IMathModuleSampleArgs) GetWithReplacement() bool {
+  return p.WithReplacement
+}
+
+func (p *IMathModuleSampleArgs) GetNum() []int64 {
+  return p.Num
+}
+
+func (p *IMathModuleSampleArgs) GetSeed() int32 {
+  return p.Seed
 }
-    p.Num = append(p.Num, _elem29)
+func (p *IMathModuleSampleArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleSampleArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.WithReplacement = v
+}
+  return nil
+}
+
+func (p *IMathModuleSampleArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]int64, 0, size)
+  p.Num =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem44 int64
+    if v, err := iprot.ReadI64(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem44 = v
+}
+    p.Num = append(p.Num, _elem44)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *IMathModuleSampleArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Seed = v
+}
+  return nil
+}
+
+func (p *IMathModuleSampleArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sample_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleSampleArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "withReplacement", thrift.BOOL, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:withReplacement: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.WithReplacement)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.withReplacement (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:withReplacement: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleSampleArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "num", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:num: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.Num)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Num {
+    if err := oprot.WriteI64(ctx, int64(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:num: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleSampleArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "seed", thrift.I32, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:seed: ", p), err) }
+  if err := oprot.WriteI32(ctx, int32(p.Seed)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.seed (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:seed: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleSampleArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleSampleArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IMathModuleSampleResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIMathModuleSampleResult() *IMathModuleSampleResult {
+  return &IMathModuleSampleResult{}
+}
+
+var IMathModuleSampleResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleSampleResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IMathModuleSampleResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IMathModuleSampleResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IMathModuleSampleResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleSampleResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleSampleResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sample_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleSampleResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IMathModuleSampleResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleSampleResult(%+v)", *p)
+}
+
+type IMathModuleCountArgs struct {
+}
+
+func NewIMathModuleCountArgs() *IMathModuleCountArgs {
+  return &IMathModuleCountArgs{}
+}
+
+func (p *IMathModuleCountArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleCountArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "count_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleCountArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleCountArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Ex
+type IMathModuleCountResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIMathModuleCountResult() *IMathModuleCountResult {
+  return &IMathModuleCountResult{}
+}
+
+var IMathModuleCountResult_Success_DEFAULT int64
+func (p *IMathModuleCountResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return IMathModuleCountResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var IMathModuleCountResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleCountResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IMathModuleCountResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IMathModuleCountResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *IMathModuleCountResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IMathModuleCountResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField0(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleCountResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *IMathModuleCountResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleCountResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "count_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(ctx, oprot); err != nil { return err }
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleCountResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *IMathModuleCountResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IMathModuleCountResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleCountResult(%+v)", *p)
+}
+
+type IMathModuleMaxArgs struct {
+}
+
+func NewIMathModuleMaxArgs() *IMathModuleMaxArgs {
+  return &IMathModuleMaxArgs{}
+}
+
+func (p *IMathModuleMaxArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMaxArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "max_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleMaxArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleMaxArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IMathModuleMaxResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIMathModuleMaxResult() *IMathModuleMaxResult {
+  return &IMathModuleMaxResult{}
+}
+
+var IMathModuleMaxResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleMaxResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IMathModuleMaxResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IMathModuleMaxResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IMathModuleMaxResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMaxResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMaxResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "max_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleMaxResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IMathModuleMaxResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleMaxResult(%+v)", *p)
+}
+
+type IMathModuleMinArgs struct {
+}
+
+func NewIMathModuleMinArgs() *IMathModuleMinArgs {
+  return &IMathModuleMinArgs{}
+}
+
+func (p *IMathModuleMinArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMinArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "min_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleMinArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleMinArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IMathModuleMinResult struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIMathModuleMinResult() *IMathModuleMinResult {
+  return &IMathModuleMinResult{}
+}
+
+var IMathModuleMinResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleMinResult) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IMathModuleMinResult_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IMathModuleMinResult) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IMathModuleMinResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMinResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMinResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "min_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleMinResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IMathModuleMinResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleMinResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Cmp
+type IMathModuleMax1Args struct {
+  Cmp *rpc.ISource `thrift:"cmp,1" db:"cmp" json:"cmp"`
+}
+
+func NewIMathModuleMax1Args() *IMathModuleMax1Args {
+  return &IMathModuleMax1Args{}
+}
+
+var IMathModuleMax1Args_Cmp_DEFAULT *rpc.ISource
+func (p *IMathModuleMax1Args) GetCmp() *rpc.ISource {
+  if !p.IsSetCmp() {
+    return IMathModuleMax1Args_Cmp_DEFAULT
+  }
+return p.Cmp
+}
+func (p *IMathModuleMax1Args) IsSetCmp() bool {
+  return p.Cmp != nil
+}
+
+func (p *IMathModuleMax1Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMax1Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Cmp = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Cmp.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cmp), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMax1Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "max1_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleMax1Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "cmp", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cmp: ", p), err) }
+  if err := p.Cmp.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cmp), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cmp: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleMax1Args) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleMax1Args(%+v)", *p)
+}
+
+// Attributes:
+//  - Ex
+type IMathModuleMax1Result struct {
+  Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
+}
+
+func NewIMathModuleMax1Result() *IMathModuleMax1Result {
+  return &IMathModuleMax1Result{}
+}
+
+var IMathModuleMax1Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleMax1Result) GetEx() *rpc.IExecutorException {
+  if !p.IsSetEx() {
+    return IMathModuleMax1Result_Ex_DEFAULT
+  }
+return p.Ex
+}
+func (p *IMathModuleMax1Result) IsSetEx() bool {
+  return p.Ex != nil
+}
+
+func (p *IMathModuleMax1Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMax1Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Ex = &rpc.IExecutorException{}
+  if err := p.Ex.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleMax1Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "max1_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(ctx); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(ctx); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *IMathModuleMax1Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if p.IsSetEx() {
+    if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
+    if err := p.Ex.Write(ctx, oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Ex), err)
+    }
+    if err := oprot.WriteFieldEnd(ctx); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ex: ", p), err) }
+  }
+  return err
+}
+
+func (p *IMathModuleMax1Result) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("IMathModuleMax1Result(%+v)", *p)
+}
+
+// Attributes:
+//  - Cmp
+type IMathModuleMin1Args struct {
+  Cmp *rpc.ISource `thrift:"cmp,1" db:"cmp" json:"cmp"`
+}
+
+func NewIMathModuleMin1Args() *IMathModuleMin1Args {
+  return &IMathModuleMin1Args{}
+}
+
+var IMathModuleMin1Args_Cmp_DEFAULT *rpc.ISource
+func (p *IMathModuleMin1Args) GetCmp() *rpc.ISource {
+  if !p.IsSetCmp() {
+    return IMathModuleMin1Args_Cmp_DEFAULT
+  }
+return p.Cmp
+}
+func (p *IMathModuleMin1Args) IsSetCmp() bool {
+  return p.Cmp != nil
+}
+
+func (p *IMathModuleMin1Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin(ctx)
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(ctx); err != nil {
+      return err
+    }
   }
-  if err := iprot.ReadListEnd(ctx); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
+  if err := iprot.ReadStructEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
   return nil
 }
 
-func (p *IMathModuleSampleArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
-} else {
-  p.Seed = v
+func (p *IMathModuleMin1Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Cmp = &rpc.ISource{
+  Params: map[string][]byte{
+  },
 }
+  if err := p.Cmp.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cmp), err)
+  }
   return nil
 }
 
-func (p *IMathModuleSampleArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sample_args"); err != nil {
+func (p *IMathModuleMin1Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "min1_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1176,73 +2621,46 @@ func (p *IMathModuleSampleArgs) Write(ctx context.Context, oprot thrift.TProtoco
   return nil
 }
 
-func (p *IMathModuleSampleArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "withReplacement", thrift.BOOL, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:withReplacement: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.WithReplacement)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.withReplacement (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:withReplacement: ", p), err) }
-  return err
-}
-
-func (p *IMathModuleSampleArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "num", thrift.LIST, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:num: ", p), err) }
-  if err := oprot.WriteListBegin(ctx, thrift.I64, len(p.Num)); err != nil {
-    return thrift.PrependError("error writing list begin: ", err)
-  }
-  for _, v := range p.Num {
-    if err := oprot.WriteI64(ctx, int64(v)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
-  }
-  if err := oprot.WriteListEnd(ctx); err != nil {
-    return thrift.PrependError("error writing list end: ", err)
+func (p *IMathModuleMin1Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "cmp", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cmp: ", p), err) }
+  if err := p.Cmp.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cmp), err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:num: ", p), err) }
-  return err
-}
-
-func (p *IMathModuleSampleArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "seed", thrift.I32, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:seed: ", p), err) }
-  if err := oprot.WriteI32(ctx, int32(p.Seed)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.seed (3) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:seed: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cmp: ", p), err) }
   return err
 }
 
-func (p *IMathModuleSampleArgs) String() string {
+func (p *IMathModuleMin1Args) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleSampleArgs(%+v)", *p)
+  return fmt.Sprintf("IMathModuleMin1Args(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleSampleResult struct {
+type IMathModuleMin1Result struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleSampleResult() *IMathModuleSampleResult {
-  return &IMathModuleSampleResult{}
+func NewIMathModuleMin1Result() *IMathModuleMin1Result {
+  return &IMathModuleMin1Result{}
 }
 
-var IMathModuleSampleResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleSampleResult) GetEx() *rpc.IExecutorException {
+var IMathModuleMin1Result_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleMin1Result) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleSampleResult_Ex_DEFAULT
+    return IMathModuleMin1Result_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleSampleResult) IsSetEx() bool {
+func (p *IMathModuleMin1Result) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleSampleResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleMin1Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1280,7 +2698,7 @@ func (p *IMathModuleSampleResult) Read(ctx context.Context, iprot thrift.TProtoc
   return nil
 }
 
-func (p *IMathModuleSampleResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleMin1Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -1288,8 +2706,8 @@ func (p *IMathModuleSampleResult)  ReadField1(ctx context.Context, iprot thrift.
   return nil
 }
 
-func (p *IMathModuleSampleResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sample_result"); err != nil {
+func (p *IMathModuleMin1Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "min1_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -1301,7 +2719,7 @@ func (p *IMathModuleSampleResult) Write(ctx context.Context, oprot thrift.TProto
   return nil
 }
 
-func (p *IMathModuleSampleResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleMin1Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -1314,21 +2732,47 @@ func (p *IMathModuleSampleResult) writeField1(ctx context.Context, oprot thrift.
   return err
 }
 
-func (p *IMathModuleSampleResult) String() string {
+func (p *IMathModuleMin1Result) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleSampleResult(%+v)", *p)
+  return fmt.Sprintf("IMathModuleMin1Result(%+v)", *p)
 }
 
-type IMathModuleCountArgs struct {
+// Attributes:
+//  - WithReplacement
+//  - Fractions
+//  - Seed
+type IMathModuleSampleByKeyArgs struct {
+  WithReplacement bool `thrift:"withReplacement,1" db:"withReplacement" json:"withReplacement"`
+  Fractions *rpc.ISource `thrift:"fractions,2" db:"fractions" json:"fractions"`
+  Seed int32 `thrift:"seed,3" db:"seed" json:"seed"`
 }
 
-func NewIMathModuleCountArgs() *IMathModuleCountArgs {
-  return &IMathModuleCountArgs{}
+func NewIMathModuleSampleByKeyArgs() *IMathModuleSampleByKeyArgs {
+  return &IMathModuleSampleByKeyArgs{}
 }
 
-func (p *IMathModuleCountArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IMathModuleSampleByKeyArgs) GetWithReplacement() bool {
+  return p.WithReplacement
+}
+var IMathModuleSampleByKeyArgs_Fractions_DEFAULT *rpc.ISource
+func (p *IMathModuleSampleByKeyArgs) GetFractions() *rpc.ISource {
+  if !p.IsSetFractions() {
+    return IMathModuleSampleByKeyArgs_Fractions_DEFAULT
+  }
+return p.Fractions
+}
+
+func (p *IMathModuleSampleByKeyArgs) GetSeed() int32 {
+  return p.Seed
+}
+func (p *IMathModuleSampleByKeyArgs) IsSetFractions() bool {
+  return p.Fractions != nil
+}
+
+func (p *IMathModuleSampleByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1340,8 +2784,41 @@ func (p *IMathModuleCountArgs) Read(ctx context.Context, iprot thrift.TProtocol)
       return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
     if fieldTypeId == thrift.STOP { break; }
-    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-      return err
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField3(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
     }
     if err := iprot.ReadFieldEnd(ctx); err != nil {
       return err
@@ -1353,10 +2830,42 @@ func (p *IMathModuleCountArgs) Read(ctx context.Context, iprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleCountArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "count_args"); err != nil {
+func (p *IMathModuleSampleByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.WithReplacement = v
+}
+  return nil
+}
+
+func (p *IMathModuleSampleByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  p.Fractions = &rpc.ISource{
+  Params: map[string][]byte{
+  },
+}
+  if err := p.Fractions.Read(ctx, iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Fractions), err)
+  }
+  return nil
+}
+
+func (p *IMathModuleSampleByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(ctx); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Seed = v
+}
+  return nil
+}
+
+func (p *IMathModuleSampleByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sampleByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
+    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1365,48 +2874,66 @@ func (p *IMathModuleCountArgs) Write(ctx context.Context, oprot thrift.TProtocol
   return nil
 }
 
-func (p *IMathModuleCountArgs) String() string {
+func (p *IMathModuleSampleByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "withReplacement", thrift.BOOL, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:withReplacement: ", p), err) }
+  if err := oprot.WriteBool(ctx, bool(p.WithReplacement)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.withReplacement (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:withReplacement: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleSampleByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "fractions", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:fractions: ", p), err) }
+  if err := p.Fractions.Write(ctx, oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Fractions), err)
+  }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:fractions: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleSampleByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "seed", thrift.I32, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:seed: ", p), err) }
+  if err := oprot.WriteI32(ctx, int32(p.Seed)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.seed (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:seed: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleSampleByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleCountArgs(%+v)", *p)
+  return fmt.Sprintf("IMathModuleSampleByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - Ex
-type IMathModuleCountResult struct {
-  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+type IMathModuleSampleByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
-
-func NewIMathModuleCountResult() *IMathModuleCountResult {
-  return &IMathModuleCountResult{}
-}
-
-var IMathModuleCountResult_Success_DEFAULT int64
-func (p *IMathModuleCountResult) GetSuccess() int64 {
-  if !p.IsSetSuccess() {
-    return IMathModuleCountResult_Success_DEFAULT
-  }
-return *p.Success
+
+func NewIMathModuleSampleByKeyResult() *IMathModuleSampleByKeyResult {
+  return &IMathModuleSampleByKeyResult{}
 }
-var IMathModuleCountResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleCountResult) GetEx() *rpc.IExecutorException {
+
+var IMathModuleSampleByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleSampleByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleCountResult_Ex_DEFAULT
+    return IMathModuleSampleByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleCountResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *IMathModuleCountResult) IsSetEx() bool {
+func (p *IMathModuleSampleByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleCountResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleSampleByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1419,16 +2946,6 @@ func (p *IMathModuleCountResult) Read(ctx context.Context, iprot thrift.TProtoco
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField0(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
     case 1:
       if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(ctx, iprot); err != nil {
@@ -1454,16 +2971,7 @@ func (p *IMathModuleCountResult) Read(ctx context.Context, iprot thrift.TProtoco
   return nil
 }
 
-func (p *IMathModuleCountResult)  ReadField0(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(ctx); err != nil {
-  return thrift.PrependError("error reading field 0: ", err)
-} else {
-  p.Success = &v
-}
-  return nil
-}
-
-func (p *IMathModuleCountResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleSampleByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -1471,11 +2979,10 @@ func (p *IMathModuleCountResult)  ReadField1(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IMathModuleCountResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "count_result"); err != nil {
+func (p *IMathModuleSampleByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sampleByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(ctx, oprot); err != nil { return err }
     if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
@@ -1485,19 +2992,7 @@ func (p *IMathModuleCountResult) Write(ctx context.Context, oprot thrift.TProtoc
   return nil
 }
 
-func (p *IMathModuleCountResult) writeField0(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin(ctx, "success", thrift.I64, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := oprot.WriteI64(ctx, int64(*p.Success)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
-    if err := oprot.WriteFieldEnd(ctx); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *IMathModuleCountResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleSampleByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -1510,21 +3005,21 @@ func (p *IMathModuleCountResult) writeField1(ctx context.Context, oprot thrift.T
   return err
 }
 
-func (p *IMathModuleCountResult) String() string {
+func (p *IMathModuleSampleByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleCountResult(%+v)", *p)
+  return fmt.Sprintf("IMathModuleSampleByKeyResult(%+v)", *p)
 }
 
-type IMathModuleMaxArgs struct {
+type IMathModuleCountByKeyArgs struct {
 }
 
-func NewIMathModuleMaxArgs() *IMathModuleMaxArgs {
-  return &IMathModuleMaxArgs{}
+func NewIMathModuleCountByKeyArgs() *IMathModuleCountByKeyArgs {
+  return &IMathModuleCountByKeyArgs{}
 }
 
-func (p *IMathModuleMaxArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleCountByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1549,8 +3044,8 @@ func (p *IMathModuleMaxArgs) Read(ctx context.Context, iprot thrift.TProtocol) e
   return nil
 }
 
-func (p *IMathModuleMaxArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "max_args"); err != nil {
+func (p *IMathModuleCountByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "countByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
   }
@@ -1561,35 +3056,35 @@ func (p *IMathModuleMaxArgs) Write(ctx context.Context, oprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMaxArgs) String() string {
+func (p *IMathModuleCountByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMaxArgs(%+v)", *p)
+  return fmt.Sprintf("IMathModuleCountByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleMaxResult struct {
+type IMathModuleCountByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleMaxResult() *IMathModuleMaxResult {
-  return &IMathModuleMaxResult{}
+func NewIMathModuleCountByKeyResult() *IMathModuleCountByKeyResult {
+  return &IMathModuleCountByKeyResult{}
 }
 
-var IMathModuleMaxResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleMaxResult) GetEx() *rpc.IExecutorException {
+var IMathModuleCountByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleCountByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleMaxResult_Ex_DEFAULT
+    return IMathModuleCountByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleMaxResult) IsSetEx() bool {
+func (p *IMathModuleCountByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleMaxResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleCountByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1627,7 +3122,7 @@ func (p *IMathModuleMaxResult) Read(ctx context.Context, iprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMaxResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleCountByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -1635,8 +3130,8 @@ func (p *IMathModuleMaxResult)  ReadField1(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IMathModuleMaxResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "max_result"); err != nil {
+func (p *IMathModuleCountByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "countByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -1648,7 +3143,7 @@ func (p *IMathModuleMaxResult) Write(ctx context.Context, oprot thrift.TProtocol
   return nil
 }
 
-func (p *IMathModuleMaxResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleCountByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -1661,21 +3156,21 @@ func (p *IMathModuleMaxResult) writeField1(ctx context.Context, oprot thrift.TPr
   return err
 }
 
-func (p *IMathModuleMaxResult) String() string {
+func (p *IMathModuleCountByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMaxResult(%+v)", *p)
+  return fmt.Sprintf("IMathModuleCountByKeyResult(%+v)", *p)
 }
 
-type IMathModuleMinArgs struct {
+type IMathModuleCountByValueArgs struct {
 }
 
-func NewIMathModuleMinArgs() *IMathModuleMinArgs {
-  return &IMathModuleMinArgs{}
+func NewIMathModuleCountByValueArgs() *IMathModuleCountByValueArgs {
+  return &IMathModuleCountByValueArgs{}
 }
 
-func (p *IMathModuleMinArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleCountByValueArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1700,8 +3195,8 @@ func (p *IMathModuleMinArgs) Read(ctx context.Context, iprot thrift.TProtocol) e
   return nil
 }
 
-func (p *IMathModuleMinArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "min_args"); err != nil {
+func (p *IMathModuleCountByValueArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "countByValue_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
   }
@@ -1712,35 +3207,35 @@ func (p *IMathModuleMinArgs) Write(ctx context.Context, oprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMinArgs) String() string {
+func (p *IMathModuleCountByValueArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMinArgs(%+v)", *p)
+  return fmt.Sprintf("IMathModuleCountByValueArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleMinResult struct {
+type IMathModuleCountByValueResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleMinResult() *IMathModuleMinResult {
-  return &IMathModuleMinResult{}
+func NewIMathModuleCountByValueResult() *IMathModuleCountByValueResult {
+  return &IMathModuleCountByValueResult{}
 }
 
-var IMathModuleMinResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleMinResult) GetEx() *rpc.IExecutorException {
+var IMathModuleCountByValueResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleCountByValueResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleMinResult_Ex_DEFAULT
+    return IMathModuleCountByValueResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleMinResult) IsSetEx() bool {
+func (p *IMathModuleCountByValueResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleMinResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleCountByValueResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1778,7 +3273,7 @@ func (p *IMathModuleMinResult) Read(ctx context.Context, iprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMinResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleCountByValueResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -1786,8 +3281,8 @@ func (p *IMathModuleMinResult)  ReadField1(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IMathModuleMinResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "min_result"); err != nil {
+func (p *IMathModuleCountByValueResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "countByValue_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -1799,7 +3294,7 @@ func (p *IMathModuleMinResult) Write(ctx context.Context, oprot thrift.TProtocol
   return nil
 }
 
-func (p *IMathModuleMinResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleCountByValueResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -1812,35 +3307,28 @@ func (p *IMathModuleMinResult) writeField1(ctx context.Context, oprot thrift.TPr
   return err
 }
 
-func (p *IMathModuleMinResult) String() string {
+func (p *IMathModuleCountByValueResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMinResult(%+v)", *p)
+  return fmt.Sprintf("IMathModuleCountByValueResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Cmp
-type IMathModuleMax1Args struct {
-  Cmp *rpc.ISource `thrift:"cmp,1" db:"cmp" json:"cmp"`
+//  - Buckets
+type IMathModuleHistogramArgs struct {
+  Buckets int64 `thrift:"buckets,1" db:"buckets" json:"buckets"`
 }
 
-func NewIMathModuleMax1Args() *IMathModuleMax1Args {
-  return &IMathModuleMax1Args{}
+func NewIMathModuleHistogramArgs() *IMathModuleHistogramArgs {
+  return &IMathModuleHistogramArgs{}
 }
 
-var IMathModuleMax1Args_Cmp_DEFAULT *rpc.ISource
-func (p *IMathModuleMax1Args) GetCmp() *rpc.ISource {
-  if !p.IsSetCmp() {
-    return IMathModuleMax1Args_Cmp_DEFAULT
-  }
-return p.Cmp
-}
-func (p *IMathModuleMax1Args) IsSetCmp() bool {
-  return p.Cmp != nil
-}
 
-func (p *IMathModuleMax1Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleHistogramArgs) GetBuckets() int64 {
+  return p.Buckets
+}
+func (p *IMathModuleHistogramArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1854,7 +3342,7 @@ func (p *IMathModuleMax1Args) Read(ctx context.Context, iprot thrift.TProtocol)
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -1878,19 +3366,17 @@ func (p *IMathModuleMax1Args) Read(ctx context.Context, iprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMax1Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Cmp = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IMathModuleHistogramArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Buckets = v
 }
-  if err := p.Cmp.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cmp), err)
-  }
   return nil
 }
 
-func (p *IMathModuleMax1Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "max1_args"); err != nil {
+func (p *IMathModuleHistogramArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "histogram_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -1902,46 +3388,45 @@ func (p *IMathModuleMax1Args) Write(ctx context.Context, oprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMax1Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "cmp", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cmp: ", p), err) }
-  if err := p.Cmp.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cmp), err)
-  }
+func (p *IMathModuleHistogramArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "buckets", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:buckets: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.Buckets)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.buckets (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cmp: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:buckets: ", p), err) }
   return err
 }
 
-func (p *IMathModuleMax1Args) String() string {
+func (p *IMathModuleHistogramArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMax1Args(%+v)", *p)
+  return fmt.Sprintf("IMathModuleHistogramArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleMax1Result struct {
+type IMathModuleHistogramResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleMax1Result() *IMathModuleMax1Result {
-  return &IMathModuleMax1Result{}
+func NewIMathModuleHistogramResult() *IMathModuleHistogramResult {
+  return &IMathModuleHistogramResult{}
 }
 
-var IMathModuleMax1Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleMax1Result) GetEx() *rpc.IExecutorException {
+var IMathModuleHistogramResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleHistogramResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleMax1Result_Ex_DEFAULT
+    return IMathModuleHistogramResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleMax1Result) IsSetEx() bool {
+func (p *IMathModuleHistogramResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleMax1Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleHistogramResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1979,7 +3464,7 @@ func (p *IMathModuleMax1Result) Read(ctx context.Context, iprot thrift.TProtocol
   return nil
 }
 
-func (p *IMathModuleMax1Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleHistogramResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -1987,8 +3472,8 @@ func (p *IMathModuleMax1Result)  ReadField1(ctx context.Context, iprot thrift.TP
   return nil
 }
 
-func (p *IMathModuleMax1Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "max1_result"); err != nil {
+func (p *IMathModuleHistogramResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "histogram_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2000,7 +3485,7 @@ func (p *IMathModuleMax1Result) Write(ctx context.Context, oprot thrift.TProtoco
   return nil
 }
 
-func (p *IMathModuleMax1Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleHistogramResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2013,35 +3498,34 @@ func (p *IMathModuleMax1Result) writeField1(ctx context.Context, oprot thrift.TP
   return err
 }
 
-func (p *IMathModuleMax1Result) String() string {
+func (p *IMathModuleHistogramResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMax1Result(%+v)", *p)
+  return fmt.Sprintf("IMathModuleHistogramResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Cmp
-type IMathModuleMin1Args struct {
-  Cmp *rpc.ISource `thrift:"cmp,1" db:"cmp" json:"cmp"`
+//  - Probabilities
+//  - RelativeError
+type IMathModuleApproxQuantileArgs struct {
+  Probabilities []float64 `thrift:"probabilities,1" db:"probabilities" json:"probabilities"`
+  RelativeError float64 `thrift:"relativeError,2" db:"relativeError" json:"relativeError"`
 }
 
-func NewIMathModuleMin1Args() *IMathModuleMin1Args {
-  return &IMathModuleMin1Args{}
+func NewIMathModuleApproxQuantileArgs() *IMathModuleApproxQuantileArgs {
+  return &IMathModuleApproxQuantileArgs{}
 }
 
-var IMathModuleMin1Args_Cmp_DEFAULT *rpc.ISource
-func (p *IMathModuleMin1Args) GetCmp() *rpc.ISource {
-  if !p.IsSetCmp() {
-    return IMathModuleMin1Args_Cmp_DEFAULT
-  }
-return p.Cmp
-}
-func (p *IMathModuleMin1Args) IsSetCmp() bool {
-  return p.Cmp != nil
+
+func (p *IMathModuleApproxQuantileArgs) GetProbabilities() []float64 {
+  return p.Probabilities
 }
 
-func (p *IMathModuleMin1Args) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleApproxQuantileArgs) GetRelativeError() float64 {
+  return p.RelativeError
+}
+func (p *IMathModuleApproxQuantileArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2055,7 +3539,7 @@ func (p *IMathModuleMin1Args) Read(ctx context.Context, iprot thrift.TProtocol)
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.LIST {
         if err := p.ReadField1(ctx, iprot); err != nil {
           return err
         }
@@ -2064,6 +3548,16 @@ func (p *IMathModuleMin1Args) Read(ctx context.Context, iprot thrift.TProtocol)
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.DOUBLE {
+        if err := p.ReadField2(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(ctx, fieldTypeId); err != nil {
         return err
@@ -2079,22 +3573,43 @@ func (p *IMathModuleMin1Args) Read(ctx context.Context, iprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMin1Args)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Cmp = &rpc.ISource{
-  Params: map[string][]byte{
-  },
+func (p *IMathModuleApproxQuantileArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin(ctx)
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]float64, 0, size)
+  p.Probabilities =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem45 float64
+    if v, err := iprot.ReadDouble(ctx); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem45 = v
 }
-  if err := p.Cmp.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Cmp), err)
+    p.Probabilities = append(p.Probabilities, _elem45)
+  }
+  if err := iprot.ReadListEnd(ctx); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
   return nil
 }
 
-func (p *IMathModuleMin1Args) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "min1_args"); err != nil {
+func (p *IMathModuleApproxQuantileArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadDouble(ctx); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.RelativeError = v
+}
+  return nil
+}
+
+func (p *IMathModuleApproxQuantileArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "approxQuantile_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
+    if err := p.writeField2(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2103,46 +3618,63 @@ func (p *IMathModuleMin1Args) Write(ctx context.Context, oprot thrift.TProtocol)
   return nil
 }
 
-func (p *IMathModuleMin1Args) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "cmp", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cmp: ", p), err) }
-  if err := p.Cmp.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Cmp), err)
+func (p *IMathModuleApproxQuantileArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "probabilities", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:probabilities: ", p), err) }
+  if err := oprot.WriteListBegin(ctx, thrift.DOUBLE, len(p.Probabilities)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Probabilities {
+    if err := oprot.WriteDouble(ctx, float64(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(ctx); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
   }
   if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cmp: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:probabilities: ", p), err) }
   return err
 }
 
-func (p *IMathModuleMin1Args) String() string {
+func (p *IMathModuleApproxQuantileArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "relativeError", thrift.DOUBLE, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:relativeError: ", p), err) }
+  if err := oprot.WriteDouble(ctx, float64(p.RelativeError)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.relativeError (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:relativeError: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleApproxQuantileArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMin1Args(%+v)", *p)
+  return fmt.Sprintf("IMathModuleApproxQuantileArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleMin1Result struct {
+type IMathModuleApproxQuantileResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleMin1Result() *IMathModuleMin1Result {
-  return &IMathModuleMin1Result{}
+func NewIMathModuleApproxQuantileResult() *IMathModuleApproxQuantileResult {
+  return &IMathModuleApproxQuantileResult{}
 }
 
-var IMathModuleMin1Result_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleMin1Result) GetEx() *rpc.IExecutorException {
+var IMathModuleApproxQuantileResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleApproxQuantileResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleMin1Result_Ex_DEFAULT
+    return IMathModuleApproxQuantileResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleMin1Result) IsSetEx() bool {
+func (p *IMathModuleApproxQuantileResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleMin1Result) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleApproxQuantileResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2180,7 +3712,7 @@ func (p *IMathModuleMin1Result) Read(ctx context.Context, iprot thrift.TProtocol
   return nil
 }
 
-func (p *IMathModuleMin1Result)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleApproxQuantileResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2188,8 +3720,8 @@ func (p *IMathModuleMin1Result)  ReadField1(ctx context.Context, iprot thrift.TP
   return nil
 }
 
-func (p *IMathModuleMin1Result) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "min1_result"); err != nil {
+func (p *IMathModuleApproxQuantileResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "approxQuantile_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2201,7 +3733,7 @@ func (p *IMathModuleMin1Result) Write(ctx context.Context, oprot thrift.TProtoco
   return nil
 }
 
-func (p *IMathModuleMin1Result) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleApproxQuantileResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2214,47 +3746,21 @@ func (p *IMathModuleMin1Result) writeField1(ctx context.Context, oprot thrift.TP
   return err
 }
 
-func (p *IMathModuleMin1Result) String() string {
+func (p *IMathModuleApproxQuantileResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleMin1Result(%+v)", *p)
+  return fmt.Sprintf("IMathModuleApproxQuantileResult(%+v)", *p)
 }
 
-// Attributes:
-//  - WithReplacement
-//  - Fractions
-//  - Seed
-type IMathModuleSampleByKeyArgs struct {
-  WithReplacement bool `thrift:"withReplacement,1" db:"withReplacement" json:"withReplacement"`
-  Fractions *rpc.ISource `thrift:"fractions,2" db:"fractions" json:"fractions"`
-  Seed int32 `thrift:"seed,3" db:"seed" json:"seed"`
-}
-
-func NewIMathModuleSampleByKeyArgs() *IMathModuleSampleByKeyArgs {
-  return &IMathModuleSampleByKeyArgs{}
-}
-
-
-func (p *IMathModuleSampleByKeyArgs) GetWithReplacement() bool {
-  return p.WithReplacement
-}
-var IMathModuleSampleByKeyArgs_Fractions_DEFAULT *rpc.ISource
-func (p *IMathModuleSampleByKeyArgs) GetFractions() *rpc.ISource {
-  if !p.IsSetFractions() {
-    return IMathModuleSampleByKeyArgs_Fractions_DEFAULT
-  }
-return p.Fractions
+type IMathModuleSumArgs struct {
 }
 
-func (p *IMathModuleSampleByKeyArgs) GetSeed() int32 {
-  return p.Seed
-}
-func (p *IMathModuleSampleByKeyArgs) IsSetFractions() bool {
-  return p.Fractions != nil
+func NewIMathModuleSumArgs() *IMathModuleSumArgs {
+  return &IMathModuleSumArgs{}
 }
 
-func (p *IMathModuleSampleByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleSumArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2266,41 +3772,8 @@ func (p *IMathModuleSampleByKeyArgs) Read(ctx context.Context, iprot thrift.TPro
       return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
     if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField1(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField2(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
-      if fieldTypeId == thrift.I32 {
-        if err := p.ReadField3(ctx, iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-          return err
-        }
-      }
-    default:
-      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-        return err
-      }
+    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+      return err
     }
     if err := iprot.ReadFieldEnd(ctx); err != nil {
       return err
@@ -2312,42 +3785,10 @@ func (p *IMathModuleSampleByKeyArgs) Read(ctx context.Context, iprot thrift.TPro
   return nil
 }
 
-func (p *IMathModuleSampleByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(ctx); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.WithReplacement = v
-}
-  return nil
-}
-
-func (p *IMathModuleSampleByKeyArgs)  ReadField2(ctx context.Context, iprot thrift.TProtocol) error {
-  p.Fractions = &rpc.ISource{
-  Params: map[string][]byte{
-  },
-}
-  if err := p.Fractions.Read(ctx, iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Fractions), err)
-  }
-  return nil
-}
-
-func (p *IMathModuleSampleByKeyArgs)  ReadField3(ctx context.Context, iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(ctx); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
-} else {
-  p.Seed = v
-}
-  return nil
-}
-
-func (p *IMathModuleSampleByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sampleByKey_args"); err != nil {
+func (p *IMathModuleSumArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sum_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField1(ctx, oprot); err != nil { return err }
-    if err := p.writeField2(ctx, oprot); err != nil { return err }
-    if err := p.writeField3(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2356,66 +3797,35 @@ func (p *IMathModuleSampleByKeyArgs) Write(ctx context.Context, oprot thrift.TPr
   return nil
 }
 
-func (p *IMathModuleSampleByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "withReplacement", thrift.BOOL, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:withReplacement: ", p), err) }
-  if err := oprot.WriteBool(ctx, bool(p.WithReplacement)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.withReplacement (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:withReplacement: ", p), err) }
-  return err
-}
-
-func (p *IMathModuleSampleByKeyArgs) writeField2(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "fractions", thrift.STRUCT, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:fractions: ", p), err) }
-  if err := p.Fractions.Write(ctx, oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Fractions), err)
-  }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:fractions: ", p), err) }
-  return err
-}
-
-func (p *IMathModuleSampleByKeyArgs) writeField3(ctx context.Context, oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin(ctx, "seed", thrift.I32, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:seed: ", p), err) }
-  if err := oprot.WriteI32(ctx, int32(p.Seed)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.seed (3) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(ctx); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:seed: ", p), err) }
-  return err
-}
-
-func (p *IMathModuleSampleByKeyArgs) String() string {
+func (p *IMathModuleSumArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleSampleByKeyArgs(%+v)", *p)
+  return fmt.Sprintf("IMathModuleSumArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleSampleByKeyResult struct {
+type IMathModuleSumResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleSampleByKeyResult() *IMathModuleSampleByKeyResult {
-  return &IMathModuleSampleByKeyResult{}
+func NewIMathModuleSumResult() *IMathModuleSumResult {
+  return &IMathModuleSumResult{}
 }
 
-var IMathModuleSampleByKeyResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleSampleByKeyResult) GetEx() *rpc.IExecutorException {
+var IMathModuleSumResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleSumResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleSampleByKeyResult_Ex_DEFAULT
+    return IMathModuleSumResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleSampleByKeyResult) IsSetEx() bool {
+func (p *IMathModuleSumResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleSampleByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleSumResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2453,7 +3863,7 @@ func (p *IMathModuleSampleByKeyResult) Read(ctx context.Context, iprot thrift.TP
   return nil
 }
 
-func (p *IMathModuleSampleByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleSumResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2461,8 +3871,8 @@ func (p *IMathModuleSampleByKeyResult)  ReadField1(ctx context.Context, iprot th
   return nil
 }
 
-func (p *IMathModuleSampleByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "sampleByKey_result"); err != nil {
+func (p *IMathModuleSumResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sum_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2474,7 +3884,7 @@ func (p *IMathModuleSampleByKeyResult) Write(ctx context.Context, oprot thrift.T
   return nil
 }
 
-func (p *IMathModuleSampleByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleSumResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2487,21 +3897,21 @@ func (p *IMathModuleSampleByKeyResult) writeField1(ctx context.Context, oprot th
   return err
 }
 
-func (p *IMathModuleSampleByKeyResult) String() string {
+func (p *IMathModuleSumResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleSampleByKeyResult(%+v)", *p)
+  return fmt.Sprintf("IMathModuleSumResult(%+v)", *p)
 }
 
-type IMathModuleCountByKeyArgs struct {
+type IMathModuleMeanArgs struct {
 }
 
-func NewIMathModuleCountByKeyArgs() *IMathModuleCountByKeyArgs {
-  return &IMathModuleCountByKeyArgs{}
+func NewIMathModuleMeanArgs() *IMathModuleMeanArgs {
+  return &IMathModuleMeanArgs{}
 }
 
-func (p *IMathModuleCountByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleMeanArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2526,8 +3936,8 @@ func (p *IMathModuleCountByKeyArgs) Read(ctx context.Context, iprot thrift.TProt
   return nil
 }
 
-func (p *IMathModuleCountByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "countByKey_args"); err != nil {
+func (p *IMathModuleMeanArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mean_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
   }
@@ -2538,35 +3948,35 @@ func (p *IMathModuleCountByKeyArgs) Write(ctx context.Context, oprot thrift.TPro
   return nil
 }
 
-func (p *IMathModuleCountByKeyArgs) String() string {
+func (p *IMathModuleMeanArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleCountByKeyArgs(%+v)", *p)
+  return fmt.Sprintf("IMathModuleMeanArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleCountByKeyResult struct {
+type IMathModuleMeanResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleCountByKeyResult() *IMathModuleCountByKeyResult {
-  return &IMathModuleCountByKeyResult{}
+func NewIMathModuleMeanResult() *IMathModuleMeanResult {
+  return &IMathModuleMeanResult{}
 }
 
-var IMathModuleCountByKeyResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleCountByKeyResult) GetEx() *rpc.IExecutorException {
+var IMathModuleMeanResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleMeanResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleCountByKeyResult_Ex_DEFAULT
+    return IMathModuleMeanResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleCountByKeyResult) IsSetEx() bool {
+func (p *IMathModuleMeanResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleCountByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleMeanResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2604,7 +4014,7 @@ func (p *IMathModuleCountByKeyResult) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IMathModuleCountByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleMeanResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2612,8 +4022,8 @@ func (p *IMathModuleCountByKeyResult)  ReadField1(ctx context.Context, iprot thr
   return nil
 }
 
-func (p *IMathModuleCountByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "countByKey_result"); err != nil {
+func (p *IMathModuleMeanResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "mean_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2625,7 +4035,7 @@ func (p *IMathModuleCountByKeyResult) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IMathModuleCountByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleMeanResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2638,21 +4048,28 @@ func (p *IMathModuleCountByKeyResult) writeField1(ctx context.Context, oprot thr
   return err
 }
 
-func (p *IMathModuleCountByKeyResult) String() string {
+func (p *IMathModuleMeanResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleCountByKeyResult(%+v)", *p)
+  return fmt.Sprintf("IMathModuleMeanResult(%+v)", *p)
 }
 
-type IMathModuleCountByValueArgs struct {
+// Attributes:
+//  - NumPartitions
+type IMathModuleSumByKeyArgs struct {
+  NumPartitions int64 `thrift:"numPartitions,1" db:"numPartitions" json:"numPartitions"`
 }
 
-func NewIMathModuleCountByValueArgs() *IMathModuleCountByValueArgs {
-  return &IMathModuleCountByValueArgs{}
+func NewIMathModuleSumByKeyArgs() *IMathModuleSumByKeyArgs {
+  return &IMathModuleSumByKeyArgs{}
 }
 
-func (p *IMathModuleCountByValueArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
+
+func (p *IMathModuleSumByKeyArgs) GetNumPartitions() int64 {
+  return p.NumPartitions
+}
+func (p *IMathModuleSumByKeyArgs) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2664,8 +4081,21 @@ func (p *IMathModuleCountByValueArgs) Read(ctx context.Context, iprot thrift.TPr
       return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
     if fieldTypeId == thrift.STOP { break; }
-    if err := iprot.Skip(ctx, fieldTypeId); err != nil {
-      return err
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(ctx, iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(ctx, fieldTypeId); err != nil {
+        return err
+      }
     }
     if err := iprot.ReadFieldEnd(ctx); err != nil {
       return err
@@ -2677,10 +4107,20 @@ func (p *IMathModuleCountByValueArgs) Read(ctx context.Context, iprot thrift.TPr
   return nil
 }
 
-func (p *IMathModuleCountByValueArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "countByValue_args"); err != nil {
+func (p *IMathModuleSumByKeyArgs)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(ctx); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.NumPartitions = v
+}
+  return nil
+}
+
+func (p *IMathModuleSumByKeyArgs) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sumByKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField1(ctx, oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(ctx); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2689,35 +4129,45 @@ func (p *IMathModuleCountByValueArgs) Write(ctx context.Context, oprot thrift.TP
   return nil
 }
 
-func (p *IMathModuleCountByValueArgs) String() string {
+func (p *IMathModuleSumByKeyArgs) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin(ctx, "numPartitions", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:numPartitions: ", p), err) }
+  if err := oprot.WriteI64(ctx, int64(p.NumPartitions)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.numPartitions (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(ctx); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:numPartitions: ", p), err) }
+  return err
+}
+
+func (p *IMathModuleSumByKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleCountByValueArgs(%+v)", *p)
+  return fmt.Sprintf("IMathModuleSumByKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Ex
-type IMathModuleCountByValueResult struct {
+type IMathModuleSumByKeyResult struct {
   Ex *rpc.IExecutorException `thrift:"ex,1" db:"ex" json:"ex,omitempty"`
 }
 
-func NewIMathModuleCountByValueResult() *IMathModuleCountByValueResult {
-  return &IMathModuleCountByValueResult{}
+func NewIMathModuleSumByKeyResult() *IMathModuleSumByKeyResult {
+  return &IMathModuleSumByKeyResult{}
 }
 
-var IMathModuleCountByValueResult_Ex_DEFAULT *rpc.IExecutorException
-func (p *IMathModuleCountByValueResult) GetEx() *rpc.IExecutorException {
+var IMathModuleSumByKeyResult_Ex_DEFAULT *rpc.IExecutorException
+func (p *IMathModuleSumByKeyResult) GetEx() *rpc.IExecutorException {
   if !p.IsSetEx() {
-    return IMathModuleCountByValueResult_Ex_DEFAULT
+    return IMathModuleSumByKeyResult_Ex_DEFAULT
   }
 return p.Ex
 }
-func (p *IMathModuleCountByValueResult) IsSetEx() bool {
+func (p *IMathModuleSumByKeyResult) IsSetEx() bool {
   return p.Ex != nil
 }
 
-func (p *IMathModuleCountByValueResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleSumByKeyResult) Read(ctx context.Context, iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(ctx); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2755,7 +4205,7 @@ func (p *IMathModuleCountByValueResult) Read(ctx context.Context, iprot thrift.T
   return nil
 }
 
-func (p *IMathModuleCountByValueResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
+func (p *IMathModuleSumByKeyResult)  ReadField1(ctx context.Context, iprot thrift.TProtocol) error {
   p.Ex = &rpc.IExecutorException{}
   if err := p.Ex.Read(ctx, iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Ex), err)
@@ -2763,8 +4213,8 @@ func (p *IMathModuleCountByValueResult)  ReadField1(ctx context.Context, iprot t
   return nil
 }
 
-func (p *IMathModuleCountByValueResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin(ctx, "countByValue_result"); err != nil {
+func (p *IMathModuleSumByKeyResult) Write(ctx context.Context, oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin(ctx, "sumByKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(ctx, oprot); err != nil { return err }
@@ -2776,7 +4226,7 @@ func (p *IMathModuleCountByValueResult) Write(ctx context.Context, oprot thrift.
   return nil
 }
 
-func (p *IMathModuleCountByValueResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
+func (p *IMathModuleSumByKeyResult) writeField1(ctx context.Context, oprot thrift.TProtocol) (err error) {
   if p.IsSetEx() {
     if err := oprot.WriteFieldBegin(ctx, "ex", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ex: ", p), err) }
@@ -2789,11 +4239,11 @@ func (p *IMathModuleCountByValueResult) writeField1(ctx context.Context, oprot t
   return err
 }
 
-func (p *IMathModuleCountByValueResult) String() string {
+func (p *IMathModuleSumByKeyResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("IMathModuleCountByValueResult(%+v)", *p)
+  return fmt.Sprintf("IMathModuleSumByKeyResult(%+v)", *p)
 }
 
 
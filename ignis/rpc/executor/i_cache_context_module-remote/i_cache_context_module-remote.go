@@ -29,7 +29,11 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void loadContext(i64 id)")
   fmt.Fprintln(os.Stderr, "  void loadContextAsVariable(i64 id, string name)")
   fmt.Fprintln(os.Stderr, "  void cache(i64 id, i8 level)")
+  fmt.Fprintln(os.Stderr, "  void unpersist(i64 id)")
   fmt.Fprintln(os.Stderr, "  void loadCache(i64 id)")
+  fmt.Fprintln(os.Stderr, "  void loadReplica(i64 id, i64 executor)")
+  fmt.Fprintln(os.Stderr, "  i64 checkpoint()")
+  fmt.Fprintln(os.Stderr, "  void restoreCheckpoint()")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
 }
@@ -173,8 +177,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "LoadContext requires 1 args")
       flag.Usage()
     }
-    argvalue0, err20 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err20 != nil {
+    argvalue0, err32 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err32 != nil {
       Usage()
       return
     }
@@ -187,8 +191,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "LoadContextAsVariable requires 2 args")
       flag.Usage()
     }
-    argvalue0, err21 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err21 != nil {
+    argvalue0, err33 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err33 != nil {
       Usage()
       return
     }
@@ -203,14 +207,14 @@ func main() {
       fmt.Fprintln(os.Stderr, "Cache requires 2 args")
       flag.Usage()
     }
-    argvalue0, err23 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err23 != nil {
+    argvalue0, err35 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err35 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    tmp1, err24 := (strconv.Atoi(flag.Arg(2)))
-    if err24 != nil {
+    tmp1, err36 := (strconv.Atoi(flag.Arg(2)))
+    if err36 != nil {
       Usage()
       return
     }
@@ -219,13 +223,27 @@ func main() {
     fmt.Print(client.Cache(context.Background(), value0, value1))
     fmt.Print("\n")
     break
+  case "unpersist":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "Unpersist requires 1 args")
+      flag.Usage()
+    }
+    argvalue0, err37 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err37 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.Unpersist(context.Background(), value0))
+    fmt.Print("\n")
+    break
   case "loadCache":
     if flag.NArg() - 1 != 1 {
       fmt.Fprintln(os.Stderr, "LoadCache requires 1 args")
       flag.Usage()
     }
-    argvalue0, err25 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err25 != nil {
+    argvalue0, err38 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err38 != nil {
       Usage()
       return
     }
@@ -233,6 +251,42 @@ func main() {
     fmt.Print(client.LoadCache(context.Background(), value0))
     fmt.Print("\n")
     break
+  case "loadReplica":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "LoadReplica requires 2 args")
+      flag.Usage()
+    }
+    argvalue0, err39 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err39 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1, err40 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err40 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.LoadReplica(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "checkpoint":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "Checkpoint requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.Checkpoint(context.Background()))
+    fmt.Print("\n")
+    break
+  case "restoreCheckpoint":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "RestoreCheckpoint requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.RestoreCheckpoint(context.Background()))
+    fmt.Print("\n")
+    break
   case "":
     Usage()
     break
@@ -42,6 +42,9 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void driverScatter3(string group, i64 partitions, ISource src)")
   fmt.Fprintln(os.Stderr, "  void importData(string group, bool source, i64 threads)")
   fmt.Fprintln(os.Stderr, "  void importData4(string group, bool source, i64 threads, ISource src)")
+  fmt.Fprintln(os.Stderr, "  void broadcast(string name, binary value)")
+  fmt.Fprintln(os.Stderr, "  void removeBroadcast(string name)")
+  fmt.Fprintln(os.Stderr, "   reduceAccumulators()")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
 }
@@ -247,8 +250,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "GetPartitions requires 1 args")
       flag.Usage()
     }
-    tmp0, err67 := (strconv.Atoi(flag.Arg(1)))
-    if err67 != nil {
+    tmp0, err78 := (strconv.Atoi(flag.Arg(1)))
+    if err78 != nil {
       Usage()
       return
     }
@@ -262,15 +265,15 @@ func main() {
       fmt.Fprintln(os.Stderr, "GetPartitions2 requires 2 args")
       flag.Usage()
     }
-    tmp0, err68 := (strconv.Atoi(flag.Arg(1)))
-    if err68 != nil {
+    tmp0, err79 := (strconv.Atoi(flag.Arg(1)))
+    if err79 != nil {
       Usage()
       return
     }
     argvalue0 := int8(tmp0)
     value0 := argvalue0
-    argvalue1, err69 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err69 != nil {
+    argvalue1, err80 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err80 != nil {
       Usage()
       return
     }
@@ -283,19 +286,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "SetPartitions requires 1 args")
       flag.Usage()
     }
-    arg70 := flag.Arg(1)
-    mbTrans71 := thrift.NewTMemoryBufferLen(len(arg70))
-    defer mbTrans71.Close()
-    _, err72 := mbTrans71.WriteString(arg70)
-    if err72 != nil { 
+    arg81 := flag.Arg(1)
+    mbTrans82 := thrift.NewTMemoryBufferLen(len(arg81))
+    defer mbTrans82.Close()
+    _, err83 := mbTrans82.WriteString(arg81)
+    if err83 != nil { 
       Usage()
       return
     }
-    factory73 := thrift.NewTJSONProtocolFactory()
-    jsProt74 := factory73.GetProtocol(mbTrans71)
+    factory84 := thrift.NewTJSONProtocolFactory()
+    jsProt85 := factory84.GetProtocol(mbTrans82)
     containerStruct0 := executor.NewICommModuleSetPartitionsArgs()
-    err75 := containerStruct0.ReadField1(context.Background(), jsProt74)
-    if err75 != nil {
+    err86 := containerStruct0.ReadField1(context.Background(), jsProt85)
+    if err86 != nil {
       Usage()
       return
     }
@@ -309,37 +312,37 @@ func main() {
       fmt.Fprintln(os.Stderr, "SetPartitions2 requires 2 args")
       flag.Usage()
     }
-    arg76 := flag.Arg(1)
-    mbTrans77 := thrift.NewTMemoryBufferLen(len(arg76))
-    defer mbTrans77.Close()
-    _, err78 := mbTrans77.WriteString(arg76)
-    if err78 != nil { 
+    arg87 := flag.Arg(1)
+    mbTrans88 := thrift.NewTMemoryBufferLen(len(arg87))
+    defer mbTrans88.Close()
+    _, err89 := mbTrans88.WriteString(arg87)
+    if err89 != nil { 
       Usage()
       return
     }
-    factory79 := thrift.NewTJSONProtocolFactory()
-    jsProt80 := factory79.GetProtocol(mbTrans77)
+    factory90 := thrift.NewTJSONProtocolFactory()
+    jsProt91 := factory90.GetProtocol(mbTrans88)
     containerStruct0 := executor.NewICommModuleSetPartitions2Args()
-    err81 := containerStruct0.ReadField1(context.Background(), jsProt80)
-    if err81 != nil {
+    err92 := containerStruct0.ReadField1(context.Background(), jsProt91)
+    if err92 != nil {
       Usage()
       return
     }
     argvalue0 := containerStruct0.Partitions
     value0 := argvalue0
-    arg82 := flag.Arg(2)
-    mbTrans83 := thrift.NewTMemoryBufferLen(len(arg82))
-    defer mbTrans83.Close()
-    _, err84 := mbTrans83.WriteString(arg82)
-    if err84 != nil {
+    arg93 := flag.Arg(2)
+    mbTrans94 := thrift.NewTMemoryBufferLen(len(arg93))
+    defer mbTrans94.Close()
+    _, err95 := mbTrans94.WriteString(arg93)
+    if err95 != nil {
       Usage()
       return
     }
-    factory85 := thrift.NewTJSONProtocolFactory()
-    jsProt86 := factory85.GetProtocol(mbTrans83)
+    factory96 := thrift.NewTJSONProtocolFactory()
+    jsProt97 := factory96.GetProtocol(mbTrans94)
     argvalue1 := rpc.NewISource()
-    err87 := argvalue1.Read(context.Background(), jsProt86)
-    if err87 != nil {
+    err98 := argvalue1.Read(context.Background(), jsProt97)
+    if err98 != nil {
       Usage()
       return
     }
@@ -354,19 +357,19 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    arg89 := flag.Arg(2)
-    mbTrans90 := thrift.NewTMemoryBufferLen(len(arg89))
-    defer mbTrans90.Close()
-    _, err91 := mbTrans90.WriteString(arg89)
-    if err91 != nil {
+    arg100 := flag.Arg(2)
+    mbTrans101 := thrift.NewTMemoryBufferLen(len(arg100))
+    defer mbTrans101.Close()
+    _, err102 := mbTrans101.WriteString(arg100)
+    if err102 != nil {
       Usage()
       return
     }
-    factory92 := thrift.NewTJSONProtocolFactory()
-    jsProt93 := factory92.GetProtocol(mbTrans90)
+    factory103 := thrift.NewTJSONProtocolFactory()
+    jsProt104 := factory103.GetProtocol(mbTrans101)
     argvalue1 := rpc.NewISource()
-    err94 := argvalue1.Read(context.Background(), jsProt93)
-    if err94 != nil {
+    err105 := argvalue1.Read(context.Background(), jsProt104)
+    if err105 != nil {
       Usage()
       return
     }
@@ -381,19 +384,19 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    arg96 := flag.Arg(2)
-    mbTrans97 := thrift.NewTMemoryBufferLen(len(arg96))
-    defer mbTrans97.Close()
-    _, err98 := mbTrans97.WriteString(arg96)
-    if err98 != nil {
+    arg107 := flag.Arg(2)
+    mbTrans108 := thrift.NewTMemoryBufferLen(len(arg107))
+    defer mbTrans108.Close()
+    _, err109 := mbTrans108.WriteString(arg107)
+    if err109 != nil {
       Usage()
       return
     }
-    factory99 := thrift.NewTJSONProtocolFactory()
-    jsProt100 := factory99.GetProtocol(mbTrans97)
+    factory110 := thrift.NewTJSONProtocolFactory()
+    jsProt111 := factory110.GetProtocol(mbTrans108)
     argvalue1 := rpc.NewISource()
-    err101 := argvalue1.Read(context.Background(), jsProt100)
-    if err101 != nil {
+    err112 := argvalue1.Read(context.Background(), jsProt111)
+    if err112 != nil {
       Usage()
       return
     }
@@ -408,8 +411,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err103 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err103 != nil {
+    argvalue1, err114 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err114 != nil {
       Usage()
       return
     }
@@ -424,25 +427,25 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err105 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err105 != nil {
+    argvalue1, err116 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err116 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    arg106 := flag.Arg(3)
-    mbTrans107 := thrift.NewTMemoryBufferLen(len(arg106))
-    defer mbTrans107.Close()
-    _, err108 := mbTrans107.WriteString(arg106)
-    if err108 != nil {
+    arg117 := flag.Arg(3)
+    mbTrans118 := thrift.NewTMemoryBufferLen(len(arg117))
+    defer mbTrans118.Close()
+    _, err119 := mbTrans118.WriteString(arg117)
+    if err119 != nil {
       Usage()
       return
     }
-    factory109 := thrift.NewTJSONProtocolFactory()
-    jsProt110 := factory109.GetProtocol(mbTrans107)
+    factory120 := thrift.NewTJSONProtocolFactory()
+    jsProt121 := factory120.GetProtocol(mbTrans118)
     argvalue2 := rpc.NewISource()
-    err111 := argvalue2.Read(context.Background(), jsProt110)
-    if err111 != nil {
+    err122 := argvalue2.Read(context.Background(), jsProt121)
+    if err122 != nil {
       Usage()
       return
     }
@@ -459,8 +462,8 @@ func main() {
     value0 := argvalue0
     argvalue1 := flag.Arg(2) == "true"
     value1 := argvalue1
-    argvalue2, err114 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err114 != nil {
+    argvalue2, err125 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err125 != nil {
       Usage()
       return
     }
@@ -477,25 +480,25 @@ func main() {
     value0 := argvalue0
     argvalue1 := flag.Arg(2) == "true"
     value1 := argvalue1
-    argvalue2, err117 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err117 != nil {
+    argvalue2, err128 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err128 != nil {
       Usage()
       return
     }
     value2 := argvalue2
-    arg118 := flag.Arg(4)
-    mbTrans119 := thrift.NewTMemoryBufferLen(len(arg118))
-    defer mbTrans119.Close()
-    _, err120 := mbTrans119.WriteString(arg118)
-    if err120 != nil {
+    arg129 := flag.Arg(4)
+    mbTrans130 := thrift.NewTMemoryBufferLen(len(arg129))
+    defer mbTrans130.Close()
+    _, err131 := mbTrans130.WriteString(arg129)
+    if err131 != nil {
       Usage()
       return
     }
-    factory121 := thrift.NewTJSONProtocolFactory()
-    jsProt122 := factory121.GetProtocol(mbTrans119)
+    factory132 := thrift.NewTJSONProtocolFactory()
+    jsProt133 := factory132.GetProtocol(mbTrans130)
     argvalue3 := rpc.NewISource()
-    err123 := argvalue3.Read(context.Background(), jsProt122)
-    if err123 != nil {
+    err134 := argvalue3.Read(context.Background(), jsProt133)
+    if err134 != nil {
       Usage()
       return
     }
@@ -503,6 +506,36 @@ func main() {
     fmt.Print(client.ImportData4(context.Background(), value0, value1, value2, value3))
     fmt.Print("\n")
     break
+  case "broadcast":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Broadcast requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1 := []byte(flag.Arg(2))
+    value1 := argvalue1
+    fmt.Print(client.Broadcast(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "removeBroadcast":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "RemoveBroadcast requires 1 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    fmt.Print(client.RemoveBroadcast(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "reduceAccumulators":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "ReduceAccumulators requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.ReduceAccumulators(context.Background()))
+    fmt.Print("\n")
+    break
   case "":
     Usage()
     break
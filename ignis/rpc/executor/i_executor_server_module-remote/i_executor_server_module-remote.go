@@ -26,6 +26,7 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "\nFunctions:")
   fmt.Fprintln(os.Stderr, "  void start( properties,  env)")
   fmt.Fprintln(os.Stderr, "  void stop()")
+  fmt.Fprintln(os.Stderr, "  void cancel(string reason)")
   fmt.Fprintln(os.Stderr, "  bool test()")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
@@ -154,37 +155,37 @@ func main() {
       fmt.Fprintln(os.Stderr, "Start requires 2 args")
       flag.Usage()
     }
-    arg15 := flag.Arg(1)
-    mbTrans16 := thrift.NewTMemoryBufferLen(len(arg15))
-    defer mbTrans16.Close()
-    _, err17 := mbTrans16.WriteString(arg15)
-    if err17 != nil { 
+    arg18 := flag.Arg(1)
+    mbTrans19 := thrift.NewTMemoryBufferLen(len(arg18))
+    defer mbTrans19.Close()
+    _, err20 := mbTrans19.WriteString(arg18)
+    if err20 != nil { 
       Usage()
       return
     }
-    factory18 := thrift.NewTJSONProtocolFactory()
-    jsProt19 := factory18.GetProtocol(mbTrans16)
+    factory21 := thrift.NewTJSONProtocolFactory()
+    jsProt22 := factory21.GetProtocol(mbTrans19)
     containerStruct0 := executor.NewIExecutorServerModuleStartArgs()
-    err20 := containerStruct0.ReadField1(context.Background(), jsProt19)
-    if err20 != nil {
+    err23 := containerStruct0.ReadField1(context.Background(), jsProt22)
+    if err23 != nil {
       Usage()
       return
     }
     argvalue0 := containerStruct0.Properties
     value0 := argvalue0
-    arg21 := flag.Arg(2)
-    mbTrans22 := thrift.NewTMemoryBufferLen(len(arg21))
-    defer mbTrans22.Close()
-    _, err23 := mbTrans22.WriteString(arg21)
-    if err23 != nil { 
+    arg24 := flag.Arg(2)
+    mbTrans25 := thrift.NewTMemoryBufferLen(len(arg24))
+    defer mbTrans25.Close()
+    _, err26 := mbTrans25.WriteString(arg24)
+    if err26 != nil { 
       Usage()
       return
     }
-    factory24 := thrift.NewTJSONProtocolFactory()
-    jsProt25 := factory24.GetProtocol(mbTrans22)
+    factory27 := thrift.NewTJSONProtocolFactory()
+    jsProt28 := factory27.GetProtocol(mbTrans25)
     containerStruct1 := executor.NewIExecutorServerModuleStartArgs()
-    err26 := containerStruct1.ReadField2(context.Background(), jsProt25)
-    if err26 != nil {
+    err29 := containerStruct1.ReadField2(context.Background(), jsProt28)
+    if err29 != nil {
       Usage()
       return
     }
@@ -201,6 +202,16 @@ func main() {
     fmt.Print(client.Stop(context.Background()))
     fmt.Print("\n")
     break
+  case "cancel":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "Cancel requires 1 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    fmt.Print(client.Cancel(context.Background(), value0))
+    fmt.Print("\n")
+    break
   case "test":
     if flag.NArg() - 1 != 0 {
       fmt.Fprintln(os.Stderr, "Test requires 0 args")
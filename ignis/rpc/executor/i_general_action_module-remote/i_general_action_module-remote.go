@@ -42,6 +42,7 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void takeOrdered2(i64 num, ISource cmp)")
   fmt.Fprintln(os.Stderr, "  void keys()")
   fmt.Fprintln(os.Stderr, "  void values()")
+  fmt.Fprintln(os.Stderr, "  void reduceByKeyLocally(ISource src)")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
 }
@@ -169,19 +170,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Execute requires 1 args")
       flag.Usage()
     }
-    arg56 := flag.Arg(1)
-    mbTrans57 := thrift.NewTMemoryBufferLen(len(arg56))
-    defer mbTrans57.Close()
-    _, err58 := mbTrans57.WriteString(arg56)
-    if err58 != nil {
+    arg59 := flag.Arg(1)
+    mbTrans60 := thrift.NewTMemoryBufferLen(len(arg59))
+    defer mbTrans60.Close()
+    _, err61 := mbTrans60.WriteString(arg59)
+    if err61 != nil {
       Usage()
       return
     }
-    factory59 := thrift.NewTJSONProtocolFactory()
-    jsProt60 := factory59.GetProtocol(mbTrans57)
+    factory62 := thrift.NewTJSONProtocolFactory()
+    jsProt63 := factory62.GetProtocol(mbTrans60)
     argvalue0 := rpc.NewISource()
-    err61 := argvalue0.Read(context.Background(), jsProt60)
-    if err61 != nil {
+    err64 := argvalue0.Read(context.Background(), jsProt63)
+    if err64 != nil {
       Usage()
       return
     }
@@ -194,19 +195,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Reduce requires 1 args")
       flag.Usage()
     }
-    arg62 := flag.Arg(1)
-    mbTrans63 := thrift.NewTMemoryBufferLen(len(arg62))
-    defer mbTrans63.Close()
-    _, err64 := mbTrans63.WriteString(arg62)
-    if err64 != nil {
+    arg65 := flag.Arg(1)
+    mbTrans66 := thrift.NewTMemoryBufferLen(len(arg65))
+    defer mbTrans66.Close()
+    _, err67 := mbTrans66.WriteString(arg65)
+    if err67 != nil {
       Usage()
       return
     }
-    factory65 := thrift.NewTJSONProtocolFactory()
-    jsProt66 := factory65.GetProtocol(mbTrans63)
+    factory68 := thrift.NewTJSONProtocolFactory()
+    jsProt69 := factory68.GetProtocol(mbTrans66)
     argvalue0 := rpc.NewISource()
-    err67 := argvalue0.Read(context.Background(), jsProt66)
-    if err67 != nil {
+    err70 := argvalue0.Read(context.Background(), jsProt69)
+    if err70 != nil {
       Usage()
       return
     }
@@ -219,19 +220,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "TreeReduce requires 1 args")
       flag.Usage()
     }
-    arg68 := flag.Arg(1)
-    mbTrans69 := thrift.NewTMemoryBufferLen(len(arg68))
-    defer mbTrans69.Close()
-    _, err70 := mbTrans69.WriteString(arg68)
-    if err70 != nil {
+    arg71 := flag.Arg(1)
+    mbTrans72 := thrift.NewTMemoryBufferLen(len(arg71))
+    defer mbTrans72.Close()
+    _, err73 := mbTrans72.WriteString(arg71)
+    if err73 != nil {
       Usage()
       return
     }
-    factory71 := thrift.NewTJSONProtocolFactory()
-    jsProt72 := factory71.GetProtocol(mbTrans69)
+    factory74 := thrift.NewTJSONProtocolFactory()
+    jsProt75 := factory74.GetProtocol(mbTrans72)
     argvalue0 := rpc.NewISource()
-    err73 := argvalue0.Read(context.Background(), jsProt72)
-    if err73 != nil {
+    err76 := argvalue0.Read(context.Background(), jsProt75)
+    if err76 != nil {
       Usage()
       return
     }
@@ -252,53 +253,53 @@ func main() {
       fmt.Fprintln(os.Stderr, "Aggregate requires 3 args")
       flag.Usage()
     }
-    arg74 := flag.Arg(1)
-    mbTrans75 := thrift.NewTMemoryBufferLen(len(arg74))
-    defer mbTrans75.Close()
-    _, err76 := mbTrans75.WriteString(arg74)
-    if err76 != nil {
+    arg77 := flag.Arg(1)
+    mbTrans78 := thrift.NewTMemoryBufferLen(len(arg77))
+    defer mbTrans78.Close()
+    _, err79 := mbTrans78.WriteString(arg77)
+    if err79 != nil {
       Usage()
       return
     }
-    factory77 := thrift.NewTJSONProtocolFactory()
-    jsProt78 := factory77.GetProtocol(mbTrans75)
+    factory80 := thrift.NewTJSONProtocolFactory()
+    jsProt81 := factory80.GetProtocol(mbTrans78)
     argvalue0 := rpc.NewISource()
-    err79 := argvalue0.Read(context.Background(), jsProt78)
-    if err79 != nil {
+    err82 := argvalue0.Read(context.Background(), jsProt81)
+    if err82 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg80 := flag.Arg(2)
-    mbTrans81 := thrift.NewTMemoryBufferLen(len(arg80))
-    defer mbTrans81.Close()
-    _, err82 := mbTrans81.WriteString(arg80)
-    if err82 != nil {
+    arg83 := flag.Arg(2)
+    mbTrans84 := thrift.NewTMemoryBufferLen(len(arg83))
+    defer mbTrans84.Close()
+    _, err85 := mbTrans84.WriteString(arg83)
+    if err85 != nil {
       Usage()
       return
     }
-    factory83 := thrift.NewTJSONProtocolFactory()
-    jsProt84 := factory83.GetProtocol(mbTrans81)
+    factory86 := thrift.NewTJSONProtocolFactory()
+    jsProt87 := factory86.GetProtocol(mbTrans84)
     argvalue1 := rpc.NewISource()
-    err85 := argvalue1.Read(context.Background(), jsProt84)
-    if err85 != nil {
+    err88 := argvalue1.Read(context.Background(), jsProt87)
+    if err88 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    arg86 := flag.Arg(3)
-    mbTrans87 := thrift.NewTMemoryBufferLen(len(arg86))
-    defer mbTrans87.Close()
-    _, err88 := mbTrans87.WriteString(arg86)
-    if err88 != nil {
+    arg89 := flag.Arg(3)
+    mbTrans90 := thrift.NewTMemoryBufferLen(len(arg89))
+    defer mbTrans90.Close()
+    _, err91 := mbTrans90.WriteString(arg89)
+    if err91 != nil {
       Usage()
       return
     }
-    factory89 := thrift.NewTJSONProtocolFactory()
-    jsProt90 := factory89.GetProtocol(mbTrans87)
+    factory92 := thrift.NewTJSONProtocolFactory()
+    jsProt93 := factory92.GetProtocol(mbTrans90)
     argvalue2 := rpc.NewISource()
-    err91 := argvalue2.Read(context.Background(), jsProt90)
-    if err91 != nil {
+    err94 := argvalue2.Read(context.Background(), jsProt93)
+    if err94 != nil {
       Usage()
       return
     }
@@ -311,53 +312,53 @@ func main() {
       fmt.Fprintln(os.Stderr, "TreeAggregate requires 3 args")
       flag.Usage()
     }
-    arg92 := flag.Arg(1)
-    mbTrans93 := thrift.NewTMemoryBufferLen(len(arg92))
-    defer mbTrans93.Close()
-    _, err94 := mbTrans93.WriteString(arg92)
-    if err94 != nil {
+    arg95 := flag.Arg(1)
+    mbTrans96 := thrift.NewTMemoryBufferLen(len(arg95))
+    defer mbTrans96.Close()
+    _, err97 := mbTrans96.WriteString(arg95)
+    if err97 != nil {
       Usage()
       return
     }
-    factory95 := thrift.NewTJSONProtocolFactory()
-    jsProt96 := factory95.GetProtocol(mbTrans93)
+    factory98 := thrift.NewTJSONProtocolFactory()
+    jsProt99 := factory98.GetProtocol(mbTrans96)
     argvalue0 := rpc.NewISource()
-    err97 := argvalue0.Read(context.Background(), jsProt96)
-    if err97 != nil {
+    err100 := argvalue0.Read(context.Background(), jsProt99)
+    if err100 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg98 := flag.Arg(2)
-    mbTrans99 := thrift.NewTMemoryBufferLen(len(arg98))
-    defer mbTrans99.Close()
-    _, err100 := mbTrans99.WriteString(arg98)
-    if err100 != nil {
+    arg101 := flag.Arg(2)
+    mbTrans102 := thrift.NewTMemoryBufferLen(len(arg101))
+    defer mbTrans102.Close()
+    _, err103 := mbTrans102.WriteString(arg101)
+    if err103 != nil {
       Usage()
       return
     }
-    factory101 := thrift.NewTJSONProtocolFactory()
-    jsProt102 := factory101.GetProtocol(mbTrans99)
+    factory104 := thrift.NewTJSONProtocolFactory()
+    jsProt105 := factory104.GetProtocol(mbTrans102)
     argvalue1 := rpc.NewISource()
-    err103 := argvalue1.Read(context.Background(), jsProt102)
-    if err103 != nil {
+    err106 := argvalue1.Read(context.Background(), jsProt105)
+    if err106 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    arg104 := flag.Arg(3)
-    mbTrans105 := thrift.NewTMemoryBufferLen(len(arg104))
-    defer mbTrans105.Close()
-    _, err106 := mbTrans105.WriteString(arg104)
-    if err106 != nil {
+    arg107 := flag.Arg(3)
+    mbTrans108 := thrift.NewTMemoryBufferLen(len(arg107))
+    defer mbTrans108.Close()
+    _, err109 := mbTrans108.WriteString(arg107)
+    if err109 != nil {
       Usage()
       return
     }
-    factory107 := thrift.NewTJSONProtocolFactory()
-    jsProt108 := factory107.GetProtocol(mbTrans105)
+    factory110 := thrift.NewTJSONProtocolFactory()
+    jsProt111 := factory110.GetProtocol(mbTrans108)
     argvalue2 := rpc.NewISource()
-    err109 := argvalue2.Read(context.Background(), jsProt108)
-    if err109 != nil {
+    err112 := argvalue2.Read(context.Background(), jsProt111)
+    if err112 != nil {
       Usage()
       return
     }
@@ -370,36 +371,36 @@ func main() {
       fmt.Fprintln(os.Stderr, "Fold requires 2 args")
       flag.Usage()
     }
-    arg110 := flag.Arg(1)
-    mbTrans111 := thrift.NewTMemoryBufferLen(len(arg110))
-    defer mbTrans111.Close()
-    _, err112 := mbTrans111.WriteString(arg110)
-    if err112 != nil {
+    arg113 := flag.Arg(1)
+    mbTrans114 := thrift.NewTMemoryBufferLen(len(arg113))
+    defer mbTrans114.Close()
+    _, err115 := mbTrans114.WriteString(arg113)
+    if err115 != nil {
       Usage()
       return
     }
-    factory113 := thrift.NewTJSONProtocolFactory()
-    jsProt114 := factory113.GetProtocol(mbTrans111)
+    factory116 := thrift.NewTJSONProtocolFactory()
+    jsProt117 := factory116.GetProtocol(mbTrans114)
     argvalue0 := rpc.NewISource()
-    err115 := argvalue0.Read(context.Background(), jsProt114)
-    if err115 != nil {
+    err118 := argvalue0.Read(context.Background(), jsProt117)
+    if err118 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg116 := flag.Arg(2)
-    mbTrans117 := thrift.NewTMemoryBufferLen(len(arg116))
-    defer mbTrans117.Close()
-    _, err118 := mbTrans117.WriteString(arg116)
-    if err118 != nil {
+    arg119 := flag.Arg(2)
+    mbTrans120 := thrift.NewTMemoryBufferLen(len(arg119))
+    defer mbTrans120.Close()
+    _, err121 := mbTrans120.WriteString(arg119)
+    if err121 != nil {
       Usage()
       return
     }
-    factory119 := thrift.NewTJSONProtocolFactory()
-    jsProt120 := factory119.GetProtocol(mbTrans117)
+    factory122 := thrift.NewTJSONProtocolFactory()
+    jsProt123 := factory122.GetProtocol(mbTrans120)
     argvalue1 := rpc.NewISource()
-    err121 := argvalue1.Read(context.Background(), jsProt120)
-    if err121 != nil {
+    err124 := argvalue1.Read(context.Background(), jsProt123)
+    if err124 != nil {
       Usage()
       return
     }
@@ -412,36 +413,36 @@ func main() {
       fmt.Fprintln(os.Stderr, "TreeFold requires 2 args")
       flag.Usage()
     }
-    arg122 := flag.Arg(1)
-    mbTrans123 := thrift.NewTMemoryBufferLen(len(arg122))
-    defer mbTrans123.Close()
-    _, err124 := mbTrans123.WriteString(arg122)
-    if err124 != nil {
+    arg125 := flag.Arg(1)
+    mbTrans126 := thrift.NewTMemoryBufferLen(len(arg125))
+    defer mbTrans126.Close()
+    _, err127 := mbTrans126.WriteString(arg125)
+    if err127 != nil {
       Usage()
       return
     }
-    factory125 := thrift.NewTJSONProtocolFactory()
-    jsProt126 := factory125.GetProtocol(mbTrans123)
+    factory128 := thrift.NewTJSONProtocolFactory()
+    jsProt129 := factory128.GetProtocol(mbTrans126)
     argvalue0 := rpc.NewISource()
-    err127 := argvalue0.Read(context.Background(), jsProt126)
-    if err127 != nil {
+    err130 := argvalue0.Read(context.Background(), jsProt129)
+    if err130 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg128 := flag.Arg(2)
-    mbTrans129 := thrift.NewTMemoryBufferLen(len(arg128))
-    defer mbTrans129.Close()
-    _, err130 := mbTrans129.WriteString(arg128)
-    if err130 != nil {
+    arg131 := flag.Arg(2)
+    mbTrans132 := thrift.NewTMemoryBufferLen(len(arg131))
+    defer mbTrans132.Close()
+    _, err133 := mbTrans132.WriteString(arg131)
+    if err133 != nil {
       Usage()
       return
     }
-    factory131 := thrift.NewTJSONProtocolFactory()
-    jsProt132 := factory131.GetProtocol(mbTrans129)
+    factory134 := thrift.NewTJSONProtocolFactory()
+    jsProt135 := factory134.GetProtocol(mbTrans132)
     argvalue1 := rpc.NewISource()
-    err133 := argvalue1.Read(context.Background(), jsProt132)
-    if err133 != nil {
+    err136 := argvalue1.Read(context.Background(), jsProt135)
+    if err136 != nil {
       Usage()
       return
     }
@@ -454,8 +455,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "Take requires 1 args")
       flag.Usage()
     }
-    argvalue0, err134 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err134 != nil {
+    argvalue0, err137 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err137 != nil {
       Usage()
       return
     }
@@ -468,19 +469,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Foreach_ requires 1 args")
       flag.Usage()
     }
-    arg135 := flag.Arg(1)
-    mbTrans136 := thrift.NewTMemoryBufferLen(len(arg135))
-    defer mbTrans136.Close()
-    _, err137 := mbTrans136.WriteString(arg135)
-    if err137 != nil {
+    arg138 := flag.Arg(1)
+    mbTrans139 := thrift.NewTMemoryBufferLen(len(arg138))
+    defer mbTrans139.Close()
+    _, err140 := mbTrans139.WriteString(arg138)
+    if err140 != nil {
       Usage()
       return
     }
-    factory138 := thrift.NewTJSONProtocolFactory()
-    jsProt139 := factory138.GetProtocol(mbTrans136)
+    factory141 := thrift.NewTJSONProtocolFactory()
+    jsProt142 := factory141.GetProtocol(mbTrans139)
     argvalue0 := rpc.NewISource()
-    err140 := argvalue0.Read(context.Background(), jsProt139)
-    if err140 != nil {
+    err143 := argvalue0.Read(context.Background(), jsProt142)
+    if err143 != nil {
       Usage()
       return
     }
@@ -493,19 +494,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "ForeachPartition requires 1 args")
       flag.Usage()
     }
-    arg141 := flag.Arg(1)
-    mbTrans142 := thrift.NewTMemoryBufferLen(len(arg141))
-    defer mbTrans142.Close()
-    _, err143 := mbTrans142.WriteString(arg141)
-    if err143 != nil {
+    arg144 := flag.Arg(1)
+    mbTrans145 := thrift.NewTMemoryBufferLen(len(arg144))
+    defer mbTrans145.Close()
+    _, err146 := mbTrans145.WriteString(arg144)
+    if err146 != nil {
       Usage()
       return
     }
-    factory144 := thrift.NewTJSONProtocolFactory()
-    jsProt145 := factory144.GetProtocol(mbTrans142)
+    factory147 := thrift.NewTJSONProtocolFactory()
+    jsProt148 := factory147.GetProtocol(mbTrans145)
     argvalue0 := rpc.NewISource()
-    err146 := argvalue0.Read(context.Background(), jsProt145)
-    if err146 != nil {
+    err149 := argvalue0.Read(context.Background(), jsProt148)
+    if err149 != nil {
       Usage()
       return
     }
@@ -518,19 +519,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "ForeachExecutor requires 1 args")
       flag.Usage()
     }
-    arg147 := flag.Arg(1)
-    mbTrans148 := thrift.NewTMemoryBufferLen(len(arg147))
-    defer mbTrans148.Close()
-    _, err149 := mbTrans148.WriteString(arg147)
-    if err149 != nil {
+    arg150 := flag.Arg(1)
+    mbTrans151 := thrift.NewTMemoryBufferLen(len(arg150))
+    defer mbTrans151.Close()
+    _, err152 := mbTrans151.WriteString(arg150)
+    if err152 != nil {
       Usage()
       return
     }
-    factory150 := thrift.NewTJSONProtocolFactory()
-    jsProt151 := factory150.GetProtocol(mbTrans148)
+    factory153 := thrift.NewTJSONProtocolFactory()
+    jsProt154 := factory153.GetProtocol(mbTrans151)
     argvalue0 := rpc.NewISource()
-    err152 := argvalue0.Read(context.Background(), jsProt151)
-    if err152 != nil {
+    err155 := argvalue0.Read(context.Background(), jsProt154)
+    if err155 != nil {
       Usage()
       return
     }
@@ -543,8 +544,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "Top requires 1 args")
       flag.Usage()
     }
-    argvalue0, err153 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err153 != nil {
+    argvalue0, err156 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err156 != nil {
       Usage()
       return
     }
@@ -557,25 +558,25 @@ func main() {
       fmt.Fprintln(os.Stderr, "Top2 requires 2 args")
       flag.Usage()
     }
-    argvalue0, err154 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err154 != nil {
+    argvalue0, err157 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err157 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg155 := flag.Arg(2)
-    mbTrans156 := thrift.NewTMemoryBufferLen(len(arg155))
-    defer mbTrans156.Close()
-    _, err157 := mbTrans156.WriteString(arg155)
-    if err157 != nil {
+    arg158 := flag.Arg(2)
+    mbTrans159 := thrift.NewTMemoryBufferLen(len(arg158))
+    defer mbTrans159.Close()
+    _, err160 := mbTrans159.WriteString(arg158)
+    if err160 != nil {
       Usage()
       return
     }
-    factory158 := thrift.NewTJSONProtocolFactory()
-    jsProt159 := factory158.GetProtocol(mbTrans156)
+    factory161 := thrift.NewTJSONProtocolFactory()
+    jsProt162 := factory161.GetProtocol(mbTrans159)
     argvalue1 := rpc.NewISource()
-    err160 := argvalue1.Read(context.Background(), jsProt159)
-    if err160 != nil {
+    err163 := argvalue1.Read(context.Background(), jsProt162)
+    if err163 != nil {
       Usage()
       return
     }
@@ -588,8 +589,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "TakeOrdered requires 1 args")
       flag.Usage()
     }
-    argvalue0, err161 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err161 != nil {
+    argvalue0, err164 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err164 != nil {
       Usage()
       return
     }
@@ -602,25 +603,25 @@ func main() {
       fmt.Fprintln(os.Stderr, "TakeOrdered2 requires 2 args")
       flag.Usage()
     }
-    argvalue0, err162 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err162 != nil {
+    argvalue0, err165 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err165 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg163 := flag.Arg(2)
-    mbTrans164 := thrift.NewTMemoryBufferLen(len(arg163))
-    defer mbTrans164.Close()
-    _, err165 := mbTrans164.WriteString(arg163)
-    if err165 != nil {
+    arg166 := flag.Arg(2)
+    mbTrans167 := thrift.NewTMemoryBufferLen(len(arg166))
+    defer mbTrans167.Close()
+    _, err168 := mbTrans167.WriteString(arg166)
+    if err168 != nil {
       Usage()
       return
     }
-    factory166 := thrift.NewTJSONProtocolFactory()
-    jsProt167 := factory166.GetProtocol(mbTrans164)
+    factory169 := thrift.NewTJSONProtocolFactory()
+    jsProt170 := factory169.GetProtocol(mbTrans167)
     argvalue1 := rpc.NewISource()
-    err168 := argvalue1.Read(context.Background(), jsProt167)
-    if err168 != nil {
+    err171 := argvalue1.Read(context.Background(), jsProt170)
+    if err171 != nil {
       Usage()
       return
     }
@@ -644,6 +645,31 @@ func main() {
     fmt.Print(client.Values(context.Background()))
     fmt.Print("\n")
     break
+  case "reduceByKeyLocally":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "ReduceByKeyLocally requires 1 args")
+      flag.Usage()
+    }
+    arg172 := flag.Arg(1)
+    mbTrans173 := thrift.NewTMemoryBufferLen(len(arg172))
+    defer mbTrans173.Close()
+    _, err174 := mbTrans173.WriteString(arg172)
+    if err174 != nil {
+      Usage()
+      return
+    }
+    factory175 := thrift.NewTJSONProtocolFactory()
+    jsProt176 := factory175.GetProtocol(mbTrans173)
+    argvalue0 := rpc.NewISource()
+    err177 := argvalue0.Read(context.Background(), jsProt176)
+    if err177 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.ReduceByKeyLocally(context.Background(), value0))
+    fmt.Print("\n")
+    break
   case "":
     Usage()
     break
@@ -32,8 +32,14 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void mapWithIndex(ISource src)")
   fmt.Fprintln(os.Stderr, "  void mapPartitions(ISource src)")
   fmt.Fprintln(os.Stderr, "  void mapPartitionsWithIndex(ISource src)")
+  fmt.Fprintln(os.Stderr, "  void mapPartitionsWithBoundary(ISource src, i64 k)")
   fmt.Fprintln(os.Stderr, "  void mapExecutor(ISource src)")
   fmt.Fprintln(os.Stderr, "  void mapExecutorTo(ISource src)")
+  fmt.Fprintln(os.Stderr, "  void pipeCmd( command,  env, string encoding)")
+  fmt.Fprintln(os.Stderr, "  void select( paths)")
+  fmt.Fprintln(os.Stderr, "  void selectTo(ISource src,  paths)")
+  fmt.Fprintln(os.Stderr, "  void explode(ISource src, string path)")
+  fmt.Fprintln(os.Stderr, "  void explodeSelect(ISource src, string path,  paths)")
   fmt.Fprintln(os.Stderr, "  void groupBy(ISource src, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void sort(bool ascending)")
   fmt.Fprintln(os.Stderr, "  void sort2(bool ascending, i64 numPartitions)")
@@ -41,14 +47,31 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void sortBy3(ISource src, bool ascending, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void union_(string other, bool preserveOrder)")
   fmt.Fprintln(os.Stderr, "  void union2(string other, bool preserveOrder, ISource src)")
+  fmt.Fprintln(os.Stderr, "  void unionAll( others, bool rebalance)")
+  fmt.Fprintln(os.Stderr, "  void zip(string other, ISource src)")
+  fmt.Fprintln(os.Stderr, "  void zipWithIndex()")
   fmt.Fprintln(os.Stderr, "  void join(string other, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void join3(string other, i64 numPartitions, ISource src)")
+  fmt.Fprintln(os.Stderr, "  void leftOuterJoin(string other, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void rightOuterJoin(string other, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void fullOuterJoin(string other, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void coGroup(string other, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void distinct(i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void distinct2(i64 numPartitions, ISource src)")
+  fmt.Fprintln(os.Stderr, "  void intersection(string other, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void subtract(string other, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void subtractByKey(string other, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void repartition(i64 numPartitions, bool preserveOrdering, bool global_)")
+  fmt.Fprintln(os.Stderr, "  void coalesce(i64 numPartitions, bool shuffle)")
   fmt.Fprintln(os.Stderr, "  void partitionByRandom(i64 numPartitions, i32 seed)")
   fmt.Fprintln(os.Stderr, "  void partitionByHash(i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void partitionBy(ISource src, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void partitionByKeyHash(i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void partitionByKeyRange(i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void partitionByKey(ISource src, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void reorderPartitions( order)")
+  fmt.Fprintln(os.Stderr, "  void reorderPartitionsBy()")
+  fmt.Fprintln(os.Stderr, "  i64 partitionOffset()")
   fmt.Fprintln(os.Stderr, "  void flatMapValues(ISource src)")
   fmt.Fprintln(os.Stderr, "  void mapValues(ISource src)")
   fmt.Fprintln(os.Stderr, "  void groupByKey(i64 numPartitions)")
@@ -57,10 +80,19 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void aggregateByKey(ISource zero, ISource seqOp, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void aggregateByKey4(ISource zero, ISource seqOp, ISource combOp, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void foldByKey(ISource zero, ISource src, i64 numPartitions, bool localFold)")
+  fmt.Fprintln(os.Stderr, "  string pivot(ISource key, ISource column, ISource agg, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void unpivot(ISource src, string columns)")
+  fmt.Fprintln(os.Stderr, "  void scan(ISource zero, ISource src)")
   fmt.Fprintln(os.Stderr, "  void sortByKey(bool ascending)")
   fmt.Fprintln(os.Stderr, "  void sortByKey2a(bool ascending, i64 numPartitions)")
   fmt.Fprintln(os.Stderr, "  void sortByKey2b(ISource src, bool ascending)")
   fmt.Fprintln(os.Stderr, "  void sortByKey3(ISource src, bool ascending, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  void repartitionAndSortWithinPartitions(i64 numPartitions, bool ascending)")
+  fmt.Fprintln(os.Stderr, "  void groupByKeyAndSortValues(i64 numPartitions, bool ascending)")
+  fmt.Fprintln(os.Stderr, "  void groupByKeyAndSortValues3(ISource src, i64 numPartitions, bool ascending)")
+  fmt.Fprintln(os.Stderr, "  void gapsAndIslands(ISource key, ISource seq, i64 maxGap, i64 numPartitions)")
+  fmt.Fprintln(os.Stderr, "  i64 recomputePartitions()")
+  fmt.Fprintln(os.Stderr, "  string partitionStats()")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
 }
@@ -188,19 +220,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "ExecuteTo requires 1 args")
       flag.Usage()
     }
-    arg113 := flag.Arg(1)
-    mbTrans114 := thrift.NewTMemoryBufferLen(len(arg113))
-    defer mbTrans114.Close()
-    _, err115 := mbTrans114.WriteString(arg113)
-    if err115 != nil {
+    arg216 := flag.Arg(1)
+    mbTrans217 := thrift.NewTMemoryBufferLen(len(arg216))
+    defer mbTrans217.Close()
+    _, err218 := mbTrans217.WriteString(arg216)
+    if err218 != nil {
       Usage()
       return
     }
-    factory116 := thrift.NewTJSONProtocolFactory()
-    jsProt117 := factory116.GetProtocol(mbTrans114)
+    factory219 := thrift.NewTJSONProtocolFactory()
+    jsProt220 := factory219.GetProtocol(mbTrans217)
     argvalue0 := rpc.NewISource()
-    err118 := argvalue0.Read(context.Background(), jsProt117)
-    if err118 != nil {
+    err221 := argvalue0.Read(context.Background(), jsProt220)
+    if err221 != nil {
       Usage()
       return
     }
@@ -213,19 +245,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Map_ requires 1 args")
       flag.Usage()
     }
-    arg119 := flag.Arg(1)
-    mbTrans120 := thrift.NewTMemoryBufferLen(len(arg119))
-    defer mbTrans120.Close()
-    _, err121 := mbTrans120.WriteString(arg119)
-    if err121 != nil {
+    arg222 := flag.Arg(1)
+    mbTrans223 := thrift.NewTMemoryBufferLen(len(arg222))
+    defer mbTrans223.Close()
+    _, err224 := mbTrans223.WriteString(arg222)
+    if err224 != nil {
       Usage()
       return
     }
-    factory122 := thrift.NewTJSONProtocolFactory()
-    jsProt123 := factory122.GetProtocol(mbTrans120)
+    factory225 := thrift.NewTJSONProtocolFactory()
+    jsProt226 := factory225.GetProtocol(mbTrans223)
     argvalue0 := rpc.NewISource()
-    err124 := argvalue0.Read(context.Background(), jsProt123)
-    if err124 != nil {
+    err227 := argvalue0.Read(context.Background(), jsProt226)
+    if err227 != nil {
       Usage()
       return
     }
@@ -238,19 +270,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Filter requires 1 args")
       flag.Usage()
     }
-    arg125 := flag.Arg(1)
-    mbTrans126 := thrift.NewTMemoryBufferLen(len(arg125))
-    defer mbTrans126.Close()
-    _, err127 := mbTrans126.WriteString(arg125)
-    if err127 != nil {
+    arg228 := flag.Arg(1)
+    mbTrans229 := thrift.NewTMemoryBufferLen(len(arg228))
+    defer mbTrans229.Close()
+    _, err230 := mbTrans229.WriteString(arg228)
+    if err230 != nil {
       Usage()
       return
     }
-    factory128 := thrift.NewTJSONProtocolFactory()
-    jsProt129 := factory128.GetProtocol(mbTrans126)
+    factory231 := thrift.NewTJSONProtocolFactory()
+    jsProt232 := factory231.GetProtocol(mbTrans229)
     argvalue0 := rpc.NewISource()
-    err130 := argvalue0.Read(context.Background(), jsProt129)
-    if err130 != nil {
+    err233 := argvalue0.Read(context.Background(), jsProt232)
+    if err233 != nil {
       Usage()
       return
     }
@@ -263,19 +295,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Flatmap requires 1 args")
       flag.Usage()
     }
-    arg131 := flag.Arg(1)
-    mbTrans132 := thrift.NewTMemoryBufferLen(len(arg131))
-    defer mbTrans132.Close()
-    _, err133 := mbTrans132.WriteString(arg131)
-    if err133 != nil {
+    arg234 := flag.Arg(1)
+    mbTrans235 := thrift.NewTMemoryBufferLen(len(arg234))
+    defer mbTrans235.Close()
+    _, err236 := mbTrans235.WriteString(arg234)
+    if err236 != nil {
       Usage()
       return
     }
-    factory134 := thrift.NewTJSONProtocolFactory()
-    jsProt135 := factory134.GetProtocol(mbTrans132)
+    factory237 := thrift.NewTJSONProtocolFactory()
+    jsProt238 := factory237.GetProtocol(mbTrans235)
     argvalue0 := rpc.NewISource()
-    err136 := argvalue0.Read(context.Background(), jsProt135)
-    if err136 != nil {
+    err239 := argvalue0.Read(context.Background(), jsProt238)
+    if err239 != nil {
       Usage()
       return
     }
@@ -288,19 +320,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "KeyBy requires 1 args")
       flag.Usage()
     }
-    arg137 := flag.Arg(1)
-    mbTrans138 := thrift.NewTMemoryBufferLen(len(arg137))
-    defer mbTrans138.Close()
-    _, err139 := mbTrans138.WriteString(arg137)
-    if err139 != nil {
+    arg240 := flag.Arg(1)
+    mbTrans241 := thrift.NewTMemoryBufferLen(len(arg240))
+    defer mbTrans241.Close()
+    _, err242 := mbTrans241.WriteString(arg240)
+    if err242 != nil {
       Usage()
       return
     }
-    factory140 := thrift.NewTJSONProtocolFactory()
-    jsProt141 := factory140.GetProtocol(mbTrans138)
+    factory243 := thrift.NewTJSONProtocolFactory()
+    jsProt244 := factory243.GetProtocol(mbTrans241)
     argvalue0 := rpc.NewISource()
-    err142 := argvalue0.Read(context.Background(), jsProt141)
-    if err142 != nil {
+    err245 := argvalue0.Read(context.Background(), jsProt244)
+    if err245 != nil {
       Usage()
       return
     }
@@ -313,19 +345,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "MapWithIndex requires 1 args")
       flag.Usage()
     }
-    arg143 := flag.Arg(1)
-    mbTrans144 := thrift.NewTMemoryBufferLen(len(arg143))
-    defer mbTrans144.Close()
-    _, err145 := mbTrans144.WriteString(arg143)
-    if err145 != nil {
+    arg246 := flag.Arg(1)
+    mbTrans247 := thrift.NewTMemoryBufferLen(len(arg246))
+    defer mbTrans247.Close()
+    _, err248 := mbTrans247.WriteString(arg246)
+    if err248 != nil {
       Usage()
       return
     }
-    factory146 := thrift.NewTJSONProtocolFactory()
-    jsProt147 := factory146.GetProtocol(mbTrans144)
+    factory249 := thrift.NewTJSONProtocolFactory()
+    jsProt250 := factory249.GetProtocol(mbTrans247)
     argvalue0 := rpc.NewISource()
-    err148 := argvalue0.Read(context.Background(), jsProt147)
-    if err148 != nil {
+    err251 := argvalue0.Read(context.Background(), jsProt250)
+    if err251 != nil {
       Usage()
       return
     }
@@ -338,19 +370,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "MapPartitions requires 1 args")
       flag.Usage()
     }
-    arg149 := flag.Arg(1)
-    mbTrans150 := thrift.NewTMemoryBufferLen(len(arg149))
-    defer mbTrans150.Close()
-    _, err151 := mbTrans150.WriteString(arg149)
-    if err151 != nil {
+    arg252 := flag.Arg(1)
+    mbTrans253 := thrift.NewTMemoryBufferLen(len(arg252))
+    defer mbTrans253.Close()
+    _, err254 := mbTrans253.WriteString(arg252)
+    if err254 != nil {
       Usage()
       return
     }
-    factory152 := thrift.NewTJSONProtocolFactory()
-    jsProt153 := factory152.GetProtocol(mbTrans150)
+    factory255 := thrift.NewTJSONProtocolFactory()
+    jsProt256 := factory255.GetProtocol(mbTrans253)
     argvalue0 := rpc.NewISource()
-    err154 := argvalue0.Read(context.Background(), jsProt153)
-    if err154 != nil {
+    err257 := argvalue0.Read(context.Background(), jsProt256)
+    if err257 != nil {
       Usage()
       return
     }
@@ -363,19 +395,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "MapPartitionsWithIndex requires 1 args")
       flag.Usage()
     }
-    arg155 := flag.Arg(1)
-    mbTrans156 := thrift.NewTMemoryBufferLen(len(arg155))
-    defer mbTrans156.Close()
-    _, err157 := mbTrans156.WriteString(arg155)
-    if err157 != nil {
+    arg258 := flag.Arg(1)
+    mbTrans259 := thrift.NewTMemoryBufferLen(len(arg258))
+    defer mbTrans259.Close()
+    _, err260 := mbTrans259.WriteString(arg258)
+    if err260 != nil {
       Usage()
       return
     }
-    factory158 := thrift.NewTJSONProtocolFactory()
-    jsProt159 := factory158.GetProtocol(mbTrans156)
+    factory261 := thrift.NewTJSONProtocolFactory()
+    jsProt262 := factory261.GetProtocol(mbTrans259)
     argvalue0 := rpc.NewISource()
-    err160 := argvalue0.Read(context.Background(), jsProt159)
-    if err160 != nil {
+    err263 := argvalue0.Read(context.Background(), jsProt262)
+    if err263 != nil {
       Usage()
       return
     }
@@ -383,24 +415,55 @@ func main() {
     fmt.Print(client.MapPartitionsWithIndex(context.Background(), value0))
     fmt.Print("\n")
     break
+  case "mapPartitionsWithBoundary":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "MapPartitionsWithBoundary requires 2 args")
+      flag.Usage()
+    }
+    arg264 := flag.Arg(1)
+    mbTrans265 := thrift.NewTMemoryBufferLen(len(arg264))
+    defer mbTrans265.Close()
+    _, err266 := mbTrans265.WriteString(arg264)
+    if err266 != nil {
+      Usage()
+      return
+    }
+    factory267 := thrift.NewTJSONProtocolFactory()
+    jsProt268 := factory267.GetProtocol(mbTrans265)
+    argvalue0 := rpc.NewISource()
+    err269 := argvalue0.Read(context.Background(), jsProt268)
+    if err269 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1, err270 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err270 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.MapPartitionsWithBoundary(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
   case "mapExecutor":
     if flag.NArg() - 1 != 1 {
       fmt.Fprintln(os.Stderr, "MapExecutor requires 1 args")
       flag.Usage()
     }
-    arg161 := flag.Arg(1)
-    mbTrans162 := thrift.NewTMemoryBufferLen(len(arg161))
-    defer mbTrans162.Close()
-    _, err163 := mbTrans162.WriteString(arg161)
-    if err163 != nil {
+    arg271 := flag.Arg(1)
+    mbTrans272 := thrift.NewTMemoryBufferLen(len(arg271))
+    defer mbTrans272.Close()
+    _, err273 := mbTrans272.WriteString(arg271)
+    if err273 != nil {
       Usage()
       return
     }
-    factory164 := thrift.NewTJSONProtocolFactory()
-    jsProt165 := factory164.GetProtocol(mbTrans162)
+    factory274 := thrift.NewTJSONProtocolFactory()
+    jsProt275 := factory274.GetProtocol(mbTrans272)
     argvalue0 := rpc.NewISource()
-    err166 := argvalue0.Read(context.Background(), jsProt165)
-    if err166 != nil {
+    err276 := argvalue0.Read(context.Background(), jsProt275)
+    if err276 != nil {
       Usage()
       return
     }
@@ -413,19 +476,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "MapExecutorTo requires 1 args")
       flag.Usage()
     }
-    arg167 := flag.Arg(1)
-    mbTrans168 := thrift.NewTMemoryBufferLen(len(arg167))
-    defer mbTrans168.Close()
-    _, err169 := mbTrans168.WriteString(arg167)
-    if err169 != nil {
+    arg277 := flag.Arg(1)
+    mbTrans278 := thrift.NewTMemoryBufferLen(len(arg277))
+    defer mbTrans278.Close()
+    _, err279 := mbTrans278.WriteString(arg277)
+    if err279 != nil {
       Usage()
       return
     }
-    factory170 := thrift.NewTJSONProtocolFactory()
-    jsProt171 := factory170.GetProtocol(mbTrans168)
+    factory280 := thrift.NewTJSONProtocolFactory()
+    jsProt281 := factory280.GetProtocol(mbTrans278)
     argvalue0 := rpc.NewISource()
-    err172 := argvalue0.Read(context.Background(), jsProt171)
-    if err172 != nil {
+    err282 := argvalue0.Read(context.Background(), jsProt281)
+    if err282 != nil {
       Usage()
       return
     }
@@ -433,30 +496,217 @@ func main() {
     fmt.Print(client.MapExecutorTo(context.Background(), value0))
     fmt.Print("\n")
     break
+  case "pipeCmd":
+    if flag.NArg() - 1 != 3 {
+      fmt.Fprintln(os.Stderr, "PipeCmd requires 3 args")
+      flag.Usage()
+    }
+    arg283 := flag.Arg(1)
+    mbTrans284 := thrift.NewTMemoryBufferLen(len(arg283))
+    defer mbTrans284.Close()
+    _, err285 := mbTrans284.WriteString(arg283)
+    if err285 != nil { 
+      Usage()
+      return
+    }
+    factory286 := thrift.NewTJSONProtocolFactory()
+    jsProt287 := factory286.GetProtocol(mbTrans284)
+    containerStruct0 := executor.NewIGeneralModulePipeCmdArgs()
+    err288 := containerStruct0.ReadField1(context.Background(), jsProt287)
+    if err288 != nil {
+      Usage()
+      return
+    }
+    argvalue0 := containerStruct0.Command
+    value0 := argvalue0
+    arg289 := flag.Arg(2)
+    mbTrans290 := thrift.NewTMemoryBufferLen(len(arg289))
+    defer mbTrans290.Close()
+    _, err291 := mbTrans290.WriteString(arg289)
+    if err291 != nil { 
+      Usage()
+      return
+    }
+    factory292 := thrift.NewTJSONProtocolFactory()
+    jsProt293 := factory292.GetProtocol(mbTrans290)
+    containerStruct1 := executor.NewIGeneralModulePipeCmdArgs()
+    err294 := containerStruct1.ReadField2(context.Background(), jsProt293)
+    if err294 != nil {
+      Usage()
+      return
+    }
+    argvalue1 := containerStruct1.Env
+    value1 := argvalue1
+    argvalue2 := flag.Arg(3)
+    value2 := argvalue2
+    fmt.Print(client.PipeCmd(context.Background(), value0, value1, value2))
+    fmt.Print("\n")
+    break
+  case "select":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "Select requires 1 args")
+      flag.Usage()
+    }
+    arg296 := flag.Arg(1)
+    mbTrans297 := thrift.NewTMemoryBufferLen(len(arg296))
+    defer mbTrans297.Close()
+    _, err298 := mbTrans297.WriteString(arg296)
+    if err298 != nil { 
+      Usage()
+      return
+    }
+    factory299 := thrift.NewTJSONProtocolFactory()
+    jsProt300 := factory299.GetProtocol(mbTrans297)
+    containerStruct0 := executor.NewIGeneralModuleSelectArgs()
+    err301 := containerStruct0.ReadField1(context.Background(), jsProt300)
+    if err301 != nil {
+      Usage()
+      return
+    }
+    argvalue0 := containerStruct0.Paths
+    value0 := argvalue0
+    fmt.Print(client.Select(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "selectTo":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "SelectTo requires 2 args")
+      flag.Usage()
+    }
+    arg302 := flag.Arg(1)
+    mbTrans303 := thrift.NewTMemoryBufferLen(len(arg302))
+    defer mbTrans303.Close()
+    _, err304 := mbTrans303.WriteString(arg302)
+    if err304 != nil {
+      Usage()
+      return
+    }
+    factory305 := thrift.NewTJSONProtocolFactory()
+    jsProt306 := factory305.GetProtocol(mbTrans303)
+    argvalue0 := rpc.NewISource()
+    err307 := argvalue0.Read(context.Background(), jsProt306)
+    if err307 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    arg308 := flag.Arg(2)
+    mbTrans309 := thrift.NewTMemoryBufferLen(len(arg308))
+    defer mbTrans309.Close()
+    _, err310 := mbTrans309.WriteString(arg308)
+    if err310 != nil { 
+      Usage()
+      return
+    }
+    factory311 := thrift.NewTJSONProtocolFactory()
+    jsProt312 := factory311.GetProtocol(mbTrans309)
+    containerStruct1 := executor.NewIGeneralModuleSelectToArgs()
+    err313 := containerStruct1.ReadField2(context.Background(), jsProt312)
+    if err313 != nil {
+      Usage()
+      return
+    }
+    argvalue1 := containerStruct1.Paths
+    value1 := argvalue1
+    fmt.Print(client.SelectTo(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "explode":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Explode requires 2 args")
+      flag.Usage()
+    }
+    arg314 := flag.Arg(1)
+    mbTrans315 := thrift.NewTMemoryBufferLen(len(arg314))
+    defer mbTrans315.Close()
+    _, err316 := mbTrans315.WriteString(arg314)
+    if err316 != nil {
+      Usage()
+      return
+    }
+    factory317 := thrift.NewTJSONProtocolFactory()
+    jsProt318 := factory317.GetProtocol(mbTrans315)
+    argvalue0 := rpc.NewISource()
+    err319 := argvalue0.Read(context.Background(), jsProt318)
+    if err319 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2)
+    value1 := argvalue1
+    fmt.Print(client.Explode(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "explodeSelect":
+    if flag.NArg() - 1 != 3 {
+      fmt.Fprintln(os.Stderr, "ExplodeSelect requires 3 args")
+      flag.Usage()
+    }
+    arg321 := flag.Arg(1)
+    mbTrans322 := thrift.NewTMemoryBufferLen(len(arg321))
+    defer mbTrans322.Close()
+    _, err323 := mbTrans322.WriteString(arg321)
+    if err323 != nil {
+      Usage()
+      return
+    }
+    factory324 := thrift.NewTJSONProtocolFactory()
+    jsProt325 := factory324.GetProtocol(mbTrans322)
+    argvalue0 := rpc.NewISource()
+    err326 := argvalue0.Read(context.Background(), jsProt325)
+    if err326 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2)
+    value1 := argvalue1
+    arg328 := flag.Arg(3)
+    mbTrans329 := thrift.NewTMemoryBufferLen(len(arg328))
+    defer mbTrans329.Close()
+    _, err330 := mbTrans329.WriteString(arg328)
+    if err330 != nil { 
+      Usage()
+      return
+    }
+    factory331 := thrift.NewTJSONProtocolFactory()
+    jsProt332 := factory331.GetProtocol(mbTrans329)
+    containerStruct2 := executor.NewIGeneralModuleExplodeSelectArgs()
+    err333 := containerStruct2.ReadField3(context.Background(), jsProt332)
+    if err333 != nil {
+      Usage()
+      return
+    }
+    argvalue2 := containerStruct2.Paths
+    value2 := argvalue2
+    fmt.Print(client.ExplodeSelect(context.Background(), value0, value1, value2))
+    fmt.Print("\n")
+    break
   case "groupBy":
     if flag.NArg() - 1 != 2 {
       fmt.Fprintln(os.Stderr, "GroupBy requires 2 args")
       flag.Usage()
     }
-    arg173 := flag.Arg(1)
-    mbTrans174 := thrift.NewTMemoryBufferLen(len(arg173))
-    defer mbTrans174.Close()
-    _, err175 := mbTrans174.WriteString(arg173)
-    if err175 != nil {
+    arg334 := flag.Arg(1)
+    mbTrans335 := thrift.NewTMemoryBufferLen(len(arg334))
+    defer mbTrans335.Close()
+    _, err336 := mbTrans335.WriteString(arg334)
+    if err336 != nil {
       Usage()
       return
     }
-    factory176 := thrift.NewTJSONProtocolFactory()
-    jsProt177 := factory176.GetProtocol(mbTrans174)
+    factory337 := thrift.NewTJSONProtocolFactory()
+    jsProt338 := factory337.GetProtocol(mbTrans335)
     argvalue0 := rpc.NewISource()
-    err178 := argvalue0.Read(context.Background(), jsProt177)
-    if err178 != nil {
+    err339 := argvalue0.Read(context.Background(), jsProt338)
+    if err339 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    argvalue1, err179 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err179 != nil {
+    argvalue1, err340 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err340 != nil {
       Usage()
       return
     }
@@ -481,8 +731,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1) == "true"
     value0 := argvalue0
-    argvalue1, err182 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err182 != nil {
+    argvalue1, err343 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err343 != nil {
       Usage()
       return
     }
@@ -495,19 +745,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "SortBy requires 2 args")
       flag.Usage()
     }
-    arg183 := flag.Arg(1)
-    mbTrans184 := thrift.NewTMemoryBufferLen(len(arg183))
-    defer mbTrans184.Close()
-    _, err185 := mbTrans184.WriteString(arg183)
-    if err185 != nil {
+    arg344 := flag.Arg(1)
+    mbTrans345 := thrift.NewTMemoryBufferLen(len(arg344))
+    defer mbTrans345.Close()
+    _, err346 := mbTrans345.WriteString(arg344)
+    if err346 != nil {
       Usage()
       return
     }
-    factory186 := thrift.NewTJSONProtocolFactory()
-    jsProt187 := factory186.GetProtocol(mbTrans184)
+    factory347 := thrift.NewTJSONProtocolFactory()
+    jsProt348 := factory347.GetProtocol(mbTrans345)
     argvalue0 := rpc.NewISource()
-    err188 := argvalue0.Read(context.Background(), jsProt187)
-    if err188 != nil {
+    err349 := argvalue0.Read(context.Background(), jsProt348)
+    if err349 != nil {
       Usage()
       return
     }
@@ -522,27 +772,27 @@ func main() {
       fmt.Fprintln(os.Stderr, "SortBy3 requires 3 args")
       flag.Usage()
     }
-    arg190 := flag.Arg(1)
-    mbTrans191 := thrift.NewTMemoryBufferLen(len(arg190))
-    defer mbTrans191.Close()
-    _, err192 := mbTrans191.WriteString(arg190)
-    if err192 != nil {
+    arg351 := flag.Arg(1)
+    mbTrans352 := thrift.NewTMemoryBufferLen(len(arg351))
+    defer mbTrans352.Close()
+    _, err353 := mbTrans352.WriteString(arg351)
+    if err353 != nil {
       Usage()
       return
     }
-    factory193 := thrift.NewTJSONProtocolFactory()
-    jsProt194 := factory193.GetProtocol(mbTrans191)
+    factory354 := thrift.NewTJSONProtocolFactory()
+    jsProt355 := factory354.GetProtocol(mbTrans352)
     argvalue0 := rpc.NewISource()
-    err195 := argvalue0.Read(context.Background(), jsProt194)
-    if err195 != nil {
+    err356 := argvalue0.Read(context.Background(), jsProt355)
+    if err356 != nil {
       Usage()
       return
     }
     value0 := argvalue0
     argvalue1 := flag.Arg(2) == "true"
     value1 := argvalue1
-    argvalue2, err197 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err197 != nil {
+    argvalue2, err358 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err358 != nil {
       Usage()
       return
     }
@@ -571,19 +821,19 @@ func main() {
     value0 := argvalue0
     argvalue1 := flag.Arg(2) == "true"
     value1 := argvalue1
-    arg202 := flag.Arg(3)
-    mbTrans203 := thrift.NewTMemoryBufferLen(len(arg202))
-    defer mbTrans203.Close()
-    _, err204 := mbTrans203.WriteString(arg202)
-    if err204 != nil {
+    arg363 := flag.Arg(3)
+    mbTrans364 := thrift.NewTMemoryBufferLen(len(arg363))
+    defer mbTrans364.Close()
+    _, err365 := mbTrans364.WriteString(arg363)
+    if err365 != nil {
       Usage()
       return
     }
-    factory205 := thrift.NewTJSONProtocolFactory()
-    jsProt206 := factory205.GetProtocol(mbTrans203)
+    factory366 := thrift.NewTJSONProtocolFactory()
+    jsProt367 := factory366.GetProtocol(mbTrans364)
     argvalue2 := rpc.NewISource()
-    err207 := argvalue2.Read(context.Background(), jsProt206)
-    if err207 != nil {
+    err368 := argvalue2.Read(context.Background(), jsProt367)
+    if err368 != nil {
       Usage()
       return
     }
@@ -591,6 +841,69 @@ func main() {
     fmt.Print(client.Union2(context.Background(), value0, value1, value2))
     fmt.Print("\n")
     break
+  case "unionAll":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "UnionAll requires 2 args")
+      flag.Usage()
+    }
+    arg369 := flag.Arg(1)
+    mbTrans370 := thrift.NewTMemoryBufferLen(len(arg369))
+    defer mbTrans370.Close()
+    _, err371 := mbTrans370.WriteString(arg369)
+    if err371 != nil { 
+      Usage()
+      return
+    }
+    factory372 := thrift.NewTJSONProtocolFactory()
+    jsProt373 := factory372.GetProtocol(mbTrans370)
+    containerStruct0 := executor.NewIGeneralModuleUnionAllArgs()
+    err374 := containerStruct0.ReadField1(context.Background(), jsProt373)
+    if err374 != nil {
+      Usage()
+      return
+    }
+    argvalue0 := containerStruct0.Others
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2) == "true"
+    value1 := argvalue1
+    fmt.Print(client.UnionAll(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "zip":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Zip requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    arg377 := flag.Arg(2)
+    mbTrans378 := thrift.NewTMemoryBufferLen(len(arg377))
+    defer mbTrans378.Close()
+    _, err379 := mbTrans378.WriteString(arg377)
+    if err379 != nil {
+      Usage()
+      return
+    }
+    factory380 := thrift.NewTJSONProtocolFactory()
+    jsProt381 := factory380.GetProtocol(mbTrans378)
+    argvalue1 := rpc.NewISource()
+    err382 := argvalue1.Read(context.Background(), jsProt381)
+    if err382 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.Zip(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "zipWithIndex":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "ZipWithIndex requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.ZipWithIndex(context.Background()))
+    fmt.Print("\n")
+    break
   case "join":
     if flag.NArg() - 1 != 2 {
       fmt.Fprintln(os.Stderr, "Join requires 2 args")
@@ -598,8 +911,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err209 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err209 != nil {
+    argvalue1, err384 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err384 != nil {
       Usage()
       return
     }
@@ -614,25 +927,25 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err211 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err211 != nil {
+    argvalue1, err386 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err386 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    arg212 := flag.Arg(3)
-    mbTrans213 := thrift.NewTMemoryBufferLen(len(arg212))
-    defer mbTrans213.Close()
-    _, err214 := mbTrans213.WriteString(arg212)
-    if err214 != nil {
+    arg387 := flag.Arg(3)
+    mbTrans388 := thrift.NewTMemoryBufferLen(len(arg387))
+    defer mbTrans388.Close()
+    _, err389 := mbTrans388.WriteString(arg387)
+    if err389 != nil {
       Usage()
       return
     }
-    factory215 := thrift.NewTJSONProtocolFactory()
-    jsProt216 := factory215.GetProtocol(mbTrans213)
+    factory390 := thrift.NewTJSONProtocolFactory()
+    jsProt391 := factory390.GetProtocol(mbTrans388)
     argvalue2 := rpc.NewISource()
-    err217 := argvalue2.Read(context.Background(), jsProt216)
-    if err217 != nil {
+    err392 := argvalue2.Read(context.Background(), jsProt391)
+    if err392 != nil {
       Usage()
       return
     }
@@ -640,13 +953,77 @@ func main() {
     fmt.Print(client.Join3(context.Background(), value0, value1, value2))
     fmt.Print("\n")
     break
+  case "leftOuterJoin":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "LeftOuterJoin requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err394 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err394 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.LeftOuterJoin(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "rightOuterJoin":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "RightOuterJoin requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err396 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err396 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.RightOuterJoin(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "fullOuterJoin":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "FullOuterJoin requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err398 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err398 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.FullOuterJoin(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "coGroup":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "CoGroup requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err400 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err400 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.CoGroup(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
   case "distinct":
     if flag.NArg() - 1 != 1 {
       fmt.Fprintln(os.Stderr, "Distinct requires 1 args")
       flag.Usage()
     }
-    argvalue0, err218 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err218 != nil {
+    argvalue0, err401 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err401 != nil {
       Usage()
       return
     }
@@ -659,25 +1036,25 @@ func main() {
       fmt.Fprintln(os.Stderr, "Distinct2 requires 2 args")
       flag.Usage()
     }
-    argvalue0, err219 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err219 != nil {
+    argvalue0, err402 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err402 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg220 := flag.Arg(2)
-    mbTrans221 := thrift.NewTMemoryBufferLen(len(arg220))
-    defer mbTrans221.Close()
-    _, err222 := mbTrans221.WriteString(arg220)
-    if err222 != nil {
+    arg403 := flag.Arg(2)
+    mbTrans404 := thrift.NewTMemoryBufferLen(len(arg403))
+    defer mbTrans404.Close()
+    _, err405 := mbTrans404.WriteString(arg403)
+    if err405 != nil {
       Usage()
       return
     }
-    factory223 := thrift.NewTJSONProtocolFactory()
-    jsProt224 := factory223.GetProtocol(mbTrans221)
+    factory406 := thrift.NewTJSONProtocolFactory()
+    jsProt407 := factory406.GetProtocol(mbTrans404)
     argvalue1 := rpc.NewISource()
-    err225 := argvalue1.Read(context.Background(), jsProt224)
-    if err225 != nil {
+    err408 := argvalue1.Read(context.Background(), jsProt407)
+    if err408 != nil {
       Usage()
       return
     }
@@ -685,13 +1062,61 @@ func main() {
     fmt.Print(client.Distinct2(context.Background(), value0, value1))
     fmt.Print("\n")
     break
+  case "intersection":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Intersection requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err410 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err410 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.Intersection(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "subtract":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Subtract requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err412 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err412 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.Subtract(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "subtractByKey":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "SubtractByKey requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err414 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err414 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.SubtractByKey(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
   case "repartition":
     if flag.NArg() - 1 != 3 {
       fmt.Fprintln(os.Stderr, "Repartition requires 3 args")
       flag.Usage()
     }
-    argvalue0, err226 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err226 != nil {
+    argvalue0, err415 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err415 != nil {
       Usage()
       return
     }
@@ -703,19 +1128,35 @@ func main() {
     fmt.Print(client.Repartition(context.Background(), value0, value1, value2))
     fmt.Print("\n")
     break
+  case "coalesce":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Coalesce requires 2 args")
+      flag.Usage()
+    }
+    argvalue0, err418 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err418 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2) == "true"
+    value1 := argvalue1
+    fmt.Print(client.Coalesce(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
   case "partitionByRandom":
     if flag.NArg() - 1 != 2 {
       fmt.Fprintln(os.Stderr, "PartitionByRandom requires 2 args")
       flag.Usage()
     }
-    argvalue0, err229 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err229 != nil {
+    argvalue0, err420 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err420 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    tmp1, err230 := (strconv.Atoi(flag.Arg(2)))
-    if err230 != nil {
+    tmp1, err421 := (strconv.Atoi(flag.Arg(2)))
+    if err421 != nil {
       Usage()
       return
     }
@@ -729,8 +1170,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "PartitionByHash requires 1 args")
       flag.Usage()
     }
-    argvalue0, err231 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err231 != nil {
+    argvalue0, err422 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err422 != nil {
       Usage()
       return
     }
@@ -743,25 +1184,25 @@ func main() {
       fmt.Fprintln(os.Stderr, "PartitionBy requires 2 args")
       flag.Usage()
     }
-    arg232 := flag.Arg(1)
-    mbTrans233 := thrift.NewTMemoryBufferLen(len(arg232))
-    defer mbTrans233.Close()
-    _, err234 := mbTrans233.WriteString(arg232)
-    if err234 != nil {
+    arg423 := flag.Arg(1)
+    mbTrans424 := thrift.NewTMemoryBufferLen(len(arg423))
+    defer mbTrans424.Close()
+    _, err425 := mbTrans424.WriteString(arg423)
+    if err425 != nil {
       Usage()
       return
     }
-    factory235 := thrift.NewTJSONProtocolFactory()
-    jsProt236 := factory235.GetProtocol(mbTrans233)
+    factory426 := thrift.NewTJSONProtocolFactory()
+    jsProt427 := factory426.GetProtocol(mbTrans424)
     argvalue0 := rpc.NewISource()
-    err237 := argvalue0.Read(context.Background(), jsProt236)
-    if err237 != nil {
+    err428 := argvalue0.Read(context.Background(), jsProt427)
+    if err428 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    argvalue1, err238 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err238 != nil {
+    argvalue1, err429 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err429 != nil {
       Usage()
       return
     }
@@ -769,24 +1210,125 @@ func main() {
     fmt.Print(client.PartitionBy(context.Background(), value0, value1))
     fmt.Print("\n")
     break
+  case "partitionByKeyHash":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "PartitionByKeyHash requires 1 args")
+      flag.Usage()
+    }
+    argvalue0, err430 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err430 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.PartitionByKeyHash(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "partitionByKeyRange":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "PartitionByKeyRange requires 1 args")
+      flag.Usage()
+    }
+    argvalue0, err431 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err431 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.PartitionByKeyRange(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "partitionByKey":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "PartitionByKey requires 2 args")
+      flag.Usage()
+    }
+    arg432 := flag.Arg(1)
+    mbTrans433 := thrift.NewTMemoryBufferLen(len(arg432))
+    defer mbTrans433.Close()
+    _, err434 := mbTrans433.WriteString(arg432)
+    if err434 != nil {
+      Usage()
+      return
+    }
+    factory435 := thrift.NewTJSONProtocolFactory()
+    jsProt436 := factory435.GetProtocol(mbTrans433)
+    argvalue0 := rpc.NewISource()
+    err437 := argvalue0.Read(context.Background(), jsProt436)
+    if err437 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1, err438 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err438 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.PartitionByKey(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "reorderPartitions":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "ReorderPartitions requires 1 args")
+      flag.Usage()
+    }
+    arg439 := flag.Arg(1)
+    mbTrans440 := thrift.NewTMemoryBufferLen(len(arg439))
+    defer mbTrans440.Close()
+    _, err441 := mbTrans440.WriteString(arg439)
+    if err441 != nil { 
+      Usage()
+      return
+    }
+    factory442 := thrift.NewTJSONProtocolFactory()
+    jsProt443 := factory442.GetProtocol(mbTrans440)
+    containerStruct0 := executor.NewIGeneralModuleReorderPartitionsArgs()
+    err444 := containerStruct0.ReadField1(context.Background(), jsProt443)
+    if err444 != nil {
+      Usage()
+      return
+    }
+    argvalue0 := containerStruct0.Order
+    value0 := argvalue0
+    fmt.Print(client.ReorderPartitions(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "reorderPartitionsBy":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "ReorderPartitionsBy requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.ReorderPartitionsBy(context.Background()))
+    fmt.Print("\n")
+    break
+  case "partitionOffset":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "PartitionOffset requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.PartitionOffset(context.Background()))
+    fmt.Print("\n")
+    break
   case "flatMapValues":
     if flag.NArg() - 1 != 1 {
       fmt.Fprintln(os.Stderr, "FlatMapValues requires 1 args")
       flag.Usage()
     }
-    arg239 := flag.Arg(1)
-    mbTrans240 := thrift.NewTMemoryBufferLen(len(arg239))
-    defer mbTrans240.Close()
-    _, err241 := mbTrans240.WriteString(arg239)
-    if err241 != nil {
+    arg445 := flag.Arg(1)
+    mbTrans446 := thrift.NewTMemoryBufferLen(len(arg445))
+    defer mbTrans446.Close()
+    _, err447 := mbTrans446.WriteString(arg445)
+    if err447 != nil {
       Usage()
       return
     }
-    factory242 := thrift.NewTJSONProtocolFactory()
-    jsProt243 := factory242.GetProtocol(mbTrans240)
+    factory448 := thrift.NewTJSONProtocolFactory()
+    jsProt449 := factory448.GetProtocol(mbTrans446)
     argvalue0 := rpc.NewISource()
-    err244 := argvalue0.Read(context.Background(), jsProt243)
-    if err244 != nil {
+    err450 := argvalue0.Read(context.Background(), jsProt449)
+    if err450 != nil {
       Usage()
       return
     }
@@ -799,19 +1341,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "MapValues requires 1 args")
       flag.Usage()
     }
-    arg245 := flag.Arg(1)
-    mbTrans246 := thrift.NewTMemoryBufferLen(len(arg245))
-    defer mbTrans246.Close()
-    _, err247 := mbTrans246.WriteString(arg245)
-    if err247 != nil {
+    arg451 := flag.Arg(1)
+    mbTrans452 := thrift.NewTMemoryBufferLen(len(arg451))
+    defer mbTrans452.Close()
+    _, err453 := mbTrans452.WriteString(arg451)
+    if err453 != nil {
       Usage()
       return
     }
-    factory248 := thrift.NewTJSONProtocolFactory()
-    jsProt249 := factory248.GetProtocol(mbTrans246)
+    factory454 := thrift.NewTJSONProtocolFactory()
+    jsProt455 := factory454.GetProtocol(mbTrans452)
     argvalue0 := rpc.NewISource()
-    err250 := argvalue0.Read(context.Background(), jsProt249)
-    if err250 != nil {
+    err456 := argvalue0.Read(context.Background(), jsProt455)
+    if err456 != nil {
       Usage()
       return
     }
@@ -824,8 +1366,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "GroupByKey requires 1 args")
       flag.Usage()
     }
-    argvalue0, err251 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err251 != nil {
+    argvalue0, err457 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err457 != nil {
       Usage()
       return
     }
@@ -838,25 +1380,25 @@ func main() {
       fmt.Fprintln(os.Stderr, "GroupByKey2 requires 2 args")
       flag.Usage()
     }
-    argvalue0, err252 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err252 != nil {
+    argvalue0, err458 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err458 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg253 := flag.Arg(2)
-    mbTrans254 := thrift.NewTMemoryBufferLen(len(arg253))
-    defer mbTrans254.Close()
-    _, err255 := mbTrans254.WriteString(arg253)
-    if err255 != nil {
+    arg459 := flag.Arg(2)
+    mbTrans460 := thrift.NewTMemoryBufferLen(len(arg459))
+    defer mbTrans460.Close()
+    _, err461 := mbTrans460.WriteString(arg459)
+    if err461 != nil {
       Usage()
       return
     }
-    factory256 := thrift.NewTJSONProtocolFactory()
-    jsProt257 := factory256.GetProtocol(mbTrans254)
+    factory462 := thrift.NewTJSONProtocolFactory()
+    jsProt463 := factory462.GetProtocol(mbTrans460)
     argvalue1 := rpc.NewISource()
-    err258 := argvalue1.Read(context.Background(), jsProt257)
-    if err258 != nil {
+    err464 := argvalue1.Read(context.Background(), jsProt463)
+    if err464 != nil {
       Usage()
       return
     }
@@ -869,25 +1411,25 @@ func main() {
       fmt.Fprintln(os.Stderr, "ReduceByKey requires 3 args")
       flag.Usage()
     }
-    arg259 := flag.Arg(1)
-    mbTrans260 := thrift.NewTMemoryBufferLen(len(arg259))
-    defer mbTrans260.Close()
-    _, err261 := mbTrans260.WriteString(arg259)
-    if err261 != nil {
+    arg465 := flag.Arg(1)
+    mbTrans466 := thrift.NewTMemoryBufferLen(len(arg465))
+    defer mbTrans466.Close()
+    _, err467 := mbTrans466.WriteString(arg465)
+    if err467 != nil {
       Usage()
       return
     }
-    factory262 := thrift.NewTJSONProtocolFactory()
-    jsProt263 := factory262.GetProtocol(mbTrans260)
+    factory468 := thrift.NewTJSONProtocolFactory()
+    jsProt469 := factory468.GetProtocol(mbTrans466)
     argvalue0 := rpc.NewISource()
-    err264 := argvalue0.Read(context.Background(), jsProt263)
-    if err264 != nil {
+    err470 := argvalue0.Read(context.Background(), jsProt469)
+    if err470 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    argvalue1, err265 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err265 != nil {
+    argvalue1, err471 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err471 != nil {
       Usage()
       return
     }
@@ -902,42 +1444,42 @@ func main() {
       fmt.Fprintln(os.Stderr, "AggregateByKey requires 3 args")
       flag.Usage()
     }
-    arg267 := flag.Arg(1)
-    mbTrans268 := thrift.NewTMemoryBufferLen(len(arg267))
-    defer mbTrans268.Close()
-    _, err269 := mbTrans268.WriteString(arg267)
-    if err269 != nil {
+    arg473 := flag.Arg(1)
+    mbTrans474 := thrift.NewTMemoryBufferLen(len(arg473))
+    defer mbTrans474.Close()
+    _, err475 := mbTrans474.WriteString(arg473)
+    if err475 != nil {
       Usage()
       return
     }
-    factory270 := thrift.NewTJSONProtocolFactory()
-    jsProt271 := factory270.GetProtocol(mbTrans268)
+    factory476 := thrift.NewTJSONProtocolFactory()
+    jsProt477 := factory476.GetProtocol(mbTrans474)
     argvalue0 := rpc.NewISource()
-    err272 := argvalue0.Read(context.Background(), jsProt271)
-    if err272 != nil {
+    err478 := argvalue0.Read(context.Background(), jsProt477)
+    if err478 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg273 := flag.Arg(2)
-    mbTrans274 := thrift.NewTMemoryBufferLen(len(arg273))
-    defer mbTrans274.Close()
-    _, err275 := mbTrans274.WriteString(arg273)
-    if err275 != nil {
+    arg479 := flag.Arg(2)
+    mbTrans480 := thrift.NewTMemoryBufferLen(len(arg479))
+    defer mbTrans480.Close()
+    _, err481 := mbTrans480.WriteString(arg479)
+    if err481 != nil {
       Usage()
       return
     }
-    factory276 := thrift.NewTJSONProtocolFactory()
-    jsProt277 := factory276.GetProtocol(mbTrans274)
+    factory482 := thrift.NewTJSONProtocolFactory()
+    jsProt483 := factory482.GetProtocol(mbTrans480)
     argvalue1 := rpc.NewISource()
-    err278 := argvalue1.Read(context.Background(), jsProt277)
-    if err278 != nil {
+    err484 := argvalue1.Read(context.Background(), jsProt483)
+    if err484 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    argvalue2, err279 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err279 != nil {
+    argvalue2, err485 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err485 != nil {
       Usage()
       return
     }
@@ -950,59 +1492,59 @@ func main() {
       fmt.Fprintln(os.Stderr, "AggregateByKey4 requires 4 args")
       flag.Usage()
     }
-    arg280 := flag.Arg(1)
-    mbTrans281 := thrift.NewTMemoryBufferLen(len(arg280))
-    defer mbTrans281.Close()
-    _, err282 := mbTrans281.WriteString(arg280)
-    if err282 != nil {
+    arg486 := flag.Arg(1)
+    mbTrans487 := thrift.NewTMemoryBufferLen(len(arg486))
+    defer mbTrans487.Close()
+    _, err488 := mbTrans487.WriteString(arg486)
+    if err488 != nil {
       Usage()
       return
     }
-    factory283 := thrift.NewTJSONProtocolFactory()
-    jsProt284 := factory283.GetProtocol(mbTrans281)
+    factory489 := thrift.NewTJSONProtocolFactory()
+    jsProt490 := factory489.GetProtocol(mbTrans487)
     argvalue0 := rpc.NewISource()
-    err285 := argvalue0.Read(context.Background(), jsProt284)
-    if err285 != nil {
+    err491 := argvalue0.Read(context.Background(), jsProt490)
+    if err491 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg286 := flag.Arg(2)
-    mbTrans287 := thrift.NewTMemoryBufferLen(len(arg286))
-    defer mbTrans287.Close()
-    _, err288 := mbTrans287.WriteString(arg286)
-    if err288 != nil {
+    arg492 := flag.Arg(2)
+    mbTrans493 := thrift.NewTMemoryBufferLen(len(arg492))
+    defer mbTrans493.Close()
+    _, err494 := mbTrans493.WriteString(arg492)
+    if err494 != nil {
       Usage()
       return
     }
-    factory289 := thrift.NewTJSONProtocolFactory()
-    jsProt290 := factory289.GetProtocol(mbTrans287)
+    factory495 := thrift.NewTJSONProtocolFactory()
+    jsProt496 := factory495.GetProtocol(mbTrans493)
     argvalue1 := rpc.NewISource()
-    err291 := argvalue1.Read(context.Background(), jsProt290)
-    if err291 != nil {
+    err497 := argvalue1.Read(context.Background(), jsProt496)
+    if err497 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    arg292 := flag.Arg(3)
-    mbTrans293 := thrift.NewTMemoryBufferLen(len(arg292))
-    defer mbTrans293.Close()
-    _, err294 := mbTrans293.WriteString(arg292)
-    if err294 != nil {
+    arg498 := flag.Arg(3)
+    mbTrans499 := thrift.NewTMemoryBufferLen(len(arg498))
+    defer mbTrans499.Close()
+    _, err500 := mbTrans499.WriteString(arg498)
+    if err500 != nil {
       Usage()
       return
     }
-    factory295 := thrift.NewTJSONProtocolFactory()
-    jsProt296 := factory295.GetProtocol(mbTrans293)
+    factory501 := thrift.NewTJSONProtocolFactory()
+    jsProt502 := factory501.GetProtocol(mbTrans499)
     argvalue2 := rpc.NewISource()
-    err297 := argvalue2.Read(context.Background(), jsProt296)
-    if err297 != nil {
+    err503 := argvalue2.Read(context.Background(), jsProt502)
+    if err503 != nil {
       Usage()
       return
     }
     value2 := argvalue2
-    argvalue3, err298 := (strconv.ParseInt(flag.Arg(4), 10, 64))
-    if err298 != nil {
+    argvalue3, err504 := (strconv.ParseInt(flag.Arg(4), 10, 64))
+    if err504 != nil {
       Usage()
       return
     }
@@ -1015,42 +1557,42 @@ func main() {
       fmt.Fprintln(os.Stderr, "FoldByKey requires 4 args")
       flag.Usage()
     }
-    arg299 := flag.Arg(1)
-    mbTrans300 := thrift.NewTMemoryBufferLen(len(arg299))
-    defer mbTrans300.Close()
-    _, err301 := mbTrans300.WriteString(arg299)
-    if err301 != nil {
+    arg505 := flag.Arg(1)
+    mbTrans506 := thrift.NewTMemoryBufferLen(len(arg505))
+    defer mbTrans506.Close()
+    _, err507 := mbTrans506.WriteString(arg505)
+    if err507 != nil {
       Usage()
       return
     }
-    factory302 := thrift.NewTJSONProtocolFactory()
-    jsProt303 := factory302.GetProtocol(mbTrans300)
+    factory508 := thrift.NewTJSONProtocolFactory()
+    jsProt509 := factory508.GetProtocol(mbTrans506)
     argvalue0 := rpc.NewISource()
-    err304 := argvalue0.Read(context.Background(), jsProt303)
-    if err304 != nil {
+    err510 := argvalue0.Read(context.Background(), jsProt509)
+    if err510 != nil {
       Usage()
       return
     }
     value0 := argvalue0
-    arg305 := flag.Arg(2)
-    mbTrans306 := thrift.NewTMemoryBufferLen(len(arg305))
-    defer mbTrans306.Close()
-    _, err307 := mbTrans306.WriteString(arg305)
-    if err307 != nil {
+    arg511 := flag.Arg(2)
+    mbTrans512 := thrift.NewTMemoryBufferLen(len(arg511))
+    defer mbTrans512.Close()
+    _, err513 := mbTrans512.WriteString(arg511)
+    if err513 != nil {
       Usage()
       return
     }
-    factory308 := thrift.NewTJSONProtocolFactory()
-    jsProt309 := factory308.GetProtocol(mbTrans306)
+    factory514 := thrift.NewTJSONProtocolFactory()
+    jsProt515 := factory514.GetProtocol(mbTrans512)
     argvalue1 := rpc.NewISource()
-    err310 := argvalue1.Read(context.Background(), jsProt309)
-    if err310 != nil {
+    err516 := argvalue1.Read(context.Background(), jsProt515)
+    if err516 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    argvalue2, err311 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err311 != nil {
+    argvalue2, err517 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err517 != nil {
       Usage()
       return
     }
@@ -1060,6 +1602,140 @@ func main() {
     fmt.Print(client.FoldByKey(context.Background(), value0, value1, value2, value3))
     fmt.Print("\n")
     break
+  case "pivot":
+    if flag.NArg() - 1 != 4 {
+      fmt.Fprintln(os.Stderr, "Pivot requires 4 args")
+      flag.Usage()
+    }
+    arg519 := flag.Arg(1)
+    mbTrans520 := thrift.NewTMemoryBufferLen(len(arg519))
+    defer mbTrans520.Close()
+    _, err521 := mbTrans520.WriteString(arg519)
+    if err521 != nil {
+      Usage()
+      return
+    }
+    factory522 := thrift.NewTJSONProtocolFactory()
+    jsProt523 := factory522.GetProtocol(mbTrans520)
+    argvalue0 := rpc.NewISource()
+    err524 := argvalue0.Read(context.Background(), jsProt523)
+    if err524 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    arg525 := flag.Arg(2)
+    mbTrans526 := thrift.NewTMemoryBufferLen(len(arg525))
+    defer mbTrans526.Close()
+    _, err527 := mbTrans526.WriteString(arg525)
+    if err527 != nil {
+      Usage()
+      return
+    }
+    factory528 := thrift.NewTJSONProtocolFactory()
+    jsProt529 := factory528.GetProtocol(mbTrans526)
+    argvalue1 := rpc.NewISource()
+    err530 := argvalue1.Read(context.Background(), jsProt529)
+    if err530 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    arg531 := flag.Arg(3)
+    mbTrans532 := thrift.NewTMemoryBufferLen(len(arg531))
+    defer mbTrans532.Close()
+    _, err533 := mbTrans532.WriteString(arg531)
+    if err533 != nil {
+      Usage()
+      return
+    }
+    factory534 := thrift.NewTJSONProtocolFactory()
+    jsProt535 := factory534.GetProtocol(mbTrans532)
+    argvalue2 := rpc.NewISource()
+    err536 := argvalue2.Read(context.Background(), jsProt535)
+    if err536 != nil {
+      Usage()
+      return
+    }
+    value2 := argvalue2
+    argvalue3, err537 := (strconv.ParseInt(flag.Arg(4), 10, 64))
+    if err537 != nil {
+      Usage()
+      return
+    }
+    value3 := argvalue3
+    fmt.Print(client.Pivot(context.Background(), value0, value1, value2, value3))
+    fmt.Print("\n")
+    break
+  case "unpivot":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Unpivot requires 2 args")
+      flag.Usage()
+    }
+    arg538 := flag.Arg(1)
+    mbTrans539 := thrift.NewTMemoryBufferLen(len(arg538))
+    defer mbTrans539.Close()
+    _, err540 := mbTrans539.WriteString(arg538)
+    if err540 != nil {
+      Usage()
+      return
+    }
+    factory541 := thrift.NewTJSONProtocolFactory()
+    jsProt542 := factory541.GetProtocol(mbTrans539)
+    argvalue0 := rpc.NewISource()
+    err543 := argvalue0.Read(context.Background(), jsProt542)
+    if err543 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2)
+    value1 := argvalue1
+    fmt.Print(client.Unpivot(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "scan":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "Scan requires 2 args")
+      flag.Usage()
+    }
+    arg545 := flag.Arg(1)
+    mbTrans546 := thrift.NewTMemoryBufferLen(len(arg545))
+    defer mbTrans546.Close()
+    _, err547 := mbTrans546.WriteString(arg545)
+    if err547 != nil {
+      Usage()
+      return
+    }
+    factory548 := thrift.NewTJSONProtocolFactory()
+    jsProt549 := factory548.GetProtocol(mbTrans546)
+    argvalue0 := rpc.NewISource()
+    err550 := argvalue0.Read(context.Background(), jsProt549)
+    if err550 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    arg551 := flag.Arg(2)
+    mbTrans552 := thrift.NewTMemoryBufferLen(len(arg551))
+    defer mbTrans552.Close()
+    _, err553 := mbTrans552.WriteString(arg551)
+    if err553 != nil {
+      Usage()
+      return
+    }
+    factory554 := thrift.NewTJSONProtocolFactory()
+    jsProt555 := factory554.GetProtocol(mbTrans552)
+    argvalue1 := rpc.NewISource()
+    err556 := argvalue1.Read(context.Background(), jsProt555)
+    if err556 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.Scan(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
   case "sortByKey":
     if flag.NArg() - 1 != 1 {
       fmt.Fprintln(os.Stderr, "SortByKey requires 1 args")
@@ -1077,8 +1753,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1) == "true"
     value0 := argvalue0
-    argvalue1, err315 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err315 != nil {
+    argvalue1, err559 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err559 != nil {
       Usage()
       return
     }
@@ -1091,19 +1767,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "SortByKey2b requires 2 args")
       flag.Usage()
     }
-    arg316 := flag.Arg(1)
-    mbTrans317 := thrift.NewTMemoryBufferLen(len(arg316))
-    defer mbTrans317.Close()
-    _, err318 := mbTrans317.WriteString(arg316)
-    if err318 != nil {
+    arg560 := flag.Arg(1)
+    mbTrans561 := thrift.NewTMemoryBufferLen(len(arg560))
+    defer mbTrans561.Close()
+    _, err562 := mbTrans561.WriteString(arg560)
+    if err562 != nil {
       Usage()
       return
     }
-    factory319 := thrift.NewTJSONProtocolFactory()
-    jsProt320 := factory319.GetProtocol(mbTrans317)
+    factory563 := thrift.NewTJSONProtocolFactory()
+    jsProt564 := factory563.GetProtocol(mbTrans561)
     argvalue0 := rpc.NewISource()
-    err321 := argvalue0.Read(context.Background(), jsProt320)
-    if err321 != nil {
+    err565 := argvalue0.Read(context.Background(), jsProt564)
+    if err565 != nil {
       Usage()
       return
     }
@@ -1118,27 +1794,27 @@ func main() {
       fmt.Fprintln(os.Stderr, "SortByKey3 requires 3 args")
       flag.Usage()
     }
-    arg323 := flag.Arg(1)
-    mbTrans324 := thrift.NewTMemoryBufferLen(len(arg323))
-    defer mbTrans324.Close()
-    _, err325 := mbTrans324.WriteString(arg323)
-    if err325 != nil {
+    arg567 := flag.Arg(1)
+    mbTrans568 := thrift.NewTMemoryBufferLen(len(arg567))
+    defer mbTrans568.Close()
+    _, err569 := mbTrans568.WriteString(arg567)
+    if err569 != nil {
       Usage()
       return
     }
-    factory326 := thrift.NewTJSONProtocolFactory()
-    jsProt327 := factory326.GetProtocol(mbTrans324)
+    factory570 := thrift.NewTJSONProtocolFactory()
+    jsProt571 := factory570.GetProtocol(mbTrans568)
     argvalue0 := rpc.NewISource()
-    err328 := argvalue0.Read(context.Background(), jsProt327)
-    if err328 != nil {
+    err572 := argvalue0.Read(context.Background(), jsProt571)
+    if err572 != nil {
       Usage()
       return
     }
     value0 := argvalue0
     argvalue1 := flag.Arg(2) == "true"
     value1 := argvalue1
-    argvalue2, err330 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err330 != nil {
+    argvalue2, err574 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err574 != nil {
       Usage()
       return
     }
@@ -1146,6 +1822,141 @@ func main() {
     fmt.Print(client.SortByKey3(context.Background(), value0, value1, value2))
     fmt.Print("\n")
     break
+  case "repartitionAndSortWithinPartitions":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "RepartitionAndSortWithinPartitions requires 2 args")
+      flag.Usage()
+    }
+    argvalue0, err575 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err575 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2) == "true"
+    value1 := argvalue1
+    fmt.Print(client.RepartitionAndSortWithinPartitions(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "groupByKeyAndSortValues":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "GroupByKeyAndSortValues requires 2 args")
+      flag.Usage()
+    }
+    argvalue0, err577 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err577 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2) == "true"
+    value1 := argvalue1
+    fmt.Print(client.GroupByKeyAndSortValues(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "groupByKeyAndSortValues3":
+    if flag.NArg() - 1 != 3 {
+      fmt.Fprintln(os.Stderr, "GroupByKeyAndSortValues3 requires 3 args")
+      flag.Usage()
+    }
+    arg579 := flag.Arg(1)
+    mbTrans580 := thrift.NewTMemoryBufferLen(len(arg579))
+    defer mbTrans580.Close()
+    _, err581 := mbTrans580.WriteString(arg579)
+    if err581 != nil {
+      Usage()
+      return
+    }
+    factory582 := thrift.NewTJSONProtocolFactory()
+    jsProt583 := factory582.GetProtocol(mbTrans580)
+    argvalue0 := rpc.NewISource()
+    err584 := argvalue0.Read(context.Background(), jsProt583)
+    if err584 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1, err585 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err585 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    argvalue2 := flag.Arg(3) == "true"
+    value2 := argvalue2
+    fmt.Print(client.GroupByKeyAndSortValues3(context.Background(), value0, value1, value2))
+    fmt.Print("\n")
+    break
+  case "gapsAndIslands":
+    if flag.NArg() - 1 != 4 {
+      fmt.Fprintln(os.Stderr, "GapsAndIslands requires 4 args")
+      flag.Usage()
+    }
+    arg587 := flag.Arg(1)
+    mbTrans588 := thrift.NewTMemoryBufferLen(len(arg587))
+    defer mbTrans588.Close()
+    _, err589 := mbTrans588.WriteString(arg587)
+    if err589 != nil {
+      Usage()
+      return
+    }
+    factory590 := thrift.NewTJSONProtocolFactory()
+    jsProt591 := factory590.GetProtocol(mbTrans588)
+    argvalue0 := rpc.NewISource()
+    err592 := argvalue0.Read(context.Background(), jsProt591)
+    if err592 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    arg593 := flag.Arg(2)
+    mbTrans594 := thrift.NewTMemoryBufferLen(len(arg593))
+    defer mbTrans594.Close()
+    _, err595 := mbTrans594.WriteString(arg593)
+    if err595 != nil {
+      Usage()
+      return
+    }
+    factory596 := thrift.NewTJSONProtocolFactory()
+    jsProt597 := factory596.GetProtocol(mbTrans594)
+    argvalue1 := rpc.NewISource()
+    err598 := argvalue1.Read(context.Background(), jsProt597)
+    if err598 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    argvalue2, err599 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err599 != nil {
+      Usage()
+      return
+    }
+    value2 := argvalue2
+    argvalue3, err600 := (strconv.ParseInt(flag.Arg(4), 10, 64))
+    if err600 != nil {
+      Usage()
+      return
+    }
+    value3 := argvalue3
+    fmt.Print(client.GapsAndIslands(context.Background(), value0, value1, value2, value3))
+    fmt.Print("\n")
+    break
+  case "recomputePartitions":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "RecomputePartitions requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.RecomputePartitions(context.Background()))
+    fmt.Print("\n")
+    break
+  case "partitionStats":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "PartitionStats requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.PartitionStats(context.Background()))
+    fmt.Print("\n")
+    break
   case "":
     Usage()
     break
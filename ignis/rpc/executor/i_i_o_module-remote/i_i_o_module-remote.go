@@ -33,13 +33,20 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void plainFile3(string path, i64 minPartitions, string delim)")
   fmt.Fprintln(os.Stderr, "  void textFile(string path)")
   fmt.Fprintln(os.Stderr, "  void textFile2(string path, i64 minPartitions)")
+  fmt.Fprintln(os.Stderr, "  void sequenceFile(string path, i64 minPartitions)")
+  fmt.Fprintln(os.Stderr, "  void binaryFile(string path, ISource src)")
+  fmt.Fprintln(os.Stderr, "  void csvFile(string path, i64 minPartitions, string delimiter, string quote, bool header, ISource src)")
+  fmt.Fprintln(os.Stderr, "  string jsonFile(string path, i64 minPartitions, bool permissive, ISource src)")
   fmt.Fprintln(os.Stderr, "  void partitionObjectFile(string path, i64 first, i64 partitions)")
   fmt.Fprintln(os.Stderr, "  void partitionObjectFile4(string path, i64 first, i64 partitions, ISource src)")
   fmt.Fprintln(os.Stderr, "  void partitionTextFile(string path, i64 first, i64 partitions)")
   fmt.Fprintln(os.Stderr, "  void partitionJsonFile4a(string path, i64 first, i64 partitions, bool objectMapping)")
   fmt.Fprintln(os.Stderr, "  void partitionJsonFile4b(string path, i64 first, i64 partitions, ISource src)")
   fmt.Fprintln(os.Stderr, "  void saveAsObjectFile(string path, i8 compression, i64 first)")
+  fmt.Fprintln(os.Stderr, "  void saveAsSequenceFile(string path, i8 compression, i64 first)")
+  fmt.Fprintln(os.Stderr, "  void saveAsBinaryFile(string path, i8 compression, i64 first)")
   fmt.Fprintln(os.Stderr, "  void saveAsTextFile(string path, i64 first)")
+  fmt.Fprintln(os.Stderr, "  void saveAsTextFile3(string path, i64 first, ISource src)")
   fmt.Fprintln(os.Stderr, "  void saveAsJsonFile(string path, i64 first, bool pretty)")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
@@ -168,19 +175,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "LoadClass requires 1 args")
       flag.Usage()
     }
-    arg54 := flag.Arg(1)
-    mbTrans55 := thrift.NewTMemoryBufferLen(len(arg54))
-    defer mbTrans55.Close()
-    _, err56 := mbTrans55.WriteString(arg54)
-    if err56 != nil {
+    arg75 := flag.Arg(1)
+    mbTrans76 := thrift.NewTMemoryBufferLen(len(arg75))
+    defer mbTrans76.Close()
+    _, err77 := mbTrans76.WriteString(arg75)
+    if err77 != nil {
       Usage()
       return
     }
-    factory57 := thrift.NewTJSONProtocolFactory()
-    jsProt58 := factory57.GetProtocol(mbTrans55)
+    factory78 := thrift.NewTJSONProtocolFactory()
+    jsProt79 := factory78.GetProtocol(mbTrans76)
     argvalue0 := rpc.NewISource()
-    err59 := argvalue0.Read(context.Background(), jsProt58)
-    if err59 != nil {
+    err80 := argvalue0.Read(context.Background(), jsProt79)
+    if err80 != nil {
       Usage()
       return
     }
@@ -241,8 +248,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err64 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err64 != nil {
+    argvalue1, err85 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err85 != nil {
       Usage()
       return
     }
@@ -269,8 +276,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err68 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err68 != nil {
+    argvalue1, err89 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err89 != nil {
       Usage()
       return
     }
@@ -278,6 +285,123 @@ func main() {
     fmt.Print(client.TextFile2(context.Background(), value0, value1))
     fmt.Print("\n")
     break
+  case "sequenceFile":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "SequenceFile requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err91 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err91 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.SequenceFile(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "binaryFile":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "BinaryFile requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    arg93 := flag.Arg(2)
+    mbTrans94 := thrift.NewTMemoryBufferLen(len(arg93))
+    defer mbTrans94.Close()
+    _, err95 := mbTrans94.WriteString(arg93)
+    if err95 != nil {
+      Usage()
+      return
+    }
+    factory96 := thrift.NewTJSONProtocolFactory()
+    jsProt97 := factory96.GetProtocol(mbTrans94)
+    argvalue1 := rpc.NewISource()
+    err98 := argvalue1.Read(context.Background(), jsProt97)
+    if err98 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.BinaryFile(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "csvFile":
+    if flag.NArg() - 1 != 6 {
+      fmt.Fprintln(os.Stderr, "CsvFile requires 6 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err100 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err100 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    argvalue2 := flag.Arg(3)
+    value2 := argvalue2
+    argvalue3 := flag.Arg(4)
+    value3 := argvalue3
+    argvalue4 := flag.Arg(5) == "true"
+    value4 := argvalue4
+    arg104 := flag.Arg(6)
+    mbTrans105 := thrift.NewTMemoryBufferLen(len(arg104))
+    defer mbTrans105.Close()
+    _, err106 := mbTrans105.WriteString(arg104)
+    if err106 != nil {
+      Usage()
+      return
+    }
+    factory107 := thrift.NewTJSONProtocolFactory()
+    jsProt108 := factory107.GetProtocol(mbTrans105)
+    argvalue5 := rpc.NewISource()
+    err109 := argvalue5.Read(context.Background(), jsProt108)
+    if err109 != nil {
+      Usage()
+      return
+    }
+    value5 := argvalue5
+    fmt.Print(client.CsvFile(context.Background(), value0, value1, value2, value3, value4, value5))
+    fmt.Print("\n")
+    break
+  case "jsonFile":
+    if flag.NArg() - 1 != 4 {
+      fmt.Fprintln(os.Stderr, "JsonFile requires 4 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err111 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err111 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    argvalue2 := flag.Arg(3) == "true"
+    value2 := argvalue2
+    arg113 := flag.Arg(4)
+    mbTrans114 := thrift.NewTMemoryBufferLen(len(arg113))
+    defer mbTrans114.Close()
+    _, err115 := mbTrans114.WriteString(arg113)
+    if err115 != nil {
+      Usage()
+      return
+    }
+    factory116 := thrift.NewTJSONProtocolFactory()
+    jsProt117 := factory116.GetProtocol(mbTrans114)
+    argvalue3 := rpc.NewISource()
+    err118 := argvalue3.Read(context.Background(), jsProt117)
+    if err118 != nil {
+      Usage()
+      return
+    }
+    value3 := argvalue3
+    fmt.Print(client.JsonFile(context.Background(), value0, value1, value2, value3))
+    fmt.Print("\n")
+    break
   case "partitionObjectFile":
     if flag.NArg() - 1 != 3 {
       fmt.Fprintln(os.Stderr, "PartitionObjectFile requires 3 args")
@@ -285,14 +409,14 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err70 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err70 != nil {
+    argvalue1, err120 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err120 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    argvalue2, err71 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err71 != nil {
+    argvalue2, err121 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err121 != nil {
       Usage()
       return
     }
@@ -307,31 +431,31 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err73 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err73 != nil {
+    argvalue1, err123 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err123 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    argvalue2, err74 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err74 != nil {
+    argvalue2, err124 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err124 != nil {
       Usage()
       return
     }
     value2 := argvalue2
-    arg75 := flag.Arg(4)
-    mbTrans76 := thrift.NewTMemoryBufferLen(len(arg75))
-    defer mbTrans76.Close()
-    _, err77 := mbTrans76.WriteString(arg75)
-    if err77 != nil {
+    arg125 := flag.Arg(4)
+    mbTrans126 := thrift.NewTMemoryBufferLen(len(arg125))
+    defer mbTrans126.Close()
+    _, err127 := mbTrans126.WriteString(arg125)
+    if err127 != nil {
       Usage()
       return
     }
-    factory78 := thrift.NewTJSONProtocolFactory()
-    jsProt79 := factory78.GetProtocol(mbTrans76)
+    factory128 := thrift.NewTJSONProtocolFactory()
+    jsProt129 := factory128.GetProtocol(mbTrans126)
     argvalue3 := rpc.NewISource()
-    err80 := argvalue3.Read(context.Background(), jsProt79)
-    if err80 != nil {
+    err130 := argvalue3.Read(context.Background(), jsProt129)
+    if err130 != nil {
       Usage()
       return
     }
@@ -346,14 +470,14 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err82 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err82 != nil {
+    argvalue1, err132 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err132 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    argvalue2, err83 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err83 != nil {
+    argvalue2, err133 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err133 != nil {
       Usage()
       return
     }
@@ -368,14 +492,14 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err85 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err85 != nil {
+    argvalue1, err135 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err135 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    argvalue2, err86 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err86 != nil {
+    argvalue2, err136 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err136 != nil {
       Usage()
       return
     }
@@ -392,31 +516,31 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err89 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err89 != nil {
+    argvalue1, err139 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err139 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    argvalue2, err90 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err90 != nil {
+    argvalue2, err140 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err140 != nil {
       Usage()
       return
     }
     value2 := argvalue2
-    arg91 := flag.Arg(4)
-    mbTrans92 := thrift.NewTMemoryBufferLen(len(arg91))
-    defer mbTrans92.Close()
-    _, err93 := mbTrans92.WriteString(arg91)
-    if err93 != nil {
+    arg141 := flag.Arg(4)
+    mbTrans142 := thrift.NewTMemoryBufferLen(len(arg141))
+    defer mbTrans142.Close()
+    _, err143 := mbTrans142.WriteString(arg141)
+    if err143 != nil {
       Usage()
       return
     }
-    factory94 := thrift.NewTJSONProtocolFactory()
-    jsProt95 := factory94.GetProtocol(mbTrans92)
+    factory144 := thrift.NewTJSONProtocolFactory()
+    jsProt145 := factory144.GetProtocol(mbTrans142)
     argvalue3 := rpc.NewISource()
-    err96 := argvalue3.Read(context.Background(), jsProt95)
-    if err96 != nil {
+    err146 := argvalue3.Read(context.Background(), jsProt145)
+    if err146 != nil {
       Usage()
       return
     }
@@ -431,15 +555,15 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    tmp1, err98 := (strconv.Atoi(flag.Arg(2)))
-    if err98 != nil {
+    tmp1, err148 := (strconv.Atoi(flag.Arg(2)))
+    if err148 != nil {
       Usage()
       return
     }
     argvalue1 := int8(tmp1)
     value1 := argvalue1
-    argvalue2, err99 := (strconv.ParseInt(flag.Arg(3), 10, 64))
-    if err99 != nil {
+    argvalue2, err149 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err149 != nil {
       Usage()
       return
     }
@@ -447,6 +571,52 @@ func main() {
     fmt.Print(client.SaveAsObjectFile(context.Background(), value0, value1, value2))
     fmt.Print("\n")
     break
+  case "saveAsSequenceFile":
+    if flag.NArg() - 1 != 3 {
+      fmt.Fprintln(os.Stderr, "SaveAsSequenceFile requires 3 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    tmp1, err151 := (strconv.Atoi(flag.Arg(2)))
+    if err151 != nil {
+      Usage()
+      return
+    }
+    argvalue1 := int8(tmp1)
+    value1 := argvalue1
+    argvalue2, err152 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err152 != nil {
+      Usage()
+      return
+    }
+    value2 := argvalue2
+    fmt.Print(client.SaveAsSequenceFile(context.Background(), value0, value1, value2))
+    fmt.Print("\n")
+    break
+  case "saveAsBinaryFile":
+    if flag.NArg() - 1 != 3 {
+      fmt.Fprintln(os.Stderr, "SaveAsBinaryFile requires 3 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    tmp1, err154 := (strconv.Atoi(flag.Arg(2)))
+    if err154 != nil {
+      Usage()
+      return
+    }
+    argvalue1 := int8(tmp1)
+    value1 := argvalue1
+    argvalue2, err155 := (strconv.ParseInt(flag.Arg(3), 10, 64))
+    if err155 != nil {
+      Usage()
+      return
+    }
+    value2 := argvalue2
+    fmt.Print(client.SaveAsBinaryFile(context.Background(), value0, value1, value2))
+    fmt.Print("\n")
+    break
   case "saveAsTextFile":
     if flag.NArg() - 1 != 2 {
       fmt.Fprintln(os.Stderr, "SaveAsTextFile requires 2 args")
@@ -454,8 +624,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err101 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err101 != nil {
+    argvalue1, err157 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err157 != nil {
       Usage()
       return
     }
@@ -463,6 +633,39 @@ func main() {
     fmt.Print(client.SaveAsTextFile(context.Background(), value0, value1))
     fmt.Print("\n")
     break
+  case "saveAsTextFile3":
+    if flag.NArg() - 1 != 3 {
+      fmt.Fprintln(os.Stderr, "SaveAsTextFile3 requires 3 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1, err159 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err159 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    arg160 := flag.Arg(3)
+    mbTrans161 := thrift.NewTMemoryBufferLen(len(arg160))
+    defer mbTrans161.Close()
+    _, err162 := mbTrans161.WriteString(arg160)
+    if err162 != nil {
+      Usage()
+      return
+    }
+    factory163 := thrift.NewTJSONProtocolFactory()
+    jsProt164 := factory163.GetProtocol(mbTrans161)
+    argvalue2 := rpc.NewISource()
+    err165 := argvalue2.Read(context.Background(), jsProt164)
+    if err165 != nil {
+      Usage()
+      return
+    }
+    value2 := argvalue2
+    fmt.Print(client.SaveAsTextFile3(context.Background(), value0, value1, value2))
+    fmt.Print("\n")
+    break
   case "saveAsJsonFile":
     if flag.NArg() - 1 != 3 {
       fmt.Fprintln(os.Stderr, "SaveAsJsonFile requires 3 args")
@@ -470,8 +673,8 @@ func main() {
     }
     argvalue0 := flag.Arg(1)
     value0 := argvalue0
-    argvalue1, err103 := (strconv.ParseInt(flag.Arg(2), 10, 64))
-    if err103 != nil {
+    argvalue1, err167 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err167 != nil {
       Usage()
       return
     }
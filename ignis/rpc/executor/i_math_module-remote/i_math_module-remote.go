@@ -33,6 +33,11 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  void sampleByKey(bool withReplacement, ISource fractions, i32 seed)")
   fmt.Fprintln(os.Stderr, "  void countByKey()")
   fmt.Fprintln(os.Stderr, "  void countByValue()")
+  fmt.Fprintln(os.Stderr, "  void histogram(i64 buckets)")
+  fmt.Fprintln(os.Stderr, "  void approxQuantile( probabilities, double relativeError)")
+  fmt.Fprintln(os.Stderr, "  void sum()")
+  fmt.Fprintln(os.Stderr, "  void mean()")
+  fmt.Fprintln(os.Stderr, "  void sumByKey(i64 numPartitions)")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
 }
@@ -162,26 +167,26 @@ func main() {
     }
     argvalue0 := flag.Arg(1) == "true"
     value0 := argvalue0
-    arg31 := flag.Arg(2)
-    mbTrans32 := thrift.NewTMemoryBufferLen(len(arg31))
-    defer mbTrans32.Close()
-    _, err33 := mbTrans32.WriteString(arg31)
-    if err33 != nil { 
+    arg47 := flag.Arg(2)
+    mbTrans48 := thrift.NewTMemoryBufferLen(len(arg47))
+    defer mbTrans48.Close()
+    _, err49 := mbTrans48.WriteString(arg47)
+    if err49 != nil { 
       Usage()
       return
     }
-    factory34 := thrift.NewTJSONProtocolFactory()
-    jsProt35 := factory34.GetProtocol(mbTrans32)
+    factory50 := thrift.NewTJSONProtocolFactory()
+    jsProt51 := factory50.GetProtocol(mbTrans48)
     containerStruct1 := executor.NewIMathModuleSampleArgs()
-    err36 := containerStruct1.ReadField2(context.Background(), jsProt35)
-    if err36 != nil {
+    err52 := containerStruct1.ReadField2(context.Background(), jsProt51)
+    if err52 != nil {
       Usage()
       return
     }
     argvalue1 := containerStruct1.Num
     value1 := argvalue1
-    tmp2, err37 := (strconv.Atoi(flag.Arg(3)))
-    if err37 != nil {
+    tmp2, err53 := (strconv.Atoi(flag.Arg(3)))
+    if err53 != nil {
       Usage()
       return
     }
@@ -219,19 +224,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Max1 requires 1 args")
       flag.Usage()
     }
-    arg38 := flag.Arg(1)
-    mbTrans39 := thrift.NewTMemoryBufferLen(len(arg38))
-    defer mbTrans39.Close()
-    _, err40 := mbTrans39.WriteString(arg38)
-    if err40 != nil {
+    arg54 := flag.Arg(1)
+    mbTrans55 := thrift.NewTMemoryBufferLen(len(arg54))
+    defer mbTrans55.Close()
+    _, err56 := mbTrans55.WriteString(arg54)
+    if err56 != nil {
       Usage()
       return
     }
-    factory41 := thrift.NewTJSONProtocolFactory()
-    jsProt42 := factory41.GetProtocol(mbTrans39)
+    factory57 := thrift.NewTJSONProtocolFactory()
+    jsProt58 := factory57.GetProtocol(mbTrans55)
     argvalue0 := rpc.NewISource()
-    err43 := argvalue0.Read(context.Background(), jsProt42)
-    if err43 != nil {
+    err59 := argvalue0.Read(context.Background(), jsProt58)
+    if err59 != nil {
       Usage()
       return
     }
@@ -244,19 +249,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "Min1 requires 1 args")
       flag.Usage()
     }
-    arg44 := flag.Arg(1)
-    mbTrans45 := thrift.NewTMemoryBufferLen(len(arg44))
-    defer mbTrans45.Close()
-    _, err46 := mbTrans45.WriteString(arg44)
-    if err46 != nil {
+    arg60 := flag.Arg(1)
+    mbTrans61 := thrift.NewTMemoryBufferLen(len(arg60))
+    defer mbTrans61.Close()
+    _, err62 := mbTrans61.WriteString(arg60)
+    if err62 != nil {
       Usage()
       return
     }
-    factory47 := thrift.NewTJSONProtocolFactory()
-    jsProt48 := factory47.GetProtocol(mbTrans45)
+    factory63 := thrift.NewTJSONProtocolFactory()
+    jsProt64 := factory63.GetProtocol(mbTrans61)
     argvalue0 := rpc.NewISource()
-    err49 := argvalue0.Read(context.Background(), jsProt48)
-    if err49 != nil {
+    err65 := argvalue0.Read(context.Background(), jsProt64)
+    if err65 != nil {
       Usage()
       return
     }
@@ -271,25 +276,25 @@ func main() {
     }
     argvalue0 := flag.Arg(1) == "true"
     value0 := argvalue0
-    arg51 := flag.Arg(2)
-    mbTrans52 := thrift.NewTMemoryBufferLen(len(arg51))
-    defer mbTrans52.Close()
-    _, err53 := mbTrans52.WriteString(arg51)
-    if err53 != nil {
+    arg67 := flag.Arg(2)
+    mbTrans68 := thrift.NewTMemoryBufferLen(len(arg67))
+    defer mbTrans68.Close()
+    _, err69 := mbTrans68.WriteString(arg67)
+    if err69 != nil {
       Usage()
       return
     }
-    factory54 := thrift.NewTJSONProtocolFactory()
-    jsProt55 := factory54.GetProtocol(mbTrans52)
+    factory70 := thrift.NewTJSONProtocolFactory()
+    jsProt71 := factory70.GetProtocol(mbTrans68)
     argvalue1 := rpc.NewISource()
-    err56 := argvalue1.Read(context.Background(), jsProt55)
-    if err56 != nil {
+    err72 := argvalue1.Read(context.Background(), jsProt71)
+    if err72 != nil {
       Usage()
       return
     }
     value1 := argvalue1
-    tmp2, err57 := (strconv.Atoi(flag.Arg(3)))
-    if err57 != nil {
+    tmp2, err73 := (strconv.Atoi(flag.Arg(3)))
+    if err73 != nil {
       Usage()
       return
     }
@@ -314,6 +319,82 @@ func main() {
     fmt.Print(client.CountByValue(context.Background()))
     fmt.Print("\n")
     break
+  case "histogram":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "Histogram requires 1 args")
+      flag.Usage()
+    }
+    argvalue0, err74 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err74 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.Histogram(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "approxQuantile":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "ApproxQuantile requires 2 args")
+      flag.Usage()
+    }
+    arg75 := flag.Arg(1)
+    mbTrans76 := thrift.NewTMemoryBufferLen(len(arg75))
+    defer mbTrans76.Close()
+    _, err77 := mbTrans76.WriteString(arg75)
+    if err77 != nil { 
+      Usage()
+      return
+    }
+    factory78 := thrift.NewTJSONProtocolFactory()
+    jsProt79 := factory78.GetProtocol(mbTrans76)
+    containerStruct0 := executor.NewIMathModuleApproxQuantileArgs()
+    err80 := containerStruct0.ReadField1(context.Background(), jsProt79)
+    if err80 != nil {
+      Usage()
+      return
+    }
+    argvalue0 := containerStruct0.Probabilities
+    value0 := argvalue0
+    argvalue1, err81 := (strconv.ParseFloat(flag.Arg(2), 64))
+    if err81 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.ApproxQuantile(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "sum":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "Sum requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.Sum(context.Background()))
+    fmt.Print("\n")
+    break
+  case "mean":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "Mean requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.Mean(context.Background()))
+    fmt.Print("\n")
+    break
+  case "sumByKey":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "SumByKey requires 1 args")
+      flag.Usage()
+    }
+    argvalue0, err82 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err82 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.SumByKey(context.Background(), value0))
+    fmt.Print("\n")
+    break
   case "":
     Usage()
     break